@@ -22,6 +22,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/cannon/runner"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 )
@@ -79,7 +80,7 @@ var (
 	}
 	RunStopAtPreimageTypeFlag = &cli.StringFlag{
 		Name:     "stop-at-preimage-type",
-		Usage:    "stop at the first preimage request matching this type",
+		Usage:    "stop at the first preimage request matching this type, the name of a preimage key type (e.g. keccak) or any",
 		Required: false,
 	}
 	RunStopAtPreimageLargerThanFlag = &cli.StringFlag{
@@ -131,18 +132,6 @@ type Proof struct {
 	OracleOffset uint32        `json:"oracle-offset,omitempty"`
 }
 
-type rawHint string
-
-func (rh rawHint) Hint() string {
-	return string(rh)
-}
-
-type rawKey [32]byte
-
-func (rk rawKey) PreimageKey() [32]byte {
-	return rk
-}
-
 type ProcessPreimageOracle struct {
 	pCl      *preimage.OracleClient
 	hCl      *preimage.HintWriter
@@ -196,14 +185,14 @@ func (p *ProcessPreimageOracle) Hint(v []byte) {
 	if p.hCl == nil { // no hint processor
 		return
 	}
-	p.hCl.Hint(rawHint(v))
+	p.hCl.Hint(runner.RawHint(v))
 }
 
 func (p *ProcessPreimageOracle) GetPreimage(k [32]byte) []byte {
 	if p.pCl == nil {
 		panic("no pre-image retriever available")
 	}
-	return p.pCl.Get(rawKey(k))
+	return p.pCl.Get(runner.RawKey(k))
 }
 
 func (p *ProcessPreimageOracle) Start() error {
@@ -274,6 +263,16 @@ func Guard(proc *os.ProcessState, fn StepFn) StepFn {
 
 var _ mipsevm.PreimageOracle = (*ProcessPreimageOracle)(nil)
 
+// guardedVM reports the exit code of the pre-image server when a step fails.
+type guardedVM struct {
+	mipsevm.FPVM
+	step StepFn
+}
+
+func (g *guardedVM) Step(proof bool) (*mipsevm.StepWitness, error) {
+	return g.step(proof)
+}
+
 func Run(ctx *cli.Context) error {
 	if ctx.Bool(RunPProfCPU.Name) {
 		defer profile.Start(profile.NoShutdownHook, profile.ProfilePath("."), profile.CPUProfile).Stop()
@@ -303,23 +302,17 @@ func Run(ctx *cli.Context) error {
 			stopAtPreimageOffset = uint32(x)
 		}
 	} else {
-		switch ctx.String(RunStopAtPreimageTypeFlag.Name) {
-		case "local":
-			stopAtPreimageKeyPrefix = []byte{byte(preimage.LocalKeyType)}
-		case "keccak":
-			stopAtPreimageKeyPrefix = []byte{byte(preimage.Keccak256KeyType)}
-		case "sha256":
-			stopAtPreimageKeyPrefix = []byte{byte(preimage.Sha256KeyType)}
-		case "blob":
-			stopAtPreimageKeyPrefix = []byte{byte(preimage.BlobKeyType)}
-		case "precompile":
-			stopAtPreimageKeyPrefix = []byte{byte(preimage.PrecompileKeyType)}
+		switch typ := ctx.String(RunStopAtPreimageTypeFlag.Name); typ {
 		case "any":
 			stopAtAnyPreimage = true
 		case "":
 			// 0 preimage type is forbidden so will not stop at any preimage
 		default:
-			return fmt.Errorf("invalid preimage type %q", ctx.String(RunStopAtPreimageTypeFlag.Name))
+			info, ok := preimage.KeyTypeByName(typ)
+			if !ok {
+				return fmt.Errorf("invalid preimage type %q", typ)
+			}
+			stopAtPreimageKeyPrefix = []byte{byte(info.Type)}
 		}
 	}
 	stopAtPreimageLargerThan := ctx.Int(RunStopAtPreimageLargerThanFlag.Name)
@@ -386,56 +379,47 @@ func Run(ctx *cli.Context) error {
 	proofFmt := ctx.String(RunProofFmtFlag.Name)
 	snapshotFmt := ctx.String(RunSnapshotFmtFlag.Name)
 
-	stepFn := vm.Step
+	var runVM mipsevm.FPVM = vm
 	if po.cmd != nil {
-		stepFn = Guard(po.cmd.ProcessState, stepFn)
+		runVM = &guardedVM{FPVM: vm, step: Guard(po.cmd.ProcessState, vm.Step)}
 	}
 
 	start := time.Now()
 
 	startStep := state.GetStep()
 
-	for !state.GetExited() {
-		step := state.GetStep()
-		if step%100 == 0 { // don't do the ctx err check (includes lock) too often
-			if err := ctx.Context.Err(); err != nil {
-				return err
+	hooks := runner.Hooks{
+		BeforeStep: func(vm mipsevm.FPVM) error {
+			step := state.GetStep()
+			if infoAt(state) {
+				delta := time.Since(start)
+				l.Info("processing",
+					"step", step,
+					"pc", mipsevm.HexU32(state.GetPC()),
+					"insn", mipsevm.HexU32(state.GetMemory().GetMemory(state.GetPC())),
+					"ips", float64(step-startStep)/(float64(delta)/float64(time.Second)),
+					"pages", state.GetMemory().PageCount(),
+					"mem", state.GetMemory().Usage(),
+					"name", meta.LookupSymbol(state.GetPC()),
+				)
 			}
-		}
-
-		if infoAt(state) {
-			delta := time.Since(start)
-			l.Info("processing",
-				"step", step,
-				"pc", mipsevm.HexU32(state.GetPC()),
-				"insn", mipsevm.HexU32(state.GetMemory().GetMemory(state.GetPC())),
-				"ips", float64(step-startStep)/(float64(delta)/float64(time.Second)),
-				"pages", state.GetMemory().PageCount(),
-				"mem", state.GetMemory().Usage(),
-				"name", meta.LookupSymbol(state.GetPC()),
-			)
-		}
-
-		if vm.CheckInfiniteLoop() {
-			// don't loop forever when we get stuck because of an unexpected bad program
-			return fmt.Errorf("detected an infinite loop at step %d", step)
-		}
 
-		if stopAt(state) {
-			l.Info("Reached stop at")
-			break
-		}
-
-		if snapshotAt(state) {
-			if err := serialize.Write(fmt.Sprintf(snapshotFmt, step), state, OutFilePerm); err != nil {
-				return fmt.Errorf("failed to write state snapshot: %w", err)
+			if stopAt(state) {
+				l.Info("Reached stop at")
+				return runner.ErrStop
 			}
-		}
 
-		if proofAt(state) {
-			witness, err := stepFn(true)
-			if err != nil {
-				return fmt.Errorf("failed at proof-gen step %d (PC: %08x): %w", step, state.GetPC(), err)
+			if snapshotAt(state) {
+				if err := serialize.Write(fmt.Sprintf(snapshotFmt, step), state, OutFilePerm); err != nil {
+					return fmt.Errorf("failed to write state snapshot: %w", err)
+				}
+			}
+			return nil
+		},
+		ProofAt: func(state mipsevm.FPVMState) bool { return proofAt(state) },
+		AfterStep: func(vm mipsevm.FPVM, step uint64, witness *mipsevm.StepWitness) error {
+			if witness == nil {
+				return nil
 			}
 			_, postStateHash := state.EncodeWitness()
 			proof := &Proof{
@@ -453,31 +437,29 @@ func Run(ctx *cli.Context) error {
 			if err := jsonutil.WriteJSON(proof, ioutil.ToStdOutOrFileOrNoop(fmt.Sprintf(proofFmt, step), OutFilePerm)); err != nil {
 				return fmt.Errorf("failed to write proof data: %w", err)
 			}
-		} else {
-			_, err = stepFn(false)
-			if err != nil {
-				return fmt.Errorf("failed at step %d (PC: %08x): %w", step, state.GetPC(), err)
-			}
-		}
-
-		lastPreimageKey, lastPreimageValue, lastPreimageOffset := vm.LastPreimage()
-		if lastPreimageOffset != ^uint32(0) {
+			return nil
+		},
+		OnPreimage: func(key [32]byte, value []byte, offset uint32) error {
 			if stopAtAnyPreimage {
 				l.Info("Stopping at preimage read")
-				break
+				return runner.ErrStop
 			}
 			if len(stopAtPreimageKeyPrefix) > 0 &&
-				slices.Equal(lastPreimageKey[:len(stopAtPreimageKeyPrefix)], stopAtPreimageKeyPrefix) {
-				if stopAtPreimageOffset == lastPreimageOffset {
-					l.Info("Stopping at preimage read", "keyPrefix", common.Bytes2Hex(stopAtPreimageKeyPrefix), "offset", lastPreimageOffset)
-					break
+				slices.Equal(key[:len(stopAtPreimageKeyPrefix)], stopAtPreimageKeyPrefix) {
+				if stopAtPreimageOffset == offset {
+					l.Info("Stopping at preimage read", "keyPrefix", common.Bytes2Hex(stopAtPreimageKeyPrefix), "offset", offset)
+					return runner.ErrStop
 				}
 			}
-			if stopAtPreimageLargerThan != 0 && len(lastPreimageValue) > stopAtPreimageLargerThan {
-				l.Info("Stopping at preimage read", "size", len(lastPreimageValue), "min", stopAtPreimageLargerThan)
-				break
+			if stopAtPreimageLargerThan != 0 && len(value) > stopAtPreimageLargerThan {
+				l.Info("Stopping at preimage read", "size", len(value), "min", stopAtPreimageLargerThan)
+				return runner.ErrStop
 			}
-		}
+			return nil
+		},
+	}
+	if _, err := runner.Run(ctx.Context, runVM, runner.Config{Hooks: hooks}); err != nil {
+		return err
 	}
 	l.Info("Execution stopped", "exited", state.GetExited(), "code", state.GetExitCode())
 	if debugProgram {
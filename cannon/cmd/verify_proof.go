@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/singlethreaded"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+var (
+	VerifyProofInputFlag = &cli.PathFlag{
+		Name:      "input",
+		Usage:     "path of the step proof JSON, as written by the run command.",
+		TakesFile: true,
+		Required:  true,
+	}
+	VerifyProofPostFlag = &cli.StringFlag{
+		Name:  "post",
+		Usage: "claimed post-state hash, instead of the post-state hash of the step proof.",
+	}
+)
+
+func VerifyProof(ctx *cli.Context) error {
+	input := ctx.Path(VerifyProofInputFlag.Name)
+	proof, err := jsonutil.LoadJSON[Proof](input)
+	if err != nil {
+		return fmt.Errorf("invalid input proof (%v): %w", input, err)
+	}
+	claimedPost := proof.Post
+	if ctx.IsSet(VerifyProofPostFlag.Name) {
+		if err := claimedPost.UnmarshalText([]byte(ctx.String(VerifyProofPostFlag.Name))); err != nil {
+			return fmt.Errorf("invalid post-state hash: %w", err)
+		}
+	}
+	if len(proof.StateData) != singlethreaded.STATE_WITNESS_SIZE {
+		return fmt.Errorf("unsupported state witness of %d bytes, only single-threaded states are supported", len(proof.StateData))
+	}
+	preHash, err := singlethreaded.StateWitness(proof.StateData).StateHash()
+	if err != nil {
+		return err
+	}
+	if preHash != proof.Pre {
+		return fmt.Errorf("pre-state hash mismatch: proof has %v, state witness hashes to %v", proof.Pre, preHash)
+	}
+
+	var oracleKey [32]byte
+	var oracleValue []byte
+	if len(proof.OracleKey) > 0 {
+		if len(proof.OracleKey) != 32 {
+			return fmt.Errorf("invalid pre-image key length %d", len(proof.OracleKey))
+		}
+		copy(oracleKey[:], proof.OracleKey)
+		oracleValue = proof.OracleValue
+		if err := verifyPreimage(oracleKey, oracleValue); err != nil {
+			return err
+		}
+	}
+
+	post, err := singlethreaded.VerifyStep(proof.StateData, proof.ProofData, oracleKey, oracleValue)
+	if err != nil {
+		return fmt.Errorf("step %d failed: %w", proof.Step, err)
+	}
+	postHash, err := post.StateHash()
+	if err != nil {
+		return err
+	}
+	if postHash != claimedPost {
+		return fmt.Errorf("post-state hash mismatch: claimed %v, step results in %v", claimedPost, postHash)
+	}
+	fmt.Println(postHash.Hex())
+	return nil
+}
+
+// verifyPreimage checks that the pre-image value matches its key, like the pre-image oracle contract does
+// when loading it. Local and other pre-images depend on context not available in the proof, and are not checked.
+func verifyPreimage(key [32]byte, value []byte) error {
+	if len(value) < 8 {
+		return errors.New("pre-image value is missing its length prefix")
+	}
+	data := value[8:]
+	var expected [32]byte
+	switch preimage.KeyType(key[0]) {
+	case preimage.Keccak256KeyType:
+		expected = preimage.Keccak256Key(crypto.Keccak256Hash(data)).PreimageKey()
+	case preimage.Sha256KeyType:
+		expected = preimage.Sha256Key(sha256.Sum256(data)).PreimageKey()
+	default:
+		return nil
+	}
+	if expected != key {
+		return fmt.Errorf("pre-image value does not match key %v", common.Hash(key))
+	}
+	return nil
+}
+
+var VerifyProofCommand = &cli.Command{
+	Name:  "verify-proof",
+	Usage: "Verify a step proof locally",
+	Description: "Verify a step proof locally, as the MIPS contract would on-chain: the memory proofs must match the pre-state, " +
+		"the pre-image must match its key, and the step must result in the claimed post-state. The post-state hash is written to stdout",
+	Action: VerifyProof,
+	Flags: []cli.Flag{
+		VerifyProofInputFlag,
+		VerifyProofPostFlag,
+	},
+}
@@ -49,6 +49,11 @@ func (m *MemoryTrackerImpl) Reset(enableProof bool) {
 	m.lastMemAccess = ^uint32(0)
 }
 
+// LastMemAccess returns the address of the last memory access, or max uint32 if there was none since the reset.
+func (m *MemoryTrackerImpl) LastMemAccess() uint32 {
+	return m.lastMemAccess
+}
+
 func (m *MemoryTrackerImpl) MemProof() [memory.MEM_PROOF_SIZE]byte {
 	return m.memProof
 }
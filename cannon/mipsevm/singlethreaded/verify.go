@@ -0,0 +1,173 @@
+package singlethreaded
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/memory"
+)
+
+var (
+	ErrInvalidInstructionProof = errors.New("invalid instruction proof")
+	ErrInvalidMemoryProof      = errors.New("invalid memory proof")
+	ErrMissingPreimage         = errors.New("missing pre-image")
+)
+
+// VerifyStep executes a single step of the encoded pre-state, like the MIPS contract does on-chain:
+// the memory is served from the merkle proofs of the step, which must match the memory root of the pre-state,
+// and the pre-image oracle only serves the pre-image data of the step.
+// The oracle value includes the 8-byte length prefix, as recorded in the step witness.
+// It returns the witness of the post-state.
+func VerifyStep(stateData []byte, proofData []byte, oracleKey [32]byte, oracleValue []byte) (StateWitness, error) {
+	if len(stateData) != STATE_WITNESS_SIZE {
+		return nil, fmt.Errorf("invalid state witness length %d, expected %d", len(stateData), STATE_WITNESS_SIZE)
+	}
+	if len(proofData) != 2*memory.MEM_PROOF_SIZE {
+		return nil, fmt.Errorf("invalid proof data length %d, expected %d", len(proofData), 2*memory.MEM_PROOF_SIZE)
+	}
+	if oracleValue != nil && (len(oracleValue) < 8 || binary.BigEndian.Uint64(oracleValue[:8]) != uint64(len(oracleValue)-8)) {
+		return nil, errors.New("invalid length prefix of pre-image value")
+	}
+	insnProof, memProof := proofData[:memory.MEM_PROOF_SIZE], proofData[memory.MEM_PROOF_SIZE:]
+	pre, memRoot, err := decodeWitness(stateData)
+	if err != nil {
+		return nil, err
+	}
+	if root := memProofRoot(pre.Cpu.PC, insnProof); root != memRoot {
+		return nil, fmt.Errorf("%w: pc %08x proves root %x, expected %x", ErrInvalidInstructionProof, pre.Cpu.PC, root, memRoot)
+	}
+	po := &stepPreimageOracle{key: oracleKey, value: oracleValue}
+
+	// The accessed memory address is only known once the instruction is decoded,
+	// so a first pass without the accessed memory determines which memory proof to verify.
+	post, effAddr, err := execStep(stateData, po, map[uint32][]byte{pre.Cpu.PC: insnProof})
+	if err != nil {
+		return nil, err
+	}
+	if effAddr == ^uint32(0) {
+		return encodeWitness(post, memRoot), nil
+	}
+	if root := memProofRoot(effAddr, memProof); root != memRoot {
+		return nil, fmt.Errorf("%w: address %08x proves root %x, expected %x", ErrInvalidMemoryProof, effAddr, root, memRoot)
+	}
+	post, _, err = execStep(stateData, po, map[uint32][]byte{pre.Cpu.PC: insnProof, effAddr: memProof})
+	if err != nil {
+		return nil, err
+	}
+	// Only the accessed memory can be written to, so the post memory root follows from its updated leaf.
+	var leaf [32]byte
+	if _, err := io.ReadFull(post.Memory.ReadMemoryRange(effAddr&^31, 32), leaf[:]); err != nil {
+		return nil, fmt.Errorf("failed to read updated memory: %w", err)
+	}
+	updated := make([]byte, memory.MEM_PROOF_SIZE)
+	copy(updated, leaf[:])
+	copy(updated[32:], memProof[32:])
+	return encodeWitness(post, memProofRoot(effAddr, updated)), nil
+}
+
+// execStep executes a step of the encoded state, with only the memory leaves of the given proofs loaded.
+// It returns the post-state and the address of the memory accessed by the step, if any.
+func execStep(stateData []byte, po *stepPreimageOracle, proofs map[uint32][]byte) (post *State, effAddr uint32, err error) {
+	state, _, err := decodeWitness(stateData)
+	if err != nil {
+		return nil, 0, err
+	}
+	for addr, proof := range proofs {
+		for i := uint32(0); i < 32; i += 4 {
+			state.Memory.SetMemory(addr&^31+i, binary.BigEndian.Uint32(proof[i:i+4]))
+		}
+	}
+	vm := NewInstrumentedState(state, po, io.Discard, io.Discard, nil)
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = fmt.Errorf("step failed: %w", e)
+			} else {
+				err = fmt.Errorf("step failed: %v", r)
+			}
+		}
+	}()
+	if _, err := vm.Step(true); err != nil {
+		return nil, 0, err
+	}
+	return state, vm.memoryTracker.LastMemAccess(), nil
+}
+
+// memProofRoot computes the memory root proven by the merkle proof of the memory leaf at the given address.
+func memProofRoot(addr uint32, proof []byte) common.Hash {
+	var node [32]byte
+	copy(node[:], proof[:32])
+	for i := 0; i < 27; i++ {
+		var sibling [32]byte
+		copy(sibling[:], proof[32*(i+1):32*(i+2)])
+		if addr&(1<<(5+i)) != 0 {
+			node = memory.HashPair(sibling, node)
+		} else {
+			node = memory.HashPair(node, sibling)
+		}
+	}
+	return node
+}
+
+// decodeWitness decodes the state witness into a state with empty memory, and the memory root of the witness.
+func decodeWitness(sw []byte) (*State, common.Hash, error) {
+	if len(sw) != STATE_WITNESS_SIZE {
+		return nil, common.Hash{}, fmt.Errorf("invalid state witness length %d, expected %d", len(sw), STATE_WITNESS_SIZE)
+	}
+	s := CreateEmptyState()
+	memRoot := common.BytesToHash(sw[:32])
+	s.PreimageKey = common.BytesToHash(sw[32:64])
+	offset := 64
+	readUint32 := func() uint32 {
+		v := binary.BigEndian.Uint32(sw[offset : offset+4])
+		offset += 4
+		return v
+	}
+	s.PreimageOffset = readUint32()
+	s.Cpu.PC = readUint32()
+	s.Cpu.NextPC = readUint32()
+	s.Cpu.LO = readUint32()
+	s.Cpu.HI = readUint32()
+	s.Heap = readUint32()
+	s.ExitCode = sw[offset]
+	switch sw[offset+1] {
+	case 0:
+	case 1:
+		s.Exited = true
+	default:
+		return nil, common.Hash{}, fmt.Errorf("invalid exited flag %d", sw[offset+1])
+	}
+	offset += 2
+	s.Step = binary.BigEndian.Uint64(sw[offset : offset+8])
+	offset += 8
+	for i := range s.Registers {
+		s.Registers[i] = readUint32()
+	}
+	return s, memRoot, nil
+}
+
+// encodeWitness encodes the witness of the state with the given memory root, since the state only holds part of the memory.
+func encodeWitness(s *State, memRoot common.Hash) StateWitness {
+	out, _ := s.EncodeWitness()
+	copy(out[:32], memRoot[:])
+	return out
+}
+
+// stepPreimageOracle only serves the pre-image data of a single step.
+type stepPreimageOracle struct {
+	key   [32]byte
+	value []byte
+}
+
+func (o *stepPreimageOracle) Hint(v []byte) {}
+
+func (o *stepPreimageOracle) GetPreimage(k [32]byte) []byte {
+	if o.value == nil || k != o.key {
+		panic(fmt.Errorf("%w: %x", ErrMissingPreimage, k))
+	}
+	return o.value[8:]
+}
@@ -0,0 +1,112 @@
+package singlethreaded
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/exec"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/memory"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+type verifyTestOracle struct {
+	data []byte
+}
+
+func (o *verifyTestOracle) Hint(v []byte) {}
+
+func (o *verifyTestOracle) GetPreimage(k [32]byte) []byte {
+	return o.data
+}
+
+func TestVerifyStep(t *testing.T) {
+	preimageData := []byte("hello world, this is a pre-image")
+	preimageKey := preimage.Keccak256Key(crypto.Keccak256Hash(preimageData)).PreimageKey()
+
+	newState := func(insn uint32) *State {
+		state := CreateInitialState(0x1000, 0x4000_0000)
+		state.Memory.SetMemory(0x1000, insn)
+		// unrelated memory, so the proofs are not trivial
+		state.Memory.SetMemory(0x1004, 0xaabbccdd)
+		state.Memory.SetMemory(0x2008, 0x11223344)
+		state.Memory.SetMemory(0x8000_0000, 0x55667788)
+		state.PreimageKey = preimageKey
+		state.Registers[8] = 0xdeadbeef // $t0
+		state.Registers[9] = 0x2000     // $t1
+		return state
+	}
+	generate := func(t *testing.T, state *State) *mipsevm.StepWitness {
+		vm := NewInstrumentedState(state, &verifyTestOracle{data: preimageData}, io.Discard, io.Discard, nil)
+		wit, err := vm.Step(true)
+		require.NoError(t, err)
+		return wit
+	}
+	verify := func(t *testing.T, state *State) {
+		wit := generate(t, state)
+		post, err := VerifyStep(wit.State, wit.ProofData, wit.PreimageKey, wit.PreimageValue)
+		require.NoError(t, err)
+		expected, expectedHash := state.EncodeWitness()
+		require.Equal(t, StateWitness(expected), post)
+		postHash, err := post.StateHash()
+		require.NoError(t, err)
+		require.Equal(t, expectedHash, postHash)
+	}
+
+	t.Run("NoMemoryAccess", func(t *testing.T) {
+		verify(t, newState(0x25290001)) // addiu $t1, $t1, 1
+	})
+	t.Run("Load", func(t *testing.T) {
+		verify(t, newState(0x8d280008)) // lw $t0, 8($t1)
+	})
+	t.Run("Store", func(t *testing.T) {
+		verify(t, newState(0xad280004)) // sw $t0, 4($t1)
+	})
+	t.Run("StoreInstructionLeaf", func(t *testing.T) {
+		state := newState(0xad280004) // sw $t0, 4($t1)
+		state.Registers[9] = 0x1000
+		verify(t, state)
+	})
+	t.Run("PreimageRead", func(t *testing.T) {
+		state := newState(0x0000000c) // syscall
+		state.Registers[2] = exec.SysRead
+		state.Registers[4] = exec.FdPreimageRead
+		state.Registers[5] = 0x2004
+		state.Registers[6] = 4
+		state.PreimageOffset = 8
+		verify(t, state)
+	})
+
+	t.Run("InvalidInstructionProof", func(t *testing.T) {
+		wit := generate(t, newState(0x8d280008))
+		wit.ProofData[0] ^= 1
+		_, err := VerifyStep(wit.State, wit.ProofData, wit.PreimageKey, wit.PreimageValue)
+		require.ErrorIs(t, err, ErrInvalidInstructionProof)
+	})
+	t.Run("InvalidMemoryProof", func(t *testing.T) {
+		wit := generate(t, newState(0x8d280008))
+		wit.ProofData[memory.MEM_PROOF_SIZE+32*3] ^= 1
+		_, err := VerifyStep(wit.State, wit.ProofData, wit.PreimageKey, wit.PreimageValue)
+		require.ErrorIs(t, err, ErrInvalidMemoryProof)
+	})
+	t.Run("MissingPreimage", func(t *testing.T) {
+		state := newState(0x0000000c) // syscall
+		state.Registers[2] = exec.SysRead
+		state.Registers[4] = exec.FdPreimageRead
+		state.Registers[5] = 0x2004
+		state.Registers[6] = 4
+		wit := generate(t, state)
+		_, err := VerifyStep(wit.State, wit.ProofData, [32]byte{}, nil)
+		require.ErrorIs(t, err, ErrMissingPreimage)
+	})
+	t.Run("InvalidPreimageLength", func(t *testing.T) {
+		wit := generate(t, newState(0x0000000c))
+		value := binary.BigEndian.AppendUint64(nil, 100)
+		_, err := VerifyStep(wit.State, wit.ProofData, preimageKey, append(value, preimageData...))
+		require.ErrorContains(t, err, "length prefix")
+	})
+}
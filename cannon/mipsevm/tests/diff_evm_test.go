@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/testutil"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
+)
+
+// skipPreimageOracle skips the test when a preimage is read,
+// as the preimages of random and recorded states are not available.
+type skipPreimageOracle struct {
+	t *testing.T
+}
+
+var _ mipsevm.PreimageOracle = (*skipPreimageOracle)(nil)
+
+func (o *skipPreimageOracle) Hint(v []byte) {}
+
+func (o *skipPreimageOracle) GetPreimage(k [32]byte) []byte {
+	o.t.Skipf("step reads preimage %x, which is not available", k)
+	return nil
+}
+
+// FuzzStateDiffRandomStep executes a random instruction from a random state in both the Go VM and the EVM,
+// and asserts that both produce the same post-state, or that both fail.
+func FuzzStateDiffRandomStep(f *testing.F) {
+	versions := GetMipsVersionTestCases(f)
+	f.Fuzz(func(t *testing.T, insn uint32, seed int64) {
+		for _, v := range versions {
+			t.Run(v.Name, func(t *testing.T) {
+				oracle := &skipPreimageOracle{t: t}
+				goVm := v.VMFactory(oracle, io.Discard, io.Discard, testutil.CreateLogger(), testutil.WithRandomization(seed))
+				state := goVm.GetState()
+				state.GetMemory().SetMemory(state.GetPC(), insn)
+				testutil.DiffStep(t, goVm, oracle, v.StateHashFn, v.Contracts, nil)
+			})
+		}
+	})
+}
+
+// TestDiffRecordedStates executes recorded states, e.g. the snapshots of `cannon run`, in both the Go VM and the EVM.
+// Set CANNON_DIFF_STATES to a directory of state files to run it,
+// and CANNON_DIFF_STEPS to the number of steps to diff from each state (default 1).
+// The steps stop at the first step that reads a preimage, as the preimages are not recorded with the states.
+func TestDiffRecordedStates(t *testing.T) {
+	dir := os.Getenv("CANNON_DIFF_STATES")
+	if dir == "" {
+		t.Skip("CANNON_DIFF_STATES is not set")
+	}
+	steps := 1
+	if s := os.Getenv("CANNON_DIFF_STEPS"); s != "" {
+		var err error
+		steps, err = strconv.Atoi(s)
+		require.NoError(t, err, "invalid CANNON_DIFF_STEPS")
+	}
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		t.Run(f.Name(), func(t *testing.T) {
+			state, err := versions.LoadStateFromFile(filepath.Join(dir, f.Name()))
+			require.NoError(t, err)
+			var v VersionedVMTestCase
+			switch state.Version {
+			case versions.VersionSingleThreaded:
+				v = GetSingleThreadedTestCase(t)
+			case versions.VersionMultiThreaded:
+				v = GetMultiThreadedTestCase(t)
+			default:
+				t.Fatalf("unsupported state version %d", state.Version)
+			}
+			oracle := &skipPreimageOracle{t: t}
+			goVm := state.CreateVM(testutil.CreateLogger(), oracle, io.Discard, io.Discard, nil)
+			for i := 0; i < steps && !goVm.GetState().GetExited(); i++ {
+				testutil.DiffStep(t, goVm, oracle, v.StateHashFn, v.Contracts, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+// DiffStep executes the next step of the FPVM in both the Go VM and the on-chain MIPS contract,
+// and asserts that both produce the same post-state, and thus the same post-state root.
+// If the Go VM panics, the step is invalid, and the EVM step must revert.
+// The oracle must be the oracle of the Go VM, it is used to load precompile preimages into the on-chain oracle.
+func DiffStep(t *testing.T, goVm mipsevm.FPVM, po mipsevm.PreimageOracle, hashFn mipsevm.HashFn, contracts *ContractMetadata, tracer *tracing.Hooks) {
+	state := goVm.GetState()
+	step := state.GetStep()
+
+	var stepWitness *mipsevm.StepWitness
+	var stepErr error
+	panicked := func() (panicked bool) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Logf("Go VM panicked at step %d: %v", step, r)
+				panicked = true
+			}
+		}()
+		stepWitness, stepErr = goVm.Step(true)
+		return false
+	}()
+	if panicked {
+		AssertEVMReverts(t, state, contracts, tracer)
+		return
+	}
+	require.NoError(t, stepErr, "Go VM failed to step")
+
+	evm := NewMIPSEVM(contracts)
+	evm.SetTracer(tracer)
+	evm.SetLocalOracle(po)
+	LogStepFailureAtCleanup(t, evm)
+	evmPost := evm.Step(t, stepWitness, step, hashFn)
+	evmPostHash, err := hashFn(evmPost)
+	require.NoError(t, err, "EVM post-state hash could not be computed")
+
+	goPost, goPostHash := state.EncodeWitness()
+	require.Equal(t, common.Bytes2Hex(goPost), common.Bytes2Hex(evmPost), "mipsevm produced different state than EVM")
+	require.Equal(t, goPostHash, evmPostHash, "mipsevm produced different state root than EVM")
+}
@@ -0,0 +1,53 @@
+package runner
+
+import (
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+// RawHint is a hint written by the VM, passed through to the host as is.
+type RawHint string
+
+func (rh RawHint) Hint() string {
+	return string(rh)
+}
+
+// RawKey is a type-prefixed preimage key requested by the VM.
+type RawKey [32]byte
+
+func (rk RawKey) PreimageKey() [32]byte {
+	return rk
+}
+
+// OracleFuncs implements mipsevm.PreimageOracle with functions, e.g. to serve preimages from memory in tests.
+type OracleFuncs struct {
+	HintFn     func(v []byte)
+	PreimageFn func(k [32]byte) []byte
+}
+
+var _ mipsevm.PreimageOracle = OracleFuncs{}
+
+func (o OracleFuncs) Hint(v []byte) {
+	if o.HintFn != nil {
+		o.HintFn(v)
+	}
+}
+
+func (o OracleFuncs) GetPreimage(k [32]byte) []byte {
+	return o.PreimageFn(k)
+}
+
+// NewPreimageOracle adapts a preimage oracle and hinter, such as the clients of a preimage server
+// or an in-process host, to serve the preimages requested by the VM. The hinter may be nil.
+func NewPreimageOracle(oracle preimage.Oracle, hinter preimage.Hinter) mipsevm.PreimageOracle {
+	return OracleFuncs{
+		HintFn: func(v []byte) {
+			if hinter != nil {
+				hinter.Hint(RawHint(v))
+			}
+		},
+		PreimageFn: func(k [32]byte) []byte {
+			return oracle.Get(RawKey(k))
+		},
+	}
+}
@@ -0,0 +1,120 @@
+// Package runner executes the cannon VM in-process, so that other services can embed and control
+// execution programmatically instead of shelling out to the cannon binary.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+// ctxCheckInterval is the number of steps between checks of the context, since checking it requires a lock.
+const ctxCheckInterval = 100
+
+var (
+	// ErrStop may be returned by a hook to stop execution without an error.
+	ErrStop = errors.New("stop requested")
+	// ErrStepLimit is returned when execution exceeds the step budget before the program exits.
+	ErrStepLimit = errors.New("step limit reached")
+	// ErrInfiniteLoop is returned when the VM is detected to be stuck in an infinite loop.
+	ErrInfiniteLoop = errors.New("detected an infinite loop")
+)
+
+// Hooks are called during execution to observe and control the VM. All hooks are optional.
+// Returning ErrStop from a hook stops execution, any other error aborts it.
+type Hooks struct {
+	// BeforeStep is called before each step is executed.
+	BeforeStep func(vm mipsevm.FPVM) error
+	// ProofAt returns true if a proof should be generated for the step about to be executed.
+	ProofAt func(state mipsevm.FPVMState) bool
+	// AfterStep is called after each step with the number of the executed step.
+	// The witness is nil unless a proof was requested by ProofAt.
+	AfterStep func(vm mipsevm.FPVM, step uint64, witness *mipsevm.StepWitness) error
+	// OnPreimage is called after a step that read from the preimage oracle.
+	OnPreimage func(key [32]byte, value []byte, offset uint32) error
+}
+
+// Config configures an execution.
+type Config struct {
+	Hooks
+
+	// MaxSteps is the maximum number of steps to execute, metering the execution. Zero means no limit.
+	MaxSteps uint64
+}
+
+// Result summarizes an execution.
+type Result struct {
+	// Steps is the number of steps executed.
+	Steps uint64
+	// Stopped is true if execution was stopped by a hook before the program exited.
+	Stopped bool
+	// Exited and ExitCode are the exit status of the program.
+	Exited   bool
+	ExitCode uint8
+}
+
+// Run executes the VM until the program exits, a hook stops it, the step budget is used up or the context is done.
+// The VM state is updated in place and can be serialized after Run returns, including when it returns an error.
+func Run(ctx context.Context, vm mipsevm.FPVM, cfg Config) (*Result, error) {
+	state := vm.GetState()
+	startStep := state.GetStep()
+	result := &Result{}
+	defer func() {
+		result.Steps = state.GetStep() - startStep
+		result.Exited = state.GetExited()
+		result.ExitCode = state.GetExitCode()
+	}()
+
+	for !state.GetExited() {
+		step := state.GetStep()
+		if step%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+		}
+		if cfg.MaxSteps != 0 && step-startStep >= cfg.MaxSteps {
+			return result, fmt.Errorf("%w: executed %d steps", ErrStepLimit, cfg.MaxSteps)
+		}
+		if cfg.BeforeStep != nil {
+			if stop, err := checkHook(cfg.BeforeStep(vm)); stop || err != nil {
+				result.Stopped = stop
+				return result, err
+			}
+		}
+		if vm.CheckInfiniteLoop() {
+			return result, fmt.Errorf("%w at step %d", ErrInfiniteLoop, step)
+		}
+
+		proof := cfg.ProofAt != nil && cfg.ProofAt(state)
+		witness, err := vm.Step(proof)
+		if err != nil {
+			return result, fmt.Errorf("failed at step %d (PC: %08x): %w", step, state.GetPC(), err)
+		}
+		if cfg.AfterStep != nil {
+			if stop, err := checkHook(cfg.AfterStep(vm, step, witness)); stop || err != nil {
+				result.Stopped = stop
+				return result, err
+			}
+		}
+		if cfg.OnPreimage != nil {
+			key, value, offset := vm.LastPreimage()
+			if offset != ^uint32(0) {
+				if stop, err := checkHook(cfg.OnPreimage(key, value, offset)); stop || err != nil {
+					result.Stopped = stop
+					return result, err
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// checkHook returns true if the hook requested a stop, or the error if it failed.
+func checkHook(err error) (bool, error) {
+	if errors.Is(err, ErrStop) {
+		return true, nil
+	}
+	return false, err
+}
@@ -0,0 +1,130 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/singlethreaded"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// exitProgram creates a VM running a program that exits with code 3 after 3 steps.
+func exitProgram(t *testing.T) mipsevm.FPVM {
+	state := singlethreaded.CreateInitialState(0, 0x1000)
+	state.GetMemory().SetMemory(0, 0x24021096) // addiu $v0, $zero, 4246 (exit_group)
+	state.GetMemory().SetMemory(4, 0x24040003) // addiu $a0, $zero, 3
+	state.GetMemory().SetMemory(8, 0x0000000c) // syscall
+	oracle := OracleFuncs{PreimageFn: func(k [32]byte) []byte {
+		t.Fatalf("unexpected preimage request %x", k)
+		return nil
+	}}
+	return state.CreateVM(testlog.Logger(t, log.LevelInfo), oracle, io.Discard, io.Discard, &program.Metadata{})
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Exit", func(t *testing.T) {
+		var steps []uint64
+		result, err := Run(ctx, exitProgram(t), Config{Hooks: Hooks{
+			AfterStep: func(vm mipsevm.FPVM, step uint64, witness *mipsevm.StepWitness) error {
+				require.Nil(t, witness)
+				steps = append(steps, step)
+				return nil
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, &Result{Steps: 3, Exited: true, ExitCode: 3}, result)
+		require.Equal(t, []uint64{0, 1, 2}, steps)
+	})
+
+	t.Run("Proof", func(t *testing.T) {
+		var witnesses int
+		_, err := Run(ctx, exitProgram(t), Config{Hooks: Hooks{
+			ProofAt: func(state mipsevm.FPVMState) bool {
+				return state.GetStep() == 1
+			},
+			AfterStep: func(vm mipsevm.FPVM, step uint64, witness *mipsevm.StepWitness) error {
+				if witness != nil {
+					require.EqualValues(t, 1, step)
+					require.NotEmpty(t, witness.ProofData)
+					witnesses++
+				}
+				return nil
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, 1, witnesses)
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		vm := exitProgram(t)
+		result, err := Run(ctx, vm, Config{Hooks: Hooks{
+			BeforeStep: func(vm mipsevm.FPVM) error {
+				if vm.GetState().GetStep() == 2 {
+					return ErrStop
+				}
+				return nil
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, &Result{Steps: 2, Stopped: true}, result)
+
+		// Execution can be resumed from where it stopped.
+		result, err = Run(ctx, vm, Config{})
+		require.NoError(t, err)
+		require.Equal(t, &Result{Steps: 1, Exited: true, ExitCode: 3}, result)
+	})
+
+	t.Run("HookError", func(t *testing.T) {
+		hookErr := errors.New("boom")
+		_, err := Run(ctx, exitProgram(t), Config{Hooks: Hooks{
+			AfterStep: func(vm mipsevm.FPVM, step uint64, witness *mipsevm.StepWitness) error {
+				return hookErr
+			},
+		}})
+		require.ErrorIs(t, err, hookErr)
+	})
+
+	t.Run("StepLimit", func(t *testing.T) {
+		result, err := Run(ctx, exitProgram(t), Config{MaxSteps: 2})
+		require.ErrorIs(t, err, ErrStepLimit)
+		require.Equal(t, &Result{Steps: 2}, result)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		result, err := Run(ctx, exitProgram(t), Config{})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, &Result{}, result)
+	})
+}
+
+func TestNewPreimageOracle(t *testing.T) {
+	key := preimage.Keccak256Key{0x01}
+	var hints []string
+	oracle := NewPreimageOracle(
+		preimage.OracleFn(func(k preimage.Key) []byte {
+			require.Equal(t, key.PreimageKey(), k.PreimageKey())
+			return []byte{0xaa}
+		}),
+		preimage.HinterFn(func(v preimage.Hint) {
+			hints = append(hints, v.Hint())
+		}),
+	)
+	oracle.Hint([]byte("fetch 0x01"))
+	require.Equal(t, []string{"fetch 0x01"}, hints)
+	require.Equal(t, []byte{0xaa}, oracle.GetPreimage(key.PreimageKey()))
+
+	// A nil hinter drops hints.
+	NewPreimageOracle(preimage.OracleFn(func(k preimage.Key) []byte { return nil }), nil).Hint([]byte("ignored"))
+}
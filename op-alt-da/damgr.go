@@ -328,8 +328,9 @@ func (d *DA) AdvanceL1Origin(ctx context.Context, l1 L1Fetcher, block eth.BlockI
 
 // loadChallengeEvents fetches the l1 block receipts and updates the challenge status
 func (d *DA) loadChallengeEvents(ctx context.Context, l1 L1Fetcher, block eth.BlockID) error {
+	blk := eth.NewLazyBlock(block, l1)
 	// filter any challenge event logs in the block
-	logs, err := d.fetchChallengeLogs(ctx, l1, block)
+	logs, err := d.fetchChallengeLogs(ctx, blk)
 	if err != nil {
 		return err
 	}
@@ -344,7 +345,7 @@ func (d *DA) loadChallengeEvents(ctx context.Context, l1 L1Fetcher, block eth.Bl
 		switch status {
 		case ChallengeResolved:
 			// cached with input resolution call so not expensive
-			_, txs, err := l1.InfoAndTxsByHash(ctx, block.Hash)
+			txs, err := blk.Transactions(ctx)
 			if err != nil {
 				d.log.Error("failed to fetch l1 block", "block", block.Number, "err", err)
 				continue
@@ -394,18 +395,18 @@ func (d *DA) loadChallengeEvents(ctx context.Context, l1 L1Fetcher, block eth.Bl
 }
 
 // fetchChallengeLogs returns logs for challenge events if any for the given block
-func (d *DA) fetchChallengeLogs(ctx context.Context, l1 L1Fetcher, block eth.BlockID) ([]*types.Log, error) {
+func (d *DA) fetchChallengeLogs(ctx context.Context, block *eth.LazyBlock) ([]*types.Log, error) {
 	var logs []*types.Log
 	// Don't look at the challenge contract if there is no challenge contract.
 	if d.cfg.CommitmentType == GenericCommitmentType {
 		return logs, nil
 	}
 	//cached with deposits events call so not expensive
-	_, receipts, err := l1.FetchReceipts(ctx, block.Hash)
+	receipts, err := block.Receipts(ctx)
 	if err != nil {
 		return nil, err
 	}
-	d.log.Info("loading challenges", "epoch", block.Number, "numReceipts", len(receipts))
+	d.log.Info("loading challenges", "epoch", block.ID().Number, "numReceipts", len(receipts))
 	for _, rec := range receipts {
 		// skip error logs
 		if rec.Status != types.ReceiptStatusSuccessful {
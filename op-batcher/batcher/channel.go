@@ -162,20 +162,29 @@ func (s *channel) ID() derive.ChannelID {
 //
 // NextTxData should only be called after HasTxData returned true.
 func (s *channel) NextTxData() txData {
-	nf := s.cfg.MaxFramesPerTx()
-	txdata := txData{frames: make([]frameData, 0, nf), asBlob: s.cfg.UseBlobs}
-	for i := 0; i < nf && s.channelBuilder.HasFrame(); i++ {
-		frame := s.channelBuilder.NextFrame()
-		txdata.frames = append(txdata.frames, frame)
-	}
-
+	txdata := txData{frames: s.nextFrames(s.cfg.MaxFramesPerTx()), asBlob: s.cfg.UseBlobs}
 	id := txdata.ID().String()
 	s.log.Debug("returning next tx data", "id", id, "num_frames", len(txdata.frames), "as_blob", txdata.asBlob)
-	s.pendingTransactions[id] = txdata
-
+	s.addPendingTx(id, txdata)
 	return txdata
 }
 
+// nextFrames reads up to n frames from the channel builder. The frames must be
+// registered with addPendingTx once the tx they are sent in is known.
+func (s *channel) nextFrames(n int) []frameData {
+	frames := make([]frameData, 0, n)
+	for i := 0; i < n && s.channelBuilder.HasFrame(); i++ {
+		frames = append(frames, s.channelBuilder.NextFrame())
+	}
+	return frames
+}
+
+// addPendingTx records the frames of this channel that are sent in the tx with the given id.
+// If the tx packs multiple channels, txdata only holds the frames of this channel.
+func (s *channel) addPendingTx(id string, txdata txData) {
+	s.pendingTransactions[id] = txdata
+}
+
 func (s *channel) HasTxData() bool {
 	if s.IsFull() || !s.cfg.UseBlobs {
 		return s.channelBuilder.HasFrame()
@@ -86,6 +86,10 @@ type ChannelBuilder struct {
 // channel out could not be created.
 // it acts as a factory for either a span or singular channel out
 func NewChannelBuilder(cfg ChannelConfig, rollupCfg rollup.Config, latestL1OriginBlockNum uint64) (*ChannelBuilder, error) {
+	// Zstd channels are compressed with the dictionary of the chain config, if any.
+	if rollupCfg.ZstdConfig != nil {
+		cfg.CompressorConfig.ZstdDictionary = rollupCfg.ZstdConfig.Dictionary
+	}
 	c, err := cfg.CompressorConfig.NewCompressor()
 	if err != nil {
 		return nil, err
@@ -97,7 +101,8 @@ func NewChannelBuilder(cfg ChannelConfig, rollupCfg rollup.Config, latestL1Origi
 		co, err = derive.NewSpanChannelOut(
 			rollupCfg.Genesis.L2Time, rollupCfg.L2ChainID,
 			cfg.CompressorConfig.TargetOutputSize, cfg.CompressorConfig.CompressionAlgo,
-			chainSpec, derive.WithMaxBlocksPerSpanBatch(cfg.MaxBlocksPerSpanBatch))
+			chainSpec, derive.WithMaxBlocksPerSpanBatch(cfg.MaxBlocksPerSpanBatch),
+			derive.WithCompressorOptions(cfg.CompressorConfig.ChannelCompressorOptions()...))
 	} else {
 		co, err = derive.NewSingularChannelOut(c, chainSpec)
 	}
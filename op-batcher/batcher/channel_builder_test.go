@@ -3,6 +3,7 @@ package batcher
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"math/rand"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
+	"github.com/klauspost/compress/zstd"
 
 	"github.com/stretchr/testify/require"
 )
@@ -434,7 +436,7 @@ func TestChannelBuilder_OutputFrames(t *testing.T) {
 func TestChannelBuilder_OutputFrames_SpanBatch(t *testing.T) {
 	for _, algo := range derive.CompressionAlgos {
 		t.Run("ChannelBuilder_OutputFrames_SpanBatch_"+algo.String(), func(t *testing.T) {
-			ChannelBuilder_OutputFrames_SpanBatch(t, algo) // to fill faster for brotli
+			ChannelBuilder_OutputFrames_SpanBatch(t, algo) // to fill faster for brotli and zstd
 		})
 	}
 }
@@ -442,7 +444,7 @@ func TestChannelBuilder_OutputFrames_SpanBatch(t *testing.T) {
 func ChannelBuilder_OutputFrames_SpanBatch(t *testing.T, algo derive.CompressionAlgo) {
 	channelConfig := defaultTestChannelConfig()
 	channelConfig.MaxFrameSize = 20 + derive.FrameV0OverHeadSize
-	if algo.IsBrotli() {
+	if algo.IsBrotli() || algo.IsZstd() {
 		channelConfig.TargetNumFrames = 3
 	} else {
 		channelConfig.TargetNumFrames = 5
@@ -949,3 +951,57 @@ func blockBatchRlpSize(t *testing.T, b *types.Block) int {
 	require.NoError(t, batch.EncodeRLP(&buf), "RLP-encoding batch")
 	return buf.Len()
 }
+
+func TestChannelBuilder_ZstdDictionary(t *testing.T) {
+	rng := rand.New(rand.NewSource(0xd1c7))
+	var contents [][]byte
+	var history []byte
+	for i := 0; i < 20; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, derive.NewBatchData(derive.RandomSingularBatch(rng, 5, big.NewInt(333))).EncodeRLP(&buf))
+		contents = append(contents, buf.Bytes())
+		history = append(history, buf.Bytes()[:100]...)
+	}
+	dict, err := zstd.BuildDict(zstd.BuildDictOptions{ID: 1, Contents: contents, History: history, Offsets: [3]int{1, 4, 8}})
+	require.NoError(t, err)
+
+	rollupCfg := defaultTestRollupConfig
+	rollupCfg.ZstdConfig = &rollup.ZstdConfig{Dictionary: dict}
+
+	for _, batchType := range []uint{derive.SingularBatchType, derive.SpanBatchType} {
+		batchType := batchType
+		t.Run(fmt.Sprintf("BatchType%d", batchType), func(t *testing.T) {
+			channelConfig := defaultTestChannelConfig()
+			channelConfig.BatchType = batchType
+			channelConfig.InitRatioCompressor(1, derive.Zstd19)
+
+			cb, err := NewChannelBuilder(channelConfig, rollupCfg, latestL1BlockOrigin)
+			require.NoError(t, err)
+			require.NoError(t, addMiniBlock(cb))
+			cb.Close()
+			require.NoError(t, cb.OutputFrames())
+
+			origin := eth.L1BlockRef{Number: latestL1BlockOrigin}
+			ch := derive.NewChannel(cb.ID(), origin)
+			for _, fd := range cb.frames {
+				var frame derive.Frame
+				require.NoError(t, frame.UnmarshalBinary(bytes.NewReader(fd.data)))
+				require.NoError(t, ch.AddFrame(frame, origin))
+			}
+			require.True(t, ch.IsReady())
+
+			// The channel can only be read with the dictionary of the chain config
+			br, err := derive.BatchReader(ch.Reader(), 10_000_000, true, derive.WithChainZstd(&rollupCfg, 0))
+			require.NoError(t, err)
+			batchData, err := br()
+			require.NoError(t, err)
+			require.Equal(t, derive.Zstd, batchData.ComprAlgo)
+
+			br, err = derive.BatchReader(ch.Reader(), 10_000_000, true, derive.WithZstd(nil))
+			if err == nil {
+				_, err = br()
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -38,6 +38,12 @@ type ChannelConfig struct {
 	// to each blob tx.
 	TargetNumFrames int
 
+	// TargetNumBlobs is the target number of blobs per blob tx. If it is larger
+	// than the number of frames that are ready in a channel, frames of the
+	// following channels are packed into the same tx.
+	// A value of 0 or 1 disables packing.
+	TargetNumBlobs int
+
 	// CompressorConfig contains the configuration for creating new compressors.
 	// It should not be set directly, but via the Init*Compressor methods after
 	// creating the ChannelConfig to guarantee a consistent configuration.
@@ -99,6 +105,12 @@ func (cc *ChannelConfig) MaxFramesPerTx() int {
 	return cc.TargetNumFrames
 }
 
+// PacksChannels returns true if the frames of multiple channels are packed
+// into a single blob tx.
+func (cc *ChannelConfig) PacksChannels() bool {
+	return cc.UseBlobs && cc.TargetNumBlobs > 1
+}
+
 // Check validates the [ChannelConfig] parameters.
 func (cc *ChannelConfig) Check() error {
 	// The [ChannelTimeout] must be larger than the [SubSafetyMargin].
@@ -122,6 +134,10 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("invalid number of frames %d", nf)
 	}
 
+	if nb := cc.TargetNumBlobs; nb < 0 || nb > 6 {
+		return fmt.Errorf("invalid number of blobs %d", nb)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
@@ -42,11 +43,16 @@ type channelManager struct {
 	currentChannel *channel
 	// channels to read frame data from, for writing batches onchain
 	channelQueue []*channel
-	// used to lookup channels by tx ID upon tx success / failure
-	txChannels map[string]*channel
+	// used to lookup channels by tx ID upon tx success / failure. A blob tx
+	// can hold the frames of multiple channels if they are packed.
+	txChannels map[string][]*channel
 
 	// if set to true, prevents production of any new channel frames
 	closed bool
+
+	// if set to true, channels are closed as soon as all pending blocks are added,
+	// instead of being filled up, to submit the data as soon as possible.
+	shrinkChannels bool
 }
 
 func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider ChannelConfigProvider, rollupCfg *rollup.Config) *channelManager {
@@ -55,7 +61,7 @@ func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider Channe
 		metr:        metr,
 		cfgProvider: cfgProvider,
 		rollupCfg:   rollupCfg,
-		txChannels:  make(map[string]*channel),
+		txChannels:  make(map[string][]*channel),
 	}
 }
 
@@ -71,7 +77,7 @@ func (s *channelManager) Clear(l1OriginLastClosedChannel eth.BlockID) {
 	s.closed = false
 	s.currentChannel = nil
 	s.channelQueue = nil
-	s.txChannels = make(map[string]*channel)
+	s.txChannels = make(map[string][]*channel)
 }
 
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
@@ -80,12 +86,14 @@ func (s *channelManager) TxFailed(_id txID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	id := _id.String()
-	if channel, ok := s.txChannels[id]; ok {
+	if channels, ok := s.txChannels[id]; ok {
 		delete(s.txChannels, id)
-		channel.TxFailed(id)
-		if s.closed && channel.NoneSubmitted() {
-			s.log.Info("Channel has no submitted transactions, clearing for shutdown", "chID", channel.ID())
-			s.removePendingChannel(channel)
+		for _, channel := range channels {
+			channel.TxFailed(id)
+			if s.closed && channel.NoneSubmitted() {
+				s.log.Info("Channel has no submitted transactions, clearing for shutdown", "chID", channel.ID())
+				s.removePendingChannel(channel)
+			}
 		}
 	} else {
 		s.log.Warn("transaction from unknown channel marked as failed", "id", id)
@@ -100,13 +108,17 @@ func (s *channelManager) TxConfirmed(_id txID, inclusionBlock eth.BlockID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	id := _id.String()
-	if channel, ok := s.txChannels[id]; ok {
+	if channels, ok := s.txChannels[id]; ok {
 		delete(s.txChannels, id)
-		done, blocks := channel.TxConfirmed(id, inclusionBlock)
-		s.blocks = append(blocks, s.blocks...)
-		if done {
-			s.removePendingChannel(channel)
+		var timedOutBlocks []*types.Block
+		for _, channel := range channels {
+			done, blocks := channel.TxConfirmed(id, inclusionBlock)
+			timedOutBlocks = append(timedOutBlocks, blocks...)
+			if done {
+				s.removePendingChannel(channel)
+			}
 		}
+		s.blocks = append(timedOutBlocks, s.blocks...)
 	} else {
 		s.log.Warn("transaction from unknown channel marked as confirmed", "id", id)
 	}
@@ -134,16 +146,88 @@ func (s *channelManager) removePendingChannel(channel *channel) {
 }
 
 // nextTxData pops off s.datas & handles updating the internal state
-func (s *channelManager) nextTxData(channel *channel) (txData, error) {
-	if channel == nil || !channel.HasTxData() {
+func (s *channelManager) nextTxData(ch *channel) (txData, error) {
+	if ch == nil || !ch.HasTxData() {
 		s.log.Trace("no next tx data")
 		return txData{}, io.EOF // TODO: not enough data error instead
 	}
-	tx := channel.NextTxData()
-	s.txChannels[tx.ID().String()] = channel
+	if ch.cfg.PacksChannels() {
+		return s.nextPackedTxData(ch), nil
+	}
+	tx := ch.NextTxData()
+	s.txChannels[tx.ID().String()] = []*channel{ch}
 	return tx, nil
 }
 
+// nextPackedTxData returns a blob tx with the frames of the given channel, which is
+// topped up with the frames of the following channels in the queue, up to the target
+// number of blobs. Channels are packed in queue order, so packing stops at the first
+// channel without tx data.
+func (s *channelManager) nextPackedTxData(first *channel) txData {
+	var (
+		tx       = txData{asBlob: true}
+		channels []*channel
+		frames   [][]frameData
+	)
+	for _, ch := range s.packableChannels(first) {
+		n := first.cfg.TargetNumBlobs - len(tx.frames)
+		if n <= 0 {
+			break
+		}
+		chFrames := ch.nextFrames(n)
+		tx.frames = append(tx.frames, chFrames...)
+		channels = append(channels, ch)
+		frames = append(frames, chFrames)
+	}
+
+	id := tx.ID().String()
+	for i, ch := range channels {
+		ch.addPendingTx(id, txData{frames: frames[i], asBlob: true})
+	}
+	s.txChannels[id] = channels
+	s.log.Debug("returning next packed tx data", "id", id, "num_frames", len(tx.frames), "num_channels", len(channels))
+	return tx
+}
+
+// packableChannels returns the channels, starting at the given channel, whose frames
+// can be packed into the same blob tx.
+func (s *channelManager) packableChannels(first *channel) []*channel {
+	var channels []*channel
+	for _, ch := range s.channelQueue {
+		if len(channels) == 0 && ch != first {
+			continue
+		}
+		if !ch.cfg.UseBlobs || !ch.HasTxData() {
+			break
+		}
+		channels = append(channels, ch)
+	}
+	return channels
+}
+
+// canPackMore returns true if the channel packs blob txs, and not enough frames are
+// ready yet to fill a blob tx with the target number of blobs.
+func (s *channelManager) canPackMore(first *channel) bool {
+	if !first.cfg.PacksChannels() {
+		return false
+	}
+	var frames int
+	for _, ch := range s.packableChannels(first) {
+		frames += ch.PendingFrames()
+	}
+	return frames < first.cfg.TargetNumBlobs
+}
+
+// firstWithTxData returns the first channel in the queue that has tx data, or nil.
+func (s *channelManager) firstWithTxData() *channel {
+	for _, ch := range s.channelQueue {
+		if ch.HasTxData() {
+			return ch
+		}
+	}
+	return nil
+}
+
 // TxData returns the next tx data that should be submitted to L1.
 //
 // If the pending channel is
@@ -152,19 +236,15 @@ func (s *channelManager) nextTxData(channel *channel) (txData, error) {
 func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	var firstWithTxData *channel
-	for _, ch := range s.channelQueue {
-		if ch.HasTxData() {
-			firstWithTxData = ch
-			break
-		}
-	}
+	firstWithTxData := s.firstWithTxData()
 
-	dataPending := firstWithTxData != nil && firstWithTxData.HasTxData()
+	dataPending := firstWithTxData != nil
 	s.log.Debug("Requested tx data", "l1Head", l1Head, "txdata_pending", dataPending, "blocks_pending", len(s.blocks))
 
 	// Short circuit if there is pending tx data or the channel manager is closed.
-	if dataPending || s.closed {
+	// Pending tx data of packing channels is only returned right away if it fills
+	// a blob tx, so that it can be topped up with the frames of new channels first.
+	if (dataPending && !s.canPackMore(firstWithTxData)) || s.closed {
 		return s.nextTxData(firstWithTxData)
 	}
 
@@ -172,15 +252,35 @@ func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 
 	// If we have no saved blocks, we will not be able to create valid frames
 	if len(s.blocks) == 0 {
+		if dataPending {
+			return s.nextTxData(firstWithTxData)
+		}
 		return txData{}, io.EOF
 	}
 
-	if err := s.ensureChannelWithSpace(l1Head); err != nil {
+	if err := s.addBlocksToChannel(l1Head); err != nil {
 		return txData{}, err
 	}
+	// If blob txs are packed, continue with new channels until there are enough
+	// frames to fill a blob tx, or all blocks are added.
+	for len(s.blocks) > 0 && s.currentChannel.IsFull() && s.canPackMore(s.firstWithTxData()) {
+		if err := s.addBlocksToChannel(l1Head); err != nil {
+			return txData{}, err
+		}
+	}
+
+	return s.nextTxData(s.firstWithTxData())
+}
+
+// addBlocksToChannel adds pending blocks to the current channel, or a new channel
+// if the current one is full, and outputs its frames.
+func (s *channelManager) addBlocksToChannel(l1Head eth.BlockID) error {
+	if err := s.ensureChannelWithSpace(l1Head); err != nil {
+		return err
+	}
 
 	if err := s.processBlocks(); err != nil {
-		return txData{}, err
+		return err
 	}
 
 	// Register current L1 head only after all pending blocks have been
@@ -188,11 +288,12 @@ func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	// all pending blocks be included in this channel for submission.
 	s.registerL1Block(l1Head)
 
-	if err := s.outputFrames(); err != nil {
-		return txData{}, err
+	if s.shrinkChannels && !s.currentChannel.IsFull() {
+		s.log.Info("Closing channel early to reduce safe lag", "id", s.currentChannel.ID(), "input_bytes", s.currentChannel.InputBytes())
+		s.currentChannel.Close()
 	}
 
-	return s.nextTxData(s.currentChannel)
+	return s.outputFrames()
 }
 
 // ensureChannelWithSpace ensures currentChannel is populated with a channel that has
@@ -310,6 +411,7 @@ func (s *channelManager) outputFrames() error {
 		outBytes,
 		s.currentChannel.FullErr(),
 	)
+	s.metr.RecordChannelCompressionRatio(s.currentChannel.cfg.CompressorConfig.CompressionAlgo, inBytes, outBytes)
 
 	var comprRatio float64
 	if inBytes > 0 {
@@ -350,6 +452,47 @@ func (s *channelManager) AddL2Block(block *types.Block) error {
 	return nil
 }
 
+// SetShrinkChannels sets whether channels are closed as soon as all pending blocks were added to them,
+// trading compression ratio for a lower latency of the data submission.
+func (s *channelManager) SetShrinkChannels(shrink bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shrinkChannels = shrink
+}
+
+// PendingDABytes returns the estimated DA size of the blocks that are not yet added to a channel.
+func (s *channelManager) PendingDABytes() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var size uint64
+	for _, block := range s.blocks {
+		for _, tx := range block.Transactions() {
+			// Deposit transactions are not included in batches.
+			if tx.IsDepositTx() {
+				continue
+			}
+			size += tx.RollupCostData().FastLzSize
+		}
+	}
+	return size
+}
+
+// PendingBlocks returns the blocks of which the data is not confirmed on L1 yet, ordered by number.
+// These are the blocks of the channels that are not fully submitted, and the blocks not added to a channel.
+func (s *channelManager) PendingBlocks() []*types.Block {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var blocks []*types.Block
+	for _, ch := range s.channelQueue {
+		blocks = append(blocks, ch.channelBuilder.Blocks()...)
+	}
+	blocks = append(blocks, s.blocks...)
+	slices.SortStableFunc(blocks, func(a, b *types.Block) int {
+		return a.Number().Cmp(b.Number())
+	})
+	return blocks
+}
+
 func l2BlockRefFromBlockAndL1Info(block *types.Block, l1info *derive.L1BlockInfo) eth.L2BlockRef {
 	return eth.L2BlockRef{
 		Hash:           block.Hash(),
@@ -380,15 +523,19 @@ func (s *channelManager) Close() error {
 	s.closed = true
 	s.log.Info("Channel manager is closing")
 
-	// Any pending state can be proactively cleared if there are no submitted transactions
+	// Any pending state can be proactively cleared if there are no submitted transactions.
+	// The blocks of dropped channels are kept as pending blocks, so they can be persisted on shutdown.
+	var dropped []*types.Block
 	for _, ch := range s.channelQueue {
 		if ch.NoneSubmitted() {
 			s.log.Info("Channel has no past or pending submission - dropping", "id", ch.ID())
+			dropped = append(dropped, ch.channelBuilder.Blocks()...)
 			s.removePendingChannel(ch)
 		} else {
 			s.log.Info("Channel is in-flight and will need to be submitted after close", "id", ch.ID(), "confirmed", len(ch.confirmedTransactions), "pending", len(ch.pendingTransactions))
 		}
 	}
+	s.blocks = append(dropped, s.blocks...)
 	s.log.Info("Reviewed all pending channels on close", "remaining", len(s.channelQueue))
 
 	if s.currentChannel == nil {
@@ -483,3 +483,137 @@ func TestChannelManager_ChannelCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestChannelManager_PendingDABytes(t *testing.T) {
+	log := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(10000, derive.SingularBatchType)
+	cfg.CompressorConfig.TargetOutputSize = 1 // full on first block
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg, &defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+	require.Zero(t, m.PendingDABytes())
+
+	a := newMiniL2Block(3)
+	require.NoError(t, m.AddL2Block(a))
+	var expected uint64
+	for _, tx := range a.Transactions()[1:] {
+		expected += tx.RollupCostData().FastLzSize
+	}
+	require.NotZero(t, expected)
+	require.Equal(t, expected, m.PendingDABytes(), "deposit must not be counted")
+
+	// Once the block is added to a channel, it is no longer pending.
+	_, err := m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.Zero(t, m.PendingDABytes())
+}
+
+func TestChannelManager_ShrinkChannels(t *testing.T) {
+	log := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(100_000, derive.SingularBatchType)
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg, &defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	// A small block doesn't fill the channel.
+	a := newMiniL2Block(1)
+	require.NoError(t, m.AddL2Block(a))
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+	require.False(t, m.currentChannel.IsFull())
+
+	// When shrinking, the channel is closed with the pending blocks and submitted right away.
+	m.SetShrinkChannels(true)
+	require.NoError(t, m.AddL2Block(newMiniL2BlockWithNumberParent(2, big.NewInt(1), a.Hash())))
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.NotZero(t, txdata.Len())
+	require.ErrorIs(t, m.currentChannel.FullErr(), ErrTerminated)
+	require.Empty(t, m.blocks)
+}
+
+func TestChannelManager_PackChannels(t *testing.T) {
+	log := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(100_000, derive.SingularBatchType)
+	cfg.InitNoneCompressor()
+	cfg.CompressorConfig.TargetOutputSize = 1 // a channel per block
+	cfg.ChannelTimeout = 100
+	cfg.UseBlobs = true
+	cfg.TargetNumBlobs = 3
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg, &defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	parent := common.Hash{}
+	for i := int64(0); i < 5; i++ {
+		block := newMiniL2BlockWithNumberParent(0, big.NewInt(i), parent)
+		require.NoError(t, m.AddL2Block(block))
+		parent = block.Hash()
+	}
+
+	// The first tx is filled with the frames of the first three channels.
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.True(t, txdata.asBlob)
+	require.Len(t, txdata.frames, 3)
+	channels := m.txChannels[txdata.ID().String()]
+	require.Len(t, channels, 3)
+	for i, ch := range channels {
+		require.Equal(t, ch.ID(), txdata.frames[i].id.chID)
+		require.Len(t, ch.pendingTransactions[txdata.ID().String()].frames, 1)
+	}
+
+	// A failed tx re-queues the frames in their channels, which are packed again.
+	m.TxFailed(txdata.ID())
+	require.Empty(t, m.txChannels)
+	txdata, err = m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.Len(t, txdata.frames, 3)
+	m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+	require.Empty(t, m.channelQueue, "fully submitted channels must be removed")
+	require.Len(t, m.blocks, 2, "only enough channels to fill the tx must be created")
+
+	// The remaining blocks are packed when there are no more blocks to fill the tx.
+	txdata, err = m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.Len(t, txdata.frames, 2)
+	m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+	require.Empty(t, m.channelQueue)
+
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+}
+
+// TestChannelManager_PendingBlocks asserts that the blocks of channels that are dropped on close
+// remain pending, so they can be persisted on shutdown.
+func TestChannelManager_PendingBlocks(t *testing.T) {
+	require := require.New(t)
+	rng := rand.New(rand.NewSource(2015))
+	cfg := channelManagerTestConfig(100, derive.SingularBatchType)
+	cfg.TargetNumFrames = 1000
+	cfg.InitNoneCompressor()
+	m := NewChannelManager(testlog.Logger(t, log.LevelCrit), metrics.NoopMetrics, cfg, &defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	a := derivetest.RandomL2BlockWithChainId(rng, 1000, defaultTestRollupConfig.L2ChainID)
+	require.NoError(m.AddL2Block(a))
+	require.Equal([]*types.Block{a}, m.PendingBlocks())
+
+	var txdatas []txData
+	for {
+		txdata, err := m.TxData(eth.BlockID{})
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		txdatas = append(txdatas, txdata)
+	}
+	require.NotEmpty(txdatas)
+	require.Empty(m.blocks)
+	require.Equal([]*types.Block{a}, m.PendingBlocks(), "blocks of pending channels are pending")
+
+	for _, txdata := range txdatas {
+		m.TxFailed(txdata.ID())
+	}
+	require.NoError(m.Close())
+	require.Empty(m.channelQueue)
+	require.Equal([]*types.Block{a}, m.blocks, "blocks of dropped channels are kept")
+	require.Equal([]*types.Block{a}, m.PendingBlocks())
+}
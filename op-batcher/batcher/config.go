@@ -29,6 +29,17 @@ type CLIConfig struct {
 	// RollupRpc is the HTTP provider URL for the L2 rollup node. A comma-separated list enables the active L2 provider. Such a list needs to match the number of L2EthRpcs provided.
 	RollupRpc string
 
+	// SequencerRollupRpc is the HTTP provider URL for the rollup node of the sequencer, set when L2EthRpc and
+	// RollupRpc serve a follower node. The batcher then loads blocks from the follower, up to the unsafe
+	// heads of the sequencer, after checking the follower serves the same chain.
+	SequencerRollupRpc string
+
+	// AdditionalL2EthRpcs and AdditionalRollupRpcs are the HTTP provider URLs of the L2 execution engines and
+	// rollup nodes of additional chains to submit batches for, matched by index. Each chain has its own channel
+	// pipeline, and shares the transaction manager and key with the other chains.
+	AdditionalL2EthRpcs  []string
+	AdditionalRollupRpcs []string
+
 	// MaxChannelDuration is the maximum duration (in #L1-blocks) to keep a
 	// channel open. This allows to more eagerly send batcher transactions
 	// during times of low L2 transaction volume. Note that the effective
@@ -52,6 +63,10 @@ type CLIConfig struct {
 	// transactions sent to the transaction manager (0 == no limit).
 	MaxPendingTransactions uint64
 
+	// MaxPendingTransactionsTotal is the maximum number of concurrent pending
+	// transactions across all chains (0 == no limit).
+	MaxPendingTransactionsTotal uint64
+
 	// MaxL1TxSize is the maximum size of a batch tx submitted to L1.
 	// If using blobs, this setting is ignored and the max blob size is used.
 	MaxL1TxSize uint64
@@ -63,6 +78,11 @@ type CLIConfig struct {
 	// per blob tx, if using Blob DA.
 	TargetNumFrames int
 
+	// TargetNumBlobs is the target number of blobs per blob tx. If larger than the
+	// number of frames per channel, the frames of multiple channels are packed into
+	// a blob tx. 0 disables packing.
+	TargetNumBlobs int
+
 	// ApproxComprRatio to assume (only [compressor.RatioCompressor]).
 	// Should be slightly smaller than average from experiments to avoid the
 	// chances of creating a small additional leftover frame.
@@ -85,6 +105,20 @@ type CLIConfig struct {
 	// If 0, the batcher will just use the current head.
 	CheckRecentTxsDepth int
 
+	// ResumeScanDepth is the number of recent L1 blocks that are scanned for batcher transactions at startup,
+	// to resume batch submission after the L2 blocks of which the data is already included on L1,
+	// but not derived by the rollup node yet. 0 disables it, and batch submission resumes at the L2 safe head.
+	// Blob txs are read from the L1Beacon, so it requires the L1Beacon unless the data availability type is calldata.
+	ResumeScanDepth uint64
+
+	// L1Beacon is the HTTP provider URL for the L1 beacon node, to read the blobs of the scanned batcher txs.
+	// Blob txs are not scanned if empty.
+	L1Beacon string
+
+	// StateFile is the path of the file the L2 blocks pending submission are written to on shutdown,
+	// to load them from it at the next start. Disabled if empty.
+	StateFile string
+
 	BatchType uint
 
 	// DataAvailabilityType is one of the values defined in op-batcher/flags/types.go and dictates
@@ -95,6 +129,20 @@ type CLIConfig struct {
 	// ActiveSequencerCheckDuration is the duration between checks to determine the active sequencer endpoint.
 	ActiveSequencerCheckDuration time.Duration
 
+	// ThrottleThreshold is the estimated DA size in bytes of pending L2 blocks above which the sequencer is
+	// throttled, by limiting the DA size of the transactions and blocks it builds. 0 disables throttling.
+	ThrottleThreshold uint64
+	// ThrottleTxSize is the maximum DA size of a transaction while throttling.
+	ThrottleTxSize uint64
+	// ThrottleBlockSize is the maximum DA size of a block while throttling.
+	ThrottleBlockSize uint64
+
+	// MaxSafeLag is the SLO of the time between the L2 unsafe head and the L2 safe head. 0 disables it.
+	MaxSafeLag time.Duration
+	// SafeLagEscalationRatio is the fraction of MaxSafeLag the projected safe lag has to reach for the
+	// batcher to escalate data submission, by closing channels early and bumping fees faster.
+	SafeLagEscalationRatio float64
+
 	// TestUseMaxTxSizeForBlobs allows to set the blob size with MaxL1TxSize.
 	// Should only be used for testing purposes.
 	TestUseMaxTxSizeForBlobs bool
@@ -120,6 +168,17 @@ func (c *CLIConfig) Check() error {
 	if strings.Count(c.RollupRpc, ",") != strings.Count(c.L2EthRpc, ",") {
 		return errors.New("number of rollup and eth URLs must match")
 	}
+	if c.SequencerRollupRpc != "" {
+		if strings.Contains(c.RollupRpc, ",") {
+			return errors.New("follower mode does not support multiple rollup and eth URLs")
+		}
+		if c.ThrottleThreshold > 0 {
+			return errors.New("follower mode does not support throttling, which requires the sequencer execution engine")
+		}
+	}
+	if len(c.AdditionalRollupRpcs) != len(c.AdditionalL2EthRpcs) {
+		return errors.New("number of additional rollup and eth URLs must match")
+	}
 	if c.PollInterval == 0 {
 		return errors.New("must set PollInterval")
 	}
@@ -141,9 +200,30 @@ func (c *CLIConfig) Check() error {
 	if c.CheckRecentTxsDepth > 128 {
 		return fmt.Errorf("CheckRecentTxsDepth cannot be set higher than 128: %v", c.CheckRecentTxsDepth)
 	}
+	if c.ResumeScanDepth > maxResumeScanDepth {
+		return fmt.Errorf("ResumeScanDepth cannot be set higher than %d: %v", maxResumeScanDepth, c.ResumeScanDepth)
+	}
+	if c.ResumeScanDepth > 0 && c.DataAvailabilityType != flags.CalldataType && c.L1Beacon == "" {
+		return fmt.Errorf("ResumeScanDepth requires an L1 beacon endpoint to scan blob txs, with the %q data availability type", c.DataAvailabilityType)
+	}
 	if c.DataAvailabilityType == flags.BlobsType && c.TargetNumFrames > 6 {
 		return errors.New("too many frames for blob transactions, max 6")
 	}
+	if c.TargetNumBlobs < 0 || c.TargetNumBlobs > 6 {
+		return fmt.Errorf("TargetNumBlobs must be between 0 and 6: %v", c.TargetNumBlobs)
+	}
+	if c.TargetNumBlobs > 0 && c.DataAvailabilityType == flags.CalldataType {
+		return errors.New("TargetNumBlobs requires blobs or auto data availability type")
+	}
+	if c.ThrottleThreshold > 0 && (c.ThrottleTxSize == 0 || c.ThrottleBlockSize == 0) {
+		return errors.New("throttle tx and block size must be set when throttling is enabled")
+	}
+	if c.ThrottleTxSize > c.ThrottleBlockSize {
+		return fmt.Errorf("throttle tx size %d must not exceed throttle block size %d", c.ThrottleTxSize, c.ThrottleBlockSize)
+	}
+	if c.MaxSafeLag > 0 && (c.SafeLagEscalationRatio <= 0 || c.SafeLagEscalationRatio > 1) {
+		return fmt.Errorf("safe lag escalation ratio must be in (0, 1], got %v", c.SafeLagEscalationRatio)
+	}
 	if !flags.ValidDataAvailabilityType(c.DataAvailabilityType) {
 		return fmt.Errorf("unknown data availability type: %q", c.DataAvailabilityType)
 	}
@@ -173,20 +253,33 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		PollInterval:    ctx.Duration(flags.PollIntervalFlag.Name),
 
 		/* Optional Flags */
+		SequencerRollupRpc:           ctx.String(flags.SequencerRollupRpcFlag.Name),
+		AdditionalL2EthRpcs:          ctx.StringSlice(flags.AdditionalL2EthRpcsFlag.Name),
+		AdditionalRollupRpcs:         ctx.StringSlice(flags.AdditionalRollupRpcsFlag.Name),
 		MaxPendingTransactions:       ctx.Uint64(flags.MaxPendingTransactionsFlag.Name),
+		MaxPendingTransactionsTotal:  ctx.Uint64(flags.MaxPendingTransactionsTotalFlag.Name),
 		MaxChannelDuration:           ctx.Uint64(flags.MaxChannelDurationFlag.Name),
 		MaxL1TxSize:                  ctx.Uint64(flags.MaxL1TxSizeBytesFlag.Name),
 		MaxBlocksPerSpanBatch:        ctx.Int(flags.MaxBlocksPerSpanBatch.Name),
 		TargetNumFrames:              ctx.Int(flags.TargetNumFramesFlag.Name),
+		TargetNumBlobs:               ctx.Int(flags.TargetNumBlobsFlag.Name),
 		ApproxComprRatio:             ctx.Float64(flags.ApproxComprRatioFlag.Name),
 		Compressor:                   ctx.String(flags.CompressorFlag.Name),
 		CompressionAlgo:              derive.CompressionAlgo(ctx.String(flags.CompressionAlgoFlag.Name)),
 		Stopped:                      ctx.Bool(flags.StoppedFlag.Name),
 		WaitNodeSync:                 ctx.Bool(flags.WaitNodeSyncFlag.Name),
 		CheckRecentTxsDepth:          ctx.Int(flags.CheckRecentTxsDepthFlag.Name),
+		ResumeScanDepth:              ctx.Uint64(flags.ResumeScanDepthFlag.Name),
+		L1Beacon:                     ctx.String(flags.L1BeaconFlag.Name),
+		StateFile:                    ctx.String(flags.StateFileFlag.Name),
 		BatchType:                    ctx.Uint(flags.BatchTypeFlag.Name),
 		DataAvailabilityType:         flags.DataAvailabilityType(ctx.String(flags.DataAvailabilityTypeFlag.Name)),
 		ActiveSequencerCheckDuration: ctx.Duration(flags.ActiveSequencerCheckDurationFlag.Name),
+		ThrottleThreshold:            ctx.Uint64(flags.ThrottleThresholdFlag.Name),
+		ThrottleTxSize:               ctx.Uint64(flags.ThrottleTxSizeFlag.Name),
+		ThrottleBlockSize:            ctx.Uint64(flags.ThrottleBlockSizeFlag.Name),
+		MaxSafeLag:                   ctx.Duration(flags.MaxSafeLagFlag.Name),
+		SafeLagEscalationRatio:       ctx.Float64(flags.SafeLagEscalationRatioFlag.Name),
 		TxMgrConfig:                  txmgr.ReadCLIConfig(ctx),
 		LogConfig:                    oplog.ReadCLIConfig(ctx),
 		MetricsConfig:                opmetrics.ReadCLIConfig(ctx),
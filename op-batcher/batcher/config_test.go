@@ -105,6 +105,22 @@ func TestBatcherConfig(t *testing.T) {
 			},
 			errString: "too many frames for blob transactions, max 6",
 		},
+		{
+			name: "larger 6 TargetNumBlobs",
+			override: func(c *batcher.CLIConfig) {
+				c.TargetNumBlobs = 7
+				c.DataAvailabilityType = flags.BlobsType
+			},
+			errString: "TargetNumBlobs must be between 0 and 6: 7",
+		},
+		{
+			name: "TargetNumBlobs for calldata",
+			override: func(c *batcher.CLIConfig) {
+				c.TargetNumBlobs = 3
+				c.DataAvailabilityType = flags.CalldataType
+			},
+			errString: "TargetNumBlobs requires blobs or auto data availability type",
+		},
 		{
 			name: "invalid compr ratio for ratio compressor",
 			override: func(c *batcher.CLIConfig) {
@@ -113,6 +129,56 @@ func TestBatcherConfig(t *testing.T) {
 			},
 			errString: "invalid ApproxComprRatio 4.2 for ratio compressor",
 		},
+		{
+			name: "resume scan depth too large",
+			override: func(c *batcher.CLIConfig) {
+				c.ResumeScanDepth = 1025
+			},
+			errString: "ResumeScanDepth cannot be set higher than 1024: 1025",
+		},
+		{
+			name: "resume scan with blobs without beacon",
+			override: func(c *batcher.CLIConfig) {
+				c.ResumeScanDepth = 100
+				c.DataAvailabilityType = flags.AutoType
+			},
+			errString: "ResumeScanDepth requires an L1 beacon endpoint to scan blob txs, with the \"auto\" data availability type",
+		},
+		{
+			name: "invalid safe lag escalation ratio",
+			override: func(c *batcher.CLIConfig) {
+				c.MaxSafeLag = time.Hour
+				c.SafeLagEscalationRatio = 1.5
+			},
+			errString: "safe lag escalation ratio must be in (0, 1], got 1.5",
+		},
+		{
+			name: "follower mode with multiple endpoints",
+			override: func(c *batcher.CLIConfig) {
+				c.SequencerRollupRpc = "http://sequencer:8545"
+				c.L2EthRpc = "http://a:8545,http://b:8545"
+				c.RollupRpc = "http://a:9545,http://b:9545"
+			},
+			errString: "follower mode does not support multiple rollup and eth URLs",
+		},
+		{
+			name: "follower mode with throttling",
+			override: func(c *batcher.CLIConfig) {
+				c.SequencerRollupRpc = "http://sequencer:8545"
+				c.ThrottleThreshold = 1000
+				c.ThrottleTxSize = 100
+				c.ThrottleBlockSize = 1000
+			},
+			errString: "follower mode does not support throttling",
+		},
+		{
+			name: "mismatched additional chain endpoints",
+			override: func(c *batcher.CLIConfig) {
+				c.AdditionalL2EthRpcs = []string{"http://a:8545", "http://b:8545"}
+				c.AdditionalRollupRpcs = []string{"http://a:9545"}
+			},
+			errString: "number of additional rollup and eth URLs must match",
+		},
 	}
 
 	for _, test := range tests {
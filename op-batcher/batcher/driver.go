@@ -8,6 +8,7 @@ import (
 	"math/big"
 	_ "net/http/pprof"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
@@ -18,16 +19,31 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 	"golang.org/x/sync/errgroup"
 )
 
+// SetMaxDASizeMethod is the engine RPC method used to limit the DA size of the transactions
+// and blocks built by the sequencer, to throttle it while the batcher has a backlog.
+const SetMaxDASizeMethod = "miner_setMaxDASize"
+
+// methodNotFoundCode is the JSON-RPC error code of calls to unknown methods.
+const methodNotFoundCode = -32601
+
+// maxSequencerHints is the maximum number of sequencer unsafe heads remembered in follower mode.
+const maxSequencerHints = 64
+
 var (
 	ErrBatcherNotRunning = errors.New("batcher is not running")
-	emptyTxData          = txData{
+	// ErrFollowerInconsistent is returned when the follower node that L2 blocks are loaded from
+	// does not serve the canonical unsafe chain of the sequencer.
+	ErrFollowerInconsistent = errors.New("follower node is inconsistent with the sequencer")
+	emptyTxData             = txData{
 		frames: []frameData{
 			{
 				data: []byte{},
@@ -40,10 +56,13 @@ type txRef struct {
 	id       txID
 	isCancel bool
 	isBlob   bool
+	// inbox is the batch inbox address the tx is sent to
+	inbox common.Address
 }
 
 type L1Client interface {
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
 	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 }
 
@@ -55,17 +74,28 @@ type RollupClient interface {
 	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
 }
 
+type L1BeaconClient interface {
+	GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error)
+}
+
 // DriverSetup is the collection of input/output interfaces and configuration that the driver operates on.
 type DriverSetup struct {
-	Log              log.Logger
-	Metr             metrics.Metricer
-	RollupConfig     *rollup.Config
-	Config           BatcherConfig
-	Txmgr            *txmgr.SimpleTxManager
+	Log          log.Logger
+	Metr         metrics.Metricer
+	RollupConfig *rollup.Config
+	Config       BatcherConfig
+	Txmgr        *txmgr.SimpleTxManager
+	// TxBudget limits the txs pending across all drivers sharing Txmgr. Unlimited if nil.
+	TxBudget         TxBudget
 	L1Client         L1Client
 	EndpointProvider dial.L2EndpointProvider
 	ChannelConfig    ChannelConfigProvider
 	AltDA            *altda.DAClient
+	// SequencerRollupClient is set when the EndpointProvider serves a follower (non-sequencer) node.
+	// The unsafe heads of the sequencer are then used to decide which blocks of the follower to batch.
+	SequencerRollupClient RollupClient
+	// L1Beacon reads the blobs of the batcher txs that are scanned at startup. Blob txs are not scanned if nil.
+	L1Beacon L1BeaconClient
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -90,6 +120,26 @@ type BatchSubmitter struct {
 	// lastStoredBlock is the last block loaded into `state`. If it is empty it should be set to the l2 safe head.
 	lastStoredBlock eth.BlockID
 	lastL1Tip       eth.L1BlockRef
+	// nextL1Num is the number of the L1 block after the last L1 tip, in which batcher txs are expected to be included.
+	// It selects the batch inbox address, also of the txs that are sent by the Alt DA goroutines.
+	nextL1Num atomic.Uint64
+	// resumeScan is true if recent batcher txs on L1 should be scanned when initializing the last stored block.
+	// It is only set at startup: after a reorg the channel manager still holds the data that is pending inclusion.
+	resumeScan bool
+	// restoreState is true if the blocks pending submission at the last shutdown should be loaded from the
+	// state file when initializing the last stored block. It is only set at startup.
+	restoreState bool
+
+	// sequencerHints are the unsafe heads of the sequencer seen at previous polls, in ascending order.
+	// Only used in follower mode.
+	sequencerHints []eth.BlockID
+
+	// lastSafeLag is the safe lag at the previous poll, to project the safe lag.
+	lastSafeLag time.Duration
+	// safeLagEscalated is true while data submission is escalated to meet the max safe lag.
+	safeLagEscalated bool
+	// bumpFeeRetryTime is the fee bump retry time of the tx manager to restore after de-escalating.
+	bumpFeeRetryTime time.Duration
 
 	state *channelManager
 }
@@ -117,6 +167,8 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 	l.killCtx, l.cancelKillCtx = context.WithCancel(context.Background())
 	l.clearState(l.shutdownCtx)
 	l.lastStoredBlock = eth.BlockID{}
+	l.resumeScan = l.Config.ResumeScanDepth > 0
+	l.restoreState = l.Config.StateFile != ""
 
 	if l.Config.WaitNodeSync {
 		err := l.waitNodeSync()
@@ -127,6 +179,12 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 
 	l.wg.Add(1)
 	go l.loop()
+	if l.Config.ThrottleThreshold > 0 {
+		l.wg.Add(1)
+		go l.throttlingLoop()
+	} else {
+		l.Log.Info("Sequencer throttling is disabled")
+	}
 
 	l.Log.Info("Batch Submitter started")
 	return nil
@@ -164,6 +222,10 @@ func (l *BatchSubmitter) StopBatchSubmitting(ctx context.Context) error {
 	l.cancelShutdownCtx()
 	l.wg.Wait()
 	l.cancelKillCtx()
+	if l.safeLagEscalated {
+		l.setSafeLagEscalated(false)
+	}
+	l.lastSafeLag = 0
 
 	l.Log.Info("Batch Submitter stopped")
 	return nil
@@ -186,10 +248,30 @@ func (l *BatchSubmitter) loadBlocksIntoState(ctx context.Context) error {
 		return errors.New("start number is >= end number")
 	}
 
+	// In follower mode, the end of the range is an unsafe head of the sequencer.
+	// Check the follower serves the same block before loading any of the blocks.
+	var endBlock *types.Block
+	if l.SequencerRollupClient != nil {
+		endBlock, err = l.fetchL2Block(ctx, end.Number)
+		if err != nil {
+			l.Log.Warn("Failed to fetch L2 block from follower", "err", err)
+			return err
+		}
+		if endBlock.Hash() != end.Hash {
+			l.Log.Warn("Follower node is not on the sequencer chain", "number", end.Number, "follower", endBlock.Hash(), "sequencer", end.Hash)
+			return fmt.Errorf("%w: block %d is %s, sequencer has %s", ErrFollowerInconsistent, end.Number, endBlock.Hash(), end.Hash)
+		}
+	}
+
 	var latestBlock *types.Block
 	// Add all blocks to "state"
 	for i := start.Number + 1; i < end.Number+1; i++ {
-		block, err := l.loadBlockIntoState(ctx, i)
+		var block *types.Block
+		if endBlock != nil && i == end.Number {
+			block, err = endBlock, l.addL2BlockToState(endBlock)
+		} else {
+			block, err = l.loadBlockIntoState(ctx, i)
+		}
 		if errors.Is(err, ErrReorg) {
 			l.Log.Warn("Found L2 reorg", "block_number", i)
 			l.lastStoredBlock = eth.BlockID{}
@@ -214,6 +296,17 @@ func (l *BatchSubmitter) loadBlocksIntoState(ctx context.Context) error {
 
 // loadBlockIntoState fetches & stores a single block into `state`. It returns the block it loaded.
 func (l *BatchSubmitter) loadBlockIntoState(ctx context.Context, blockNumber uint64) (*types.Block, error) {
+	block, err := l.fetchL2Block(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.addL2BlockToState(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (l *BatchSubmitter) fetchL2Block(ctx context.Context, blockNumber uint64) (*types.Block, error) {
 	l2Client, err := l.EndpointProvider.EthClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("getting L2 client: %w", err)
@@ -226,13 +319,16 @@ func (l *BatchSubmitter) loadBlockIntoState(ctx context.Context, blockNumber uin
 	if err != nil {
 		return nil, fmt.Errorf("getting L2 block: %w", err)
 	}
+	return block, nil
+}
 
+func (l *BatchSubmitter) addL2BlockToState(block *types.Block) error {
 	if err := l.state.AddL2Block(block); err != nil {
-		return nil, fmt.Errorf("adding L2 block to state: %w", err)
+		return fmt.Errorf("adding L2 block to state: %w", err)
 	}
 
 	l.Log.Info("Added L2 block to local state", "block", eth.ToBlockID(block), "tx_count", len(block.Transactions()), "time", block.Time())
-	return block, nil
+	return nil
 }
 
 // calculateL2BlockRangeToStore determines the range (start,end] that should be loaded into the local state.
@@ -254,12 +350,26 @@ func (l *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 	if syncStatus.HeadL1 == (eth.L1BlockRef{}) {
 		return eth.BlockID{}, eth.BlockID{}, errors.New("empty sync status")
 	}
+	l.checkSafeLag(syncStatus)
 
 	// Check last stored to see if it needs to be set on startup OR set if is lagged behind.
 	// It lagging implies that the op-node processed some batches that were submitted prior to the current instance of the batcher being alive.
 	if l.lastStoredBlock == (eth.BlockID{}) {
 		l.Log.Info("Starting batch-submitter work at safe-head", "safe", syncStatus.SafeL2)
 		l.lastStoredBlock = syncStatus.SafeL2.ID()
+		if l.resumeScan {
+			l.resumeScan = false
+			if resume, err := l.resumeBlock(ctx, syncStatus, l.Txmgr.From()); err != nil {
+				l.Log.Warn("Failed to scan L1 for included batch data, starting at safe-head", "err", err)
+			} else if resume != l.lastStoredBlock {
+				l.Log.Info("Resuming batch-submitter work after data included on L1", "safe", syncStatus.SafeL2, "resume", resume)
+				l.lastStoredBlock = resume
+			}
+		}
+		if l.restoreState {
+			l.restoreState = false
+			l.loadStateFile(ctx, syncStatus.UnsafeL2)
+		}
 	} else if l.lastStoredBlock.Number < syncStatus.SafeL2.Number {
 		l.Log.Warn("Last submitted block lagged behind L2 safe head: batch submission will continue from the safe head now", "last", l.lastStoredBlock, "safe", syncStatus.SafeL2)
 		l.lastStoredBlock = syncStatus.SafeL2.ID()
@@ -270,9 +380,56 @@ func (l *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 		return eth.BlockID{}, eth.BlockID{}, errors.New("L2 safe head ahead of L2 unsafe head")
 	}
 
+	if l.SequencerRollupClient != nil {
+		end, err := l.sequencerHintedHead(ctx, syncStatus.UnsafeL2)
+		if err != nil {
+			return eth.BlockID{}, eth.BlockID{}, err
+		}
+		return l.lastStoredBlock, end, nil
+	}
+
 	return l.lastStoredBlock, syncStatus.UnsafeL2.ID(), nil
 }
 
+// sequencerHintedHead records the current unsafe head of the sequencer, and returns the highest
+// unsafe head of the sequencer that the follower has caught up with. Blocks are only loaded up to it,
+// so the follower blocks can be checked against the sequencer chain.
+// It returns the last stored block if the follower has not caught up with any of the sequencer heads yet.
+func (l *BatchSubmitter) sequencerHintedHead(ctx context.Context, followerUnsafe eth.L2BlockRef) (eth.BlockID, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+
+	seqStatus, err := l.SequencerRollupClient.SyncStatus(cCtx)
+	if err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to get sequencer sync status: %w", err)
+	}
+	l.addSequencerHint(seqStatus.UnsafeL2.ID())
+
+	for i := len(l.sequencerHints) - 1; i >= 0; i-- {
+		if hint := l.sequencerHints[i]; hint.Number <= followerUnsafe.Number {
+			return hint, nil
+		}
+	}
+	l.Log.Debug("Follower node is behind the sequencer", "follower", followerUnsafe, "sequencer", seqStatus.UnsafeL2)
+	return l.lastStoredBlock, nil
+}
+
+// addSequencerHint adds an unsafe head of the sequencer to the hints, and drops the hints
+// that were already loaded, replaced by a reorg of the sequencer, or are the oldest beyond the maximum.
+func (l *BatchSubmitter) addSequencerHint(head eth.BlockID) {
+	hints := l.sequencerHints[:0]
+	for _, hint := range l.sequencerHints {
+		if hint.Number > l.lastStoredBlock.Number && hint.Number < head.Number {
+			hints = append(hints, hint)
+		}
+	}
+	hints = append(hints, head)
+	if len(hints) > maxSequencerHints {
+		hints = hints[len(hints)-maxSequencerHints:]
+	}
+	l.sequencerHints = hints
+}
+
 // The following things occur:
 // New L2 block (reorg or not)
 // L1 transaction is confirmed
@@ -302,7 +459,11 @@ func (l *BatchSubmitter) loop() {
 	defer l.wg.Done()
 
 	receiptsCh := make(chan txmgr.TxReceipt[txRef])
-	queue := txmgr.NewQueue[txRef](l.killCtx, l.Txmgr, l.Config.MaxPendingTransactions)
+	var txMgr txmgr.TxManager = l.Txmgr
+	if l.TxBudget != nil {
+		txMgr = &budgetTxManager{TxManager: l.Txmgr, budget: l.TxBudget}
+	}
+	queue := txmgr.NewQueue[txRef](l.killCtx, txMgr, l.Config.MaxPendingTransactions)
 	daGroup := &errgroup.Group{}
 	// errgroup with limit of 0 means no goroutine is able to run concurrently,
 	// so we only set the limit if it is greater than 0.
@@ -388,6 +549,7 @@ func (l *BatchSubmitter) loop() {
 			}
 			l.publishStateToL1(queue, receiptsCh, daGroup)
 		case <-l.shutdownCtx.Done():
+			defer l.writeStateFile()
 			if l.Txmgr.IsClosed() {
 				l.Log.Info("Txmgr is closed, remaining channel data won't be sent")
 				return
@@ -409,6 +571,145 @@ func (l *BatchSubmitter) loop() {
 	}
 }
 
+// throttlingLoop monitors the estimated DA size of the L2 blocks that are pending submission.
+// While it exceeds the throttle threshold, the sequencer's execution engine is instructed to limit
+// the DA size of the transactions and blocks it builds, so the batcher can work off the backlog.
+// The limits are lifted when the backlog is cleared, and when the batcher is stopped.
+func (l *BatchSubmitter) throttlingLoop() {
+	defer l.wg.Done()
+	l.Log.Info("Starting sequencer throttling loop", "threshold", l.Config.ThrottleThreshold)
+	ticker := time.NewTicker(l.Config.PollInterval)
+	defer ticker.Stop()
+
+	// The engine limits are unknown at startup, so the first update is always sent.
+	var current *throttleParams
+	for {
+		select {
+		case <-ticker.C:
+			pending := l.state.PendingDABytes()
+			params := throttleParams{}
+			if pending > l.Config.ThrottleThreshold {
+				params = throttleParams{maxTxSize: l.Config.ThrottleTxSize, maxBlockSize: l.Config.ThrottleBlockSize}
+			}
+			if current != nil && *current == params {
+				continue
+			}
+			if err := l.setMaxDASize(l.shutdownCtx, params); errors.Is(err, errThrottlingUnsupported) {
+				l.Log.Error("Sequencer engine does not support throttling, disabling throttling", "method", SetMaxDASizeMethod)
+				return
+			} else if err != nil {
+				l.Log.Warn("Failed to update sequencer throttling", "err", err)
+				continue
+			}
+			if params.maxBlockSize > 0 {
+				l.Log.Warn("Throttling sequencer, pending data exceeds threshold", "pending_bytes", pending,
+					"threshold", l.Config.ThrottleThreshold, "max_tx_size", params.maxTxSize, "max_block_size", params.maxBlockSize)
+			} else if current != nil {
+				l.Log.Info("Stopped throttling sequencer", "pending_bytes", pending)
+			}
+			current = &params
+		case <-l.shutdownCtx.Done():
+			if current != nil && *current != (throttleParams{}) {
+				// Don't leave the sequencer throttled while the batcher is not running.
+				ctx, cancel := context.WithTimeout(l.killCtx, l.Config.NetworkTimeout)
+				if err := l.setMaxDASize(ctx, throttleParams{}); err != nil {
+					l.Log.Error("Failed to stop throttling sequencer on shutdown", "err", err)
+				}
+				cancel()
+			}
+			l.Log.Info("Sequencer throttling loop done")
+			return
+		}
+	}
+}
+
+var errThrottlingUnsupported = errors.New("engine does not support throttling")
+
+// throttleParams are the DA size limits of the sequencer. Zero values disable the limits.
+type throttleParams struct {
+	maxTxSize    uint64
+	maxBlockSize uint64
+}
+
+func (l *BatchSubmitter) setMaxDASize(ctx context.Context, params throttleParams) error {
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+	client, err := l.EndpointProvider.EthClient(cCtx)
+	if err != nil {
+		return fmt.Errorf("getting L2 client: %w", err)
+	}
+	var success bool
+	err = client.Client().CallContext(cCtx, &success, SetMaxDASizeMethod, hexutil.Uint64(params.maxTxSize), hexutil.Uint64(params.maxBlockSize))
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundCode {
+		return errThrottlingUnsupported
+	} else if err != nil {
+		return fmt.Errorf("calling %s: %w", SetMaxDASizeMethod, err)
+	} else if !success {
+		return fmt.Errorf("%s was not applied", SetMaxDASizeMethod)
+	}
+	l.Metr.RecordThrottleParams(params.maxTxSize, params.maxBlockSize)
+	return nil
+}
+
+// checkSafeLag records the safe lag of the sync status, and enforces the max safe lag SLO:
+// while the projected safe lag exceeds the escalation threshold, channels are closed as soon as
+// the pending blocks are added to them, and the fees of batcher txs are bumped twice as often.
+func (l *BatchSubmitter) checkSafeLag(status *eth.SyncStatus) {
+	if l.Config.MaxSafeLag == 0 {
+		return
+	}
+	lag := safeLag(status)
+	projected := projectSafeLag(lag, l.lastSafeLag)
+	l.lastSafeLag = lag
+
+	escalate := projected >= time.Duration(float64(l.Config.MaxSafeLag)*l.Config.SafeLagEscalationRatio)
+	if escalate && !l.safeLagEscalated {
+		l.Log.Warn("Projected safe lag approaches max safe lag, escalating data submission",
+			"lag", lag, "projected", projected, "max_safe_lag", l.Config.MaxSafeLag)
+		l.setSafeLagEscalated(true)
+	} else if !escalate && l.safeLagEscalated {
+		l.Log.Info("Projected safe lag recovered, de-escalating data submission", "lag", lag, "projected", projected)
+		l.setSafeLagEscalated(false)
+	}
+	if lag > l.Config.MaxSafeLag {
+		l.Log.Error("Safe lag exceeds max safe lag", "lag", lag, "max_safe_lag", l.Config.MaxSafeLag,
+			"unsafe", status.UnsafeL2, "safe", status.SafeL2)
+	}
+	l.Metr.RecordSafeLag(lag, projected, l.safeLagEscalated)
+}
+
+// setSafeLagEscalated escalates or de-escalates the data submission.
+// The fee bump retry time of the tx manager is restored to its value at the time of escalation.
+func (l *BatchSubmitter) setSafeLagEscalated(escalated bool) {
+	l.safeLagEscalated = escalated
+	l.state.SetShrinkChannels(escalated)
+	if escalated {
+		l.bumpFeeRetryTime = l.Txmgr.GetBumpFeeRetryTime()
+		l.Txmgr.SetBumpFeeRetryTime(l.bumpFeeRetryTime / 2)
+	} else {
+		l.Txmgr.SetBumpFeeRetryTime(l.bumpFeeRetryTime)
+	}
+}
+
+// safeLag returns the time between the L2 unsafe head and the L2 safe head of the sync status.
+func safeLag(status *eth.SyncStatus) time.Duration {
+	if status.UnsafeL2.Time <= status.SafeL2.Time {
+		return 0
+	}
+	return time.Duration(status.UnsafeL2.Time-status.SafeL2.Time) * time.Second
+}
+
+// projectSafeLag projects the safe lag to the next poll, assuming it grows as much as since the previous poll.
+// A shrinking safe lag is not projected, so escalation is only lifted once the lag itself is below the threshold.
+// A zero last lag is not projected either, to not escalate on the first poll after starting.
+func projectSafeLag(lag, lastLag time.Duration) time.Duration {
+	if lastLag == 0 || lag <= lastLag {
+		return lag
+	}
+	return lag + (lag - lastLag)
+}
+
 // waitNodeSync Check to see if there was a batcher tx sent recently that
 // still needs more block confirmations before being considered finalized
 func (l *BatchSubmitter) waitNodeSync() error {
@@ -654,7 +955,7 @@ func (l *BatchSubmitter) sendTx(txdata txData, isCancel bool, candidate *txmgr.T
 		candidate.GasLimit = intrinsicGas
 	}
 
-	queue.Send(txRef{id: txdata.ID(), isCancel: isCancel, isBlob: txdata.asBlob}, *candidate, receiptsCh)
+	queue.Send(txRef{id: txdata.ID(), isCancel: isCancel, isBlob: txdata.asBlob, inbox: *candidate.To}, *candidate, receiptsCh)
 }
 
 func (l *BatchSubmitter) blobTxCandidate(data txData) (*txmgr.TxCandidate, error) {
@@ -667,24 +968,37 @@ func (l *BatchSubmitter) blobTxCandidate(data txData) (*txmgr.TxCandidate, error
 	l.Log.Info("Building Blob transaction candidate",
 		"size", size, "last_size", lastSize, "num_blobs", len(blobs))
 	l.Metr.RecordBlobUsedBytes(lastSize)
+	inbox := l.batchInbox()
 	return &txmgr.TxCandidate{
-		To:    &l.RollupConfig.BatchInboxAddress,
+		To:    &inbox,
 		Blobs: blobs,
 	}, nil
 }
 
 func (l *BatchSubmitter) calldataTxCandidate(data []byte) *txmgr.TxCandidate {
 	l.Log.Info("Building Calldata transaction candidate", "size", len(data))
+	inbox := l.batchInbox()
 	return &txmgr.TxCandidate{
-		To:     &l.RollupConfig.BatchInboxAddress,
+		To:     &inbox,
 		TxData: data,
 	}
 }
 
+// batchInbox returns the batch inbox address of the L1 block after the last L1 tip, which batcher txs are sent to.
+// A tx that is included after a later batch inbox update is ignored by the derivation,
+// so its receipt is checked against the batch inbox of the inclusion block, and its data resubmitted if they differ.
+func (l *BatchSubmitter) batchInbox() common.Address {
+	return l.RollupConfig.BatchInboxAddressAt(l.nextL1Num.Load())
+}
+
 func (l *BatchSubmitter) handleReceipt(r txmgr.TxReceipt[txRef]) {
 	// Record TX Status
 	if r.Err != nil {
 		l.recordFailedTx(r.ID.id, r.Err)
+	} else if inbox := l.RollupConfig.BatchInboxAddressAt(r.Receipt.BlockNumber.Uint64()); !r.ID.isCancel && r.ID.inbox != inbox {
+		l.Log.Warn("Transaction included after a batch inbox update, resubmitting its data",
+			append(logFields(r.ID.id, r.Receipt), "tx_inbox", r.ID.inbox, "inbox", inbox)...)
+		l.state.TxFailed(r.ID.id)
 	} else {
 		l.recordConfirmedTx(r.ID.id, r.Receipt)
 	}
@@ -695,6 +1009,7 @@ func (l *BatchSubmitter) recordL1Tip(l1tip eth.L1BlockRef) {
 		return
 	}
 	l.lastL1Tip = l1tip
+	l.nextL1Num.Store(l1tip.Number + 1)
 	l.Metr.RecordLatestL1Block(l1tip)
 }
 
@@ -725,7 +1040,7 @@ func (l *BatchSubmitter) l1Tip(ctx context.Context) (eth.L1BlockRef, error) {
 	if err != nil {
 		return eth.L1BlockRef{}, fmt.Errorf("getting latest L1 block: %w", err)
 	}
-	return eth.InfoToL1BlockRef(eth.HeaderBlockInfo(head)), nil
+	return eth.HeaderToL1BlockRef(head), nil
 }
 
 func (l *BatchSubmitter) checkTxpool(queue *txmgr.Queue[txRef], receiptsCh chan txmgr.TxReceipt[txRef]) bool {
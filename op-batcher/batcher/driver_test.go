@@ -3,14 +3,24 @@ package batcher
 import (
 	"context"
 	"errors"
+	"io"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -117,3 +127,194 @@ func TestBatchSubmitter_SafeL1Origin_FailsToResolveRollupClient(t *testing.T) {
 	_, err := bs.safeL1Origin(context.Background())
 	require.Error(t, err)
 }
+
+type mockMinerAPI struct {
+	maxTxSize    hexutil.Uint64
+	maxBlockSize hexutil.Uint64
+}
+
+func (m *mockMinerAPI) SetMaxDASize(maxTxSize hexutil.Uint64, maxBlockSize hexutil.Uint64) bool {
+	m.maxTxSize = maxTxSize
+	m.maxBlockSize = maxBlockSize
+	return true
+}
+
+func TestBatchSubmitter_SetMaxDASize(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+
+	miner := &mockMinerAPI{}
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("miner", miner))
+	t.Cleanup(srv.Stop)
+	ep.ethClient.ExpectClient(rpc.DialInProc(srv))
+
+	require.NoError(t, bs.setMaxDASize(context.Background(), throttleParams{maxTxSize: 300, maxBlockSize: 21_000}))
+	require.EqualValues(t, 300, miner.maxTxSize)
+	require.EqualValues(t, 21_000, miner.maxBlockSize)
+
+	require.NoError(t, bs.setMaxDASize(context.Background(), throttleParams{}))
+	require.Zero(t, miner.maxTxSize)
+	require.Zero(t, miner.maxBlockSize)
+}
+
+func TestBatchSubmitter_SetMaxDASize_Unsupported(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+
+	srv := rpc.NewServer()
+	t.Cleanup(srv.Stop)
+	ep.ethClient.ExpectClient(rpc.DialInProc(srv))
+
+	err := bs.setMaxDASize(context.Background(), throttleParams{maxTxSize: 300, maxBlockSize: 21_000})
+	require.ErrorIs(t, err, errThrottlingUnsupported)
+}
+
+func TestSafeLag(t *testing.T) {
+	status := &eth.SyncStatus{
+		UnsafeL2: eth.L2BlockRef{Time: 1000},
+		SafeL2:   eth.L2BlockRef{Time: 940},
+	}
+	require.Equal(t, time.Minute, safeLag(status))
+
+	status.SafeL2.Time = 1000
+	require.Zero(t, safeLag(status))
+}
+
+func TestProjectSafeLag(t *testing.T) {
+	tests := []struct {
+		name      string
+		lag       time.Duration
+		lastLag   time.Duration
+		projected time.Duration
+	}{
+		{name: "Growing", lag: 30 * time.Second, lastLag: 20 * time.Second, projected: 40 * time.Second},
+		{name: "Stable", lag: 30 * time.Second, lastLag: 30 * time.Second, projected: 30 * time.Second},
+		{name: "Shrinking", lag: 30 * time.Second, lastLag: 50 * time.Second, projected: 30 * time.Second},
+		{name: "FirstPoll", lag: 30 * time.Second, lastLag: 0, projected: 30 * time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.projected, projectSafeLag(test.lag, test.lastLag))
+		})
+	}
+}
+
+func TestBatchSubmitter_FollowerMode(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+	seq := new(testutils.MockRollupClient)
+	bs.SequencerRollupClient = seq
+
+	l1Head := eth.L1BlockRef{Number: 100}
+	safe := eth.L2BlockRef{Hash: common.Hash{0x05}, Number: 5}
+	followerStatus := func(unsafe uint64) *eth.SyncStatus {
+		return &eth.SyncStatus{
+			HeadL1:   l1Head,
+			SafeL2:   safe,
+			UnsafeL2: eth.L2BlockRef{Hash: common.Hash{byte(unsafe)}, Number: unsafe},
+		}
+	}
+	sequencerStatus := func(unsafe uint64) *eth.SyncStatus {
+		return &eth.SyncStatus{UnsafeL2: eth.L2BlockRef{Hash: common.Hash{byte(unsafe)}, Number: unsafe}}
+	}
+
+	// The follower is behind the sequencer: no blocks can be checked against the sequencer chain yet.
+	ep.rollupClient.ExpectSyncStatus(followerStatus(10), nil)
+	seq.ExpectSyncStatus(sequencerStatus(12), nil)
+	start, end, err := bs.calculateL2BlockRangeToStore(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, safe.ID(), start)
+	require.Equal(t, safe.ID(), end)
+
+	// The follower caught up with the sequencer head of the previous poll.
+	ep.rollupClient.ExpectSyncStatus(followerStatus(13), nil)
+	seq.ExpectSyncStatus(sequencerStatus(15), nil)
+	_, end, err = bs.calculateL2BlockRangeToStore(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, eth.BlockID{Hash: common.Hash{12}, Number: 12}, end)
+	require.Equal(t, []eth.BlockID{{Hash: common.Hash{12}, Number: 12}, {Hash: common.Hash{15}, Number: 15}}, bs.sequencerHints)
+
+	// A reorg of the sequencer drops the hints it replaced.
+	ep.rollupClient.ExpectSyncStatus(followerStatus(13), nil)
+	seq.ExpectSyncStatus(sequencerStatus(14), nil)
+	_, _, err = bs.calculateL2BlockRangeToStore(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []eth.BlockID{{Hash: common.Hash{12}, Number: 12}, {Hash: common.Hash{14}, Number: 14}}, bs.sequencerHints)
+
+	ep.rollupClient.AssertExpectations(t)
+	seq.AssertExpectations(t)
+}
+
+func TestBatchSubmitter_FollowerInconsistent(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+	seq := new(testutils.MockRollupClient)
+	bs.SequencerRollupClient = seq
+
+	safe := eth.L2BlockRef{Hash: common.Hash{0x05}, Number: 5}
+	ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{
+		HeadL1:   eth.L1BlockRef{Number: 100},
+		SafeL2:   safe,
+		UnsafeL2: eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 8},
+	}, nil)
+	seq.ExpectSyncStatus(&eth.SyncStatus{UnsafeL2: eth.L2BlockRef{Hash: common.Hash{0xbb}, Number: 8}}, nil)
+	followerBlock := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(8)})
+	ep.ethClient.ExpectBlockByNumber(big.NewInt(8), followerBlock, nil)
+
+	err := bs.loadBlocksIntoState(context.Background())
+	require.ErrorIs(t, err, ErrFollowerInconsistent)
+	require.Empty(t, bs.state.blocks, "no blocks must be loaded from an inconsistent follower")
+	require.Equal(t, safe.ID(), bs.lastStoredBlock)
+	ep.ethClient.AssertExpectations(t)
+}
+
+func TestBatchSubmitter_BatchInboxSchedule(t *testing.T) {
+	bs, _ := setup(t)
+	initial := bs.RollupConfig.BatchInboxAddress
+	updated := common.Address{0xee}
+	bs.RollupConfig.BatchInboxSchedule = []rollup.BatchInboxUpdate{{L1Block: 101, Address: updated}}
+
+	bs.recordL1Tip(eth.L1BlockRef{Hash: common.Hash{0x01}, Number: 99})
+	require.Equal(t, initial, *bs.calldataTxCandidate(nil).To)
+
+	// Txs are sent to the batch inbox of the next L1 block
+	bs.recordL1Tip(eth.L1BlockRef{Hash: common.Hash{0x02}, Number: 100})
+	require.Equal(t, updated, *bs.calldataTxCandidate(nil).To)
+	candidate, err := bs.blobTxCandidate(emptyTxData)
+	require.NoError(t, err)
+	require.Equal(t, updated, *candidate.To)
+}
+
+func TestBatchSubmitter_ReceiptAfterBatchInboxUpdate(t *testing.T) {
+	bs, _ := setup(t)
+	initial := bs.RollupConfig.BatchInboxAddress
+	updated := common.Address{0xee}
+	bs.RollupConfig.BatchInboxSchedule = []rollup.BatchInboxUpdate{{L1Block: 101, Address: updated}}
+	cfg := channelManagerTestConfig(10_000, derive.SingularBatchType)
+	cfg.CompressorConfig.TargetOutputSize = 1 // full on first block
+	cfg.ChannelTimeout = 1000
+	bs.state = NewChannelManager(bs.Log, metrics.NoopMetrics, cfg, bs.RollupConfig)
+	bs.state.Clear(eth.BlockID{})
+	require.NoError(t, bs.state.AddL2Block(newMiniL2Block(0)))
+
+	sendTx := func() txData {
+		txdata, err := bs.state.TxData(eth.BlockID{})
+		require.NoError(t, err)
+		return txdata
+	}
+	receipt := func(l1Num int64) *types.Receipt {
+		return &types.Receipt{BlockHash: common.Hash{0x01}, BlockNumber: big.NewInt(l1Num)}
+	}
+
+	// Sent to the initial inbox, but included after the update: the data is resubmitted
+	txdata := sendTx()
+	bs.handleReceipt(txmgr.TxReceipt[txRef]{ID: txRef{id: txdata.ID(), inbox: initial}, Receipt: receipt(101)})
+	resubmitted := sendTx()
+	require.Equal(t, txdata.ID(), resubmitted.ID())
+
+	// Included at the inbox of the inclusion block: confirmed
+	bs.handleReceipt(txmgr.TxReceipt[txRef]{ID: txRef{id: resubmitted.ID(), inbox: updated}, Receipt: receipt(101)})
+	_, err := bs.state.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+}
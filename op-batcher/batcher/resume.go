@@ -0,0 +1,246 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxResumeScanDepth is the maximum number of L1 blocks that are scanned for batcher transactions at startup.
+const maxResumeScanDepth = 1024
+
+// includedRange is the range of L2 blocks of which the batches are included on L1 in a complete channel.
+type includedRange struct {
+	first uint64
+	last  uint64
+	// checkParent returns true if the hash is the parent hash of the first block, as committed to by the batches.
+	checkParent func(hash common.Hash) bool
+}
+
+// resumeBlock returns the L2 block after which batch submission can resume. It is the last block of the
+// complete channels in the recent L1 blocks that the batcher address submitted, if they cover the L2 chain
+// contiguously from the safe head. The blobs of blob transactions are fetched from the L1 beacon node,
+// and blob transactions are skipped if there is none. Channels that cannot be read or that don't match
+// the L2 chain end the scan, so the safe head is returned when in doubt, and data is submitted again rather than skipped.
+func (l *BatchSubmitter) resumeBlock(ctx context.Context, syncStatus *eth.SyncStatus, batcherAddr common.Address) (eth.BlockID, error) {
+	safe := syncStatus.SafeL2.ID()
+	from := uint64(0)
+	if syncStatus.HeadL1.Number >= l.Config.ResumeScanDepth {
+		from = syncStatus.HeadL1.Number - l.Config.ResumeScanDepth + 1
+	}
+
+	var l1Blocks []*types.Block
+	for n := from; n <= syncStatus.HeadL1.Number; n++ {
+		cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+		block, err := l.L1Client.BlockByNumber(cCtx, new(big.Int).SetUint64(n))
+		cancel()
+		if err != nil {
+			return eth.BlockID{}, fmt.Errorf("failed to fetch L1 block %d: %w", n, err)
+		}
+		l1Blocks = append(l1Blocks, block)
+	}
+	ranges, skippedBlobTxs, err := includedRanges(ctx, l.RollupConfig, l.L1Beacon, l1Blocks, batcherAddr)
+	if err != nil {
+		return eth.BlockID{}, err
+	}
+	if skippedBlobTxs > 0 {
+		l.Log.Info("Skipped batcher blob txs while scanning for included data", "count", skippedBlobTxs)
+	}
+
+	l2Client, err := l.EndpointProvider.EthClient(ctx)
+	if err != nil {
+		return eth.BlockID{}, fmt.Errorf("getting L2 client: %w", err)
+	}
+	blockByNumber := func(n uint64) (*types.Block, error) {
+		cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+		defer cancel()
+		return l2Client.BlockByNumber(cCtx, new(big.Int).SetUint64(n))
+	}
+
+	resume := safe
+	for _, r := range ranges {
+		if r.last <= resume.Number {
+			continue
+		}
+		if r.first > resume.Number+1 {
+			l.Log.Info("Included batch data is not contiguous with the resume block", "resume", resume, "first", r.first)
+			break
+		}
+		if r.last > syncStatus.UnsafeL2.Number {
+			l.Log.Warn("Included batch data is ahead of the L2 unsafe head", "last", r.last, "unsafe", syncStatus.UnsafeL2)
+			break
+		}
+		parent, err := blockByNumber(r.first - 1)
+		if err != nil {
+			return eth.BlockID{}, fmt.Errorf("failed to fetch L2 block %d: %w", r.first-1, err)
+		}
+		if !r.checkParent(parent.Hash()) {
+			l.Log.Warn("Included batch data does not match the L2 chain", "first", r.first, "parent", eth.ToBlockID(parent))
+			break
+		}
+		last, err := blockByNumber(r.last)
+		if err != nil {
+			return eth.BlockID{}, fmt.Errorf("failed to fetch L2 block %d: %w", r.last, err)
+		}
+		resume = eth.ToBlockID(last)
+	}
+	return resume, nil
+}
+
+// includedRanges reads the channels that are complete in the batcher transactions of the L1 blocks,
+// and returns the L2 block ranges of their batches, in the order the channels were completed.
+// The blobs of blob transactions are fetched from the L1 beacon node. If it is nil, blob transactions
+// are not read, and their number is returned.
+func includedRanges(ctx context.Context, cfg *rollup.Config, beacon L1BeaconClient, l1Blocks []*types.Block, batcherAddr common.Address) ([]includedRange, int, error) {
+	spec := rollup.NewChainSpec(cfg)
+	signer := types.LatestSignerForChainID(cfg.L1ChainID)
+	channels := make(map[derive.ChannelID]*derive.Channel)
+	var ranges []includedRange
+	var skippedBlobTxs int
+	for _, block := range l1Blocks {
+		ref := eth.BlockToL1BlockRef(block)
+		datas, skipped, err := batcherData(ctx, cfg, beacon, signer, block, ref, batcherAddr)
+		if err != nil {
+			return nil, 0, err
+		}
+		skippedBlobTxs += skipped
+		for _, data := range datas {
+			frames, err := derive.ParseFrames(data)
+			if err != nil {
+				continue
+			}
+			for _, frame := range frames {
+				ch, ok := channels[frame.ID]
+				if ok && ch.OpenBlockNumber()+spec.ChannelTimeout(ref.Time) < ref.Number {
+					ok = false // timed out, and dropped by the derivation pipeline
+				}
+				if !ok {
+					ch = derive.NewChannel(frame.ID, ref)
+					channels[frame.ID] = ch
+				}
+				if err := ch.AddFrame(frame, ref); err != nil {
+					continue
+				}
+				if ch.IsReady() {
+					delete(channels, frame.ID)
+					if r, err := channelRange(cfg, spec, ch, ref); err == nil {
+						ranges = append(ranges, r)
+					}
+				}
+			}
+		}
+	}
+	return ranges, skippedBlobTxs, nil
+}
+
+// batcherData returns the calldata and blobs of the batcher transactions of the L1 block, in transaction order.
+// Blobs that cannot be decoded are returned as empty data. If the beacon is nil, blob transactions are skipped,
+// and their number is returned.
+func batcherData(ctx context.Context, cfg *rollup.Config, beacon L1BeaconClient, signer types.Signer, block *types.Block, ref eth.L1BlockRef, batcherAddr common.Address) ([]eth.Data, int, error) {
+	inbox := cfg.BatchInboxAddressAt(ref.Number)
+	var datas []eth.Data
+	var hashes []eth.IndexedBlobHash
+	var blobDatas []int // index in datas of each blob hash
+	var skippedBlobTxs int
+	blobIndex := uint64(0) // index of each blob in the blob sidecars of the block
+	for _, tx := range block.Transactions() {
+		numBlobs := uint64(len(tx.BlobHashes()))
+		if to := tx.To(); to == nil || *to != inbox {
+			blobIndex += numBlobs
+			continue
+		}
+		if sender, err := types.Sender(signer, tx); err != nil || sender != batcherAddr {
+			blobIndex += numBlobs
+			continue
+		}
+		if tx.Type() != types.BlobTxType {
+			datas = append(datas, tx.Data())
+			continue
+		}
+		if beacon == nil {
+			skippedBlobTxs++
+			blobIndex += numBlobs
+			continue
+		}
+		for _, h := range tx.BlobHashes() {
+			hashes = append(hashes, eth.IndexedBlobHash{Index: blobIndex, Hash: h})
+			blobDatas = append(blobDatas, len(datas))
+			datas = append(datas, nil)
+			blobIndex++
+		}
+	}
+	if len(hashes) == 0 {
+		return datas, skippedBlobTxs, nil
+	}
+	blobs, err := beacon.GetBlobs(ctx, ref, hashes)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch blobs of L1 block %s: %w", ref, err)
+	}
+	for i, blob := range blobs {
+		if data, err := blob.ToData(); err == nil {
+			datas[blobDatas[i]] = data
+		}
+	}
+	return datas, skippedBlobTxs, nil
+}
+
+// channelRange decodes the batches of the channel, and returns the range of L2 blocks they cover.
+func channelRange(cfg *rollup.Config, spec *rollup.ChainSpec, ch *derive.Channel, ref eth.L1BlockRef) (includedRange, error) {
+	nextBatch, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(ref.Time), cfg.IsFjord(ref.Time), derive.WithChainZstd(cfg, ref.Time))
+	if err != nil {
+		return includedRange{}, err
+	}
+	var r includedRange
+	for {
+		batchData, err := nextBatch()
+		if err != nil {
+			break // io.EOF, or the channel data is invalid after this batch
+		}
+		var first, last uint64
+		var checkParent func(hash common.Hash) bool
+		switch batchData.GetBatchType() {
+		case derive.SingularBatchType:
+			batch, err := derive.GetSingularBatch(batchData)
+			if err != nil {
+				return includedRange{}, err
+			}
+			if first, err = cfg.TargetBlockNumber(batch.Timestamp); err != nil {
+				return includedRange{}, err
+			}
+			last = first
+			checkParent = func(hash common.Hash) bool { return hash == batch.ParentHash }
+		case derive.SpanBatchType:
+			batch, err := derive.DeriveSpanBatch(batchData, cfg.BlockTime, cfg.Genesis.L2Time, cfg.L2ChainID)
+			if err != nil {
+				return includedRange{}, err
+			}
+			if first, err = cfg.TargetBlockNumber(batch.GetTimestamp()); err != nil {
+				return includedRange{}, err
+			}
+			if last, err = cfg.TargetBlockNumber(batch.GetBlockTimestamp(batch.GetBlockCount() - 1)); err != nil {
+				return includedRange{}, err
+			}
+			checkParent = batch.CheckParentHash
+		default:
+			return includedRange{}, fmt.Errorf("unknown batch type %d", batchData.GetBatchType())
+		}
+		if r.checkParent == nil {
+			r = includedRange{first: first, last: last, checkParent: checkParent}
+		} else if first != r.last+1 {
+			break // batches that are not contiguous are dropped by the derivation pipeline
+		} else {
+			r.last = last
+		}
+	}
+	if r.checkParent == nil {
+		return includedRange{}, errors.New("no batches in channel")
+	}
+	return r, nil
+}
@@ -0,0 +1,245 @@
+package batcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+type stubL1Blocks map[uint64]*types.Block
+
+func (s stubL1Blocks) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	block, err := s.BlockByNumber(context.Background(), number)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (s stubL1Blocks) BlockByNumber(_ context.Context, number *big.Int) (*types.Block, error) {
+	block, ok := s[number.Uint64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return block, nil
+}
+
+func (s stubL1Blocks) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return 0, nil
+}
+
+func TestBatchSubmitter_ResumeBlock(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+	bs.Config.ResumeScanDepth = 3
+	bs.RollupConfig.BlockTime = 2
+	bs.RollupConfig.Genesis.L2Time = 1000
+	bs.RollupConfig.L1ChainID = big.NewInt(900)
+	bs.RollupConfig.BatchInboxAddress = common.Address{0xff}
+	bs.RollupConfig.ChannelTimeoutBedrock = 100
+
+	batcherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	batcherAddr := crypto.PubkeyToAddress(batcherKey.PublicKey)
+
+	var l2Blocks []*types.Block
+	parent := common.Hash{}
+	for i := uint64(0); i <= 10; i++ {
+		block := types.NewBlockWithHeader(&types.Header{Number: new(big.Int).SetUint64(i), ParentHash: parent, Time: 1000 + 2*i})
+		l2Blocks = append(l2Blocks, block)
+		parent = block.Hash()
+	}
+
+	// channelFrames returns the frames of a channel with the batches of the L2 blocks, with a frame per tx.
+	channelFrames := func(nums ...uint64) [][]byte {
+		comp, err := compressor.NewNonCompressor(compressor.Config{TargetOutputSize: 100_000, CompressionAlgo: derive.Zlib})
+		require.NoError(t, err)
+		co, err := derive.NewSingularChannelOut(comp, rollup.NewChainSpec(bs.RollupConfig))
+		require.NoError(t, err)
+		for _, n := range nums {
+			require.NoError(t, co.AddSingularBatch(&derive.SingularBatch{
+				ParentHash: l2Blocks[n].ParentHash(),
+				Timestamp:  l2Blocks[n].Time(),
+			}, 0))
+		}
+		require.NoError(t, co.Close())
+		var frames [][]byte
+		for {
+			var buf bytes.Buffer
+			buf.WriteByte(derive.DerivationVersion0)
+			_, err := co.OutputFrame(&buf, 40)
+			frames = append(frames, buf.Bytes())
+			if err == io.EOF {
+				return frames
+			}
+			require.NoError(t, err)
+		}
+	}
+	signer := types.LatestSignerForChainID(bs.RollupConfig.L1ChainID)
+	batchTx := func(key *ecdsa.PrivateKey, data []byte) *types.Transaction {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID: bs.RollupConfig.L1ChainID,
+			To:      &bs.RollupConfig.BatchInboxAddress,
+			Data:    data,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+	l1Block := func(num uint64, txs ...*types.Transaction) *types.Block {
+		return types.NewBlock(&types.Header{Number: new(big.Int).SetUint64(num)}, &types.Body{Transactions: txs}, nil, trie.NewStackTrie(nil))
+	}
+
+	batchTxs := func(key *ecdsa.PrivateKey, frames [][]byte) []*types.Transaction {
+		var txs []*types.Transaction
+		for _, frame := range frames {
+			txs = append(txs, batchTx(key, frame))
+		}
+		return txs
+	}
+	first := channelFrames(3, 4)
+	second := channelFrames(5, 6)
+	gap := channelFrames(8)
+	require.Greater(t, len(first), 1, "channel must span multiple frames")
+	bs.L1Client = stubL1Blocks{
+		10: l1Block(10, batchTx(batcherKey, first[0])),
+		11: l1Block(11, append(batchTxs(otherKey, gap), batchTxs(batcherKey, first[1:])...)...),
+		12: l1Block(12, append(batchTxs(batcherKey, second), batchTxs(batcherKey, gap)...)...),
+	}
+
+	syncStatus := &eth.SyncStatus{
+		HeadL1:   eth.L1BlockRef{Number: 12},
+		SafeL2:   eth.L2BlockRef{Hash: l2Blocks[2].Hash(), Number: 2},
+		UnsafeL2: eth.L2BlockRef{Hash: l2Blocks[10].Hash(), Number: 10},
+	}
+
+	t.Run("Contiguous", func(t *testing.T) {
+		for _, n := range []int64{2, 4, 4, 6} {
+			ep.ethClient.ExpectBlockByNumber(big.NewInt(n), l2Blocks[n], nil)
+		}
+		resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+		require.NoError(t, err)
+		require.Equal(t, eth.ToBlockID(l2Blocks[6]), resume)
+		ep.ethClient.AssertExpectations(t)
+	})
+
+	t.Run("ParentMismatch", func(t *testing.T) {
+		reorged := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), Extra: []byte{0x01}})
+		ep.ethClient.ExpectBlockByNumber(big.NewInt(2), reorged, nil)
+		resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+		require.NoError(t, err)
+		require.Equal(t, syncStatus.SafeL2.ID(), resume)
+		ep.ethClient.AssertExpectations(t)
+	})
+
+	t.Run("OtherBatcher", func(t *testing.T) {
+		resume, err := bs.resumeBlock(context.Background(), syncStatus, common.Address{0xaa})
+		require.NoError(t, err)
+		require.Equal(t, syncStatus.SafeL2.ID(), resume)
+	})
+
+	t.Run("InboxSchedule", func(t *testing.T) {
+		bs.RollupConfig.BatchInboxSchedule = []rollup.BatchInboxUpdate{{L1Block: 11, Address: common.Address{0xee}}}
+		defer func() { bs.RollupConfig.BatchInboxSchedule = nil }()
+		// Only the first frame of the first channel was sent to the batch inbox of its L1 block.
+		resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+		require.NoError(t, err)
+		require.Equal(t, syncStatus.SafeL2.ID(), resume)
+	})
+
+	t.Run("BlobTxs", func(t *testing.T) {
+		blobTx := func(key *ecdsa.PrivateKey, numBlobs int) *types.Transaction {
+			hashes := make([]common.Hash, numBlobs)
+			for i := range hashes {
+				hashes[i] = common.Hash{0x01, byte(i)}
+			}
+			tx, err := types.SignNewTx(key, signer, &types.BlobTx{
+				ChainID:    uint256.MustFromBig(bs.RollupConfig.L1ChainID),
+				GasTipCap:  new(uint256.Int),
+				GasFeeCap:  new(uint256.Int),
+				To:         bs.RollupConfig.BatchInboxAddress,
+				Value:      new(uint256.Int),
+				BlobFeeCap: new(uint256.Int),
+				BlobHashes: hashes,
+			})
+			require.NoError(t, err)
+			return tx
+		}
+		// The second channel is submitted in a blob tx, after a blob tx of another sender.
+		block12 := l1Block(12, blobTx(otherKey, 1), blobTx(batcherKey, len(second)))
+		l1Client := bs.L1Client
+		bs.L1Client = stubL1Blocks{10: l1Client.(stubL1Blocks)[10], 11: l1Client.(stubL1Blocks)[11], 12: block12}
+		defer func() { bs.L1Client = l1Client }()
+		ref12 := eth.InfoToL1BlockRef(eth.BlockToInfo(block12))
+		// The blob of the other sender is the first blob of the block.
+		var hashes []eth.IndexedBlobHash
+		var blobs []*eth.Blob
+		for i, frame := range second {
+			hashes = append(hashes, eth.IndexedBlobHash{Index: uint64(i + 1), Hash: common.Hash{0x01, byte(i)}})
+			var blob eth.Blob
+			require.NoError(t, blob.FromData(frame))
+			blobs = append(blobs, &blob)
+		}
+
+		t.Run("WithBeacon", func(t *testing.T) {
+			beacon := &testutils.MockBlobsFetcher{}
+			bs.L1Beacon = beacon
+			defer func() { bs.L1Beacon = nil }()
+			beacon.ExpectOnGetBlobs(context.Background(), ref12, hashes, blobs, nil)
+			for _, n := range []int64{2, 4, 4, 6} {
+				ep.ethClient.ExpectBlockByNumber(big.NewInt(n), l2Blocks[n], nil)
+			}
+			resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+			require.NoError(t, err)
+			require.Equal(t, eth.ToBlockID(l2Blocks[6]), resume)
+			beacon.AssertExpectations(t)
+			ep.ethClient.AssertExpectations(t)
+		})
+
+		t.Run("WithoutBeacon", func(t *testing.T) {
+			for _, n := range []int64{2, 4} {
+				ep.ethClient.ExpectBlockByNumber(big.NewInt(n), l2Blocks[n], nil)
+			}
+			resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+			require.NoError(t, err)
+			require.Equal(t, eth.ToBlockID(l2Blocks[4]), resume)
+			ep.ethClient.AssertExpectations(t)
+		})
+
+		t.Run("BeaconError", func(t *testing.T) {
+			beacon := &testutils.MockBlobsFetcher{}
+			bs.L1Beacon = beacon
+			defer func() { bs.L1Beacon = nil }()
+			beacon.ExpectOnGetBlobs(context.Background(), ref12, hashes, nil, errors.New("boom"))
+			_, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+			require.ErrorContains(t, err, "boom")
+		})
+	})
+
+	t.Run("ScanDepth", func(t *testing.T) {
+		bs.Config.ResumeScanDepth = 2
+		defer func() { bs.Config.ResumeScanDepth = 3 }()
+		// The first frame of the first channel is not scanned, and the second channel is not contiguous.
+		resume, err := bs.resumeBlock(context.Background(), syncStatus, batcherAddr)
+		require.NoError(t, err)
+		require.Equal(t, syncStatus.SafeL2.ID(), resume)
+	})
+}
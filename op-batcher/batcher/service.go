@@ -20,12 +20,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -44,6 +46,19 @@ type BatcherConfig struct {
 
 	WaitNodeSync        bool
 	CheckRecentTxsDepth int
+	ResumeScanDepth     uint64
+	StateFile           string
+
+	// ThrottleThreshold, ThrottleTxSize and ThrottleBlockSize configure the throttling of the sequencer,
+	// applied while the DA size of the pending L2 blocks exceeds the threshold. 0 threshold disables throttling.
+	ThrottleThreshold uint64
+	ThrottleTxSize    uint64
+	ThrottleBlockSize uint64
+
+	// MaxSafeLag is the SLO of the time between the L2 unsafe head and the L2 safe head. 0 disables it.
+	// Data submission is escalated while the projected safe lag exceeds SafeLagEscalationRatio of the SLO.
+	MaxSafeLag             time.Duration
+	SafeLagEscalationRatio float64
 }
 
 // BatcherService represents a full batch-submitter instance and its resources,
@@ -54,7 +69,13 @@ type BatcherService struct {
 	L1Client         *ethclient.Client
 	EndpointProvider dial.L2EndpointProvider
 	TxManager        *txmgr.SimpleTxManager
-	AltDA            *altda.DAClient
+	// TxBudget limits the txs pending across the default chain and the additional target chains.
+	TxBudget TxBudget
+	AltDA    *altda.DAClient
+	// SequencerRollupClient is set in follower mode, when the endpoint provider serves a follower node.
+	SequencerRollupClient *sources.RollupClient
+	// L1Beacon is set if an L1 beacon endpoint is configured, to read the blobs of the batcher txs scanned at startup.
+	L1Beacon *sources.L1BeaconClient
 
 	BatcherConfig
 
@@ -62,6 +83,8 @@ type BatcherService struct {
 	RollupConfig  *rollup.Config
 
 	driver *BatchSubmitter
+	// targets are the additional chains to submit batches for.
+	targets []*chainTarget
 
 	Version string
 
@@ -69,8 +92,8 @@ type BatcherService struct {
 	metricsSrv   *httputil.HTTPServer
 	rpcServer    *oprpc.Server
 
-	balanceMetricer io.Closer
-	stopped         atomic.Bool
+	accountMonitor io.Closer
+	stopped        atomic.Bool
 
 	NotSubmittingOnStart bool
 }
@@ -98,7 +121,14 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	bs.MaxConcurrentDARequests = cfg.AltDA.MaxConcurrentRequests
 	bs.NetworkTimeout = cfg.TxMgrConfig.NetworkTimeout
 	bs.CheckRecentTxsDepth = cfg.CheckRecentTxsDepth
+	bs.ResumeScanDepth = cfg.ResumeScanDepth
+	bs.StateFile = cfg.StateFile
 	bs.WaitNodeSync = cfg.WaitNodeSync
+	bs.ThrottleThreshold = cfg.ThrottleThreshold
+	bs.ThrottleTxSize = cfg.ThrottleTxSize
+	bs.ThrottleBlockSize = cfg.ThrottleBlockSize
+	bs.MaxSafeLag = cfg.MaxSafeLag
+	bs.SafeLagEscalationRatio = cfg.SafeLagEscalationRatio
 	if err := bs.initRPCClients(ctx, cfg); err != nil {
 		return err
 	}
@@ -108,6 +138,7 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	if err := bs.initTxManager(cfg); err != nil {
 		return fmt.Errorf("failed to init Tx manager: %w", err)
 	}
+	bs.TxBudget = NewTxBudget(cfg.MaxPendingTransactionsTotal)
 	// must be init before driver and channel config
 	if err := bs.initAltDA(cfg); err != nil {
 		return fmt.Errorf("failed to init AltDA: %w", err)
@@ -123,6 +154,9 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 		return fmt.Errorf("failed to init profiling: %w", err)
 	}
 	bs.initDriver()
+	if err := bs.initTargets(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init additional chains: %w", err)
+	}
 	if err := bs.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
 	}
@@ -152,6 +186,20 @@ func (bs *BatcherService) initRPCClients(ctx context.Context, cfg *CLIConfig) er
 	}
 	bs.EndpointProvider = endpointProvider
 
+	if cfg.SequencerRollupRpc != "" {
+		sequencerClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, bs.Log, cfg.SequencerRollupRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial sequencer rollup RPC: %w", err)
+		}
+		bs.Log.Info("Loading L2 blocks from follower node, following the unsafe chain of the sequencer")
+		bs.SequencerRollupClient = sequencerClient
+	}
+
+	if cfg.L1Beacon != "" {
+		beaconClient := sources.NewBeaconHTTPClient(client.NewBasicHTTPClient(cfg.L1Beacon, bs.Log))
+		bs.L1Beacon = sources.NewL1BeaconClient(beaconClient, sources.L1BeaconClientConfig{})
+	}
+
 	return nil
 }
 
@@ -167,7 +215,7 @@ func (bs *BatcherService) initMetrics(cfg *CLIConfig) {
 // initBalanceMonitor depends on Metrics, L1Client and TxManager to start background-monitoring of the batcher balance.
 func (bs *BatcherService) initBalanceMonitor(cfg *CLIConfig) {
 	if cfg.MetricsConfig.Enabled {
-		bs.balanceMetricer = bs.Metrics.StartBalanceMetrics(bs.Log, bs.L1Client, bs.TxManager.From())
+		bs.accountMonitor = bs.Metrics.StartAccountMonitor(bs.Log, bs.L1Client, bs.TxManager.From())
 	}
 }
 
@@ -189,19 +237,30 @@ func (bs *BatcherService) initRollupConfig(ctx context.Context) error {
 }
 
 func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
-	channelTimeout := bs.RollupConfig.ChannelTimeoutBedrock
+	cc, err := bs.newChannelConfig(cfg, bs.RollupConfig)
+	if err != nil {
+		return err
+	}
+	bs.ChannelConfig = cc
+	return nil
+}
+
+// newChannelConfig creates the channel config of the chain with the given rollup config.
+func (bs *BatcherService) newChannelConfig(cfg *CLIConfig, rollupCfg *rollup.Config) (ChannelConfigProvider, error) {
+	channelTimeout := rollupCfg.ChannelTimeoutBedrock
 	// Use lower channel timeout if granite is scheduled.
 	// Ensures channels are restricted to the tighter timeout even if granite hasn't activated yet
-	if bs.RollupConfig.GraniteTime != nil {
+	if rollupCfg.GraniteTime != nil {
 		channelTimeout = params.ChannelTimeoutGranite
 	}
 	cc := ChannelConfig{
-		SeqWindowSize:         bs.RollupConfig.SeqWindowSize,
+		SeqWindowSize:         rollupCfg.SeqWindowSize,
 		ChannelTimeout:        channelTimeout,
 		MaxChannelDuration:    cfg.MaxChannelDuration,
 		MaxFrameSize:          cfg.MaxL1TxSize - 1, // account for version byte prefix; reset for blobs
 		MaxBlocksPerSpanBatch: cfg.MaxBlocksPerSpanBatch,
 		TargetNumFrames:       cfg.TargetNumFrames,
+		TargetNumBlobs:        cfg.TargetNumBlobs,
 		SubSafetyMargin:       cfg.SubSafetyMargin,
 		BatchType:             cfg.BatchType,
 	}
@@ -215,35 +274,41 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		cc.UseBlobs = true
 	case flags.CalldataType: // do nothing
 	default:
-		return fmt.Errorf("unknown data availability type: %v", cfg.DataAvailabilityType)
+		return nil, fmt.Errorf("unknown data availability type: %v", cfg.DataAvailabilityType)
 	}
 
 	if bs.UseAltDA && cc.MaxFrameSize > altda.MaxInputSize {
-		return fmt.Errorf("max frame size %d exceeds altDA max input size %d", cc.MaxFrameSize, altda.MaxInputSize)
+		return nil, fmt.Errorf("max frame size %d exceeds altDA max input size %d", cc.MaxFrameSize, altda.MaxInputSize)
 	}
 
 	cc.InitCompressorConfig(cfg.ApproxComprRatio, cfg.Compressor, cfg.CompressionAlgo)
 
-	if cc.UseBlobs && !bs.RollupConfig.IsEcotone(uint64(time.Now().Unix())) {
-		return errors.New("cannot use Blobs before Ecotone")
+	if cc.UseBlobs && !rollupCfg.IsEcotone(uint64(time.Now().Unix())) {
+		return nil, errors.New("cannot use Blobs before Ecotone")
 	}
-	if !cc.UseBlobs && bs.RollupConfig.IsEcotone(uint64(time.Now().Unix())) {
+	if !cc.UseBlobs && rollupCfg.IsEcotone(uint64(time.Now().Unix())) {
 		bs.Log.Warn("Ecotone upgrade is active, but batcher is not configured to use Blobs!")
 	}
 
 	// Checking for brotli compression only post Fjord
-	if cc.CompressorConfig.CompressionAlgo.IsBrotli() && !bs.RollupConfig.IsFjord(uint64(time.Now().Unix())) {
-		return errors.New("cannot use brotli compression before Fjord")
+	if cc.CompressorConfig.CompressionAlgo.IsBrotli() && !rollupCfg.IsFjord(uint64(time.Now().Unix())) {
+		return nil, errors.New("cannot use brotli compression before Fjord")
+	}
+	// Checking for zstd compression only post activation in the chain config
+	if cc.CompressorConfig.CompressionAlgo.IsZstd() && !rollupCfg.IsZstd(uint64(time.Now().Unix())) {
+		return nil, errors.New("cannot use zstd compression before its activation in the rollup config")
 	}
 
 	if err := cc.Check(); err != nil {
-		return fmt.Errorf("invalid channel configuration: %w", err)
+		return nil, fmt.Errorf("invalid channel configuration: %w", err)
 	}
 	bs.Log.Info("Initialized channel-config",
+		"chain_id", rollupCfg.L2ChainID,
 		"da_type", cfg.DataAvailabilityType,
 		"use_alt_da", bs.UseAltDA,
 		"max_frame_size", cc.MaxFrameSize,
 		"target_num_frames", cc.TargetNumFrames,
+		"target_num_blobs", cc.TargetNumBlobs,
 		"compressor", cc.CompressorConfig.Kind,
 		"compression_algo", cc.CompressorConfig.CompressionAlgo,
 		"batch_type", cc.BatchType,
@@ -258,16 +323,14 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		// copy blobs config and use hardcoded calldata fallback config for now
 		calldataCC := cc
 		calldataCC.TargetNumFrames = 1
+		calldataCC.TargetNumBlobs = 0
 		calldataCC.MaxFrameSize = 120_000
 		calldataCC.UseBlobs = false
 		calldataCC.ReinitCompressorConfig()
 
-		bs.ChannelConfig = NewDynamicEthChannelConfig(bs.Log, 10*time.Second, bs.TxManager, cc, calldataCC)
-	} else {
-		bs.ChannelConfig = cc
+		return NewDynamicEthChannelConfig(bs.Log, 10*time.Second, bs.TxManager, cc, calldataCC), nil
 	}
-
-	return nil
+	return cc, nil
 }
 
 func (bs *BatcherService) initTxManager(cfg *CLIConfig) error {
@@ -288,6 +351,7 @@ func (bs *BatcherService) initPProf(cfg *CLIConfig) error {
 		cfg.PprofConfig.ProfileDir,
 		cfg.PprofConfig.ProfileFilename,
 	)
+	bs.pprofService.EnableUpload(cfg.PprofConfig.Upload, "op-batcher", bs.Version)
 
 	if err := bs.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
@@ -316,19 +380,36 @@ func (bs *BatcherService) initMetricsServer(cfg *CLIConfig) error {
 }
 
 func (bs *BatcherService) initDriver() {
+	// avoid a non-nil interface holding a nil client
+	var sequencerClient RollupClient
+	if bs.SequencerRollupClient != nil {
+		sequencerClient = bs.SequencerRollupClient
+	}
 	bs.driver = NewBatchSubmitter(DriverSetup{
-		Log:              bs.Log,
-		Metr:             bs.Metrics,
-		RollupConfig:     bs.RollupConfig,
-		Config:           bs.BatcherConfig,
-		Txmgr:            bs.TxManager,
-		L1Client:         bs.L1Client,
-		EndpointProvider: bs.EndpointProvider,
-		ChannelConfig:    bs.ChannelConfig,
-		AltDA:            bs.AltDA,
+		Log:                   bs.Log,
+		Metr:                  bs.Metrics,
+		RollupConfig:          bs.RollupConfig,
+		Config:                bs.BatcherConfig,
+		Txmgr:                 bs.TxManager,
+		TxBudget:              bs.TxBudget,
+		L1Client:              bs.L1Client,
+		EndpointProvider:      bs.EndpointProvider,
+		ChannelConfig:         bs.ChannelConfig,
+		AltDA:                 bs.AltDA,
+		SequencerRollupClient: sequencerClient,
+		L1Beacon:              bs.l1BeaconClient(),
 	})
 }
 
+// l1BeaconClient returns the L1 beacon client, or nil if there is none,
+// avoiding a non-nil interface holding a nil client.
+func (bs *BatcherService) l1BeaconClient() L1BeaconClient {
+	if bs.L1Beacon == nil {
+		return nil
+	}
+	return bs.L1Beacon
+}
+
 func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
 	server := oprpc.NewServer(
 		cfg.RPC.ListenAddr,
@@ -336,8 +417,31 @@ func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
 		bs.Version,
 		oprpc.WithLogger(bs.Log),
 	)
+	server.AddReadinessCheck("l1", func(ctx context.Context) error {
+		_, err := bs.L1Client.BlockNumber(ctx)
+		return err
+	})
+	server.AddReadinessCheck("rollup", func(ctx context.Context) error {
+		rollupClient, err := bs.EndpointProvider.RollupClient(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = rollupClient.SyncStatus(ctx)
+		return err
+	})
+	for _, target := range bs.targets {
+		target := target
+		server.AddReadinessCheck(fmt.Sprintf("rollup-%d", target.rollupConfig.L2ChainID), func(ctx context.Context) error {
+			rollupClient, err := target.endpointProvider.RollupClient(ctx)
+			if err != nil {
+				return err
+			}
+			_, err = rollupClient.SyncStatus(ctx)
+			return err
+		})
+	}
 	if cfg.RPC.EnableAdmin {
-		adminAPI := rpc.NewAdminAPI(bs.driver, bs.Metrics, bs.Log)
+		adminAPI := rpc.NewAdminAPI(bs.drivers(), bs.Metrics, bs.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
 		server.AddAPI(bs.TxManager.API())
 		bs.Log.Info("Admin RPC enabled")
@@ -366,7 +470,7 @@ func (bs *BatcherService) Start(_ context.Context) error {
 	bs.driver.Log.Info("Starting batcher", "notSubmittingOnStart", bs.NotSubmittingOnStart)
 
 	if !bs.NotSubmittingOnStart {
-		return bs.driver.StartBatchSubmitting()
+		return bs.drivers().StartBatchSubmitting()
 	}
 	return nil
 }
@@ -404,6 +508,14 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop batch submitting: %w", err))
 		}
 	}
+	for _, target := range bs.targets {
+		if target.driver == nil {
+			continue
+		}
+		if err := target.driver.StopBatchSubmittingIfRunning(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop batch submitting of chain %v: %w", target.rollupConfig.L2ChainID, err))
+		}
+	}
 
 	if bs.rpcServer != nil {
 		// TODO(7685): the op-service RPC server is not built on top of op-service httputil Server, and has poor shutdown
@@ -416,8 +528,8 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
 		}
 	}
-	if bs.balanceMetricer != nil {
-		if err := bs.balanceMetricer.Close(); err != nil {
+	if bs.accountMonitor != nil {
+		if err := bs.accountMonitor.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
 		}
 	}
@@ -434,6 +546,12 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 	if bs.EndpointProvider != nil {
 		bs.EndpointProvider.Close()
 	}
+	for _, target := range bs.targets {
+		target.endpointProvider.Close()
+	}
+	if bs.SequencerRollupClient != nil {
+		bs.SequencerRollupClient.Close()
+	}
 
 	if result == nil {
 		bs.stopped.Store(true)
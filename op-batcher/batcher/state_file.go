@@ -0,0 +1,125 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// writeStateFile writes the L2 blocks pending submission to the state file, so they can be loaded
+// from it at the next start instead of from the L2 node. The state file is removed if no blocks are pending.
+func (l *BatchSubmitter) writeStateFile() {
+	path := l.Config.StateFile
+	if path == "" {
+		return
+	}
+	blocks := l.state.PendingBlocks()
+	if len(blocks) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			l.Log.Warn("Failed to remove state file", "file", path, "err", err)
+		}
+		return
+	}
+	if err := writeBlocks(path, blocks); err != nil {
+		l.Log.Error("Failed to write pending blocks to state file", "file", path, "err", err)
+		return
+	}
+	l.Log.Info("Wrote pending blocks to state file", "file", path, "count", len(blocks),
+		"first", eth.ToBlockID(blocks[0]), "last", eth.ToBlockID(blocks[len(blocks)-1]))
+}
+
+// loadStateFile loads the L2 blocks pending submission at the last shutdown from the state file into
+// the channel manager, if they extend the last stored block and are still canonical. Blocks up to the last
+// stored block are skipped, since their data was included on L1 in the meantime. The state file is
+// removed afterwards, so it is never loaded twice.
+func (l *BatchSubmitter) loadStateFile(ctx context.Context, unsafe eth.L2BlockRef) {
+	path := l.Config.StateFile
+	blocks, err := readBlocks(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	defer func() {
+		if err := os.Remove(path); err != nil {
+			l.Log.Warn("Failed to remove state file", "file", path, "err", err)
+		}
+	}()
+	if err != nil {
+		l.Log.Warn("Failed to read state file, loading blocks from L2", "file", path, "err", err)
+		return
+	}
+
+	for len(blocks) > 0 && blocks[0].NumberU64() <= l.lastStoredBlock.Number {
+		blocks = blocks[1:]
+	}
+	if len(blocks) == 0 {
+		l.Log.Info("All blocks of the state file were submitted already", "file", path)
+		return
+	}
+	parent := l.lastStoredBlock.Hash
+	for _, block := range blocks {
+		if block.ParentHash() != parent {
+			l.Log.Warn("Blocks of the state file do not extend the last stored block, loading blocks from L2",
+				"last_stored", l.lastStoredBlock, "block", eth.ToBlockID(block))
+			return
+		}
+		parent = block.Hash()
+	}
+	last := blocks[len(blocks)-1]
+	if last.NumberU64() > unsafe.Number {
+		l.Log.Warn("Blocks of the state file are ahead of the L2 unsafe head, loading blocks from L2",
+			"last", eth.ToBlockID(last), "unsafe", unsafe)
+		return
+	}
+	// The blocks are canonical if the last one is, since they are a chain.
+	canonical, err := l.fetchL2Block(ctx, last.NumberU64())
+	if err != nil {
+		l.Log.Warn("Failed to check blocks of the state file, loading blocks from L2", "err", err)
+		return
+	} else if canonical.Hash() != last.Hash() {
+		l.Log.Warn("Blocks of the state file were reorged, loading blocks from L2",
+			"last", eth.ToBlockID(last), "canonical", eth.ToBlockID(canonical))
+		return
+	}
+
+	for _, block := range blocks {
+		if err := l.state.AddL2Block(block); err != nil {
+			// Loading the next blocks from L2 detects the inconsistent state as a reorg, and clears it.
+			l.Log.Error("Failed to add block of the state file to state", "block", eth.ToBlockID(block), "err", err)
+			return
+		}
+	}
+	l.Log.Info("Loaded pending blocks from state file", "file", path, "count", len(blocks),
+		"first", eth.ToBlockID(blocks[0]), "last", eth.ToBlockID(last))
+	l.lastStoredBlock = eth.ToBlockID(last)
+}
+
+func writeBlocks(path string, blocks []*types.Block) error {
+	w, err := ioutil.NewAtomicWriterCompressed(path, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create state file: %w", err)
+	}
+	if err := rlp.Encode(w, blocks); err != nil {
+		_ = w.Abort()
+		return fmt.Errorf("failed to encode blocks: %w", err)
+	}
+	return w.Close()
+}
+
+func readBlocks(path string) ([]*types.Block, error) {
+	r, err := ioutil.OpenDecompressed(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var blocks []*types.Block
+	if err := rlp.NewStream(r, 0).Decode(&blocks); err != nil {
+		return nil, fmt.Errorf("failed to decode blocks: %w", err)
+	}
+	return blocks, nil
+}
@@ -0,0 +1,90 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchSubmitter_StateFile(t *testing.T) {
+	bs, ep := setup(t)
+	bs.Config.NetworkTimeout = time.Second
+
+	var l2Blocks []*types.Block
+	parent := common.Hash{}
+	for i := uint64(0); i <= 6; i++ {
+		block := types.NewBlockWithHeader(&types.Header{Number: new(big.Int).SetUint64(i), ParentHash: parent, Time: 1000 + 2*i})
+		l2Blocks = append(l2Blocks, block)
+		parent = block.Hash()
+	}
+	unsafe := eth.L2BlockRef{Hash: l2Blocks[6].Hash(), Number: 6}
+	ids := func(blocks []*types.Block) (ids []eth.BlockID) {
+		for _, block := range blocks {
+			ids = append(ids, eth.ToBlockID(block))
+		}
+		return ids
+	}
+
+	// load resets the state, and loads the state file written with the given blocks pending.
+	load := func(t *testing.T, lastStored *types.Block, pending ...*types.Block) {
+		bs.Config.StateFile = filepath.Join(t.TempDir(), "state.gz")
+		bs.state.Clear(eth.BlockID{})
+		for _, block := range pending {
+			require.NoError(t, bs.state.AddL2Block(block))
+		}
+		bs.writeStateFile()
+		bs.state.Clear(eth.BlockID{})
+		bs.lastStoredBlock = eth.ToBlockID(lastStored)
+		bs.loadStateFile(context.Background(), unsafe)
+		_, err := os.Stat(bs.Config.StateFile)
+		require.ErrorIs(t, err, os.ErrNotExist, "state file must be removed once loaded")
+	}
+
+	t.Run("Loaded", func(t *testing.T) {
+		ep.ethClient.ExpectBlockByNumber(big.NewInt(5), l2Blocks[5], nil)
+		load(t, l2Blocks[2], l2Blocks[3:6]...)
+		require.Equal(t, eth.ToBlockID(l2Blocks[5]), bs.lastStoredBlock)
+		require.Equal(t, ids(l2Blocks[3:6]), ids(bs.state.PendingBlocks()))
+		ep.ethClient.AssertExpectations(t)
+	})
+
+	t.Run("SkipSubmitted", func(t *testing.T) {
+		ep.ethClient.ExpectBlockByNumber(big.NewInt(5), l2Blocks[5], nil)
+		load(t, l2Blocks[3], l2Blocks[2:6]...)
+		require.Equal(t, eth.ToBlockID(l2Blocks[5]), bs.lastStoredBlock)
+		require.Equal(t, ids(l2Blocks[4:6]), ids(bs.state.PendingBlocks()))
+		ep.ethClient.AssertExpectations(t)
+	})
+
+	t.Run("NotExtending", func(t *testing.T) {
+		load(t, l2Blocks[2], l2Blocks[4:6]...)
+		require.Equal(t, eth.ToBlockID(l2Blocks[2]), bs.lastStoredBlock)
+		require.Empty(t, bs.state.PendingBlocks())
+	})
+
+	t.Run("Reorged", func(t *testing.T) {
+		reorged := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(5), Extra: []byte{0x01}})
+		ep.ethClient.ExpectBlockByNumber(big.NewInt(5), reorged, nil)
+		load(t, l2Blocks[2], l2Blocks[3:6]...)
+		require.Equal(t, eth.ToBlockID(l2Blocks[2]), bs.lastStoredBlock)
+		require.Empty(t, bs.state.PendingBlocks())
+		ep.ethClient.AssertExpectations(t)
+	})
+
+	t.Run("NoPendingBlocks", func(t *testing.T) {
+		bs.Config.StateFile = filepath.Join(t.TempDir(), "state")
+		require.NoError(t, os.WriteFile(bs.Config.StateFile, []byte{0x01}, 0o644))
+		bs.state.Clear(eth.BlockID{})
+		bs.writeStateFile()
+		_, err := os.Stat(bs.Config.StateFile)
+		require.ErrorIs(t, err, os.ErrNotExist, "stale state file must be removed")
+	})
+}
@@ -0,0 +1,170 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// chainTarget is an additional chain the batcher submits batches for. It has its own channel pipeline,
+// and shares the tx manager, and so the batcher key, with the default chain and other targets.
+type chainTarget struct {
+	endpointProvider dial.L2EndpointProvider
+	rollupConfig     *rollup.Config
+	driver           *BatchSubmitter
+}
+
+// initTargets depends on the default driver, and creates the drivers of the additional target chains.
+func (bs *BatcherService) initTargets(ctx context.Context, cfg *CLIConfig) error {
+	chainIDs := map[uint64]bool{bs.RollupConfig.L2ChainID.Uint64(): true}
+	inboxes := make(map[common.Address]bool)
+	for _, inbox := range batchInboxes(bs.RollupConfig) {
+		inboxes[inbox] = true
+	}
+	for i, rollupRpc := range cfg.AdditionalRollupRpcs {
+		endpointProvider, err := dial.NewStaticL2EndpointProvider(ctx, bs.Log, cfg.AdditionalL2EthRpcs[i], rollupRpc)
+		if err != nil {
+			return fmt.Errorf("failed to build L2 endpoint provider of additional chain %d: %w", i, err)
+		}
+		target := &chainTarget{endpointProvider: endpointProvider}
+		// Register the target first, so its endpoints are closed when failing to initialize it.
+		bs.targets = append(bs.targets, target)
+
+		rollupNode, err := endpointProvider.RollupClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve rollup client of additional chain %d: %w", i, err)
+		}
+		rollupCfg, err := rollupNode.RollupConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve rollup config of additional chain %d: %w", i, err)
+		}
+		if err := rollupCfg.Check(); err != nil {
+			return fmt.Errorf("invalid rollup config of additional chain %d: %w", i, err)
+		}
+		chainID := rollupCfg.L2ChainID.Uint64()
+		if chainIDs[chainID] {
+			return fmt.Errorf("duplicate chain ID %d of additional chain %d", chainID, i)
+		}
+		chainInboxes := batchInboxes(rollupCfg)
+		for _, inbox := range chainInboxes {
+			if inboxes[inbox] {
+				return fmt.Errorf("duplicate batch inbox %v of additional chain %d", inbox, i)
+			}
+		}
+		chainIDs[chainID] = true
+		for _, inbox := range chainInboxes {
+			inboxes[inbox] = true
+		}
+		target.rollupConfig = rollupCfg
+
+		logger := bs.Log.New("chain", chainID)
+		rollupCfg.LogDescription(logger, chaincfg.L2ChainIDToNetworkDisplayName)
+		channelConfig, err := bs.newChannelConfig(cfg, rollupCfg)
+		if err != nil {
+			return fmt.Errorf("failed to init channel config of additional chain %d: %w", i, err)
+		}
+
+		config := bs.BatcherConfig
+		// Safe lag escalation tunes the shared tx manager, so it is only applied by the default chain.
+		config.MaxSafeLag = 0
+		if config.StateFile != "" {
+			config.StateFile = fmt.Sprintf("%s.%d", config.StateFile, chainID)
+		}
+		metr := metrics.NoopMetrics
+		if m, ok := bs.Metrics.(*metrics.Metrics); ok {
+			metr = m.ChainMetrics(chainID)
+		}
+		target.driver = NewBatchSubmitter(DriverSetup{
+			Log:              logger,
+			Metr:             metr,
+			RollupConfig:     rollupCfg,
+			Config:           config,
+			Txmgr:            bs.TxManager,
+			TxBudget:         bs.TxBudget,
+			L1Client:         bs.L1Client,
+			EndpointProvider: endpointProvider,
+			ChannelConfig:    channelConfig,
+			AltDA:            bs.AltDA,
+			L1Beacon:         bs.l1BeaconClient(),
+		})
+		logger.Info("Initialized additional chain", "batch_inboxes", chainInboxes)
+	}
+	return nil
+}
+
+// batchInboxes returns the initial batch inbox address of the chain, and the addresses of its batch inbox schedule.
+// Chains must not share any of them, as the derivation of both chains would read the batches of the other.
+func batchInboxes(cfg *rollup.Config) []common.Address {
+	inboxes := []common.Address{cfg.BatchInboxAddress}
+	for _, update := range cfg.BatchInboxSchedule {
+		inboxes = append(inboxes, update.Address)
+	}
+	return inboxes
+}
+
+// drivers returns the drivers of the default chain and the additional target chains.
+func (bs *BatcherService) drivers() batchSubmitters {
+	drivers := batchSubmitters{bs.driver}
+	for _, target := range bs.targets {
+		if target.driver != nil {
+			drivers = append(drivers, target.driver)
+		}
+	}
+	return drivers
+}
+
+// batchSubmitters starts and stops the batch submission of multiple chains together.
+type batchSubmitters []*BatchSubmitter
+
+func (b batchSubmitters) StartBatchSubmitting() error {
+	for _, driver := range b {
+		if err := driver.StartBatchSubmitting(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b batchSubmitters) StopBatchSubmitting(ctx context.Context) error {
+	var result error
+	for _, driver := range b {
+		result = errors.Join(result, driver.StopBatchSubmitting(ctx))
+	}
+	return result
+}
+
+// TxBudget limits the number of transactions pending at once across the drivers sharing a tx manager.
+// A nil budget is unlimited.
+type TxBudget chan struct{}
+
+func NewTxBudget(maxPending uint64) TxBudget {
+	if maxPending == 0 {
+		return nil
+	}
+	return make(TxBudget, maxPending)
+}
+
+// budgetTxManager is a tx manager that waits for a slot of the budget before sending a tx.
+type budgetTxManager struct {
+	txmgr.TxManager
+	budget TxBudget
+}
+
+func (m *budgetTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	select {
+	case m.budget <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-m.budget }()
+	return m.TxManager.Send(ctx, candidate)
+}
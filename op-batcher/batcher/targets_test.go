@@ -0,0 +1,78 @@
+package batcher
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+type blockingTxManager struct {
+	txmgr.TxManager
+	release chan struct{}
+	pending atomic.Int64
+	maxSeen atomic.Int64
+}
+
+func (m *blockingTxManager) Send(ctx context.Context, _ txmgr.TxCandidate) (*types.Receipt, error) {
+	pending := m.pending.Add(1)
+	defer m.pending.Add(-1)
+	for {
+		seen := m.maxSeen.Load()
+		if pending <= seen || m.maxSeen.CompareAndSwap(seen, pending) {
+			break
+		}
+	}
+	select {
+	case <-m.release:
+		return &types.Receipt{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestTxBudget(t *testing.T) {
+	require.Nil(t, NewTxBudget(0), "0 should be unlimited")
+
+	budget := NewTxBudget(2)
+	inner := &blockingTxManager{release: make(chan struct{})}
+	// Multiple drivers share the budget, each with their own tx manager wrapper.
+	managers := []*budgetTxManager{
+		{TxManager: inner, budget: budget},
+		{TxManager: inner, budget: budget},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		m := managers[i%len(managers)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.Send(context.Background(), txmgr.TxCandidate{})
+			require.NoError(t, err)
+		}()
+	}
+	require.Eventually(t, func() bool { return len(budget) == 2 }, time.Second, time.Millisecond)
+	for i := 0; i < 6; i++ {
+		inner.release <- struct{}{}
+	}
+	wg.Wait()
+	require.EqualValues(t, 2, inner.maxSeen.Load())
+	require.Empty(t, budget, "should release the budget")
+
+	t.Run("Cancelled", func(t *testing.T) {
+		budget := NewTxBudget(1)
+		budget <- struct{}{}
+		m := &budgetTxManager{TxManager: inner, budget: budget}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := m.Send(ctx, txmgr.TxCandidate{})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
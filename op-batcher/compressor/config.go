@@ -17,8 +17,16 @@ type Config struct {
 	// will default to RatioKind.
 	Kind string
 
-	// Type of compression algorithm to use. Must be one of [zlib, brotli-(9|10|11)]
+	// Type of compression algorithm to use. Must be one of [zlib, brotli-(9|10|11), zstd-(1|3|9|19)]
 	CompressionAlgo derive.CompressionAlgo
+	// ZstdDictionary is the zstd dictionary of the chain config, if any.
+	// It is only used by the zstd compression algorithms.
+	ZstdDictionary []byte
+}
+
+// ChannelCompressorOptions returns the options of the channel compressors to create.
+func (c Config) ChannelCompressorOptions() []derive.ChannelCompressorOption {
+	return []derive.ChannelCompressorOption{derive.WithCompressionDictionary(c.ZstdDictionary)}
 }
 
 func (c Config) NewCompressor() (derive.Compressor, error) {
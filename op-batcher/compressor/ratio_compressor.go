@@ -21,7 +21,7 @@ func NewRatioCompressor(config Config) (derive.Compressor, error) {
 		config: config,
 	}
 
-	compressor, err := derive.NewChannelCompressor(config.CompressionAlgo)
+	compressor, err := derive.NewChannelCompressor(config.CompressionAlgo, config.ChannelCompressorOptions()...)
 	if err != nil {
 		return nil, err
 	}
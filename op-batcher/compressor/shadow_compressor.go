@@ -39,11 +39,11 @@ func NewShadowCompressor(config Config) (derive.Compressor, error) {
 	}
 
 	var err error
-	c.compressor, err = derive.NewChannelCompressor(config.CompressionAlgo)
+	c.compressor, err = derive.NewChannelCompressor(config.CompressionAlgo, config.ChannelCompressorOptions()...)
 	if err != nil {
 		return nil, err
 	}
-	c.shadowCompressor, err = derive.NewChannelCompressor(config.CompressionAlgo)
+	c.shadowCompressor, err = derive.NewChannelCompressor(config.CompressionAlgo, config.ChannelCompressorOptions()...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +44,31 @@ var (
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
 	// Optional flags
+	SequencerRollupRpcFlag = &cli.StringFlag{
+		Name: "sequencer-rollup-rpc",
+		Usage: "HTTP provider URL for the rollup node of the sequencer, to run the batcher against a follower node. " +
+			"If set, the l2-eth-rpc and rollup-rpc serve a follower node that blocks are loaded from, " +
+			"up to the unsafe heads of the sequencer, after checking the follower serves the same chain.",
+		EnvVars: prefixEnvVars("SEQUENCER_ROLLUP_RPC"),
+	}
+	AdditionalL2EthRpcsFlag = &cli.StringSliceFlag{
+		Name: "additional-l2-eth-rpcs",
+		Usage: "HTTP provider URLs for the L2 execution engines of additional chains to submit batches for, " +
+			"sharing the batcher key and transaction manager. Must match the additional-rollup-rpcs in number and order.",
+		EnvVars: prefixEnvVars("ADDITIONAL_L2_ETH_RPCS"),
+	}
+	AdditionalRollupRpcsFlag = &cli.StringSliceFlag{
+		Name: "additional-rollup-rpcs",
+		Usage: "HTTP provider URLs for the rollup nodes of additional chains to submit batches for. " +
+			"The chain ID and batch inbox of each chain are loaded from its rollup config.",
+		EnvVars: prefixEnvVars("ADDITIONAL_ROLLUP_RPCS"),
+	}
+	MaxPendingTransactionsTotalFlag = &cli.Uint64Flag{
+		Name:    "max-pending-tx-total",
+		Usage:   "The maximum number of pending transactions across all chains. 0 for no limit.",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_PENDING_TX_TOTAL"),
+	}
 	SubSafetyMarginFlag = &cli.Uint64Flag{
 		Name: "sub-safety-margin",
 		Usage: "The batcher tx submission safety margin (in #L1-blocks) to subtract " +
@@ -87,6 +112,13 @@ var (
 		Value:   1,
 		EnvVars: prefixEnvVars("TARGET_NUM_FRAMES"),
 	}
+	TargetNumBlobsFlag = &cli.IntFlag{
+		Name: "target-num-blobs",
+		Usage: "The target number of blobs per blob tx, which are filled with the frames of multiple channels if needed. " +
+			"Useful with small channels on high-throughput chains, to amortize the fixed L1 costs of blob txs. " +
+			"Only relevant for Blob DA. Default is 0 - a blob tx only contains frames of a single channel.",
+		EnvVars: prefixEnvVars("TARGET_NUM_BLOBS"),
+	}
 	ApproxComprRatioFlag = &cli.Float64Flag{
 		Name:    "approx-compr-ratio",
 		Usage:   "The approximate compression ratio (<= 1.0). Only relevant for ratio compressor.",
@@ -149,6 +181,27 @@ var (
 		Value:   0,
 		EnvVars: prefixEnvVars("CHECK_RECENT_TXS_DEPTH"),
 	}
+	ResumeScanDepthFlag = &cli.Uint64Flag{
+		Name: "resume-scan-depth",
+		Usage: "Number of recent L1 blocks the batcher scans during startup for its batch txs. Batch submission " +
+			"resumes after the L2 blocks of which the data is already included on L1, instead of at the L2 safe head, " +
+			"to avoid submitting data twice after a restart. Blob txs are read from the l1-beacon, which is required " +
+			"unless the data availability type is calldata. 0 disables it.",
+		Value:   0,
+		EnvVars: prefixEnvVars("RESUME_SCAN_DEPTH"),
+	}
+	L1BeaconFlag = &cli.StringFlag{
+		Name:    "l1-beacon",
+		Usage:   "HTTP provider URL for the L1 beacon node, to read the blobs of the batch txs scanned at startup, see resume-scan-depth.",
+		EnvVars: prefixEnvVars("L1_BEACON"),
+	}
+	StateFileFlag = &cli.StringFlag{
+		Name: "state-file",
+		Usage: "Path of the file the L2 blocks pending submission are written to on shutdown. They are loaded from it " +
+			"at the next start, if they are still canonical, instead of fetching them from the L2 node again. " +
+			"The file is gzipped if the path ends in .gz. Disabled if empty.",
+		EnvVars: prefixEnvVars("STATE_FILE"),
+	}
 	WaitNodeSyncFlag = &cli.BoolFlag{
 		Name: "wait-node-sync",
 		Usage: "Indicates if, during startup, the batcher should wait for a recent batcher tx on L1 to " +
@@ -156,6 +209,39 @@ var (
 		Value:   false,
 		EnvVars: prefixEnvVars("WAIT_NODE_SYNC"),
 	}
+	ThrottleThresholdFlag = &cli.Uint64Flag{
+		Name: "throttle-threshold",
+		Usage: "Estimated DA size in bytes of the L2 blocks pending submission above which the batcher " +
+			"instructs the sequencer execution engine to limit the DA size of the transactions and blocks it builds. 0 disables throttling. " +
+			"Requires the engine to support the miner_setMaxDASize RPC method.",
+		Value:   0,
+		EnvVars: prefixEnvVars("THROTTLE_THRESHOLD"),
+	}
+	ThrottleTxSizeFlag = &cli.Uint64Flag{
+		Name:    "throttle-tx-size",
+		Usage:   "The maximum estimated DA size of a transaction included by the sequencer while throttling.",
+		Value:   300,
+		EnvVars: prefixEnvVars("THROTTLE_TX_SIZE"),
+	}
+	ThrottleBlockSizeFlag = &cli.Uint64Flag{
+		Name:    "throttle-block-size",
+		Usage:   "The maximum estimated DA size of a block built by the sequencer while throttling.",
+		Value:   21_000,
+		EnvVars: prefixEnvVars("THROTTLE_BLOCK_SIZE"),
+	}
+	MaxSafeLagFlag = &cli.DurationFlag{
+		Name: "max-safe-lag",
+		Usage: "The SLO of the time between the L2 unsafe head and the L2 safe head derived from the submitted data. " +
+			"While the projected safe lag approaches it, the batcher closes channels early and bumps fees faster. 0 disables it.",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_SAFE_LAG"),
+	}
+	SafeLagEscalationRatioFlag = &cli.Float64Flag{
+		Name:    "safe-lag-escalation-ratio",
+		Usage:   "The fraction of the max safe lag the projected safe lag has to reach for the batcher to escalate data submission.",
+		Value:   0.75,
+		EnvVars: prefixEnvVars("SAFE_LAG_ESCALATION_RATIO"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -167,15 +253,23 @@ var requiredFlags = []cli.Flag{
 }
 
 var optionalFlags = []cli.Flag{
+	SequencerRollupRpcFlag,
+	AdditionalL2EthRpcsFlag,
+	AdditionalRollupRpcsFlag,
 	WaitNodeSyncFlag,
 	CheckRecentTxsDepthFlag,
+	ResumeScanDepthFlag,
+	L1BeaconFlag,
+	StateFileFlag,
 	SubSafetyMarginFlag,
 	PollIntervalFlag,
 	MaxPendingTransactionsFlag,
+	MaxPendingTransactionsTotalFlag,
 	MaxChannelDurationFlag,
 	MaxL1TxSizeBytesFlag,
 	MaxBlocksPerSpanBatch,
 	TargetNumFramesFlag,
+	TargetNumBlobsFlag,
 	ApproxComprRatioFlag,
 	CompressorFlag,
 	StoppedFlag,
@@ -184,6 +278,11 @@ var optionalFlags = []cli.Flag{
 	DataAvailabilityTypeFlag,
 	ActiveSequencerCheckDurationFlag,
 	CompressionAlgoFlag,
+	ThrottleThresholdFlag,
+	ThrottleTxSizeFlag,
+	ThrottleBlockSizeFlag,
+	MaxSafeLagFlag,
+	SafeLagEscalationRatioFlag,
 }
 
 func init() {
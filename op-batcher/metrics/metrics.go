@@ -1,13 +1,14 @@
 package metrics
 
 import (
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
@@ -30,7 +31,7 @@ type Metricer interface {
 
 	opmetrics.RPCMetricer
 
-	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
+	StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer
 
 	RecordLatestL1Block(l1ref eth.L1BlockRef)
 	RecordL2BlocksLoaded(l2ref eth.L2BlockRef)
@@ -39,6 +40,7 @@ type Metricer interface {
 	RecordL2BlockInPendingQueue(block *types.Block)
 	RecordL2BlockInChannel(block *types.Block)
 	RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
+	RecordChannelCompressionRatio(algo derive.CompressionAlgo, inputBytes int, outputComprBytes int)
 	RecordChannelFullySubmitted(id derive.ChannelID)
 	RecordChannelTimedOut(id derive.ChannelID)
 
@@ -48,6 +50,10 @@ type Metricer interface {
 
 	RecordBlobUsedBytes(num int)
 
+	RecordThrottleParams(maxTxSize, maxBlockSize uint64)
+
+	RecordSafeLag(lag, projected time.Duration, escalated bool)
+
 	Document() []opmetrics.DocumentedMetric
 }
 
@@ -77,12 +83,20 @@ type Metrics struct {
 	channelClosedReason     prometheus.Gauge
 	channelNumFrames        prometheus.Gauge
 	channelComprRatio       prometheus.Histogram
+	channelComprRatioByAlgo *prometheus.HistogramVec
 	channelInputBytesTotal  prometheus.Counter
 	channelOutputBytesTotal prometheus.Counter
 
 	batcherTxEvs opmetrics.EventVec
 
 	blobUsedBytes prometheus.Histogram
+
+	throttleMaxTxSize    prometheus.Gauge
+	throttleMaxBlockSize prometheus.Gauge
+
+	safeLag          prometheus.Gauge
+	safeLagProjected prometheus.Gauge
+	safeLagEscalated prometheus.Gauge
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -94,9 +108,16 @@ func NewMetrics(procName string) *Metrics {
 	if procName == "" {
 		procName = "default"
 	}
-	ns := Namespace + "_" + procName
+	return newMetrics(Namespace+"_"+procName, opmetrics.NewRegistry())
+}
+
+// ChainMetrics creates the metrics of an additional target chain of the batcher,
+// namespaced by chain ID and registered in the same registry.
+func (m *Metrics) ChainMetrics(chainID uint64) *Metrics {
+	return newMetrics(fmt.Sprintf("%s_chain_%d", m.ns, chainID), m.registry)
+}
 
-	registry := opmetrics.NewRegistry()
+func newMetrics(ns string, registry *prometheus.Registry) *Metrics {
 	factory := opmetrics.With(registry)
 
 	return &Metrics{
@@ -175,6 +196,12 @@ func NewMetrics(procName string) *Metrics {
 			Help:      "Compression ratios of closed channel.",
 			Buckets:   append([]float64{0.1, 0.2}, prometheus.LinearBuckets(0.3, 0.05, 14)...),
 		}),
+		channelComprRatioByAlgo: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_compr_ratio_by_algo",
+			Help:      "Compression ratios of closed channel, by compression algorithm.",
+			Buckets:   append([]float64{0.1, 0.2}, prometheus.LinearBuckets(0.3, 0.05, 14)...),
+		}, []string{"algo"}),
 		channelInputBytesTotal: factory.NewCounter(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      "input_bytes_total",
@@ -193,6 +220,32 @@ func NewMetrics(procName string) *Metrics {
 		}),
 
 		batcherTxEvs: opmetrics.NewEventVec(factory, ns, "", "batcher_tx", "BatcherTx", []string{"stage"}),
+
+		throttleMaxTxSize: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "throttle_max_tx_size",
+			Help:      "Maximum DA size of a transaction the sequencer is throttled to, 0 if not throttled.",
+		}),
+		throttleMaxBlockSize: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "throttle_max_block_size",
+			Help:      "Maximum DA size of a block the sequencer is throttled to, 0 if not throttled.",
+		}),
+		safeLag: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "safe_lag_seconds",
+			Help:      "Time between the L2 unsafe head and the L2 safe head.",
+		}),
+		safeLagProjected: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "safe_lag_projected_seconds",
+			Help:      "Time between the L2 unsafe head and the L2 safe head, projected to the next poll.",
+		}),
+		safeLagEscalated: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "safe_lag_escalated",
+			Help:      "1 if the batcher escalated data submission because the projected safe lag approaches the max safe lag, 0 otherwise.",
+		}),
 	}
 }
 
@@ -204,8 +257,8 @@ func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
 
-func (m *Metrics) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
-	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+func (m *Metrics) StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer {
+	return opmetrics.LaunchAccountMonitor(l, m.registry, m.ns, client, account)
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
@@ -277,6 +330,15 @@ func (m *Metrics) RecordChannelClosed(id derive.ChannelID, numPendingBlocks int,
 	m.channelClosedReason.Set(float64(ClosedReasonToNum(reason)))
 }
 
+// RecordChannelCompressionRatio records the compression ratio of a closed channel, by its compression algorithm,
+// to compare the algorithms on the same chain data.
+func (m *Metrics) RecordChannelCompressionRatio(algo derive.CompressionAlgo, inputBytes int, outputComprBytes int) {
+	if inputBytes == 0 {
+		return
+	}
+	m.channelComprRatioByAlgo.WithLabelValues(algo.String()).Observe(float64(outputComprBytes) / float64(inputBytes))
+}
+
 func (m *Metrics) RecordL2BlockInPendingQueue(block *types.Block) {
 	size := float64(estimateBatchSize(block))
 	m.pendingBlocksBytesTotal.Add(size)
@@ -318,6 +380,21 @@ func (m *Metrics) RecordBlobUsedBytes(num int) {
 	m.blobUsedBytes.Observe(float64(num))
 }
 
+func (m *Metrics) RecordThrottleParams(maxTxSize, maxBlockSize uint64) {
+	m.throttleMaxTxSize.Set(float64(maxTxSize))
+	m.throttleMaxBlockSize.Set(float64(maxBlockSize))
+}
+
+func (m *Metrics) RecordSafeLag(lag, projected time.Duration, escalated bool) {
+	m.safeLag.Set(lag.Seconds())
+	m.safeLagProjected.Set(projected.Seconds())
+	if escalated {
+		m.safeLagEscalated.Set(1)
+	} else {
+		m.safeLagEscalated.Set(0)
+	}
+}
+
 // estimateBatchSize estimates the size of the batch
 func estimateBatchSize(block *types.Block) uint64 {
 	size := uint64(70) // estimated overhead of batch metadata
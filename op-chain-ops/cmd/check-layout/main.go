@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/storagelayout"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func main() {
+	color := isatty.IsTerminal(os.Stderr.Fd())
+	oplog.SetGlobalLogHandler(log.NewTerminalHandler(os.Stderr, color))
+
+	app := &cli.App{
+		Name:        "check-layout",
+		Usage:       "Check that the storage layout of a new contract version is compatible with the old version",
+		Description: "Compares two storage layouts, each either a storage layout snapshot or a forge artifact compiled with the storageLayout extra output, and reports changes that corrupt the existing storage behind a proxy.",
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "old",
+				Required: true,
+				Usage:    "File system path to the storage layout of the deployed contract version",
+			},
+			&cli.PathFlag{
+				Name:     "new",
+				Required: true,
+				Usage:    "File system path to the storage layout of the new contract version",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error checking storage layout", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	prev, err := storagelayout.Load(ctx.Path("old"))
+	if err != nil {
+		return err
+	}
+	next, err := storagelayout.Load(ctx.Path("new"))
+	if err != nil {
+		return err
+	}
+
+	changes := storagelayout.Diff(prev, next)
+	for _, change := range changes {
+		if change.Kind.Incompatible() {
+			log.Error("Incompatible storage layout change", "change", change)
+		} else {
+			log.Info("Compatible storage layout change", "change", change)
+		}
+	}
+	if incompatible := storagelayout.Incompatible(changes); len(incompatible) > 0 {
+		return fmt.Errorf("found %d incompatible storage layout changes", len(incompatible))
+	}
+	log.Info("Storage layouts are compatible", "changes", len(changes))
+	return nil
+}
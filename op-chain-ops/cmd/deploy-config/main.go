@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func main() {
+	color := isatty.IsTerminal(os.Stderr.Fd())
+	oplog.SetGlobalLogHandler(log.NewTerminalHandler(os.Stderr, color))
+
+	app := &cli.App{
+		Name:  "deploy-config",
+		Usage: "Tools for deploy configs",
+		Commands: []*cli.Command{
+			{
+				Name:  "migrate",
+				Usage: "Migrate a deploy config to the latest version",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "path",
+						Required: true,
+						Usage:    "File system path to the deploy config",
+					},
+					&cli.StringFlag{
+						Name:  "out",
+						Usage: "File system path to write the migrated deploy config to. Defaults to overwriting the input",
+					},
+				},
+				Action: migrate,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error running deploy-config", "err", err)
+	}
+}
+
+func migrate(ctx *cli.Context) error {
+	path := ctx.String("path")
+	out := ctx.String("out")
+	if out == "" {
+		out = path
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot read deploy config: %w", err)
+	}
+	migrated, version, err := genesis.MigrateDeployConfig(log.Root(), data)
+	if err != nil {
+		return err
+	}
+	if version == genesis.DeployConfigVersion && out == path {
+		log.Info("Deploy config is up to date", "path", path, "version", version)
+		return nil
+	}
+
+	// Check the migrated config can be loaded, before writing it
+	dec := json.NewDecoder(bytes.NewReader(migrated))
+	dec.DisallowUnknownFields()
+	var config genesis.DeployConfig
+	if err := dec.Decode(&config); err != nil {
+		return fmt.Errorf("cannot unmarshal migrated deploy config: %w", err)
+	}
+
+	if err := os.WriteFile(out, append(bytes.TrimRight(migrated, "\n"), '\n'), 0o644); err != nil {
+		return fmt.Errorf("cannot write migrated deploy config: %w", err)
+	}
+	log.Info("Migrated deploy config", "path", path, "out", out, "from", version, "to", genesis.DeployConfigVersion)
+	return nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/governance"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	superchainConfigFlag = &cli.StringFlag{
+		Name:     "superchain-config",
+		Usage:    "Address of the SuperchainConfig proxy.",
+		Required: true,
+	}
+	identifierFlag = &cli.StringFlag{
+		Name:  "identifier",
+		Usage: "Identifier of the pause, emitted in the Paused event, e.g. a link to the incident.",
+	}
+	portalFlag = &cli.StringFlag{
+		Name:     "portal",
+		Usage:    "Address of the OptimismPortal proxy.",
+		Required: true,
+	}
+	gameFlag = &cli.StringSliceFlag{
+		Name:     "game",
+		Usage:    "Address of a dispute game to blacklist. May be repeated.",
+		Required: true,
+	}
+	gameTypeFlag = &cli.UintFlag{
+		Name:     "game-type",
+		Usage:    "Game type to respect.",
+		Required: true,
+	}
+
+	fromFlag = &cli.StringFlag{
+		Name:     "from",
+		Usage:    "Address of the Guardian sending the transactions, or of the Guardian Safe executing the batch.",
+		Required: true,
+	}
+	safeFlag = &cli.BoolFlag{
+		Name:  "safe",
+		Usage: "Output a Safe batch instead of unsigned transactions.",
+	}
+	l1RPCFlag = &cli.StringFlag{
+		Name:  "l1-rpc-url",
+		Usage: "L1 RPC URL. If set, the transactions are simulated before they are written.",
+	}
+	chainIDFlag = &cli.Uint64Flag{
+		Name:  "chain-id",
+		Usage: "Chain ID the Safe batch is executed on. Defaults to the chain ID of the L1 RPC if set.",
+		Value: 1,
+	}
+	outFlag = &cli.PathFlag{
+		Name:  "out",
+		Usage: "Path to write the transactions or Safe batch to. Defaults to stdout.",
+	}
+)
+
+var outputFlags = []cli.Flag{fromFlag, safeFlag, l1RPCFlag, chainIDFlag, outFlag}
+
+func main() {
+	app := &cli.App{
+		Name:        "governance",
+		Usage:       "Encode Guardian operations into ready-to-sign transactions or Safe batches",
+		Description: "Encodes common incident response operations of the Guardian, simulates them against L1 if an RPC is given, and summarizes them for review before signing.",
+		Commands: []*cli.Command{
+			{
+				Name:   "pause",
+				Usage:  "Pause withdrawals with the SuperchainConfig",
+				Flags:  append([]cli.Flag{superchainConfigFlag, identifierFlag}, outputFlags...),
+				Action: withActions(pauseActions),
+			},
+			{
+				Name:   "unpause",
+				Usage:  "Unpause withdrawals with the SuperchainConfig",
+				Flags:  append([]cli.Flag{superchainConfigFlag}, outputFlags...),
+				Action: withActions(unpauseActions),
+			},
+			{
+				Name:   "blacklist-game",
+				Usage:  "Blacklist dispute games in the OptimismPortal",
+				Flags:  append([]cli.Flag{portalFlag, gameFlag}, outputFlags...),
+				Action: withActions(blacklistActions),
+			},
+			{
+				Name:   "set-respected-game-type",
+				Usage:  "Set the respected game type of the OptimismPortal",
+				Flags:  append([]cli.Flag{portalFlag, gameTypeFlag}, outputFlags...),
+				Action: withActions(respectedGameTypeActions),
+			},
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error governance", "err", err)
+	}
+}
+
+func pauseActions(ctx *cli.Context) ([]governance.Action, error) {
+	superchainConfig, err := parseAddress(ctx, superchainConfigFlag.Name)
+	if err != nil {
+		return nil, err
+	}
+	action, err := governance.Pause(superchainConfig, ctx.String(identifierFlag.Name))
+	return []governance.Action{action}, err
+}
+
+func unpauseActions(ctx *cli.Context) ([]governance.Action, error) {
+	superchainConfig, err := parseAddress(ctx, superchainConfigFlag.Name)
+	if err != nil {
+		return nil, err
+	}
+	action, err := governance.Unpause(superchainConfig)
+	return []governance.Action{action}, err
+}
+
+func blacklistActions(ctx *cli.Context) ([]governance.Action, error) {
+	portal, err := parseAddress(ctx, portalFlag.Name)
+	if err != nil {
+		return nil, err
+	}
+	var actions []governance.Action
+	for _, gameStr := range ctx.StringSlice(gameFlag.Name) {
+		game, err := opservice.ParseAddress(gameStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v %q: %w", gameFlag.Name, gameStr, err)
+		}
+		action, err := governance.BlacklistDisputeGame(portal, game)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func respectedGameTypeActions(ctx *cli.Context) ([]governance.Action, error) {
+	portal, err := parseAddress(ctx, portalFlag.Name)
+	if err != nil {
+		return nil, err
+	}
+	action, err := governance.SetRespectedGameType(portal, uint32(ctx.Uint(gameTypeFlag.Name)))
+	return []governance.Action{action}, err
+}
+
+func withActions(fn func(ctx *cli.Context) ([]governance.Action, error)) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		logger := oplog.NewLogger(os.Stderr, oplog.DefaultCLIConfig())
+		oplog.SetGlobalLogHandler(logger.Handler())
+
+		actions, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		from, err := parseAddress(ctx, fromFlag.Name)
+		if err != nil {
+			return err
+		}
+		chainID := ctx.Uint64(chainIDFlag.Name)
+
+		if ctx.IsSet(l1RPCFlag.Name) {
+			cl, err := ethclient.DialContext(ctx.Context, ctx.String(l1RPCFlag.Name))
+			if err != nil {
+				return fmt.Errorf("failed to dial L1 RPC: %w", err)
+			}
+			defer cl.Close()
+			if !ctx.IsSet(chainIDFlag.Name) {
+				id, err := cl.ChainID(ctx.Context)
+				if err != nil {
+					return fmt.Errorf("failed to get L1 chain ID: %w", err)
+				}
+				chainID = id.Uint64()
+			}
+			failed := false
+			for _, result := range governance.Simulate(ctx.Context, cl, from, actions) {
+				if result.Success() {
+					logger.Info("Simulation succeeded", "action", result.Summary, "current", result.State)
+				} else {
+					logger.Error("Simulation failed", "action", result.Summary, "current", result.State, "err", result.Error)
+					failed = true
+				}
+			}
+			if failed {
+				return errors.New("simulation failed, not writing transactions")
+			}
+		} else {
+			for _, action := range actions {
+				logger.Info("Encoded action, not simulated", "action", action.Summary)
+			}
+		}
+
+		var result any
+		if ctx.Bool(safeFlag.Name) {
+			result = governance.SafeBatch(chainID, "Guardian: "+ctx.Command.Name, actions)
+		} else {
+			result = governance.Transactions(from, actions)
+		}
+		out := os.Stdout
+		if ctx.IsSet(outFlag.Name) {
+			f, err := os.Create(ctx.Path(outFlag.Name))
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			defer f.Close()
+			out = f
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+}
+
+func parseAddress(ctx *cli.Context, name string) (common.Address, error) {
+	addr, err := opservice.ParseAddress(ctx.String(name))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid %v: %w", name, err)
+	}
+	return addr, nil
+}
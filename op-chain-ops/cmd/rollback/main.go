@@ -0,0 +1,223 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/rollback"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/solc"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	preStateFlag = &cli.PathFlag{
+		Name:     "pre-state",
+		Usage:    "Path to the pre-upgrade state snapshot, in forge allocs format.",
+		Required: true,
+	}
+	postStateFlag = &cli.PathFlag{
+		Name:  "post-state",
+		Usage: "Path to the post-upgrade state snapshot, in forge allocs format. Either this or --tx-hash must be set.",
+	}
+	txHashFlag = &cli.StringSliceFlag{
+		Name:  "tx-hash",
+		Usage: "Hash of an upgrade transaction, in execution order. The storage changes are traced with debug_traceTransaction.",
+	}
+	l1RPCFlag = &cli.StringFlag{
+		Name:  "l1-rpc-url",
+		Usage: "L1 RPC URL with the debug namespace enabled, used to trace the upgrade transactions.",
+	}
+	upgradeBundleFlag = &cli.PathFlag{
+		Name:  "upgrade-bundle",
+		Usage: "Path to the Safe batch of the upgrade. If set, only the proxies upgraded by the bundle are rolled back.",
+	}
+	proxyAdminFlag = &cli.StringFlag{
+		Name:     "proxy-admin",
+		Usage:    "Address of the ProxyAdmin of the upgraded proxies.",
+		Required: true,
+	}
+	storageSetterFlag = &cli.StringFlag{
+		Name:  "storage-setter",
+		Usage: "Address of a deployed StorageSetter implementation. Required if any storage other than the implementation changed.",
+	}
+	contractFlag = &cli.StringSliceFlag{
+		Name:  "contract",
+		Usage: "Contract name of a proxy, as <address>=<name>, used to label its storage slots from the storage layout.",
+	}
+	storageLayoutsFlag = &cli.PathFlag{
+		Name:  "storage-layouts",
+		Usage: "Directory of contract storage layout snapshots.",
+		Value: "packages/contracts-bedrock/snapshots/storageLayout",
+	}
+	chainIDFlag = &cli.Uint64Flag{
+		Name:  "chain-id",
+		Usage: "Chain ID the bundle is executed on.",
+		Value: 1,
+	}
+	outFlag = &cli.PathFlag{
+		Name:  "out",
+		Usage: "Path to write the rollback bundle and review to. Defaults to stdout.",
+	}
+)
+
+func main() {
+	app := &cli.App{
+		Name:        "rollback",
+		Usage:       "Generate a Safe batch reverting the state changes of a failed upgrade",
+		Description: "Compares the pre-upgrade state snapshot against the post-upgrade state and generates the inverse operations, restoring proxy implementations and storage values.",
+		Flags: []cli.Flag{
+			preStateFlag,
+			postStateFlag,
+			txHashFlag,
+			l1RPCFlag,
+			upgradeBundleFlag,
+			proxyAdminFlag,
+			storageSetterFlag,
+			contractFlag,
+			storageLayoutsFlag,
+			chainIDFlag,
+			outFlag,
+		},
+		Action: rollbackApp,
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error rollback", "err", err)
+	}
+}
+
+func rollbackApp(ctx *cli.Context) error {
+	logger := oplog.NewLogger(os.Stderr, oplog.DefaultCLIConfig())
+	oplog.SetGlobalLogHandler(logger.Handler())
+
+	proxyAdmin, err := opservice.ParseAddress(ctx.String(proxyAdminFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid %v: %w", proxyAdminFlag.Name, err)
+	}
+	cfg := rollback.Config{
+		ChainID:    ctx.Uint64(chainIDFlag.Name),
+		ProxyAdmin: proxyAdmin,
+		Contracts:  make(map[common.Address]string),
+		Layouts:    make(map[string][]solc.StorageLayoutEntry),
+	}
+	if ctx.IsSet(storageSetterFlag.Name) {
+		cfg.StorageSetter, err = opservice.ParseAddress(ctx.String(storageSetterFlag.Name))
+		if err != nil {
+			return fmt.Errorf("invalid %v: %w", storageSetterFlag.Name, err)
+		}
+	}
+	for _, contract := range ctx.StringSlice(contractFlag.Name) {
+		addrStr, name, ok := strings.Cut(contract, "=")
+		if !ok {
+			return fmt.Errorf("invalid %v %q, expected <address>=<name>", contractFlag.Name, contract)
+		}
+		addr, err := opservice.ParseAddress(addrStr)
+		if err != nil {
+			return fmt.Errorf("invalid %v %q: %w", contractFlag.Name, contract, err)
+		}
+		layout, err := rollback.LoadStorageLayout(ctx.Path(storageLayoutsFlag.Name), name)
+		if err != nil {
+			return err
+		}
+		cfg.Contracts[addr] = name
+		cfg.Layouts[name] = layout
+	}
+
+	pre, err := foundry.LoadForgeAllocs(ctx.Path(preStateFlag.Name))
+	if err != nil {
+		return err
+	}
+	var proxies []common.Address
+	if ctx.IsSet(upgradeBundleFlag.Name) {
+		bundle, err := safe.LoadBatch(ctx.Path(upgradeBundleFlag.Name))
+		if err != nil {
+			return err
+		}
+		proxies, err = rollback.BundleProxies(bundle, proxyAdmin)
+		if err != nil {
+			return err
+		}
+		logger.Info("Loaded upgrade bundle", "transactions", len(bundle.Transactions), "proxies", proxies)
+	}
+
+	var changes []rollback.StorageChange
+	switch {
+	case ctx.IsSet(postStateFlag.Name):
+		post, err := foundry.LoadForgeAllocs(ctx.Path(postStateFlag.Name))
+		if err != nil {
+			return err
+		}
+		changes = rollback.DiffAllocs(pre, post, proxies)
+	case ctx.IsSet(txHashFlag.Name):
+		if !ctx.IsSet(l1RPCFlag.Name) {
+			return fmt.Errorf("%v is required to trace upgrade transactions", l1RPCFlag.Name)
+		}
+		var txHashes []common.Hash
+		for _, h := range ctx.StringSlice(txHashFlag.Name) {
+			txHashes = append(txHashes, common.HexToHash(h))
+		}
+		cl, err := rpc.DialContext(ctx.Context, ctx.String(l1RPCFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 RPC: %w", err)
+		}
+		defer cl.Close()
+		changes, err = rollback.TraceChanges(ctx.Context, cl, pre, txHashes)
+		if err != nil {
+			return err
+		}
+		if len(proxies) > 0 {
+			changes = filterChanges(changes, proxies)
+		}
+	default:
+		return errors.New("either a post-upgrade state or upgrade transaction hashes must be specified")
+	}
+
+	result, err := rollback.Build(cfg, pre, changes)
+	if err != nil {
+		return err
+	}
+	for _, item := range result.Review {
+		logger.Info("Restoring slot", "contract", item.Contract, "address", item.Address, "slot", item.Slot,
+			"labels", item.Labels, "current", item.Post, "restored", item.Pre)
+	}
+	for _, item := range result.UnlabelledSlots() {
+		logger.Warn("Slot is not declared in the storage layout, review manually", "address", item.Address, "slot", item.Slot)
+	}
+
+	out := os.Stdout
+	if ctx.IsSet(outFlag.Name) {
+		f, err := os.Create(ctx.Path(outFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func filterChanges(changes []rollback.StorageChange, addrs []common.Address) []rollback.StorageChange {
+	allowed := make(map[common.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		allowed[addr] = true
+	}
+	var out []rollback.StorageChange
+	for _, change := range changes {
+		if allowed[change.Address] {
+			out = append(out, change)
+		}
+	}
+	return out
+}
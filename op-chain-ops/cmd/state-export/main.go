@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	l2RPCFlag = &cli.StringFlag{
+		Name:     "l2-rpc-url",
+		Usage:    "L2 archive RPC URL with the debug namespace enabled. Storage keys are enumerated with debug_storageRangeAt, which requires the node to record preimages.",
+		Required: true,
+	}
+	blockFlag = &cli.Uint64Flag{
+		Name:     "block",
+		Usage:    "Block number to export the post-state of. The next block must be available.",
+		Required: true,
+	}
+	addressFlag = &cli.StringSliceFlag{
+		Name:  "address",
+		Usage: "Address of an account to export.",
+	}
+	predeploysFlag = &cli.BoolFlag{
+		Name:  "predeploys",
+		Usage: "Export all predeploys, including the implementations of the proxied predeploys.",
+	}
+	outFlag = &cli.PathFlag{
+		Name:  "out",
+		Usage: "Path to write the state to, in forge allocs format, or - for stdout.",
+		Value: "-",
+	}
+)
+
+func main() {
+	app := &cli.App{
+		Name:        "state-export",
+		Usage:       "Export the state of accounts of a live chain in forge allocs format",
+		Description: "Exports the balance, nonce, code and full storage of the selected accounts at a block, e.g. to create the genesis of a shadow fork from mainnet state.",
+		Flags: []cli.Flag{
+			l2RPCFlag,
+			blockFlag,
+			addressFlag,
+			predeploysFlag,
+			outFlag,
+		},
+		Action: exportApp,
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error exporting state", "err", err)
+	}
+}
+
+func exportApp(ctx *cli.Context) error {
+	logger := oplog.NewLogger(os.Stderr, oplog.DefaultCLIConfig())
+	oplog.SetGlobalLogHandler(logger.Handler())
+
+	var addrs []common.Address
+	for _, addrStr := range ctx.StringSlice(addressFlag.Name) {
+		addr, err := opservice.ParseAddress(addrStr)
+		if err != nil {
+			return fmt.Errorf("invalid %v %q: %w", addressFlag.Name, addrStr, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	var proxies []common.Address
+	if ctx.Bool(predeploysFlag.Name) {
+		for _, p := range predeploys.All() {
+			addrs = append(addrs, p.Address)
+			if !p.ProxyDisabled {
+				proxies = append(proxies, p.Address)
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return errors.New("no accounts to export, specify addresses or export the predeploys")
+	}
+	slices.SortFunc(addrs, func(a, b common.Address) int { return a.Cmp(b) })
+	addrs = slices.Compact(addrs)
+
+	cl, err := rpc.DialContext(ctx.Context, ctx.String(l2RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer cl.Close()
+	exporter, err := foundry.NewStateExporter(ctx.Context, cl, ctx.Uint64(blockFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger.Info("Exporting state", "block", exporter.Block(), "accounts", len(addrs))
+
+	var allocs foundry.ForgeAllocs
+	if err := allocs.FromRPC(ctx.Context, exporter, addrs); err != nil {
+		return err
+	}
+	// The predeploy proxies are only useful with the code of their implementations.
+	for _, proxy := range proxies {
+		impl := common.BytesToAddress(allocs.Accounts[proxy].Storage[genesis.ImplementationSlot].Bytes())
+		if impl == (common.Address{}) {
+			continue
+		}
+		if _, ok := allocs.Accounts[impl]; ok {
+			continue
+		}
+		acc, err := exporter.Account(ctx.Context, impl)
+		if err != nil {
+			return fmt.Errorf("failed to export implementation of %s: %w", proxy, err)
+		}
+		allocs.Accounts[impl] = acc
+	}
+	logger.Info("Exported state", "accounts", len(allocs.Accounts))
+
+	return jsonutil.WriteJSON(allocs.Accounts, ioutil.ToStdOutOrFileOrNoop(ctx.Path(outFlag.Name), 0o644))
+}
@@ -0,0 +1,137 @@
+package foundry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// storageRangePageSize is the number of storage slots fetched per debug_storageRangeAt call.
+const storageRangePageSize = 1024
+
+// StateRPC is the minimal RPC client interface to export state from a live chain.
+type StateRPC interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+var _ StateRPC = (*rpc.Client)(nil)
+
+// StateExporter exports accounts from an archive node, as they are after a given block.
+type StateExporter struct {
+	rpc StateRPC
+	// block is the block to export the post-state of.
+	block common.Hash
+	// next is the child of the block. debug_storageRangeAt serves the state before a transaction,
+	// so the storage is read at the first transaction of the next block.
+	next common.Hash
+}
+
+type rpcHeader struct {
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+}
+
+// NewStateExporter creates an exporter of the state after the given block.
+// The node must serve the debug namespace, have the state of the block available,
+// and have recorded the preimages of the storage keys, to enumerate the storage.
+// The block must have a child block, since the storage is read at the start of the child block.
+func NewStateExporter(ctx context.Context, cl StateRPC, num uint64) (*StateExporter, error) {
+	var next *rpcHeader
+	if err := cl.CallContext(ctx, &next, "eth_getBlockByNumber", hexutil.Uint64(num+1), false); err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", num+1, err)
+	}
+	if next == nil {
+		return nil, fmt.Errorf("block %d not found, the state can only be exported once the next block is available", num+1)
+	}
+	return &StateExporter{rpc: cl, block: next.ParentHash, next: next.Hash}, nil
+}
+
+// Block returns the hash of the block the state is exported at.
+func (e *StateExporter) Block() common.Hash {
+	return e.block
+}
+
+// Account exports the balance, nonce, code and full storage of the account.
+func (e *StateExporter) Account(ctx context.Context, addr common.Address) (types.Account, error) {
+	at := rpc.BlockNumberOrHashWithHash(e.block, true)
+	var balance hexutil.Big
+	if err := e.rpc.CallContext(ctx, &balance, "eth_getBalance", addr, at); err != nil {
+		return types.Account{}, fmt.Errorf("failed to fetch balance of %s: %w", addr, err)
+	}
+	var nonce hexutil.Uint64
+	if err := e.rpc.CallContext(ctx, &nonce, "eth_getTransactionCount", addr, at); err != nil {
+		return types.Account{}, fmt.Errorf("failed to fetch nonce of %s: %w", addr, err)
+	}
+	var code hexutil.Bytes
+	if err := e.rpc.CallContext(ctx, &code, "eth_getCode", addr, at); err != nil {
+		return types.Account{}, fmt.Errorf("failed to fetch code of %s: %w", addr, err)
+	}
+	storage, err := e.Storage(ctx, addr)
+	if err != nil {
+		return types.Account{}, err
+	}
+	acc := types.Account{
+		Balance: (*big.Int)(&balance),
+		Nonce:   uint64(nonce),
+		Storage: storage,
+	}
+	if len(code) > 0 {
+		acc.Code = code
+	}
+	return acc, nil
+}
+
+type storageRangeResult struct {
+	Storage map[common.Hash]struct {
+		Key   *common.Hash `json:"key"`
+		Value common.Hash  `json:"value"`
+	} `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"`
+}
+
+// Storage enumerates all non-zero storage slots of the account, or returns nil if it has no storage.
+func (e *StateExporter) Storage(ctx context.Context, addr common.Address) (map[common.Hash]common.Hash, error) {
+	var storage map[common.Hash]common.Hash
+	start := hexutil.Bytes{}
+	for {
+		var res storageRangeResult
+		if err := e.rpc.CallContext(ctx, &res, "debug_storageRangeAt",
+			rpc.BlockNumberOrHashWithHash(e.next, true), 0, addr, start, storageRangePageSize); err != nil {
+			return nil, fmt.Errorf("failed to fetch storage of %s: %w", addr, err)
+		}
+		for hashedKey, entry := range res.Storage {
+			if entry.Key == nil {
+				return nil, fmt.Errorf("missing preimage of storage key %s of %s", hashedKey, addr)
+			}
+			if storage == nil {
+				storage = make(map[common.Hash]common.Hash)
+			}
+			storage[*entry.Key] = entry.Value
+		}
+		if res.NextKey == nil {
+			return storage, nil
+		}
+		start = res.NextKey[:]
+	}
+}
+
+// FromRPC exports the given accounts with the exporter. Any previous allocs contents are removed.
+func (f *ForgeAllocs) FromRPC(ctx context.Context, e *StateExporter, addrs []common.Address) error {
+	f.Accounts = make(types.GenesisAlloc, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := f.Accounts[addr]; ok {
+			continue
+		}
+		acc, err := e.Account(ctx, addr)
+		if err != nil {
+			return err
+		}
+		f.Accounts[addr] = acc
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package foundry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// stubStateRPC serves the state of a single block, with a page size of one storage slot.
+type stubStateRPC struct {
+	block, next common.Hash
+	accounts    types.GenesisAlloc
+}
+
+func (s *stubStateRPC) CallContext(_ context.Context, result any, method string, args ...any) error {
+	var res any
+	switch method {
+	case "eth_getBlockByNumber":
+		res = map[string]any{"hash": s.next, "parentHash": s.block}
+	case "eth_getBalance", "eth_getTransactionCount", "eth_getCode":
+		acc := s.accounts[args[0].(common.Address)]
+		switch method {
+		case "eth_getBalance":
+			res = (*hexutil.Big)(acc.Balance)
+		case "eth_getTransactionCount":
+			res = hexutil.Uint64(acc.Nonce)
+		default:
+			res = hexutil.Bytes(acc.Code)
+		}
+	case "debug_storageRangeAt":
+		// Keys are served in order, using the key itself as the hashed key for simplicity.
+		start := common.BytesToHash(args[3].(hexutil.Bytes))
+		var keys []common.Hash
+		for k := range s.accounts[args[2].(common.Address)].Storage {
+			if k.Cmp(start) >= 0 {
+				keys = append(keys, k)
+			}
+		}
+		out := map[string]any{"storage": map[common.Hash]any{}, "nextKey": nil}
+		if len(keys) > 0 {
+			first := keys[0]
+			for _, k := range keys {
+				if k.Cmp(first) < 0 {
+					first = k
+				}
+			}
+			out["storage"] = map[common.Hash]any{first: map[string]any{"key": first, "value": s.accounts[args[2].(common.Address)].Storage[first]}}
+			for _, k := range keys {
+				if k.Cmp(first) > 0 && (out["nextKey"] == nil || k.Cmp(out["nextKey"].(common.Hash)) < 0) {
+					out["nextKey"] = k
+				}
+			}
+		}
+		res = out
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func TestForgeAllocs_FromRPC(t *testing.T) {
+	ctx := context.Background()
+	alice := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	contract := common.HexToAddress("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC")
+	accounts := types.GenesisAlloc{
+		alice: {Balance: big.NewInt(123), Nonce: 42},
+		contract: {
+			Balance: big.NewInt(5),
+			Nonce:   1,
+			Code:    []byte{10, 11, 12},
+			Storage: map[common.Hash]common.Hash{
+				{31: 1}: {31: 0xaa},
+				{31: 2}: {31: 0xbb},
+				{0: 3}:  {0: 0xcc},
+			},
+		},
+	}
+	cl := &stubStateRPC{block: common.Hash{0x01}, next: common.Hash{0x02}, accounts: accounts}
+
+	exporter, err := NewStateExporter(ctx, cl, 100)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0x01}, exporter.Block())
+
+	var allocs ForgeAllocs
+	require.NoError(t, allocs.FromRPC(ctx, exporter, []common.Address{alice, contract, alice}))
+	require.Equal(t, accounts, allocs.Accounts)
+
+	// The exported accounts can be loaded as forge allocs.
+	path := filepath.Join(t.TempDir(), "allocs.json")
+	require.NoError(t, jsonutil.WriteJSON(allocs.Accounts, ioutil.ToAtomicFile(path, 0o644)))
+	loaded, err := LoadForgeAllocs(path)
+	require.NoError(t, err)
+	require.Equal(t, accounts, loaded.Accounts)
+}
+
+func TestNewStateExporter_MissingNextBlock(t *testing.T) {
+	cl := stateRPCFunc(func(result any, method string) error {
+		return json.Unmarshal([]byte("null"), result)
+	})
+	_, err := NewStateExporter(context.Background(), cl, 100)
+	require.ErrorContains(t, err, "block 101 not found")
+}
+
+type stateRPCFunc func(result any, method string) error
+
+func (f stateRPCFunc) CallContext(_ context.Context, result any, method string, _ ...any) error {
+	return f(result, method)
+}
@@ -21,6 +21,7 @@ import (
 	opparams "github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 )
 
 var (
@@ -353,9 +354,18 @@ type UpgradeScheduleDeployConfig struct {
 
 	// When Cancun activates. Relative to L1 genesis.
 	L1CancunTimeOffset *hexutil.Uint64 `json:"l1CancunTimeOffset,omitempty"`
+	// When Prague activates. Relative to L1 genesis. Nil to disable Prague.
+	L1PragueTimeOffset *hexutil.Uint64 `json:"l1PragueTimeOffset,omitempty"`
 
 	// UseInterop is a flag that indicates if the system is using interop
 	UseInterop bool `json:"useInterop,omitempty"`
+
+	// InteropDependencySet are the chain IDs of the chains in the interop dependency set of the L2 chain.
+	// Optional, the dependency set is not included in the rollup config if empty.
+	InteropDependencySet []uint64 `json:"interopDependencySet,omitempty"`
+	// InteropDependencySetTimeOffset is the number of seconds after genesis block that the interop dependency set activates.
+	// Defaults to the Interop activation if nil.
+	InteropDependencySetTimeOffset *hexutil.Uint64 `json:"interopDependencySetTimeOffset,omitempty"`
 }
 
 var _ ConfigChecker = (*UpgradeScheduleDeployConfig)(nil)
@@ -457,9 +467,46 @@ func (d *UpgradeScheduleDeployConfig) Check(log log.Logger) error {
 			return err
 		}
 	}
+	// Cancun activates at L1 genesis if not scheduled
+	if d.L1PragueTimeOffset != nil && d.L1CancunTimeOffset != nil && *d.L1CancunTimeOffset > *d.L1PragueTimeOffset {
+		return fmt.Errorf("%w: L1 Prague offset %d is before L1 Cancun offset %d",
+			ErrInvalidDeployConfig, *d.L1PragueTimeOffset, *d.L1CancunTimeOffset)
+	}
+	if len(d.InteropDependencySet) > 0 || d.InteropDependencySetTimeOffset != nil {
+		if d.L2GenesisInteropTimeOffset == nil {
+			return fmt.Errorf("%w: interop dependency set requires Interop to be scheduled", ErrInvalidDeployConfig)
+		}
+		if d.InteropDependencySetTimeOffset != nil && *d.InteropDependencySetTimeOffset < *d.L2GenesisInteropTimeOffset {
+			return fmt.Errorf("%w: interop dependency set offset %d is before Interop offset %d",
+				ErrInvalidDeployConfig, *d.InteropDependencySetTimeOffset, *d.L2GenesisInteropTimeOffset)
+		}
+	}
 	return nil
 }
 
+// InteropDependencySetConfig returns the interop dependency set to include in the rollup config,
+// or nil if no dependency set is configured.
+func (d *UpgradeScheduleDeployConfig) InteropDependencySetConfig(genesisTime uint64) *rollup.InteropDependencySet {
+	if len(d.InteropDependencySet) == 0 {
+		return nil
+	}
+	activation := d.InteropTime(genesisTime)
+	if d.InteropDependencySetTimeOffset != nil {
+		activation = offsetToUpgradeTime(d.InteropDependencySetTimeOffset, genesisTime)
+	}
+	if activation == nil {
+		return nil
+	}
+	chainIDs := make([]*big.Int, len(d.InteropDependencySet))
+	for i, id := range d.InteropDependencySet {
+		chainIDs[i] = new(big.Int).SetUint64(id)
+	}
+	return &rollup.InteropDependencySet{
+		ChainIDs:       chainIDs,
+		ActivationTime: *activation,
+	}
+}
+
 // L2CoreDeployConfig configures the core protocol parameters of the chain.
 type L2CoreDeployConfig struct {
 	// L1ChainID is the chain ID of the L1 chain.
@@ -599,6 +646,9 @@ type DevL1DeployConfig struct {
 	L1GenesisBlockBaseFeePerGas *hexutil.Big    `json:"l1GenesisBlockBaseFeePerGas"`
 	L1GenesisBlockExcessBlobGas *hexutil.Uint64 `json:"l1GenesisBlockExcessBlobGas,omitempty"` // EIP-4844
 	L1GenesisBlockBlobGasUsed   *hexutil.Uint64 `json:"l1GenesisBlockblobGasUsed,omitempty"`   // EIP-4844
+	// L1BeaconDepositContract is the address of the beacon deposit contract of the L1 developer genesis.
+	// Defaults to DefaultL1BeaconDepositContract, as configured in the devnet beacon chain config.
+	L1BeaconDepositContract *common.Address `json:"l1BeaconDepositContract,omitempty"`
 }
 
 // SuperchainL1DeployConfig configures parameters of the superchain-wide deployed contracts to L1.
@@ -804,6 +854,10 @@ func (d *LegacyDeployConfig) Check(log log.Logger) error {
 // DeployConfig represents the deployment configuration for an OP Stack chain.
 // It is used to deploy the L1 contracts as well as create the L2 genesis state.
 type DeployConfig struct {
+	// Version is the schema version of the deploy config, see DeployConfigVersion.
+	// Outdated configs are migrated when they are loaded with NewDeployConfig.
+	Version uint64 `json:"version,omitempty"`
+
 	// Pre-L1-deployment L2 configs
 	L2InitializationConfig
 
@@ -849,6 +903,9 @@ func (d *DeployConfig) Copy() *DeployConfig {
 
 // Check will ensure that the config is sane and return an error when it is not
 func (d *DeployConfig) Check(log log.Logger) error {
+	if d.Version > DeployConfigVersion {
+		return fmt.Errorf("%w: %d, latest known version is %d", ErrUnsupportedDeployConfigVersion, d.Version, DeployConfigVersion)
+	}
 	if d.L1StartingBlockTag == nil {
 		return fmt.Errorf("%w: L1StartingBlockTag cannot be nil", ErrInvalidDeployConfig)
 	}
@@ -938,16 +995,31 @@ func (d *DeployConfig) RollupConfig(l1StartBlock *types.Block, l2GenesisBlockHas
 		PragueTime:             d.PragueTime(l1StartBlock.Time()),
 		GraniteTime:            d.GraniteTime(l1StartBlock.Time()),
 		InteropTime:            d.InteropTime(l1StartBlock.Time()),
+		InteropDependencySet:   d.InteropDependencySetConfig(l1StartBlock.Time()),
 		AltDAConfig:            altDA,
 	}, nil
 }
 
 // NewDeployConfig reads a config file given a path on the filesystem.
+// Outdated configs are migrated to the current version in-memory, logging a warning for every change.
 func NewDeployConfig(path string) (*DeployConfig, error) {
 	file, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("deploy config at %s not found: %w", path, err)
 	}
+	// Deploy configs may be written in TOML or YAML too, the migrations operate on JSON.
+	file, err = jsonutil.ToJSON(file, jsonutil.FormatFromPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read deploy config at %s: %w", path, err)
+	}
+
+	file, version, err := MigrateDeployConfig(log.Root(), file)
+	if err != nil {
+		return nil, err
+	}
+	if version < DeployConfigVersion {
+		log.Warn("Loaded outdated deploy config, rewrite it with the deploy-config migrate command", "path", path, "version", version)
+	}
 
 	dec := json.NewDecoder(bytes.NewReader(file))
 	dec.DisallowUnknownFields()
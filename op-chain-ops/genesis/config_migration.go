@@ -0,0 +1,196 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	opparams "github.com/ethereum-optimism/optimism/op-node/params"
+)
+
+// DeployConfigVersion is the current schema version of the deploy config.
+// Configs without a version field are version 0.
+const DeployConfigVersion = 1
+
+var ErrUnsupportedDeployConfigVersion = errors.New("unsupported deploy config version")
+
+// deployConfigMigration upgrades a deploy config by a single schema version.
+type deployConfigMigration struct {
+	// description is logged when the migration is applied.
+	description string
+	migrate     func(log log.Logger, cfg *rawDeployConfig) error
+}
+
+// deployConfigMigrations upgrade the deploy config from the version of their index to the next version.
+var deployConfigMigrations = []deployConfigMigration{
+	{
+		description: "rename plasma attributes to alt-DA, remove unused deployment wait confirmations",
+		migrate: func(log log.Logger, cfg *rawDeployConfig) error {
+			if err := cfg.rename(log, "usePlasma", "useAltDA"); err != nil {
+				return err
+			}
+			// The clique attributes are legacy, but still configure the L1 genesis, so they are kept.
+			cfg.remove(log, "deploymentWaitConfirmations")
+			// The channel timeout is a protocol constant since Granite. Other values are left in place,
+			// to be rejected by the config checks.
+			if raw, ok := cfg.values["channelTimeoutGranite"]; ok {
+				var timeout uint64
+				if err := json.Unmarshal(raw, &timeout); err != nil {
+					return fmt.Errorf("invalid channelTimeoutGranite: %w", err)
+				}
+				if timeout == 0 || timeout == opparams.ChannelTimeoutGranite {
+					cfg.remove(log, "channelTimeoutGranite")
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// MigrateDeployConfig upgrades a JSON encoded deploy config to the current schema version.
+// It returns the upgraded config, and the version the config was upgraded from.
+// Warnings are logged for every attribute that is changed. The order of the attributes is preserved.
+func MigrateDeployConfig(log log.Logger, data []byte) ([]byte, uint64, error) {
+	var cfg rawDeployConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, 0, fmt.Errorf("cannot parse deploy config: %w", err)
+	}
+	var version uint64
+	if raw, ok := cfg.values["version"]; ok {
+		if err := json.Unmarshal(raw, &version); err != nil {
+			return nil, 0, fmt.Errorf("invalid deploy config version: %w", err)
+		}
+	}
+	if version > DeployConfigVersion {
+		return nil, 0, fmt.Errorf("%w: %d, latest known version is %d", ErrUnsupportedDeployConfigVersion, version, DeployConfigVersion)
+	}
+	if version == DeployConfigVersion {
+		return data, version, nil
+	}
+	for v := version; v < DeployConfigVersion; v++ {
+		m := deployConfigMigrations[v]
+		log.Warn("Migrating outdated deploy config", "from", v, "to", v+1, "migration", m.description)
+		if err := m.migrate(log, &cfg); err != nil {
+			return nil, 0, fmt.Errorf("failed to migrate deploy config from version %d: %w", v, err)
+		}
+	}
+	cfg.set("version", json.RawMessage(fmt.Sprintf("%d", DeployConfigVersion)))
+	out, err := json.MarshalIndent(&cfg, "", "  ")
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot encode migrated deploy config: %w", err)
+	}
+	return out, version, nil
+}
+
+// rawDeployConfig is a JSON object of deploy config attributes, that preserves the order of the attributes,
+// so a migrated config can be written back with a minimal diff.
+type rawDeployConfig struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func (c *rawDeployConfig) set(key string, value json.RawMessage) {
+	if _, ok := c.values[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.values[key] = value
+}
+
+func (c *rawDeployConfig) remove(log log.Logger, key string) {
+	if _, ok := c.values[key]; !ok {
+		return
+	}
+	log.Warn("Removing unused deploy config attribute", "attribute", key)
+	delete(c.values, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// rename renames the attribute, keeping its position. Both attributes may only be set if they are equal.
+func (c *rawDeployConfig) rename(log log.Logger, from, to string) error {
+	value, ok := c.values[from]
+	if !ok {
+		return nil
+	}
+	if existing, ok := c.values[to]; ok {
+		if !bytes.Equal(compactJSON(existing), compactJSON(value)) {
+			return fmt.Errorf("conflicting deploy config attributes %s and %s", from, to)
+		}
+		c.remove(log, from)
+		return nil
+	}
+	log.Warn("Renaming deploy config attribute", "from", from, "to", to)
+	delete(c.values, from)
+	c.values[to] = value
+	for i, k := range c.keys {
+		if k == from {
+			c.keys[i] = to
+			break
+		}
+	}
+	return nil
+}
+
+func compactJSON(data json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+func (c *rawDeployConfig) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return errors.New("deploy config must be a JSON object")
+	}
+	c.keys = nil
+	c.values = make(map[string]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("invalid value of %s: %w", key, err)
+		}
+		if _, ok := c.values[key]; ok {
+			return fmt.Errorf("duplicate attribute %s", key)
+		}
+		c.set(key, value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func (c *rawDeployConfig) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range c.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(compactJSON(c.values[key]))
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
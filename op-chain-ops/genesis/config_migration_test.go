@@ -0,0 +1,104 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opparams "github.com/ethereum-optimism/optimism/op-node/params"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestMigrateDeployConfig(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	in := fmt.Sprintf(`{
+  "l1ChainID": 900,
+  "usePlasma": true,
+  "l1UseClique": true,
+  "cliqueSignerAddress": "0xca062b0fd91172d89bcd4bb084ac4e21972cc467",
+  "deploymentWaitConfirmations": 1,
+  "channelTimeoutGranite": %d,
+  "daCommitmentType": "KeccakCommitment"
+}`, opparams.ChannelTimeoutGranite)
+
+	out, version, err := MigrateDeployConfig(logger, []byte(in))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), version)
+	require.Equal(t, `{
+  "l1ChainID": 900,
+  "useAltDA": true,
+  "l1UseClique": true,
+  "cliqueSignerAddress": "0xca062b0fd91172d89bcd4bb084ac4e21972cc467",
+  "daCommitmentType": "KeccakCommitment",
+  "version": 1
+}`, string(out))
+
+	// Migrating an up-to-date config is a no-op
+	again, version, err := MigrateDeployConfig(logger, out)
+	require.NoError(t, err)
+	require.Equal(t, uint64(DeployConfigVersion), version)
+	require.Equal(t, out, again)
+}
+
+func TestMigrateDeployConfig_KeepsInvalidChannelTimeoutGranite(t *testing.T) {
+	out, _, err := MigrateDeployConfig(testlog.Logger(t, log.LevelDebug), []byte(`{"channelTimeoutGranite": 5}`))
+	require.NoError(t, err)
+	var cfg map[string]any
+	require.NoError(t, json.Unmarshal(out, &cfg))
+	require.Contains(t, cfg, "channelTimeoutGranite", "must be left for the config checks to reject")
+}
+
+func TestMigrateDeployConfig_Conflict(t *testing.T) {
+	_, _, err := MigrateDeployConfig(testlog.Logger(t, log.LevelDebug), []byte(`{"usePlasma": true, "useAltDA": false}`))
+	require.ErrorContains(t, err, "conflicting deploy config attributes usePlasma and useAltDA")
+
+	out, _, err := MigrateDeployConfig(testlog.Logger(t, log.LevelDebug), []byte(`{"usePlasma": true, "useAltDA": true}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"useAltDA": true, "version": 1}`, string(out))
+}
+
+func TestMigrateDeployConfig_UnsupportedVersion(t *testing.T) {
+	_, _, err := MigrateDeployConfig(testlog.Logger(t, log.LevelDebug), []byte(`{"version": 2}`))
+	require.ErrorIs(t, err, ErrUnsupportedDeployConfigVersion)
+
+	cfg := &DeployConfig{Version: DeployConfigVersion + 1}
+	require.ErrorIs(t, cfg.Check(testlog.Logger(t, log.LevelDebug)), ErrUnsupportedDeployConfigVersion)
+}
+
+func TestNewDeployConfig_MigratesOutdatedConfig(t *testing.T) {
+	b, err := os.ReadFile("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(b, &raw))
+	raw["usePlasma"] = raw["useAltDA"]
+	delete(raw, "useAltDA")
+	b, err = json.Marshal(raw)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "deploy-config.json")
+	require.NoError(t, os.WriteFile(path, b, 0o644))
+
+	cfg, err := NewDeployConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(DeployConfigVersion), cfg.Version)
+	require.Equal(t, raw["usePlasma"], cfg.UseAltDA)
+	require.NoError(t, cfg.Check(testlog.Logger(t, log.LevelDebug)))
+}
+
+func TestNewDeployConfig_YAML(t *testing.T) {
+	expected, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "deploy-config.yaml")
+	require.NoError(t, jsonutil.WriteYAML(expected, ioutil.ToAtomicFile(path, 0o644)))
+	cfg, err := NewDeployConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, cfg)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
@@ -78,6 +80,43 @@ func TestCanyonTimeOffset(t *testing.T) {
 	require.Equal(t, uint64(1234+1500), *config.CanyonTime(1234))
 }
 
+func TestInteropDependencySetConfig(t *testing.T) {
+	config := &UpgradeScheduleDeployConfig{}
+	require.NoError(t, config.Check(testlog.Logger(t, log.LevelInfo)))
+	require.Nil(t, config.InteropDependencySetConfig(1000))
+
+	config.InteropDependencySet = []uint64{900, 901}
+	require.ErrorIs(t, config.Check(testlog.Logger(t, log.LevelInfo)), ErrInvalidDeployConfig)
+
+	interopOffset := hexutil.Uint64(10)
+	config.L2GenesisInteropTimeOffset = &interopOffset
+	require.NoError(t, config.Check(testlog.Logger(t, log.LevelInfo)))
+	require.Equal(t, &rollup.InteropDependencySet{
+		ChainIDs:       []*big.Int{big.NewInt(900), big.NewInt(901)},
+		ActivationTime: 1010,
+	}, config.InteropDependencySetConfig(1000))
+
+	depSetOffset := hexutil.Uint64(5)
+	config.InteropDependencySetTimeOffset = &depSetOffset
+	require.ErrorIs(t, config.Check(testlog.Logger(t, log.LevelInfo)), ErrInvalidDeployConfig)
+	depSetOffset = 20
+	require.NoError(t, config.Check(testlog.Logger(t, log.LevelInfo)))
+	require.Equal(t, uint64(1020), config.InteropDependencySetConfig(1000).ActivationTime)
+}
+
+func TestL1ForkScheduleCheck(t *testing.T) {
+	config := &UpgradeScheduleDeployConfig{}
+	pragueOffset := hexutil.Uint64(10)
+	config.L1PragueTimeOffset = &pragueOffset
+	require.NoError(t, config.Check(testlog.Logger(t, log.LevelInfo)))
+
+	cancunOffset := hexutil.Uint64(20)
+	config.L1CancunTimeOffset = &cancunOffset
+	require.ErrorIs(t, config.Check(testlog.Logger(t, log.LevelInfo)), ErrInvalidDeployConfig)
+	cancunOffset = 10
+	require.NoError(t, config.Check(testlog.Logger(t, log.LevelInfo)))
+}
+
 // TestCopy will copy a DeployConfig and ensure that the copy is equal to the original.
 func TestCopy(t *testing.T) {
 	b, err := os.ReadFile("testdata/test-deploy-config-full.json")
@@ -0,0 +1,146 @@
+package genesis
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AllocsDiff is a machine-readable report of the differences between two sets of genesis allocs.
+// Accounts and storage slots are sorted, so the report of the same allocs is always the same.
+type AllocsDiff struct {
+	Added    []AccountDiff `json:"added"`
+	Removed  []AccountDiff `json:"removed"`
+	Modified []AccountDiff `json:"modified"`
+}
+
+// Empty returns true if the allocs are the same.
+func (d *AllocsDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// AccountDiff describes the changes to an account. Fields that did not change are omitted.
+// Added accounts diff against an empty account, and removed accounts diff to an empty account.
+type AccountDiff struct {
+	Address common.Address `json:"address"`
+
+	Balance  *BalanceDiff  `json:"balance,omitempty"`
+	Nonce    *NonceDiff    `json:"nonce,omitempty"`
+	CodeHash *CodeHashDiff `json:"codeHash,omitempty"`
+	Storage  []StorageDiff `json:"storage,omitempty"`
+}
+
+type BalanceDiff struct {
+	Prev *hexutil.Big `json:"prev"`
+	New  *hexutil.Big `json:"new"`
+}
+
+type NonceDiff struct {
+	Prev hexutil.Uint64 `json:"prev"`
+	New  hexutil.Uint64 `json:"new"`
+}
+
+// CodeHashDiff describes a code change by the hashes of the code, the zero hash for no code.
+type CodeHashDiff struct {
+	Prev common.Hash `json:"prev"`
+	New  common.Hash `json:"new"`
+}
+
+// StorageDiff describes a storage slot change. Missing slots are zero.
+type StorageDiff struct {
+	Slot common.Hash `json:"slot"`
+	Prev common.Hash `json:"prev"`
+	New  common.Hash `json:"new"`
+}
+
+// DiffAllocs compares the allocs of a previous genesis with the allocs of a new genesis.
+func DiffAllocs(prev types.GenesisAlloc, next types.GenesisAlloc) *AllocsDiff {
+	out := &AllocsDiff{
+		Added:    []AccountDiff{},
+		Removed:  []AccountDiff{},
+		Modified: []AccountDiff{},
+	}
+	for _, addr := range sortedAddresses(prev, next) {
+		prevAcc, inPrev := prev[addr]
+		nextAcc, inNext := next[addr]
+		diff := diffAccount(addr, prevAcc, nextAcc)
+		switch {
+		case !inPrev:
+			out.Added = append(out.Added, diff)
+		case !inNext:
+			out.Removed = append(out.Removed, diff)
+		case diff.Balance != nil || diff.Nonce != nil || diff.CodeHash != nil || len(diff.Storage) > 0:
+			out.Modified = append(out.Modified, diff)
+		}
+	}
+	return out
+}
+
+func diffAccount(addr common.Address, prev types.Account, next types.Account) AccountDiff {
+	diff := AccountDiff{Address: addr}
+	prevBalance, nextBalance := balanceOf(prev), balanceOf(next)
+	if prevBalance.Cmp(nextBalance) != 0 {
+		diff.Balance = &BalanceDiff{Prev: (*hexutil.Big)(prevBalance), New: (*hexutil.Big)(nextBalance)}
+	}
+	if prev.Nonce != next.Nonce {
+		diff.Nonce = &NonceDiff{Prev: hexutil.Uint64(prev.Nonce), New: hexutil.Uint64(next.Nonce)}
+	}
+	if !bytes.Equal(prev.Code, next.Code) {
+		diff.CodeHash = &CodeHashDiff{Prev: codeHash(prev.Code), New: codeHash(next.Code)}
+	}
+	for _, slot := range sortedSlots(prev.Storage, next.Storage) {
+		if prevVal, nextVal := prev.Storage[slot], next.Storage[slot]; prevVal != nextVal {
+			diff.Storage = append(diff.Storage, StorageDiff{Slot: slot, Prev: prevVal, New: nextVal})
+		}
+	}
+	return diff
+}
+
+func balanceOf(acc types.Account) *big.Int {
+	if acc.Balance == nil {
+		return new(big.Int)
+	}
+	return acc.Balance
+}
+
+func codeHash(code []byte) common.Hash {
+	if len(code) == 0 {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(code)
+}
+
+func sortedAddresses(a, b types.GenesisAlloc) []common.Address {
+	seen := make(map[common.Address]struct{}, len(a))
+	var out []common.Address
+	for _, allocs := range []types.GenesisAlloc{a, b} {
+		for addr := range allocs {
+			if _, ok := seen[addr]; !ok {
+				seen[addr] = struct{}{}
+				out = append(out, addr)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i][:], out[j][:]) < 0 })
+	return out
+}
+
+func sortedSlots(a, b map[common.Hash]common.Hash) []common.Hash {
+	seen := make(map[common.Hash]struct{}, len(a))
+	var out []common.Hash
+	for _, storage := range []map[common.Hash]common.Hash{a, b} {
+		for slot := range storage {
+			if _, ok := seen[slot]; !ok {
+				seen[slot] = struct{}{}
+				out = append(out, slot)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i][:], out[j][:]) < 0 })
+	return out
+}
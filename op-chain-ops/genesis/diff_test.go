@@ -0,0 +1,93 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAllocs(t *testing.T) {
+	unchanged := common.Address{0x01}
+	modified := common.Address{0x02}
+	removed := common.Address{0x03}
+	added := common.Address{0x04}
+	slot1, slot2, slot3 := common.Hash{0x01}, common.Hash{0x02}, common.Hash{0x03}
+
+	prev := types.GenesisAlloc{
+		unchanged: {Balance: big.NewInt(1), Code: []byte{0x60}},
+		modified: {
+			Balance: big.NewInt(10),
+			Nonce:   1,
+			Code:    []byte{0x60, 0x00},
+			Storage: map[common.Hash]common.Hash{slot1: {0xaa}, slot2: {0xbb}},
+		},
+		removed: {Balance: big.NewInt(5)},
+	}
+	next := types.GenesisAlloc{
+		unchanged: {Balance: big.NewInt(1), Code: []byte{0x60}},
+		modified: {
+			Balance: big.NewInt(20),
+			Nonce:   1,
+			Code:    []byte{0x60, 0x01},
+			Storage: map[common.Hash]common.Hash{slot1: {0xaa}, slot3: {0xcc}},
+		},
+		added: {Nonce: 2, Storage: map[common.Hash]common.Hash{slot1: {0xdd}}},
+	}
+
+	diff := DiffAllocs(prev, next)
+	require.False(t, diff.Empty())
+	require.Equal(t, []AccountDiff{{
+		Address: added,
+		Nonce:   &NonceDiff{Prev: 0, New: 2},
+		Storage: []StorageDiff{{Slot: slot1, New: common.Hash{0xdd}}},
+	}}, diff.Added)
+	require.Equal(t, []AccountDiff{{
+		Address: removed,
+		Balance: &BalanceDiff{Prev: (*hexutil.Big)(big.NewInt(5)), New: (*hexutil.Big)(new(big.Int))},
+	}}, diff.Removed)
+	require.Equal(t, []AccountDiff{{
+		Address: modified,
+		Balance: &BalanceDiff{Prev: (*hexutil.Big)(big.NewInt(10)), New: (*hexutil.Big)(big.NewInt(20))},
+		CodeHash: &CodeHashDiff{
+			Prev: crypto.Keccak256Hash([]byte{0x60, 0x00}),
+			New:  crypto.Keccak256Hash([]byte{0x60, 0x01}),
+		},
+		Storage: []StorageDiff{
+			{Slot: slot2, Prev: common.Hash{0xbb}},
+			{Slot: slot3, New: common.Hash{0xcc}},
+		},
+	}}, diff.Modified)
+
+	// the report is stable, regardless of map iteration order
+	first, err := json.Marshal(diff)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		again, err := json.Marshal(DiffAllocs(prev, next))
+		require.NoError(t, err)
+		require.Equal(t, first, again)
+	}
+}
+
+func TestDiffAllocsSame(t *testing.T) {
+	allocs := types.GenesisAlloc{
+		common.Address{0x01}: {Balance: big.NewInt(1), Storage: map[common.Hash]common.Hash{{0x01}: {0x02}}},
+		// a nil balance is the same as a zero balance
+		common.Address{0x02}: {},
+	}
+	same := types.GenesisAlloc{
+		common.Address{0x01}: {Balance: big.NewInt(1), Storage: map[common.Hash]common.Hash{{0x01}: {0x02}}},
+		common.Address{0x02}: {Balance: new(big.Int)},
+	}
+	diff := DiffAllocs(allocs, same)
+	require.True(t, diff.Empty())
+
+	out, err := json.Marshal(diff)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"added":[],"removed":[],"modified":[]}`, string(out))
+}
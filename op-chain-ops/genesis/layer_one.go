@@ -20,6 +20,10 @@ import (
 // with a single wei in the genesis state.
 const PrecompileCount = 256
 
+// DefaultL1BeaconDepositContract is the address of the beacon deposit contract of the L1 developer genesis,
+// if not configured otherwise. It matches the deposit contract of the devnet beacon chain config.
+var DefaultL1BeaconDepositContract = common.HexToAddress("0x1111111111111111111111111111111111111111")
+
 // BuildL1DeveloperGenesis will create a L1 genesis block after creating
 // all of the state required for an Optimism network to function.
 // It is expected that the dump contains all of the required state to bootstrap
@@ -50,7 +54,10 @@ func BuildL1DeveloperGenesis(config *DeployConfig, dump *foundry.ForgeAllocs, l1
 		}
 	})
 
-	beaconDepositAddr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	beaconDepositAddr := DefaultL1BeaconDepositContract
+	if config.L1BeaconDepositContract != nil {
+		beaconDepositAddr = *config.L1BeaconDepositContract
+	}
 	if err := beacondeposit.InsertEmptyBeaconDepositContract(genesis, beaconDepositAddr); err != nil {
 		return nil, fmt.Errorf("failed to insert beacon deposit contract into L1 dev genesis: %w", err)
 	}
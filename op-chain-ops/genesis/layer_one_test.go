@@ -1,12 +1,19 @@
 package genesis
 
 import (
+	"encoding/json"
 	"math/big"
+	"os"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 )
 
 // TestFundDevAccounts ensures that the developer accounts are
@@ -34,3 +41,48 @@ func TestSetPrecompileBalances(t *testing.T) {
 		require.Equal(t, big.NewInt(1), account.Balance)
 	}
 }
+
+func TestBuildL1DeveloperGenesis(t *testing.T) {
+	b, err := os.ReadFile("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+	newConfig := func(t *testing.T) *DeployConfig {
+		config := new(DeployConfig)
+		require.NoError(t, json.Unmarshal(b, config))
+		config.L1GenesisBlockTimestamp = 1000
+		return config
+	}
+	build := func(t *testing.T, config *DeployConfig) *core.Genesis {
+		allocs := &foundry.ForgeAllocs{Accounts: types.GenesisAlloc{}}
+		gen, err := BuildL1DeveloperGenesis(config, allocs, &L1Deployments{})
+		require.NoError(t, err)
+		return gen
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		gen := build(t, newConfig(t))
+		require.Equal(t, uint64(0), *gen.Config.ShanghaiTime)
+		require.Equal(t, uint64(0), *gen.Config.CancunTime)
+		require.Nil(t, gen.Config.PragueTime)
+		require.NotEmpty(t, gen.Alloc[DefaultL1BeaconDepositContract].Code)
+		require.Equal(t, predeploys.EIP4788ContractCode, []byte(gen.Alloc[predeploys.EIP4788ContractAddr].Code))
+	})
+
+	t.Run("scheduled forks", func(t *testing.T) {
+		config := newConfig(t)
+		cancunOffset, pragueOffset := hexutil.Uint64(12), hexutil.Uint64(24)
+		config.L1CancunTimeOffset = &cancunOffset
+		config.L1PragueTimeOffset = &pragueOffset
+		gen := build(t, config)
+		require.Equal(t, uint64(1012), *gen.Config.CancunTime)
+		require.Equal(t, uint64(1024), *gen.Config.PragueTime)
+	})
+
+	t.Run("deposit contract", func(t *testing.T) {
+		config := newConfig(t)
+		addr := common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa")
+		config.L1BeaconDepositContract = &addr
+		gen := build(t, config)
+		require.NotEmpty(t, gen.Alloc[addr].Code)
+		require.NotContains(t, gen.Alloc, DefaultL1BeaconDepositContract)
+	})
+}
@@ -2,13 +2,11 @@ package genesis
 
 import (
 	"fmt"
-	"math/big"
 
 	hdwallet "github.com/ethereum-optimism/go-ethereum-hdwallet"
 	"github.com/holiman/uint256"
 
 	"github.com/ethereum/go-ethereum/accounts"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -27,8 +25,6 @@ const (
 )
 
 var (
-	// l2PredeployNamespace is the namespace for L2 predeploys
-	l2PredeployNamespace = common.HexToAddress("0x4200000000000000000000000000000000000000")
 	// mnemonic for the test accounts in hardhat/foundry
 	testMnemonic = "test test test test test test test test test test test junk"
 )
@@ -60,9 +56,9 @@ func BuildL2Genesis(config *DeployConfig, dump *foundry.ForgeAllocs, l1StartBloc
 		}
 	}
 	// sanity check that all predeploys are present
-	for i := 0; i < 2048; i++ {
-		addr := common.BigToAddress(new(big.Int).Or(l2PredeployNamespace.Big(), big.NewInt(int64(i))))
-		if !config.GovernanceEnabled() && addr == predeploys.GovernanceTokenAddr {
+	for i := uint64(0); i < predeploys.NamespaceSize; i++ {
+		addr := predeploys.NamespaceAddress(i)
+		if p, ok := predeploys.ByAddress(addr); ok && !p.IsEnabled(config) {
 			continue
 		}
 		if len(genspec.Alloc[addr].Code) == 0 {
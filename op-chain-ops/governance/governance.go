@@ -0,0 +1,227 @@
+// Package governance encodes common Guardian operations, like pausing withdrawals or blacklisting dispute games,
+// into ready-to-sign transactions or Safe batches.
+//
+// Encoding the calls with a reviewed tool, and simulating them before signing, reduces the risk of errors
+// during incident response.
+package governance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-service/errutil"
+)
+
+const (
+	superchainConfigABIJSON = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_identifier\",\"type\":\"string\"}],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"paused_\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+	portalABIJSON           = "[{\"inputs\":[{\"internalType\":\"contract IDisputeGame\",\"name\":\"_disputeGame\",\"type\":\"address\"}],\"name\":\"blacklistDisputeGame\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"GameType\",\"name\":\"_gameType\",\"type\":\"uint32\"}],\"name\":\"setRespectedGameType\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contract IDisputeGame\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"disputeGameBlacklist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"respectedGameType\",\"outputs\":[{\"internalType\":\"GameType\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+)
+
+var (
+	superchainConfigABI = mustParseABI(superchainConfigABIJSON)
+	portalABI           = mustParseABI(portalABIJSON)
+)
+
+// Caller executes calls against the current L1 state, e.g. an ethclient.Client.
+type Caller interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Action is a single encoded governance call.
+type Action struct {
+	// Summary is a human-readable description of the call, for review before signing.
+	Summary string         `json:"summary"`
+	To      common.Address `json:"to"`
+	Data    hexutil.Bytes  `json:"data"`
+
+	// state describes the state the action changes, as a human-readable string.
+	state func(ctx context.Context, caller Caller) (string, error)
+}
+
+// Pause encodes pausing all withdrawals through the SuperchainConfig.
+func Pause(superchainConfig common.Address, identifier string) (Action, error) {
+	data, err := superchainConfigABI.Pack("pause", identifier)
+	if err != nil {
+		return Action{}, fmt.Errorf("failed to encode pause: %w", err)
+	}
+	return Action{
+		Summary: fmt.Sprintf("Pause withdrawals with SuperchainConfig %s (identifier %q)", superchainConfig, identifier),
+		To:      superchainConfig,
+		Data:    data,
+		state:   pausedState(superchainConfig),
+	}, nil
+}
+
+// Unpause encodes unpausing withdrawals through the SuperchainConfig.
+func Unpause(superchainConfig common.Address) (Action, error) {
+	data, err := superchainConfigABI.Pack("unpause")
+	if err != nil {
+		return Action{}, fmt.Errorf("failed to encode unpause: %w", err)
+	}
+	return Action{
+		Summary: fmt.Sprintf("Unpause withdrawals with SuperchainConfig %s", superchainConfig),
+		To:      superchainConfig,
+		Data:    data,
+		state:   pausedState(superchainConfig),
+	}, nil
+}
+
+// BlacklistDisputeGame encodes blacklisting a dispute game in the OptimismPortal,
+// so withdrawals proven against it can no longer be finalized.
+func BlacklistDisputeGame(portal common.Address, game common.Address) (Action, error) {
+	data, err := portalABI.Pack("blacklistDisputeGame", game)
+	if err != nil {
+		return Action{}, fmt.Errorf("failed to encode blacklistDisputeGame: %w", err)
+	}
+	return Action{
+		Summary: fmt.Sprintf("Blacklist dispute game %s in OptimismPortal %s", game, portal),
+		To:      portal,
+		Data:    data,
+		state: func(ctx context.Context, caller Caller) (string, error) {
+			var blacklisted bool
+			if err := call(ctx, caller, portal, portalABI, &blacklisted, "disputeGameBlacklist", game); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("blacklisted: %v", blacklisted), nil
+		},
+	}, nil
+}
+
+// SetRespectedGameType encodes setting the respected game type of the OptimismPortal.
+// This invalidates all withdrawals proven against games of the previous type.
+func SetRespectedGameType(portal common.Address, gameType uint32) (Action, error) {
+	data, err := portalABI.Pack("setRespectedGameType", gameType)
+	if err != nil {
+		return Action{}, fmt.Errorf("failed to encode setRespectedGameType: %w", err)
+	}
+	return Action{
+		Summary: fmt.Sprintf("Set respected game type of OptimismPortal %s to %d", portal, gameType),
+		To:      portal,
+		Data:    data,
+		state: func(ctx context.Context, caller Caller) (string, error) {
+			var current uint32
+			if err := call(ctx, caller, portal, portalABI, &current, "respectedGameType"); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("respected game type: %d", current), nil
+		},
+	}, nil
+}
+
+func pausedState(superchainConfig common.Address) func(ctx context.Context, caller Caller) (string, error) {
+	return func(ctx context.Context, caller Caller) (string, error) {
+		var paused bool
+		if err := call(ctx, caller, superchainConfig, superchainConfigABI, &paused, "paused"); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("paused: %v", paused), nil
+	}
+}
+
+// Transaction is an unsigned transaction, to be signed and sent by the Guardian directly.
+type Transaction struct {
+	Summary string         `json:"summary"`
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// Transactions returns the actions as unsigned transactions sent by from.
+func Transactions(from common.Address, actions []Action) []Transaction {
+	txs := make([]Transaction, 0, len(actions))
+	for _, action := range actions {
+		txs = append(txs, Transaction{
+			Summary: action.Summary,
+			From:    from,
+			To:      action.To,
+			Value:   (*hexutil.Big)(new(big.Int)),
+			Data:    action.Data,
+		})
+	}
+	return txs
+}
+
+// SafeBatch returns the actions as a Safe batch, to be executed by the Guardian Safe.
+func SafeBatch(chainID uint64, name string, actions []Action) *safe.Batch {
+	batch := &safe.Batch{
+		Version:   "1.0",
+		ChainID:   fmt.Sprintf("%d", chainID),
+		CreatedAt: uint64(time.Now().UnixMilli()),
+		Meta:      safe.BatchMeta{Name: name},
+	}
+	summaries := make([]string, 0, len(actions))
+	for _, action := range actions {
+		batch.Transactions = append(batch.Transactions, safe.Tx{To: action.To, Value: "0", Data: action.Data})
+		summaries = append(summaries, action.Summary)
+	}
+	batch.Meta.Description = strings.Join(summaries, "; ")
+	return batch
+}
+
+// Simulation is the outcome of the pre-flight simulation of an action.
+type Simulation struct {
+	Summary string `json:"summary"`
+	// State is the current state changed by the action, if it could be read.
+	State string `json:"state,omitempty"`
+	// Error is the reason the action fails, or empty if it succeeds.
+	Error string `json:"error,omitempty"`
+}
+
+func (s Simulation) Success() bool {
+	return s.Error == ""
+}
+
+// Simulate executes each action as a call from the given sender against the current state.
+// Actions are simulated independently, so an action depending on the effects of an earlier one may fail.
+func Simulate(ctx context.Context, caller Caller, from common.Address, actions []Action) []Simulation {
+	results := make([]Simulation, 0, len(actions))
+	for _, action := range actions {
+		result := Simulation{Summary: action.Summary}
+		if action.state != nil {
+			if state, err := action.state(ctx, caller); err != nil {
+				result.State = fmt.Sprintf("unknown (%v)", err)
+			} else {
+				result.State = state
+			}
+		}
+		_, err := caller.CallContract(ctx, ethereum.CallMsg{From: from, To: &action.To, Data: action.Data}, nil)
+		if err != nil {
+			result.Error = errutil.TryAddRevertReason(err).Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func call(ctx context.Context, caller Caller, to common.Address, contractABI abi.ABI, out any, method string, args ...any) error {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	if err := contractABI.UnpackIntoInterface(out, method, result); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", method, err)
+	}
+	return nil
+}
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,109 @@
+package governance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+)
+
+var (
+	superchainConfig = common.HexToAddress("0xaa")
+	portal           = common.HexToAddress("0xbb")
+	game             = common.HexToAddress("0xcc")
+	guardian         = common.HexToAddress("0xdd")
+)
+
+func TestEncode(t *testing.T) {
+	pause, err := Pause(superchainConfig, "incident")
+	require.NoError(t, err)
+	require.Equal(t, superchainConfig, pause.To)
+	expected, err := superchainConfigABI.Pack("pause", "incident")
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Bytes(expected), pause.Data)
+
+	unpause, err := Unpause(superchainConfig)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Bytes(superchainConfigABI.Methods["unpause"].ID), unpause.Data)
+
+	blacklist, err := BlacklistDisputeGame(portal, game)
+	require.NoError(t, err)
+	require.Equal(t, portal, blacklist.To)
+	expected, err = portalABI.Pack("blacklistDisputeGame", game)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Bytes(expected), blacklist.Data)
+
+	gameType, err := SetRespectedGameType(portal, 1)
+	require.NoError(t, err)
+	expected, err = portalABI.Pack("setRespectedGameType", uint32(1))
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Bytes(expected), gameType.Data)
+	require.Contains(t, gameType.Summary, "to 1")
+
+	actions := []Action{pause, blacklist}
+	txs := Transactions(guardian, actions)
+	require.Len(t, txs, 2)
+	require.Equal(t, Transaction{
+		Summary: blacklist.Summary,
+		From:    guardian,
+		To:      portal,
+		Value:   (*hexutil.Big)(new(big.Int)),
+		Data:    blacklist.Data,
+	}, txs[1])
+
+	batch := SafeBatch(10, "Guardian: pause", actions)
+	require.Equal(t, "10", batch.ChainID)
+	require.Equal(t, "Guardian: pause", batch.Meta.Name)
+	require.Equal(t, pause.Summary+"; "+blacklist.Summary, batch.Meta.Description)
+	require.Equal(t, []safe.Tx{
+		{To: superchainConfig, Value: "0", Data: pause.Data},
+		{To: portal, Value: "0", Data: blacklist.Data},
+	}, batch.Transactions)
+}
+
+func TestSimulate(t *testing.T) {
+	pause, err := Pause(superchainConfig, "")
+	require.NoError(t, err)
+	gameType, err := SetRespectedGameType(portal, 1)
+	require.NoError(t, err)
+
+	caller := &stubCaller{
+		t:        t,
+		failTo: map[common.Address]error{portal: errors.New("execution reverted")},
+	}
+	results := Simulate(context.Background(), caller, guardian, []Action{pause, gameType})
+	require.Equal(t, []Simulation{
+		{Summary: pause.Summary, State: "paused: true"},
+		{Summary: gameType.Summary, State: "respected game type: 0", Error: "execution reverted"},
+	}, results)
+	require.True(t, results[0].Success())
+	require.False(t, results[1].Success())
+}
+
+type stubCaller struct {
+	t        *testing.T
+	failTo map[common.Address]error
+}
+
+func (s *stubCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := superchainConfigABI.MethodById(call.Data)
+	if err != nil {
+		method, err = portalABI.MethodById(call.Data)
+		require.NoError(s.t, err)
+	}
+	switch method.Name {
+	case "paused":
+		return method.Outputs.Pack(true)
+	case "respectedGameType":
+		return method.Outputs.Pack(uint32(0))
+	}
+	require.Equal(s.t, guardian, call.From, "actions must be simulated from the sender")
+	return nil, s.failTo[*call.To]
+}
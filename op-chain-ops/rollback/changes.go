@@ -0,0 +1,138 @@
+package rollback
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// StorageChange is a single storage slot that was modified by the upgrade.
+type StorageChange struct {
+	Address common.Address `json:"address"`
+	Slot    common.Hash    `json:"slot"`
+	Pre     common.Hash    `json:"pre"`
+	Post    common.Hash    `json:"post"`
+}
+
+// DiffAllocs returns the storage changes of the given accounts between the pre- and post-upgrade states.
+// If accounts is empty, all accounts present in either state are compared.
+func DiffAllocs(pre, post *foundry.ForgeAllocs, accounts []common.Address) []StorageChange {
+	if len(accounts) == 0 {
+		for addr := range pre.Accounts {
+			accounts = append(accounts, addr)
+		}
+		for addr := range post.Accounts {
+			if _, ok := pre.Accounts[addr]; !ok {
+				accounts = append(accounts, addr)
+			}
+		}
+	}
+	var changes []StorageChange
+	for _, addr := range accounts {
+		preStorage := pre.Accounts[addr].Storage
+		postStorage := post.Accounts[addr].Storage
+		slots := make(map[common.Hash]struct{})
+		for slot := range preStorage {
+			slots[slot] = struct{}{}
+		}
+		for slot := range postStorage {
+			slots[slot] = struct{}{}
+		}
+		for slot := range slots {
+			if preStorage[slot] != postStorage[slot] {
+				changes = append(changes, StorageChange{Address: addr, Slot: slot, Pre: preStorage[slot], Post: postStorage[slot]})
+			}
+		}
+	}
+	sortChanges(changes)
+	return changes
+}
+
+type prestateDiff struct {
+	Pre  map[common.Address]prestateAccount `json:"pre"`
+	Post map[common.Address]prestateAccount `json:"post"`
+}
+
+type prestateAccount struct {
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// TraceChanges collects the storage changes made by the given upgrade transactions,
+// using the prestateTracer in diff mode. Transactions must be supplied in execution order.
+// The pre-value of each slot is taken from the supplied pre-upgrade snapshot, which is authoritative.
+func TraceChanges(ctx context.Context, cl *rpc.Client, pre *foundry.ForgeAllocs, txHashes []common.Hash) ([]StorageChange, error) {
+	type key struct {
+		addr common.Address
+		slot common.Hash
+	}
+	post := make(map[key]common.Hash)
+	for _, txHash := range txHashes {
+		var diff prestateDiff
+		if err := cl.CallContext(ctx, &diff, "debug_traceTransaction", txHash, map[string]any{
+			"tracer":       "prestateTracer",
+			"tracerConfig": map[string]any{"diffMode": true},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to trace tx %s: %w", txHash, err)
+		}
+		// Slots that are cleared only appear in the pre section of the diff.
+		for addr, acc := range diff.Pre {
+			for slot := range acc.Storage {
+				post[key{addr, slot}] = diff.Post[addr].Storage[slot]
+			}
+		}
+		for addr, acc := range diff.Post {
+			for slot, value := range acc.Storage {
+				post[key{addr, slot}] = value
+			}
+		}
+	}
+	var changes []StorageChange
+	for k, value := range post {
+		preValue := pre.Accounts[k.addr].Storage[k.slot]
+		if preValue == value {
+			continue
+		}
+		changes = append(changes, StorageChange{Address: k.addr, Slot: k.slot, Pre: preValue, Post: value})
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+// BundleProxies returns the proxies upgraded by the bundle, i.e. the targets of
+// ProxyAdmin upgrade and upgradeAndCall calls, in order of first appearance.
+func BundleProxies(batch *safe.Batch, proxyAdmin common.Address) ([]common.Address, error) {
+	var proxies []common.Address
+	for i, tx := range batch.Transactions {
+		if tx.To != proxyAdmin || len(tx.Data) < 4 {
+			continue
+		}
+		method, err := proxyAdminABI.MethodById(tx.Data[:4])
+		if err != nil || (method.Name != "upgrade" && method.Name != "upgradeAndCall") {
+			continue
+		}
+		args, err := method.Inputs.Unpack(tx.Data[4:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s call in tx %d: %w", method.Name, i, err)
+		}
+		proxy := args[0].(common.Address)
+		if !slices.Contains(proxies, proxy) {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies, nil
+}
+
+func sortChanges(changes []StorageChange) {
+	slices.SortFunc(changes, func(a, b StorageChange) int {
+		if c := bytes.Compare(a.Address[:], b.Address[:]); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.Slot[:], b.Slot[:])
+	})
+}
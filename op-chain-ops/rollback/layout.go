@@ -0,0 +1,39 @@
+package rollback
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/solc"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LoadStorageLayout reads the storage layout snapshot of a contract, as generated into
+// packages/contracts-bedrock/snapshots/storageLayout.
+func LoadStorageLayout(dir string, contract string) ([]solc.StorageLayoutEntry, error) {
+	path := filepath.Join(dir, contract+".json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage layout %q: %w", path, err)
+	}
+	var entries []solc.StorageLayoutEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to json-decode storage layout %q: %w", path, err)
+	}
+	return entries, nil
+}
+
+// SlotLabels maps each slot declared in the layout to the labels of the variables stored in it.
+// Packed variables share a slot and are listed in offset order. Only the base slot of
+// dynamic types (mappings and dynamic arrays) is known, as their contents live at hashed locations.
+func SlotLabels(layout []solc.StorageLayoutEntry) map[common.Hash][]string {
+	out := make(map[common.Hash][]string)
+	for _, entry := range layout {
+		slot := common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot)))
+		out[slot] = append(out[slot], entry.Label)
+	}
+	return out
+}
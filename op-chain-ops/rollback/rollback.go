@@ -0,0 +1,183 @@
+// Package rollback generates the state surgery required to revert a failed upgrade.
+//
+// The storage changes made by the upgrade are inverted into a Safe batch that, for each affected proxy,
+// temporarily upgrades to the StorageSetter to restore the pre-upgrade storage values,
+// and then restores the pre-upgrade implementation.
+package rollback
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/solc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	proxyAdminABIJSON    = "[{\"inputs\":[{\"internalType\":\"address payable\",\"name\":\"_proxy\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_implementation\",\"type\":\"address\"}],\"name\":\"upgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address payable\",\"name\":\"_proxy\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_implementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"upgradeAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]"
+	storageSetterABIJSON = "[{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"key\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"value\",\"type\":\"bytes32\"}],\"internalType\":\"struct StorageSetter.Slot[]\",\"name\":\"slots\",\"type\":\"tuple[]\"}],\"name\":\"setBytes32\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+	implementationLabel = "EIP-1967 implementation"
+)
+
+var (
+	ErrNotProxy          = errors.New("account is not an EIP-1967 proxy in the pre-upgrade state")
+	ErrProxyAdminChanged = errors.New("proxy admin was changed by the upgrade")
+	ErrNoChanges         = errors.New("no storage changes to roll back")
+	ErrMissingSetter     = errors.New("storage setter address is required to restore storage")
+	ErrMissingProxyAdmin = errors.New("proxy admin address is required")
+	proxyAdminABI        = mustParseABI(proxyAdminABIJSON)
+	storageSetterABI     = mustParseABI(storageSetterABIJSON)
+)
+
+type storageSetterSlot struct {
+	Key   [32]byte
+	Value [32]byte
+}
+
+type Config struct {
+	ChainID uint64
+	// ProxyAdmin is the owner of the proxies being rolled back, and the target of all generated calls.
+	ProxyAdmin common.Address
+	// StorageSetter is a deployed StorageSetter implementation, used to restore storage values.
+	StorageSetter common.Address
+	// Contracts maps proxy addresses to their contract name, used to select the storage layout.
+	Contracts map[common.Address]string
+	// Layouts maps contract names to their storage layout.
+	Layouts map[string][]solc.StorageLayoutEntry
+}
+
+// ReviewItem describes a single slot restored by the rollback, for review before signing.
+type ReviewItem struct {
+	StorageChange
+	Contract string   `json:"contract,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
+}
+
+// Unlabelled returns true if the slot is not declared in the contract's storage layout,
+// e.g. because it is a mapping entry, and so requires manual review.
+func (r ReviewItem) Unlabelled() bool {
+	return len(r.Labels) == 0
+}
+
+// Result is the generated rollback bundle, along with the review of the slots it restores.
+type Result struct {
+	Batch  *safe.Batch  `json:"batch"`
+	Review []ReviewItem `json:"review"`
+}
+
+// Build generates the Safe batch restoring the pre-upgrade values of the given storage changes.
+// The pre-upgrade state is used to look up the implementation each proxy is restored to.
+func Build(cfg Config, pre *foundry.ForgeAllocs, changes []StorageChange) (*Result, error) {
+	if cfg.ProxyAdmin == (common.Address{}) {
+		return nil, ErrMissingProxyAdmin
+	}
+	if len(changes) == 0 {
+		return nil, ErrNoChanges
+	}
+	byProxy := make(map[common.Address][]StorageChange)
+	var proxies []common.Address
+	for _, change := range changes {
+		if _, ok := byProxy[change.Address]; !ok {
+			proxies = append(proxies, change.Address)
+		}
+		byProxy[change.Address] = append(byProxy[change.Address], change)
+	}
+
+	result := &Result{
+		Batch: &safe.Batch{
+			Version:   "1.0",
+			ChainID:   fmt.Sprintf("%d", cfg.ChainID),
+			CreatedAt: uint64(time.Now().UnixMilli()),
+			Meta: safe.BatchMeta{
+				Name: "Upgrade rollback",
+			},
+		},
+	}
+	var summary []string
+	for _, proxy := range proxies {
+		txs, review, err := cfg.rollbackProxy(pre, proxy, byProxy[proxy])
+		if err != nil {
+			return nil, fmt.Errorf("failed to roll back %s: %w", proxy, err)
+		}
+		result.Batch.Transactions = append(result.Batch.Transactions, txs...)
+		result.Review = append(result.Review, review...)
+		summary = append(summary, fmt.Sprintf("%s (%d slots)", cfg.contractName(proxy), len(review)))
+	}
+	result.Batch.Meta.Description = "Restores the pre-upgrade implementation and storage of " + strings.Join(summary, ", ")
+	return result, nil
+}
+
+func (cfg Config) rollbackProxy(pre *foundry.ForgeAllocs, proxy common.Address, changes []StorageChange) ([]safe.Tx, []ReviewItem, error) {
+	impl := pre.Accounts[proxy].Storage[genesis.ImplementationSlot]
+	if impl == (common.Hash{}) {
+		return nil, nil, ErrNotProxy
+	}
+	labels := SlotLabels(cfg.Layouts[cfg.Contracts[proxy]])
+	var slots []storageSetterSlot
+	var review []ReviewItem
+	for _, change := range changes {
+		item := ReviewItem{StorageChange: change, Contract: cfg.Contracts[proxy], Labels: labels[change.Slot]}
+		switch change.Slot {
+		case genesis.AdminSlot:
+			return nil, nil, ErrProxyAdminChanged
+		case genesis.ImplementationSlot:
+			// Restored by the final upgrade call.
+			item.Labels = []string{implementationLabel}
+		default:
+			slots = append(slots, storageSetterSlot{Key: change.Slot, Value: change.Pre})
+		}
+		review = append(review, item)
+	}
+
+	var txs []safe.Tx
+	if len(slots) > 0 {
+		if cfg.StorageSetter == (common.Address{}) {
+			return nil, nil, ErrMissingSetter
+		}
+		setData, err := storageSetterABI.Pack("setBytes32", slots)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode storage restore: %w", err)
+		}
+		data, err := proxyAdminABI.Pack("upgradeAndCall", proxy, cfg.StorageSetter, setData)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode upgradeAndCall: %w", err)
+		}
+		txs = append(txs, safe.Tx{To: cfg.ProxyAdmin, Value: "0", Data: data})
+	}
+	data, err := proxyAdminABI.Pack("upgrade", proxy, common.BytesToAddress(impl[:]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode upgrade: %w", err)
+	}
+	txs = append(txs, safe.Tx{To: cfg.ProxyAdmin, Value: "0", Data: data})
+	return txs, review, nil
+}
+
+func (cfg Config) contractName(addr common.Address) string {
+	if name, ok := cfg.Contracts[addr]; ok {
+		return name
+	}
+	return addr.Hex()
+}
+
+// UnlabelledSlots returns the review items that are not declared in the storage layouts.
+func (r *Result) UnlabelledSlots() []ReviewItem {
+	return slices.DeleteFunc(slices.Clone(r.Review), func(item ReviewItem) bool {
+		return !item.Unlabelled()
+	})
+}
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,154 @@
+package rollback
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	proxyAdmin    = common.HexToAddress("0xaa")
+	storageSetter = common.HexToAddress("0xbb")
+	proxy         = common.HexToAddress("0x01")
+	oldImpl       = common.HexToAddress("0x02")
+	newImpl       = common.HexToAddress("0x03")
+)
+
+func TestDiffAllocs(t *testing.T) {
+	pre, post := upgradeAllocs()
+	changes := DiffAllocs(pre, post, nil)
+	require.Equal(t, []StorageChange{
+		{Address: proxy, Slot: common.Hash{}, Pre: common.Hash{0x01}, Post: common.Hash{0x02}},
+		{Address: proxy, Slot: common.Hash{31: 0x05}, Pre: common.Hash{0x05}, Post: common.Hash{}},
+		{Address: proxy, Slot: genesis.ImplementationSlot, Pre: common.BytesToHash(oldImpl[:]), Post: common.BytesToHash(newImpl[:])},
+	}, changes)
+
+	require.Empty(t, DiffAllocs(pre, post, []common.Address{common.HexToAddress("0x99")}))
+}
+
+func TestBuild(t *testing.T) {
+	pre, post := upgradeAllocs()
+	cfg := Config{
+		ChainID:       10,
+		ProxyAdmin:    proxyAdmin,
+		StorageSetter: storageSetter,
+		Contracts:     map[common.Address]string{proxy: "SystemConfig"},
+		Layouts: map[string][]solc.StorageLayoutEntry{
+			"SystemConfig": {
+				{Label: "_initialized", Slot: 0, Offset: 0},
+				{Label: "_initializing", Slot: 0, Offset: 1},
+			},
+		},
+	}
+
+	t.Run("RestoresStorageAndImplementation", func(t *testing.T) {
+		result, err := Build(cfg, pre, DiffAllocs(pre, post, nil))
+		require.NoError(t, err)
+		require.Equal(t, "10", result.Batch.ChainID)
+		require.Len(t, result.Batch.Transactions, 2)
+
+		setData, err := storageSetterABI.Pack("setBytes32", []storageSetterSlot{
+			{Key: common.Hash{}, Value: common.Hash{0x01}},
+			{Key: common.Hash{31: 0x05}, Value: common.Hash{0x05}},
+		})
+		require.NoError(t, err)
+		expectedRestore, err := proxyAdminABI.Pack("upgradeAndCall", proxy, storageSetter, setData)
+		require.NoError(t, err)
+		expectedUpgrade, err := proxyAdminABI.Pack("upgrade", proxy, oldImpl)
+		require.NoError(t, err)
+		require.Equal(t, safe.Tx{To: proxyAdmin, Value: "0", Data: expectedRestore}, result.Batch.Transactions[0])
+		require.Equal(t, safe.Tx{To: proxyAdmin, Value: "0", Data: expectedUpgrade}, result.Batch.Transactions[1])
+
+		require.Len(t, result.Review, 3)
+		require.Equal(t, []string{"_initialized", "_initializing"}, result.Review[0].Labels)
+		require.Equal(t, []string{implementationLabel}, result.Review[2].Labels)
+		unlabelled := result.UnlabelledSlots()
+		require.Len(t, unlabelled, 1)
+		require.Equal(t, common.Hash{31: 0x05}, unlabelled[0].Slot)
+	})
+
+	t.Run("ImplementationOnly", func(t *testing.T) {
+		changes := []StorageChange{{Address: proxy, Slot: genesis.ImplementationSlot, Pre: common.BytesToHash(oldImpl[:]), Post: common.BytesToHash(newImpl[:])}}
+		result, err := Build(Config{ProxyAdmin: proxyAdmin}, pre, changes)
+		require.NoError(t, err)
+		require.Len(t, result.Batch.Transactions, 1)
+	})
+
+	t.Run("MissingStorageSetter", func(t *testing.T) {
+		_, err := Build(Config{ProxyAdmin: proxyAdmin}, pre, DiffAllocs(pre, post, nil))
+		require.ErrorIs(t, err, ErrMissingSetter)
+	})
+
+	t.Run("NotProxy", func(t *testing.T) {
+		changes := []StorageChange{{Address: common.HexToAddress("0x99"), Slot: common.Hash{}, Pre: common.Hash{0x01}}}
+		_, err := Build(cfg, pre, changes)
+		require.ErrorIs(t, err, ErrNotProxy)
+	})
+
+	t.Run("AdminChanged", func(t *testing.T) {
+		changes := []StorageChange{{Address: proxy, Slot: genesis.AdminSlot, Pre: common.Hash{0x01}}}
+		_, err := Build(cfg, pre, changes)
+		require.ErrorIs(t, err, ErrProxyAdminChanged)
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		_, err := Build(cfg, pre, nil)
+		require.ErrorIs(t, err, ErrNoChanges)
+	})
+}
+
+func TestBundleProxies(t *testing.T) {
+	upgrade, err := proxyAdminABI.Pack("upgrade", proxy, newImpl)
+	require.NoError(t, err)
+	other := common.HexToAddress("0x04")
+	upgradeAndCall, err := proxyAdminABI.Pack("upgradeAndCall", other, newImpl, []byte{0x01})
+	require.NoError(t, err)
+	batch := &safe.Batch{Transactions: []safe.Tx{
+		{To: proxyAdmin, Data: upgrade},
+		{To: common.HexToAddress("0x05"), Data: upgrade},
+		{To: proxyAdmin, Data: upgradeAndCall},
+		{To: proxyAdmin, Data: upgrade},
+		{To: proxyAdmin, Data: []byte{0x01}},
+	}}
+	proxies, err := BundleProxies(batch, proxyAdmin)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{proxy, other}, proxies)
+}
+
+func TestLoadStorageLayout(t *testing.T) {
+	layout, err := LoadStorageLayout("../../packages/contracts-bedrock/snapshots/storageLayout", "SystemConfig")
+	require.NoError(t, err)
+	labels := SlotLabels(layout)
+	require.Equal(t, []string{"_initialized", "_initializing"}, labels[common.Hash{}])
+	require.Equal(t, []string{"_owner"}, labels[common.BigToHash(big.NewInt(51))])
+
+	_, err = LoadStorageLayout(t.TempDir(), "Missing")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func upgradeAllocs() (*foundry.ForgeAllocs, *foundry.ForgeAllocs) {
+	pre := &foundry.ForgeAllocs{Accounts: types.GenesisAlloc{
+		proxy: {Storage: map[common.Hash]common.Hash{
+			{}:                         {0x01},
+			{31: 0x05}:                 {0x05},
+			genesis.ImplementationSlot: common.BytesToHash(oldImpl[:]),
+			genesis.AdminSlot:          common.BytesToHash(proxyAdmin[:]),
+		}},
+	}}
+	post := &foundry.ForgeAllocs{Accounts: types.GenesisAlloc{
+		proxy: {Storage: map[common.Hash]common.Hash{
+			{}:                         {0x02},
+			genesis.ImplementationSlot: common.BytesToHash(newImpl[:]),
+			genesis.AdminSlot:          common.BytesToHash(proxyAdmin[:]),
+		}},
+	}}
+	return pre, post
+}
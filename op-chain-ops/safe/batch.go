@@ -0,0 +1,46 @@
+// Package safe provides the Safe Transaction Builder batch format, used to bundle transactions
+// for review and execution by a Safe multisig.
+package safe
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Batch is a batch of transactions in the Safe Transaction Builder JSON format.
+type Batch struct {
+	Version      string    `json:"version"`
+	ChainID      string    `json:"chainId"`
+	CreatedAt    uint64    `json:"createdAt"`
+	Meta         BatchMeta `json:"meta"`
+	Transactions []Tx      `json:"transactions"`
+}
+
+type BatchMeta struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Tx struct {
+	To    common.Address `json:"to"`
+	Value string         `json:"value"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+// LoadBatch reads a Safe Transaction Builder batch from the given path.
+func LoadBatch(path string) (*Batch, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open safe batch %q: %w", path, err)
+	}
+	defer f.Close()
+	var out Batch
+	if err := json.NewDecoder(f).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to json-decode safe batch %q: %w", path, err)
+	}
+	return &out, nil
+}
@@ -0,0 +1,18 @@
+package safe
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":"1.0","chainId":"1","transactions":[{"to":"0x00000000000000000000000000000000000000aa","value":"0","data":"0x01"}]}`), 0644))
+	batch, err := LoadBatch(path)
+	require.NoError(t, err)
+	require.Equal(t, []Tx{{To: common.HexToAddress("0xaa"), Value: "0", Data: []byte{0x01}}}, batch.Transactions)
+}
@@ -0,0 +1,124 @@
+package storagelayout
+
+import (
+	"fmt"
+)
+
+type ChangeKind string
+
+const (
+	// TypeChanged is a variable of which the type changed, so existing values are decoded differently.
+	TypeChanged ChangeKind = "type_changed"
+	// Moved is a variable that is stored at a different location, so it no longer reads its existing value.
+	Moved ChangeKind = "moved"
+	// Overlap is a new variable that is stored where an existing variable was, so it reads a dirty value.
+	Overlap ChangeKind = "overlap"
+	// Removed is a variable that was removed. Its value remains in storage, which is safe
+	// as long as no later version reuses the storage.
+	Removed ChangeKind = "removed"
+	// Renamed is a variable that changed label but not location or type, which is safe but should be reviewed.
+	Renamed ChangeKind = "renamed"
+	// Added is a new variable in previously unused storage, or in storage reserved by a gap.
+	Added ChangeKind = "added"
+)
+
+// Incompatible returns true if the change corrupts the existing storage of the contract.
+func (k ChangeKind) Incompatible() bool {
+	switch k {
+	case TypeChanged, Moved, Overlap:
+		return true
+	default:
+		return false
+	}
+}
+
+// Change is a difference between the old and new storage layout.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	Old  *Variable  `json:"old,omitempty"`
+	New  *Variable  `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	switch {
+	case c.Old != nil && c.New != nil:
+		return fmt.Sprintf("%s: %s -> %s", c.Kind, c.Old, c.New)
+	case c.Old != nil:
+		return fmt.Sprintf("%s: %s", c.Kind, c.Old)
+	default:
+		return fmt.Sprintf("%s: %s", c.Kind, c.New)
+	}
+}
+
+// Diff compares the storage layout of the new version of a contract against the old version.
+// Storage gaps may shrink to make room for new variables, so changes to gaps are not reported,
+// but new variables overlapping any other existing variable are.
+func Diff(prev, next []Variable) []Change {
+	var changes []Change
+	matched := make(map[int]bool)
+	for i := range prev {
+		o := prev[i]
+		if o.isGap() {
+			continue
+		}
+		idx := find(next, func(n Variable) bool { return n.Slot == o.Slot && n.Offset == o.Offset })
+		if idx < 0 || next[idx].Label != o.Label {
+			// A variable of the same name elsewhere means the variable moved, instead of being renamed or removed
+			if moved := find(next, func(n Variable) bool { return n.Label == o.Label }); moved >= 0 {
+				matched[moved] = true
+				changes = append(changes, change(Moved, &o, &next[moved]))
+				continue
+			}
+		}
+		if idx < 0 {
+			changes = append(changes, change(Removed, &o, nil))
+			continue
+		}
+		n := next[idx]
+		matched[idx] = true
+		switch {
+		case n.Type != o.Type || n.Bytes != o.Bytes:
+			changes = append(changes, change(TypeChanged, &o, &n))
+		case n.Label != o.Label:
+			changes = append(changes, change(Renamed, &o, &n))
+		}
+	}
+
+	for i := range next {
+		n := next[i]
+		if matched[i] || n.isGap() {
+			continue
+		}
+		overlap := find(prev, func(o Variable) bool { return !o.isGap() && o.overlaps(n) })
+		if overlap >= 0 {
+			changes = append(changes, change(Overlap, &prev[overlap], &n))
+		} else {
+			changes = append(changes, change(Added, nil, &n))
+		}
+	}
+	return changes
+}
+
+// Incompatible returns the changes that corrupt the existing storage of the contract.
+func Incompatible(changes []Change) []Change {
+	var out []Change
+	for _, c := range changes {
+		if c.Kind.Incompatible() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func change(kind ChangeKind, o, n *Variable) Change {
+	return Change{Kind: kind, Old: o, New: n}
+}
+
+func find(vars []Variable, fn func(v Variable) bool) int {
+	for i, v := range vars {
+		if fn(v) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,78 @@
+package storagelayout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	initialized := Variable{Bytes: 1, Label: "_initialized", Slot: 0, Offset: 0, Type: "uint8"}
+	owner := Variable{Bytes: 20, Label: "_owner", Slot: 1, Offset: 0, Type: "address"}
+	gap := Variable{Bytes: 32 * 10, Label: "__gap", Slot: 2, Type: "uint256[10]"}
+	value := Variable{Bytes: 32, Label: "value", Slot: 12, Type: "uint256"}
+	prev := []Variable{initialized, owner, gap, value}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		require.Empty(t, Diff(prev, prev))
+	})
+
+	t.Run("AddedInGap", func(t *testing.T) {
+		added := Variable{Bytes: 32, Label: "added", Slot: 2, Type: "bytes32"}
+		shrunk := Variable{Bytes: 32 * 9, Label: "__gap", Slot: 3, Type: "uint256[9]"}
+		changes := Diff(prev, []Variable{initialized, owner, added, shrunk, value})
+		require.Equal(t, []Change{{Kind: Added, New: &added}}, changes)
+		require.Empty(t, Incompatible(changes))
+	})
+
+	t.Run("AddedAtEnd", func(t *testing.T) {
+		added := Variable{Bytes: 32, Label: "added", Slot: 13, Type: "bytes32"}
+		require.Equal(t, []Change{{Kind: Added, New: &added}}, Diff(prev, append(prev[:4:4], added)))
+	})
+
+	t.Run("Packed", func(t *testing.T) {
+		packed := Variable{Bytes: 1, Label: "flag", Slot: 1, Offset: 20, Type: "bool"}
+		require.Equal(t, []Change{{Kind: Added, New: &packed}}, Diff(prev, []Variable{initialized, owner, packed, gap, value}))
+
+		overlapping := Variable{Bytes: 1, Label: "flag", Slot: 1, Offset: 19, Type: "bool"}
+		changes := Diff(prev, []Variable{initialized, owner, overlapping, gap, value})
+		require.Equal(t, []Change{{Kind: Overlap, Old: &owner, New: &overlapping}}, changes)
+		require.Equal(t, changes, Incompatible(changes))
+	})
+
+	t.Run("TypeChanged", func(t *testing.T) {
+		changed := owner
+		changed.Type = "uint160"
+		require.Equal(t, []Change{{Kind: TypeChanged, Old: &owner, New: &changed}}, Diff(prev, []Variable{initialized, changed, gap, value}))
+	})
+
+	t.Run("Renamed", func(t *testing.T) {
+		renamed := value
+		renamed.Label = "renamed"
+		changes := Diff(prev, []Variable{initialized, owner, gap, renamed})
+		require.Equal(t, []Change{{Kind: Renamed, Old: &value, New: &renamed}}, changes)
+		require.Empty(t, Incompatible(changes))
+	})
+
+	t.Run("InsertedBefore", func(t *testing.T) {
+		inserted := Variable{Bytes: 32, Label: "inserted", Slot: 1, Type: "bytes32"}
+		movedOwner := owner
+		movedOwner.Slot = 2
+		grownGap := gap
+		grownGap.Slot = 3
+		movedValue := value
+		movedValue.Slot = 13
+		changes := Diff(prev, []Variable{initialized, inserted, movedOwner, grownGap, movedValue})
+		require.Equal(t, []Change{
+			{Kind: Moved, Old: &owner, New: &movedOwner},
+			{Kind: Moved, Old: &value, New: &movedValue},
+			{Kind: Overlap, Old: &owner, New: &inserted},
+		}, changes)
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		changes := Diff(prev, []Variable{initialized, owner, gap})
+		require.Equal(t, []Change{{Kind: Removed, Old: &value}}, changes)
+		require.Empty(t, Incompatible(changes))
+	})
+}
@@ -0,0 +1,86 @@
+// Package storagelayout compares the storage layouts of two versions of a contract,
+// to detect storage collisions before a new implementation is deployed behind a proxy.
+package storagelayout
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/solc"
+)
+
+// Variable is a storage variable, in the format of the storage layout snapshots
+// in packages/contracts-bedrock/snapshots/storageLayout.
+type Variable struct {
+	Bytes  uint   `json:"bytes,string"`
+	Label  string `json:"label"`
+	Offset uint   `json:"offset"`
+	Slot   uint   `json:"slot,string"`
+	Type   string `json:"type"`
+}
+
+func (v Variable) String() string {
+	return fmt.Sprintf("%s %s (slot %d, offset %d, %d bytes)", v.Type, v.Label, v.Slot, v.Offset, v.Bytes)
+}
+
+// start returns the position of the first byte of the variable in storage.
+func (v Variable) start() uint {
+	return v.Slot*32 + v.Offset
+}
+
+// end returns the position after the last byte of the variable in storage.
+func (v Variable) end() uint {
+	return v.start() + v.Bytes
+}
+
+func (v Variable) overlaps(o Variable) bool {
+	return v.start() < o.end() && o.start() < v.end()
+}
+
+// isGap returns true if the variable is a storage gap, reserved for variables added by later versions.
+func (v Variable) isGap() bool {
+	return strings.HasPrefix(v.Label, "__gap")
+}
+
+// FromSolc converts a storage layout of the compiler output to its variables.
+func FromSolc(layout solc.StorageLayout) ([]Variable, error) {
+	out := make([]Variable, 0, len(layout.Storage))
+	for _, entry := range layout.Storage {
+		typ, ok := layout.Types[entry.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q of variable %q", entry.Type, entry.Label)
+		}
+		out = append(out, Variable{
+			Bytes:  typ.NumberOfBytes,
+			Label:  entry.Label,
+			Offset: entry.Offset,
+			Slot:   entry.Slot,
+			Type:   typ.Label,
+		})
+	}
+	return out, nil
+}
+
+// Load reads a storage layout from either a storage layout snapshot, or a forge artifact.
+func Load(path string) ([]Variable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage layout %q: %w", path, err)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var out []Variable
+		if err := json.Unmarshal(data, &out); err != nil {
+			return nil, fmt.Errorf("failed to json-decode storage layout snapshot %q: %w", path, err)
+		}
+		return out, nil
+	}
+	artifact, err := foundry.ReadArtifact(path)
+	if err != nil {
+		return nil, err
+	}
+	return FromSolc(artifact.StorageLayout)
+}
@@ -0,0 +1,58 @@
+package storagelayout
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	expected := []Variable{
+		{Bytes: 1, Label: "_initialized", Slot: 0, Offset: 0, Type: "uint8"},
+		{Bytes: 20, Label: "_owner", Slot: 1, Offset: 0, Type: "address"},
+	}
+	dir := t.TempDir()
+
+	t.Run("Snapshot", func(t *testing.T) {
+		path := filepath.Join(dir, "snapshot.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[
+			{"bytes": "1", "label": "_initialized", "offset": 0, "slot": "0", "type": "uint8"},
+			{"bytes": "20", "label": "_owner", "offset": 0, "slot": "1", "type": "address"}
+		]`), 0644))
+		layout, err := Load(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, layout)
+	})
+
+	t.Run("Artifact", func(t *testing.T) {
+		path := filepath.Join(dir, "artifact.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{
+			"abi": [],
+			"storageLayout": {
+				"storage": [
+					{"astId": 1, "contract": "C", "label": "_initialized", "offset": 0, "slot": "0", "type": "t_uint8"},
+					{"astId": 2, "contract": "C", "label": "_owner", "offset": 0, "slot": "1", "type": "t_address"}
+				],
+				"types": {
+					"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+					"t_uint8": {"encoding": "inplace", "label": "uint8", "numberOfBytes": "1"}
+				}
+			}
+		}`), 0644))
+		layout, err := Load(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, layout)
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{
+			"abi": [],
+			"storageLayout": {"storage": [{"label": "_owner", "offset": 0, "slot": "1", "type": "t_address"}], "types": {}}
+		}`), 0644))
+		_, err := Load(path)
+		require.ErrorContains(t, err, "unknown type")
+	})
+}
@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/big"
+	"os"
+	"slices"
 	"strconv"
 	"time"
 
@@ -34,6 +37,21 @@ var (
 		Usage:   "Verbose output",
 		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "VERBOSE"),
 	}
+	MinDepthFlag = &cli.Uint64Flag{
+		Name:    "min-depth",
+		Usage:   "Only list claims at or below the depth.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "MIN_DEPTH"),
+	}
+	MaxDepthFlag = &cli.Uint64Flag{
+		Name:    "max-depth",
+		Usage:   "Only list claims at or above the depth.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "MAX_DEPTH"),
+	}
+	ClaimantFlag = &cli.StringFlag{
+		Name:    "claimant",
+		Usage:   "Only list claims posted by the address.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "CLAIMANT"),
+	}
 )
 
 func ListClaims(ctx *cli.Context) error {
@@ -41,63 +59,188 @@ func ListClaims(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	rpcUrl := ctx.String(flags.L1EthRpcFlag.Name)
-	if rpcUrl == "" {
-		return fmt.Errorf("missing %v", flags.L1EthRpcFlag.Name)
+	format, err := outputFormat(ctx)
+	if err != nil {
+		return err
 	}
-	gameAddr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	filter, err := claimFilterFromCLI(ctx)
 	if err != nil {
 		return err
 	}
 
-	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, rpcUrl)
-	if err != nil {
-		return fmt.Errorf("failed to dial L1: %w", err)
+	var game *gameClaims
+	if input := ctx.Path(InputFlag.Name); input != "" {
+		game, err = loadQueryInput[gameClaims](input)
+		if err != nil {
+			return err
+		}
+	} else {
+		rpcUrl := ctx.String(flags.L1EthRpcFlag.Name)
+		if rpcUrl == "" {
+			return fmt.Errorf("missing %v", flags.L1EthRpcFlag.Name)
+		}
+		gameAddr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+		if err != nil {
+			return err
+		}
+
+		l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, rpcUrl)
+		if err != nil {
+			return fmt.Errorf("failed to dial L1: %w", err)
+		}
+		defer l1Client.Close()
+
+		caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+		contract, err := contracts.NewFaultDisputeGameContract(ctx.Context, metrics.NoopContractMetrics, gameAddr, caller)
+		if err != nil {
+			return err
+		}
+		game, err = fetchClaims(ctx.Context, gameAddr, contract, time.Now())
+		if err != nil {
+			return err
+		}
 	}
-	defer l1Client.Close()
+	game.Claims = filter.apply(game.Claims)
+	if format == OutputJSON {
+		return writeJSON(os.Stdout, game)
+	}
+	printClaims(os.Stdout, game, ctx.Bool(VerboseFlag.Name))
+	return nil
+}
 
-	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
-	contract, err := contracts.NewFaultDisputeGameContract(ctx.Context, metrics.NoopContractMetrics, gameAddr, caller)
-	if err != nil {
-		return err
+// gameClaims is a dispute game with its claims.
+type gameClaims struct {
+	Game            common.Address       `json:"game"`
+	Status          gameTypes.GameStatus `json:"status"`
+	ResolvedAt      *time.Time           `json:"resolvedAt,omitempty"`
+	L2StartBlockNum uint64               `json:"l2StartBlockNum"`
+	L2BlockNum      uint64               `json:"l2BlockNum"`
+	// L2BlockNumberChallenger is the address that challenged the L2 block number, nil if it is unchallenged.
+	L2BlockNumberChallenger *common.Address `json:"l2BlockNumberChallenger,omitempty"`
+	SplitDepth              types.Depth     `json:"splitDepth"`
+	MaxDepth                types.Depth     `json:"maxDepth"`
+	// ClaimCount is the number of claims in the game, including those not matching the filter.
+	ClaimCount int         `json:"claimCount"`
+	Claims     []claimInfo `json:"claims"`
+}
+
+type claimInfo struct {
+	Index       int            `json:"index"`
+	Move        string         `json:"move"`
+	ParentIndex int            `json:"parentIndex"`
+	Depth       types.Depth    `json:"depth"`
+	TraceIndex  *big.Int       `json:"traceIndex"`
+	Value       common.Hash    `json:"value"`
+	Claimant    common.Address `json:"claimant"`
+	Bond        *big.Int       `json:"bond"`
+	Timestamp   time.Time      `json:"timestamp"`
+	// ClockUsed is the chess clock time accumulated by the team that posted the claim at the time of the claim.
+	ClockUsed   time.Duration  `json:"clockUsed"`
+	Resolved    bool           `json:"resolved"`
+	CounteredBy common.Address `json:"counteredBy"`
+	// ResolvableAt is the time the claim can be resolved at, nil if it is resolved.
+	ResolvableAt *time.Time `json:"resolvableAt,omitempty"`
+}
+
+func (c claimInfo) IsRoot() bool {
+	return c.Depth == 0
+}
+
+// claimFilter selects the claims to list. Empty fields match all claims.
+type claimFilter struct {
+	minDepth   types.Depth
+	maxDepth   *types.Depth
+	claimant   common.Address
+	unresolved bool
+}
+
+func claimFilterFromCLI(ctx *cli.Context) (claimFilter, error) {
+	filter := claimFilter{
+		minDepth:   types.Depth(ctx.Uint64(MinDepthFlag.Name)),
+		unresolved: ctx.Bool(UnresolvedFlag.Name),
+	}
+	if ctx.IsSet(MaxDepthFlag.Name) {
+		maxDepth := types.Depth(ctx.Uint64(MaxDepthFlag.Name))
+		if maxDepth < filter.minDepth {
+			return claimFilter{}, fmt.Errorf("%v must not be less than %v", MaxDepthFlag.Name, MinDepthFlag.Name)
+		}
+		filter.maxDepth = &maxDepth
+	}
+	if ctx.IsSet(ClaimantFlag.Name) {
+		claimant, err := opservice.ParseAddress(ctx.String(ClaimantFlag.Name))
+		if err != nil {
+			return claimFilter{}, fmt.Errorf("invalid %v: %w", ClaimantFlag.Name, err)
+		}
+		filter.claimant = claimant
+	}
+	return filter, nil
+}
+
+func (f claimFilter) matches(claim claimInfo) bool {
+	if claim.Depth < f.minDepth || (f.maxDepth != nil && claim.Depth > *f.maxDepth) {
+		return false
+	}
+	if f.claimant != (common.Address{}) && f.claimant != claim.Claimant {
+		return false
 	}
-	return listClaims(ctx.Context, contract, ctx.Bool(VerboseFlag.Name))
+	if f.unresolved && claim.Resolved {
+		return false
+	}
+	return true
+}
+
+func (f claimFilter) apply(claims []claimInfo) []claimInfo {
+	return slices.DeleteFunc(claims, func(claim claimInfo) bool {
+		return !f.matches(claim)
+	})
 }
 
-func listClaims(ctx context.Context, game contracts.FaultDisputeGameContract, verbose bool) error {
+func fetchClaims(ctx context.Context, gameAddr common.Address, game contracts.FaultDisputeGameContract, now time.Time) (*gameClaims, error) {
 	metadata, err := game.GetGameMetadata(ctx, rpcblock.Latest)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve metadata: %w", err)
+		return nil, fmt.Errorf("failed to retrieve metadata: %w", err)
 	}
 	maxDepth, err := game.GetMaxGameDepth(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve max depth: %w", err)
+		return nil, fmt.Errorf("failed to retrieve max depth: %w", err)
 	}
 	maxClockDuration, err := game.GetMaxClockDuration(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve max clock duration: %w", err)
+		return nil, fmt.Errorf("failed to retrieve max clock duration: %w", err)
 	}
 	splitDepth, err := game.GetSplitDepth(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve split depth: %w", err)
+		return nil, fmt.Errorf("failed to retrieve split depth: %w", err)
 	}
-	status := metadata.Status
 	l2StartBlockNum, l2BlockNum, err := game.GetBlockRange(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve status: %w", err)
+		return nil, fmt.Errorf("failed to retrieve status: %w", err)
 	}
 
 	claims, err := game.GetAllClaims(ctx, rpcblock.Latest)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve claims: %w", err)
+		return nil, fmt.Errorf("failed to retrieve claims: %w", err)
 	}
 
-	var resolutionTime time.Time
-	if status != gameTypes.GameStatusInProgress {
-		resolutionTime, err = game.GetResolvedAt(ctx, rpcblock.Latest)
+	out := &gameClaims{
+		Game:            gameAddr,
+		Status:          metadata.Status,
+		L2StartBlockNum: l2StartBlockNum,
+		L2BlockNum:      l2BlockNum,
+		SplitDepth:      splitDepth,
+		MaxDepth:        maxDepth,
+		ClaimCount:      len(claims),
+	}
+	if metadata.Status != gameTypes.GameStatusInProgress {
+		resolutionTime, err := game.GetResolvedAt(ctx, rpcblock.Latest)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve resolved at: %w", err)
+			return nil, fmt.Errorf("failed to retrieve resolved at: %w", err)
 		}
+		out.ResolvedAt = &resolutionTime
+	}
+	if metadata.L2BlockNumberChallenged {
+		challenger := metadata.L2BlockNumberChallenger
+		out.L2BlockNumberChallenger = &challenger
 	}
 
 	// The top game runs from depth 0 to split depth *inclusive*.
@@ -106,82 +249,100 @@ func listClaims(ctx context.Context, game contracts.FaultDisputeGameContract, ve
 
 	resolved, err := game.IsResolved(ctx, rpcblock.Latest, claims...)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve claim resolution: %w", err)
+		return nil, fmt.Errorf("failed to retrieve claim resolution: %w", err)
 	}
 
 	gameState := types.NewGameState(claims, maxDepth)
-	valueFormat := "%-14v"
-	if verbose {
-		valueFormat = "%-66v"
-	}
-	now := time.Now()
-	lineFormat := "%3v %-7v %6v %5v %14v " + valueFormat + " %-42v %12v %-19v %10v %v\n"
-	info := fmt.Sprintf(lineFormat, "Idx", "Move", "Parent", "Depth", "Trace", "Value", "Claimant", "Bond (ETH)", "Time", "Clock Used", "Resolution")
 	for i, claim := range claims {
-		pos := claim.Position
-		parent := strconv.Itoa(claim.ParentContractIndex)
-		var elapsed time.Duration // Root claim does not accumulate any time on its team's chess clock
-		if claim.IsRoot() {
-			parent = "-"
-		} else {
+		info := claimInfo{
+			Index:       i,
+			Move:        "Attack",
+			ParentIndex: claim.ParentContractIndex,
+			Depth:       claim.Position.Depth(),
+			Value:       claim.Value,
+			Claimant:    claim.Claimant,
+			Bond:        claim.Bond,
+			Timestamp:   claim.Clock.Timestamp,
+			Resolved:    resolved[i],
+			CounteredBy: claim.CounteredBy,
+		}
+		// Root claim does not accumulate any time on its team's chess clock
+		if !claim.IsRoot() {
 			parentClaim, err := gameState.GetParent(claim)
 			if err != nil {
-				return fmt.Errorf("failed to retrieve parent claim: %w", err)
+				return nil, fmt.Errorf("failed to retrieve parent claim: %w", err)
 			}
 			// Get the total chess clock time accumulated by the team that posted this claim at the time of the claim.
-			elapsed = gameState.ChessClock(claim.Clock.Timestamp, parentClaim)
+			info.ClockUsed = gameState.ChessClock(claim.Clock.Timestamp, parentClaim)
 		}
-		var countered string
 		if !resolved[i] {
 			clock := gameState.ChessClock(now, claim)
-			resolvableAt := now.Add(maxClockDuration - clock).Format(time.DateTime)
-			countered = fmt.Sprintf("⏱️  %v", resolvableAt)
-		} else if claim.IsRoot() && metadata.L2BlockNumberChallenged {
-			countered = "❌ " + metadata.L2BlockNumberChallenger.Hex()
-		} else if claim.CounteredBy != (common.Address{}) {
-			countered = "❌ " + claim.CounteredBy.Hex()
-		} else {
-			countered = "✅"
+			resolvableAt := now.Add(maxClockDuration - clock)
+			info.ResolvableAt = &resolvableAt
 		}
-		move := "Attack"
 		if gameState.DefendsParent(claim) {
-			move = "Defend"
+			info.Move = "Defend"
 		}
-		var traceIdx *big.Int
 		if claim.Depth() <= splitDepth {
-			traceIdx = claim.TraceIndex(splitDepth)
+			info.TraceIndex = claim.TraceIndex(splitDepth)
 		} else {
 			relativePos, err := claim.Position.RelativeToAncestorAtDepth(splitDepth + 1)
 			if err != nil {
 				fmt.Printf("Error calculating relative position for claim %v: %v", claim.ContractIndex, err)
-				traceIdx = big.NewInt(-1)
+				info.TraceIndex = big.NewInt(-1)
 			} else {
-				traceIdx = relativePos.TraceIndex(bottomDepth)
+				info.TraceIndex = relativePos.TraceIndex(bottomDepth)
 			}
 		}
+		out.Claims = append(out.Claims, info)
+	}
+	return out, nil
+}
+
+func printClaims(w io.Writer, game *gameClaims, verbose bool) {
+	valueFormat := "%-14v"
+	if verbose {
+		valueFormat = "%-66v"
+	}
+	lineFormat := "%3v %-7v %6v %5v %14v " + valueFormat + " %-42v %12v %-19v %10v %v\n"
+	info := fmt.Sprintf(lineFormat, "Idx", "Move", "Parent", "Depth", "Trace", "Value", "Claimant", "Bond (ETH)", "Time", "Clock Used", "Resolution")
+	for _, claim := range game.Claims {
+		parent := strconv.Itoa(claim.ParentIndex)
+		if claim.IsRoot() {
+			parent = "-"
+		}
+		var countered string
+		if !claim.Resolved {
+			countered = fmt.Sprintf("⏱️  %v", claim.ResolvableAt.Format(time.DateTime))
+		} else if claim.IsRoot() && game.L2BlockNumberChallenger != nil {
+			countered = "❌ " + game.L2BlockNumberChallenger.Hex()
+		} else if claim.CounteredBy != (common.Address{}) {
+			countered = "❌ " + claim.CounteredBy.Hex()
+		} else {
+			countered = "✅"
+		}
 		value := claim.Value.TerminalString()
 		if verbose {
 			value = claim.Value.Hex()
 		}
-		timestamp := claim.Clock.Timestamp.Format(time.DateTime)
+		timestamp := claim.Timestamp.Format(time.DateTime)
 		bond := fmt.Sprintf("%12.8f", eth.WeiToEther(claim.Bond))
 		if verbose {
 			bond = fmt.Sprintf("%f", eth.WeiToEther(claim.Bond))
 		}
 		info = info + fmt.Sprintf(lineFormat,
-			i, move, parent, pos.Depth(), traceIdx, value, claim.Claimant, bond, timestamp, elapsed, countered)
+			claim.Index, claim.Move, parent, claim.Depth, claim.TraceIndex, value, claim.Claimant, bond, timestamp, claim.ClockUsed, countered)
 	}
 	blockNumChallenger := "Unchallenged"
-	if metadata.L2BlockNumberChallenged {
-		blockNumChallenger = "❌ " + metadata.L2BlockNumberChallenger.Hex()
+	if game.L2BlockNumberChallenger != nil {
+		blockNumChallenger = "❌ " + game.L2BlockNumberChallenger.Hex()
 	}
-	statusStr := status.String()
-	if status != gameTypes.GameStatusInProgress {
-		statusStr = fmt.Sprintf("%v • Resolution Time: %v", statusStr, resolutionTime.Format(time.DateTime))
+	statusStr := game.Status.String()
+	if game.ResolvedAt != nil {
+		statusStr = fmt.Sprintf("%v • Resolution Time: %v", statusStr, game.ResolvedAt.Format(time.DateTime))
 	}
-	fmt.Printf("Status: %v • L2 Blocks: %v to %v (%v) • Split Depth: %v • Max Depth: %v • Claim Count: %v\n%v\n",
-		statusStr, l2StartBlockNum, l2BlockNum, blockNumChallenger, splitDepth, maxDepth, len(claims), info)
-	return nil
+	fmt.Fprintf(w, "Status: %v • L2 Blocks: %v to %v (%v) • Split Depth: %v • Max Depth: %v • Claim Count: %v\n%v\n",
+		statusStr, game.L2StartBlockNum, game.L2BlockNum, blockNumChallenger, game.SplitDepth, game.MaxDepth, game.ClaimCount, info)
 }
 
 func listClaimsFlags() []cli.Flag {
@@ -189,6 +350,12 @@ func listClaimsFlags() []cli.Flag {
 		flags.L1EthRpcFlag,
 		GameAddressFlag,
 		VerboseFlag,
+		MinDepthFlag,
+		MaxDepthFlag,
+		ClaimantFlag,
+		UnresolvedFlag,
+		OutputFormatFlag,
+		InputFlag,
 	}
 	cliFlags = append(cliFlags, oplog.CLIFlags(flags.EnvVarPrefix)...)
 	return cliFlags
@@ -3,7 +3,10 @@ package main
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"slices"
 	"sync"
 	"time"
@@ -40,16 +43,26 @@ var (
 	}
 )
 
-func ListGames(ctx *cli.Context) error {
-	logger, err := setupLogging(ctx)
-	if err != nil {
-		return err
+var (
+	StatusFlag = &cli.StringSliceFlag{
+		Name:    "status",
+		Usage:   "Only list games with one of the statuses. Valid options: " + openum.EnumString(GameStatusNames),
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "STATUS"),
 	}
-	rpcUrl := ctx.String(flags.L1EthRpcFlag.Name)
-	if rpcUrl == "" {
-		return fmt.Errorf("missing %v", flags.L1EthRpcFlag.Name)
+	GameTypeFlag = &cli.UintSliceFlag{
+		Name:    "game-type",
+		Usage:   "Only list games of one of the game types.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "GAME_TYPE"),
 	}
-	factoryAddr, err := flags.FactoryAddress(ctx)
+	ProposerFlag = &cli.StringFlag{
+		Name:    "proposer",
+		Usage:   "Only list games proposed by the address, i.e. the claimant of the root claim.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "PROPOSER"),
+	}
+)
+
+func ListGames(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
 	if err != nil {
 		return err
 	}
@@ -61,50 +74,147 @@ func ListGames(ctx *cli.Context) error {
 	if sortOrder != "" && sortOrder != "asc" && sortOrder != "desc" {
 		return fmt.Errorf("invalid sort-order value: %v", sortOrder)
 	}
-
-	gameWindow := ctx.Duration(flags.GameWindowFlag.Name)
-
-	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, rpcUrl)
+	format, err := outputFormat(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to dial L1: %w", err)
+		return err
 	}
-	defer l1Client.Close()
-
-	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
-	contract := contracts.NewDisputeGameFactoryContract(metrics.NoopContractMetrics, factoryAddr, caller)
-	head, err := l1Client.HeaderByNumber(ctx.Context, nil)
+	filter, err := gameFilterFromCLI(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve current head block: %w", err)
+		return err
 	}
-	return listGames(ctx.Context, caller, contract, head.Hash(), gameWindow, sortBy, sortOrder)
+
+	var infos []gameInfo
+	if input := ctx.Path(InputFlag.Name); input != "" {
+		loaded, err := loadQueryInput[[]gameInfo](input)
+		if err != nil {
+			return err
+		}
+		infos = *loaded
+	} else {
+		rpcUrl := ctx.String(flags.L1EthRpcFlag.Name)
+		if rpcUrl == "" {
+			return fmt.Errorf("missing %v", flags.L1EthRpcFlag.Name)
+		}
+		factoryAddr, err := flags.FactoryAddress(ctx)
+		if err != nil {
+			return err
+		}
+		gameWindow := ctx.Duration(flags.GameWindowFlag.Name)
+
+		l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, rpcUrl)
+		if err != nil {
+			return fmt.Errorf("failed to dial L1: %w", err)
+		}
+		defer l1Client.Close()
+
+		caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+		contract := contracts.NewDisputeGameFactoryContract(metrics.NoopContractMetrics, factoryAddr, caller)
+		head, err := l1Client.HeaderByNumber(ctx.Context, nil)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve current head block: %w", err)
+		}
+		infos, err = fetchGames(ctx.Context, caller, contract, head.Hash(), gameWindow)
+		if err != nil {
+			return err
+		}
+	}
+	infos = filter.apply(infos)
+	sortGames(infos, sortBy, sortOrder)
+	if format == OutputJSON {
+		return writeJSON(os.Stdout, infos)
+	}
+	printGames(os.Stdout, infos)
+	return nil
 }
 
 type gameInfo struct {
-	types.GameMetadata
-	claimCount uint64
-	l2BlockNum uint64
-	rootClaim  common.Hash
-	status     types.GameStatus
-	err        error
+	Index      uint64           `json:"index"`
+	GameType   uint32           `json:"gameType"`
+	Timestamp  uint64           `json:"timestamp"`
+	Proxy      common.Address   `json:"game"`
+	Proposer   common.Address   `json:"proposer"`
+	ClaimCount uint64           `json:"claimCount"`
+	L2BlockNum uint64           `json:"l2BlockNum"`
+	RootClaim  common.Hash      `json:"rootClaim"`
+	Status     types.GameStatus `json:"status"`
 }
 
-func listGames(ctx context.Context, caller *batching.MultiCaller, factory *contracts.DisputeGameFactoryContract, block common.Hash, gameWindow time.Duration, sortBy, sortOrder string) error {
+// gameFilter selects the games to list. Empty fields match all games.
+type gameFilter struct {
+	statuses  []types.GameStatus
+	gameTypes []uint32
+	proposer  common.Address
+}
+
+func gameFilterFromCLI(ctx *cli.Context) (gameFilter, error) {
+	var filter gameFilter
+	for _, name := range ctx.StringSlice(StatusFlag.Name) {
+		status, err := parseGameStatus(name)
+		if err != nil {
+			return gameFilter{}, err
+		}
+		filter.statuses = append(filter.statuses, status)
+	}
+	if ctx.Bool(UnresolvedFlag.Name) {
+		if len(filter.statuses) > 0 {
+			return gameFilter{}, fmt.Errorf("cannot use %v with %v", UnresolvedFlag.Name, StatusFlag.Name)
+		}
+		filter.statuses = []types.GameStatus{types.GameStatusInProgress}
+	}
+	for _, gameType := range ctx.UintSlice(GameTypeFlag.Name) {
+		filter.gameTypes = append(filter.gameTypes, uint32(gameType))
+	}
+	if ctx.IsSet(ProposerFlag.Name) {
+		proposer, err := opservice.ParseAddress(ctx.String(ProposerFlag.Name))
+		if err != nil {
+			return gameFilter{}, fmt.Errorf("invalid %v: %w", ProposerFlag.Name, err)
+		}
+		filter.proposer = proposer
+	}
+	return filter, nil
+}
+
+func (f gameFilter) matches(game gameInfo) bool {
+	if len(f.statuses) > 0 && !slices.Contains(f.statuses, game.Status) {
+		return false
+	}
+	if len(f.gameTypes) > 0 && !slices.Contains(f.gameTypes, game.GameType) {
+		return false
+	}
+	if f.proposer != (common.Address{}) && f.proposer != game.Proposer {
+		return false
+	}
+	return true
+}
+
+func (f gameFilter) apply(games []gameInfo) []gameInfo {
+	return slices.DeleteFunc(games, func(game gameInfo) bool {
+		return !f.matches(game)
+	})
+}
+
+func fetchGames(ctx context.Context, caller *batching.MultiCaller, factory *contracts.DisputeGameFactoryContract, block common.Hash, gameWindow time.Duration) ([]gameInfo, error) {
 	earliestTimestamp := clock.MinCheckedTimestamp(clock.SystemClock, gameWindow)
 	games, err := factory.GetGamesAtOrAfter(ctx, block, earliestTimestamp)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve games: %w", err)
+		return nil, fmt.Errorf("failed to retrieve games: %w", err)
 	}
 	slices.Reverse(games)
 
 	infos := make([]gameInfo, len(games))
+	errs := make([]error, len(games))
 	var wg sync.WaitGroup
 	for idx, game := range games {
 		gameContract, err := contracts.NewFaultDisputeGameContract(ctx, metrics.NoopContractMetrics, game.Proxy, caller)
 		if err != nil {
-			return fmt.Errorf("failed to create dispute game contract: %w", err)
+			return nil, fmt.Errorf("failed to create dispute game contract: %w", err)
+		}
+		infos[idx] = gameInfo{
+			Index:     game.Index,
+			GameType:  game.GameType,
+			Timestamp: game.Timestamp,
+			Proxy:     game.Proxy,
 		}
-		info := gameInfo{GameMetadata: game}
-		infos[idx] = info
 		gameProxy := game.Proxy
 		currIndex := idx
 		wg.Add(1)
@@ -112,64 +222,74 @@ func listGames(ctx context.Context, caller *batching.MultiCaller, factory *contr
 			defer wg.Done()
 			metadata, err := gameContract.GetGameMetadata(ctx, rpcblock.ByHash(block))
 			if err != nil {
-				info.err = fmt.Errorf("failed to retrieve metadata for game %v: %w", gameProxy, err)
+				errs[currIndex] = fmt.Errorf("failed to retrieve metadata for game %v: %w", gameProxy, err)
 				return
 			}
-			infos[currIndex].status = metadata.Status
-			infos[currIndex].l2BlockNum = metadata.L2BlockNum
-			infos[currIndex].rootClaim = metadata.RootClaim
+			infos[currIndex].Status = metadata.Status
+			infos[currIndex].L2BlockNum = metadata.L2BlockNum
+			infos[currIndex].RootClaim = metadata.RootClaim
 			claimCount, err := gameContract.GetClaimCount(ctx)
 			if err != nil {
-				info.err = fmt.Errorf("failed to retrieve claim count for game %v: %w", gameProxy, err)
+				errs[currIndex] = fmt.Errorf("failed to retrieve claim count for game %v: %w", gameProxy, err)
 				return
 			}
-			infos[currIndex].claimCount = claimCount
+			infos[currIndex].ClaimCount = claimCount
+			rootClaim, err := gameContract.GetClaim(ctx, 0)
+			if err != nil {
+				errs[currIndex] = fmt.Errorf("failed to retrieve root claim for game %v: %w", gameProxy, err)
+				return
+			}
+			infos[currIndex].Proposer = rootClaim.Claimant
 		}()
 	}
 	wg.Wait()
-	lineFormat := "%3v %-42v %4v %-21v %14v %-66v %6v %-14v\n"
-	fmt.Printf(lineFormat, "Idx", "Game", "Type", "Created (Local)", "L2 Block", "Output Root", "Claims", "Status")
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
 
-	// Sort infos by the specified column
+// sortGames sorts the games by the specified column
+func sortGames(infos []gameInfo, sortBy, sortOrder string) {
+	var key func(game gameInfo) uint64
 	switch sortBy {
 	case "time":
-		slices.SortFunc(infos, func(i, j gameInfo) int {
-			if sortOrder == "desc" {
-				return cmp.Compare(j.Timestamp, i.Timestamp)
-			}
-			return cmp.Compare(i.Timestamp, j.Timestamp)
-		})
+		key = func(game gameInfo) uint64 { return game.Timestamp }
 	case "claimCount":
-		slices.SortFunc(infos, func(i, j gameInfo) int {
-			if sortOrder == "desc" {
-				return cmp.Compare(j.claimCount, i.claimCount)
-			}
-			return cmp.Compare(i.claimCount, j.claimCount)
-		})
+		key = func(game gameInfo) uint64 { return game.ClaimCount }
 	case "l2BlockNum":
-		slices.SortFunc(infos, func(i, j gameInfo) int {
-			if sortOrder == "desc" {
-				return cmp.Compare(j.l2BlockNum, i.l2BlockNum)
-			}
-			return cmp.Compare(i.l2BlockNum, j.l2BlockNum)
-		})
+		key = func(game gameInfo) uint64 { return game.L2BlockNum }
+	default:
+		return
 	}
+	slices.SortFunc(infos, func(i, j gameInfo) int {
+		if sortOrder == "desc" {
+			return cmp.Compare(key(j), key(i))
+		}
+		return cmp.Compare(key(i), key(j))
+	})
+}
 
+func printGames(w io.Writer, infos []gameInfo) {
+	lineFormat := "%3v %-42v %4v %-21v %14v %-66v %6v %-14v %-42v\n"
+	fmt.Fprintf(w, lineFormat, "Idx", "Game", "Type", "Created (Local)", "L2 Block", "Output Root", "Claims", "Status", "Proposer")
 	for _, game := range infos {
-		if game.err != nil {
-			return game.err
-		}
 		created := time.Unix(int64(game.Timestamp), 0).Format(time.DateTime)
-		fmt.Printf(lineFormat,
-			game.Index, game.Proxy, game.GameType, created, game.l2BlockNum, game.rootClaim, game.claimCount, game.status)
+		fmt.Fprintf(w, lineFormat,
+			game.Index, game.Proxy, game.GameType, created, game.L2BlockNum, game.RootClaim, game.ClaimCount, game.Status, game.Proposer)
 	}
-	return nil
 }
 
 func listGamesFlags() []cli.Flag {
 	cliFlags := []cli.Flag{
 		SortByFlag,
 		SortOrderFlag,
+		StatusFlag,
+		GameTypeFlag,
+		ProposerFlag,
+		UnresolvedFlag,
+		OutputFormatFlag,
+		InputFlag,
 		flags.L1EthRpcFlag,
 		flags.NetworkFlag,
 		flags.FactoryAddressFlag,
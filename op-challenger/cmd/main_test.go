@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
@@ -771,6 +772,27 @@ func TestRollupRpc(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon))
 		require.Equal(t, rollupRpc, cfg.RollupRpc)
 	})
+
+	t.Run("NotRequiredWithProgramOutputSource", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgsExcept(types.TraceTypeCannon, "--rollup-rpc", "--output-source=op-program"))
+		require.Equal(t, "", cfg.RollupRpc)
+	})
+}
+
+func TestOutputSource(t *testing.T) {
+	t.Run("DefaultsToRollup", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon))
+		require.Equal(t, config.OutputSourceRollup, cfg.OutputSource)
+	})
+
+	t.Run("Program", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon, "--output-source=op-program"))
+		require.Equal(t, config.OutputSourceProgram, cfg.OutputSource)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown output source: \"oracle\"", addRequiredArgs(types.TraceTypeCannon, "--output-source=oracle"))
+	})
 }
 
 func TestGameWindow(t *testing.T) {
@@ -812,6 +834,62 @@ func TestUnsafeAllowInvalidPrestate(t *testing.T) {
 	})
 }
 
+func TestVmSandbox(t *testing.T) {
+	t.Run("DefaultsToDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon))
+		require.False(t, cfg.Cannon.Sandbox.Enabled())
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon,
+			"--vm-memory-limit=4096", "--vm-cpu-time-limit=2h", "--vm-timeout=3h", "--vm-no-network"))
+		expected := vm.SandboxConfig{
+			MemoryLimit:  4096 * 1024 * 1024,
+			CPUTimeLimit: 2 * time.Hour,
+			Timeout:      3 * time.Hour,
+			NoNetwork:    true,
+		}
+		require.Equal(t, expected, cfg.Cannon.Sandbox)
+		require.Equal(t, expected, cfg.Asterisc.Sandbox)
+		require.Equal(t, expected, cfg.AsteriscKona.Sandbox)
+	})
+
+	t.Run("InvalidCPUTimeLimit", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon, "--vm-cpu-time-limit=10ms"))
+		require.ErrorContains(t, cfg.Check(), "vm cpu time limit must be at least 1s")
+	})
+}
+
+func TestMode(t *testing.T) {
+	t.Run("DefaultsToActive", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet))
+		require.Equal(t, config.ModeActive, cfg.Mode)
+	})
+
+	t.Run("Observer", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--mode=observer"))
+		require.Equal(t, config.ModeObserver, cfg.Mode)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown mode: \"passive\"", addRequiredArgs(types.TraceTypeAlphabet, "--mode=passive"))
+	})
+}
+
+func TestRPCEnabled(t *testing.T) {
+	t.Run("DefaultsToFalse", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet))
+		require.False(t, cfg.RPCEnabled)
+		require.Equal(t, 8545, cfg.RPCConfig.ListenPort)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--rpc.enabled", "--rpc.port=9999"))
+		require.True(t, cfg.RPCEnabled)
+		require.Equal(t, 9999, cfg.RPCConfig.ListenPort)
+	})
+}
+
 func TestAdditionalBondClaimants(t *testing.T) {
 	t.Run("DefaultsToEmpty", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgsExcept(types.TraceTypeAlphabet, "--additional-bond-claimants"))
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+)
+
+var OutputFormats = []string{OutputTable, OutputJSON}
+
+// GameStatusNames are the names of the game statuses, indexed by status.
+var GameStatusNames = []string{"in-progress", "challenger-won", "defender-won"}
+
+var (
+	OutputFormatFlag = &cli.StringFlag{
+		Name:    "output",
+		Usage:   "Output format. Valid options: " + openum.EnumString(OutputFormats),
+		Value:   OutputTable,
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "OUTPUT"),
+	}
+	InputFlag = &cli.PathFlag{
+		Name: "input",
+		Usage: "Path of the output of a previous query with --output=json, to query instead of L1. " +
+			"Allows querying offline, the L1 RPC is not used.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "INPUT"),
+	}
+	UnresolvedFlag = &cli.BoolFlag{
+		Name:    "unresolved",
+		Usage:   "Only list unresolved entries.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "UNRESOLVED"),
+	}
+)
+
+// outputFormat returns the output format selected with the OutputFormatFlag.
+func outputFormat(ctx *cli.Context) (string, error) {
+	format := ctx.String(OutputFormatFlag.Name)
+	if !slices.Contains(OutputFormats, format) {
+		return "", fmt.Errorf("invalid output format: %v", format)
+	}
+	return format, nil
+}
+
+// parseGameStatus parses a game status by its name, e.g. in-progress.
+func parseGameStatus(name string) (types.GameStatus, error) {
+	idx := slices.Index(GameStatusNames, name)
+	if idx < 0 {
+		return 0, fmt.Errorf("invalid game status: %v", name)
+	}
+	return types.GameStatusFromUint8(uint8(idx))
+}
+
+// loadQueryInput loads the output of a previous query with --output=json.
+func loadQueryInput[T any](path string) (*T, error) {
+	out, err := jsonutil.LoadJSON[T](path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load input: %w", err)
+	}
+	return out, nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
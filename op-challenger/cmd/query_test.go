@@ -0,0 +1,117 @@
+package main
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGameStatus(t *testing.T) {
+	for i, name := range GameStatusNames {
+		status, err := parseGameStatus(name)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatus(i), status)
+	}
+	_, err := parseGameStatus("resolved")
+	require.ErrorContains(t, err, "invalid game status: resolved")
+}
+
+func TestGameFilter(t *testing.T) {
+	proposer := common.Address{0xaa}
+	games := []gameInfo{
+		{Index: 0, GameType: 0, Status: types.GameStatusInProgress, Proposer: proposer},
+		{Index: 1, GameType: 1, Status: types.GameStatusDefenderWon, Proposer: proposer},
+		{Index: 2, GameType: 0, Status: types.GameStatusChallengerWon, Proposer: common.Address{0xbb}},
+	}
+	indices := func(filter gameFilter) []uint64 {
+		var out []uint64
+		for _, game := range filter.apply(append([]gameInfo(nil), games...)) {
+			out = append(out, game.Index)
+		}
+		return out
+	}
+
+	require.Equal(t, []uint64{0, 1, 2}, indices(gameFilter{}))
+	require.Equal(t, []uint64{0}, indices(gameFilter{statuses: []types.GameStatus{types.GameStatusInProgress}}))
+	require.Equal(t, []uint64{1, 2}, indices(gameFilter{statuses: []types.GameStatus{types.GameStatusDefenderWon, types.GameStatusChallengerWon}}))
+	require.Equal(t, []uint64{1}, indices(gameFilter{gameTypes: []uint32{1}}))
+	require.Equal(t, []uint64{0, 1}, indices(gameFilter{proposer: proposer}))
+	require.Equal(t, []uint64{2}, indices(gameFilter{gameTypes: []uint32{0}, statuses: []types.GameStatus{types.GameStatusChallengerWon}}))
+}
+
+func TestSortGames(t *testing.T) {
+	games := []gameInfo{
+		{Index: 0, Timestamp: 20, ClaimCount: 1},
+		{Index: 1, Timestamp: 10, ClaimCount: 3},
+		{Index: 2, Timestamp: 30, ClaimCount: 2},
+	}
+	sortGames(games, "time", "asc")
+	require.Equal(t, []uint64{1, 0, 2}, []uint64{games[0].Index, games[1].Index, games[2].Index})
+	sortGames(games, "claimCount", "desc")
+	require.Equal(t, []uint64{1, 2, 0}, []uint64{games[0].Index, games[1].Index, games[2].Index})
+}
+
+func TestClaimFilter(t *testing.T) {
+	claimant := common.Address{0xaa}
+	claims := []claimInfo{
+		{Index: 0, Depth: 0, Claimant: claimant, Resolved: true},
+		{Index: 1, Depth: 1, Claimant: common.Address{0xbb}, Resolved: false},
+		{Index: 2, Depth: 2, Claimant: claimant, Resolved: false},
+	}
+	indices := func(filter claimFilter) []int {
+		var out []int
+		for _, claim := range filter.apply(append([]claimInfo(nil), claims...)) {
+			out = append(out, claim.Index)
+		}
+		return out
+	}
+	maxDepth := faultTypes.Depth(1)
+
+	require.Equal(t, []int{0, 1, 2}, indices(claimFilter{}))
+	require.Equal(t, []int{1, 2}, indices(claimFilter{minDepth: 1}))
+	require.Equal(t, []int{0, 1}, indices(claimFilter{maxDepth: &maxDepth}))
+	require.Equal(t, []int{0, 2}, indices(claimFilter{claimant: claimant}))
+	require.Equal(t, []int{1, 2}, indices(claimFilter{unresolved: true}))
+	require.Equal(t, []int{2}, indices(claimFilter{unresolved: true, claimant: claimant}))
+}
+
+func TestQueryInputRoundTrip(t *testing.T) {
+	resolvableAt := time.Unix(5000, 0).UTC()
+	challenger := common.Address{0xcc}
+	game := gameClaims{
+		Game:                    common.Address{0x01},
+		Status:                  types.GameStatusInProgress,
+		L2BlockNum:              100,
+		L2BlockNumberChallenger: &challenger,
+		SplitDepth:              2,
+		MaxDepth:                4,
+		ClaimCount:              1,
+		Claims: []claimInfo{{
+			Index:        0,
+			Move:         "Attack",
+			TraceIndex:   big.NewInt(3),
+			Value:        common.Hash{0x02},
+			Claimant:     common.Address{0x03},
+			Bond:         big.NewInt(1_000_000_000_000_000_000),
+			Timestamp:    time.Unix(4000, 0).UTC(),
+			ClockUsed:    time.Minute,
+			ResolvableAt: &resolvableAt,
+		}},
+	}
+	path := filepath.Join(t.TempDir(), "claims.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, writeJSON(f, game))
+	require.NoError(t, f.Close())
+
+	loaded, err := loadQueryInput[gameClaims](path)
+	require.NoError(t, err)
+	require.Equal(t, game, *loaded)
+}
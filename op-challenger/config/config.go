@@ -11,8 +11,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -37,6 +39,9 @@ var (
 	ErrCannonNetworkAndL2Genesis        = errors.New("only specify one of network or l2 genesis path")
 	ErrCannonNetworkUnknown             = errors.New("unknown cannon network")
 	ErrMissingRollupRpc                 = errors.New("missing rollup rpc url")
+	ErrUnknownMode                      = errors.New("unknown mode")
+	ErrUnknownOutputSource              = errors.New("unknown output source")
+	ErrOutputSourceUnsupported          = errors.New("output source not supported by trace type")
 
 	ErrMissingAsteriscBin                 = errors.New("missing asterisc bin")
 	ErrMissingAsteriscServer              = errors.New("missing asterisc server")
@@ -49,6 +54,8 @@ var (
 	ErrAsteriscNetworkAndRollupConfig     = errors.New("only specify one of network or rollup config path")
 	ErrAsteriscNetworkAndL2Genesis        = errors.New("only specify one of network or l2 genesis path")
 	ErrAsteriscNetworkUnknown             = errors.New("unknown asterisc network")
+
+	ErrAsteriscKonaNoNetwork = errors.New("asterisc-kona does not support running the vm without network access")
 )
 
 const (
@@ -66,6 +73,67 @@ const (
 	DefaultMaxPendingTx = 10
 )
 
+// Mode is the mode the challenger runs in.
+type Mode string
+
+const (
+	// ModeActive plays games by sending transactions.
+	ModeActive Mode = "active"
+	// ModeObserver runs the trace providers and claim resolution logic to score games, without sending transactions.
+	ModeObserver Mode = "observer"
+)
+
+var Modes = []Mode{ModeActive, ModeObserver}
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (m *Mode) Set(value string) error {
+	if !slices.Contains(Modes, Mode(value)) {
+		return fmt.Errorf("%w: %q", ErrUnknownMode, value)
+	}
+	*m = Mode(value)
+	return nil
+}
+
+func (m *Mode) Clone() any {
+	cpy := *m
+	return &cpy
+}
+
+// OutputSource is where the challenger sources the honest output roots of the top half of output games from.
+type OutputSource string
+
+const (
+	// OutputSourceRollup queries the output roots from the rollup node at RollupRpc.
+	OutputSourceRollup OutputSource = "rollup"
+	// OutputSourceProgram derives the output roots of each game from L1 data, by running op-program natively
+	// from the starting output root of the game, so the rollup node is not a trusted dependency.
+	OutputSourceProgram OutputSource = "op-program"
+)
+
+var OutputSources = []OutputSource{OutputSourceRollup, OutputSourceProgram}
+
+func (o OutputSource) String() string {
+	return string(o)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (o *OutputSource) Set(value string) error {
+	if !slices.Contains(OutputSources, OutputSource(value)) {
+		return fmt.Errorf("%w: %q", ErrUnknownOutputSource, value)
+	}
+	*o = OutputSource(value)
+	return nil
+}
+
+func (o *OutputSource) Clone() any {
+	cpy := *o
+	return &cpy
+}
+
 // Config is a well typed config that is parsed from the CLI params.
 // This also contains config options for auxiliary services.
 // It is used to initialize the challenger.
@@ -79,6 +147,7 @@ type Config struct {
 	MaxConcurrency       uint             // Maximum number of threads to use when progressing games
 	PollInterval         time.Duration    // Polling interval for latest-block subscription when using an HTTP RPC provider
 	AllowInvalidPrestate bool             // Whether to allow responding to games where the prestate does not match
+	Mode                 Mode             // Whether to act on games, or only observe them
 
 	AdditionalBondClaimants []common.Address // List of addresses to claim bonds for in addition to the tx manager sender
 
@@ -86,7 +155,8 @@ type Config struct {
 
 	TraceTypes []types.TraceType // Type of traces supported
 
-	RollupRpc string // L2 Rollup RPC Url
+	RollupRpc    string       // L2 Rollup RPC Url
+	OutputSource OutputSource // Where the honest output roots are sourced from
 
 	L2Rpc string // L2 RPC Url
 
@@ -105,9 +175,13 @@ type Config struct {
 
 	MaxPendingTx uint64 // Maximum number of pending transactions (0 == no limit)
 
+	RPCEnabled bool // Whether to start the JSON-RPC server
+
 	TxMgrConfig   txmgr.CLIConfig
+	RPCConfig     oprpc.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	ClockConfig   clock.CLIConfig
 }
 
 func NewConfig(
@@ -127,6 +201,8 @@ func NewConfig(
 		GameFactoryAddress: gameFactoryAddress,
 		MaxConcurrency:     uint(runtime.NumCPU()),
 		PollInterval:       DefaultPollInterval,
+		Mode:               ModeActive,
+		OutputSource:       OutputSourceRollup,
 
 		TraceTypes: supportedTraceTypes,
 
@@ -135,6 +211,8 @@ func NewConfig(
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc, txmgr.DefaultChallengerFlagValues),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		RPCConfig:     oprpc.DefaultCLIConfig(),
+		ClockConfig:   clock.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
@@ -172,6 +250,11 @@ func (c Config) TraceTypeEnabled(t types.TraceType) bool {
 	return slices.Contains(c.TraceTypes, t)
 }
 
+// Observer returns true if the challenger only observes games, and never sends transactions.
+func (c Config) Observer() bool {
+	return c.Mode == ModeObserver
+}
+
 func (c Config) Check() error {
 	if c.L1EthRpc == "" {
 		return ErrMissingL1EthRPC
@@ -179,7 +262,17 @@ func (c Config) Check() error {
 	if c.L1Beacon == "" {
 		return ErrMissingL1Beacon
 	}
-	if c.RollupRpc == "" {
+	if c.OutputSource != "" && !slices.Contains(OutputSources, c.OutputSource) {
+		return fmt.Errorf("%w: %v", ErrUnknownOutputSource, c.OutputSource)
+	}
+	if c.OutputSource == OutputSourceProgram {
+		// Output roots are derived with the chain config of the VM, which the alphabet trace types do not have.
+		for _, traceType := range []types.TraceType{types.TraceTypeAlphabet, types.TraceTypeFast} {
+			if c.TraceTypeEnabled(traceType) {
+				return fmt.Errorf("%w: %v", ErrOutputSourceUnsupported, traceType)
+			}
+		}
+	} else if c.RollupRpc == "" {
 		return ErrMissingRollupRpc
 	}
 	if c.L2Rpc == "" {
@@ -197,6 +290,9 @@ func (c Config) Check() error {
 	if c.MaxConcurrency == 0 {
 		return ErrMaxConcurrencyZero
 	}
+	if c.Mode != "" && !slices.Contains(Modes, c.Mode) {
+		return fmt.Errorf("%w: %v", ErrUnknownMode, c.Mode)
+	}
 	if c.TraceTypeEnabled(types.TraceTypeCannon) || c.TraceTypeEnabled(types.TraceTypePermissioned) {
 		if c.Cannon.VmBin == "" {
 			return ErrMissingCannonBin
@@ -273,8 +369,20 @@ func (c Config) Check() error {
 			return ErrMissingAsteriscInfoFreq
 		}
 	}
-	if err := c.TxMgrConfig.Check(); err != nil {
-		return err
+	for _, vmCfg := range []vm.Config{c.Cannon, c.Asterisc, c.AsteriscKona} {
+		if err := vmCfg.Sandbox.Check(); err != nil {
+			return fmt.Errorf("invalid %v sandbox config: %w", vmCfg.VmType, err)
+		}
+	}
+	// The kona pre-image server can't serve pre-images offline, so needs network access to the L1 and L2 nodes.
+	if c.TraceTypeEnabled(types.TraceTypeAsteriscKona) && c.AsteriscKona.Sandbox.NoNetwork {
+		return ErrAsteriscKonaNoNetwork
+	}
+	// Observers never send transactions, so don't need a signer.
+	if !c.Observer() {
+		if err := c.TxMgrConfig.Check(); err != nil {
+			return err
+		}
 	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return err
@@ -282,5 +390,13 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if c.RPCEnabled {
+		if err := c.RPCConfig.Check(); err != nil {
+			return err
+		}
+	}
+	if err := c.ClockConfig.Check(); err != nil {
+		return err
+	}
 	return nil
 }
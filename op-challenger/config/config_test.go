@@ -80,6 +80,28 @@ func TestTxMgrConfig(t *testing.T) {
 	})
 }
 
+func TestMode(t *testing.T) {
+	t.Run("DefaultsToActive", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		require.Equal(t, ModeActive, config.Mode)
+		require.False(t, config.Observer())
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		config.Mode = "passive"
+		require.ErrorIs(t, config.Check(), ErrUnknownMode)
+	})
+
+	t.Run("ObserverDoesNotRequireTxMgr", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		config.Mode = ModeObserver
+		config.TxMgrConfig = txmgr.CLIConfig{}
+		require.True(t, config.Observer())
+		require.NoError(t, config.Check())
+	})
+}
+
 func TestL1EthRpcRequired(t *testing.T) {
 	config := validConfig(types.TraceTypeCannon)
 	config.L1EthRpc = ""
@@ -358,6 +380,49 @@ func TestHttpPollInterval(t *testing.T) {
 	})
 }
 
+func TestOutputSource(t *testing.T) {
+	t.Run("DefaultsToRollup", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		require.Equal(t, OutputSourceRollup, config.OutputSource)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		config.OutputSource = "oracle"
+		require.ErrorIs(t, config.Check(), ErrUnknownOutputSource)
+	})
+
+	t.Run("ProgramDoesNotRequireRollupRpc", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		config.OutputSource = OutputSourceProgram
+		config.RollupRpc = ""
+		require.NoError(t, config.Check())
+	})
+
+	for _, traceType := range []types.TraceType{types.TraceTypeAlphabet, types.TraceTypeFast} {
+		traceType := traceType
+		t.Run(fmt.Sprintf("ProgramNotSupportedBy-%v", traceType), func(t *testing.T) {
+			config := validConfig(traceType)
+			config.OutputSource = OutputSourceProgram
+			require.ErrorIs(t, config.Check(), ErrOutputSourceUnsupported)
+		})
+	}
+}
+
+func TestVmNoNetwork(t *testing.T) {
+	t.Run("Cannon", func(t *testing.T) {
+		config := validConfig(types.TraceTypeCannon)
+		config.Cannon.Sandbox.NoNetwork = true
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("AsteriscKonaUnsupported", func(t *testing.T) {
+		config := validConfig(types.TraceTypeAsteriscKona)
+		config.AsteriscKona.Sandbox.NoNetwork = true
+		require.ErrorIs(t, config.Check(), ErrAsteriscKonaNoNetwork)
+	})
+}
+
 func TestRollupRpcRequired(t *testing.T) {
 	for _, traceType := range types.TraceTypes {
 		traceType := traceType
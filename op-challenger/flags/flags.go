@@ -18,10 +18,12 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -45,9 +47,17 @@ var (
 	}
 	RollupRpcFlag = &cli.StringFlag{
 		Name:    "rollup-rpc",
-		Usage:   "HTTP provider URL for the rollup node",
+		Usage:   "HTTP provider URL for the rollup node. Not required if output roots are derived with op-program.",
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
+	OutputSourceFlag = &cli.StringFlag{
+		Name: "output-source",
+		Usage: "Source of the honest output roots of games. Valid options: " + openum.EnumString(config.OutputSources) + ". " +
+			"With op-program the output roots are derived from L1 data, starting from the output root each game agrees on, " +
+			"so the rollup node is not trusted. Derivation uses the network config and L2 RPC of the trace type.",
+		EnvVars: prefixEnvVars("OUTPUT_SOURCE"),
+		Value:   config.OutputSourceRollup.String(),
+	}
 	NetworkFlag        = flags.CLINetworkFlag(EnvVarPrefix, "")
 	FactoryAddressFlag = &cli.StringFlag{
 		Name:    "game-factory-address",
@@ -229,6 +239,39 @@ var (
 		Usage:   "Only resolve claims for the configured claimants",
 		EnvVars: prefixEnvVars("SELECTIVE_CLAIM_RESOLUTION"),
 	}
+	ModeFlag = &cli.StringFlag{
+		Name: "mode",
+		Usage: "Mode to run the challenger in. Valid options: " + openum.EnumString(config.Modes) + ". " +
+			"In observer mode games are scored and reported without sending any transactions, so no signer is required.",
+		EnvVars: prefixEnvVars("MODE"),
+		Value:   config.ModeActive.String(),
+	}
+	RPCEnabledFlag = &cli.BoolFlag{
+		Name:    "rpc.enabled",
+		Usage:   "Enable the JSON-RPC server, used to query the explanations of moves made by the challenger",
+		EnvVars: prefixEnvVars("RPC_ENABLED"),
+	}
+	VmMemoryLimitFlag = &cli.Uint64Flag{
+		Name:    "vm-memory-limit",
+		Usage:   "Maximum virtual memory in MiB of the fault proof VM and its pre-image server processes. 0 for no limit (linux only)",
+		EnvVars: prefixEnvVars("VM_MEMORY_LIMIT"),
+	}
+	VmCPUTimeLimitFlag = &cli.DurationFlag{
+		Name:    "vm-cpu-time-limit",
+		Usage:   "Maximum CPU time of the fault proof VM and its pre-image server processes. 0 for no limit (linux only)",
+		EnvVars: prefixEnvVars("VM_CPU_TIME_LIMIT"),
+	}
+	VmTimeoutFlag = &cli.DurationFlag{
+		Name:    "vm-timeout",
+		Usage:   "Maximum wall clock time of a single fault proof VM execution. 0 for no limit",
+		EnvVars: prefixEnvVars("VM_TIMEOUT"),
+	}
+	VmNoNetworkFlag = &cli.BoolFlag{
+		Name: "vm-no-network",
+		Usage: "Run the fault proof VM and its pre-image server without network access (linux only). " +
+			"The pre-images are first fetched by running the program natively, then served offline. Not supported by asterisc-kona",
+		EnvVars: prefixEnvVars("VM_NO_NETWORK"),
+	}
 	UnsafeAllowInvalidPrestate = &cli.BoolFlag{
 		Name:    "unsafe-allow-invalid-prestate",
 		Usage:   "Allow responding to games where the absolute prestate is configured incorrectly. THIS IS UNSAFE!",
@@ -241,12 +284,13 @@ var (
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
 	DatadirFlag,
-	RollupRpcFlag,
 	L1BeaconFlag,
 }
 
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
+	RollupRpcFlag,
+	OutputSourceFlag,
 	NetworkFlag,
 	FactoryAddressFlag,
 	TraceTypeFlag,
@@ -280,6 +324,12 @@ var optionalFlags = []cli.Flag{
 	AsteriscInfoFreqFlag,
 	GameWindowFlag,
 	SelectiveClaimResolutionFlag,
+	ModeFlag,
+	RPCEnabledFlag,
+	VmMemoryLimitFlag,
+	VmCPUTimeLimitFlag,
+	VmTimeoutFlag,
+	VmNoNetworkFlag,
 	UnsafeAllowInvalidPrestate,
 }
 
@@ -288,6 +338,8 @@ func init() {
 	optionalFlags = append(optionalFlags, txmgr.CLIFlagsWithDefaults(EnvVarPrefix, txmgr.DefaultChallengerFlagValues)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, clock.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -385,6 +437,9 @@ func CheckRequired(ctx *cli.Context, traceTypes []types.TraceType) error {
 			return fmt.Errorf("flag %s is required", f.Names()[0])
 		}
 	}
+	if ctx.String(OutputSourceFlag.Name) != config.OutputSourceProgram.String() && !ctx.IsSet(RollupRpcFlag.Name) {
+		return fmt.Errorf("flag %s is required", RollupRpcFlag.Name)
+	}
 	// CannonL2Flag is checked because it is an alias with L2EthRpcFlag
 	if !ctx.IsSet(CannonL2Flag.Name) && !ctx.IsSet(L2EthRpcFlag.Name) {
 		return fmt.Errorf("flag %s is required", L2EthRpcFlag.Name)
@@ -495,9 +550,20 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 		}
 	}
 
+	var mode config.Mode
+	if err := mode.Set(ctx.String(ModeFlag.Name)); err != nil {
+		return nil, err
+	}
+	var outputSource config.OutputSource
+	if err := outputSource.Set(ctx.String(OutputSourceFlag.Name)); err != nil {
+		return nil, err
+	}
+
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	rpcConfig := oprpc.ReadCLIConfig(ctx)
+	clockConfig := clock.ReadCLIConfig(ctx)
 
 	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
 	if maxConcurrency == 0 {
@@ -551,6 +617,12 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 	}
 	l1EthRpc := ctx.String(L1EthRpcFlag.Name)
 	l1Beacon := ctx.String(L1BeaconFlag.Name)
+	sandbox := vm.SandboxConfig{
+		MemoryLimit:  ctx.Uint64(VmMemoryLimitFlag.Name) * 1024 * 1024,
+		CPUTimeLimit: ctx.Duration(VmCPUTimeLimitFlag.Name),
+		Timeout:      ctx.Duration(VmTimeoutFlag.Name),
+		NoNetwork:    ctx.Bool(VmNoNetworkFlag.Name),
+	}
 	return &config.Config{
 		// Required Flags
 		L1EthRpc:                l1EthRpc,
@@ -565,6 +637,7 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 		PollInterval:            ctx.Duration(HTTPPollInterval.Name),
 		AdditionalBondClaimants: claimants,
 		RollupRpc:               ctx.String(RollupRpcFlag.Name),
+		OutputSource:            outputSource,
 		Cannon: vm.Config{
 			VmType:           types.TraceTypeCannon,
 			L1:               l1EthRpc,
@@ -579,6 +652,7 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 			InfoFreq:         ctx.Uint(CannonInfoFreqFlag.Name),
 			DebugInfo:        true,
 			BinarySnapshots:  true,
+			Sandbox:          sandbox,
 		},
 		CannonAbsolutePreState:        ctx.String(CannonPreStateFlag.Name),
 		CannonAbsolutePreStateBaseURL: cannonPrestatesURL,
@@ -595,6 +669,7 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 			L2GenesisPath:    ctx.String(AsteriscL2GenesisFlag.Name),
 			SnapshotFreq:     ctx.Uint(AsteriscSnapshotFreqFlag.Name),
 			InfoFreq:         ctx.Uint(AsteriscInfoFreqFlag.Name),
+			Sandbox:          sandbox,
 		},
 		AsteriscAbsolutePreState:        ctx.String(AsteriscPreStateFlag.Name),
 		AsteriscAbsolutePreStateBaseURL: asteriscPreStatesURL,
@@ -610,13 +685,18 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 			L2GenesisPath:    ctx.String(AsteriscL2GenesisFlag.Name),
 			SnapshotFreq:     ctx.Uint(AsteriscSnapshotFreqFlag.Name),
 			InfoFreq:         ctx.Uint(AsteriscInfoFreqFlag.Name),
+			Sandbox:          sandbox,
 		},
 		AsteriscKonaAbsolutePreState:        ctx.String(AsteriscKonaPreStateFlag.Name),
 		AsteriscKonaAbsolutePreStateBaseURL: asteriscKonaPreStatesURL,
 		TxMgrConfig:                         txMgrConfig,
 		MetricsConfig:                       metricsConfig,
 		PprofConfig:                         pprofConfig,
+		ClockConfig:                         clockConfig,
+		RPCEnabled:                          ctx.Bool(RPCEnabledFlag.Name),
+		RPCConfig:                           rpcConfig,
 		SelectiveClaimResolution:            ctx.Bool(SelectiveClaimResolutionFlag.Name),
 		AllowInvalidPrestate:                ctx.Bool(UnsafeAllowInvalidPrestate.Name),
+		Mode:                                mode,
 	}, nil
 }
@@ -11,7 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-const gameDirPrefix = "game-"
+const (
+	gameDirPrefix      = "game-"
+	explanationsDir    = "explanations"
+	observerReportFile = "observer-report.json"
+)
 
 // diskManager coordinates the storage of game data on disk.
 type diskManager struct {
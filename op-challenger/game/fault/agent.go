@@ -28,6 +28,16 @@ type Responder interface {
 	PerformAction(ctx context.Context, action types.Action) error
 }
 
+// ActionRecorder records an explanation of each action performed by the agent.
+type ActionRecorder interface {
+	RecordAction(action types.Action, actionErr error) error
+}
+
+// Observer records our view of the game, when the challenger observes games without acting on them.
+type Observer interface {
+	RecordAgreement(rootClaim common.Hash, agreeWithRootClaim bool, pendingActions int) error
+}
+
 type ClaimLoader interface {
 	GetAllClaims(ctx context.Context, block rpcblock.Block) ([]types.Claim, error)
 	IsL2BlockNumberChallenged(ctx context.Context, block rpcblock.Block) (bool, error)
@@ -40,6 +50,8 @@ type Agent struct {
 	solver           *solver.GameSolver
 	loader           ClaimLoader
 	responder        Responder
+	recorder         ActionRecorder
+	observer         Observer
 	selective        bool
 	claimants        []common.Address
 	maxDepth         types.Depth
@@ -56,6 +68,8 @@ func NewAgent(
 	maxClockDuration time.Duration,
 	trace types.TraceAccessor,
 	responder Responder,
+	recorder ActionRecorder,
+	observer Observer,
 	log log.Logger,
 	selective bool,
 	claimants []common.Address,
@@ -67,6 +81,8 @@ func NewAgent(
 		solver:           solver.NewGameSolver(maxDepth, trace),
 		loader:           loader,
 		responder:        responder,
+		recorder:         recorder,
+		observer:         observer,
 		selective:        selective,
 		claimants:        claimants,
 		maxDepth:         maxDepth,
@@ -76,8 +92,9 @@ func NewAgent(
 }
 
 // Act iterates the game & performs all of the next actions.
+// Observers always score the game, even once it can be resolved.
 func (a *Agent) Act(ctx context.Context) error {
-	if a.tryResolve(ctx) {
+	if a.tryResolve(ctx) && a.observer == nil {
 		return nil
 	}
 
@@ -102,6 +119,9 @@ func (a *Agent) Act(ctx context.Context) error {
 	if err != nil {
 		a.log.Error("Failed to calculate all required moves", "err", err)
 	}
+	if a.observer != nil {
+		a.recordAgreement(ctx, game, actions)
+	}
 
 	var wg sync.WaitGroup
 	wg.Add(len(actions))
@@ -112,6 +132,18 @@ func (a *Agent) Act(ctx context.Context) error {
 	return nil
 }
 
+func (a *Agent) recordAgreement(ctx context.Context, game types.Game, actions []types.Action) {
+	agree, err := a.solver.AgreeWithRootClaim(ctx, game)
+	if err != nil {
+		a.log.Error("Failed to determine if root claim is correct", "err", err)
+		return
+	}
+	a.log.Info("Scored game", "agreeWithRootClaim", agree, "pendingActions", len(actions))
+	if err := a.observer.RecordAgreement(game.Claims()[0].Value, agree, len(actions)); err != nil {
+		a.log.Warn("Failed to record game agreement", "err", err)
+	}
+}
+
 func (a *Agent) performAction(ctx context.Context, wg *sync.WaitGroup, action types.Action) {
 	defer wg.Done()
 	actionLog := a.log.New("action", action.Type)
@@ -141,11 +173,14 @@ func (a *Agent) performAction(ctx context.Context, wg *sync.WaitGroup, action ty
 	case types.ActionTypeChallengeL2BlockNumber:
 		a.metrics.RecordGameL2Challenge()
 	}
-	actionLog.Info("Performing action")
+	actionLog.Info("Performing action", "reason", action.Reason)
 	err := a.responder.PerformAction(ctx, action)
 	if err != nil {
 		actionLog.Error("Action failed", "err", err)
 	}
+	if err := a.recorder.RecordAction(action, err); err != nil {
+		actionLog.Warn("Failed to record action explanation", "err", err)
+	}
 }
 
 // tryResolve resolves the game if it is in a winning state
@@ -164,6 +199,10 @@ func (a *Agent) tryResolve(ctx context.Context) bool {
 	if err != nil || status == gameTypes.GameStatusInProgress {
 		return false
 	}
+	if a.observer != nil {
+		a.log.Info("Game is resolvable", "status", status)
+		return true
+	}
 	a.log.Info("Resolving game")
 	if err := a.responder.Resolve(); err != nil {
 		a.log.Error("Failed to resolve the game", "err", err)
@@ -194,6 +194,68 @@ func TestLoadClaimsWhenGameNotResolvable(t *testing.T) {
 	require.Zero(t, responder.resolveClaimCount, "should not send resolveClaim")
 }
 
+func TestRecordActionExplanations(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	recorder := &stubActionRecorder{}
+	agent.recorder = recorder
+	responder.performActionErr = errors.New("boom")
+	claimLoader.claims = []types.Claim{
+		{
+			ClaimData: types.ClaimData{
+				Value:    common.Hash{0xaa},
+				Position: types.NewPositionFromGIndex(big.NewInt(1)),
+			},
+			Clock: types.NewClock(0, l1Time),
+		},
+	}
+
+	require.NoError(t, agent.Act(context.Background()))
+
+	require.Len(t, recorder.actions, 1)
+	require.Equal(t, types.ActionTypeMove, recorder.actions[0].Type)
+	require.True(t, recorder.actions[0].IsAttack)
+	require.Equal(t, types.CounterReasonInvalidRootClaim, recorder.actions[0].Reason)
+	require.ErrorIs(t, recorder.errs[0], responder.performActionErr)
+}
+
+func TestObserverScoresGame(t *testing.T) {
+	claims := []types.Claim{
+		{
+			ClaimData: types.ClaimData{
+				Value:    common.Hash{0xaa},
+				Position: types.NewPositionFromGIndex(big.NewInt(1)),
+			},
+			Clock: types.NewClock(0, l1Time),
+		},
+	}
+
+	t.Run("InProgress", func(t *testing.T) {
+		agent, claimLoader, _ := setupTestAgent(t)
+		observer := &stubObserver{}
+		agent.observer = observer
+		claimLoader.claims = claims
+
+		require.NoError(t, agent.Act(context.Background()))
+
+		require.Equal(t, common.Hash{0xaa}, observer.rootClaim)
+		require.False(t, observer.agree)
+		require.Equal(t, 1, observer.pendingActions)
+	})
+
+	t.Run("Resolvable", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		observer := &stubObserver{}
+		agent.observer = observer
+		claimLoader.claims = claims
+		responder.callResolveStatus = gameTypes.GameStatusDefenderWon
+
+		require.NoError(t, agent.Act(context.Background()))
+
+		require.Zero(t, responder.resolveCount, "should not resolve game")
+		require.Equal(t, 1, observer.calls, "should score resolvable game")
+	})
+}
+
 func setupTestAgent(t *testing.T) (*Agent, *stubClaimLoader, *stubResponder) {
 	logger := testlog.Logger(t, log.LevelInfo)
 	claimLoader := &stubClaimLoader{}
@@ -203,7 +265,7 @@ func setupTestAgent(t *testing.T) (*Agent, *stubClaimLoader, *stubResponder) {
 	responder := &stubResponder{}
 	systemClock := clock.NewDeterministicClock(time.UnixMilli(120200))
 	l1Clock := clock.NewDeterministicClock(l1Time)
-	agent := NewAgent(metrics.NoopMetrics, systemClock, l1Clock, claimLoader, depth, gameDuration, trace.NewSimpleTraceAccessor(provider), responder, logger, false, []common.Address{})
+	agent := NewAgent(metrics.NoopMetrics, systemClock, l1Clock, claimLoader, depth, gameDuration, trace.NewSimpleTraceAccessor(provider), responder, &stubActionRecorder{}, nil, logger, false, []common.Address{})
 	return agent, claimLoader, responder
 }
 
@@ -239,6 +301,8 @@ type stubResponder struct {
 	callResolveClaimErr   error
 	resolveClaimCount     int
 	resolvedClaims        []uint64
+
+	performActionErr error
 }
 
 func (s *stubResponder) CallResolve(_ context.Context) (gameTypes.GameStatus, error) {
@@ -274,5 +338,34 @@ func (s *stubResponder) ResolveClaims(claims ...uint64) error {
 }
 
 func (s *stubResponder) PerformAction(_ context.Context, _ types.Action) error {
+	return s.performActionErr
+}
+
+type stubActionRecorder struct {
+	l       sync.Mutex
+	actions []types.Action
+	errs    []error
+}
+
+func (s *stubActionRecorder) RecordAction(action types.Action, actionErr error) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.actions = append(s.actions, action)
+	s.errs = append(s.errs, actionErr)
+	return nil
+}
+
+type stubObserver struct {
+	calls          int
+	rootClaim      common.Hash
+	agree          bool
+	pendingActions int
+}
+
+func (s *stubObserver) RecordAgreement(rootClaim common.Hash, agreeWithRootClaim bool, pendingActions int) error {
+	s.calls++
+	s.rootClaim = rootClaim
+	s.agree = agreeWithRootClaim
+	s.pendingActions = pendingActions
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 var (
 	methodWithdrawals = "withdrawals"
 	methodDelay       = "delay"
+	methodBalanceOf   = "balanceOf"
 )
 
 type DelayedWETHContract struct {
@@ -60,6 +61,16 @@ func (d *DelayedWETHContract) GetBalanceAndDelay(ctx context.Context, block rpcb
 	return balance, delay, nil
 }
 
+// GetBalanceOf returns the WETH balance of the given address, typically a dispute game.
+func (d *DelayedWETHContract) GetBalanceOf(ctx context.Context, block rpcblock.Block, addr common.Address) (*big.Int, error) {
+	defer d.metrics.StartContractRequest("GetBalanceOf")()
+	result, err := d.multiCaller.SingleCall(ctx, block, d.contract.Call(methodBalanceOf, addr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve WETH balance of %v: %w", addr, err)
+	}
+	return result.GetBigInt(0), nil
+}
+
 // GetWithdrawals returns all withdrawals made from the contract since the given block.
 func (d *DelayedWETHContract) GetWithdrawals(ctx context.Context, block rpcblock.Block, gameAddr common.Address, recipients ...common.Address) ([]*WithdrawalRequest, error) {
 	defer d.metrics.StartContractRequest("GetWithdrawals")()
@@ -58,6 +58,17 @@ func TestDelayedWeth_GetBalanceAndDelay(t *testing.T) {
 	require.Equal(t, delay, actualDelay)
 }
 
+func TestDelayedWeth_GetBalanceOf(t *testing.T) {
+	stubRpc, weth := setupDelayedWethTest(t)
+	block := rpcblock.ByNumber(482)
+	balance := big.NewInt(5729)
+	stubRpc.SetResponse(delayedWeth, methodBalanceOf, block, []interface{}{fdgAddr}, []interface{}{balance})
+
+	actual, err := weth.GetBalanceOf(context.Background(), block, fdgAddr)
+	require.NoError(t, err)
+	require.Equal(t, balance, actual)
+}
+
 func setupDelayedWethTest(t *testing.T) (*batchingTest.AbiBasedRpc, *DelayedWETHContract) {
 	delayedWethAbi := snapshots.LoadDelayedWETHABI()
 	stubRpc := batchingTest.NewAbiBasedRpc(t, delayedWeth, delayedWethAbi)
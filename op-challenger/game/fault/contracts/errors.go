@@ -0,0 +1,16 @@
+package contracts
+
+import (
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ErrorABIs returns the ABIs of the contracts the challenger sends txs to, to decode their custom errors.
+func ErrorABIs() []*abi.ABI {
+	return []*abi.ABI{
+		snapshots.LoadDisputeGameFactoryABI(),
+		snapshots.LoadFaultDisputeGameABI(),
+		snapshots.LoadPreimageOracleABI(),
+		snapshots.LoadDelayedWETHABI(),
+	}
+}
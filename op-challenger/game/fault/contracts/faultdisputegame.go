@@ -27,6 +27,7 @@ var maxChildChecks = big.NewInt(512)
 var (
 	methodMaxClockDuration        = "maxClockDuration"
 	methodMaxGameDepth            = "maxGameDepth"
+	methodClockExtension          = "clockExtension"
 	methodAbsolutePrestate        = "absolutePrestate"
 	methodStatus                  = "status"
 	methodRootClaim               = "rootClaim"
@@ -358,6 +359,17 @@ func (f *FaultDisputeGameContractLatest) GetWithdrawals(ctx context.Context, blo
 	return delayedWETH.GetWithdrawals(ctx, block, f.contract.Addr(), recipients...)
 }
 
+// GetWETHBalance returns the balance of the game in its DelayedWETH contract.
+// The balance backs the bonds of unresolved claims and the unclaimed credits of the game.
+func (f *FaultDisputeGameContractLatest) GetWETHBalance(ctx context.Context, block rpcblock.Block) (*big.Int, error) {
+	defer f.metrics.StartContractRequest("GetWETHBalance")()
+	delayedWETH, err := f.getDelayedWETH(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	return delayedWETH.GetBalanceOf(ctx, block, f.contract.Addr())
+}
+
 func (f *FaultDisputeGameContractLatest) getDelayedWETH(ctx context.Context, block rpcblock.Block) (*DelayedWETHContract, error) {
 	defer f.metrics.StartContractRequest("GetDelayedWETH")()
 	result, err := f.multiCaller.SingleCall(ctx, block, f.contract.Call(methodWETH))
@@ -385,6 +397,31 @@ func (f *FaultDisputeGameContractLatest) GetMaxClockDuration(ctx context.Context
 	return time.Duration(result.GetUint64(0)) * time.Second, nil
 }
 
+// GetClockExtension returns the time a move's clock is extended to, when it has less time remaining.
+func (f *FaultDisputeGameContractLatest) GetClockExtension(ctx context.Context) (time.Duration, error) {
+	defer f.metrics.StartContractRequest("GetClockExtension")()
+	result, err := f.multiCaller.SingleCall(ctx, rpcblock.Latest, f.contract.Call(methodClockExtension))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch clock extension: %w", err)
+	}
+	return time.Duration(result.GetUint64(0)) * time.Second, nil
+}
+
+// GetOracleChallengePeriod returns the challenge period for large preimages of the game's preimage oracle,
+// which is added to the clock extension of moves before an execution step.
+func (f *FaultDisputeGameContractLatest) GetOracleChallengePeriod(ctx context.Context) (time.Duration, error) {
+	defer f.metrics.StartContractRequest("GetOracleChallengePeriod")()
+	oracle, err := f.GetOracle(ctx)
+	if err != nil {
+		return 0, err
+	}
+	period, err := oracle.ChallengePeriod(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(period) * time.Second, nil
+}
+
 func (f *FaultDisputeGameContractLatest) GetMaxGameDepth(ctx context.Context) (types.Depth, error) {
 	defer f.metrics.StartContractRequest("GetMaxGameDepth")()
 	result, err := f.multiCaller.SingleCall(ctx, rpcblock.Latest, f.contract.Call(methodMaxGameDepth))
@@ -619,9 +656,12 @@ type FaultDisputeGameContract interface {
 	GetRequiredBond(ctx context.Context, position types.Position) (*big.Int, error)
 	UpdateOracleTx(ctx context.Context, claimIdx uint64, data *types.PreimageOracleData) (txmgr.TxCandidate, error)
 	GetWithdrawals(ctx context.Context, block rpcblock.Block, recipients ...common.Address) ([]*WithdrawalRequest, error)
+	GetWETHBalance(ctx context.Context, block rpcblock.Block) (*big.Int, error)
 	GetOracle(ctx context.Context) (PreimageOracleContract, error)
 	GetMaxClockDuration(ctx context.Context) (time.Duration, error)
 	GetMaxGameDepth(ctx context.Context) (types.Depth, error)
+	GetClockExtension(ctx context.Context) (time.Duration, error)
+	GetOracleChallengePeriod(ctx context.Context) (time.Duration, error)
 	GetAbsolutePrestateHash(ctx context.Context) (common.Hash, error)
 	GetL1Head(ctx context.Context) (common.Hash, error)
 	GetStatus(ctx context.Context) (gameTypes.GameStatus, error)
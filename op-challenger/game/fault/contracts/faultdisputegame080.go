@@ -70,6 +70,11 @@ func (f *FaultDisputeGameContract080) GetMaxClockDuration(ctx context.Context) (
 	return time.Duration(result.GetUint64(0)) * time.Second / 2, nil
 }
 
+// GetClockExtension returns 0 since clocks are not extended in this version.
+func (f *FaultDisputeGameContract080) GetClockExtension(_ context.Context) (time.Duration, error) {
+	return 0, nil
+}
+
 func (f *FaultDisputeGameContract080) GetClaim(ctx context.Context, idx uint64) (types.Claim, error) {
 	claim, err := f.FaultDisputeGameContractLatest.GetClaim(ctx, idx)
 	if err != nil {
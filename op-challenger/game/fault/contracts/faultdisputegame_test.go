@@ -116,6 +116,18 @@ func TestSimpleGetters(t *testing.T) {
 				return version.version == vers080
 			},
 		},
+		{
+			methodAlias: "clockExtension",
+			method:      methodClockExtension,
+			result:      uint64(3600),
+			expected:    time.Hour,
+			call: func(game FaultDisputeGameContract) (any, error) {
+				return game.GetClockExtension(context.Background())
+			},
+			applies: func(version contractVersion) bool {
+				return version.version != vers080
+			},
+		},
 		{
 			methodAlias: "maxGameDepth",
 			method:      methodMaxGameDepth,
@@ -354,6 +366,25 @@ func TestGetBalance(t *testing.T) {
 	}
 }
 
+func TestGetWETHBalance(t *testing.T) {
+	for _, version := range versions {
+		version := version
+		t.Run(version.version, func(t *testing.T) {
+			wethAddr := common.Address{0x11, 0x55, 0x66}
+			balance := big.NewInt(3423)
+			block := rpcblock.ByNumber(424)
+			stubRpc, game := setupFaultDisputeGameTest(t, version)
+			stubRpc.SetResponse(fdgAddr, methodWETH, block, nil, []interface{}{wethAddr})
+			stubRpc.AddContract(wethAddr, snapshots.LoadDelayedWETHABI())
+			stubRpc.SetResponse(wethAddr, methodBalanceOf, block, []interface{}{fdgAddr}, []interface{}{balance})
+
+			actual, err := game.GetWETHBalance(context.Background(), block)
+			require.NoError(t, err)
+			require.Equal(t, balance, actual)
+		})
+	}
+}
+
 func TestCallResolveClaim(t *testing.T) {
 	for _, version := range versions {
 		version := version
@@ -0,0 +1,114 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	methodNextOutputIndex = "nextOutputIndex"
+	methodGetL2Output     = "getL2Output"
+)
+
+// L2OutputOracleContract is a binding for the legacy L2OutputOracle, which stores output proposals
+// of chains that have not migrated to dispute games yet.
+type L2OutputOracleContract struct {
+	metrics     metrics.ContractMetricer
+	multiCaller *batching.MultiCaller
+	contract    *batching.BoundContract
+}
+
+// OutputProposal is an output root proposed to the L2OutputOracle.
+type OutputProposal struct {
+	Index         uint64
+	OutputRoot    common.Hash
+	Timestamp     uint64
+	L2BlockNumber uint64
+}
+
+type outputProposalResult struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+func NewL2OutputOracleContract(metrics metrics.ContractMetricer, addr common.Address, caller *batching.MultiCaller) *L2OutputOracleContract {
+	return &L2OutputOracleContract{
+		metrics:     metrics,
+		multiCaller: caller,
+		contract:    batching.NewBoundContract(snapshots.LoadL2OutputOracleABI(), addr),
+	}
+}
+
+func (o *L2OutputOracleContract) Addr() common.Address {
+	return o.contract.Addr()
+}
+
+// GetOutputCount returns the number of output proposals.
+func (o *L2OutputOracleContract) GetOutputCount(ctx context.Context, blockHash common.Hash) (uint64, error) {
+	defer o.metrics.StartContractRequest("GetOutputCount")()
+	result, err := o.multiCaller.SingleCall(ctx, rpcblock.ByHash(blockHash), o.contract.Call(methodNextOutputIndex))
+	if err != nil {
+		return 0, fmt.Errorf("failed to load output count: %w", err)
+	}
+	return result.GetBigInt(0).Uint64(), nil
+}
+
+// GetOutputsAtOrAfter returns the output proposals proposed at or after the earliest timestamp,
+// in descending order of index.
+func (o *L2OutputOracleContract) GetOutputsAtOrAfter(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]OutputProposal, error) {
+	defer o.metrics.StartContractRequest("GetOutputsAtOrAfter")()
+	count, err := o.GetOutputCount(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := uint64(o.multiCaller.BatchSize())
+	rangeEnd := count
+
+	var outputs []OutputProposal
+	for {
+		if rangeEnd == uint64(0) {
+			// rangeEnd is exclusive so if its 0 we've reached the end.
+			return outputs, nil
+		}
+		rangeStart := uint64(0)
+		if rangeEnd > batchSize {
+			rangeStart = rangeEnd - batchSize
+		}
+		calls := make([]batching.Call, 0, rangeEnd-rangeStart)
+		for i := rangeEnd - 1; ; i-- {
+			calls = append(calls, o.contract.Call(methodGetL2Output, new(big.Int).SetUint64(i)))
+			// Break once we've added the last call to avoid underflow when rangeStart == 0
+			if i == rangeStart {
+				break
+			}
+		}
+
+		results, err := o.multiCaller.Call(ctx, rpcblock.ByHash(blockHash), calls...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch outputs: %w", err)
+		}
+
+		for i, result := range results {
+			var output outputProposalResult
+			result.GetStruct(0, &output)
+			if output.Timestamp.Uint64() < earliestTimestamp {
+				return outputs, nil
+			}
+			outputs = append(outputs, OutputProposal{
+				Index:         rangeEnd - uint64(i) - 1,
+				OutputRoot:    output.OutputRoot,
+				Timestamp:     output.Timestamp.Uint64(),
+				L2BlockNumber: output.L2BlockNumber.Uint64(),
+			})
+		}
+		rangeEnd = rangeStart
+	}
+}
@@ -0,0 +1,72 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var l2ooAddr = common.HexToAddress("0x34112842371dFC380576ebb09Ae16Cb6B6caD7CB")
+
+func TestGetOutputsAtOrAfter(t *testing.T) {
+	tests := []struct {
+		outputCount       int
+		earliestOutputIdx int
+	}{
+		{outputCount: batchSize * 4, earliestOutputIdx: batchSize + 3},
+		{outputCount: 0, earliestOutputIdx: 0},
+		{outputCount: batchSize * 2, earliestOutputIdx: batchSize},
+		{outputCount: batchSize * 2, earliestOutputIdx: batchSize*2 + 1},
+		{outputCount: batchSize - 2, earliestOutputIdx: batchSize - 3},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprintf("Count_%v_Start_%v", test.outputCount, test.earliestOutputIdx), func(t *testing.T) {
+			blockHash := common.Hash{0xbb, 0xce}
+			stubRpc := batchingTest.NewAbiBasedRpc(t, l2ooAddr, snapshots.LoadL2OutputOracleABI())
+			oracle := NewL2OutputOracleContract(metrics.NoopContractMetrics, l2ooAddr, batching.NewMultiCaller(stubRpc, batchSize))
+			var allOutputs []OutputProposal
+			for i := 0; i < test.outputCount; i++ {
+				allOutputs = append(allOutputs, OutputProposal{
+					Index:         uint64(i),
+					OutputRoot:    common.Hash{byte(i)},
+					Timestamp:     uint64(i),
+					L2BlockNumber: uint64(i * 100),
+				})
+			}
+
+			stubRpc.SetResponse(l2ooAddr, methodNextOutputIndex, rpcblock.ByHash(blockHash), nil, []interface{}{big.NewInt(int64(len(allOutputs)))})
+			for _, output := range allOutputs {
+				stubRpc.SetResponse(l2ooAddr, methodGetL2Output, rpcblock.ByHash(blockHash),
+					[]interface{}{new(big.Int).SetUint64(output.Index)},
+					[]interface{}{outputProposalResult{
+						OutputRoot:    output.OutputRoot,
+						Timestamp:     new(big.Int).SetUint64(output.Timestamp),
+						L2BlockNumber: new(big.Int).SetUint64(output.L2BlockNumber),
+					}})
+			}
+			actual, err := oracle.GetOutputsAtOrAfter(context.Background(), blockHash, uint64(test.earliestOutputIdx))
+			require.NoError(t, err)
+			// Outputs come back in descending index order
+			var expected []OutputProposal
+			if test.earliestOutputIdx < len(allOutputs) {
+				expected = slices.Clone(allOutputs[test.earliestOutputIdx:])
+			}
+			slices.Reverse(expected)
+			require.Equal(t, len(expected), len(actual))
+			if len(expected) != 0 {
+				require.Equal(t, expected, actual)
+			}
+		})
+	}
+}
@@ -117,31 +117,41 @@ func (c *PreimageOracleContractLatest) AddGlobalDataTx(data *types.PreimageOracl
 		return txmgr.TxCandidate{}, ErrInvalidPreimageKey
 	}
 	keyType := preimage.KeyType(data.OracleKey[0])
-	switch keyType {
-	case preimage.Keccak256KeyType:
-		call := c.contract.Call(methodLoadKeccak256PreimagePart, new(big.Int).SetUint64(uint64(data.OracleOffset)), data.GetPreimageWithoutSize())
-		return call.ToTxCandidate()
-	case preimage.Sha256KeyType:
-		call := c.contract.Call(methodLoadSha256PreimagePart, new(big.Int).SetUint64(uint64(data.OracleOffset)), data.GetPreimageWithoutSize())
-		return call.ToTxCandidate()
-	case preimage.BlobKeyType:
-		call := c.contract.Call(methodLoadBlobPreimagePart,
+	loadCall, ok := globalDataCalls.Lookup(keyType)
+	if !ok {
+		return txmgr.TxCandidate{}, fmt.Errorf("%w: %v", ErrUnsupportedKeyType, keyType)
+	}
+	return loadCall(c.contract, data).ToTxCandidate()
+}
+
+// globalDataCall returns the call that loads the part of a global pre-image of its key type into the oracle.
+type globalDataCall func(contract *batching.BoundContract, data *types.PreimageOracleData) *batching.ContractCall
+
+// globalDataCalls are the calls to load the global pre-images of each key type that the oracle supports.
+var globalDataCalls preimage.KeyTypeBehaviors[globalDataCall]
+
+func init() {
+	globalDataCalls.Register(preimage.Keccak256KeyType, func(contract *batching.BoundContract, data *types.PreimageOracleData) *batching.ContractCall {
+		return contract.Call(methodLoadKeccak256PreimagePart, new(big.Int).SetUint64(uint64(data.OracleOffset)), data.GetPreimageWithoutSize())
+	})
+	globalDataCalls.Register(preimage.Sha256KeyType, func(contract *batching.BoundContract, data *types.PreimageOracleData) *batching.ContractCall {
+		return contract.Call(methodLoadSha256PreimagePart, new(big.Int).SetUint64(uint64(data.OracleOffset)), data.GetPreimageWithoutSize())
+	})
+	globalDataCalls.Register(preimage.BlobKeyType, func(contract *batching.BoundContract, data *types.PreimageOracleData) *batching.ContractCall {
+		return contract.Call(methodLoadBlobPreimagePart,
 			new(big.Int).SetUint64(data.BlobFieldIndex),
 			new(big.Int).SetBytes(data.GetPreimageWithoutSize()),
 			data.BlobCommitment,
 			data.BlobProof,
 			new(big.Int).SetUint64(uint64(data.OracleOffset)))
-		return call.ToTxCandidate()
-	case preimage.PrecompileKeyType:
-		call := c.contract.Call(methodLoadPrecompilePreimagePart,
+	})
+	globalDataCalls.Register(preimage.PrecompileKeyType, func(contract *batching.BoundContract, data *types.PreimageOracleData) *batching.ContractCall {
+		return contract.Call(methodLoadPrecompilePreimagePart,
 			new(big.Int).SetUint64(uint64(data.OracleOffset)),
 			data.GetPrecompileAddress(),
 			data.GetPrecompileRequiredGas(),
 			data.GetPrecompileInput())
-		return call.ToTxCandidate()
-	default:
-		return txmgr.TxCandidate{}, fmt.Errorf("%w: %v", ErrUnsupportedKeyType, keyType)
-	}
+	})
 }
 
 func (c *PreimageOracleContractLatest) InitLargePreimage(uuid *big.Int, partOffset uint32, claimedSize uint32) (txmgr.TxCandidate, error) {
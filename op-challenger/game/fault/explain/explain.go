@@ -0,0 +1,187 @@
+// Package explain records a structured explanation of every move the challenger makes,
+// so that its decisions can be audited after a dispute has concluded.
+package explain
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const fileExt = ".jsonl"
+
+// Explanation describes a single action taken by the challenger and why it was taken.
+type Explanation struct {
+	Game   common.Address      `json:"game"`
+	Time   time.Time           `json:"time"`
+	Action types.ActionType    `json:"action"`
+	Reason types.CounterReason `json:"reason"`
+
+	// ParentIndex, ParentPosition and ParentClaim describe the opponent claim being responded to.
+	ParentIndex    uint64         `json:"parentIndex"`
+	ParentDepth    types.Depth    `json:"parentDepth"`
+	ParentPosition *big.Int       `json:"parentPosition"`
+	ParentClaim    common.Hash    `json:"parentClaim"`
+	ParentClaimant common.Address `json:"parentClaimant"`
+
+	// IsAttack is true if the parent claim disagrees with our trace.
+	IsAttack bool `json:"isAttack"`
+	// Depth and Position are the location of the claim posted by a move.
+	Depth    types.Depth `json:"depth,omitempty"`
+	Position *big.Int    `json:"position,omitempty"`
+	// TraceHash is the value from our trace posted by a move.
+	TraceHash common.Hash `json:"traceHash,omitempty"`
+	// OracleKey is the preimage key loaded as part of a step, if any.
+	OracleKey hexutil.Bytes `json:"oracleKey,omitempty"`
+
+	// Error is the error returned when performing the action, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// FromAction creates an explanation for the action. The Game and Time fields are not set.
+func FromAction(action types.Action, err error) Explanation {
+	e := Explanation{
+		Action:   action.Type,
+		Reason:   action.Reason,
+		IsAttack: action.IsAttack,
+	}
+	if action.Type == types.ActionTypeMove || action.Type == types.ActionTypeStep {
+		parent := action.ParentClaim
+		e.ParentIndex = uint64(parent.ContractIndex)
+		e.ParentDepth = parent.Depth()
+		e.ParentPosition = parent.IndexAtDepth()
+		e.ParentClaim = parent.Value
+		e.ParentClaimant = parent.Claimant
+	}
+	switch action.Type {
+	case types.ActionTypeMove:
+		position := action.ParentClaim.Defend()
+		if action.IsAttack {
+			position = action.ParentClaim.Attack()
+		}
+		e.Depth = position.Depth()
+		e.Position = position.IndexAtDepth()
+		e.TraceHash = action.Value
+	case types.ActionTypeStep:
+		if action.OracleData != nil {
+			e.OracleKey = action.OracleData.OracleKey
+		}
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	return e
+}
+
+// Store persists explanations to disk as one JSON line per action, with a separate file for each game.
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create explanations dir %v: %w", dir, err)
+	}
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) path(game common.Address) string {
+	return filepath.Join(s.dir, game.Hex()+fileExt)
+}
+
+// Record appends the explanation to the log for its game.
+func (s *Store) Record(e Explanation) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode explanation: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path(e.Game), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open explanations for game %v: %w", e.Game, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write explanation for game %v: %w", e.Game, err)
+	}
+	return nil
+}
+
+// Explanations returns all recorded explanations for the game, in the order they were recorded.
+func (s *Store) Explanations(game common.Address) ([]Explanation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.Open(s.path(game))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open explanations for game %v: %w", game, err)
+	}
+	defer f.Close()
+	var out []Explanation
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Explanation
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("failed to decode explanation for game %v: %w", game, err)
+		}
+		out = append(out, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read explanations for game %v: %w", game, err)
+	}
+	return out, nil
+}
+
+// Games returns the addresses of all games with recorded explanations.
+func (s *Store) Games() ([]common.Address, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list explanations: %w", err)
+	}
+	var games []common.Address
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), fileExt)
+		if entry.IsDir() || !ok || !common.IsHexAddress(name) {
+			continue
+		}
+		games = append(games, common.HexToAddress(name))
+	}
+	slices.SortFunc(games, func(a, b common.Address) int {
+		return a.Cmp(b)
+	})
+	return games, nil
+}
+
+// ForGame returns a Recorder that records explanations for the specified game.
+func (s *Store) ForGame(game common.Address, now func() time.Time) *GameRecorder {
+	return &GameRecorder{store: s, game: game, now: now}
+}
+
+// GameRecorder records explanations for the actions taken in a single game.
+type GameRecorder struct {
+	store *Store
+	game  common.Address
+	now   func() time.Time
+}
+
+func (r *GameRecorder) RecordAction(action types.Action, actionErr error) error {
+	e := FromAction(action, actionErr)
+	e.Game = r.game
+	e.Time = r.now()
+	return r.store.Record(e)
+}
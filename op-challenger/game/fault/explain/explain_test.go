@@ -0,0 +1,112 @@
+package explain
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromAction(t *testing.T) {
+	parent := types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{0xaa},
+			Position: types.NewPosition(2, big.NewInt(0)),
+		},
+		Claimant:      common.Address{0xbb},
+		ContractIndex: 3,
+	}
+
+	t.Run("Attack", func(t *testing.T) {
+		e := FromAction(types.Action{
+			Type:        types.ActionTypeMove,
+			Reason:      types.CounterReasonCountersHonestClaim,
+			ParentClaim: parent,
+			IsAttack:    true,
+			Value:       common.Hash{0xcc},
+		}, nil)
+		require.Equal(t, types.ActionTypeMove, e.Action)
+		require.Equal(t, types.CounterReasonCountersHonestClaim, e.Reason)
+		require.EqualValues(t, 3, e.ParentIndex)
+		require.EqualValues(t, 2, e.ParentDepth)
+		require.Equal(t, big.NewInt(0), e.ParentPosition)
+		require.Equal(t, common.Hash{0xaa}, e.ParentClaim)
+		require.Equal(t, common.Address{0xbb}, e.ParentClaimant)
+		require.EqualValues(t, 3, e.Depth)
+		require.Equal(t, big.NewInt(0), e.Position)
+		require.Equal(t, common.Hash{0xcc}, e.TraceHash)
+		require.Empty(t, e.Error)
+	})
+
+	t.Run("Defend", func(t *testing.T) {
+		e := FromAction(types.Action{Type: types.ActionTypeMove, ParentClaim: parent}, nil)
+		require.EqualValues(t, 3, e.Depth)
+		require.Equal(t, big.NewInt(2), e.Position)
+	})
+
+	t.Run("StepWithError", func(t *testing.T) {
+		e := FromAction(types.Action{
+			Type:        types.ActionTypeStep,
+			ParentClaim: parent,
+			OracleData:  &types.PreimageOracleData{OracleKey: []byte{0x01}},
+		}, errors.New("boom"))
+		require.Equal(t, []byte{0x01}, []byte(e.OracleKey))
+		require.Nil(t, e.Position)
+		require.Equal(t, "boom", e.Error)
+	})
+
+	t.Run("ChallengeL2BlockNumber", func(t *testing.T) {
+		e := FromAction(types.Action{
+			Type:   types.ActionTypeChallengeL2BlockNumber,
+			Reason: types.CounterReasonInvalidL2BlockNumber,
+		}, nil)
+		require.Equal(t, types.CounterReasonInvalidL2BlockNumber, e.Reason)
+		require.Nil(t, e.ParentPosition)
+	})
+}
+
+func TestStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "explanations")
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+
+	game1 := common.Address{0x01}
+	game2 := common.Address{0x02}
+	now := time.Unix(1000, 0).UTC()
+	action := types.Action{
+		Type:        types.ActionTypeMove,
+		Reason:      types.CounterReasonInvalidRootClaim,
+		ParentClaim: types.Claim{ClaimData: types.ClaimData{Position: types.NewPositionFromGIndex(big.NewInt(1))}},
+		IsAttack:    true,
+	}
+
+	explanations, err := store.Explanations(game1)
+	require.NoError(t, err)
+	require.Empty(t, explanations)
+
+	recorder := store.ForGame(game1, func() time.Time { return now })
+	require.NoError(t, recorder.RecordAction(action, nil))
+	require.NoError(t, recorder.RecordAction(action, errors.New("boom")))
+	require.NoError(t, store.ForGame(game2, func() time.Time { return now }).RecordAction(action, nil))
+
+	explanations, err = store.Explanations(game1)
+	require.NoError(t, err)
+	require.Len(t, explanations, 2)
+	require.Equal(t, game1, explanations[0].Game)
+	require.Equal(t, now, explanations[0].Time)
+	require.Equal(t, types.CounterReasonInvalidRootClaim, explanations[0].Reason)
+	require.Empty(t, explanations[0].Error)
+	require.Equal(t, "boom", explanations[1].Error)
+
+	// Unrelated files are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("hi"), 0644))
+	games, err := store.Games()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{game1, game2}, games)
+}
@@ -0,0 +1,199 @@
+// Package observer scores dispute games without acting on them, so the challenger can be run by parties
+// that want to validate every claim but must never send transactions.
+package observer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrObserverMode is returned for operations that would require sending a transaction.
+var ErrObserverMode = errors.New("not supported in observer mode")
+
+type Metrics interface {
+	RecordObservedGames(agree, disagree, unexpectedResolution int)
+}
+
+// Report is the observed state of a single game.
+type Report struct {
+	Game      common.Address `json:"game"`
+	Time      time.Time      `json:"time"`
+	RootClaim common.Hash    `json:"rootClaim"`
+	// Scored is true once the root claim has been compared to our trace.
+	Scored bool `json:"scored"`
+	// AgreeWithRootClaim is true if the root claim matches our trace.
+	AgreeWithRootClaim bool `json:"agreeWithRootClaim"`
+	// ExpectedStatus is the status the game resolves to if the honest actor plays it correctly.
+	ExpectedStatus gameTypes.GameStatus `json:"expectedStatus"`
+	// Status is the last known status of the game.
+	Status gameTypes.GameStatus `json:"status"`
+	// PendingActions is the number of actions the challenger would have taken in the last update.
+	PendingActions int `json:"pendingActions"`
+}
+
+// UnexpectedResolution returns true if the game resolved to a different status than expected.
+func (r Report) UnexpectedResolution() bool {
+	return r.Scored && r.Status != gameTypes.GameStatusInProgress && r.Status != r.ExpectedStatus
+}
+
+// Store keeps the reports of all observed games, and writes them to a single JSON file whenever they change.
+type Store struct {
+	path    string
+	m       Metrics
+	mu      sync.Mutex
+	reports map[common.Address]Report
+}
+
+// NewStore creates a store writing to the report file at path. Reports from a previous run are loaded if present.
+func NewStore(path string, m Metrics) (*Store, error) {
+	s := &Store{
+		path:    path,
+		m:       m,
+		reports: make(map[common.Address]Report),
+	}
+	existing, err := jsonutil.LoadJSON[[]Report](path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load observer report: %w", err)
+	}
+	for _, r := range *existing {
+		s.reports[r.Game] = r
+	}
+	s.recordMetrics()
+	return s, nil
+}
+
+// Reports returns the reports of all observed games, ordered by game address.
+func (s *Store) Reports() []Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedReports()
+}
+
+func (s *Store) sortedReports() []Report {
+	reports := make([]Report, 0, len(s.reports))
+	for _, r := range s.reports {
+		reports = append(reports, r)
+	}
+	slices.SortFunc(reports, func(a, b Report) int {
+		return a.Game.Cmp(b.Game)
+	})
+	return reports
+}
+
+func (s *Store) update(game common.Address, fn func(r *Report)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.reports[game]
+	if !ok {
+		r = Report{Game: game}
+	}
+	fn(&r)
+	s.reports[game] = r
+	s.recordMetrics()
+	if err := jsonutil.WriteJSON(s.sortedReports(), ioutil.ToAtomicFile(s.path, 0o644)); err != nil {
+		return fmt.Errorf("failed to write observer report: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) recordMetrics() {
+	var agree, disagree, unexpected int
+	for _, r := range s.reports {
+		if !r.Scored {
+			continue
+		}
+		if r.AgreeWithRootClaim {
+			agree++
+		} else {
+			disagree++
+		}
+		if r.UnexpectedResolution() {
+			unexpected++
+		}
+	}
+	s.m.RecordObservedGames(agree, disagree, unexpected)
+}
+
+// ForGame returns a GameObserver that records the report of the specified game.
+func (s *Store) ForGame(game common.Address, now func() time.Time) *GameObserver {
+	return &GameObserver{store: s, game: game, now: now}
+}
+
+// GameObserver records the report of a single game.
+type GameObserver struct {
+	store *Store
+	game  common.Address
+	now   func() time.Time
+}
+
+// RecordAgreement records whether we agree with the root claim, and the number of actions we would take.
+func (o *GameObserver) RecordAgreement(rootClaim common.Hash, agreeWithRootClaim bool, pendingActions int) error {
+	return o.store.update(o.game, func(r *Report) {
+		r.Time = o.now()
+		r.RootClaim = rootClaim
+		r.Scored = true
+		r.AgreeWithRootClaim = agreeWithRootClaim
+		r.ExpectedStatus = gameTypes.GameStatusChallengerWon
+		if agreeWithRootClaim {
+			r.ExpectedStatus = gameTypes.GameStatusDefenderWon
+		}
+		r.PendingActions = pendingActions
+	})
+}
+
+// RecordStatus records the current status of the game.
+func (o *GameObserver) RecordStatus(status gameTypes.GameStatus) error {
+	return o.store.update(o.game, func(r *Report) {
+		r.Time = o.now()
+		r.Status = status
+	})
+}
+
+type GameContract interface {
+	CallResolve(ctx context.Context) (gameTypes.GameStatus, error)
+}
+
+// Responder is a read-only responder, that checks the game can be resolved but never sends transactions.
+type Responder struct {
+	contract GameContract
+}
+
+func NewResponder(contract GameContract) *Responder {
+	return &Responder{contract: contract}
+}
+
+func (r *Responder) CallResolve(ctx context.Context) (gameTypes.GameStatus, error) {
+	return r.contract.CallResolve(ctx)
+}
+
+func (r *Responder) Resolve() error {
+	return nil
+}
+
+// CallResolveClaim always fails, since claims are never resolved by an observer and
+// reporting them as resolvable would cause them to be retried indefinitely.
+func (r *Responder) CallResolveClaim(_ context.Context, _ uint64) error {
+	return ErrObserverMode
+}
+
+func (r *Responder) ResolveClaims(_ ...uint64) error {
+	return ErrObserverMode
+}
+
+// PerformAction does nothing, so the action is only recorded as the action the challenger would have taken.
+func (r *Responder) PerformAction(_ context.Context, _ types.Action) error {
+	return nil
+}
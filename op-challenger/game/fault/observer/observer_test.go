@@ -0,0 +1,93 @@
+package observer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	m := &stubMetrics{}
+	store, err := NewStore(path, m)
+	require.NoError(t, err)
+	now := func() time.Time { return time.Unix(1000, 0).UTC() }
+
+	gameA := common.Address{0xaa}
+	gameB := common.Address{0xbb}
+	observerA := store.ForGame(gameA, now)
+	observerB := store.ForGame(gameB, now)
+
+	require.NoError(t, observerB.RecordAgreement(common.Hash{0x01}, false, 2))
+	require.NoError(t, observerA.RecordAgreement(common.Hash{0x02}, true, 0))
+	require.Equal(t, []int{1, 1, 0}, m.last)
+
+	// A status recorded before the game is scored is not counted
+	require.NoError(t, store.ForGame(common.Address{0xcc}, now).RecordStatus(gameTypes.GameStatusChallengerWon))
+	require.Equal(t, []int{1, 1, 0}, m.last)
+
+	require.NoError(t, observerA.RecordStatus(gameTypes.GameStatusChallengerWon))
+	require.NoError(t, observerB.RecordStatus(gameTypes.GameStatusChallengerWon))
+	require.Equal(t, []int{1, 1, 1}, m.last)
+
+	reports := store.Reports()
+	require.Len(t, reports, 3)
+	require.Equal(t, Report{
+		Game:               gameA,
+		Time:               now(),
+		RootClaim:          common.Hash{0x02},
+		Scored:             true,
+		AgreeWithRootClaim: true,
+		ExpectedStatus:     gameTypes.GameStatusDefenderWon,
+		Status:             gameTypes.GameStatusChallengerWon,
+	}, reports[0])
+	require.True(t, reports[0].UnexpectedResolution())
+	require.Equal(t, gameB, reports[1].Game)
+	require.Equal(t, gameTypes.GameStatusChallengerWon, reports[1].ExpectedStatus)
+	require.Equal(t, 2, reports[1].PendingActions)
+	require.False(t, reports[1].UnexpectedResolution())
+
+	written, err := jsonutil.LoadJSON[[]Report](path)
+	require.NoError(t, err)
+	require.Equal(t, reports, *written)
+
+	// Reports are loaded on restart
+	m = &stubMetrics{}
+	reloaded, err := NewStore(path, m)
+	require.NoError(t, err)
+	require.Equal(t, reports, reloaded.Reports())
+	require.Equal(t, []int{1, 1, 1}, m.last)
+}
+
+func TestResponder(t *testing.T) {
+	contract := &stubContract{status: gameTypes.GameStatusDefenderWon}
+	responder := NewResponder(contract)
+
+	status, err := responder.CallResolve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, gameTypes.GameStatusDefenderWon, status)
+	require.NoError(t, responder.Resolve())
+	require.ErrorIs(t, responder.CallResolveClaim(context.Background(), 0), ErrObserverMode)
+}
+
+type stubMetrics struct {
+	last []int
+}
+
+func (s *stubMetrics) RecordObservedGames(agree, disagree, unexpectedResolution int) {
+	s.last = []int{agree, disagree, unexpectedResolution}
+}
+
+type stubContract struct {
+	status gameTypes.GameStatus
+}
+
+func (s *stubContract) CallResolve(_ context.Context) (gameTypes.GameStatus, error) {
+	return s.status, nil
+}
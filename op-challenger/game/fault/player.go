@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/explain"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/observer"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/preimages"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
@@ -49,6 +51,7 @@ type GamePlayer struct {
 	prestateValidators []Validator
 	status             gameTypes.GameStatus
 	gameL1Head         eth.BlockID
+	observer           *observer.GameObserver
 }
 
 type GameContract interface {
@@ -84,6 +87,8 @@ func NewGamePlayer(
 	validators []Validator,
 	creator resourceCreator,
 	l1HeaderSource L1HeaderSource,
+	explanations *explain.Store,
+	observations *observer.Store,
 	selective bool,
 	claimants []common.Address,
 ) (*GamePlayer, error) {
@@ -136,19 +141,30 @@ func NewGamePlayer(
 	}
 	l1Head := eth.HeaderBlockID(l1Header)
 
-	minLargePreimageSize, err := oracle.MinLargePreimageSize(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load min large preimage size: %w", err)
-	}
-	direct := preimages.NewDirectPreimageUploader(logger, txSender, loader)
-	large := preimages.NewLargePreimageUploader(logger, l1Clock, txSender, oracle)
-	uploader := preimages.NewSplitPreimageUploader(direct, large, minLargePreimageSize)
-	responder, err := responder.NewFaultResponder(logger, txSender, loader, uploader, oracle)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create the responder: %w", err)
+	var gameResponder Responder
+	var agentObserver Observer
+	var gameObserver *observer.GameObserver
+	if observations != nil {
+		// Observers score the game, but never send transactions, so don't need a tx sender or preimage uploader.
+		gameObserver = observations.ForGame(addr, systemClock.Now)
+		agentObserver = gameObserver
+		gameResponder = observer.NewResponder(loader)
+	} else {
+		minLargePreimageSize, err := oracle.MinLargePreimageSize(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load min large preimage size: %w", err)
+		}
+		direct := preimages.NewDirectPreimageUploader(logger, txSender, loader)
+		large := preimages.NewLargePreimageUploader(logger, l1Clock, txSender, oracle)
+		uploader := preimages.NewSplitPreimageUploader(direct, large, minLargePreimageSize)
+		gameResponder, err = responder.NewFaultResponder(logger, txSender, loader, uploader, oracle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the responder: %w", err)
+		}
 	}
 
-	agent := NewAgent(m, systemClock, l1Clock, loader, gameDepth, maxClockDuration, accessor, responder, logger, selective, claimants)
+	recorder := explanations.ForGame(addr, systemClock.Now)
+	agent := NewAgent(m, systemClock, l1Clock, loader, gameDepth, maxClockDuration, accessor, gameResponder, recorder, agentObserver, logger, selective, claimants)
 	return &GamePlayer{
 		act:                agent.Act,
 		loader:             loader,
@@ -157,6 +173,7 @@ func NewGamePlayer(
 		gameL1Head:         l1Head,
 		syncValidator:      syncValidator,
 		prestateValidators: validators,
+		observer:           gameObserver,
 	}, nil
 }
 
@@ -196,6 +213,11 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 		return gameTypes.GameStatusInProgress
 	}
 	g.logGameStatus(ctx, status)
+	if g.observer != nil {
+		if err := g.observer.RecordStatus(status); err != nil {
+			g.logger.Warn("Failed to record observed game status", "err", err)
+		}
+	}
 	g.status = status
 	if status != gameTypes.GameStatusInProgress {
 		// Release the agent as we will no longer need to act on this game.
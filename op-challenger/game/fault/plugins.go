@@ -0,0 +1,133 @@
+package fault
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+)
+
+// Capabilities describes what a game type plugin requires and supports.
+type Capabilities struct {
+	// VM is the name of the VM that is used to execute the trace, or empty if no VM is used.
+	VM string
+	// Permissioned is true if only permissioned addresses can propose and challenge games of this type.
+	Permissioned bool
+	// TestOnly is true if the game type must not be used in production.
+	TestOnly bool
+}
+
+// GameTypePlugin adds support for playing a game type to the challenger.
+type GameTypePlugin struct {
+	TraceType    faultTypes.TraceType
+	GameType     faultTypes.GameType
+	Capabilities Capabilities
+
+	// CheckConfig validates the config options that are specific to the game type. Optional.
+	CheckConfig func(cfg *config.Config) error
+	// NewRegisterTask creates the task that registers the game type with the scheduler.
+	NewRegisterTask func(cfg *config.Config, m metrics.Metricer) *RegisterTask
+}
+
+var (
+	pluginsLock sync.RWMutex
+	plugins     = make(map[faultTypes.TraceType]GameTypePlugin)
+)
+
+// RegisterPlugin adds a game type plugin, so the game type can be enabled with the trace type.
+// Trace types that are not built in are added to the valid trace types.
+// Plugins must be registered before the flags are parsed, i.e. from an init function.
+// Panics if a plugin is already registered for the trace type, since this indicates a significant programmer error.
+func RegisterPlugin(plugin GameTypePlugin) {
+	pluginsLock.Lock()
+	defer pluginsLock.Unlock()
+	if _, ok := plugins[plugin.TraceType]; ok {
+		panic(fmt.Errorf("duplicate plugin for trace type %v", plugin.TraceType))
+	}
+	if plugin.NewRegisterTask == nil {
+		panic(fmt.Errorf("plugin for trace type %v has no register task", plugin.TraceType))
+	}
+	if !faultTypes.ValidTraceType(plugin.TraceType) {
+		faultTypes.RegisterTraceType(plugin.TraceType, plugin.GameType)
+	} else if plugin.TraceType.GameType() != plugin.GameType {
+		panic(fmt.Errorf("trace type %v plays game type %v, not %v", plugin.TraceType, plugin.TraceType.GameType(), plugin.GameType))
+	}
+	plugins[plugin.TraceType] = plugin
+}
+
+// Plugin returns the plugin for the trace type.
+func Plugin(traceType faultTypes.TraceType) (GameTypePlugin, bool) {
+	pluginsLock.RLock()
+	defer pluginsLock.RUnlock()
+	plugin, ok := plugins[traceType]
+	return plugin, ok
+}
+
+// Plugins returns all registered plugins, ordered by game type.
+func Plugins() []GameTypePlugin {
+	pluginsLock.RLock()
+	defer pluginsLock.RUnlock()
+	result := make([]GameTypePlugin, 0, len(plugins))
+	for _, plugin := range plugins {
+		result = append(result, plugin)
+	}
+	slices.SortFunc(result, func(a, b GameTypePlugin) int {
+		return int(a.GameType) - int(b.GameType)
+	})
+	return result
+}
+
+func init() {
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypeCannon,
+		GameType:     faultTypes.CannonGameType,
+		Capabilities: Capabilities{VM: "cannon"},
+		NewRegisterTask: func(cfg *config.Config, m metrics.Metricer) *RegisterTask {
+			return NewCannonRegisterTask(faultTypes.CannonGameType, cfg, m, vm.NewOpProgramServerExecutor())
+		},
+	})
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypePermissioned,
+		GameType:     faultTypes.PermissionedGameType,
+		Capabilities: Capabilities{VM: "cannon", Permissioned: true},
+		NewRegisterTask: func(cfg *config.Config, m metrics.Metricer) *RegisterTask {
+			return NewCannonRegisterTask(faultTypes.PermissionedGameType, cfg, m, vm.NewOpProgramServerExecutor())
+		},
+	})
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypeAsterisc,
+		GameType:     faultTypes.AsteriscGameType,
+		Capabilities: Capabilities{VM: "asterisc"},
+		NewRegisterTask: func(cfg *config.Config, m metrics.Metricer) *RegisterTask {
+			return NewAsteriscRegisterTask(faultTypes.AsteriscGameType, cfg, m, vm.NewOpProgramServerExecutor())
+		},
+	})
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypeAsteriscKona,
+		GameType:     faultTypes.AsteriscKonaGameType,
+		Capabilities: Capabilities{VM: "asterisc"},
+		NewRegisterTask: func(cfg *config.Config, m metrics.Metricer) *RegisterTask {
+			return NewAsteriscKonaRegisterTask(faultTypes.AsteriscKonaGameType, cfg, m, vm.NewKonaServerExecutor())
+		},
+	})
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypeFast,
+		GameType:     faultTypes.FastGameType,
+		Capabilities: Capabilities{TestOnly: true},
+		NewRegisterTask: func(_ *config.Config, _ metrics.Metricer) *RegisterTask {
+			return NewAlphabetRegisterTask(faultTypes.FastGameType)
+		},
+	})
+	RegisterPlugin(GameTypePlugin{
+		TraceType:    faultTypes.TraceTypeAlphabet,
+		GameType:     faultTypes.AlphabetGameType,
+		Capabilities: Capabilities{TestOnly: true},
+		NewRegisterTask: func(_ *config.Config, _ metrics.Metricer) *RegisterTask {
+			return NewAlphabetRegisterTask(faultTypes.AlphabetGameType)
+		},
+	})
+}
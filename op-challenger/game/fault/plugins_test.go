@@ -0,0 +1,80 @@
+package fault
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInPlugins(t *testing.T) {
+	for _, traceType := range []faultTypes.TraceType{
+		faultTypes.TraceTypeAlphabet,
+		faultTypes.TraceTypeCannon,
+		faultTypes.TraceTypePermissioned,
+		faultTypes.TraceTypeAsterisc,
+		faultTypes.TraceTypeAsteriscKona,
+		faultTypes.TraceTypeFast,
+	} {
+		traceType := traceType
+		t.Run(traceType.String(), func(t *testing.T) {
+			plugin, ok := Plugin(traceType)
+			require.True(t, ok)
+			require.Equal(t, traceType.GameType(), plugin.GameType)
+		})
+	}
+
+	permissioned, _ := Plugin(faultTypes.TraceTypePermissioned)
+	require.Equal(t, Capabilities{VM: "cannon", Permissioned: true}, permissioned.Capabilities)
+	alphabet, _ := Plugin(faultTypes.TraceTypeAlphabet)
+	require.True(t, alphabet.Capabilities.TestOnly)
+}
+
+func TestRegisterPlugin(t *testing.T) {
+	traceType := faultTypes.TraceType("custom-vm")
+	gameType := faultTypes.GameType(4242)
+	if _, ok := Plugin(traceType); !ok {
+		RegisterPlugin(GameTypePlugin{
+			TraceType:    traceType,
+			GameType:     gameType,
+			Capabilities: Capabilities{VM: "custom"},
+			NewRegisterTask: func(_ *config.Config, _ metrics.Metricer) *RegisterTask {
+				return NewRegisterTask(gameType, nil, nil)
+			},
+		})
+	}
+
+	plugin, ok := Plugin(traceType)
+	require.True(t, ok)
+	require.Equal(t, "custom", plugin.Capabilities.VM)
+	require.Equal(t, gameType, plugin.NewRegisterTask(nil, nil).gameType)
+	require.True(t, faultTypes.ValidTraceType(traceType))
+	require.Equal(t, gameType, traceType.GameType())
+	require.Equal(t, "custom-vm", gameType.String())
+	require.True(t, slices.ContainsFunc(Plugins(), func(p GameTypePlugin) bool { return p.TraceType == traceType }))
+
+	t.Run("Duplicate", func(t *testing.T) {
+		require.Panics(t, func() { RegisterPlugin(plugin) })
+	})
+
+	t.Run("GameTypeInUse", func(t *testing.T) {
+		require.Panics(t, func() {
+			RegisterPlugin(GameTypePlugin{
+				TraceType:       "other-vm",
+				GameType:        faultTypes.CannonGameType,
+				NewRegisterTask: plugin.NewRegisterTask,
+			})
+		})
+		require.False(t, faultTypes.ValidTraceType("other-vm"))
+	})
+}
+
+func TestPluginsOrderedByGameType(t *testing.T) {
+	all := Plugins()
+	for i := 1; i < len(all); i++ {
+		require.Less(t, all[i-1].GameType, all[i].GameType)
+	}
+}
@@ -3,12 +3,14 @@ package fault
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/explain"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/observer"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
-	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	keccakTypes "github.com/ethereum-optimism/optimism/op-challenger/game/keccak/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
@@ -57,6 +59,8 @@ func RegisterGameTypes(
 	gameFactory *contracts.DisputeGameFactoryContract,
 	caller *batching.MultiCaller,
 	l1HeaderSource L1HeaderSource,
+	explanations *explain.Store,
+	observations *observer.Store,
 	selective bool,
 	claimants []common.Address,
 ) (CloseFunc, error) {
@@ -64,29 +68,32 @@ func RegisterGameTypes(
 	if err != nil {
 		return nil, fmt.Errorf("dial l2 client %v: %w", cfg.L2Rpc, err)
 	}
-	syncValidator := newSyncStatusValidator(rollupClient)
+	var syncValidator SyncValidator = newSyncStatusValidator(rollupClient)
+	if cfg.OutputSource == config.OutputSourceProgram {
+		// Output roots are derived from L1 data, so there is no rollup node that needs to be in sync.
+		syncValidator = noopSyncValidator{}
+	}
 
 	var registerTasks []*RegisterTask
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypeCannon) {
-		registerTasks = append(registerTasks, NewCannonRegisterTask(faultTypes.CannonGameType, cfg, m, vm.NewOpProgramServerExecutor()))
-	}
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypePermissioned) {
-		registerTasks = append(registerTasks, NewCannonRegisterTask(faultTypes.PermissionedGameType, cfg, m, vm.NewOpProgramServerExecutor()))
-	}
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypeAsterisc) {
-		registerTasks = append(registerTasks, NewAsteriscRegisterTask(faultTypes.AsteriscGameType, cfg, m, vm.NewOpProgramServerExecutor()))
-	}
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypeAsteriscKona) {
-		registerTasks = append(registerTasks, NewAsteriscKonaRegisterTask(faultTypes.AsteriscKonaGameType, cfg, m, vm.NewKonaServerExecutor()))
-	}
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypeFast) {
-		registerTasks = append(registerTasks, NewAlphabetRegisterTask(faultTypes.FastGameType))
-	}
-	if cfg.TraceTypeEnabled(faultTypes.TraceTypeAlphabet) {
-		registerTasks = append(registerTasks, NewAlphabetRegisterTask(faultTypes.AlphabetGameType))
+	for _, traceType := range cfg.TraceTypes {
+		plugin, ok := Plugin(traceType)
+		if !ok {
+			return nil, fmt.Errorf("no plugin registered for trace type %v", traceType)
+		}
+		if slices.ContainsFunc(registerTasks, func(task *RegisterTask) bool { return task.gameType == plugin.GameType }) {
+			continue
+		}
+		if plugin.CheckConfig != nil {
+			if err := plugin.CheckConfig(cfg); err != nil {
+				return nil, fmt.Errorf("invalid config for trace type %v: %w", traceType, err)
+			}
+		}
+		logger.Info("Enabling game type", "traceType", traceType, "gameType", plugin.GameType,
+			"vm", plugin.Capabilities.VM, "permissioned", plugin.Capabilities.Permissioned, "testOnly", plugin.Capabilities.TestOnly)
+		registerTasks = append(registerTasks, plugin.NewRegisterTask(cfg, m))
 	}
 	for _, task := range registerTasks {
-		if err := task.Register(ctx, registry, oracles, systemClock, l1Clock, logger, m, syncValidator, rollupClient, txSender, gameFactory, caller, l2Client, l1HeaderSource, selective, claimants); err != nil {
+		if err := task.Register(ctx, registry, oracles, systemClock, l1Clock, logger, m, syncValidator, rollupClient, txSender, gameFactory, caller, outputs.NewL2OutputSource(l2Client), l1HeaderSource, explanations, observations, selective, claimants); err != nil {
 			return nil, fmt.Errorf("failed to register %v game type: %w", task.gameType, err)
 		}
 	}
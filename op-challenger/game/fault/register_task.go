@@ -9,6 +9,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/explain"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/observer"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/asterisc"
@@ -21,6 +23,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	opnode "github.com/ethereum-optimism/optimism/op-node"
+	hostcfg "github.com/ethereum-optimism/optimism/op-program/host/config"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
@@ -29,22 +33,49 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// PrestateProviderCreator returns the VM prestate provider for the absolute prestate hash of a game.
+type PrestateProviderCreator func(prestateHash common.Hash) (faultTypes.PrestateProvider, error)
+
+// TraceAccessorCreator creates the trace accessor for a game.
+type TraceAccessorCreator func(
+	logger log.Logger,
+	m metrics.Metricer,
+	l2Client utils.L2HeaderSource,
+	prestateProvider faultTypes.PrestateProvider,
+	vmPrestateProvider faultTypes.PrestateProvider,
+	rollupClient outputs.OutputRollupClient,
+	dir string,
+	l1Head eth.BlockID,
+	splitDepth faultTypes.Depth,
+	prestateBlock uint64,
+	poststateBlock uint64) (*trace.Accessor, error)
+
+// OutputSourceCreator creates the source of the honest output roots of a game, in place of the rollup node.
+type OutputSourceCreator func(
+	logger log.Logger,
+	l2Client outputs.L2OutputSource,
+	dir string,
+	l1Head eth.BlockID,
+	prestateBlock uint64,
+	startingRoot common.Hash,
+	poststateBlock uint64) (outputs.OutputRollupClient, error)
+
 type RegisterTask struct {
 	gameType faultTypes.GameType
 
-	getPrestateProvider func(prestateHash common.Hash) (faultTypes.PrestateProvider, error)
-	newTraceAccessor    func(
-		logger log.Logger,
-		m metrics.Metricer,
-		l2Client utils.L2HeaderSource,
-		prestateProvider faultTypes.PrestateProvider,
-		vmPrestateProvider faultTypes.PrestateProvider,
-		rollupClient outputs.OutputRollupClient,
-		dir string,
-		l1Head eth.BlockID,
-		splitDepth faultTypes.Depth,
-		prestateBlock uint64,
-		poststateBlock uint64) (*trace.Accessor, error)
+	getPrestateProvider PrestateProviderCreator
+	newTraceAccessor    TraceAccessorCreator
+	// newOutputSource is nil if the output roots are sourced from the rollup node.
+	newOutputSource OutputSourceCreator
+}
+
+// NewRegisterTask creates a task to register a custom game type, that uses output roots for the top half of the game.
+func NewRegisterTask(gameType faultTypes.GameType, getPrestateProvider PrestateProviderCreator, newTraceAccessor TraceAccessorCreator) *RegisterTask {
+	return &RegisterTask{
+		gameType:            gameType,
+		getPrestateProvider: getPrestateProvider,
+		newTraceAccessor:    newTraceAccessor,
+	}
 }
 
 func NewCannonRegisterTask(gameType faultTypes.GameType, cfg *config.Config, m caching.Metrics, serverExecutor vm.OracleServerExecutor) *RegisterTask {
@@ -76,6 +107,7 @@ func NewCannonRegisterTask(gameType faultTypes.GameType, cfg *config.Config, m c
 			provider := vmPrestateProvider.(*vm.PrestateProvider)
 			return outputs.NewOutputCannonTraceAccessor(logger, m, cfg.Cannon, serverExecutor, l2Client, prestateProvider, provider.PrestatePath(), rollupClient, dir, l1Head, splitDepth, prestateBlock, poststateBlock)
 		},
+		newOutputSource: programOutputSource(cfg, cfg.Cannon),
 	}
 }
 
@@ -108,6 +140,7 @@ func NewAsteriscRegisterTask(gameType faultTypes.GameType, cfg *config.Config, m
 			provider := vmPrestateProvider.(*vm.PrestateProvider)
 			return outputs.NewOutputAsteriscTraceAccessor(logger, m, cfg.Asterisc, serverExecutor, l2Client, prestateProvider, provider.PrestatePath(), rollupClient, dir, l1Head, splitDepth, prestateBlock, poststateBlock)
 		},
+		newOutputSource: programOutputSource(cfg, cfg.Asterisc),
 	}
 }
 
@@ -140,6 +173,7 @@ func NewAsteriscKonaRegisterTask(gameType faultTypes.GameType, cfg *config.Confi
 			provider := vmPrestateProvider.(*vm.PrestateProvider)
 			return outputs.NewOutputAsteriscTraceAccessor(logger, m, cfg.AsteriscKona, serverExecutor, l2Client, prestateProvider, provider.PrestatePath(), rollupClient, dir, l1Head, splitDepth, prestateBlock, poststateBlock)
 		},
+		newOutputSource: programOutputSource(cfg, cfg.AsteriscKona),
 	}
 }
 
@@ -166,6 +200,39 @@ func NewAlphabetRegisterTask(gameType faultTypes.GameType) *RegisterTask {
 	}
 }
 
+// programOutputSource returns the creator of op-program output sources for games, that derive output roots with the
+// chain config and RPCs of the VM, or nil if output roots are sourced from the rollup node.
+func programOutputSource(cfg *config.Config, vmCfg vm.Config) OutputSourceCreator {
+	if cfg.OutputSource != config.OutputSourceProgram {
+		return nil
+	}
+	return func(
+		logger log.Logger,
+		l2Client outputs.L2OutputSource,
+		dir string,
+		l1Head eth.BlockID,
+		prestateBlock uint64,
+		startingRoot common.Hash,
+		poststateBlock uint64) (outputs.OutputRollupClient, error) {
+		rollupCfg, err := opnode.NewRollupConfig(logger, vmCfg.Network, vmCfg.RollupConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load rollup config: %w", err)
+		}
+		l2ChainCfg, err := hostcfg.NewL2ChainConfig(vmCfg.Network, vmCfg.L2GenesisPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load l2 chain config: %w", err)
+		}
+		programCfg := outputs.ProgramConfig{
+			Rollup:        rollupCfg,
+			L2ChainConfig: l2ChainCfg,
+			L1:            vmCfg.L1,
+			L1Beacon:      vmCfg.L1Beacon,
+			L2:            vmCfg.L2,
+		}
+		return outputs.NewProgramOutputSource(logger, programCfg, l2Client, dir, l1Head, prestateBlock, startingRoot, poststateBlock), nil
+	}
+}
+
 func cachePrestates(
 	gameType faultTypes.GameType,
 	stateConverter vm.StateConverter,
@@ -174,7 +241,7 @@ func cachePrestates(
 	preStatePath string,
 	prestateDir string,
 	newPrestateProvider func(path string) faultTypes.PrestateProvider,
-) func(prestateHash common.Hash) (faultTypes.PrestateProvider, error) {
+) PrestateProviderCreator {
 	prestateSource := prestates.NewPrestateSource(prestateBaseURL, preStatePath, prestateDir, stateConverter)
 	prestateProviderCache := prestates.NewPrestateProviderCache(m, fmt.Sprintf("prestates-%v", gameType), func(prestateHash common.Hash) (faultTypes.PrestateProvider, error) {
 		prestatePath, err := prestateSource.PrestatePath(prestateHash)
@@ -199,8 +266,10 @@ func (e *RegisterTask) Register(
 	txSender TxSender,
 	gameFactory *contracts.DisputeGameFactoryContract,
 	caller *batching.MultiCaller,
-	l2Client utils.L2HeaderSource,
+	l2Client outputs.L2OutputSource,
 	l1HeaderSource L1HeaderSource,
+	explanations *explain.Store,
+	observations *observer.Store,
 	selective bool,
 	claimants []common.Address) error {
 
@@ -236,9 +305,20 @@ func (e *RegisterTask) Register(
 		if err != nil {
 			return nil, err
 		}
-		prestateProvider := outputs.NewPrestateProvider(rollupClient, prestateBlock)
+		outputSource := rollupClient
+		if e.newOutputSource != nil {
+			startingRoot, err := contract.GetStartingRootHash(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load starting output root: %w", err)
+			}
+			outputSource, err = e.newOutputSource(logger, l2Client, dir, l1HeadID, prestateBlock, startingRoot, poststateBlock)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create output source for game %v: %w", game.Proxy, err)
+			}
+		}
+		prestateProvider := outputs.NewPrestateProvider(outputSource, prestateBlock)
 		creator := func(ctx context.Context, logger log.Logger, gameDepth faultTypes.Depth, dir string) (faultTypes.TraceAccessor, error) {
-			accessor, err := e.newTraceAccessor(logger, m, l2Client, prestateProvider, vmPrestateProvider, rollupClient, dir, l1HeadID, splitDepth, prestateBlock, poststateBlock)
+			accessor, err := e.newTraceAccessor(logger, m, l2Client, prestateProvider, vmPrestateProvider, outputSource, dir, l1HeadID, splitDepth, prestateBlock, poststateBlock)
 			if err != nil {
 				return nil, err
 			}
@@ -246,7 +326,7 @@ func (e *RegisterTask) Register(
 		}
 		prestateValidator := NewPrestateValidator(e.gameType.String(), contract.GetAbsolutePrestateHash, vmPrestateProvider)
 		startingValidator := NewPrestateValidator("output root", contract.GetStartingRootHash, prestateProvider)
-		return NewGamePlayer(ctx, systemClock, l1Clock, logger, m, dir, game.Proxy, txSender, contract, syncValidator, []Validator{prestateValidator, startingValidator}, creator, l1HeaderSource, selective, claimants)
+		return NewGamePlayer(ctx, systemClock, l1Clock, logger, m, dir, game.Proxy, txSender, contract, syncValidator, []Validator{prestateValidator, startingValidator}, creator, l1HeaderSource, explanations, observations, selective, claimants)
 	}
 	err := registerOracle(ctx, m, oracles, gameFactory, caller, e.gameType)
 	if err != nil {
@@ -41,6 +41,7 @@ func (s *GameSolver) CalculateNextActions(ctx context.Context, game types.Game)
 			return []types.Action{
 				{
 					Type:                          types.ActionTypeChallengeL2BlockNumber,
+					Reason:                        types.CounterReasonInvalidL2BlockNumber,
 					InvalidL2BlockNumberChallenge: challenge,
 				},
 			}, nil
@@ -91,11 +92,12 @@ func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, claim t
 		PreState:    step.PreState,
 		ProofData:   step.ProofData,
 		OracleData:  step.OracleData,
+		Reason:      step.Reason,
 	}, nil
 }
 
 func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, claim types.Claim, honestClaims *honestClaimTracker) (*types.Action, error) {
-	move, err := s.claimSolver.NextMove(ctx, claim, game, honestClaims)
+	move, reason, err := s.claimSolver.NextMove(ctx, claim, game, honestClaims)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate next move for claim index %v: %w", claim.ContractIndex, err)
 	}
@@ -111,5 +113,6 @@ func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, claim t
 		IsAttack:    !game.DefendsParent(*move),
 		ParentClaim: game.Claims()[move.ParentContractIndex],
 		Value:       move.Value,
+		Reason:      reason,
 	}, nil
 }
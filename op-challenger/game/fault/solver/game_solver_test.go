@@ -38,6 +38,7 @@ func TestCalculateNextActions_ChallengeL2BlockNumber(t *testing.T) {
 	action := actions[0]
 	require.Equal(t, types.ActionTypeChallengeL2BlockNumber, action.Type)
 	require.Equal(t, challenge, action.InvalidL2BlockNumberChallenge)
+	require.Equal(t, types.CounterReasonInvalidL2BlockNumber, action.Reason)
 }
 
 func TestCalculateNextActions(t *testing.T) {
@@ -207,6 +208,11 @@ func TestCalculateNextActions(t *testing.T) {
 
 			solver := NewGameSolver(maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
 			postState, actions := runStep(t, solver, game, claimBuilder.CorrectTraceProvider())
+			// Every action must explain why it was made, but expected actions don't specify the reason.
+			for i := range actions {
+				require.NotEqualf(t, types.CounterReasonNone, actions[i].Reason, "Action %v has no reason", i)
+				actions[i].Reason = types.CounterReasonNone
+			}
 			for i, action := range builder.ExpectedActions {
 				t.Logf("Expect %v: Type: %v, ParentIdx: %v, Attack: %v, Value: %v, PreState: %v, ProofData: %v",
 					i, action.Type, action.ParentClaim.ContractIndex, action.IsAttack, action.Value, hex.EncodeToString(action.PreState), hex.EncodeToString(action.ProofData))
@@ -27,58 +27,68 @@ func newClaimSolver(gameDepth types.Depth, trace types.TraceAccessor) *claimSolv
 	}
 }
 
-func (s *claimSolver) shouldCounter(game types.Game, claim types.Claim, honestClaims *honestClaimTracker) (bool, error) {
+// shouldCounter returns the reason the claim should be countered, or CounterReasonNone if it should not be.
+func (s *claimSolver) shouldCounter(game types.Game, claim types.Claim, honestClaims *honestClaimTracker) (types.CounterReason, error) {
 	// Do not counter honest claims
 	if honestClaims.IsHonest(claim) {
-		return false, nil
+		return types.CounterReasonNone, nil
 	}
 
 	if claim.IsRoot() {
 		// Always counter the root claim if it is not honest
-		return true, nil
+		return types.CounterReasonInvalidRootClaim, nil
 	}
 
 	parent, err := game.GetParent(claim)
 	if err != nil {
-		return false, fmt.Errorf("no parent for claim %v: %w", claim.ContractIndex, err)
+		return types.CounterReasonNone, fmt.Errorf("no parent for claim %v: %w", claim.ContractIndex, err)
 	}
 
 	// Counter all claims that are countering an honest claim
 	if honestClaims.IsHonest(parent) {
-		return true, nil
+		return types.CounterReasonCountersHonestClaim, nil
 	}
 
 	counter, hasCounter := honestClaims.HonestCounter(parent)
 	// Do not respond to any claim countering a claim the honest actor ignored
 	if !hasCounter {
-		return false, nil
+		return types.CounterReasonNone, nil
 	}
 
 	// Do not counter sibling to an honest claim that are right of the honest claim.
 	honestIdx := counter.TraceIndex(game.MaxDepth())
 	claimIdx := claim.TraceIndex(game.MaxDepth())
-	return claimIdx.Cmp(honestIdx) <= 0, nil
+	if claimIdx.Cmp(honestIdx) > 0 {
+		return types.CounterReasonNone, nil
+	}
+	return types.CounterReasonLeftOfHonestCounter, nil
 }
 
-// NextMove returns the next move to make given the current state of the game.
-func (s *claimSolver) NextMove(ctx context.Context, claim types.Claim, game types.Game, honestClaims *honestClaimTracker) (*types.Claim, error) {
+// NextMove returns the next move to make given the current state of the game, and the reason for making it.
+func (s *claimSolver) NextMove(ctx context.Context, claim types.Claim, game types.Game, honestClaims *honestClaimTracker) (*types.Claim, types.CounterReason, error) {
 	if claim.Depth() == s.gameDepth {
-		return nil, types.ErrGameDepthReached
+		return nil, types.CounterReasonNone, types.ErrGameDepthReached
 	}
 
-	if counter, err := s.shouldCounter(game, claim, honestClaims); err != nil {
-		return nil, fmt.Errorf("failed to determine if claim should be countered: %w", err)
-	} else if !counter {
-		return nil, nil
+	reason, err := s.shouldCounter(game, claim, honestClaims)
+	if err != nil {
+		return nil, types.CounterReasonNone, fmt.Errorf("failed to determine if claim should be countered: %w", err)
+	} else if reason == types.CounterReasonNone {
+		return nil, types.CounterReasonNone, nil
 	}
 
+	var move *types.Claim
 	if agree, err := s.agreeWithClaim(ctx, game, claim); err != nil {
-		return nil, err
+		return nil, types.CounterReasonNone, err
 	} else if agree {
-		return s.defend(ctx, game, claim)
+		move, err = s.defend(ctx, game, claim)
 	} else {
-		return s.attack(ctx, game, claim)
+		move, err = s.attack(ctx, game, claim)
+	}
+	if err != nil || move == nil {
+		return nil, types.CounterReasonNone, err
 	}
+	return move, reason, nil
 }
 
 type StepData struct {
@@ -87,6 +97,7 @@ type StepData struct {
 	PreState   []byte
 	ProofData  []byte
 	OracleData *types.PreimageOracleData
+	Reason     types.CounterReason
 }
 
 // AttemptStep determines what step, if any, should occur for a given leaf claim.
@@ -97,9 +108,10 @@ func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim ty
 		return nil, ErrStepNonLeafNode
 	}
 
-	if counter, err := s.shouldCounter(game, claim, honestClaims); err != nil {
+	reason, err := s.shouldCounter(game, claim, honestClaims)
+	if err != nil {
 		return nil, fmt.Errorf("failed to determine if claim should be countered: %w", err)
-	} else if !counter {
+	} else if reason == types.CounterReasonNone {
 		return nil, nil
 	}
 
@@ -129,6 +141,7 @@ func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim ty
 		PreState:   preState,
 		ProofData:  proofData,
 		OracleData: oracleData,
+		Reason:     reason,
 	}, nil
 }
 
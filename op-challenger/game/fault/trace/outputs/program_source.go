@@ -0,0 +1,216 @@
+package outputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-program/client/claim"
+	"github.com/ethereum-optimism/optimism/op-program/host"
+	hostcfg "github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrNotDerivable         = errors.New("block not derivable from L1 data up to the L1 head")
+	ErrUnsupportedL1Head    = errors.New("safe head only available at the L1 head of the game")
+	ErrOutputRootMismatch   = errors.New("L2 block data does not match the derived output root")
+	errUnexpectedValidClaim = errors.New("op-program unexpectedly accepted the empty claim")
+)
+
+var _ OutputRollupClient = (*ProgramOutputSource)(nil)
+
+// L2OutputSource provides the L2 block data that output roots commit to.
+type L2OutputSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error)
+}
+
+type l2OutputClient struct {
+	*ethclient.Client
+	proofs *gethclient.Client
+}
+
+// NewL2OutputSource creates an [L2OutputSource] backed by the given L2 execution client.
+func NewL2OutputSource(l2Client *ethclient.Client) L2OutputSource {
+	return &l2OutputClient{Client: l2Client, proofs: gethclient.New(l2Client.Client())}
+}
+
+func (c *l2OutputClient) GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return c.proofs.GetProof(ctx, account, keys, blockNumber)
+}
+
+// ProgramRunner runs op-program natively with the given config.
+type ProgramRunner func(ctx context.Context, logger log.Logger, cfg *hostcfg.Config) error
+
+// ProgramConfig configures the op-program runs of a [ProgramOutputSource].
+type ProgramConfig struct {
+	Rollup        *rollup.Config
+	L2ChainConfig *params.ChainConfig
+	L1            string
+	L1Beacon      string
+	L2            string
+}
+
+type derivedOutput struct {
+	root        eth.Bytes32
+	blockNumber uint64
+}
+
+// ProgramOutputSource is an [OutputRollupClient] for a single game, that derives output roots from L1 data up to
+// the L1 head of the game by running op-program natively, starting from the output root the game agrees on.
+// This removes the rollup node as a trusted dependency. The L2 execution client only supplies the block data
+// that the derived output roots commit to, which is verified against the derived output roots.
+type ProgramOutputSource struct {
+	logger         log.Logger
+	cfg            ProgramConfig
+	l2Client       L2OutputSource
+	dir            string
+	l1Head         eth.BlockID
+	agreedBlock    uint64
+	agreedOutput   common.Hash
+	poststateBlock uint64
+	run            ProgramRunner
+
+	// lock serializes the op-program runs, which share the pre-image data directory.
+	lock    sync.Mutex
+	derived map[uint64]derivedOutput
+}
+
+func NewProgramOutputSource(
+	logger log.Logger,
+	cfg ProgramConfig,
+	l2Client L2OutputSource,
+	dir string,
+	l1Head eth.BlockID,
+	agreedBlock uint64,
+	agreedOutput common.Hash,
+	poststateBlock uint64,
+) *ProgramOutputSource {
+	return &ProgramOutputSource{
+		logger:         logger,
+		cfg:            cfg,
+		l2Client:       l2Client,
+		dir:            dir,
+		l1Head:         l1Head,
+		agreedBlock:    agreedBlock,
+		agreedOutput:   agreedOutput,
+		poststateBlock: poststateBlock,
+		run:            host.FaultProofProgram,
+		derived:        make(map[uint64]derivedOutput),
+	}
+}
+
+// OutputAtBlock returns the output at the given block, which must be derivable from L1 data up to the L1 head.
+func (s *ProgramOutputSource) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	root := eth.Bytes32(s.agreedOutput)
+	if blockNum != s.agreedBlock {
+		derived, err := s.derive(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		if derived.blockNumber != blockNum {
+			return nil, fmt.Errorf("%w: block %v, safe head %v", ErrNotDerivable, blockNum, derived.blockNumber)
+		}
+		root = derived.root
+	}
+	return s.outputResponse(ctx, blockNum, root)
+}
+
+// SafeHeadAtL1Block returns the safe head reached by deriving up to the L1 head of the game, capped at the
+// poststate block of the game. Other L1 blocks are not supported.
+func (s *ProgramOutputSource) SafeHeadAtL1Block(ctx context.Context, l1BlockNum uint64) (*eth.SafeHeadResponse, error) {
+	if l1BlockNum != s.l1Head.Number {
+		return nil, fmt.Errorf("%w: requested %v, L1 head %v", ErrUnsupportedL1Head, l1BlockNum, s.l1Head.Number)
+	}
+	derived, err := s.derive(ctx, s.poststateBlock)
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.l2Client.HeaderByNumber(ctx, new(big.Int).SetUint64(derived.blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve L2 block header %v: %w", derived.blockNumber, err)
+	}
+	return &eth.SafeHeadResponse{
+		L1Block:  s.l1Head,
+		SafeHead: eth.BlockID{Hash: header.Hash(), Number: derived.blockNumber},
+	}, nil
+}
+
+// derive runs op-program to derive the output root at the given block, or at the safe head if the block is not
+// derivable from the L1 data up to the L1 head. The empty claim is never valid, so the derived output root is
+// reported by the mismatch with the claim.
+func (s *ProgramOutputSource) derive(ctx context.Context, blockNum uint64) (derivedOutput, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if derived, ok := s.derived[blockNum]; ok {
+		return derived, nil
+	}
+	agreedHeader, err := s.l2Client.HeaderByNumber(ctx, new(big.Int).SetUint64(s.agreedBlock))
+	if err != nil {
+		return derivedOutput{}, fmt.Errorf("failed to retrieve agreed L2 block header %v: %w", s.agreedBlock, err)
+	}
+	cfg := hostcfg.NewConfig(s.cfg.Rollup, s.cfg.L2ChainConfig, s.l1Head.Hash, agreedHeader.Hash(), s.agreedOutput, common.Hash{}, blockNum)
+	cfg.L1URL = s.cfg.L1
+	cfg.L1BeaconURL = s.cfg.L1Beacon
+	cfg.L2URL = s.cfg.L2
+	cfg.DataDir = filepath.Join(s.dir, "op-program")
+	if err := cfg.Check(); err != nil {
+		return derivedOutput{}, fmt.Errorf("invalid op-program config: %w", err)
+	}
+	s.logger.Info("Deriving output root with op-program", "block", blockNum, "agreedBlock", s.agreedBlock, "l1Head", s.l1Head)
+	err = s.run(ctx, s.logger.New("module", "op-program"), cfg)
+	var mismatch *claim.ClaimMismatchError
+	if errors.As(err, &mismatch) {
+		derived := derivedOutput{root: mismatch.Actual, blockNumber: mismatch.BlockNumber}
+		s.derived[blockNum] = derived
+		return derived, nil
+	} else if err != nil {
+		return derivedOutput{}, fmt.Errorf("failed to derive output root at block %v: %w", blockNum, err)
+	}
+	return derivedOutput{}, errUnexpectedValidClaim
+}
+
+// outputResponse builds the output response from the L2 block data, and verifies it matches the output root.
+func (s *ProgramOutputSource) outputResponse(ctx context.Context, blockNum uint64, root eth.Bytes32) (*eth.OutputResponse, error) {
+	number := new(big.Int).SetUint64(blockNum)
+	header, err := s.l2Client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve L2 block header %v: %w", blockNum, err)
+	}
+	proof, err := s.l2Client.GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, nil, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve message passer storage root at block %v: %w", blockNum, err)
+	}
+	output := &eth.OutputV0{
+		StateRoot:                eth.Bytes32(header.Root),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                header.Hash(),
+	}
+	if actual := eth.OutputRoot(output); actual != root {
+		return nil, fmt.Errorf("%w: block %v, derived %v, L2 block data %v", ErrOutputRootMismatch, blockNum, root, actual)
+	}
+	return &eth.OutputResponse{
+		Version:    output.Version(),
+		OutputRoot: root,
+		BlockRef: eth.L2BlockRef{
+			Hash:       header.Hash(),
+			Number:     blockNum,
+			ParentHash: header.ParentHash,
+			Time:       header.Time,
+		},
+		WithdrawalStorageRoot: proof.StorageHash,
+		StateRoot:             header.Root,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package outputs
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/claim"
+	hostcfg "github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var programL1Head = eth.BlockID{Hash: common.Hash{0x11}, Number: 1000}
+
+func TestProgramOutputSource(t *testing.T) {
+	t.Run("AgreedBlockNotDerived", func(t *testing.T) {
+		source, l2, runner := setupProgramOutputSource(t, poststateBlock)
+		output, err := source.OutputAtBlock(context.Background(), prestateBlock)
+		require.NoError(t, err)
+		require.Equal(t, l2.outputRoot(prestateBlock), output.OutputRoot)
+		require.Empty(t, runner.configs)
+	})
+
+	t.Run("DerivesOutput", func(t *testing.T) {
+		source, l2, runner := setupProgramOutputSource(t, poststateBlock)
+		output, err := source.OutputAtBlock(context.Background(), 150)
+		require.NoError(t, err)
+		require.Equal(t, l2.outputRoot(150), output.OutputRoot)
+		require.Equal(t, uint64(150), output.BlockRef.Number)
+		require.Equal(t, l2.header(150).Hash(), output.BlockRef.Hash)
+		require.Equal(t, common.Hash{150}, output.StateRoot)
+		require.Equal(t, common.Hash{150, 0x01}, output.WithdrawalStorageRoot)
+
+		require.Len(t, runner.configs, 1)
+		cfg := runner.configs[0]
+		require.Equal(t, programL1Head.Hash, cfg.L1Head)
+		require.Equal(t, l2.header(prestateBlock).Hash(), cfg.L2Head)
+		require.Equal(t, common.Hash(l2.outputRoot(prestateBlock)), cfg.L2OutputRoot)
+		require.Equal(t, common.Hash{}, cfg.L2Claim)
+		require.Equal(t, uint64(150), cfg.L2ClaimBlockNumber)
+
+		// Derived outputs are cached
+		_, err = source.OutputAtBlock(context.Background(), 150)
+		require.NoError(t, err)
+		require.Len(t, runner.configs, 1)
+	})
+
+	t.Run("BlockAfterSafeHead", func(t *testing.T) {
+		source, _, _ := setupProgramOutputSource(t, 120)
+		_, err := source.OutputAtBlock(context.Background(), 150)
+		require.ErrorIs(t, err, ErrNotDerivable)
+	})
+
+	t.Run("L2BlockDataMismatch", func(t *testing.T) {
+		source, _, runner := setupProgramOutputSource(t, poststateBlock)
+		runner.actual = func(blockNum uint64) eth.Bytes32 {
+			return eth.Bytes32{0xde, 0xad}
+		}
+		_, err := source.OutputAtBlock(context.Background(), 150)
+		require.ErrorIs(t, err, ErrOutputRootMismatch)
+	})
+
+	t.Run("ProgramFailed", func(t *testing.T) {
+		source, _, runner := setupProgramOutputSource(t, poststateBlock)
+		runner.err = errors.New("boom")
+		_, err := source.OutputAtBlock(context.Background(), 150)
+		require.ErrorIs(t, err, runner.err)
+	})
+
+	t.Run("SafeHeadCappedAtPoststate", func(t *testing.T) {
+		source, l2, _ := setupProgramOutputSource(t, poststateBlock+50)
+		safeHead, err := source.SafeHeadAtL1Block(context.Background(), programL1Head.Number)
+		require.NoError(t, err)
+		require.Equal(t, programL1Head, safeHead.L1Block)
+		require.Equal(t, eth.BlockID{Hash: l2.header(poststateBlock).Hash(), Number: poststateBlock}, safeHead.SafeHead)
+	})
+
+	t.Run("SafeHeadBeforePoststate", func(t *testing.T) {
+		source, _, _ := setupProgramOutputSource(t, 120)
+		safeHead, err := source.SafeHeadAtL1Block(context.Background(), programL1Head.Number)
+		require.NoError(t, err)
+		require.Equal(t, uint64(120), safeHead.SafeHead.Number)
+	})
+
+	t.Run("SafeHeadAtOtherL1Block", func(t *testing.T) {
+		source, _, _ := setupProgramOutputSource(t, poststateBlock)
+		_, err := source.SafeHeadAtL1Block(context.Background(), programL1Head.Number-1)
+		require.ErrorIs(t, err, ErrUnsupportedL1Head)
+	})
+}
+
+func setupProgramOutputSource(t *testing.T, safeHead uint64) (*ProgramOutputSource, *stubL2OutputSource, *stubProgramRunner) {
+	l2 := &stubL2OutputSource{}
+	runner := &stubProgramRunner{safeHead: safeHead, actual: l2.outputRoot}
+	cfg := ProgramConfig{
+		Rollup:        chaincfg.Sepolia,
+		L2ChainConfig: chainconfig.OPSepoliaChainConfig,
+		L1:            "http://l1",
+		L1Beacon:      "http://l1-beacon",
+		L2:            "http://l2",
+	}
+	source := NewProgramOutputSource(testlog.Logger(t, log.LevelInfo), cfg, l2, t.TempDir(), programL1Head,
+		prestateBlock, common.Hash(l2.outputRoot(prestateBlock)), poststateBlock)
+	source.run = runner.run
+	return source, l2, runner
+}
+
+type stubProgramRunner struct {
+	safeHead uint64
+	actual   func(blockNum uint64) eth.Bytes32
+	err      error
+	configs  []*hostcfg.Config
+}
+
+func (s *stubProgramRunner) run(_ context.Context, _ log.Logger, cfg *hostcfg.Config) error {
+	s.configs = append(s.configs, cfg)
+	if s.err != nil {
+		return s.err
+	}
+	blockNum := min(cfg.L2ClaimBlockNumber, s.safeHead)
+	return &claim.ClaimMismatchError{Claimed: eth.Bytes32(cfg.L2Claim), Actual: s.actual(blockNum), BlockNumber: blockNum}
+}
+
+type stubL2OutputSource struct{}
+
+func (s *stubL2OutputSource) header(blockNum uint64) *ethTypes.Header {
+	return &ethTypes.Header{Number: new(big.Int).SetUint64(blockNum), Root: common.Hash{byte(blockNum)}}
+}
+
+func (s *stubL2OutputSource) storageHash(blockNum uint64) common.Hash {
+	return common.Hash{byte(blockNum), 0x01}
+}
+
+func (s *stubL2OutputSource) outputRoot(blockNum uint64) eth.Bytes32 {
+	header := s.header(blockNum)
+	return eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(header.Root),
+		MessagePasserStorageRoot: eth.Bytes32(s.storageHash(blockNum)),
+		BlockHash:                header.Hash(),
+	})
+}
+
+func (s *stubL2OutputSource) HeaderByNumber(_ context.Context, num *big.Int) (*ethTypes.Header, error) {
+	return s.header(num.Uint64()), nil
+}
+
+func (s *stubL2OutputSource) GetProof(_ context.Context, account common.Address, _ []string, blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	if account != predeploys.L2ToL1MessagePasserAddr {
+		return nil, errors.New("unexpected account")
+	}
+	return &gethclient.AccountResult{Address: account, StorageHash: s.storageHash(blockNumber.Uint64())}, nil
+}
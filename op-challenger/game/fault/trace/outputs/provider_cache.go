@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/locks"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -12,6 +13,8 @@ import (
 type ProviderCache struct {
 	cache   *caching.LRUCache[common.Hash, types.TraceProvider]
 	creator ProposalTraceProviderCreator
+	// creating de-duplicates concurrent creation of the provider of the same local context
+	creating locks.SingleFlight[common.Hash, types.TraceProvider]
 }
 
 func (c *ProviderCache) GetOrCreate(ctx context.Context, localContext common.Hash, depth types.Depth, agreed contracts.Proposal, claimed contracts.Proposal) (types.TraceProvider, error) {
@@ -19,12 +22,18 @@ func (c *ProviderCache) GetOrCreate(ctx context.Context, localContext common.Has
 	if ok {
 		return provider, nil
 	}
-	provider, err := c.creator(ctx, localContext, depth, agreed, claimed)
-	if err != nil {
-		return nil, err
-	}
-	c.cache.Add(localContext, provider)
-	return provider, nil
+	provider, _, err := c.creating.Do(ctx, localContext, func() (types.TraceProvider, error) {
+		if provider, ok := c.cache.Get(localContext); ok {
+			return provider, nil
+		}
+		provider, err := c.creator(ctx, localContext, depth, agreed, claimed)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.Add(localContext, provider)
+		return provider, nil
+	})
+	return provider, err
 }
 
 func NewProviderCache(m caching.Metrics, metricsLabel string, creator ProposalTraceProviderCreator) *ProviderCache {
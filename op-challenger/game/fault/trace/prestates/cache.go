@@ -1,7 +1,10 @@
 package prestates
 
 import (
+	"context"
+
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/locks"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -16,6 +19,8 @@ type PrestateSource interface {
 type PrestateProviderCache struct {
 	createProvider func(prestateHash common.Hash) (types.PrestateProvider, error)
 	cache          *caching.LRUCache[common.Hash, types.PrestateProvider]
+	// creating de-duplicates concurrent creation of the provider of the same prestate
+	creating locks.SingleFlight[common.Hash, types.PrestateProvider]
 }
 
 func NewPrestateProviderCache(m caching.Metrics, label string, createProvider func(prestateHash common.Hash) (types.PrestateProvider, error)) *PrestateProviderCache {
@@ -30,10 +35,16 @@ func (p *PrestateProviderCache) GetOrCreate(prestateHash common.Hash) (types.Pre
 	if ok {
 		return provider, nil
 	}
-	provider, err := p.createProvider(prestateHash)
-	if err != nil {
-		return nil, err
-	}
-	p.cache.Add(prestateHash, provider)
-	return provider, nil
+	provider, _, err := p.creating.Do(context.Background(), prestateHash, func() (types.PrestateProvider, error) {
+		if provider, ok := p.cache.Get(prestateHash); ok {
+			return provider, nil
+		}
+		provider, err := p.createProvider(prestateHash)
+		if err != nil {
+			return nil, err
+		}
+		p.cache.Add(prestateHash, provider)
+		return provider, nil
+	})
+	return provider, err
 }
@@ -3,7 +3,10 @@ package prestates
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -49,6 +52,37 @@ func TestPrestateProviderCache_CreateFails(t *testing.T) {
 	require.Nil(t, provider)
 }
 
+func TestPrestateProviderCache_ConcurrentCreate(t *testing.T) {
+	var creates atomic.Int32
+	release := make(chan struct{})
+	cache := NewPrestateProviderCache(nil, "", func(prestateHash common.Hash) (types.PrestateProvider, error) {
+		creates.Add(1)
+		<-release
+		return &stubPrestateProvider{commitment: prestateHash}, nil
+	})
+
+	hash1 := common.Hash{0xaa}
+	providers := make([]types.PrestateProvider, 5)
+	var wg sync.WaitGroup
+	for i := range providers {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			provider, err := cache.GetOrCreate(hash1)
+			require.NoError(t, err)
+			providers[i] = provider
+		}()
+	}
+	require.Eventually(t, func() bool { return creates.Load() == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.EqualValues(t, 1, creates.Load())
+	for _, provider := range providers {
+		require.Same(t, providers[0], provider)
+	}
+}
+
 type stubPrestateProvider struct {
 	commitment common.Hash
 }
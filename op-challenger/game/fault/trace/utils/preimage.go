@@ -47,14 +47,18 @@ func (l *PreimageLoader) LoadPreimage(proof *ProofData) (*types.PreimageOracleDa
 	if len(proof.OracleKey) == 0 {
 		return nil, nil
 	}
-	switch preimage.KeyType(proof.OracleKey[0]) {
-	case preimage.BlobKeyType:
-		return l.loadBlobPreimage(proof)
-	case preimage.PrecompileKeyType:
-		return l.loadPrecompilePreimage(proof)
-	default:
-		return types.NewPreimageOracleData(proof.OracleKey, proof.OracleValue, proof.OracleOffset), nil
+	if load, ok := preimageLoaders.Lookup(preimage.KeyType(proof.OracleKey[0])); ok {
+		return load(l, proof)
 	}
+	return types.NewPreimageOracleData(proof.OracleKey, proof.OracleValue, proof.OracleOffset), nil
+}
+
+// preimageLoaders load the pre-images of the key types that require more data than the proof contains.
+var preimageLoaders preimage.KeyTypeBehaviors[func(l *PreimageLoader, proof *ProofData) (*types.PreimageOracleData, error)]
+
+func init() {
+	preimageLoaders.Register(preimage.BlobKeyType, (*PreimageLoader).loadBlobPreimage)
+	preimageLoaders.Register(preimage.PrecompileKeyType, (*PreimageLoader).loadPrecompilePreimage)
 }
 
 func (l *PreimageLoader) loadBlobPreimage(proof *ProofData) (*types.PreimageOracleData, error) {
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -24,6 +25,7 @@ const (
 type Metricer interface {
 	RecordVmExecutionTime(vmType string, t time.Duration)
 	RecordVmMemoryUsed(vmType string, memoryUsed uint64)
+	RecordVmResourceLimitExceeded(vmType string, reason string)
 }
 
 type Config struct {
@@ -34,6 +36,7 @@ type Config struct {
 	InfoFreq        uint   // Frequency of progress log messages (in VM instructions)
 	DebugInfo       bool   // Whether to record debug info from the execution
 	BinarySnapshots bool   // Whether to use binary snapshots instead of JSON
+	Sandbox         SandboxConfig
 
 	// Host Configuration
 	L1               string
@@ -49,6 +52,16 @@ type OracleServerExecutor interface {
 	OracleCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error)
 }
 
+// OfflineOracleServerExecutor is an OracleServerExecutor of which the pre-image server can run without network access,
+// after the pre-images are fetched into the data dir.
+type OfflineOracleServerExecutor interface {
+	OracleServerExecutor
+	// PrefetchCommand returns the command that fetches the pre-images of the game into the data dir.
+	PrefetchCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error)
+	// OfflineOracleCommand returns the command of the pre-image server that only serves the pre-images in the data dir.
+	OfflineOracleCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error)
+}
+
 type Executor struct {
 	cfg              Config
 	oracleServer     OracleServerExecutor
@@ -58,9 +71,20 @@ type Executor struct {
 	inputs           utils.LocalGameInputs
 	selectSnapshot   SnapshotSelect
 	cmdExecutor      CmdExecutor
+	// prefetchExecutor fetches the pre-images with network access, when the VM runs without it.
+	prefetchExecutor CmdExecutor
 }
 
 func NewExecutor(logger log.Logger, m Metricer, cfg Config, oracleServer OracleServerExecutor, prestate string, inputs utils.LocalGameInputs) *Executor {
+	cmdExecutor := RunCmd
+	if cfg.Sandbox.Enabled() {
+		cmdExecutor = NewSandboxedCmdExecutor(cfg.Sandbox)
+	}
+	prefetchExecutor := RunCmd
+	if prefetchSandbox := cfg.Sandbox; prefetchSandbox.NoNetwork {
+		prefetchSandbox.NoNetwork = false
+		prefetchExecutor = NewSandboxedCmdExecutor(prefetchSandbox)
+	}
 	return &Executor{
 		cfg:              cfg,
 		oracleServer:     oracleServer,
@@ -69,7 +93,8 @@ func NewExecutor(logger log.Logger, m Metricer, cfg Config, oracleServer OracleS
 		inputs:           inputs,
 		absolutePreState: prestate,
 		selectSnapshot:   FindStartingSnapshot,
-		cmdExecutor:      RunCmd,
+		cmdExecutor:      cmdExecutor,
+		prefetchExecutor: prefetchExecutor,
 	}
 }
 
@@ -113,11 +138,6 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 	}
 	args = append(args, extraVmArgs...)
 	args = append(args, "--")
-	oracleArgs, err := e.oracleServer.OracleCommand(e.cfg, dataDir, e.inputs)
-	if err != nil {
-		return err
-	}
-	args = append(args, oracleArgs...)
 
 	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
 		return fmt.Errorf("could not create snapshot directory %v: %w", snapshotDir, err)
@@ -128,12 +148,20 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 	if err := os.MkdirAll(proofDir, 0755); err != nil {
 		return fmt.Errorf("could not create proofs directory %v: %w", proofDir, err)
 	}
+	oracleArgs, err := e.oracleCommand(ctx, dataDir, end)
+	if err != nil {
+		return err
+	}
+	args = append(args, oracleArgs...)
 	e.logger.Info("Generating trace", "proof", end, "cmd", e.cfg.VmBin, "args", strings.Join(args, ", "))
 	execStart := time.Now()
 	err = e.cmdExecutor(ctx, e.logger.New("proof", end), e.cfg.VmBin, args...)
 	execTime := time.Since(execStart)
 	memoryUsed := "unknown"
 	e.metrics.RecordVmExecutionTime(e.cfg.VmType.String(), execTime)
+	if e.checkResourceLimit("VM execution", err) {
+		return err
+	}
 	if e.cfg.DebugInfo && err == nil {
 		if info, err := jsonutil.LoadJSON[debugInfo](filepath.Join(dataDir, debugFilename)); err != nil {
 			e.logger.Warn("Failed to load debug metrics", "err", err)
@@ -146,6 +174,44 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 	return err
 }
 
+// oracleCommand returns the command of the pre-image server. If the VM runs without network access,
+// the pre-images are fetched into the data dir first, and the pre-image server runs offline.
+func (e *Executor) oracleCommand(ctx context.Context, dataDir string, end uint64) ([]string, error) {
+	if !e.cfg.Sandbox.NoNetwork {
+		return e.oracleServer.OracleCommand(e.cfg, dataDir, e.inputs)
+	}
+	offline, ok := e.oracleServer.(OfflineOracleServerExecutor)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrOfflineUnsupported, e.cfg.VmType)
+	}
+	prefetchArgs, err := offline.PrefetchCommand(e.cfg, dataDir, e.inputs)
+	if err != nil {
+		return nil, err
+	}
+	e.logger.Info("Fetching pre-images", "proof", end, "cmd", prefetchArgs[0], "args", strings.Join(prefetchArgs[1:], ", "))
+	if err := e.prefetchExecutor(ctx, e.logger.New("proof", end, "prefetch", true), prefetchArgs[0], prefetchArgs[1:]...); err != nil {
+		if e.checkResourceLimit("Pre-image fetching", err) || ctx.Err() != nil {
+			return nil, fmt.Errorf("failed to fetch pre-images: %w", err)
+		}
+		// The program also fails after fetching all pre-images if the claim is invalid.
+		// Any missing pre-images fail the VM execution.
+		e.logger.Warn("Pre-image fetching failed, continuing with the fetched pre-images", "err", err)
+	}
+	return offline.OfflineOracleCommand(e.cfg, dataDir, e.inputs)
+}
+
+// checkResourceLimit records and returns true if the error reports an execution that exceeded a sandbox limit.
+func (e *Executor) checkResourceLimit(execution string, err error) bool {
+	var limitErr *ResourceLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+	e.metrics.RecordVmResourceLimitExceeded(e.cfg.VmType.String(), limitErr.Reason())
+	e.logger.Error(execution+" terminated by sandbox limit", "limit", limitErr.Reason(),
+		"elapsed", limitErr.Elapsed, "cpuTime", limitErr.CPUTime, "err", limitErr.Err)
+	return true
+}
+
 type debugInfo struct {
 	MemoryUsed hexutil.Uint64 `json:"memory_used"`
 }
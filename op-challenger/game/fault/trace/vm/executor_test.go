@@ -2,9 +2,11 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"math"
 	"math/big"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -144,9 +146,95 @@ func TestGenerateProof(t *testing.T) {
 	})
 }
 
+func TestGenerateProofNoNetwork(t *testing.T) {
+	cfg := Config{
+		VmType:   "test",
+		L1:       "http://localhost:8888",
+		L1Beacon: "http://localhost:9000",
+		L2:       "http://localhost:9999",
+		VmBin:    "./bin/testvm",
+		Server:   "./bin/testserver",
+		Network:  "op-test",
+		Sandbox:  SandboxConfig{NoNetwork: true},
+	}
+	inputs := utils.LocalGameInputs{
+		L1Head:        common.Hash{0x11},
+		L2Head:        common.Hash{0x22},
+		L2OutputRoot:  common.Hash{0x33},
+		L2Claim:       common.Hash{0x44},
+		L2BlockNumber: big.NewInt(3333),
+	}
+	newExecutor := func(t *testing.T, oracleServer OracleServerExecutor, prefetchErr error) (*Executor, *stubVmMetrics, *[]string, *[]string) {
+		m := &stubVmMetrics{}
+		executor := NewExecutor(testlog.Logger(t, log.LevelInfo), m, cfg, oracleServer, "pre.json", inputs)
+		executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64, binary bool) (string, error) {
+			return "starting.json", nil
+		}
+		var prefetchArgs, vmArgs []string
+		executor.prefetchExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+			prefetchArgs = append([]string{b}, a...)
+			return prefetchErr
+		}
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+			vmArgs = a
+			return nil
+		}
+		return executor, m, &prefetchArgs, &vmArgs
+	}
+
+	t.Run("PrefetchThenOffline", func(t *testing.T) {
+		executor, _, prefetchArgs, vmArgs := newExecutor(t, NewOpProgramServerExecutor(), nil)
+		dir := t.TempDir()
+		require.NoError(t, executor.GenerateProof(context.Background(), dir, 100))
+
+		// The pre-images are fetched by running the program natively, with the L1 and L2 endpoints
+		require.Equal(t, cfg.Server, (*prefetchArgs)[0])
+		require.NotContains(t, *prefetchArgs, "--server")
+		require.Contains(t, *prefetchArgs, cfg.L1)
+		require.Contains(t, *prefetchArgs, cfg.L2)
+		require.Contains(t, *prefetchArgs, PreimageDir(dir))
+
+		// The pre-image server of the VM only serves the fetched pre-images
+		serverArgs := (*vmArgs)[slices.Index(*vmArgs, "--")+1:]
+		require.Equal(t, []string{cfg.Server, "--server"}, serverArgs[:2])
+		require.NotContains(t, serverArgs, "--l1")
+		require.NotContains(t, serverArgs, "--l1.beacon")
+		require.NotContains(t, serverArgs, "--l2")
+		require.Contains(t, serverArgs, PreimageDir(dir))
+	})
+
+	t.Run("PrefetchFailureContinues", func(t *testing.T) {
+		// e.g. the program exits with an error if the claim is invalid, after fetching all pre-images
+		executor, _, _, vmArgs := newExecutor(t, NewOpProgramServerExecutor(), errors.New("exit status 1"))
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 100))
+		require.NotEmpty(t, *vmArgs)
+	})
+
+	t.Run("PrefetchResourceLimit", func(t *testing.T) {
+		limitErr := &ResourceLimitError{Limit: ErrVmOutOfMemory, Err: errors.New("killed")}
+		executor, m, _, vmArgs := newExecutor(t, NewOpProgramServerExecutor(), limitErr)
+		err := executor.GenerateProof(context.Background(), t.TempDir(), 100)
+		require.ErrorIs(t, err, ErrVmOutOfMemory)
+		require.Empty(t, *vmArgs)
+		require.Equal(t, []string{"memory"}, m.resourceLimitReasons)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		executor, _, _, vmArgs := newExecutor(t, NewKonaServerExecutor(), nil)
+		err := executor.GenerateProof(context.Background(), t.TempDir(), 100)
+		require.ErrorIs(t, err, ErrOfflineUnsupported)
+		require.Empty(t, *vmArgs)
+	})
+}
+
 type stubVmMetrics struct {
 	metrics.NoopMetricsImpl
 	executionTimeRecordCount int
+	resourceLimitReasons     []string
+}
+
+func (c *stubVmMetrics) RecordVmResourceLimitExceeded(_ string, reason string) {
+	c.resourceLimitReasons = append(c.resourceLimitReasons, reason)
 }
 
 func (c *stubVmMetrics) RecordVmExecutionTime(_ string, _ time.Duration) {
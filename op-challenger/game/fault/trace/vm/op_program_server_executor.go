@@ -7,25 +7,43 @@ import (
 type OpProgramServerExecutor struct {
 }
 
-var _ OracleServerExecutor = (*OpProgramServerExecutor)(nil)
+var _ OfflineOracleServerExecutor = (*OpProgramServerExecutor)(nil)
 
 func NewOpProgramServerExecutor() *OpProgramServerExecutor {
 	return &OpProgramServerExecutor{}
 }
 
 func (s *OpProgramServerExecutor) OracleCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error) {
-	args := []string{
-		cfg.Server, "--server",
-		"--l1", cfg.L1,
-		"--l1.beacon", cfg.L1Beacon,
-		"--l2", cfg.L2,
+	return s.command(cfg, dataDir, inputs, true, true), nil
+}
+
+// PrefetchCommand runs op-program natively, which fetches all pre-images of the game into the data dir.
+func (s *OpProgramServerExecutor) PrefetchCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error) {
+	return s.command(cfg, dataDir, inputs, false, true), nil
+}
+
+// OfflineOracleCommand runs the op-program pre-image server without the L1 and L2 endpoints,
+// which serves the pre-images from the data dir only.
+func (s *OpProgramServerExecutor) OfflineOracleCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error) {
+	return s.command(cfg, dataDir, inputs, true, false), nil
+}
+
+func (s *OpProgramServerExecutor) command(cfg Config, dataDir string, inputs utils.LocalGameInputs, server bool, online bool) []string {
+	args := []string{cfg.Server}
+	if server {
+		args = append(args, "--server")
+	}
+	if online {
+		args = append(args, "--l1", cfg.L1, "--l1.beacon", cfg.L1Beacon, "--l2", cfg.L2)
+	}
+	args = append(args,
 		"--datadir", dataDir,
 		"--l1.head", inputs.L1Head.Hex(),
 		"--l2.head", inputs.L2Head.Hex(),
 		"--l2.outputroot", inputs.L2OutputRoot.Hex(),
 		"--l2.claim", inputs.L2Claim.Hex(),
 		"--l2.blocknumber", inputs.L2BlockNumber.Text(10),
-	}
+	)
 	if cfg.Network != "" {
 		args = append(args, "--network", cfg.Network)
 	}
@@ -35,5 +53,5 @@ func (s *OpProgramServerExecutor) OracleCommand(cfg Config, dataDir string, inpu
 	if cfg.L2GenesisPath != "" {
 		args = append(args, "--l2.genesis", cfg.L2GenesisPath)
 	}
-	return args, nil
+	return args
 }
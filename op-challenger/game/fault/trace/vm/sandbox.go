@@ -0,0 +1,169 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	log2 "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrVmTimeout     = errors.New("vm exceeded time limit")
+	ErrVmCPULimit    = errors.New("vm exceeded cpu time limit")
+	ErrVmOutOfMemory = errors.New("vm exceeded memory limit")
+
+	ErrSandboxUnsupported = errors.New("vm sandbox limit not supported on this platform")
+	ErrOfflineUnsupported = errors.New("pre-image server does not support running without network access")
+)
+
+// outOfMemoryMarker is printed by the Go runtime when an allocation fails, e.g. because the memory limit is reached.
+var outOfMemoryMarker = []byte("out of memory")
+
+// SandboxConfig limits the resources available to the VM and the pre-image server it spawns,
+// so that executing a pathological game can't take down the challenger host.
+// Zero values disable the corresponding limit.
+type SandboxConfig struct {
+	MemoryLimit  uint64        // Maximum virtual memory of each process in bytes
+	CPUTimeLimit time.Duration // Maximum CPU time of each process
+	Timeout      time.Duration // Maximum wall clock time of a single VM execution
+	// NoNetwork runs the VM and its pre-image server in a new network namespace without network access.
+	// The pre-image server then serves the pre-images offline, from the pre-image store that is populated
+	// by running the program natively, with network access and the other limits, before the VM execution.
+	NoNetwork bool
+}
+
+func (c SandboxConfig) Enabled() bool {
+	return c.MemoryLimit != 0 || c.CPUTimeLimit != 0 || c.Timeout != 0 || c.NoNetwork
+}
+
+func (c SandboxConfig) Check() error {
+	if c.CPUTimeLimit != 0 && c.CPUTimeLimit < time.Second {
+		return fmt.Errorf("vm cpu time limit must be at least 1s, got %v", c.CPUTimeLimit)
+	}
+	return checkSandboxSupported(c)
+}
+
+// ResourceLimitError reports a VM execution that was terminated because it exceeded a sandbox limit.
+type ResourceLimitError struct {
+	Limit   error // One of ErrVmTimeout, ErrVmCPULimit or ErrVmOutOfMemory
+	Elapsed time.Duration
+	CPUTime time.Duration
+	Err     error // Error returned when running the process
+}
+
+func (e *ResourceLimitError) Error() string {
+	return fmt.Sprintf("%v (elapsed: %v, cpu time: %v): %v", e.Limit, e.Elapsed, e.CPUTime, e.Err)
+}
+
+func (e *ResourceLimitError) Unwrap() []error {
+	return []error{e.Limit, e.Err}
+}
+
+// Reason returns a short description of the exceeded limit, suitable as a metric label.
+func (e *ResourceLimitError) Reason() string {
+	switch e.Limit {
+	case ErrVmTimeout:
+		return "timeout"
+	case ErrVmCPULimit:
+		return "cpu"
+	case ErrVmOutOfMemory:
+		return "memory"
+	default:
+		return "unknown"
+	}
+}
+
+// NewSandboxedCmdExecutor creates a CmdExecutor that runs the command with the limits of the sandbox config.
+// Executions terminated by a limit return a *ResourceLimitError.
+func NewSandboxedCmdExecutor(cfg SandboxConfig) CmdExecutor {
+	return func(ctx context.Context, l log.Logger, binary string, args ...string) error {
+		runCtx := ctx
+		if cfg.Timeout != 0 {
+			var cancel context.CancelFunc
+			runCtx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+			defer cancel()
+		}
+		cmd := exec.CommandContext(runCtx, binary, args...)
+		stdOut := log2.NewWriter(l, log.LevelInfo)
+		defer stdOut.Close()
+		// Keep stdErr at info level because FPVM uses stderr for progress messages
+		stdErr := log2.NewWriter(l, log.LevelInfo)
+		defer stdErr.Close()
+		oom := &markerDetector{marker: outOfMemoryMarker}
+		cmd.Stdout = stdOut
+		cmd.Stderr = io.MultiWriter(stdErr, oom)
+		configureSandbox(cmd, cfg)
+
+		start := time.Now()
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		if err := applyResourceLimits(cmd.Process.Pid, cfg); err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return fmt.Errorf("failed to apply vm resource limits: %w", err)
+		}
+		err := cmd.Wait()
+		if err == nil {
+			return nil
+		}
+		elapsed := time.Since(start)
+		var cpuTime time.Duration
+		if cmd.ProcessState != nil {
+			cpuTime = cmd.ProcessState.UserTime() + cmd.ProcessState.SystemTime()
+		}
+		var limit error
+		switch {
+		case ctx.Err() == nil && errors.Is(runCtx.Err(), context.DeadlineExceeded):
+			limit = ErrVmTimeout
+		case cfg.CPUTimeLimit != 0 && exceededCPULimit(cmd.ProcessState, cpuTime, cfg.CPUTimeLimit):
+			limit = ErrVmCPULimit
+		case cfg.MemoryLimit != 0 && (oom.Found() || killedBySystem(cmd.ProcessState)):
+			limit = ErrVmOutOfMemory
+		default:
+			return err
+		}
+		return &ResourceLimitError{Limit: limit, Elapsed: elapsed, CPUTime: cpuTime, Err: err}
+	}
+}
+
+// markerDetector records whether the marker was written, including when it is split across writes.
+type markerDetector struct {
+	mu     sync.Mutex
+	marker []byte
+	tail   []byte
+	found  bool
+}
+
+func (d *markerDetector) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.found {
+		return len(p), nil
+	}
+	data := append(d.tail, p...)
+	if bytes.Contains(data, d.marker) {
+		d.found = true
+		d.tail = nil
+		return len(p), nil
+	}
+	keep := len(d.marker) - 1
+	if len(data) > keep {
+		data = data[len(data)-keep:]
+	}
+	d.tail = append(d.tail[:0], data...)
+	return len(p), nil
+}
+
+func (d *markerDetector) Found() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.found
+}
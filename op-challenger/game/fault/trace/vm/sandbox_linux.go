@@ -0,0 +1,77 @@
+//go:build linux
+
+package vm
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func checkSandboxSupported(_ SandboxConfig) error {
+	return nil
+}
+
+// configureSandbox runs the command in its own process group, so the pre-image server is terminated with the VM,
+// and optionally in new user and network namespaces to remove network access.
+func configureSandbox(cmd *exec.Cmd, cfg SandboxConfig) {
+	attr := &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	if cfg.NoNetwork {
+		// A user namespace allows creating the network namespace without privileges.
+		attr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET
+		attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1}}
+		attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+	}
+	cmd.SysProcAttr = attr
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+// applyResourceLimits sets the resource limits of the started process. The limits are inherited by the
+// processes it spawns. The limits apply shortly after the process started, which is sufficient to contain
+// executions that run for minutes.
+func applyResourceLimits(pid int, cfg SandboxConfig) error {
+	if cfg.MemoryLimit != 0 {
+		limit := &unix.Rlimit{Cur: cfg.MemoryLimit, Max: cfg.MemoryLimit}
+		if err := unix.Prlimit(pid, unix.RLIMIT_AS, limit, nil); err != nil {
+			return err
+		}
+	}
+	if cfg.CPUTimeLimit != 0 {
+		secs := uint64(cfg.CPUTimeLimit / time.Second)
+		// The soft limit sends SIGXCPU, the hard limit a second later SIGKILL.
+		limit := &unix.Rlimit{Cur: secs, Max: secs + 1}
+		if err := unix.Prlimit(pid, unix.RLIMIT_CPU, limit, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exceededCPULimit(state *os.ProcessState, cpuTime time.Duration, limit time.Duration) bool {
+	if state == nil {
+		return false
+	}
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return false
+	}
+	sig := status.Signal()
+	return sig == syscall.SIGXCPU || (sig == syscall.SIGKILL && cpuTime >= limit)
+}
+
+// killedBySystem returns true if the process was killed by SIGKILL, e.g. by the kernel OOM killer.
+func killedBySystem(state *os.ProcessState) bool {
+	if state == nil {
+		return false
+	}
+	status, ok := state.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGKILL
+}
@@ -0,0 +1,32 @@
+//go:build !linux
+
+package vm
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// checkSandboxSupported rejects the limits that require linux. The timeout is supported on all platforms.
+func checkSandboxSupported(cfg SandboxConfig) error {
+	if cfg.MemoryLimit != 0 || cfg.CPUTimeLimit != 0 || cfg.NoNetwork {
+		return fmt.Errorf("%w: memory, cpu time and network limits require linux", ErrSandboxUnsupported)
+	}
+	return nil
+}
+
+func configureSandbox(_ *exec.Cmd, _ SandboxConfig) {}
+
+func applyResourceLimits(_ int, _ SandboxConfig) error {
+	return nil
+}
+
+func exceededCPULimit(_ *os.ProcessState, _ time.Duration, _ time.Duration) bool {
+	return false
+}
+
+func killedBySystem(_ *os.ProcessState) bool {
+	return false
+}
@@ -0,0 +1,92 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxedCmdExecutor(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("sandbox limits require linux")
+	}
+	logger := testlog.Logger(t, log.LevelInfo)
+
+	t.Run("Success", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{Timeout: time.Minute, MemoryLimit: 1 << 30, CPUTimeLimit: time.Minute})
+		require.NoError(t, exec(context.Background(), logger, "sh", "-c", "exit 0"))
+	})
+
+	t.Run("OtherFailuresNotReported", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{Timeout: time.Minute, MemoryLimit: 1 << 30, CPUTimeLimit: time.Minute})
+		err := exec(context.Background(), logger, "sh", "-c", "exit 3")
+		require.Error(t, err)
+		var limitErr *ResourceLimitError
+		require.False(t, errors.As(err, &limitErr))
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{Timeout: 100 * time.Millisecond})
+		err := exec(context.Background(), logger, "sh", "-c", "sleep 30")
+		require.ErrorIs(t, err, ErrVmTimeout)
+		var limitErr *ResourceLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, "timeout", limitErr.Reason())
+	})
+
+	t.Run("ParentCancelledIsNotTimeout", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{Timeout: time.Minute})
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := exec(ctx, logger, "sh", "-c", "sleep 30")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrVmTimeout)
+	})
+
+	t.Run("CPUTimeLimit", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{CPUTimeLimit: time.Second, Timeout: time.Minute})
+		err := exec(context.Background(), logger, "sh", "-c", "while :; do :; done")
+		require.ErrorIs(t, err, ErrVmCPULimit)
+	})
+
+	t.Run("NoNetwork", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{NoNetwork: true})
+		// Only the loopback interface exists in a new network namespace
+		err := exec(context.Background(), logger, "sh", "-c", "test \"$(tail -n +3 /proc/net/dev | wc -l)\" -eq 1")
+		if errors.Is(err, syscall.EPERM) || errors.Is(err, syscall.EINVAL) {
+			t.Skip("unprivileged user namespaces not available")
+		}
+		require.NoError(t, err)
+	})
+
+	t.Run("OutOfMemory", func(t *testing.T) {
+		exec := NewSandboxedCmdExecutor(SandboxConfig{MemoryLimit: 1 << 30})
+		// Simulates the Go runtime failing to allocate memory
+		err := exec(context.Background(), logger, "sh", "-c", "echo 'fatal error: runtime: out of memory' >&2; exit 2")
+		require.ErrorIs(t, err, ErrVmOutOfMemory)
+	})
+}
+
+func TestMarkerDetector(t *testing.T) {
+	d := &markerDetector{marker: outOfMemoryMarker}
+	_, _ = d.Write([]byte("fatal error: runtime: out of"))
+	require.False(t, d.Found())
+	_, _ = d.Write([]byte(" mem"))
+	require.False(t, d.Found())
+	_, _ = d.Write([]byte("ory\n"))
+	require.True(t, d.Found())
+}
+
+func TestSandboxConfigCheck(t *testing.T) {
+	require.False(t, SandboxConfig{}.Enabled())
+	require.NoError(t, SandboxConfig{}.Check())
+	require.NoError(t, SandboxConfig{Timeout: time.Minute}.Check())
+	require.ErrorContains(t, SandboxConfig{CPUTimeLimit: time.Millisecond}.Check(), "at least 1s")
+}
@@ -14,8 +14,25 @@ const (
 	ActionTypeChallengeL2BlockNumber ActionType = "challenge-l2-block-number"
 )
 
+// CounterReason describes why the honest actor responds to a claim.
+type CounterReason string
+
+const (
+	CounterReasonNone CounterReason = ""
+	// CounterReasonInvalidRootClaim is used when the root claim disagrees with our trace.
+	CounterReasonInvalidRootClaim CounterReason = "invalid-root-claim"
+	// CounterReasonCountersHonestClaim is used when the claim counters a claim the honest actor agrees with.
+	CounterReasonCountersHonestClaim CounterReason = "counters-honest-claim"
+	// CounterReasonLeftOfHonestCounter is used when the claim is a sibling at or left of the honest counter to its parent.
+	CounterReasonLeftOfHonestCounter CounterReason = "left-of-honest-counter"
+	// CounterReasonInvalidL2BlockNumber is used when the root claim's L2 block number is beyond the safe head.
+	CounterReasonInvalidL2BlockNumber CounterReason = "invalid-l2-block-number"
+)
+
 type Action struct {
 	Type ActionType
+	// Reason records why the solver chose to respond to the parent claim.
+	Reason CounterReason
 
 	// Moves and Steps
 	ParentClaim Claim
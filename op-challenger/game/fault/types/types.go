@@ -52,6 +52,9 @@ func (t GameType) String() string {
 	case AlphabetGameType:
 		return "alphabet"
 	default:
+		if traceType, ok := customGameTypes[t]; ok {
+			return traceType.String()
+		}
 		return fmt.Sprintf("<invalid: %d>", t)
 	}
 }
@@ -69,6 +72,25 @@ const (
 
 var TraceTypes = []TraceType{TraceTypeAlphabet, TraceTypeCannon, TraceTypePermissioned, TraceTypeAsterisc, TraceTypeAsteriscKona, TraceTypeFast}
 
+// customGameTypes maps the game types of custom trace types to their trace type.
+var customGameTypes = make(map[GameType]TraceType)
+
+// RegisterTraceType adds a custom trace type, that plays the given game type, to the valid trace types.
+// It must be called before the flags are parsed, i.e. from an init function.
+// Panics if the trace type or game type is already in use, since this indicates a significant programmer error.
+func RegisterTraceType(traceType TraceType, gameType GameType) {
+	if ValidTraceType(traceType) {
+		panic(fmt.Errorf("duplicate trace type: %v", traceType))
+	}
+	for _, t := range TraceTypes {
+		if t.GameType() == gameType {
+			panic(fmt.Errorf("game type %v already used by trace type %v", gameType, t))
+		}
+	}
+	TraceTypes = append(TraceTypes, traceType)
+	customGameTypes[gameType] = traceType
+}
+
 func (t TraceType) String() string {
 	return string(t)
 }
@@ -111,6 +133,11 @@ func (t TraceType) GameType() GameType {
 	case TraceTypeAlphabet:
 		return AlphabetGameType
 	default:
+		for gameType, traceType := range customGameTypes {
+			if traceType == t {
+				return gameType
+			}
+		}
 		return UnknownGameType
 	}
 }
@@ -163,7 +190,7 @@ func (p *PreimageOracleData) GetPrecompileInput() []byte {
 // NewPreimageOracleData creates a new [PreimageOracleData] instance.
 func NewPreimageOracleData(key []byte, data []byte, offset uint32) *PreimageOracleData {
 	return &PreimageOracleData{
-		IsLocal:      len(key) > 0 && key[0] == byte(preimage.LocalKeyType),
+		IsLocal:      len(key) > 0 && preimage.KeyType(key[0]).IsLocal(),
 		OracleKey:    key,
 		oracleData:   data,
 		OracleOffset: offset,
@@ -31,8 +31,12 @@ type RWClock interface {
 
 type gameScheduler interface {
 	Schedule([]types.GameMetadata, uint64) error
+	Reschedule(game common.Address)
+	Ignore(game common.Address)
 }
 
+var ErrGameNotAllowed = errors.New("game not on allow list")
+
 type preimageScheduler interface {
 	Schedule(blockHash common.Hash, blockNumber uint64) error
 }
@@ -41,6 +45,15 @@ type claimer interface {
 	Schedule(blockNumber uint64, games []types.GameMetadata) error
 }
 
+// noopPreimageScheduler and noopClaimer are used in observer mode, where no transactions are sent.
+type noopPreimageScheduler struct{}
+
+func (noopPreimageScheduler) Schedule(common.Hash, uint64) error { return nil }
+
+type noopClaimer struct{}
+
+func (noopClaimer) Schedule(uint64, []types.GameMetadata) error { return nil }
+
 type gameMonitor struct {
 	logger       log.Logger
 	clock        RWClock
@@ -53,6 +66,12 @@ type gameMonitor struct {
 	l1HeadsSub   ethereum.Subscription
 	l1Source     *headSource
 	runState     sync.Mutex
+
+	// lock guards ignoredGames and latestHead
+	lock sync.Mutex
+	// ignoredGames are the games ignored by operator request, until they are rescheduled or the challenger restarts.
+	ignoredGames map[common.Address]bool
+	latestHead   eth.L1BlockRef
 }
 
 type MinimalSubscriber interface {
@@ -88,6 +107,7 @@ func newGameMonitor(
 		claimer:      claimer,
 		allowedGames: allowedGames,
 		l1Source:     &headSource{inner: l1Source},
+		ignoredGames: make(map[common.Address]bool),
 	}
 }
 
@@ -109,18 +129,25 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash,
 	if err != nil {
 		return fmt.Errorf("failed to load games: %w", err)
 	}
-	var gamesToPlay []types.GameMetadata
+	var gamesToSchedule []types.GameMetadata
+	var gamesToClaim []types.GameMetadata
 	for _, game := range games {
 		if !m.allowedGame(game.Proxy) {
 			m.logger.Debug("Skipping game not on allow list", "game", game.Proxy)
 			continue
 		}
-		gamesToPlay = append(gamesToPlay, game)
+		// Ignored games are still scheduled, so their state and data are kept, but the scheduler doesn't progress them.
+		gamesToSchedule = append(gamesToSchedule, game)
+		if m.ignoredGame(game.Proxy) {
+			m.logger.Debug("Skipping game ignored by operator request", "game", game.Proxy)
+			continue
+		}
+		gamesToClaim = append(gamesToClaim, game)
 	}
-	if err := m.claimer.Schedule(blockNumber, gamesToPlay); err != nil {
+	if err := m.claimer.Schedule(blockNumber, gamesToClaim); err != nil {
 		return fmt.Errorf("failed to schedule bond claims: %w", err)
 	}
-	if err := m.scheduler.Schedule(gamesToPlay, blockNumber); errors.Is(err, scheduler.ErrBusy) {
+	if err := m.scheduler.Schedule(gamesToSchedule, blockNumber); errors.Is(err, scheduler.ErrBusy) {
 		m.logger.Info("Scheduler still busy with previous update")
 	} else if err != nil {
 		return fmt.Errorf("failed to schedule games: %w", err)
@@ -128,7 +155,48 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash,
 	return nil
 }
 
+func (m *gameMonitor) ignoredGame(game common.Address) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.ignoredGames[game]
+}
+
+// IgnoreGame stops the challenger from progressing the game or claiming its bonds,
+// until the game is rescheduled or the challenger restarts. The state and data of the game are kept.
+func (m *gameMonitor) IgnoreGame(game common.Address) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ignoredGames[game] = true
+	m.scheduler.Ignore(game)
+	m.logger.Warn("Ignoring game by operator request", "game", game)
+}
+
+// RescheduleGame forces the game to be loaded again from the chain and re-evaluated immediately, instead of
+// waiting for the next L1 block, e.g. after fixing a config issue or funding the account. If the game was
+// ignored by operator request, it is no longer ignored.
+func (m *gameMonitor) RescheduleGame(ctx context.Context, game common.Address) error {
+	if !m.allowedGame(game) {
+		return fmt.Errorf("%w: %v", ErrGameNotAllowed, game)
+	}
+	m.lock.Lock()
+	wasIgnored := m.ignoredGames[game]
+	delete(m.ignoredGames, game)
+	head := m.latestHead
+	m.lock.Unlock()
+	m.logger.Warn("Rescheduling game by operator request", "game", game, "wasIgnored", wasIgnored)
+
+	m.scheduler.Reschedule(game)
+	if head == (eth.L1BlockRef{}) {
+		// No L1 head processed yet, the game is evaluated at the first one.
+		return nil
+	}
+	return m.progressGames(ctx, head.Hash, head.Number)
+}
+
 func (m *gameMonitor) onNewL1Head(ctx context.Context, sig eth.L1BlockRef) {
+	m.lock.Lock()
+	m.latestHead = sig
+	m.lock.Unlock()
 	m.clock.SetTime(sig.Time)
 	if err := m.progressGames(ctx, sig.Hash, sig.Number); err != nil {
 		m.logger.Error("Failed to progress games", "err", err)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -142,6 +143,54 @@ func TestMonitorOnlyScheduleSpecifiedGame(t *testing.T) {
 	require.Equal(t, 1, stubClaimer.scheduledGames)
 }
 
+func TestMonitorIgnoreGame(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, sched, _, _, stubClaimer := setupMonitorTest(t, []common.Address{})
+	source.games = []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+
+	monitor.IgnoreGame(addr1)
+	require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}, 0))
+
+	require.Equal(t, []common.Address{addr1}, sched.ignored)
+	require.Len(t, sched.Scheduled(), 1)
+	require.Equal(t, []common.Address{addr1, addr2}, sched.Scheduled()[0], "ignored games are scheduled to keep their data")
+	require.Equal(t, 1, stubClaimer.scheduledGames)
+}
+
+func TestMonitorRescheduleGame(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+
+	t.Run("BeforeFirstHead", func(t *testing.T) {
+		monitor, source, sched, _, _, _ := setupMonitorTest(t, []common.Address{})
+		source.games = []types.GameMetadata{newFDG(addr1, 9999)}
+		require.NoError(t, monitor.RescheduleGame(context.Background(), addr1))
+		require.Equal(t, []common.Address{addr1}, sched.rescheduled)
+		require.Empty(t, sched.Scheduled())
+	})
+
+	t.Run("ProgressesImmediately", func(t *testing.T) {
+		monitor, source, sched, _, _, stubClaimer := setupMonitorTest(t, []common.Address{})
+		source.games = []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+		monitor.IgnoreGame(addr1)
+		monitor.onNewL1Head(context.Background(), eth.L1BlockRef{Hash: common.Hash{0x01}, Number: 5})
+		require.Equal(t, 1, stubClaimer.scheduledGames)
+
+		require.NoError(t, monitor.RescheduleGame(context.Background(), addr1))
+		require.Equal(t, []common.Address{addr1}, sched.rescheduled)
+		require.Len(t, sched.Scheduled(), 2)
+		require.Equal(t, []common.Address{addr1, addr2}, sched.Scheduled()[1])
+		require.Equal(t, 3, stubClaimer.scheduledGames, "should no longer ignore the game")
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		monitor, _, sched, _, _, _ := setupMonitorTest(t, []common.Address{addr2})
+		require.ErrorIs(t, monitor.RescheduleGame(context.Background(), addr1), ErrGameNotAllowed)
+		require.Empty(t, sched.rescheduled)
+	})
+}
+
 func newFDG(proxy common.Address, timestamp uint64) types.GameMetadata {
 	return types.GameMetadata{
 		Proxy:     proxy,
@@ -251,7 +300,9 @@ func (s *stubGameSource) GetGamesAtOrAfter(
 
 type stubScheduler struct {
 	sync.Mutex
-	scheduled [][]common.Address
+	scheduled   [][]common.Address
+	rescheduled []common.Address
+	ignored     []common.Address
 }
 
 func (s *stubScheduler) Scheduled() [][]common.Address {
@@ -271,6 +322,18 @@ func (s *stubScheduler) Schedule(games []types.GameMetadata, blockNumber uint64)
 	return nil
 }
 
+func (s *stubScheduler) Reschedule(game common.Address) {
+	s.Lock()
+	defer s.Unlock()
+	s.rescheduled = append(s.rescheduled, game)
+}
+
+func (s *stubScheduler) Ignore(game common.Address) {
+	s.Lock()
+	defer s.Unlock()
+	s.ignored = append(s.ignored, game)
+}
+
 type stubPreimageScheduler struct {
 	sync.Mutex
 	scheduleCount int
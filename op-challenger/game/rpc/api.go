@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/explain"
+	"github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+type ExplanationStore interface {
+	Explanations(game common.Address) ([]explain.Explanation, error)
+	Games() ([]common.Address, error)
+}
+
+type GameController interface {
+	RescheduleGame(ctx context.Context, game common.Address) error
+	IgnoreGame(game common.Address)
+}
+
+type challengerAPI struct {
+	explanations ExplanationStore
+	games        GameController
+}
+
+func NewChallengerAPI(explanations ExplanationStore, games GameController) *challengerAPI {
+	return &challengerAPI{
+		explanations: explanations,
+		games:        games,
+	}
+}
+
+func GetChallengerAPI(api *challengerAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "challenger",
+		Service:   api,
+	}
+}
+
+// MoveExplanations returns the explanation of every action performed in the game, in the order they were made.
+func (a *challengerAPI) MoveExplanations(_ context.Context, game common.Address) ([]explain.Explanation, error) {
+	return a.explanations.Explanations(game)
+}
+
+// ExplainedGames returns the addresses of all games the challenger has recorded actions for.
+func (a *challengerAPI) ExplainedGames(_ context.Context) ([]common.Address, error) {
+	return a.explanations.Games()
+}
+
+// RescheduleGame forces the challenger to load the game again and re-evaluate it immediately,
+// instead of waiting for the next L1 block. Games ignored via IgnoreGame are no longer ignored.
+func (a *challengerAPI) RescheduleGame(ctx context.Context, game common.Address) error {
+	return a.games.RescheduleGame(ctx, game)
+}
+
+// IgnoreGame stops the challenger from acting on the game until it is rescheduled or the challenger restarts.
+func (a *challengerAPI) IgnoreGame(_ context.Context, game common.Address) error {
+	a.games.IgnoreGame(game)
+	return nil
+}
@@ -48,6 +48,9 @@ type coordinator struct {
 
 	allowInvalidPrestate bool
 
+	// ignored are the games that are not progressed. Their state and data are kept.
+	ignored map[common.Address]bool
+
 	// lastScheduledBlockNum is the highest block number that the coordinator has seen and scheduled jobs.
 	lastScheduledBlockNum uint64
 }
@@ -128,6 +131,11 @@ func (c *coordinator) createJob(ctx context.Context, game types.GameMetadata, bl
 		c.logger.Debug("Not rescheduling already in-flight game", "game", game.Proxy)
 		return nil, nil
 	}
+	if c.ignored[game.Proxy] {
+		c.logger.Debug("Not progressing game ignored by operator request", "game", game.Proxy)
+		state.lastProcessedBlockNum = blockNumber
+		return nil, nil
+	}
 	// Create the player separately to the state so we retry creating it if it fails on the first attempt.
 	if state.player == nil {
 		player, err := c.createPlayer(game, c.disk.DirForGame(game.Proxy))
@@ -152,6 +160,20 @@ func (c *coordinator) createJob(ctx context.Context, game types.GameMetadata, bl
 	return newJob(blockNumber, game.Proxy, state.player, state.status), nil
 }
 
+// reset discards the player and status of the game, so they are loaded again from the chain
+// the next time the game is scheduled. Games with a progression in flight are already being re-evaluated.
+func (c *coordinator) reset(addr common.Address) {
+	state, ok := c.states[addr]
+	if !ok {
+		return
+	}
+	if state.inflight {
+		c.logger.Info("Not resetting game with progression in flight", "game", addr)
+		return
+	}
+	state.player = nil
+}
+
 func (c *coordinator) enqueueJob(ctx context.Context, j job) error {
 	for {
 		select {
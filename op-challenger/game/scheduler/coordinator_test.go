@@ -119,6 +119,60 @@ func TestScheduleGameAgainAfterCompletion(t *testing.T) {
 	require.Len(t, workQueue, 1, "should reschedule completed game")
 }
 
+func TestResetGame(t *testing.T) {
+	c, workQueue, _, games, _, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	ctx := context.Background()
+
+	// The game is loaded as resolved, so it is not progressed
+	games.createCompleted = gameAddr1
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1), 0))
+	require.Len(t, workQueue, 0)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1), 1))
+	require.Len(t, workQueue, 0, "should not load resolved game again")
+
+	// After resetting, the game is loaded again and progressed
+	games.createCompleted = common.Address{}
+	delete(games.created, gameAddr1)
+	c.reset(gameAddr1)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1), 2))
+	require.Len(t, workQueue, 1, "should load and schedule reset game")
+	require.Contains(t, games.created, gameAddr1)
+
+	// Games in flight are not reset
+	c.reset(gameAddr1)
+	require.NotNil(t, c.states[gameAddr1].player)
+
+	// Unknown games are ignored
+	c.reset(common.Address{0xbb})
+	require.NotContains(t, c.states, common.Address{0xbb})
+}
+
+func TestIgnoredGameKeepsData(t *testing.T) {
+	c, workQueue, _, _, disk, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	ctx := context.Background()
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2), 0))
+	require.Len(t, workQueue, 2)
+	require.NoError(t, c.processResult(<-workQueue))
+	require.NoError(t, c.processResult(<-workQueue))
+
+	c.ignored = map[common.Address]bool{gameAddr1: true}
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2), 1))
+	require.Len(t, workQueue, 1, "should not progress ignored game")
+	j := <-workQueue
+	require.Equal(t, gameAddr2, j.addr)
+	require.NoError(t, c.processResult(j))
+	require.Contains(t, c.states, gameAddr1, "should keep state of ignored game")
+	require.True(t, disk.gameDirExists[gameAddr1], "should keep data of ignored game")
+
+	c.ignored = nil
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2), 2))
+	require.Len(t, workQueue, 2, "should progress game once no longer ignored")
+}
+
 func TestResultForUnknownGame(t *testing.T) {
 	c, _, _, _, _, _ := setupCoordinatorTest(t, 10)
 	err := c.processResult(job{addr: common.Address{0xaa}})
@@ -3,9 +3,11 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"maps"
 	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -37,6 +39,14 @@ type Scheduler struct {
 	resultQueue    chan job
 	wg             sync.WaitGroup
 	cancel         func()
+
+	// resets are the games to load again from the chain when they are next scheduled.
+	resetsLock sync.Mutex
+	resets     []common.Address
+
+	// ignored are the games that are not progressed, while their state and data are kept.
+	ignoredLock sync.Mutex
+	ignored     map[common.Address]bool
 }
 
 func NewScheduler(logger log.Logger, m SchedulerMetricer, disk DiskManager, maxConcurrency uint, createPlayer PlayerCreator, allowInvalidPrestate bool) *Scheduler {
@@ -99,6 +109,42 @@ func (s *Scheduler) Schedule(games []types.GameMetadata, blockNumber uint64) err
 	}
 }
 
+// Reschedule discards the state of the game, so it is loaded again from the chain when it is next scheduled,
+// even if it was considered resolved or failed to load previously. An ignored game is no longer ignored.
+func (s *Scheduler) Reschedule(game common.Address) {
+	s.ignoredLock.Lock()
+	delete(s.ignored, game)
+	s.ignoredLock.Unlock()
+	s.resetsLock.Lock()
+	defer s.resetsLock.Unlock()
+	s.resets = append(s.resets, game)
+}
+
+// Ignore stops progressing the game when it is scheduled, until it is rescheduled.
+// The state and data of the game are kept, so it continues where it left off once rescheduled.
+func (s *Scheduler) Ignore(game common.Address) {
+	s.ignoredLock.Lock()
+	defer s.ignoredLock.Unlock()
+	if s.ignored == nil {
+		s.ignored = make(map[common.Address]bool)
+	}
+	s.ignored[game] = true
+}
+
+func (s *Scheduler) ignoredGames() map[common.Address]bool {
+	s.ignoredLock.Lock()
+	defer s.ignoredLock.Unlock()
+	return maps.Clone(s.ignored)
+}
+
+func (s *Scheduler) takeResets() []common.Address {
+	s.resetsLock.Lock()
+	defer s.resetsLock.Unlock()
+	resets := s.resets
+	s.resets = nil
+	return resets
+}
+
 func (s *Scheduler) loop(ctx context.Context) {
 	defer s.wg.Done()
 	for {
@@ -106,6 +152,10 @@ func (s *Scheduler) loop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case blockGames := <-s.scheduleQueue:
+			for _, game := range s.takeResets() {
+				s.coordinator.reset(game)
+			}
+			s.coordinator.ignored = s.ignoredGames()
 			if err := s.coordinator.schedule(ctx, blockGames.games, blockGames.blockNumber); err != nil {
 				s.logger.Error("Failed to schedule game updates", "err", err)
 			}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/keccak"
@@ -19,7 +20,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/explain"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/observer"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/registry"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/rpc"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-challenger/version"
@@ -29,6 +33,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
@@ -53,6 +58,8 @@ type Service struct {
 	claimer   *claims.BondClaimScheduler
 
 	factoryContract *contracts.DisputeGameFactoryContract
+	explanations    *explain.Store
+	observations    *observer.Store
 	registry        *registry.GameTypeRegistry
 	oracles         *registry.OracleRegistry
 	rollupClient    *sources.RollupClient
@@ -62,8 +69,9 @@ type Service struct {
 
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
+	rpcServer    *oprpc.Server
 
-	balanceMetricer io.Closer
+	accountMonitor io.Closer
 
 	stopped atomic.Bool
 }
@@ -71,7 +79,7 @@ type Service struct {
 // NewService creates a new Service.
 func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, m metrics.Metricer) (*Service, error) {
 	s := &Service{
-		systemClock: clock.SystemClock,
+		systemClock: cfg.ClockConfig.Clock(),
 		l1Clock:     clock.NewSimpleClock(),
 		logger:      logger,
 		metrics:     m,
@@ -86,7 +94,9 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, m me
 }
 
 func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error {
-	if err := s.initTxManager(ctx, cfg); err != nil {
+	if cfg.Observer() {
+		s.logger.Info("Running in observer mode, no transactions will be sent")
+	} else if err := s.initTxManager(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init tx manager: %w", err)
 	}
 	s.initClaimants(cfg)
@@ -108,33 +118,48 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	if err := s.initFactoryContract(cfg); err != nil {
 		return fmt.Errorf("failed to create factory contract bindings: %w", err)
 	}
+	if err := s.initExplanations(cfg); err != nil {
+		return fmt.Errorf("failed to init move explanations: %w", err)
+	}
+	if err := s.initObservations(cfg); err != nil {
+		return fmt.Errorf("failed to init observer report: %w", err)
+	}
 	if err := s.registerGameTypes(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to register game types: %w", err)
 	}
-	if err := s.initBondClaims(); err != nil {
+	if err := s.initBondClaims(cfg); err != nil {
 		return fmt.Errorf("failed to init bond claiming: %w", err)
 	}
 	if err := s.initScheduler(cfg); err != nil {
 		return fmt.Errorf("failed to init scheduler: %w", err)
 	}
-	if err := s.initLargePreimages(); err != nil {
+	if err := s.initLargePreimages(cfg); err != nil {
 		return fmt.Errorf("failed to init large preimage scheduler: %w", err)
 	}
 
 	s.initMonitor(cfg)
 
+	if err := s.initRPCServer(cfg); err != nil {
+		return fmt.Errorf("failed to init rpc server: %w", err)
+	}
+
 	s.metrics.RecordInfo(version.SimpleWithMeta)
 	s.metrics.RecordUp()
 	return nil
 }
 
 func (s *Service) initClaimants(cfg *config.Config) {
-	claimants := []common.Address{s.txSender.From()}
+	var claimants []common.Address
+	if s.txSender != nil {
+		claimants = append(claimants, s.txSender.From())
+	}
 	s.claimants = append(claimants, cfg.AdditionalBondClaimants...)
 }
 
 func (s *Service) initTxManager(ctx context.Context, cfg *config.Config) error {
-	txMgr, err := txmgr.NewSimpleTxManager("challenger", s.logger, s.metrics, cfg.TxMgrConfig)
+	txMgrCfg := cfg.TxMgrConfig
+	txMgrCfg.ErrorABIs = contracts.ErrorABIs()
+	txMgr, err := txmgr.NewSimpleTxManager("challenger", s.logger, s.metrics, txMgrCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create the transaction manager: %w", err)
 	}
@@ -170,6 +195,7 @@ func (s *Service) initPProf(cfg *oppprof.CLIConfig) error {
 		cfg.ProfileDir,
 		cfg.ProfileFilename,
 	)
+	s.pprofService.EnableUpload(cfg.Upload, "op-challenger", version.SimpleWithMeta)
 
 	if err := s.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
@@ -187,13 +213,26 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	if !ok {
 		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", s.metrics)
 	}
-	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.ListenAddr, cfg.ListenPort)
+	readiness := oprpc.NewHealthChecks(version.SimpleWithMeta)
+	readiness.Register("l1", func(ctx context.Context) error {
+		_, err := s.l1Client.BlockNumber(ctx)
+		return err
+	})
+	if s.rollupClient != nil {
+		readiness.Register("rollup", func(ctx context.Context) error {
+			_, err := s.rollupClient.SyncStatus(ctx)
+			return err
+		})
+	}
+	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.ListenAddr, cfg.ListenPort, httputil.WithRoute("/readyz", readiness.Handler()))
 	if err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
 	s.logger.Info("started metrics server", "addr", metricsSrv.Addr())
 	s.metricsSrv = metricsSrv
-	s.balanceMetricer = s.metrics.StartBalanceMetrics(s.logger, s.l1Client, s.txSender.From())
+	if s.txSender != nil {
+		s.accountMonitor = s.metrics.StartAccountMonitor(s.logger, s.l1Client, s.txSender.From())
+	}
 	return nil
 }
 
@@ -204,7 +243,10 @@ func (s *Service) initFactoryContract(cfg *config.Config) error {
 	return nil
 }
 
-func (s *Service) initBondClaims() error {
+func (s *Service) initBondClaims(cfg *config.Config) error {
+	if cfg.Observer() {
+		return nil
+	}
 	claimer := claims.NewBondClaimer(s.logger, s.metrics, s.registry.CreateBondContract, s.txSender, s.claimants...)
 	s.claimer = claims.NewBondClaimScheduler(s.logger, s.metrics, claimer)
 	return nil
@@ -222,11 +264,32 @@ func (s *Service) initRollupClient(ctx context.Context, cfg *config.Config) erro
 	return nil
 }
 
+func (s *Service) initExplanations(cfg *config.Config) error {
+	store, err := explain.NewStore(filepath.Join(cfg.Datadir, explanationsDir))
+	if err != nil {
+		return err
+	}
+	s.explanations = store
+	return nil
+}
+
+func (s *Service) initObservations(cfg *config.Config) error {
+	if !cfg.Observer() {
+		return nil
+	}
+	store, err := observer.NewStore(filepath.Join(cfg.Datadir, observerReportFile), s.metrics)
+	if err != nil {
+		return err
+	}
+	s.observations = store
+	return nil
+}
+
 func (s *Service) registerGameTypes(ctx context.Context, cfg *config.Config) error {
 	gameTypeRegistry := registry.NewGameTypeRegistry()
 	oracles := registry.NewOracleRegistry()
 	caller := batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize)
-	closer, err := fault.RegisterGameTypes(ctx, s.systemClock, s.l1Clock, s.logger, s.metrics, cfg, gameTypeRegistry, oracles, s.rollupClient, s.txSender, s.factoryContract, caller, s.l1Client, cfg.SelectiveClaimResolution, s.claimants)
+	closer, err := fault.RegisterGameTypes(ctx, s.systemClock, s.l1Clock, s.logger, s.metrics, cfg, gameTypeRegistry, oracles, s.rollupClient, s.txSender, s.factoryContract, caller, s.l1Client, s.explanations, s.observations, cfg.SelectiveClaimResolution, s.claimants)
 	if err != nil {
 		return err
 	}
@@ -242,7 +305,10 @@ func (s *Service) initScheduler(cfg *config.Config) error {
 	return nil
 }
 
-func (s *Service) initLargePreimages() error {
+func (s *Service) initLargePreimages(cfg *config.Config) error {
+	if cfg.Observer() {
+		return nil
+	}
 	fetcher := fetcher.NewPreimageFetcher(s.logger, s.l1Client)
 	verifier := keccak.NewPreimageVerifier(s.logger, fetcher)
 	challenger := keccak.NewPreimageChallenger(s.logger, s.metrics, verifier, s.txSender)
@@ -251,14 +317,45 @@ func (s *Service) initLargePreimages() error {
 }
 
 func (s *Service) initMonitor(cfg *config.Config) {
-	s.monitor = newGameMonitor(s.logger, s.l1Clock, s.factoryContract, s.sched, s.preimages, cfg.GameWindow, s.claimer, cfg.GameAllowlist, s.pollClient)
+	var preimages preimageScheduler = s.preimages
+	var bondClaimer claimer = s.claimer
+	if cfg.Observer() {
+		// Observers don't challenge preimages or claim bonds, since both require sending transactions.
+		preimages = noopPreimageScheduler{}
+		bondClaimer = noopClaimer{}
+	}
+	s.monitor = newGameMonitor(s.logger, s.l1Clock, s.factoryContract, s.sched, preimages, cfg.GameWindow, bondClaimer, cfg.GameAllowlist, s.pollClient)
+}
+
+func (s *Service) initRPCServer(cfg *config.Config) error {
+	if !cfg.RPCEnabled {
+		return nil
+	}
+	server := oprpc.NewServer(
+		cfg.RPCConfig.ListenAddr,
+		cfg.RPCConfig.ListenPort,
+		version.SimpleWithMeta,
+		oprpc.WithLogger(s.logger),
+	)
+	server.AddAPI(rpc.GetChallengerAPI(rpc.NewChallengerAPI(s.explanations, s.monitor)))
+	s.logger.Info("Starting JSON-RPC server")
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("unable to start RPC server: %w", err)
+	}
+	s.logger.Info("Started JSON-RPC server", "endpoint", server.Endpoint())
+	s.rpcServer = server
+	return nil
 }
 
 func (s *Service) Start(ctx context.Context) error {
 	s.logger.Info("starting scheduler")
 	s.sched.Start(ctx)
-	s.claimer.Start(ctx)
-	s.preimages.Start(ctx)
+	if s.claimer != nil {
+		s.claimer.Start(ctx)
+	}
+	if s.preimages != nil {
+		s.preimages.Start(ctx)
+	}
 	s.logger.Info("starting monitoring")
 	s.monitor.StartMonitoring()
 	s.logger.Info("challenger game service start completed")
@@ -294,8 +391,8 @@ func (s *Service) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to close pprof server: %w", err))
 		}
 	}
-	if s.balanceMetricer != nil {
-		if err := s.balanceMetricer.Close(); err != nil {
+	if s.accountMonitor != nil {
+		if err := s.accountMonitor.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
 		}
 	}
@@ -313,6 +410,11 @@ func (s *Service) Stop(ctx context.Context) error {
 	if s.l1Client != nil {
 		s.l1Client.Close()
 	}
+	if s.rpcServer != nil {
+		if err := s.rpcServer.Stop(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop RPC server: %w", err))
+		}
+	}
 	if s.metricsSrv != nil {
 		if err := s.metricsSrv.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close metrics server: %w", err))
@@ -7,7 +7,6 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -22,7 +21,7 @@ type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
 
-	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
+	StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer
 
 	// Record Tx metrics
 	txmetrics.TxMetricer
@@ -40,6 +39,7 @@ type Metricer interface {
 	RecordGameL2Challenge()
 	RecordVmExecutionTime(vmType string, t time.Duration)
 	RecordVmMemoryUsed(vmType string, memoryUsed uint64)
+	RecordVmResourceLimitExceeded(vmType string, reason string)
 	RecordClaimResolutionTime(t float64)
 	RecordGameActTime(t float64)
 
@@ -51,6 +51,8 @@ type Metricer interface {
 
 	RecordGamesStatus(inProgress, defenderWon, challengerWon int)
 
+	RecordObservedGames(agree, disagree, unexpectedResolution int)
+
 	RecordGameUpdateScheduled()
 	RecordGameUpdateCompleted()
 
@@ -96,9 +98,13 @@ type Metrics struct {
 	gameActTime         prometheus.Histogram
 	vmExecutionTime     *prometheus.HistogramVec
 	vmMemoryUsed        *prometheus.HistogramVec
+	vmLimitExceeded     *prometheus.CounterVec
 
 	trackedGames  prometheus.GaugeVec
 	inflightGames prometheus.Gauge
+
+	observedGames         prometheus.GaugeVec
+	unexpectedResolutions prometheus.Gauge
 }
 
 func (m *Metrics) Registry() *prometheus.Registry {
@@ -185,6 +191,11 @@ func NewMetrics() *Metrics {
 			// 100MiB increments from 0 to 1.5GiB
 			Buckets: prometheus.LinearBuckets(0, 1024*1024*100, 15),
 		}, []string{"vm"}),
+		vmLimitExceeded: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "vm_limit_exceeded",
+			Help:      "Number of fault proof VM executions terminated by a sandbox limit",
+		}, []string{"vm", "limit"}),
 		bondClaimFailures: factory.NewCounter(prometheus.CounterOpts{
 			Namespace: Namespace,
 			Name:      "claim_failures",
@@ -217,6 +228,18 @@ func NewMetrics() *Metrics {
 		}, []string{
 			"status",
 		}),
+		observedGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "observed_games",
+			Help:      "Number of games scored in observer mode, by agreement with the root claim",
+		}, []string{
+			"root_claim",
+		}),
+		unexpectedResolutions: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "observed_unexpected_resolutions",
+			Help:      "Number of games scored in observer mode that resolved differently to the expected resolution",
+		}),
 		highestActedL1Block: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "highest_acted_l1_block",
@@ -234,12 +257,12 @@ func (m *Metrics) Start(host string, port int) (*httputil.HTTPServer, error) {
 	return opmetrics.StartServer(m.registry, host, port)
 }
 
-func (m *Metrics) StartBalanceMetrics(
+func (m *Metrics) StartAccountMonitor(
 	l log.Logger,
-	client *ethclient.Client,
+	client opmetrics.AccountClient,
 	account common.Address,
 ) io.Closer {
-	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+	return opmetrics.LaunchAccountMonitor(l, m.registry, m.ns, client, account)
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
@@ -298,6 +321,10 @@ func (m *Metrics) RecordVmMemoryUsed(vmType string, memoryUsed uint64) {
 	m.vmMemoryUsed.WithLabelValues(vmType).Observe(float64(memoryUsed))
 }
 
+func (m *Metrics) RecordVmResourceLimitExceeded(vmType string, reason string) {
+	m.vmLimitExceeded.WithLabelValues(vmType, reason).Inc()
+}
+
 func (m *Metrics) RecordClaimResolutionTime(t float64) {
 	m.claimResolutionTime.Observe(t)
 }
@@ -328,6 +355,12 @@ func (m *Metrics) RecordGamesStatus(inProgress, defenderWon, challengerWon int)
 	m.trackedGames.WithLabelValues("challenger_won").Set(float64(challengerWon))
 }
 
+func (m *Metrics) RecordObservedGames(agree, disagree, unexpectedResolution int) {
+	m.observedGames.WithLabelValues("agree").Set(float64(agree))
+	m.observedGames.WithLabelValues("disagree").Set(float64(disagree))
+	m.unexpectedResolutions.Set(float64(unexpectedResolution))
+}
+
 func (m *Metrics) RecordActedL1Block(n uint64) {
 	m.highestActedL1Block.Set(float64(n))
 }
@@ -6,9 +6,9 @@ import (
 
 	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
@@ -17,7 +17,7 @@ type NoopMetricsImpl struct {
 	contractMetrics.NoopMetrics
 }
 
-func (i *NoopMetricsImpl) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
+func (i *NoopMetricsImpl) StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer {
 	return nil
 }
 
@@ -39,13 +39,16 @@ func (*NoopMetricsImpl) RecordLargePreimageCount(_ int) {}
 func (*NoopMetricsImpl) RecordBondClaimFailed()   {}
 func (*NoopMetricsImpl) RecordBondClaimed(uint64) {}
 
-func (*NoopMetricsImpl) RecordVmExecutionTime(_ string, _ time.Duration) {}
-func (*NoopMetricsImpl) RecordVmMemoryUsed(_ string, _ uint64)           {}
-func (*NoopMetricsImpl) RecordClaimResolutionTime(t float64)             {}
-func (*NoopMetricsImpl) RecordGameActTime(t float64)                     {}
+func (*NoopMetricsImpl) RecordVmExecutionTime(_ string, _ time.Duration)  {}
+func (*NoopMetricsImpl) RecordVmMemoryUsed(_ string, _ uint64)            {}
+func (*NoopMetricsImpl) RecordVmResourceLimitExceeded(_ string, _ string) {}
+func (*NoopMetricsImpl) RecordClaimResolutionTime(t float64)              {}
+func (*NoopMetricsImpl) RecordGameActTime(t float64)                      {}
 
 func (*NoopMetricsImpl) RecordGamesStatus(inProgress, defenderWon, challengerWon int) {}
 
+func (*NoopMetricsImpl) RecordObservedGames(agree, disagree, unexpectedResolution int) {}
+
 func (*NoopMetricsImpl) RecordGameUpdateScheduled() {}
 func (*NoopMetricsImpl) RecordGameUpdateCompleted() {}
 
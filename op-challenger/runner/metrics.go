@@ -21,6 +21,7 @@ type Metrics struct {
 	vmLastExecutionTime *prometheus.GaugeVec
 	vmMemoryUsed        *prometheus.HistogramVec
 	vmLastMemoryUsed    *prometheus.GaugeVec
+	vmLimitExceeded     *prometheus.CounterVec
 	successTotal        *prometheus.CounterVec
 	failuresTotal       *prometheus.CounterVec
 	invalidTotal        *prometheus.CounterVec
@@ -67,6 +68,11 @@ func NewMetrics() *Metrics {
 			Name:      "vm_last_memory_used",
 			Help:      "Memory used (in bytes) for the last execution of the fault proof VM",
 		}, []string{"vm"}),
+		vmLimitExceeded: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "vm_limit_exceeded",
+			Help:      "Number of fault proof VM executions terminated by a sandbox limit",
+		}, []string{"vm", "limit"}),
 		successTotal: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: Namespace,
 			Name:      "success_total",
@@ -100,6 +106,10 @@ func (m *Metrics) RecordVmMemoryUsed(vmType string, memoryUsed uint64) {
 	m.vmLastMemoryUsed.WithLabelValues(vmType).Set(float64(memoryUsed))
 }
 
+func (m *Metrics) RecordVmResourceLimitExceeded(vmType string, reason string) {
+	m.vmLimitExceeded.WithLabelValues(vmType, reason).Inc()
+}
+
 func (m *Metrics) RecordSuccess(vmType types.TraceType) {
 	m.successTotal.WithLabelValues(vmType.String()).Inc()
 }
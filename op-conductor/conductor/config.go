@@ -3,6 +3,8 @@ package conductor
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -55,6 +57,9 @@ type Config struct {
 	// HealthCheck is the health check configuration.
 	HealthCheck HealthCheckConfig
 
+	// Rebalance is the automatic leadership rebalancing configuration.
+	Rebalance RebalanceConfig
+
 	// RollupCfg is the rollup config.
 	RollupCfg rollup.Config
 
@@ -90,6 +95,9 @@ func (c *Config) Check() error {
 	if err := c.HealthCheck.Check(); err != nil {
 		return errors.Wrap(err, "invalid health check config")
 	}
+	if err := c.Rebalance.Check(); err != nil {
+		return errors.Wrap(err, "invalid rebalance config")
+	}
 	if err := c.RollupCfg.Check(); err != nil {
 		return errors.Wrap(err, "invalid rollup config")
 	}
@@ -116,6 +124,23 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		return nil, errors.Wrap(err, "failed to load rollup config")
 	}
 
+	peerRPCs, err := parseServerValues(ctx.StringSlice(flags.RebalancePeerRPC.Name), func(v string) (string, error) {
+		return v, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rebalance peer RPCs")
+	}
+	weights, err := parseServerValues(ctx.StringSlice(flags.RebalanceWeight.Name), func(v string) (float64, error) {
+		return strconv.ParseFloat(v, 64)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rebalance weights")
+	}
+	priorities, err := parseServerValues(ctx.StringSlice(flags.RebalancePriority.Name), strconv.Atoi)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rebalance priorities")
+	}
+
 	return &Config{
 		ConsensusAddr:         ctx.String(flags.ConsensusAddr.Name),
 		ConsensusPort:         ctx.Int(flags.ConsensusPort.Name),
@@ -135,6 +160,17 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 			SafeInterval:   ctx.Uint64(flags.HealthCheckSafeInterval.Name),
 			MinPeerCount:   ctx.Uint64(flags.HealthCheckMinPeerCount.Name),
 		},
+		Rebalance: RebalanceConfig{
+			Enabled:           ctx.Bool(flags.RebalanceEnabled.Name),
+			Interval:          ctx.Duration(flags.RebalanceInterval.Name),
+			MinLeaderDuration: ctx.Duration(flags.RebalanceMinLeaderDuration.Name),
+			ScoreMargin:       ctx.Float64(flags.RebalanceScoreMargin.Name),
+			TargetPeerCount:   ctx.Uint64(flags.RebalanceTargetPeerCount.Name),
+			MaxEngineLatency:  ctx.Duration(flags.RebalanceMaxEngineLatency.Name),
+			PeerRPCs:          peerRPCs,
+			Weights:           weights,
+			Priorities:        priorities,
+		},
 		RollupCfg:      *rollupCfg,
 		RPCEnableProxy: ctx.Bool(flags.RPCEnableProxy.Name),
 		LogConfig:      oplog.ReadCLIConfig(ctx),
@@ -174,3 +210,77 @@ func (c *HealthCheckConfig) Check() error {
 	}
 	return nil
 }
+
+// RebalanceConfig defines the automatic leadership rebalancing policy.
+type RebalanceConfig struct {
+	// Enabled is true if the leader should periodically transfer leadership to a better candidate.
+	Enabled bool
+
+	// Interval is the interval between evaluations of the quality signals.
+	Interval time.Duration
+
+	// MinLeaderDuration is the minimum time a server must have been leader before it transfers leadership for rebalancing.
+	MinLeaderDuration time.Duration
+
+	// ScoreMargin is the minimum amount by which a candidate's score must exceed the leader's score.
+	ScoreMargin float64
+
+	// TargetPeerCount is the number of p2p peers at which a server receives the full connectivity score.
+	TargetPeerCount uint64
+
+	// MaxEngineLatency is the execution engine latency at which a server receives the full latency penalty.
+	MaxEngineLatency time.Duration
+
+	// PeerRPCs maps the server IDs of the other servers in the cluster to their conductor RPC URL.
+	PeerRPCs map[string]string
+
+	// Weights maps server IDs to a preference weight added to their score. Servers without a weight have weight 0.
+	Weights map[string]float64
+
+	// Priorities maps server IDs to their leadership priority. Servers without a priority have priority 0.
+	// A healthy server with a higher priority is always preferred over the leader, regardless of score,
+	// and an unhealthy leader hands leadership to the healthy server with the highest priority.
+	Priorities map[string]int
+}
+
+func (c *RebalanceConfig) Check() error {
+	if !c.Enabled {
+		if len(c.Priorities) > 0 {
+			return fmt.Errorf("leadership priorities require rebalancing to be enabled")
+		}
+		return nil
+	}
+	if c.Interval == 0 {
+		return fmt.Errorf("missing rebalance interval")
+	}
+	if c.ScoreMargin <= 0 {
+		return fmt.Errorf("rebalance score margin must be positive")
+	}
+	if c.TargetPeerCount == 0 {
+		return fmt.Errorf("missing rebalance target peer count")
+	}
+	if c.MaxEngineLatency == 0 {
+		return fmt.Errorf("missing rebalance max engine latency")
+	}
+	if len(c.PeerRPCs) == 0 {
+		return fmt.Errorf("missing rebalance peer RPCs")
+	}
+	return nil
+}
+
+// parseServerValues parses a list of <server-id>=<value> entries.
+func parseServerValues[T any](entries []string, parse func(string) (T, error)) (map[string]T, error) {
+	out := make(map[string]T, len(entries))
+	for _, entry := range entries {
+		id, value, ok := strings.Cut(entry, "=")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid entry %q, expected <server-id>=<value>", entry)
+		}
+		v, err := parse(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for server %s", id)
+		}
+		out[id] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,227 @@
+package conductor
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+)
+
+const peerQualityTimeout = 10 * time.Second
+
+// transferTargetTimeout bounds the time an unhealthy leader spends on choosing the server to transfer leadership to,
+// so that a slow or unreachable peer delays failover by little. Peers that do not respond in time are not considered.
+const transferTargetTimeout = 2 * time.Second
+
+// rebalanceCandidate is a server that leadership could be transferred to.
+type rebalanceCandidate struct {
+	server   consensus.ServerInfo
+	priority int
+	score    float64
+}
+
+// better returns true if the candidate ranks above the other candidate: by priority first, then by score.
+func (c *rebalanceCandidate) better(other *rebalanceCandidate) bool {
+	if c.priority != other.priority {
+		return c.priority > other.priority
+	}
+	return c.score > other.score
+}
+
+// score ranks a server for leadership, higher is better.
+// Connectivity adds up to 1 once TargetPeerCount peers are connected, engine latency subtracts up to 1 at MaxEngineLatency,
+// and the configured preference weight of the server is added as is.
+func (c *RebalanceConfig) score(id string, q *health.NodeQuality) float64 {
+	connectivity := min(float64(q.PeerCount)/float64(c.TargetPeerCount), 1)
+	latency := min(float64(q.EngineLatency)/float64(c.MaxEngineLatency), 1)
+	return c.Weights[id] + connectivity - latency
+}
+
+// best returns the highest ranked candidate, or nil if there are no candidates.
+func best(candidates []rebalanceCandidate) *rebalanceCandidate {
+	var out *rebalanceCandidate
+	for i := range candidates {
+		if out == nil || candidates[i].better(out) {
+			out = &candidates[i]
+		}
+	}
+	return out
+}
+
+// target returns the best candidate if it has a higher priority than the leader, or the same priority and
+// a materially better score, or nil if leadership should be kept.
+func (c *RebalanceConfig) target(leader rebalanceCandidate, candidates []rebalanceCandidate) *rebalanceCandidate {
+	target := best(candidates)
+	if target == nil || target.priority < leader.priority {
+		return nil
+	}
+	if target.priority == leader.priority && target.score < leader.score+c.ScoreMargin {
+		return nil
+	}
+	return target
+}
+
+// priority returns the leadership priority of the server, taking a priority override into account.
+func (oc *OpConductor) priority(id string) int {
+	if override := oc.priorityOverride.Load(); override != nil && *override == id {
+		return math.MaxInt
+	}
+	return oc.cfg.Rebalance.Priorities[id]
+}
+
+func (oc *OpConductor) rebalanceLoop() {
+	defer oc.wg.Done()
+
+	ticker := time.NewTicker(oc.cfg.Rebalance.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-oc.shutdownCtx.Done():
+			return
+		case <-ticker.C:
+			if err := oc.rebalance(oc.shutdownCtx); err != nil {
+				oc.log.Warn("failed to rebalance leadership", "err", err)
+			}
+		}
+	}
+}
+
+// rebalance transfers leadership to the best healthy voter, if the current server is a healthy leader
+// that has led for at least MinLeaderDuration, and the candidate has a higher priority,
+// or the same priority and a score that exceeds its own by at least ScoreMargin.
+// Unhealthy leaders are left to the control loop, which transfers leadership regardless of quality.
+func (oc *OpConductor) rebalance(ctx context.Context) error {
+	if !oc.leader.Load() {
+		oc.leaderSince = time.Time{}
+		return nil
+	}
+	now := time.Now()
+	if oc.leaderSince.IsZero() {
+		oc.leaderSince = now
+	}
+	if oc.Paused() || !oc.healthy.Load() || now.Sub(oc.leaderSince) < oc.cfg.Rebalance.MinLeaderDuration {
+		return nil
+	}
+
+	self := oc.cons.ServerID()
+	local, err := oc.NodeQuality(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get local quality signals")
+	}
+	leader := rebalanceCandidate{priority: oc.priority(self), score: oc.cfg.Rebalance.score(self, local)}
+
+	candidates, err := oc.rebalanceCandidates(ctx, self)
+	if err != nil {
+		return err
+	}
+	target := oc.cfg.Rebalance.target(leader, candidates)
+	if target == nil {
+		oc.log.Debug("keeping leadership", "server", self, "priority", leader.priority, "score", leader.score, "candidates", len(candidates))
+		return nil
+	}
+	oc.log.Info("rebalancing leadership", "server", self, "priority", leader.priority, "score", leader.score,
+		"target", target.server.ID, "target_priority", target.priority, "target_score", target.score)
+	err = oc.cons.TransferLeaderTo(target.server.ID, target.server.Addr)
+	oc.metrics.RecordLeaderTransfer(err == nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to transfer leadership to %s", target.server.ID)
+	}
+	oc.leaderSince = time.Time{}
+	return nil
+}
+
+// rebalanceCandidates returns the healthy voters, other than the given server, that have a configured peer RPC.
+// The peers are queried concurrently.
+func (oc *OpConductor) rebalanceCandidates(ctx context.Context, self string) ([]rebalanceCandidate, error) {
+	membership, err := oc.cons.ClusterMembership()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster membership")
+	}
+	var servers []consensus.ServerInfo
+	for _, server := range membership.Servers {
+		if server.ID == self || server.Suffrage != consensus.Voter {
+			continue
+		}
+		if _, ok := oc.cfg.Rebalance.PeerRPCs[server.ID]; !ok {
+			oc.log.Debug("skipping server without a configured rebalance peer RPC", "server", server.ID)
+			continue
+		}
+		servers = append(servers, server)
+	}
+
+	qualities := make([]*health.NodeQuality, len(servers))
+	var wg sync.WaitGroup
+	for i, server := range servers {
+		wg.Add(1)
+		go func(i int, server consensus.ServerInfo) {
+			defer wg.Done()
+			q, err := oc.peerQuality(ctx, oc.cfg.Rebalance.PeerRPCs[server.ID])
+			if err != nil {
+				oc.log.Warn("failed to get quality signals of server", "server", server.ID, "err", err)
+				return
+			}
+			qualities[i] = q
+		}(i, server)
+	}
+	wg.Wait()
+
+	var candidates []rebalanceCandidate
+	for i, server := range servers {
+		if q := qualities[i]; q != nil && q.Healthy {
+			candidates = append(candidates, rebalanceCandidate{
+				server:   server,
+				priority: oc.priority(server.ID),
+				score:    oc.cfg.Rebalance.score(server.ID, q),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// priorityTransferTarget returns the healthy voter with the highest priority, that an unhealthy leader should
+// transfer leadership to, or nil if no priorities are configured or overridden, or there is no healthy candidate.
+func (oc *OpConductor) priorityTransferTarget(ctx context.Context) *rebalanceCandidate {
+	if len(oc.cfg.Rebalance.Priorities) == 0 && oc.priorityOverride.Load() == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, transferTargetTimeout)
+	defer cancel()
+	candidates, err := oc.rebalanceCandidates(ctx, oc.cons.ServerID())
+	if err != nil {
+		oc.log.Warn("failed to get leadership candidates", "err", err)
+		return nil
+	}
+	return best(candidates)
+}
+
+// dialPeerQuality queries the quality signals of another conductor over RPC.
+func dialPeerQuality(ctx context.Context, rpcURL string) (*health.NodeQuality, error) {
+	ctx, cancel := context.WithTimeout(ctx, peerQualityTimeout)
+	defer cancel()
+	cl, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial conductor rpc")
+	}
+	defer cl.Close()
+	return conductorrpc.NewAPIClient(cl).NodeQuality(ctx)
+}
+
+// dialPeerPriorityOverride forwards a leadership priority override to another conductor over RPC.
+func dialPeerPriorityOverride(ctx context.Context, rpcURL string, id string, addr string) error {
+	ctx, cancel := context.WithTimeout(ctx, peerQualityTimeout)
+	defer cancel()
+	cl, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to dial conductor rpc")
+	}
+	defer cl.Close()
+	return conductorrpc.NewAPIClient(cl).TransferLeaderWithPriorityOverride(ctx, id, addr)
+}
@@ -0,0 +1,277 @@
+package conductor
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientmocks "github.com/ethereum-optimism/optimism/op-conductor/client/mocks"
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	consensusmocks "github.com/ethereum-optimism/optimism/op-conductor/consensus/mocks"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	p2pmocks "github.com/ethereum-optimism/optimism/op-node/p2p/mocks"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func rebalanceConfig() RebalanceConfig {
+	return RebalanceConfig{
+		Enabled:           true,
+		Interval:          time.Second,
+		MinLeaderDuration: 0,
+		ScoreMargin:       0.25,
+		TargetPeerCount:   10,
+		MaxEngineLatency:  100 * time.Millisecond,
+		PeerRPCs: map[string]string{
+			"SequencerB": "http://b",
+			"SequencerC": "http://c",
+		},
+		Weights: map[string]float64{"SequencerC": 0.5},
+	}
+}
+
+func TestRebalanceScore(t *testing.T) {
+	cfg := rebalanceConfig()
+	require.Equal(t, 1.0, cfg.score("SequencerA", &health.NodeQuality{PeerCount: 20}))
+	require.Equal(t, 0.5, cfg.score("SequencerA", &health.NodeQuality{PeerCount: 10, EngineLatency: 50 * time.Millisecond}))
+	require.Equal(t, -1.0, cfg.score("SequencerA", &health.NodeQuality{EngineLatency: time.Second}))
+	require.Equal(t, 1.5, cfg.score("SequencerC", &health.NodeQuality{PeerCount: 10}))
+}
+
+func TestRebalanceTarget(t *testing.T) {
+	cfg := rebalanceConfig()
+	b := rebalanceCandidate{server: consensus.ServerInfo{ID: "SequencerB"}, score: 1}
+	c := rebalanceCandidate{server: consensus.ServerInfo{ID: "SequencerC"}, score: 1.5}
+	require.Nil(t, cfg.target(rebalanceCandidate{score: 1}, nil))
+	require.Nil(t, cfg.target(rebalanceCandidate{score: 1}, []rebalanceCandidate{b}))
+	require.Nil(t, cfg.target(rebalanceCandidate{score: 1.3}, []rebalanceCandidate{b, c}), "should not transfer within the margin")
+	require.Equal(t, "SequencerC", cfg.target(rebalanceCandidate{score: 1}, []rebalanceCandidate{b, c}).server.ID)
+}
+
+func TestRebalanceTargetPriority(t *testing.T) {
+	cfg := rebalanceConfig()
+	b := rebalanceCandidate{server: consensus.ServerInfo{ID: "SequencerB"}, priority: 1, score: 0}
+	c := rebalanceCandidate{server: consensus.ServerInfo{ID: "SequencerC"}, priority: 0, score: 2}
+	require.Equal(t, "SequencerB", cfg.target(rebalanceCandidate{score: 1}, []rebalanceCandidate{b, c}).server.ID,
+		"should transfer to a higher priority regardless of score")
+	require.Nil(t, cfg.target(rebalanceCandidate{priority: 2}, []rebalanceCandidate{b, c}),
+		"should not transfer to a lower priority regardless of score")
+	require.Equal(t, "SequencerC", cfg.target(rebalanceCandidate{priority: 0}, []rebalanceCandidate{c}).server.ID)
+}
+
+type rebalanceTest struct {
+	oc   *OpConductor
+	cons *consensusmocks.Consensus
+	ctrl *clientmocks.SequencerControl
+	p2p  *p2pmocks.API
+
+	peers     map[string]*health.NodeQuality
+	overrides []string
+}
+
+func newRebalanceTest(t *testing.T) *rebalanceTest {
+	cfg := mockConfig(t)
+	cfg.Rebalance = rebalanceConfig()
+	rt := &rebalanceTest{
+		cons:  &consensusmocks.Consensus{},
+		ctrl:  &clientmocks.SequencerControl{},
+		p2p:   &p2pmocks.API{},
+		peers: make(map[string]*health.NodeQuality),
+	}
+	rt.oc = &OpConductor{
+		log:     testlog.Logger(t, log.LevelDebug),
+		cfg:     &cfg,
+		metrics: &metrics.NoopMetricsImpl{},
+		cons:    rt.cons,
+		ctrl:    rt.ctrl,
+		p2p:     rt.p2p,
+		peerQuality: func(_ context.Context, rpcURL string) (*health.NodeQuality, error) {
+			q, ok := rt.peers[rpcURL]
+			if !ok {
+				return nil, errors.New("unreachable")
+			}
+			return q, nil
+		},
+		peerPriorityOverride: func(_ context.Context, rpcURL string, id string, _ string) error {
+			rt.overrides = append(rt.overrides, rpcURL+"="+id)
+			return nil
+		},
+		shutdownCtx: context.Background(),
+	}
+	rt.oc.leader.Store(true)
+	rt.oc.healthy.Store(true)
+	rt.cons.EXPECT().ServerID().Return("SequencerA").Maybe()
+	rt.cons.EXPECT().ClusterMembership().Return(&consensus.ClusterMembership{Servers: []consensus.ServerInfo{
+		{ID: "SequencerA", Addr: "a:50050", Suffrage: consensus.Voter},
+		{ID: "SequencerB", Addr: "b:50050", Suffrage: consensus.Voter},
+		{ID: "SequencerC", Addr: "c:50050", Suffrage: consensus.Voter},
+		{ID: "SequencerD", Addr: "d:50050", Suffrage: consensus.Nonvoter},
+	}}, nil).Maybe()
+	rt.ctrl.EXPECT().LatestUnsafeBlock(mock.Anything).Return(nil, nil).Maybe()
+	return rt
+}
+
+func (rt *rebalanceTest) localPeers(n int) {
+	rt.p2p.EXPECT().PeerStats(mock.Anything).Return(&p2p.PeerStats{Connected: uint(n)}, nil)
+}
+
+func TestRebalance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TransferToBestCandidate", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.localPeers(2)
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.cons.EXPECT().TransferLeaderTo("SequencerC", "c:50050").Return(nil)
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertExpectations(t)
+	})
+
+	t.Run("SkipUnhealthyAndUnreachable", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.localPeers(2)
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: false, PeerCount: 10}
+		rt.cons.EXPECT().TransferLeaderTo(mock.Anything, mock.Anything).Return(nil)
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertNotCalled(t, "TransferLeaderTo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("KeepWithinMargin", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.localPeers(10)
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.cons.EXPECT().TransferLeaderTo(mock.Anything, mock.Anything).Return(nil)
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertNotCalled(t, "TransferLeaderTo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("RateLimited", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.MinLeaderDuration = time.Hour
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		require.False(t, rt.oc.leaderSince.IsZero())
+		rt.p2p.AssertNotCalled(t, "PeerStats", mock.Anything)
+
+		rt.oc.leader.Store(false)
+		require.NoError(t, rt.oc.rebalance(ctx))
+		require.True(t, rt.oc.leaderSince.IsZero())
+	})
+
+	t.Run("NotLeader", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.leader.Store(false)
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertNotCalled(t, "ClusterMembership")
+	})
+}
+
+func TestPriorityElection(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FailBackToHigherPriority", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 1, "SequencerB": 2}
+		rt.localPeers(20)
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: true}
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.cons.EXPECT().TransferLeaderTo("SequencerB", "b:50050").Return(nil)
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertExpectations(t)
+	})
+
+	t.Run("KeepOverLowerPriority", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 2, "SequencerB": 1}
+		rt.localPeers(0)
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.cons.EXPECT().TransferLeaderTo(mock.Anything, mock.Anything).Return(nil)
+
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertNotCalled(t, "TransferLeaderTo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("FailoverToHighestHealthyPriority", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 3, "SequencerB": 2, "SequencerC": 1}
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: false}
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true}
+		rt.cons.EXPECT().TransferLeaderTo("SequencerC", "c:50050").Return(nil)
+
+		require.NoError(t, rt.oc.transferLeader())
+		require.False(t, rt.oc.leader.Load())
+		rt.cons.AssertNotCalled(t, "TransferLeader")
+	})
+
+	t.Run("FailoverSkipsSlowPeers", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 3, "SequencerB": 2, "SequencerC": 1}
+		rt.oc.peerQuality = func(ctx context.Context, rpcURL string) (*health.NodeQuality, error) {
+			if rpcURL == "http://b" {
+				<-ctx.Done() // unresponsive peer
+				return nil, ctx.Err()
+			}
+			return &health.NodeQuality{Healthy: true}, nil
+		}
+		rt.cons.EXPECT().TransferLeaderTo("SequencerC", "c:50050").Return(nil)
+
+		start := time.Now()
+		require.NoError(t, rt.oc.transferLeader())
+		require.Less(t, time.Since(start), peerQualityTimeout, "must not wait for the peer quality timeout")
+		require.False(t, rt.oc.leader.Load())
+	})
+
+	t.Run("FailoverFallsBackToAnyServer", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 1}
+		rt.cons.EXPECT().TransferLeader().Return(nil)
+
+		require.NoError(t, rt.oc.transferLeader())
+		require.False(t, rt.oc.leader.Load())
+		rt.cons.AssertNotCalled(t, "TransferLeaderTo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.oc.cfg.Rebalance.Priorities = map[string]int{"SequencerA": 2}
+		rt.cons.EXPECT().Leader().Return(true).Once()
+		rt.cons.EXPECT().TransferLeaderTo("SequencerC", "c:50050").Return(nil).Once()
+
+		require.NoError(t, rt.oc.TransferLeaderWithPriorityOverride(ctx, "SequencerC", "c:50050"))
+		require.ElementsMatch(t, []string{"http://b=SequencerC", "http://c=SequencerC"}, rt.overrides)
+		require.Equal(t, math.MaxInt, rt.oc.priority("SequencerC"))
+		rt.cons.AssertExpectations(t)
+
+		// the overridden server is preferred over the configured priorities, once it leads it keeps leadership
+		rt.localPeers(0)
+		rt.peers["http://b"] = &health.NodeQuality{Healthy: true, PeerCount: 10}
+		rt.peers["http://c"] = &health.NodeQuality{Healthy: true}
+		rt.cons.EXPECT().TransferLeaderTo("SequencerC", "c:50050").Return(nil).Once()
+		require.NoError(t, rt.oc.rebalance(ctx))
+		rt.cons.AssertExpectations(t)
+	})
+
+	t.Run("OverrideOnFollower", func(t *testing.T) {
+		rt := newRebalanceTest(t)
+		rt.cons.EXPECT().Leader().Return(false)
+
+		require.NoError(t, rt.oc.TransferLeaderWithPriorityOverride(ctx, "SequencerA", "a:50050"))
+		require.Empty(t, rt.overrides, "followers don't forward the override")
+		require.Equal(t, math.MaxInt, rt.oc.priority("SequencerA"))
+		rt.cons.AssertNotCalled(t, "TransferLeaderTo", mock.Anything, mock.Anything)
+	})
+}
@@ -60,19 +60,21 @@ func NewOpConductor(
 	}
 
 	oc := &OpConductor{
-		log:          log,
-		version:      version,
-		cfg:          cfg,
-		metrics:      m,
-		pauseCh:      make(chan struct{}),
-		pauseDoneCh:  make(chan struct{}),
-		resumeCh:     make(chan struct{}),
-		resumeDoneCh: make(chan struct{}),
-		actionCh:     make(chan struct{}, 1),
-		ctrl:         ctrl,
-		cons:         cons,
-		hmon:         hmon,
-		retryBackoff: func() time.Duration { return time.Duration(rand.Intn(2000)) * time.Millisecond },
+		log:                  log,
+		version:              version,
+		cfg:                  cfg,
+		metrics:              m,
+		pauseCh:              make(chan struct{}),
+		pauseDoneCh:          make(chan struct{}),
+		resumeCh:             make(chan struct{}),
+		resumeDoneCh:         make(chan struct{}),
+		actionCh:             make(chan struct{}, 1),
+		ctrl:                 ctrl,
+		cons:                 cons,
+		hmon:                 hmon,
+		retryBackoff:         func() time.Duration { return time.Duration(rand.Intn(2000)) * time.Millisecond },
+		peerQuality:          dialPeerQuality,
+		peerPriorityOverride: dialPeerPriorityOverride,
 	}
 	oc.loopActionFn = oc.loopAction
 
@@ -184,6 +186,7 @@ func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 		return errors.Wrap(err, "failed to create p2p rpc client")
 	}
 	p2p := opp2p.NewClient(pc)
+	c.p2p = p2p
 
 	c.hmon = health.NewSequencerHealthMonitor(
 		c.log,
@@ -266,6 +269,7 @@ type OpConductor struct {
 	ctrl client.SequencerControl
 	cons consensus.Consensus
 	hmon health.HealthMonitor
+	p2p  opp2p.API
 
 	leader    atomic.Bool
 	seqActive atomic.Bool
@@ -292,6 +296,11 @@ type OpConductor struct {
 	metricsServer *httputil.HTTPServer
 
 	retryBackoff func() time.Duration
+
+	peerQuality          func(ctx context.Context, rpcURL string) (*health.NodeQuality, error)
+	peerPriorityOverride func(ctx context.Context, rpcURL string, id string, addr string) error
+	priorityOverride     atomic.Pointer[string] // server ID that is treated as the highest leadership priority, if any.
+	leaderSince          time.Time              // only accessed by the rebalance loop.
 }
 
 type state struct {
@@ -346,6 +355,12 @@ func (oc *OpConductor) Start(ctx context.Context) error {
 	oc.wg.Add(1)
 	go oc.loop()
 
+	if oc.cfg.Rebalance.Enabled {
+		oc.log.Info("starting leadership rebalancing", "interval", oc.cfg.Rebalance.Interval)
+		oc.wg.Add(1)
+		go oc.rebalanceLoop()
+	}
+
 	oc.metrics.RecordInfo(oc.version)
 	oc.metrics.RecordUp()
 
@@ -487,6 +502,27 @@ func (oc *OpConductor) TransferLeaderToServer(_ context.Context, id string, addr
 	return oc.cons.TransferLeaderTo(id, addr)
 }
 
+// TransferLeaderWithPriorityOverride makes the server the highest leadership priority, overriding the configured priorities,
+// and transfers leadership to it if the current server is the leader. The leader forwards the override to the servers with
+// a configured rebalance peer RPC first, so that the new leader doesn't hand leadership back to a higher configured priority.
+// The override stays in place until the next override, or until the conductor restarts.
+func (oc *OpConductor) TransferLeaderWithPriorityOverride(ctx context.Context, id string, addr string) error {
+	oc.priorityOverride.Store(&id)
+	oc.log.Info("overriding leadership priority", "server", id)
+	if !oc.cons.Leader() {
+		return nil
+	}
+	for peer, rpcURL := range oc.cfg.Rebalance.PeerRPCs {
+		if err := oc.peerPriorityOverride(ctx, rpcURL, id, addr); err != nil {
+			oc.log.Warn("failed to forward leadership priority override", "peer", peer, "err", err)
+		}
+	}
+	if id == oc.cons.ServerID() {
+		return nil
+	}
+	return oc.cons.TransferLeaderTo(id, addr)
+}
+
 // CommitUnsafePayload commits an unsafe payload (latest head) to the cluster FSM ensuring strong consistency by leveraging Raft consensus mechanisms.
 func (oc *OpConductor) CommitUnsafePayload(_ context.Context, payload *eth.ExecutionPayloadEnvelope) error {
 	return oc.cons.CommitUnsafePayload(payload)
@@ -497,6 +533,27 @@ func (oc *OpConductor) SequencerHealthy(_ context.Context) bool {
 	return oc.healthy.Load()
 }
 
+// NodeQuality returns the quality signals of the sequencer, used to rebalance leadership.
+func (oc *OpConductor) NodeQuality(ctx context.Context) (*health.NodeQuality, error) {
+	if oc.p2p == nil {
+		return nil, errors.New("p2p client is not available")
+	}
+	start := time.Now()
+	if _, err := oc.ctrl.LatestUnsafeBlock(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to query execution engine")
+	}
+	latency := time.Since(start)
+	stats, err := oc.p2p.PeerStats(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get peer stats")
+	}
+	return &health.NodeQuality{
+		Healthy:       oc.healthy.Load(),
+		PeerCount:     uint64(stats.Connected),
+		EngineLatency: latency,
+	}, nil
+}
+
 // ClusterMembership returns current cluster's membership information.
 func (oc *OpConductor) ClusterMembership(_ context.Context) (*consensus.ClusterMembership, error) {
 	return oc.cons.ClusterMembership()
@@ -675,9 +732,17 @@ func (oc *OpConductor) action() {
 
 // transferLeader tries to transfer leadership to another server.
 func (oc *OpConductor) transferLeader() error {
-	// TransferLeader here will do round robin to try to transfer leadership to the next healthy node.
-	oc.log.Info("transferring leadership", "server", oc.cons.ServerID())
-	err := oc.cons.TransferLeader()
+	var err error
+	if target := oc.priorityTransferTarget(oc.shutdownCtx); target != nil {
+		oc.log.Info("transferring leadership by priority", "server", oc.cons.ServerID(), "target", target.server.ID, "target_priority", target.priority)
+		err = oc.cons.TransferLeaderTo(target.server.ID, target.server.Addr)
+		if err != nil && !errors.Is(err, raft.ErrNotLeader) {
+			oc.log.Warn("failed to transfer leadership by priority, falling back to any healthy server", "target", target.server.ID, "err", err)
+			err = oc.transferLeaderToAny()
+		}
+	} else {
+		err = oc.transferLeaderToAny()
+	}
 	oc.metrics.RecordLeaderTransfer(err == nil)
 	if err == nil {
 		oc.leader.Store(false)
@@ -695,6 +760,13 @@ func (oc *OpConductor) transferLeader() error {
 	}
 }
 
+// transferLeaderToAny transfers leadership to the next server, regardless of priority.
+func (oc *OpConductor) transferLeaderToAny() error {
+	// TransferLeader here will do round robin to try to transfer leadership to the next healthy node.
+	oc.log.Info("transferring leadership", "server", oc.cons.ServerID())
+	return oc.cons.TransferLeader()
+}
+
 func (oc *OpConductor) stopSequencer() error {
 	oc.log.Info(
 		"stopping sequencer",
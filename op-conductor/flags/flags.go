@@ -112,6 +112,57 @@ var (
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RPC_ENABLE_PROXY"),
 		Value:   true,
 	}
+	RebalanceEnabled = &cli.BoolFlag{
+		Name:    "rebalance.enabled",
+		Usage:   "Enable automatic leadership rebalancing to the sequencer with the best quality signals",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_ENABLED"),
+		Value:   false,
+	}
+	RebalanceInterval = &cli.DurationFlag{
+		Name:    "rebalance.interval",
+		Usage:   "Interval between evaluations of the sequencer quality signals",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_INTERVAL"),
+		Value:   time.Minute,
+	}
+	RebalanceMinLeaderDuration = &cli.DurationFlag{
+		Name:    "rebalance.min-leader-duration",
+		Usage:   "Minimum time a server must have been leader before it transfers leadership for rebalancing, to avoid churn",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_MIN_LEADER_DURATION"),
+		Value:   30 * time.Minute,
+	}
+	RebalanceScoreMargin = &cli.Float64Flag{
+		Name:    "rebalance.score-margin",
+		Usage:   "Minimum amount by which a candidate's quality score must exceed the leader's to transfer leadership",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_SCORE_MARGIN"),
+		Value:   0.25,
+	}
+	RebalanceTargetPeerCount = &cli.Uint64Flag{
+		Name:    "rebalance.target-peer-count",
+		Usage:   "Number of p2p peers at which a sequencer receives the full connectivity score",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_TARGET_PEER_COUNT"),
+		Value:   20,
+	}
+	RebalanceMaxEngineLatency = &cli.DurationFlag{
+		Name:    "rebalance.max-engine-latency",
+		Usage:   "Execution engine latency at which a sequencer receives the full latency penalty",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_MAX_ENGINE_LATENCY"),
+		Value:   500 * time.Millisecond,
+	}
+	RebalancePeerRPC = &cli.StringSliceFlag{
+		Name:    "rebalance.peer-rpc",
+		Usage:   "Conductor RPC of another server in the cluster, as <server-id>=<url>, used to query its quality signals",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_PEER_RPC"),
+	}
+	RebalanceWeight = &cli.StringSliceFlag{
+		Name:    "rebalance.weight",
+		Usage:   "Preference weight added to the quality score of a server, as <server-id>=<weight>, e.g. to prefer a region",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_WEIGHT"),
+	}
+	RebalancePriority = &cli.StringSliceFlag{
+		Name:    "rebalance.priority",
+		Usage:   "Leadership priority of a server, as <server-id>=<priority>. The healthy server with the highest priority is elected leader, servers without a priority have priority 0",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "REBALANCE_PRIORITY"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -135,6 +186,15 @@ var optionalFlags = []cli.Flag{
 	RaftSnapshotInterval,
 	RaftSnapshotThreshold,
 	RaftTrailingLogs,
+	RebalanceEnabled,
+	RebalanceInterval,
+	RebalanceMinLeaderDuration,
+	RebalanceScoreMargin,
+	RebalanceTargetPeerCount,
+	RebalanceMaxEngineLatency,
+	RebalancePeerRPC,
+	RebalanceWeight,
+	RebalancePriority,
 }
 
 func init() {
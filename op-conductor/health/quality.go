@@ -0,0 +1,13 @@
+package health
+
+import "time"
+
+// NodeQuality is a snapshot of the signals used to rank sequencers when rebalancing leadership.
+type NodeQuality struct {
+	// Healthy is true if the sequencer passed its latest health check.
+	Healthy bool `json:"healthy"`
+	// PeerCount is the number of p2p peers connected to the sequencer's op-node.
+	PeerCount uint64 `json:"peerCount"`
+	// EngineLatency is the round-trip time of a request to the sequencer's execution engine.
+	EngineLatency time.Duration `json:"engineLatency"`
+}
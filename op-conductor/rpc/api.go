@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
@@ -31,6 +32,8 @@ type API interface {
 	Stopped(ctx context.Context) (bool, error)
 	// SequencerHealthy returns true if the sequencer is healthy.
 	SequencerHealthy(ctx context.Context) (bool, error)
+	// NodeQuality returns the quality signals of the sequencer, used to rebalance leadership.
+	NodeQuality(ctx context.Context) (*health.NodeQuality, error)
 
 	// Consensus related APIs
 	// Leader returns true if the server is the leader.
@@ -47,6 +50,8 @@ type API interface {
 	TransferLeader(ctx context.Context) error
 	// TransferLeaderToServer transfers leadership to a specific server.
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
+	// TransferLeaderWithPriorityOverride makes a specific server the highest leadership priority and transfers leadership to it.
+	TransferLeaderWithPriorityOverride(ctx context.Context, id string, addr string) error
 	// ClusterMembership returns the current cluster membership configuration.
 	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -17,6 +18,7 @@ type conductor interface {
 	Paused() bool
 	Stopped() bool
 	SequencerHealthy(ctx context.Context) bool
+	NodeQuality(ctx context.Context) (*health.NodeQuality, error)
 
 	Leader(ctx context.Context) bool
 	LeaderWithID(ctx context.Context) *consensus.ServerInfo
@@ -25,6 +27,7 @@ type conductor interface {
 	RemoveServer(ctx context.Context, id string, version uint64) error
 	TransferLeader(ctx context.Context) error
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
+	TransferLeaderWithPriorityOverride(ctx context.Context, id string, addr string) error
 	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error
 	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
 }
@@ -133,11 +136,22 @@ func (api *APIBackend) TransferLeaderToServer(ctx context.Context, id string, ad
 	return api.con.TransferLeaderToServer(ctx, id, addr)
 }
 
+// TransferLeaderWithPriorityOverride implements API. The override is kept by every server it is called on,
+// the leader forwards it to its peers before transferring leadership.
+func (api *APIBackend) TransferLeaderWithPriorityOverride(ctx context.Context, id string, addr string) error {
+	return api.con.TransferLeaderWithPriorityOverride(ctx, id, addr)
+}
+
 // SequencerHealthy implements API.
 func (api *APIBackend) SequencerHealthy(ctx context.Context) (bool, error) {
 	return api.con.SequencerHealthy(ctx), nil
 }
 
+// NodeQuality implements API.
+func (api *APIBackend) NodeQuality(ctx context.Context) (*health.NodeQuality, error) {
+	return api.con.NodeQuality(ctx)
+}
+
 // ClusterMembership implements API.
 func (api *APIBackend) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	return api.con.ClusterMembership(ctx)
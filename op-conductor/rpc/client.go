@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -117,6 +118,11 @@ func (c *APIClient) TransferLeaderToServer(ctx context.Context, id string, addr
 	return c.c.CallContext(ctx, nil, prefixRPC("transferLeaderToServer"), id, addr)
 }
 
+// TransferLeaderWithPriorityOverride implements API.
+func (c *APIClient) TransferLeaderWithPriorityOverride(ctx context.Context, id string, addr string) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("transferLeaderWithPriorityOverride"), id, addr)
+}
+
 // SequencerHealthy implements API.
 func (c *APIClient) SequencerHealthy(ctx context.Context) (bool, error) {
 	var healthy bool
@@ -124,6 +130,13 @@ func (c *APIClient) SequencerHealthy(ctx context.Context) (bool, error) {
 	return healthy, err
 }
 
+// NodeQuality implements API.
+func (c *APIClient) NodeQuality(ctx context.Context) (*health.NodeQuality, error) {
+	var quality health.NodeQuality
+	err := c.c.CallContext(ctx, &quality, prefixRPC("nodeQuality"))
+	return &quality, err
+}
+
 // ClusterMembership implements API.
 func (c *APIClient) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	var clusterMembership consensus.ClusterMembership
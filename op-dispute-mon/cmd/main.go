@@ -45,6 +45,9 @@ func run(ctx context.Context, args []string, action ConfiguredLifecycle) error {
 	app.Name = "op-dispute-mon"
 	app.Usage = "Monitor dispute games"
 	app.Description = "Monitors output proposals and dispute games."
+	app.Commands = []*cli.Command{
+		ReplayCommand,
+	}
 	app.Action = cliapp.LifecycleCmd(func(ctx *cli.Context, close context.CancelCauseFunc) (cliapp.Lifecycle, error) {
 		logger, err := setupLogging(ctx)
 		if err != nil {
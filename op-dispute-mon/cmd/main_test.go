@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -177,6 +178,24 @@ func TestGameWindow(t *testing.T) {
 	})
 }
 
+func TestL2OutputOracleAddress(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, common.Address{}, cfg.L2OutputOracleAddress)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xbb, 0xcc, 0xdd}
+		cfg := configForArgs(t, addRequiredArgs("--l2-output-oracle-address", addr.Hex()))
+		require.Equal(t, addr, cfg.L2OutputOracleAddress)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid L2OutputOracle address",
+			addRequiredArgs("--l2-output-oracle-address", "foo"))
+	})
+}
+
 func TestIgnoredGames(t *testing.T) {
 	t.Run("NotRequired", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
@@ -234,6 +253,36 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestRPC(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.RPCEnabled)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--rpc.enabled", "--rpc.port", "9876"))
+		require.True(t, cfg.RPCEnabled)
+		require.Equal(t, 9876, cfg.RPCConfig.ListenPort)
+	})
+}
+
+func TestTimeDilation(t *testing.T) {
+	t.Run("RealTimeByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, float64(1), cfg.ClockConfig.TimeDilation)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--dev.time-dilation", "10"))
+		require.Equal(t, float64(10), cfg.ClockConfig.TimeDilation)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--dev.time-dilation", "-2"))
+		require.ErrorIs(t, cfg.Check(), clock.ErrInvalidTimeDilation)
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := dryRunWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
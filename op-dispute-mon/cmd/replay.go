@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/flags"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/ctxinterrupt"
+)
+
+var (
+	StartBlockFlag = &cli.Uint64Flag{
+		Name:     "start-block",
+		Usage:    "First L1 block number to replay.",
+		EnvVars:  opservice.PrefixEnvVar(flags.EnvVarPrefix, "START_BLOCK"),
+		Required: true,
+	}
+	EndBlockFlag = &cli.Uint64Flag{
+		Name:     "end-block",
+		Usage:    "Last L1 block number to replay (inclusive).",
+		EnvVars:  opservice.PrefixEnvVar(flags.EnvVarPrefix, "END_BLOCK"),
+		Required: true,
+	}
+	StepFlag = &cli.Uint64Flag{
+		Name:    "step",
+		Usage:   "Number of L1 blocks to advance between each replayed block.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "STEP"),
+		Value:   1,
+	}
+	MaxIgnoredGamesFlag = &cli.IntFlag{
+		Name:    "max-ignored-games",
+		Usage:   "Alert threshold for the number of ignored games.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "MAX_IGNORED_GAMES"),
+	}
+	MaxFailedGamesFlag = &cli.IntFlag{
+		Name:    "max-failed-games",
+		Usage:   "Alert threshold for the number of games that failed to load.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "MAX_FAILED_GAMES"),
+	}
+	MaxValidProposalAgeFlag = &cli.DurationFlag{
+		Name:    "max-valid-proposal-age",
+		Usage:   "Alert threshold for the age of the latest valid proposal. Disabled if 0.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "MAX_VALID_PROPOSAL_AGE"),
+	}
+)
+
+func Replay(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	thresholds := mon.ReplayThresholds{
+		MaxIgnoredGames:     ctx.Int(MaxIgnoredGamesFlag.Name),
+		MaxFailedGames:      ctx.Int(MaxFailedGamesFlag.Name),
+		MaxValidProposalAge: ctx.Duration(MaxValidProposalAgeFlag.Name),
+	}
+	replayer, closeClients, err := mon.NewReplayer(ctx.Context, logger, cfg, thresholds)
+	if err != nil {
+		return fmt.Errorf("failed to create replayer: %w", err)
+	}
+	defer closeClients()
+
+	enc := json.NewEncoder(os.Stdout)
+	return replayer.Replay(ctx.Context, ctx.Uint64(StartBlockFlag.Name), ctx.Uint64(EndBlockFlag.Name), ctx.Uint64(StepFlag.Name), func(result *mon.ReplayResult) error {
+		return enc.Encode(result)
+	})
+}
+
+func replayFlags() []cli.Flag {
+	cliFlags := []cli.Flag{
+		StartBlockFlag,
+		EndBlockFlag,
+		StepFlag,
+		MaxIgnoredGamesFlag,
+		MaxFailedGamesFlag,
+		MaxValidProposalAgeFlag,
+	}
+	return append(cliFlags, flags.Flags...)
+}
+
+var ReplayCommand = &cli.Command{
+	Name:        "replay",
+	Usage:       "Replay historical L1 blocks through the monitor and report the alerts that would have fired",
+	Description: "Runs game extraction and forecasting at each block in the range and writes one JSON result per block to stdout",
+	Action: func(ctx *cli.Context) error {
+		ctx.Context = ctxinterrupt.WithCancelOnInterrupt(ctx.Context)
+		return Replay(ctx)
+	},
+	Flags: cliapp.ProtectFlags(replayFlags()),
+}
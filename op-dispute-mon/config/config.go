@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -37,6 +39,9 @@ const (
 type Config struct {
 	L1EthRpc           string         // L1 RPC Url
 	GameFactoryAddress common.Address // Address of the dispute game factory
+	// L2OutputOracleAddress is the address of the legacy L2OutputOracle, to monitor its output proposals
+	// side by side with dispute games while a chain migrates to fault proofs. Optional.
+	L2OutputOracleAddress common.Address
 
 	HonestActors    []common.Address // List of honest actors to monitor claims for.
 	RollupRpc       string           // The rollup node RPC URL.
@@ -47,6 +52,9 @@ type Config struct {
 
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	RPCEnabled    bool // Whether to serve the dispute monitor API
+	RPCConfig     oprpc.CLIConfig
+	ClockConfig   clock.CLIConfig
 }
 
 func NewConfig(gameFactoryAddress common.Address, l1EthRpc string, rollupRpc string) Config {
@@ -61,6 +69,8 @@ func NewConfig(gameFactoryAddress common.Address, l1EthRpc string, rollupRpc str
 
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		RPCConfig:     oprpc.DefaultCLIConfig(),
+		ClockConfig:   clock.DefaultCLIConfig(),
 	}
 }
 
@@ -83,5 +93,13 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return fmt.Errorf("pprof config: %w", err)
 	}
+	if c.RPCEnabled {
+		if err := c.RPCConfig.Check(); err != nil {
+			return fmt.Errorf("rpc config: %w", err)
+		}
+	}
+	if err := c.ClockConfig.Check(); err != nil {
+		return fmt.Errorf("clock config: %w", err)
+	}
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 )
 
 var (
@@ -45,3 +47,11 @@ func TestMaxConcurrencyRequired(t *testing.T) {
 	config.MaxConcurrency = 0
 	require.ErrorIs(t, config.Check(), ErrMissingMaxConcurrency)
 }
+
+func TestRPCConfigCheckedWhenEnabled(t *testing.T) {
+	config := validConfig()
+	config.RPCConfig.ListenPort = -1
+	require.NoError(t, config.Check())
+	config.RPCEnabled = true
+	require.ErrorIs(t, config.Check(), oprpc.ErrInvalidPort)
+}
@@ -9,18 +9,20 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
-	envVarPrefix = "OP_DISPUTE_MON"
+	EnvVarPrefix = "OP_DISPUTE_MON"
 )
 
 func prefixEnvVars(name string) []string {
-	return opservice.PrefixEnvVar(envVarPrefix, name)
+	return opservice.PrefixEnvVar(EnvVarPrefix, name)
 }
 
 var (
@@ -41,7 +43,13 @@ var (
 		Usage:   "Address of the fault game factory contract.",
 		EnvVars: prefixEnvVars("GAME_FACTORY_ADDRESS"),
 	}
-	NetworkFlag      = flags.CLINetworkFlag(envVarPrefix, "")
+	L2OutputOracleAddressFlag = &cli.StringFlag{
+		Name: "l2-output-oracle-address",
+		Usage: "Address of the legacy L2OutputOracle contract. If set, its output proposals are monitored " +
+			"together with the dispute games, for chains that are migrating to fault proofs.",
+		EnvVars: prefixEnvVars("L2_OUTPUT_ORACLE_ADDRESS"),
+	}
+	NetworkFlag      = flags.CLINetworkFlag(EnvVarPrefix, "")
 	HonestActorsFlag = &cli.StringSliceFlag{
 		Name:    "honest-actors",
 		Usage:   "List of honest actors that are monitored for any claims that are resolved against them.",
@@ -71,6 +79,11 @@ var (
 		EnvVars: prefixEnvVars("MAX_CONCURRENCY"),
 		Value:   config.DefaultMaxConcurrency,
 	}
+	RPCEnabledFlag = &cli.BoolFlag{
+		Name:    "rpc.enabled",
+		Usage:   "Enable the RPC server, which serves the max resolution times of games in progress.",
+		EnvVars: prefixEnvVars("RPC_ENABLED"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -82,18 +95,22 @@ var requiredFlags = []cli.Flag{
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
 	GameFactoryAddressFlag,
+	L2OutputOracleAddressFlag,
 	NetworkFlag,
 	HonestActorsFlag,
 	MonitorIntervalFlag,
 	GameWindowFlag,
 	IgnoredGamesFlag,
 	MaxConcurrencyFlag,
+	RPCEnabledFlag,
 }
 
 func init() {
-	optionalFlags = append(optionalFlags, oplog.CLIFlags(envVarPrefix)...)
-	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(envVarPrefix)...)
-	optionalFlags = append(optionalFlags, oppprof.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, clock.CLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -120,6 +137,14 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		return nil, err
 	}
 
+	var l2OutputOracleAddress common.Address
+	if ctx.IsSet(L2OutputOracleAddressFlag.Name) {
+		l2OutputOracleAddress, err = opservice.ParseAddress(ctx.String(L2OutputOracleAddressFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid L2OutputOracle address: %w", err)
+		}
+	}
+
 	var actors []common.Address
 	if ctx.IsSet(HonestActorsFlag.Name) {
 		for _, addrStr := range ctx.StringSlice(HonestActorsFlag.Name) {
@@ -149,11 +174,14 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	rpcConfig := oprpc.ReadCLIConfig(ctx)
+	clockConfig := clock.ReadCLIConfig(ctx)
 
 	return &config.Config{
-		L1EthRpc:           ctx.String(L1EthRpcFlag.Name),
-		GameFactoryAddress: gameFactoryAddress,
-		RollupRpc:          ctx.String(RollupRpcFlag.Name),
+		L1EthRpc:              ctx.String(L1EthRpcFlag.Name),
+		GameFactoryAddress:    gameFactoryAddress,
+		L2OutputOracleAddress: l2OutputOracleAddress,
+		RollupRpc:             ctx.String(RollupRpcFlag.Name),
 
 		HonestActors:    actors,
 		MonitorInterval: ctx.Duration(MonitorIntervalFlag.Name),
@@ -163,5 +191,8 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 
 		MetricsConfig: metricsConfig,
 		PprofConfig:   pprofConfig,
+		RPCEnabled:    ctx.Bool(RPCEnabledFlag.Name),
+		RPCConfig:     rpcConfig,
+		ClockConfig:   clockConfig,
 	}, nil
 }
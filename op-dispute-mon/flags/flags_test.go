@@ -45,8 +45,8 @@ func TestCorrectEnvVarPrefix(t *testing.T) {
 		if envVar == "" {
 			t.Errorf("Failed to find EnvVar for flag %v", flag.Names()[0])
 		}
-		if !strings.HasPrefix(envVar, fmt.Sprintf("%s_", envVarPrefix)) {
-			t.Errorf("Flag %v env var (%v) does not start with %s_", flag.Names()[0], envVar, envVarPrefix)
+		if !strings.HasPrefix(envVar, fmt.Sprintf("%s_", EnvVarPrefix)) {
+			t.Errorf("Flag %v env var (%v) does not start with %s_", flag.Names()[0], envVar, EnvVarPrefix)
 		}
 		if strings.Contains(envVar, "__") {
 			t.Errorf("Flag %v env var (%v) has duplicate underscores", flag.Names()[0], envVar)
@@ -75,7 +75,7 @@ func TestEnvVarFormat(t *testing.T) {
 			envFlags := envFlagGetter.GetEnvVars()
 			require.True(t, ok, "must be able to cast the flag to an EnvVar interface")
 			require.Equal(t, 1, len(envFlags), "flags should have exactly one env var")
-			expectedEnvVar := opservice.FlagNameToEnvVarName(flagName, envVarPrefix)
+			expectedEnvVar := opservice.FlagNameToEnvVarName(flagName, EnvVarPrefix)
 			require.Equal(t, expectedEnvVar, envFlags[0])
 		})
 	}
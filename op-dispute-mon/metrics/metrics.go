@@ -10,7 +10,6 @@ import (
 	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/prometheus/client_golang/prometheus"
@@ -51,6 +50,50 @@ const (
 	CreditAboveNonWithdrawable
 )
 
+type BondDiscrepancy uint8
+
+const (
+	// Credits of the game compared to the credits expected from the resolved claims
+	BondOverCredited BondDiscrepancy = iota
+	BondUnderCredited
+
+	// Amounts unlocked in DelayedWETH compared to the credits of the game
+	BondUnexpectedUnlock
+	BondMissingUnlock
+
+	// Balance of the game in DelayedWETH compared to the outstanding bonds and credits of the game
+	BondUnexpectedWithdrawal
+	BondExcessBalance
+)
+
+var BondDiscrepancies = []BondDiscrepancy{
+	BondOverCredited,
+	BondUnderCredited,
+	BondUnexpectedUnlock,
+	BondMissingUnlock,
+	BondUnexpectedWithdrawal,
+	BondExcessBalance,
+}
+
+func (d BondDiscrepancy) String() string {
+	switch d {
+	case BondOverCredited:
+		return "over_credited"
+	case BondUnderCredited:
+		return "under_credited"
+	case BondUnexpectedUnlock:
+		return "unexpected_unlock"
+	case BondMissingUnlock:
+		return "missing_unlock"
+	case BondUnexpectedWithdrawal:
+		return "unexpected_withdrawal"
+	case BondExcessBalance:
+		return "excess_balance"
+	default:
+		return fmt.Sprintf("<invalid: %d>", uint8(d))
+	}
+}
+
 type GameAgreementStatus uint8
 
 const (
@@ -149,6 +192,17 @@ type HonestActorData struct {
 	WonBonds          *big.Int
 }
 
+// BondForecast is the forecast outcome of the unresolved bonds of a game in progress,
+// if the game were resolved with its current claims.
+type BondForecast struct {
+	// AtRisk is the total bond of unresolved honest actor claims that would be lost.
+	AtRisk *big.Int
+	// ExpectedCredits is the total of unresolved bonds that would be credited to honest actors.
+	ExpectedCredits *big.Int
+	// ProposerLoses is true if the root claim was proposed by an honest actor, and would be countered.
+	ProposerLoses bool
+}
+
 type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
@@ -159,6 +213,8 @@ type Metricer interface {
 
 	RecordHonestActorClaims(address common.Address, stats *HonestActorData)
 
+	RecordHonestActorResponseLatency(address common.Address, latency time.Duration)
+
 	RecordGameResolutionStatus(status ResolutionStatus, count int)
 
 	RecordCredit(expectation CreditExpectation, count int)
@@ -181,8 +237,18 @@ type Metricer interface {
 
 	RecordBondCollateral(addr common.Address, required, available *big.Int)
 
+	RecordBondDiscrepancies(discrepancy BondDiscrepancy, count int)
+
 	RecordL2Challenges(agreement bool, count int)
 
+	RecordOutputProposals(source string, agreement bool, count int)
+
+	RecordLatestOutputProposal(source string, agreement bool, timestamp uint64)
+
+	RecordMaxResolutionTime(remaining time.Duration)
+
+	RecordBondForecasts(forecasts map[common.Address]BondForecast)
+
 	caching.Metrics
 	contractMetrics.ContractMetricer
 }
@@ -207,6 +273,8 @@ type Metrics struct {
 	honestActorClaims prometheus.GaugeVec
 	honestActorBonds  prometheus.GaugeVec
 
+	honestActorResponseLatency prometheus.HistogramVec
+
 	withdrawalRequests prometheus.GaugeVec
 
 	info prometheus.GaugeVec
@@ -223,9 +291,17 @@ type Metrics struct {
 	ignoredGames               prometheus.Gauge
 	failedGames                prometheus.Gauge
 	l2Challenges               prometheus.GaugeVec
+	outputProposals            prometheus.GaugeVec
+	latestOutputProposal       prometheus.GaugeVec
+	maxResolutionTime          prometheus.Gauge
+
+	forecastBondsAtRisk        prometheus.GaugeVec
+	forecastExpectedCredits    prometheus.GaugeVec
+	forecastHonestProposerLoss prometheus.Gauge
 
 	requiredCollateral  prometheus.GaugeVec
 	availableCollateral prometheus.GaugeVec
+	bondDiscrepancies   prometheus.GaugeVec
 }
 
 func (m *Metrics) Registry() *prometheus.Registry {
@@ -285,6 +361,14 @@ func NewMetrics() *Metrics {
 			"honest_actor_address",
 			"state",
 		}),
+		honestActorResponseLatency: *factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "honest_actor_response_latency_seconds",
+			Help:      "Time from a claim landing on-chain until the counter claim of an honest actor landed",
+			Buckets:   []float64{12, 30, 60, 120, 300, 600, 1800, 3600, 3 * 3600, 12 * 3600, 24 * 3600, 84 * 3600},
+		}, []string{
+			"honest_actor_address",
+		}),
 		resolutionStatus: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "resolution_status",
@@ -362,6 +446,13 @@ func NewMetrics() *Metrics {
 			"delayedWETH",
 			"balance",
 		}),
+		bondDiscrepancies: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "bond_discrepancies",
+			Help:      "Number of discrepancies found when reconciling the bonds and credits of games with their DelayedWETH contract",
+		}, []string{
+			"discrepancy",
+		}),
 		failedGames: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "failed_games",
@@ -386,6 +477,46 @@ func NewMetrics() *Metrics {
 			// An l2 block number challenge with an agreement means the challenge was invalid.
 			"root_agreement",
 		}),
+		outputProposals: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "output_proposals",
+			Help:      "Number of output proposals in the game window, by source (dispute game or legacy L2OutputOracle)",
+		}, []string{
+			"source",
+			"root_agreement",
+		}),
+		latestOutputProposal: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "latest_output_proposal",
+			Help:      "Timestamp of the most recent output proposal with a valid or invalid root claim in unix seconds, by source",
+		}, []string{
+			"source",
+			"root_agreement",
+		}),
+		maxResolutionTime: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "max_resolution_time_seconds",
+			Help:      "Maximum remaining time until all games in progress are resolvable, given their current claim trees and clocks",
+		}),
+		forecastBondsAtRisk: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "forecast_bonds_at_risk",
+			Help:      "Bonds (ETH) of unresolved honest actor claims that would be lost if the game in progress resolved with its current claims",
+		}, []string{
+			"game",
+		}),
+		forecastExpectedCredits: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "forecast_expected_credits",
+			Help:      "Unresolved bonds (ETH) that would be credited to honest actors if the game in progress resolved with its current claims",
+		}, []string{
+			"game",
+		}),
+		forecastHonestProposerLoss: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "forecast_honest_proposer_losses",
+			Help:      "Number of games in progress proposed by an honest actor, that would be lost if resolved with their current claims",
+		}),
 	}
 }
 
@@ -393,12 +524,12 @@ func (m *Metrics) Start(host string, port int) (*httputil.HTTPServer, error) {
 	return opmetrics.StartServer(m.registry, host, port)
 }
 
-func (m *Metrics) StartBalanceMetrics(
+func (m *Metrics) StartAccountMonitor(
 	l log.Logger,
-	client *ethclient.Client,
+	client opmetrics.AccountClient,
 	account common.Address,
 ) io.Closer {
-	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+	return opmetrics.LaunchAccountMonitor(l, m.registry, m.ns, client, account)
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
@@ -427,6 +558,10 @@ func (m *Metrics) RecordHonestActorClaims(address common.Address, stats *HonestA
 	m.honestActorBonds.WithLabelValues(address.Hex(), "won").Set(weiToEther(stats.WonBonds))
 }
 
+func (m *Metrics) RecordHonestActorResponseLatency(address common.Address, latency time.Duration) {
+	m.honestActorResponseLatency.WithLabelValues(address.Hex()).Observe(latency.Seconds())
+}
+
 func (m *Metrics) RecordGameResolutionStatus(status ResolutionStatus, count int) {
 	asLabels := func(status ResolutionStatus) []string {
 		switch status {
@@ -520,6 +655,10 @@ func (m *Metrics) RecordFailedGames(count int) {
 	m.failedGames.Set(float64(count))
 }
 
+func (m *Metrics) RecordBondDiscrepancies(discrepancy BondDiscrepancy, count int) {
+	m.bondDiscrepancies.WithLabelValues(discrepancy.String()).Set(float64(count))
+}
+
 func (m *Metrics) RecordBondCollateral(addr common.Address, required, available *big.Int) {
 	balanceLabel := "sufficient"
 	zeroBalanceLabel := "insufficient"
@@ -543,6 +682,41 @@ func (m *Metrics) RecordL2Challenges(agreement bool, count int) {
 	m.l2Challenges.WithLabelValues(agree).Set(float64(count))
 }
 
+func (m *Metrics) RecordOutputProposals(source string, agreement bool, count int) {
+	m.outputProposals.WithLabelValues(source, agreementLabel(agreement)).Set(float64(count))
+}
+
+func (m *Metrics) RecordLatestOutputProposal(source string, agreement bool, timestamp uint64) {
+	m.latestOutputProposal.WithLabelValues(source, agreementLabel(agreement)).Set(float64(timestamp))
+}
+
+func agreementLabel(agreement bool) string {
+	if agreement {
+		return "agree"
+	}
+	return "disagree"
+}
+
+func (m *Metrics) RecordMaxResolutionTime(remaining time.Duration) {
+	m.maxResolutionTime.Set(remaining.Seconds())
+}
+
+// RecordBondForecasts records the bond forecast of each game in progress.
+// Games that are no longer in progress, or no longer monitored, are removed from the per game metrics.
+func (m *Metrics) RecordBondForecasts(forecasts map[common.Address]BondForecast) {
+	m.forecastBondsAtRisk.Reset()
+	m.forecastExpectedCredits.Reset()
+	losses := 0
+	for game, forecast := range forecasts {
+		m.forecastBondsAtRisk.WithLabelValues(game.Hex()).Set(weiToEther(forecast.AtRisk))
+		m.forecastExpectedCredits.WithLabelValues(game.Hex()).Set(weiToEther(forecast.ExpectedCredits))
+		if forecast.ProposerLoses {
+			losses++
+		}
+	}
+	m.forecastHonestProposerLoss.Set(float64(losses))
+}
+
 const (
 	inProgress = true
 	correct    = true
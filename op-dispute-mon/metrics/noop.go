@@ -24,6 +24,8 @@ func (*NoopMetricsImpl) CacheGet(_ string, _ bool)        {}
 
 func (*NoopMetricsImpl) RecordHonestActorClaims(_ common.Address, _ *HonestActorData) {}
 
+func (*NoopMetricsImpl) RecordHonestActorResponseLatency(_ common.Address, _ time.Duration) {}
+
 func (*NoopMetricsImpl) RecordGameResolutionStatus(_ ResolutionStatus, _ int) {}
 
 func (*NoopMetricsImpl) RecordCredit(_ CreditExpectation, _ int) {}
@@ -48,4 +50,14 @@ func (*NoopMetricsImpl) RecordFailedGames(_ int) {}
 
 func (*NoopMetricsImpl) RecordBondCollateral(_ common.Address, _, _ *big.Int) {}
 
+func (*NoopMetricsImpl) RecordBondDiscrepancies(_ BondDiscrepancy, _ int) {}
+
 func (*NoopMetricsImpl) RecordL2Challenges(_ bool, _ int) {}
+
+func (*NoopMetricsImpl) RecordOutputProposals(_ string, _ bool, _ int) {}
+
+func (*NoopMetricsImpl) RecordLatestOutputProposal(_ string, _ bool, _ uint64) {}
+
+func (*NoopMetricsImpl) RecordMaxResolutionTime(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordBondForecasts(_ map[common.Address]BondForecast) {}
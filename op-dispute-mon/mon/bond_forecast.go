@@ -0,0 +1,92 @@
+package mon
+
+import (
+	"math/big"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/transform"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type BondForecastMetrics interface {
+	RecordBondForecasts(forecasts map[common.Address]metrics.BondForecast)
+}
+
+// BondForecaster simulates the resolution of games in progress with their current claims, and forecasts
+// which actor each unresolved bond would be credited to. Bonds are attributed to the configured honest actors,
+// to report the bonds they would lose and the credits they would receive if no further moves were made.
+type BondForecaster struct {
+	logger       log.Logger
+	metrics      BondForecastMetrics
+	honestActors types.HonestActors
+}
+
+func NewBondForecaster(logger log.Logger, metrics BondForecastMetrics, honestActors types.HonestActors) *BondForecaster {
+	return &BondForecaster{
+		logger:       logger,
+		metrics:      metrics,
+		honestActors: honestActors,
+	}
+}
+
+func (b *BondForecaster) CheckBondForecasts(games []*types.EnrichedGameData) {
+	forecasts := make(map[common.Address]metrics.BondForecast)
+	for _, game := range games {
+		if game.Status != gameTypes.GameStatusInProgress {
+			continue
+		}
+		forecast := b.forecastGame(game)
+		if forecast.ProposerLoses {
+			b.logger.Warn("Honest proposer forecast to lose the game",
+				"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim,
+				"bondsAtRisk", forecast.AtRisk, "expectedCredits", forecast.ExpectedCredits)
+		} else if forecast.AtRisk.Sign() > 0 {
+			b.logger.Debug("Honest actor bonds at risk in game",
+				"game", game.Proxy, "bondsAtRisk", forecast.AtRisk, "expectedCredits", forecast.ExpectedCredits)
+		}
+		forecasts[game.Proxy] = forecast
+	}
+	b.metrics.RecordBondForecasts(forecasts)
+}
+
+func (b *BondForecaster) forecastGame(game *types.EnrichedGameData) metrics.BondForecast {
+	forecast := metrics.BondForecast{
+		AtRisk:          big.NewInt(0),
+		ExpectedCredits: big.NewInt(0),
+	}
+	// Resolve a copy of the claims, which records the claimant that would counter each claim.
+	tree := transform.CreateBidirectionalTree(game.Claims)
+	status := Resolve(tree)
+	if game.BlockNumberChallenged {
+		status = gameTypes.GameStatusChallengerWon
+	}
+	for i, claim := range tree.Claims {
+		if game.Claims[i].Resolved {
+			// The bonds of resolved claims have already been credited.
+			continue
+		}
+		recipient := claim.Claim.Claimant
+		if i == 0 && game.BlockNumberChallenged {
+			// The root claim bond goes to the challenger of the L2 block number.
+			recipient = game.BlockNumberChallenger
+		} else if claim.Claim.CounteredBy != (common.Address{}) {
+			recipient = claim.Claim.CounteredBy
+		}
+		bond := claim.Claim.Bond
+		if bond == nil {
+			continue
+		}
+		if b.honestActors.Contains(recipient) {
+			forecast.ExpectedCredits = new(big.Int).Add(forecast.ExpectedCredits, bond)
+		} else if b.honestActors.Contains(claim.Claim.Claimant) {
+			forecast.AtRisk = new(big.Int).Add(forecast.AtRisk, bond)
+		}
+	}
+	if len(game.Claims) > 0 && b.honestActors.Contains(game.Claims[0].Claimant) {
+		forecast.ProposerLoses = status == gameTypes.GameStatusChallengerWon
+	}
+	return forecast
+}
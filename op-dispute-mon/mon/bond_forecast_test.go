@@ -0,0 +1,130 @@
+package mon
+
+import (
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	forecastHonest    = common.Address{0x01}
+	forecastDishonest = common.Address{0x02}
+)
+
+func TestBondForecaster(t *testing.T) {
+	t.Run("HonestActorsWin", func(t *testing.T) {
+		game := newForecastGame(common.Address{0xaa},
+			newForecastClaim(0, -1, forecastHonest, 100),
+			newForecastClaim(1, 0, forecastDishonest, 200),
+			newForecastClaim(2, 1, forecastHonest, 300))
+		forecast := checkBondForecast(t, game)
+		require.Equal(t, big.NewInt(0), forecast.AtRisk)
+		require.Equal(t, big.NewInt(600), forecast.ExpectedCredits)
+		require.False(t, forecast.ProposerLoses)
+	})
+
+	t.Run("HonestProposerLoses", func(t *testing.T) {
+		game := newForecastGame(common.Address{0xaa},
+			newForecastClaim(0, -1, forecastHonest, 100),
+			newForecastClaim(1, 0, forecastDishonest, 200))
+		forecast := checkBondForecast(t, game)
+		require.Equal(t, big.NewInt(100), forecast.AtRisk)
+		require.Equal(t, big.NewInt(0), forecast.ExpectedCredits)
+		require.True(t, forecast.ProposerLoses)
+	})
+
+	t.Run("DishonestProposerLoses", func(t *testing.T) {
+		game := newForecastGame(common.Address{0xaa},
+			newForecastClaim(0, -1, forecastDishonest, 100),
+			newForecastClaim(1, 0, forecastHonest, 200))
+		forecast := checkBondForecast(t, game)
+		require.Equal(t, big.NewInt(0), forecast.AtRisk)
+		require.Equal(t, big.NewInt(300), forecast.ExpectedCredits)
+		require.False(t, forecast.ProposerLoses)
+	})
+
+	t.Run("BlockNumberChallenged", func(t *testing.T) {
+		game := newForecastGame(common.Address{0xaa},
+			newForecastClaim(0, -1, forecastHonest, 100),
+			newForecastClaim(1, 0, forecastDishonest, 200),
+			newForecastClaim(2, 1, forecastHonest, 300))
+		game.BlockNumberChallenged = true
+		game.BlockNumberChallenger = forecastDishonest
+		forecast := checkBondForecast(t, game)
+		require.Equal(t, big.NewInt(100), forecast.AtRisk)
+		require.Equal(t, big.NewInt(500), forecast.ExpectedCredits)
+		require.True(t, forecast.ProposerLoses)
+	})
+
+	t.Run("SkipResolvedClaims", func(t *testing.T) {
+		game := newForecastGame(common.Address{0xaa},
+			newForecastClaim(0, -1, forecastHonest, 100),
+			newForecastClaim(1, 0, forecastDishonest, 200),
+			newForecastClaim(2, 1, forecastHonest, 300))
+		game.Claims[2].Resolved = true
+		forecast := checkBondForecast(t, game)
+		require.Equal(t, big.NewInt(300), forecast.ExpectedCredits)
+	})
+
+	t.Run("OnlyGamesInProgress", func(t *testing.T) {
+		stub := &stubBondForecastMetrics{}
+		forecaster := NewBondForecaster(testlog.Logger(t, log.LvlInfo), stub, types.NewHonestActors([]common.Address{forecastHonest}))
+		inProgress := newForecastGame(common.Address{0xaa}, newForecastClaim(0, -1, forecastHonest, 100))
+		completed := newForecastGame(common.Address{0xbb}, newForecastClaim(0, -1, forecastHonest, 100))
+		completed.Status = gameTypes.GameStatusDefenderWon
+		forecaster.CheckBondForecasts([]*types.EnrichedGameData{inProgress, completed})
+		require.Len(t, stub.forecasts, 1)
+		require.Contains(t, stub.forecasts, common.Address{0xaa})
+	})
+}
+
+func checkBondForecast(t *testing.T, game *types.EnrichedGameData) metrics.BondForecast {
+	stub := &stubBondForecastMetrics{}
+	forecaster := NewBondForecaster(testlog.Logger(t, log.LvlInfo), stub, types.NewHonestActors([]common.Address{forecastHonest}))
+	forecaster.CheckBondForecasts([]*types.EnrichedGameData{game})
+	require.Len(t, stub.forecasts, 1)
+	return stub.forecasts[game.Proxy]
+}
+
+func newForecastGame(proxy common.Address, claims ...types.EnrichedClaim) *types.EnrichedGameData {
+	return &types.EnrichedGameData{
+		GameMetadata: gameTypes.GameMetadata{Proxy: proxy},
+		Status:       gameTypes.GameStatusInProgress,
+		Claims:       claims,
+	}
+}
+
+// newForecastClaim creates a claim that attacks its parent, so every claim is at its own depth.
+func newForecastClaim(idx int, parentIdx int, claimant common.Address, bond int64) types.EnrichedClaim {
+	position := faultTypes.RootPosition
+	for i := 0; i < idx; i++ {
+		position = position.Attack()
+	}
+	return types.EnrichedClaim{
+		Claim: faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{
+				Position: position,
+				Bond:     big.NewInt(bond),
+			},
+			Claimant:            claimant,
+			ContractIndex:       idx,
+			ParentContractIndex: parentIdx,
+		},
+	}
+}
+
+type stubBondForecastMetrics struct {
+	forecasts map[common.Address]metrics.BondForecast
+}
+
+func (s *stubBondForecastMetrics) RecordBondForecasts(forecasts map[common.Address]metrics.BondForecast) {
+	s.forecasts = forecasts
+}
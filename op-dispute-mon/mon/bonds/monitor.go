@@ -17,6 +17,7 @@ type RClock interface {
 type BondMetrics interface {
 	RecordCredit(expectation metrics.CreditExpectation, count int)
 	RecordBondCollateral(addr common.Address, required *big.Int, available *big.Int)
+	RecordBondDiscrepancies(discrepancy metrics.BondDiscrepancy, count int)
 }
 
 type Bonds struct {
@@ -43,6 +44,7 @@ func (b *Bonds) CheckBonds(games []*types.EnrichedGameData) {
 	}
 
 	b.checkCredits(games)
+	b.reconcile(games)
 }
 
 func (b *Bonds) checkCredits(games []*types.EnrichedGameData) {
@@ -53,27 +55,7 @@ func (b *Bonds) checkCredits(games []*types.EnrichedGameData) {
 		duration := uint64(b.clock.Now().Unix()) - game.Timestamp
 		maxDurationReached := duration >= game.MaxClockDuration+uint64(game.WETHDelay.Seconds())
 
-		// Iterate over claims, filter out resolved ones and sum up expected credits per recipient
-		expectedCredits := make(map[common.Address]*big.Int)
-		for _, claim := range game.Claims {
-			// Skip unresolved claims since these bonds will not appear in the credits.
-			if !claim.Resolved {
-				continue
-			}
-			// The recipient of a resolved claim is the claimant unless it's been countered.
-			recipient := claim.Claimant
-			if claim.IsRoot() && game.BlockNumberChallenged {
-				// The bond for the root claim is paid to the block number challenger if present
-				recipient = game.BlockNumberChallenger
-			} else if claim.CounteredBy != (common.Address{}) {
-				recipient = claim.CounteredBy
-			}
-			current := expectedCredits[recipient]
-			if current == nil {
-				current = big.NewInt(0)
-			}
-			expectedCredits[recipient] = new(big.Int).Add(current, claim.Bond)
-		}
+		expectedCredits := ExpectedCredits(game)
 
 		allRecipients := make(map[common.Address]bool)
 		for address := range expectedCredits {
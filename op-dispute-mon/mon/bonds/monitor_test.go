@@ -416,16 +416,22 @@ func TestCheckRecipientCredit(t *testing.T) {
 func setupBondMetricsTest(t *testing.T) (*Bonds, *stubBondMetrics, *testlog.CapturingHandler) {
 	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
 	metrics := &stubBondMetrics{
-		credits:  make(map[metrics.CreditExpectation]int),
-		recorded: make(map[common.Address]Collateral),
+		credits:       make(map[metrics.CreditExpectation]int),
+		recorded:      make(map[common.Address]Collateral),
+		discrepancies: make(map[metrics.BondDiscrepancy]int),
 	}
 	bonds := NewBonds(logger, metrics, clock.NewDeterministicClock(frozen))
 	return bonds, metrics, logs
 }
 
 type stubBondMetrics struct {
-	credits  map[metrics.CreditExpectation]int
-	recorded map[common.Address]Collateral
+	credits       map[metrics.CreditExpectation]int
+	recorded      map[common.Address]Collateral
+	discrepancies map[metrics.BondDiscrepancy]int
+}
+
+func (s *stubBondMetrics) RecordBondDiscrepancies(discrepancy metrics.BondDiscrepancy, count int) {
+	s.discrepancies[discrepancy] = count
 }
 
 func (s *stubBondMetrics) RecordBondCollateral(addr common.Address, required *big.Int, available *big.Int) {
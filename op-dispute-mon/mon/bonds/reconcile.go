@@ -0,0 +1,133 @@
+package bonds
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Discrepancy is a mismatch between the expected and actual bond accounting of a game.
+type Discrepancy struct {
+	Type metrics.BondDiscrepancy
+	Game common.Address
+	// Recipient is the address the discrepancy applies to, or the zero address for discrepancies of the whole game.
+	Recipient common.Address
+	Expected  *big.Int
+	Actual    *big.Int
+}
+
+// ExpectedCredits sums the bonds of the resolved claims of a game, keyed by the recipient of the bond.
+func ExpectedCredits(game *monTypes.EnrichedGameData) map[common.Address]*big.Int {
+	expectedCredits := make(map[common.Address]*big.Int)
+	for _, claim := range game.Claims {
+		// Skip unresolved claims since these bonds will not appear in the credits.
+		if !claim.Resolved {
+			continue
+		}
+		// The recipient of a resolved claim is the claimant unless it's been countered.
+		recipient := claim.Claimant
+		if claim.IsRoot() && game.BlockNumberChallenged {
+			// The bond for the root claim is paid to the block number challenger if present
+			recipient = game.BlockNumberChallenger
+		} else if claim.CounteredBy != (common.Address{}) {
+			recipient = claim.CounteredBy
+		}
+		current := expectedCredits[recipient]
+		if current == nil {
+			current = big.NewInt(0)
+		}
+		expectedCredits[recipient] = new(big.Int).Add(current, claim.Bond)
+	}
+	return expectedCredits
+}
+
+// ReconcileGame checks the credits of a game against the credits expected from its resolved claims,
+// and against the unlocks and balance of the game in its DelayedWETH contract.
+//
+// Each credit is unlocked in DelayedWETH when it is paid out, and both are cleared when the credit is claimed,
+// so the unlocked amount must match the credit of each recipient.
+// The balance of the game must match the bonds of the unresolved claims plus the unclaimed credits.
+func ReconcileGame(game *monTypes.EnrichedGameData) []Discrepancy {
+	var discrepancies []Discrepancy
+	add := func(typ metrics.BondDiscrepancy, recipient common.Address, expected *big.Int, actual *big.Int) {
+		discrepancies = append(discrepancies, Discrepancy{
+			Type:      typ,
+			Game:      game.Proxy,
+			Recipient: recipient,
+			Expected:  expected,
+			Actual:    actual,
+		})
+	}
+
+	expectedCredits := ExpectedCredits(game)
+	recipients := make(map[common.Address]bool)
+	for recipient := range expectedCredits {
+		recipients[recipient] = true
+	}
+	for recipient := range game.Credits {
+		recipients[recipient] = true
+	}
+	for recipient := range game.WithdrawalRequests {
+		recipients[recipient] = true
+	}
+	for recipient := range recipients {
+		expected := valueOrZero(expectedCredits[recipient])
+		credit := valueOrZero(game.Credits[recipient])
+		if credit.Cmp(expected) > 0 {
+			add(metrics.BondOverCredited, recipient, expected, credit)
+		} else if credit.Sign() > 0 && credit.Cmp(expected) < 0 {
+			// A credit of zero is expected once the credit has been claimed.
+			add(metrics.BondUnderCredited, recipient, expected, credit)
+		}
+
+		if game.WithdrawalRequests == nil {
+			continue
+		}
+		unlocked := big.NewInt(0)
+		if request := game.WithdrawalRequests[recipient]; request != nil && request.Amount != nil {
+			unlocked = request.Amount
+		}
+		if unlocked.Cmp(credit) > 0 {
+			add(metrics.BondUnexpectedUnlock, recipient, credit, unlocked)
+		} else if unlocked.Cmp(credit) < 0 {
+			add(metrics.BondMissingUnlock, recipient, credit, unlocked)
+		}
+	}
+
+	if game.WETHBalance != nil {
+		required := requiredCollateralForGame(game)
+		if cmp := game.WETHBalance.Cmp(required); cmp < 0 {
+			add(metrics.BondUnexpectedWithdrawal, common.Address{}, required, game.WETHBalance)
+		} else if cmp > 0 {
+			add(metrics.BondExcessBalance, common.Address{}, required, game.WETHBalance)
+		}
+	}
+	return discrepancies
+}
+
+func (b *Bonds) reconcile(games []*monTypes.EnrichedGameData) {
+	counts := make(map[metrics.BondDiscrepancy]int)
+	for _, game := range games {
+		for _, d := range ReconcileGame(game) {
+			counts[d.Type]++
+			switch d.Type {
+			case metrics.BondUnderCredited, metrics.BondExcessBalance:
+				b.logger.Warn("Bond accounting discrepancy", "discrepancy", d.Type, "game", d.Game, "recipient", d.Recipient, "expected", d.Expected, "actual", d.Actual)
+			default:
+				b.logger.Error("Bond accounting discrepancy", "discrepancy", d.Type, "game", d.Game, "recipient", d.Recipient, "expected", d.Expected, "actual", d.Actual)
+			}
+		}
+	}
+	for _, discrepancy := range metrics.BondDiscrepancies {
+		b.metrics.RecordBondDiscrepancies(discrepancy, counts[discrepancy])
+	}
+}
+
+func valueOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+	return v
+}
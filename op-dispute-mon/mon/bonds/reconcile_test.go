@@ -0,0 +1,145 @@
+package bonds
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileGame(t *testing.T) {
+	gameAddr := common.Address{0xaa}
+	honest := common.Address{0x01}
+	dishonest := common.Address{0x02}
+	other := common.Address{0x03}
+
+	// honest counters the root claim of dishonest, and the counter claim of honest is unresolved.
+	makeGame := func() *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			GameMetadata: types.GameMetadata{Proxy: gameAddr},
+			Claims: []monTypes.EnrichedClaim{
+				{
+					Claim: faultTypes.Claim{
+						ClaimData:   faultTypes.ClaimData{Bond: big.NewInt(10), Position: faultTypes.RootPosition},
+						Claimant:    dishonest,
+						CounteredBy: honest,
+					},
+					Resolved: true,
+				},
+				{
+					Claim: faultTypes.Claim{
+						ClaimData: faultTypes.ClaimData{Bond: big.NewInt(5), Position: faultTypes.NewPositionFromGIndex(big.NewInt(2))},
+						Claimant:  honest,
+					},
+				},
+			},
+			Credits: map[common.Address]*big.Int{
+				honest: big.NewInt(10),
+			},
+			WithdrawalRequests: map[common.Address]*contracts.WithdrawalRequest{
+				honest:    {Amount: big.NewInt(10), Timestamp: big.NewInt(1)},
+				dishonest: {Amount: big.NewInt(0), Timestamp: big.NewInt(0)},
+			},
+			WETHBalance: big.NewInt(15),
+		}
+	}
+
+	t.Run("Consistent", func(t *testing.T) {
+		require.Empty(t, ReconcileGame(makeGame()))
+	})
+
+	t.Run("Claimed", func(t *testing.T) {
+		game := makeGame()
+		game.Credits[honest] = big.NewInt(0)
+		game.WithdrawalRequests[honest].Amount = big.NewInt(0)
+		game.WETHBalance = big.NewInt(5)
+		require.Empty(t, ReconcileGame(game))
+	})
+
+	t.Run("NotEnriched", func(t *testing.T) {
+		game := makeGame()
+		game.WithdrawalRequests = nil
+		game.WETHBalance = nil
+		require.Empty(t, ReconcileGame(game))
+	})
+
+	t.Run("OverCredited", func(t *testing.T) {
+		game := makeGame()
+		game.Credits[other] = big.NewInt(3)
+		game.WithdrawalRequests[other] = &contracts.WithdrawalRequest{Amount: big.NewInt(3), Timestamp: big.NewInt(1)}
+		game.WETHBalance = big.NewInt(18)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondOverCredited, Game: gameAddr, Recipient: other, Expected: big.NewInt(0), Actual: big.NewInt(3)},
+		}, ReconcileGame(game))
+	})
+
+	t.Run("UnderCredited", func(t *testing.T) {
+		game := makeGame()
+		game.Credits[honest] = big.NewInt(4)
+		game.WithdrawalRequests[honest].Amount = big.NewInt(4)
+		game.WETHBalance = big.NewInt(9)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondUnderCredited, Game: gameAddr, Recipient: honest, Expected: big.NewInt(10), Actual: big.NewInt(4)},
+		}, ReconcileGame(game))
+	})
+
+	t.Run("UnexpectedUnlock", func(t *testing.T) {
+		game := makeGame()
+		game.WithdrawalRequests[dishonest].Amount = big.NewInt(7)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondUnexpectedUnlock, Game: gameAddr, Recipient: dishonest, Expected: big.NewInt(0), Actual: big.NewInt(7)},
+		}, ReconcileGame(game))
+	})
+
+	t.Run("MissingUnlock", func(t *testing.T) {
+		game := makeGame()
+		game.WithdrawalRequests[honest].Amount = big.NewInt(6)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondMissingUnlock, Game: gameAddr, Recipient: honest, Expected: big.NewInt(10), Actual: big.NewInt(6)},
+		}, ReconcileGame(game))
+	})
+
+	t.Run("UnexpectedWithdrawal", func(t *testing.T) {
+		game := makeGame()
+		game.WETHBalance = big.NewInt(11)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondUnexpectedWithdrawal, Game: gameAddr, Expected: big.NewInt(15), Actual: big.NewInt(11)},
+		}, ReconcileGame(game))
+	})
+
+	t.Run("ExcessBalance", func(t *testing.T) {
+		game := makeGame()
+		game.WETHBalance = big.NewInt(20)
+		require.Equal(t, []Discrepancy{
+			{Type: metrics.BondExcessBalance, Game: gameAddr, Expected: big.NewInt(15), Actual: big.NewInt(20)},
+		}, ReconcileGame(game))
+	})
+}
+
+func TestCheckBondsRecordsDiscrepancies(t *testing.T) {
+	bonds, m, logs := setupBondMetricsTest(t)
+	game := &monTypes.EnrichedGameData{
+		GameMetadata:  types.GameMetadata{Proxy: common.Address{0xaa}},
+		Credits:       map[common.Address]*big.Int{{0x01}: big.NewInt(5)},
+		WETHBalance:   big.NewInt(2),
+		ETHCollateral: big.NewInt(100),
+	}
+	bonds.CheckBonds([]*monTypes.EnrichedGameData{game})
+
+	require.Equal(t, map[metrics.BondDiscrepancy]int{
+		metrics.BondOverCredited:         1,
+		metrics.BondUnderCredited:        0,
+		metrics.BondUnexpectedUnlock:     0,
+		metrics.BondMissingUnlock:        0,
+		metrics.BondUnexpectedWithdrawal: 1,
+		metrics.BondExcessBalance:        0,
+	}, m.discrepancies)
+	require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Bond accounting discrepancy"), testlog.NewAttributesFilter("discrepancy", "unexpected_withdrawal")))
+}
@@ -20,6 +20,7 @@ type RClock interface {
 type ClaimMetrics interface {
 	RecordClaims(statuses *metrics.ClaimStatuses)
 	RecordHonestActorClaims(address common.Address, data *metrics.HonestActorData)
+	RecordHonestActorResponseLatency(address common.Address, latency time.Duration)
 }
 
 type ClaimMonitor struct {
@@ -27,10 +28,20 @@ type ClaimMonitor struct {
 	clock        RClock
 	honestActors types.HonestActors
 	metrics      ClaimMetrics
+
+	// recordedResponses tracks, by game, the claims of which the response latency has been recorded,
+	// so each response is only recorded once while the game is monitored.
+	recordedResponses map[common.Address]map[int]bool
 }
 
 func NewClaimMonitor(logger log.Logger, clock RClock, honestActors types.HonestActors, metrics ClaimMetrics) *ClaimMonitor {
-	return &ClaimMonitor{logger, clock, honestActors, metrics}
+	return &ClaimMonitor{
+		logger:            logger,
+		clock:             clock,
+		honestActors:      honestActors,
+		metrics:           metrics,
+		recordedResponses: make(map[common.Address]map[int]bool),
+	}
 }
 
 func (c *ClaimMonitor) CheckClaims(games []*types.EnrichedGameData) {
@@ -43,9 +54,13 @@ func (c *ClaimMonitor) CheckClaims(games []*types.EnrichedGameData) {
 			WonBonds:     big.NewInt(0),
 		}
 	}
+	recorded := make(map[common.Address]map[int]bool, len(games))
 	for _, game := range games {
 		c.checkGameClaims(game, claimStatuses, honest)
+		recorded[game.Proxy] = c.checkResponseLatencies(game)
 	}
+	// Games that are no longer monitored are dropped
+	c.recordedResponses = recorded
 	c.metrics.RecordClaims(claimStatuses)
 	for actor := range c.honestActors {
 		c.metrics.RecordHonestActorClaims(actor, honest[actor])
@@ -76,6 +91,44 @@ func (c *ClaimMonitor) checkUpdateHonestActorStats(proxy common.Address, claim *
 	}
 }
 
+// checkResponseLatencies records the time honest actors took to counter claims made by other actors,
+// from the claim landing on-chain to the first counter of each honest actor landing.
+// Counters made by a step are not claims and are not included.
+func (c *ClaimMonitor) checkResponseLatencies(game *types.EnrichedGameData) map[int]bool {
+	recorded, ok := c.recordedResponses[game.Proxy]
+	if !ok {
+		recorded = make(map[int]bool)
+	}
+	responded := make(map[int]map[common.Address]bool)
+	for _, claim := range game.Claims {
+		if claim.IsRoot() || !c.honestActors[claim.Claimant] {
+			continue
+		}
+		parent := game.Claims[claim.ParentContractIndex]
+		if c.honestActors[parent.Claimant] {
+			continue
+		}
+		// Only the first counter of each actor is a response, later ones are moves at other positions.
+		if responded[parent.ContractIndex] == nil {
+			responded[parent.ContractIndex] = make(map[common.Address]bool)
+		}
+		if responded[parent.ContractIndex][claim.Claimant] {
+			continue
+		}
+		responded[parent.ContractIndex][claim.Claimant] = true
+		if recorded[claim.ContractIndex] {
+			continue
+		}
+		recorded[claim.ContractIndex] = true
+		latency := claim.Clock.Timestamp.Sub(parent.Clock.Timestamp)
+		if latency < 0 {
+			continue
+		}
+		c.metrics.RecordHonestActorResponseLatency(claim.Claimant, latency)
+	}
+	return recorded
+}
+
 func (c *ClaimMonitor) checkGameClaims(
 	game *types.EnrichedGameData,
 	claimStatuses *metrics.ClaimStatuses,
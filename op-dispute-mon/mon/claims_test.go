@@ -190,6 +190,46 @@ func TestClaimMonitor_CheckClaims(t *testing.T) {
 	})
 }
 
+func TestClaimMonitor_ResponseLatency(t *testing.T) {
+	honest1, honest2, dishonest := common.Address{0x01}, common.Address{0x02}, common.Address{0x03}
+	newClaim := func(idx int, parent int, claimant common.Address, at time.Duration) types.EnrichedClaim {
+		return types.EnrichedClaim{Claim: faultTypes.Claim{
+			ContractIndex:       idx,
+			ParentContractIndex: parent,
+			Claimant:            claimant,
+			ClaimData:           faultTypes.ClaimData{Bond: big.NewInt(1), Position: faultTypes.NewPositionFromGIndex(big.NewInt(int64(idx + 1)))},
+			Clock:               faultTypes.NewClock(0, frozen.Add(at)),
+		}}
+	}
+	game := &types.EnrichedGameData{
+		MaxClockDuration: 3600,
+		GameMetadata:     gameTypes.GameMetadata{Proxy: common.Address{0xaa}, Timestamp: uint64(frozen.Unix())},
+		Claims: []types.EnrichedClaim{
+			newClaim(0, 0, dishonest, 0),
+			newClaim(1, 0, honest1, time.Minute),
+			newClaim(2, 0, honest2, 3*time.Minute),
+			// Second counter of the same actor is not a response
+			newClaim(3, 0, honest1, 4*time.Minute),
+			// Counters of honest claims are not responses
+			newClaim(4, 1, honest2, 5*time.Minute),
+			newClaim(5, 1, dishonest, 6*time.Minute),
+			newClaim(6, 5, honest1, 10*time.Minute),
+		},
+	}
+	game.Claims[0].Position = faultTypes.RootPosition
+
+	monitor, _, cMetrics, _ := newTestClaimMonitor(t)
+	monitor.CheckClaims([]*types.EnrichedGameData{game})
+	require.Equal(t, []time.Duration{time.Minute, 4 * time.Minute}, cMetrics.latencies[honest1])
+	require.Equal(t, []time.Duration{3 * time.Minute}, cMetrics.latencies[honest2])
+
+	// Responses are only recorded once per game
+	game.Claims = append(game.Claims, newClaim(7, 5, honest2, 12*time.Minute))
+	monitor.CheckClaims([]*types.EnrichedGameData{game})
+	require.Equal(t, []time.Duration{time.Minute, 4 * time.Minute}, cMetrics.latencies[honest1])
+	require.Equal(t, []time.Duration{3 * time.Minute, 6 * time.Minute}, cMetrics.latencies[honest2])
+}
+
 func newTestClaimMonitor(t *testing.T) (*ClaimMonitor, *clock.DeterministicClock, *stubClaimMetrics, *testlog.CapturingHandler) {
 	logger, handler := testlog.CaptureLogger(t, log.LvlInfo)
 	cl := clock.NewDeterministicClock(frozen)
@@ -203,8 +243,9 @@ func newTestClaimMonitor(t *testing.T) (*ClaimMonitor, *clock.DeterministicClock
 }
 
 type stubClaimMetrics struct {
-	calls  map[metrics.ClaimStatus]int
-	honest map[common.Address]metrics.HonestActorData
+	calls     map[metrics.ClaimStatus]int
+	honest    map[common.Address]metrics.HonestActorData
+	latencies map[common.Address][]time.Duration
 }
 
 func (s *stubClaimMetrics) RecordClaims(statuses *metrics.ClaimStatuses) {
@@ -223,6 +264,13 @@ func (s *stubClaimMetrics) RecordHonestActorClaims(address common.Address, data
 	s.honest[address] = *data
 }
 
+func (s *stubClaimMetrics) RecordHonestActorResponseLatency(address common.Address, latency time.Duration) {
+	if s.latencies == nil {
+		s.latencies = make(map[common.Address][]time.Duration)
+	}
+	s.latencies[address] = append(s.latencies[address], latency)
+}
+
 func makeMultipleTestGames(duration uint64) []*types.EnrichedGameData {
 	return []*types.EnrichedGameData{
 		makeTestGame(duration),      // first half
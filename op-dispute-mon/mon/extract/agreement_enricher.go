@@ -39,33 +39,41 @@ func NewAgreementEnricher(logger log.Logger, metrics OutputMetrics, client Outpu
 
 // Enrich validates the specified root claim against the output at the given block number.
 func (o *AgreementEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
-	output, err := o.client.OutputAtBlock(ctx, game.L2BlockNumber)
+	agree, expected, err := o.CheckOutput(ctx, game.L2BlockNumber, game.L1HeadNum, game.RootClaim)
+	if err != nil {
+		return err
+	}
+	game.AgreeWithClaim = agree
+	game.ExpectedRootClaim = expected
+	return nil
+}
+
+// CheckOutput validates the root claim against the output at the L2 block number, and returns whether it agrees
+// with the root claim and the expected root claim. The expected root claim is zero if the output doesn't exist.
+// A matching root claim is only agreed with if the L2 block was safe at the L1 head.
+func (o *AgreementEnricher) CheckOutput(ctx context.Context, l2BlockNum uint64, l1HeadNum uint64, rootClaim common.Hash) (bool, common.Hash, error) {
+	output, err := o.client.OutputAtBlock(ctx, l2BlockNum)
 	if err != nil {
 		// string match as the error comes from the remote server so we can't use Errors.Is sadly.
 		if strings.Contains(err.Error(), "not found") {
 			// Output root doesn't exist, so we must disagree with it.
-			game.AgreeWithClaim = false
-			return nil
+			return false, common.Hash{}, nil
 		}
-		return fmt.Errorf("failed to get output at block: %w", err)
+		return false, common.Hash{}, fmt.Errorf("failed to get output at block: %w", err)
 	}
 	o.metrics.RecordOutputFetchTime(float64(time.Now().Unix()))
-	game.ExpectedRootClaim = common.Hash(output.OutputRoot)
-	rootMatches := game.RootClaim == game.ExpectedRootClaim
-	if !rootMatches {
-		game.AgreeWithClaim = false
-		return nil
+	expected := common.Hash(output.OutputRoot)
+	if rootClaim != expected {
+		return false, expected, nil
 	}
 
 	// If the root matches, also check that l2 block is safe at the L1 head
-	safeHead, err := o.client.SafeHeadAtL1Block(ctx, game.L1HeadNum)
+	safeHead, err := o.client.SafeHeadAtL1Block(ctx, l1HeadNum)
 	if err != nil {
-		o.log.Warn("Unable to verify proposed block was safe", "l1HeadNum", game.L1HeadNum, "l2BlockNum", game.L2BlockNumber, "err", err)
+		o.log.Warn("Unable to verify proposed block was safe", "l1HeadNum", l1HeadNum, "l2BlockNum", l2BlockNum, "err", err)
 		// If safe head data isn't available, assume the output root was safe
 		// Avoids making the dispute mon dependent on safe head db being available
-		game.AgreeWithClaim = true
-		return nil
+		return true, expected, nil
 	}
-	game.AgreeWithClaim = safeHead.SafeHead.Number >= game.L2BlockNumber
-	return nil
+	return safeHead.SafeHead.Number >= l2BlockNum, expected, nil
 }
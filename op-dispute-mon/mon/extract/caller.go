@@ -3,6 +3,7 @@ package extract
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
@@ -25,11 +26,13 @@ type GameCallerMetrics interface {
 
 type GameCaller interface {
 	GetWithdrawals(context.Context, rpcblock.Block, ...common.Address) ([]*contracts.WithdrawalRequest, error)
+	GetWETHBalance(context.Context, rpcblock.Block) (*big.Int, error)
 	GetGameMetadata(context.Context, rpcblock.Block) (contracts.GameMetadata, error)
 	GetAllClaims(context.Context, rpcblock.Block) ([]faultTypes.Claim, error)
 	BondCaller
 	BalanceCaller
 	ClaimCaller
+	ClockCaller
 }
 
 type GameCallerCreator struct {
@@ -0,0 +1,59 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*ClockEnricher)(nil)
+
+type ClockCaller interface {
+	GetMaxGameDepth(ctx context.Context) (faultTypes.Depth, error)
+	GetSplitDepth(ctx context.Context) (faultTypes.Depth, error)
+	GetClockExtension(ctx context.Context) (time.Duration, error)
+	GetOracleChallengePeriod(ctx context.Context) (time.Duration, error)
+}
+
+// ClockEnricher adds the game tree depths and clock parameters required to bound the resolution time of games in progress.
+type ClockEnricher struct{}
+
+func NewClockEnricher() *ClockEnricher {
+	return &ClockEnricher{}
+}
+
+func (e *ClockEnricher) Enrich(ctx context.Context, _ rpcblock.Block, caller GameCaller, game *types.EnrichedGameData) error {
+	if game.Status != gameTypes.GameStatusInProgress {
+		return nil
+	}
+	maxDepth, err := caller.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve max game depth: %w", err)
+	}
+	splitDepth, err := caller.GetSplitDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve split depth: %w", err)
+	}
+	extension, err := caller.GetClockExtension(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve clock extension: %w", err)
+	}
+	// The oracle challenge period only applies when clocks are extended.
+	var challengePeriod time.Duration
+	if extension > 0 {
+		challengePeriod, err = caller.GetOracleChallengePeriod(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve oracle challenge period: %w", err)
+		}
+	}
+	game.MaxGameDepth = maxDepth
+	game.SplitDepth = splitDepth
+	game.ClockExtension = extension
+	game.OracleChallengePeriod = challengePeriod
+	return nil
+}
@@ -0,0 +1,60 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockEnricher(t *testing.T) {
+	newCaller := func() *mockGameCaller {
+		return &mockGameCaller{
+			maxGameDepth:          73,
+			splitDepth:            30,
+			clockExtension:        3 * time.Hour,
+			oracleChallengePeriod: 24 * time.Hour,
+		}
+	}
+
+	t.Run("SkipCompletedGames", func(t *testing.T) {
+		caller := newCaller()
+		caller.clockErr = errors.New("should not be called")
+		game := &types.EnrichedGameData{}
+		game.Status = gameTypes.GameStatusDefenderWon
+		require.NoError(t, NewClockEnricher().Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.MaxGameDepth)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		caller := newCaller()
+		caller.clockErr = errors.New("nope")
+		game := &types.EnrichedGameData{}
+		err := NewClockEnricher().Enrich(context.Background(), rpcblock.Latest, caller, game)
+		require.ErrorIs(t, err, caller.clockErr)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		caller := newCaller()
+		game := &types.EnrichedGameData{}
+		require.NoError(t, NewClockEnricher().Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.EqualValues(t, 73, game.MaxGameDepth)
+		require.EqualValues(t, 30, game.SplitDepth)
+		require.Equal(t, 3*time.Hour, game.ClockExtension)
+		require.Equal(t, 24*time.Hour, game.OracleChallengePeriod)
+	})
+
+	t.Run("NoClockExtension", func(t *testing.T) {
+		caller := newCaller()
+		caller.clockExtension = 0
+		game := &types.EnrichedGameData{}
+		require.NoError(t, NewClockEnricher().Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.ClockExtension)
+		require.Zero(t, game.OracleChallengePeriod)
+	})
+}
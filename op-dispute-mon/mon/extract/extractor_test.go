@@ -232,8 +232,25 @@ type mockGameCaller struct {
 	withdrawalsCalls int
 	withdrawalsErr   error
 	withdrawals      []*contracts.WithdrawalRequest
+	wethBalanceCalls int
+	wethBalanceErr   error
+	wethBalance      *big.Int
 	resolvedErr      error
 	resolved         map[int]bool
+
+	clockErr              error
+	maxGameDepth          faultTypes.Depth
+	splitDepth            faultTypes.Depth
+	clockExtension        time.Duration
+	oracleChallengePeriod time.Duration
+}
+
+func (m *mockGameCaller) GetWETHBalance(_ context.Context, _ rpcblock.Block) (*big.Int, error) {
+	m.wethBalanceCalls++
+	if m.wethBalanceErr != nil {
+		return nil, m.wethBalanceErr
+	}
+	return m.wethBalance, nil
 }
 
 func (m *mockGameCaller) GetWithdrawals(_ context.Context, _ rpcblock.Block, _ ...common.Address) ([]*contracts.WithdrawalRequest, error) {
@@ -310,6 +327,22 @@ func (m *mockGameCaller) IsResolved(_ context.Context, _ rpcblock.Block, claims
 	return resolved, nil
 }
 
+func (m *mockGameCaller) GetMaxGameDepth(_ context.Context) (faultTypes.Depth, error) {
+	return m.maxGameDepth, m.clockErr
+}
+
+func (m *mockGameCaller) GetSplitDepth(_ context.Context) (faultTypes.Depth, error) {
+	return m.splitDepth, m.clockErr
+}
+
+func (m *mockGameCaller) GetClockExtension(_ context.Context) (time.Duration, error) {
+	return m.clockExtension, m.clockErr
+}
+
+func (m *mockGameCaller) GetOracleChallengePeriod(_ context.Context) (time.Duration, error) {
+	return m.oracleChallengePeriod, m.clockErr
+}
+
 type mockEnricher struct {
 	err   error
 	calls int
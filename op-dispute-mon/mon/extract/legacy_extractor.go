@@ -0,0 +1,64 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type OutputOracle interface {
+	Addr() common.Address
+	GetOutputsAtOrAfter(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]contracts.OutputProposal, error)
+}
+
+type OutputValidator interface {
+	CheckOutput(ctx context.Context, l2BlockNum uint64, l1HeadNum uint64, rootClaim common.Hash) (bool, common.Hash, error)
+}
+
+// LegacyExtractor extracts the output proposals of the legacy L2OutputOracle, and validates them
+// in the same way as the root claims of dispute games.
+type LegacyExtractor struct {
+	logger    log.Logger
+	oracle    OutputOracle
+	validator OutputValidator
+}
+
+func NewLegacyExtractor(logger log.Logger, oracle OutputOracle, validator OutputValidator) *LegacyExtractor {
+	return &LegacyExtractor{
+		logger:    logger,
+		oracle:    oracle,
+		validator: validator,
+	}
+}
+
+// Extract returns the validated output proposals made to the L2OutputOracle at or after minTimestamp,
+// as of the L1 block with the given hash and number.
+func (e *LegacyExtractor) Extract(ctx context.Context, blockHash common.Hash, blockNumber uint64, minTimestamp uint64) ([]*monTypes.OutputProposal, error) {
+	outputs, err := e.oracle.GetOutputsAtOrAfter(ctx, blockHash, minTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load legacy output proposals: %w", err)
+	}
+	proposals := make([]*monTypes.OutputProposal, 0, len(outputs))
+	for _, output := range outputs {
+		// Unlike games, outputs have no L1 head, so validate them against the current L1 block.
+		agree, expected, err := e.validator.CheckOutput(ctx, output.L2BlockNumber, blockNumber, output.OutputRoot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate legacy output proposal %v: %w", output.Index, err)
+		}
+		proposals = append(proposals, &monTypes.OutputProposal{
+			Source:            monTypes.ProposalSourceL2OO,
+			Address:           e.oracle.Addr(),
+			Index:             output.Index,
+			L2BlockNumber:     output.L2BlockNumber,
+			RootClaim:         output.OutputRoot,
+			Timestamp:         output.Timestamp,
+			AgreeWithClaim:    agree,
+			ExpectedRootClaim: expected,
+		})
+	}
+	return proposals, nil
+}
@@ -0,0 +1,106 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	legacyOracleAddr = common.Address{0x0a}
+	legacyBlockHash  = common.Hash{0xbb}
+)
+
+func TestLegacyExtractor(t *testing.T) {
+	t.Run("FetchError", func(t *testing.T) {
+		oracle := &stubOutputOracle{err: errors.New("boom")}
+		extractor := NewLegacyExtractor(testlog.Logger(t, log.LevelInfo), oracle, &stubOutputValidator{})
+		_, err := extractor.Extract(context.Background(), legacyBlockHash, 100, 6)
+		require.ErrorIs(t, err, oracle.err)
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		oracle := &stubOutputOracle{outputs: []contracts.OutputProposal{{Index: 1, L2BlockNumber: 10}}}
+		validator := &stubOutputValidator{err: errors.New("boom")}
+		extractor := NewLegacyExtractor(testlog.Logger(t, log.LevelInfo), oracle, validator)
+		_, err := extractor.Extract(context.Background(), legacyBlockHash, 100, 6)
+		require.ErrorIs(t, err, validator.err)
+	})
+
+	t.Run("ValidatesProposals", func(t *testing.T) {
+		oracle := &stubOutputOracle{outputs: []contracts.OutputProposal{
+			{Index: 4, OutputRoot: common.Hash{0x01}, Timestamp: 7, L2BlockNumber: 10},
+			{Index: 5, OutputRoot: common.Hash{0x02}, Timestamp: 8, L2BlockNumber: 20},
+		}}
+		validator := &stubOutputValidator{
+			expected: map[uint64]common.Hash{10: {0x01}, 20: {0x03}},
+		}
+		extractor := NewLegacyExtractor(testlog.Logger(t, log.LevelInfo), oracle, validator)
+		proposals, err := extractor.Extract(context.Background(), legacyBlockHash, 100, 6)
+		require.NoError(t, err)
+		require.Equal(t, legacyBlockHash, oracle.blockHash)
+		require.Equal(t, uint64(6), oracle.earliestTimestamp)
+		require.Equal(t, []uint64{100, 100}, validator.l1HeadNums)
+		require.Equal(t, []*monTypes.OutputProposal{
+			{
+				Source:            monTypes.ProposalSourceL2OO,
+				Address:           legacyOracleAddr,
+				Index:             4,
+				L2BlockNumber:     10,
+				RootClaim:         common.Hash{0x01},
+				Timestamp:         7,
+				AgreeWithClaim:    true,
+				ExpectedRootClaim: common.Hash{0x01},
+			},
+			{
+				Source:            monTypes.ProposalSourceL2OO,
+				Address:           legacyOracleAddr,
+				Index:             5,
+				L2BlockNumber:     20,
+				RootClaim:         common.Hash{0x02},
+				Timestamp:         8,
+				AgreeWithClaim:    false,
+				ExpectedRootClaim: common.Hash{0x03},
+			},
+		}, proposals)
+	})
+}
+
+type stubOutputOracle struct {
+	outputs           []contracts.OutputProposal
+	err               error
+	blockHash         common.Hash
+	earliestTimestamp uint64
+}
+
+func (s *stubOutputOracle) Addr() common.Address {
+	return legacyOracleAddr
+}
+
+func (s *stubOutputOracle) GetOutputsAtOrAfter(_ context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]contracts.OutputProposal, error) {
+	s.blockHash = blockHash
+	s.earliestTimestamp = earliestTimestamp
+	return s.outputs, s.err
+}
+
+type stubOutputValidator struct {
+	expected   map[uint64]common.Hash
+	err        error
+	l1HeadNums []uint64
+}
+
+func (s *stubOutputValidator) CheckOutput(_ context.Context, l2BlockNum uint64, l1HeadNum uint64, rootClaim common.Hash) (bool, common.Hash, error) {
+	s.l1HeadNums = append(s.l1HeadNums, l1HeadNum)
+	if s.err != nil {
+		return false, common.Hash{}, s.err
+	}
+	expected := s.expected[l2BlockNum]
+	return rootClaim == expected, expected, nil
+}
@@ -37,5 +37,10 @@ func (w *WithdrawalsEnricher) Enrich(ctx context.Context, block rpcblock.Block,
 	for i, recipient := range recipients {
 		game.WithdrawalRequests[recipient] = withdrawals[i]
 	}
+	balance, err := caller.GetWETHBalance(ctx, block)
+	if err != nil {
+		return fmt.Errorf("failed to fetch WETH balance: %w", err)
+	}
+	game.WETHBalance = balance
 	return nil
 }
@@ -73,9 +73,18 @@ func TestWithdrawalsEnricher(t *testing.T) {
 	t.Run("GetWithdrawalsSuccess", func(t *testing.T) {
 		game := makeGame()
 		enricher := NewWithdrawalsEnricher()
-		caller := &mockGameCaller{}
+		caller := &mockGameCaller{wethBalance: big.NewInt(12)}
 		err := enricher.Enrich(context.Background(), rpcblock.Latest, caller, game)
 		require.NoError(t, err)
 		require.Equal(t, 2, len(game.WithdrawalRequests))
+		require.Equal(t, big.NewInt(12), game.WETHBalance)
+	})
+
+	t.Run("GetWETHBalanceFails", func(t *testing.T) {
+		enricher := NewWithdrawalsEnricher()
+		caller := &mockGameCaller{wethBalanceErr: errors.New("nope")}
+		game := makeGame()
+		err := enricher.Enrich(context.Background(), rpcblock.Latest, caller, game)
+		require.ErrorIs(t, err, caller.wethBalanceErr)
 	})
 }
@@ -17,6 +17,7 @@ type ForecastResolution func(games []*types.EnrichedGameData, ignoredCount, fail
 type Bonds func(games []*types.EnrichedGameData)
 type Resolutions func(games []*types.EnrichedGameData)
 type Monitor func(games []*types.EnrichedGameData)
+type Proposals func(ctx context.Context, blockHash common.Hash, blockNumber uint64, minTimestamp uint64, games []*types.EnrichedGameData)
 type BlockHashFetcher func(ctx context.Context, number *big.Int) (common.Hash, error)
 type BlockNumberFetcher func(ctx context.Context) (uint64, error)
 type Extract func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error)
@@ -43,6 +44,9 @@ type gameMonitor struct {
 	claims           Monitor
 	withdrawals      Monitor
 	l2Challenges     Monitor
+	resolutionTimes  Monitor
+	bondForecasts    Monitor
+	proposals        Proposals
 	extract          Extract
 	fetchBlockHash   BlockHashFetcher
 	fetchBlockNumber BlockNumberFetcher
@@ -61,6 +65,9 @@ func newGameMonitor(
 	claims Monitor,
 	withdrawals Monitor,
 	l2Challenges Monitor,
+	resolutionTimes Monitor,
+	bondForecasts Monitor,
+	proposals Proposals,
 	extract Extract,
 	fetchBlockNumber BlockNumberFetcher,
 	fetchBlockHash BlockHashFetcher,
@@ -79,6 +86,9 @@ func newGameMonitor(
 		claims:           claims,
 		withdrawals:      withdrawals,
 		l2Challenges:     l2Challenges,
+		resolutionTimes:  resolutionTimes,
+		bondForecasts:    bondForecasts,
+		proposals:        proposals,
 		extract:          extract,
 		fetchBlockNumber: fetchBlockNumber,
 		fetchBlockHash:   fetchBlockHash,
@@ -107,6 +117,9 @@ func (m *gameMonitor) monitorGames() error {
 	m.claims(enrichedGames)
 	m.withdrawals(enrichedGames)
 	m.l2Challenges(enrichedGames)
+	m.resolutionTimes(enrichedGames)
+	m.bondForecasts(enrichedGames)
+	m.proposals(m.ctx, blockHash, blockNumber, minGameTimestamp, enrichedGames)
 	timeTaken := m.clock.Since(start)
 	m.metrics.RecordMonitorDuration(timeTaken)
 	m.logger.Info("Completed monitoring update", "blockNumber", blockNumber, "blockHash", blockHash, "duration", timeTaken, "games", len(enrichedGames), "ignored", ignored, "failed", failed)
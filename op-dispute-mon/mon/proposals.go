@@ -0,0 +1,77 @@
+package mon
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type LegacyExtract func(ctx context.Context, blockHash common.Hash, blockNumber uint64, minTimestamp uint64) ([]*types.OutputProposal, error)
+
+type ProposalMetrics interface {
+	RecordOutputProposals(source string, agreement bool, count int)
+	RecordLatestOutputProposal(source string, agreement bool, timestamp uint64)
+}
+
+// ProposalMonitor reports the output proposals of dispute games together with the proposals of the
+// legacy L2OutputOracle, so chains migrating to fault proofs have a unified view of all output claims.
+type ProposalMonitor struct {
+	logger        log.Logger
+	metrics       ProposalMetrics
+	legacyExtract LegacyExtract
+}
+
+// NewProposalMonitor creates a ProposalMonitor. The legacy extract function is optional,
+// only game proposals are reported if it is nil.
+func NewProposalMonitor(logger log.Logger, metrics ProposalMetrics, legacyExtract LegacyExtract) *ProposalMonitor {
+	return &ProposalMonitor{
+		logger:        logger,
+		metrics:       metrics,
+		legacyExtract: legacyExtract,
+	}
+}
+
+func (m *ProposalMonitor) CheckProposals(ctx context.Context, blockHash common.Hash, blockNumber uint64, minTimestamp uint64, games []*types.EnrichedGameData) {
+	proposals := make([]*types.OutputProposal, 0, len(games))
+	for _, game := range games {
+		proposals = append(proposals, types.GameOutputProposal(game))
+	}
+	m.recordProposals(types.ProposalSourceGame, proposals)
+	if m.legacyExtract == nil {
+		return
+	}
+
+	legacy, err := m.legacyExtract(ctx, blockHash, blockNumber, minTimestamp)
+	if err != nil {
+		m.logger.Error("Failed to load legacy output proposals", "err", err)
+		return
+	}
+	for _, proposal := range legacy {
+		if !proposal.AgreeWithClaim {
+			m.logger.Error("Found legacy output proposal with invalid output root", "oracle", proposal.Address,
+				"index", proposal.Index, "l2BlockNum", proposal.L2BlockNumber, "rootClaim", proposal.RootClaim,
+				"expected", proposal.ExpectedRootClaim)
+		}
+	}
+	m.recordProposals(types.ProposalSourceL2OO, legacy)
+}
+
+func (m *ProposalMonitor) recordProposals(source types.ProposalSource, proposals []*types.OutputProposal) {
+	var agreeCount, disagreeCount int
+	var latestAgree, latestDisagree uint64
+	for _, proposal := range proposals {
+		if proposal.AgreeWithClaim {
+			agreeCount++
+			latestAgree = max(latestAgree, proposal.Timestamp)
+		} else {
+			disagreeCount++
+			latestDisagree = max(latestDisagree, proposal.Timestamp)
+		}
+	}
+	m.metrics.RecordOutputProposals(string(source), true, agreeCount)
+	m.metrics.RecordOutputProposals(string(source), false, disagreeCount)
+	m.metrics.RecordLatestOutputProposal(string(source), true, latestAgree)
+	m.metrics.RecordLatestOutputProposal(string(source), false, latestDisagree)
+}
@@ -0,0 +1,96 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckProposals(t *testing.T) {
+	games := []*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x01}, Timestamp: 10}, AgreeWithClaim: true},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x02}, Timestamp: 30}, AgreeWithClaim: true},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x03}, Timestamp: 20}, AgreeWithClaim: false},
+	}
+	legacy := []*types.OutputProposal{
+		{Source: types.ProposalSourceL2OO, Index: 1, Timestamp: 5, AgreeWithClaim: true},
+		{Source: types.ProposalSourceL2OO, Index: 2, Timestamp: 8, L2BlockNumber: 22, RootClaim: common.Hash{0xaa}, ExpectedRootClaim: common.Hash{0xbb}},
+	}
+
+	t.Run("GamesOnly", func(t *testing.T) {
+		metrics := &stubProposalMetrics{}
+		monitor := NewProposalMonitor(testlog.Logger(t, log.LevelInfo), metrics, nil)
+		monitor.CheckProposals(context.Background(), common.Hash{0xcc}, 100, 1, games)
+		require.Equal(t, map[string]int{"game-true": 2, "game-false": 1}, metrics.counts)
+		require.Equal(t, map[string]uint64{"game-true": 30, "game-false": 20}, metrics.latest)
+	})
+
+	t.Run("WithLegacy", func(t *testing.T) {
+		metrics := &stubProposalMetrics{}
+		logger, logs := testlog.CaptureLogger(t, log.LevelInfo)
+		var calledHash common.Hash
+		var calledNumber, calledTimestamp uint64
+		legacyExtract := func(_ context.Context, blockHash common.Hash, blockNumber uint64, minTimestamp uint64) ([]*types.OutputProposal, error) {
+			calledHash, calledNumber, calledTimestamp = blockHash, blockNumber, minTimestamp
+			return legacy, nil
+		}
+		monitor := NewProposalMonitor(logger, metrics, legacyExtract)
+		monitor.CheckProposals(context.Background(), common.Hash{0xcc}, 100, 1, games)
+		require.Equal(t, common.Hash{0xcc}, calledHash)
+		require.Equal(t, uint64(100), calledNumber)
+		require.Equal(t, uint64(1), calledTimestamp)
+		require.Equal(t, map[string]int{"game-true": 2, "game-false": 1, "l2oo-true": 1, "l2oo-false": 1}, metrics.counts)
+		require.Equal(t, map[string]uint64{"game-true": 30, "game-false": 20, "l2oo-true": 5, "l2oo-false": 8}, metrics.latest)
+
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Found legacy output proposal with invalid output root"))
+		require.NotNil(t, l)
+		require.Equal(t, uint64(2), l.AttrValue("index"))
+		require.Equal(t, uint64(22), l.AttrValue("l2BlockNum"))
+		require.Equal(t, common.Hash{0xbb}, l.AttrValue("expected"))
+	})
+
+	t.Run("LegacyError", func(t *testing.T) {
+		metrics := &stubProposalMetrics{}
+		logger, logs := testlog.CaptureLogger(t, log.LevelInfo)
+		legacyExtract := func(_ context.Context, _ common.Hash, _ uint64, _ uint64) ([]*types.OutputProposal, error) {
+			return nil, errors.New("boom")
+		}
+		monitor := NewProposalMonitor(logger, metrics, legacyExtract)
+		monitor.CheckProposals(context.Background(), common.Hash{0xcc}, 100, 1, games)
+		require.Equal(t, map[string]int{"game-true": 2, "game-false": 1}, metrics.counts)
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Failed to load legacy output proposals")))
+	})
+}
+
+type stubProposalMetrics struct {
+	counts map[string]int
+	latest map[string]uint64
+}
+
+func (s *stubProposalMetrics) key(source string, agreement bool) string {
+	if agreement {
+		return source + "-true"
+	}
+	return source + "-false"
+}
+
+func (s *stubProposalMetrics) RecordOutputProposals(source string, agreement bool, count int) {
+	if s.counts == nil {
+		s.counts = make(map[string]int)
+	}
+	s.counts[s.key(source, agreement)] = count
+}
+
+func (s *stubProposalMetrics) RecordLatestOutputProposal(source string, agreement bool, timestamp uint64) {
+	if s.latest == nil {
+		s.latest = make(map[string]uint64)
+	}
+	s.latest[s.key(source, agreement)] = timestamp
+}
@@ -0,0 +1,208 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var ErrInvalidReplayRange = errors.New("invalid replay range")
+
+// Alerts reported by the Replayer. The names match the conditions used by the standard
+// dispute-mon alerting rules so that replay output can be compared against past incidents.
+const (
+	AlertUnexpectedResult   = "unexpected_result"
+	AlertUnexpectedForecast = "unexpected_forecast"
+	AlertIgnoredGames       = "ignored_games"
+	AlertFailedGames        = "failed_games"
+	AlertStaleValidProposal = "stale_valid_proposal"
+)
+
+type HeaderFetcher func(ctx context.Context, number *big.Int) (*gethtypes.Header, error)
+
+// ReplayThresholds are the tunable alert thresholds evaluated at each replayed block.
+type ReplayThresholds struct {
+	// MaxIgnoredGames is the maximum number of ignored games before alerting.
+	MaxIgnoredGames int
+	// MaxFailedGames is the maximum number of games that failed to load before alerting.
+	MaxFailedGames int
+	// MaxValidProposalAge is the maximum age of the latest valid proposal before alerting.
+	// Zero disables the check.
+	MaxValidProposalAge time.Duration
+}
+
+// ReplayResult records the monitor state and alerts that would have fired at a single L1 block.
+type ReplayResult struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+	Timestamp   uint64      `json:"timestamp"`
+
+	Games   int `json:"games"`
+	Ignored int `json:"ignored"`
+	Failed  int `json:"failed"`
+
+	AgreeDefenderAhead      int `json:"agreeDefenderAhead"`
+	DisagreeDefenderAhead   int `json:"disagreeDefenderAhead"`
+	AgreeChallengerAhead    int `json:"agreeChallengerAhead"`
+	DisagreeChallengerAhead int `json:"disagreeChallengerAhead"`
+	AgreeDefenderWins       int `json:"agreeDefenderWins"`
+	DisagreeDefenderWins    int `json:"disagreeDefenderWins"`
+	AgreeChallengerWins     int `json:"agreeChallengerWins"`
+	DisagreeChallengerWins  int `json:"disagreeChallengerWins"`
+
+	LatestValidProposal        uint64 `json:"latestValidProposal"`
+	LatestInvalidProposal      uint64 `json:"latestInvalidProposal"`
+	LatestValidProposalL2Block uint64 `json:"latestValidProposalL2Block"`
+
+	Alerts []string `json:"alerts,omitempty"`
+}
+
+// Replayer runs the game extraction and forecasting logic against historical L1 blocks
+// and reports the alerts that would have fired, allowing alert thresholds to be backtested.
+type Replayer struct {
+	logger      log.Logger
+	extract     Extract
+	fetchHeader HeaderFetcher
+	gameWindow  time.Duration
+	thresholds  ReplayThresholds
+}
+
+func newReplayer(logger log.Logger, extract Extract, fetchHeader HeaderFetcher, gameWindow time.Duration, thresholds ReplayThresholds) *Replayer {
+	return &Replayer{
+		logger:      logger,
+		extract:     extract,
+		fetchHeader: fetchHeader,
+		gameWindow:  gameWindow,
+		thresholds:  thresholds,
+	}
+}
+
+// Replay evaluates every step-th L1 block from start to end inclusive, passing each result to out.
+func (r *Replayer) Replay(ctx context.Context, start, end, step uint64, out func(result *ReplayResult) error) error {
+	if step == 0 || end < start {
+		return fmt.Errorf("%w: start %v, end %v, step %v", ErrInvalidReplayRange, start, end, step)
+	}
+	for num := start; num <= end; num += step {
+		result, err := r.replayBlock(ctx, num)
+		if err != nil {
+			return err
+		}
+		if err := out(result); err != nil {
+			return fmt.Errorf("failed to output result for block %v: %w", num, err)
+		}
+		if num+step < num { // Overflow
+			break
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) replayBlock(ctx context.Context, num uint64) (*ReplayResult, error) {
+	header, err := r.fetchHeader(ctx, new(big.Int).SetUint64(num))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header %v: %w", num, err)
+	}
+	minGameTimestamp := uint64(0)
+	if window := uint64(r.gameWindow.Seconds()); header.Time > window {
+		minGameTimestamp = header.Time - window
+	}
+	games, ignored, failed, err := r.extract(ctx, header.Hash(), minGameTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load games at block %v: %w", num, err)
+	}
+	m := &replayMetrics{}
+	NewForecast(r.logger, m).Forecast(games, ignored, failed)
+
+	result := m.toResult(header, games)
+	result.Alerts = r.evaluateAlerts(result)
+	r.logger.Info("Replayed block", "blockNumber", num, "games", len(games), "alerts", result.Alerts)
+	return result, nil
+}
+
+func (r *Replayer) evaluateAlerts(result *ReplayResult) []string {
+	var alerts []string
+	if result.AgreeChallengerWins > 0 || result.DisagreeDefenderWins > 0 {
+		alerts = append(alerts, AlertUnexpectedResult)
+	}
+	if result.AgreeChallengerAhead > 0 || result.DisagreeDefenderAhead > 0 {
+		alerts = append(alerts, AlertUnexpectedForecast)
+	}
+	if result.Ignored > r.thresholds.MaxIgnoredGames {
+		alerts = append(alerts, AlertIgnoredGames)
+	}
+	if result.Failed > r.thresholds.MaxFailedGames {
+		alerts = append(alerts, AlertFailedGames)
+	}
+	if maxAge := uint64(r.thresholds.MaxValidProposalAge.Seconds()); maxAge > 0 && result.Timestamp > maxAge {
+		if result.LatestValidProposal < result.Timestamp-maxAge {
+			alerts = append(alerts, AlertStaleValidProposal)
+		}
+	}
+	return alerts
+}
+
+// replayMetrics captures the values the forecast would record so they can be reported per block.
+type replayMetrics struct {
+	agreement                  [metrics.DisagreeChallengerWins + 1]int
+	latestValidProposalL2Block uint64
+	latestValid                uint64
+	latestInvalid              uint64
+	ignored                    int
+	failed                     int
+}
+
+var _ ForecastMetrics = (*replayMetrics)(nil)
+
+func (m *replayMetrics) RecordGameAgreement(status metrics.GameAgreementStatus, count int) {
+	m.agreement[status] = count
+}
+
+func (m *replayMetrics) RecordLatestValidProposalL2Block(validL2Block uint64) {
+	m.latestValidProposalL2Block = validL2Block
+}
+
+func (m *replayMetrics) RecordLatestProposals(validTimestamp, invalidTimestamp uint64) {
+	m.latestValid = validTimestamp
+	m.latestInvalid = invalidTimestamp
+}
+
+func (m *replayMetrics) RecordIgnoredGames(count int) {
+	m.ignored = count
+}
+
+func (m *replayMetrics) RecordFailedGames(count int) {
+	m.failed = count
+}
+
+func (m *replayMetrics) toResult(header *gethtypes.Header, games []*types.EnrichedGameData) *ReplayResult {
+	return &ReplayResult{
+		BlockNumber: header.Number.Uint64(),
+		BlockHash:   header.Hash(),
+		Timestamp:   header.Time,
+
+		Games:   len(games),
+		Ignored: m.ignored,
+		Failed:  m.failed,
+
+		AgreeDefenderAhead:      m.agreement[metrics.AgreeDefenderAhead],
+		DisagreeDefenderAhead:   m.agreement[metrics.DisagreeDefenderAhead],
+		AgreeChallengerAhead:    m.agreement[metrics.AgreeChallengerAhead],
+		DisagreeChallengerAhead: m.agreement[metrics.DisagreeChallengerAhead],
+		AgreeDefenderWins:       m.agreement[metrics.AgreeDefenderWins],
+		DisagreeDefenderWins:    m.agreement[metrics.DisagreeDefenderWins],
+		AgreeChallengerWins:     m.agreement[metrics.AgreeChallengerWins],
+		DisagreeChallengerWins:  m.agreement[metrics.DisagreeChallengerWins],
+
+		LatestValidProposal:        m.latestValid,
+		LatestInvalidProposal:      m.latestInvalid,
+		LatestValidProposalL2Block: m.latestValidProposalL2Block,
+	}
+}
@@ -0,0 +1,114 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayer_InvalidRange(t *testing.T) {
+	replayer, _, _ := setupReplayerTest(t, ReplayThresholds{})
+	noop := func(_ *ReplayResult) error { return nil }
+	require.ErrorIs(t, replayer.Replay(context.Background(), 10, 9, 1, noop), ErrInvalidReplayRange)
+	require.ErrorIs(t, replayer.Replay(context.Background(), 10, 20, 0, noop), ErrInvalidReplayRange)
+}
+
+func TestReplayer_ReplaysEachStep(t *testing.T) {
+	replayer, extractor, minTimestamps := setupReplayerTest(t, ReplayThresholds{})
+	var blocks []uint64
+	err := replayer.Replay(context.Background(), 100, 110, 5, func(result *ReplayResult) error {
+		blocks = append(blocks, result.BlockNumber)
+		require.Equal(t, result.BlockNumber*12, result.Timestamp)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{100, 105, 110}, blocks)
+	require.Equal(t, 3, extractor.calls)
+	require.Equal(t, []uint64{100*12 - 60, 105*12 - 60, 110*12 - 60}, *minTimestamps)
+}
+
+func TestReplayer_ExtractError(t *testing.T) {
+	replayer, extractor, _ := setupReplayerTest(t, ReplayThresholds{})
+	extractor.fetchErr = errors.New("boom")
+	err := replayer.Replay(context.Background(), 1, 2, 1, func(_ *ReplayResult) error { return nil })
+	require.ErrorIs(t, err, extractor.fetchErr)
+}
+
+func TestReplayer_Alerts(t *testing.T) {
+	run := func(t *testing.T, replayer *Replayer) *ReplayResult {
+		var result *ReplayResult
+		err := replayer.Replay(context.Background(), 100, 100, 1, func(r *ReplayResult) error {
+			result = r
+			return nil
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("NoAlerts", func(t *testing.T) {
+		replayer, extractor, _ := setupReplayerTest(t, ReplayThresholds{})
+		extractor.games = []*monTypes.EnrichedGameData{
+			{Status: types.GameStatusDefenderWon, AgreeWithClaim: true},
+		}
+		result := run(t, replayer)
+		require.Empty(t, result.Alerts)
+		require.Equal(t, 1, result.AgreeDefenderWins)
+		require.Equal(t, 1, result.Games)
+	})
+
+	t.Run("UnexpectedResult", func(t *testing.T) {
+		replayer, extractor, _ := setupReplayerTest(t, ReplayThresholds{})
+		extractor.games = []*monTypes.EnrichedGameData{
+			{Status: types.GameStatusDefenderWon, AgreeWithClaim: false},
+		}
+		result := run(t, replayer)
+		require.Equal(t, []string{AlertUnexpectedResult}, result.Alerts)
+		require.Equal(t, 1, result.DisagreeDefenderWins)
+	})
+
+	t.Run("IgnoredAndFailedThresholds", func(t *testing.T) {
+		replayer, extractor, _ := setupReplayerTest(t, ReplayThresholds{MaxIgnoredGames: 1, MaxFailedGames: 2})
+		extractor.ignoredCount = 1
+		extractor.failedCount = 2
+		require.Empty(t, run(t, replayer).Alerts)
+
+		extractor.ignoredCount = 2
+		extractor.failedCount = 3
+		require.Equal(t, []string{AlertIgnoredGames, AlertFailedGames}, run(t, replayer).Alerts)
+	})
+
+	t.Run("StaleValidProposal", func(t *testing.T) {
+		replayer, extractor, _ := setupReplayerTest(t, ReplayThresholds{MaxValidProposalAge: 100 * time.Second})
+		extractor.games = []*monTypes.EnrichedGameData{
+			{GameMetadata: types.GameMetadata{Timestamp: 100*12 - 100}, Status: types.GameStatusDefenderWon, AgreeWithClaim: true},
+		}
+		require.Empty(t, run(t, replayer).Alerts)
+
+		extractor.games[0].Timestamp = 100*12 - 101
+		require.Equal(t, []string{AlertStaleValidProposal}, run(t, replayer).Alerts)
+	})
+}
+
+func setupReplayerTest(t *testing.T, thresholds ReplayThresholds) (*Replayer, *mockExtractor, *[]uint64) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	extractor := &mockExtractor{}
+	var minTimestamps []uint64
+	extract := func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*monTypes.EnrichedGameData, int, int, error) {
+		minTimestamps = append(minTimestamps, minTimestamp)
+		return extractor.Extract(ctx, blockHash, minTimestamp)
+	}
+	fetchHeader := func(_ context.Context, number *big.Int) (*gethtypes.Header, error) {
+		return &gethtypes.Header{Number: number, Time: number.Uint64() * 12}, nil
+	}
+	return newReplayer(logger, extract, fetchHeader, time.Minute, thresholds), extractor, &minTimestamps
+}
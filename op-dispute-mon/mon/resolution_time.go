@@ -0,0 +1,113 @@
+package mon
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ResolutionTimeMetrics interface {
+	RecordMaxResolutionTime(remaining time.Duration)
+}
+
+// ResolutionTimeMonitor bounds the time until games in progress can be resolved, given the current shape
+// of their claim trees and chess clocks. The bound assumes each unresolved claim is countered at the last
+// possible moment, and every move after that is made as late as possible, down to the max game depth.
+type ResolutionTimeMonitor struct {
+	logger  log.Logger
+	clock   RClock
+	metrics ResolutionTimeMetrics
+
+	mu    sync.Mutex
+	times []types.ResolutionTime
+}
+
+func NewResolutionTimeMonitor(logger log.Logger, clock RClock, metrics ResolutionTimeMetrics) *ResolutionTimeMonitor {
+	return &ResolutionTimeMonitor{
+		logger:  logger,
+		clock:   clock,
+		metrics: metrics,
+	}
+}
+
+func (m *ResolutionTimeMonitor) CheckResolutionTimes(games []*types.EnrichedGameData) {
+	now := m.clock.Now()
+	times := make([]types.ResolutionTime, 0, len(games))
+	var maxRemaining time.Duration
+	for _, game := range games {
+		if game.Status != gameTypes.GameStatusInProgress {
+			continue
+		}
+		remaining := maxResolutionDelay(game, now)
+		times = append(times, types.ResolutionTime{
+			Game:         game.Proxy,
+			Timestamp:    uint64(now.Add(remaining).Unix()),
+			MaxRemaining: remaining,
+		})
+		maxRemaining = max(maxRemaining, remaining)
+	}
+	m.logger.Debug("Computed max resolution times", "games", len(times), "maxRemaining", maxRemaining)
+	m.metrics.RecordMaxResolutionTime(maxRemaining)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.times = times
+}
+
+// ResolutionTimes returns the max resolution times of the games in progress, as of the last check.
+func (m *ResolutionTimeMonitor) ResolutionTimes() []types.ResolutionTime {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.times)
+}
+
+// maxResolutionDelay returns the maximum remaining time until all claims of the game have expired clocks.
+func maxResolutionDelay(game *types.EnrichedGameData, now time.Time) time.Duration {
+	maxClock := time.Duration(game.MaxClockDuration) * time.Second
+	var latest time.Duration
+	for _, claim := range game.Claims {
+		if claim.Resolved {
+			continue
+		}
+		// The opposing team can counter the claim until their accumulated clock, which is the clock
+		// of the parent claim, plus the time elapsed since the claim was made, reaches the max duration.
+		var opponentDuration time.Duration
+		if !claim.IsRoot() {
+			opponentDuration = game.Claims[claim.ParentContractIndex].Clock.Duration
+		}
+		counterDeadline := maxClock - opponentDuration - now.Sub(claim.Clock.Timestamp)
+		if counterDeadline <= 0 {
+			continue
+		}
+		delay := counterDeadline
+		if depth := claim.Depth(); depth < game.MaxGameDepth {
+			// The claimant's team can respond to the counter with the rest of its own clock.
+			delay += maxClock - claim.Clock.Duration
+			// Each later move is limited by the clock extension granted to the claims below.
+			for d := depth + 1; d < game.MaxGameDepth; d++ {
+				delay += clockExtension(game, d)
+			}
+		}
+		latest = max(latest, delay)
+	}
+	return latest
+}
+
+// clockExtension returns the minimum time left on the opposing team's clock after a move to the given depth.
+func clockExtension(game *types.EnrichedGameData, depth faultTypes.Depth) time.Duration {
+	switch {
+	case game.ClockExtension == 0:
+		return 0
+	case depth == game.MaxGameDepth-1:
+		return game.ClockExtension + game.OracleChallengePeriod
+	case depth == game.SplitDepth-1:
+		return 2 * game.ClockExtension
+	default:
+		return game.ClockExtension
+	}
+}
@@ -0,0 +1,118 @@
+package mon
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var frozenNow = time.Unix(10_000, 0)
+
+func TestResolutionTimeMonitor(t *testing.T) {
+	metrics := &stubResolutionTimeMetrics{}
+	cl := clock.NewDeterministicClock(frozenNow)
+	monitor := NewResolutionTimeMonitor(testlog.Logger(t, log.LvlInfo), cl, metrics)
+
+	inProgress := newClockedGame(common.Address{0xaa}, 10*time.Second)
+	inProgress.Claims = []types.EnrichedClaim{
+		newClockedClaim(0, 0, -1, 0, 20*time.Second),
+		newClockedClaim(1, 1, 0, 20*time.Second, 5*time.Second),
+	}
+	expired := newClockedGame(common.Address{0xbb}, 10*time.Second)
+	expired.Claims = []types.EnrichedClaim{newClockedClaim(0, 0, -1, 0, 200*time.Second)}
+	completed := newClockedGame(common.Address{0xcc}, 10*time.Second)
+	completed.Status = gameTypes.GameStatusChallengerWon
+
+	monitor.CheckResolutionTimes([]*types.EnrichedGameData{inProgress, expired, completed})
+	require.Equal(t, 250*time.Second, metrics.maxResolutionTime)
+	require.Equal(t, []types.ResolutionTime{
+		{Game: common.Address{0xaa}, Timestamp: uint64(frozenNow.Unix()) + 250, MaxRemaining: 250 * time.Second},
+		{Game: common.Address{0xbb}, Timestamp: uint64(frozenNow.Unix()), MaxRemaining: 0},
+	}, monitor.ResolutionTimes())
+}
+
+func TestMaxResolutionDelay(t *testing.T) {
+	t.Run("ClockExtensions", func(t *testing.T) {
+		game := newClockedGame(common.Address{}, 10*time.Second)
+		game.Claims = []types.EnrichedClaim{
+			// Countered by 80s, then 100s for the root team, then the extensions of depths 1 to 3: 20s + 10s + 40s.
+			newClockedClaim(0, 0, -1, 0, 20*time.Second),
+			// Countered by 95s, then 80s for the claimant's team, then the extensions of depths 2 and 3: 10s + 40s.
+			newClockedClaim(1, 1, 0, 20*time.Second, 5*time.Second),
+		}
+		require.Equal(t, 250*time.Second, maxResolutionDelay(game, frozenNow))
+	})
+
+	t.Run("NoClockExtension", func(t *testing.T) {
+		game := newClockedGame(common.Address{}, 0)
+		game.Claims = []types.EnrichedClaim{
+			newClockedClaim(0, 0, -1, 0, 20*time.Second),
+			newClockedClaim(1, 1, 0, 20*time.Second, 5*time.Second),
+		}
+		require.Equal(t, 180*time.Second, maxResolutionDelay(game, frozenNow))
+	})
+
+	t.Run("MaxDepthClaim", func(t *testing.T) {
+		game := newClockedGame(common.Address{}, 10*time.Second)
+		game.Claims = []types.EnrichedClaim{
+			newClockedClaim(0, 0, -1, 0, 300*time.Second),
+			newClockedClaim(1, 3, 0, 90*time.Second, 250*time.Second),
+			newClockedClaim(2, 4, 1, 95*time.Second, 2*time.Second),
+		}
+		game.Claims[0].Resolved = true
+		// Only a step can counter the claim at max depth, within the 10s left on its opponent's clock.
+		require.Equal(t, 8*time.Second, maxResolutionDelay(game, frozenNow))
+	})
+
+	t.Run("AllClocksExpired", func(t *testing.T) {
+		game := newClockedGame(common.Address{}, 10*time.Second)
+		game.Claims = []types.EnrichedClaim{
+			newClockedClaim(0, 0, -1, 0, 200*time.Second),
+			newClockedClaim(1, 1, 0, 50*time.Second, 101*time.Second),
+		}
+		require.Zero(t, maxResolutionDelay(game, frozenNow))
+	})
+}
+
+// newClockedGame creates a game in progress with a max clock duration of 100s, max depth 4 and split depth 2.
+func newClockedGame(proxy common.Address, extension time.Duration) *types.EnrichedGameData {
+	return &types.EnrichedGameData{
+		GameMetadata:          gameTypes.GameMetadata{Proxy: proxy},
+		Status:                gameTypes.GameStatusInProgress,
+		MaxClockDuration:      100,
+		MaxGameDepth:          4,
+		SplitDepth:            2,
+		ClockExtension:        extension,
+		OracleChallengePeriod: 3 * extension,
+	}
+}
+
+func newClockedClaim(idx int, depth faultTypes.Depth, parentIdx int, duration time.Duration, age time.Duration) types.EnrichedClaim {
+	return types.EnrichedClaim{
+		Claim: faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{
+				Position: faultTypes.NewPosition(depth, big.NewInt(0)),
+			},
+			Clock:               faultTypes.NewClock(duration, frozenNow.Add(-age)),
+			ContractIndex:       idx,
+			ParentContractIndex: parentIdx,
+		},
+	}
+}
+
+type stubResolutionTimeMetrics struct {
+	maxResolutionTime time.Duration
+}
+
+func (s *stubResolutionTimeMetrics) RecordMaxResolutionTime(remaining time.Duration) {
+	s.maxResolutionTime = remaining
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+type ResolutionTimes interface {
+	ResolutionTimes() []types.ResolutionTime
+}
+
+type disputeMonAPI struct {
+	resolutionTimes ResolutionTimes
+}
+
+func NewDisputeMonAPI(resolutionTimes ResolutionTimes) *disputeMonAPI {
+	return &disputeMonAPI{
+		resolutionTimes: resolutionTimes,
+	}
+}
+
+func GetDisputeMonAPI(api *disputeMonAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "disputemon",
+		Service:   api,
+	}
+}
+
+// MaxResolutionTimes returns the latest time each game in progress can become resolvable,
+// as of the last monitoring update.
+func (a *disputeMonAPI) MaxResolutionTimes(_ context.Context) ([]types.ResolutionTime, error) {
+	return a.resolutionTimes.ResolutionTimes(), nil
+}
@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+type stubResolutionTimes []types.ResolutionTime
+
+func (s stubResolutionTimes) ResolutionTimes() []types.ResolutionTime {
+	return s
+}
+
+func TestMaxResolutionTimes(t *testing.T) {
+	times := stubResolutionTimes{
+		{Game: common.Address{0xaa}, Timestamp: 1234, MaxRemaining: time.Hour},
+		{Game: common.Address{0xbb}, Timestamp: 5678, MaxRemaining: 0},
+	}
+	server := gethrpc.NewServer()
+	api := GetDisputeMonAPI(NewDisputeMonAPI(times))
+	require.NoError(t, server.RegisterName(api.Namespace, api.Service))
+	client := gethrpc.DialInProc(server)
+	defer client.Close()
+
+	var result []types.ResolutionTime
+	require.NoError(t, client.CallContext(context.Background(), &result, "disputemon_maxResolutionTimes"))
+	require.Equal(t, []types.ResolutionTime(times), result)
+}
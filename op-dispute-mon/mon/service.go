@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/rpc"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/version"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
@@ -24,6 +25,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 )
@@ -38,19 +40,23 @@ type Service struct {
 
 	cl clock.Clock
 
-	extractor    *extract.Extractor
-	forecast     *Forecast
-	bonds        *bonds.Bonds
-	game         *extract.GameCallerCreator
-	resolutions  *ResolutionMonitor
-	claims       *ClaimMonitor
-	withdrawals  *WithdrawalMonitor
-	rollupClient *sources.RollupClient
+	extractor       *extract.Extractor
+	forecast        *Forecast
+	bonds           *bonds.Bonds
+	game            *extract.GameCallerCreator
+	resolutions     *ResolutionMonitor
+	claims          *ClaimMonitor
+	withdrawals     *WithdrawalMonitor
+	resolutionTimes *ResolutionTimeMonitor
+	bondForecasts   *BondForecaster
+	proposals       *ProposalMonitor
+	rollupClient    *sources.RollupClient
 
 	l1Client *ethclient.Client
 
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
+	rpcServer    *oprpc.Server
 
 	stopped atomic.Bool
 }
@@ -58,7 +64,7 @@ type Service struct {
 // NewService creates a new Service.
 func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Service, error) {
 	s := &Service{
-		cl:           clock.SystemClock,
+		cl:           cfg.ClockConfig.Clock(),
 		logger:       logger,
 		metrics:      metrics.NewMetrics(),
 		honestActors: types.NewHonestActors(cfg.HonestActors),
@@ -71,6 +77,39 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	return s, nil
 }
 
+// NewReplayer creates a Replayer backed by the same game extraction pipeline as the monitor service.
+// The returned close function releases the underlying RPC clients.
+func NewReplayer(ctx context.Context, logger log.Logger, cfg *config.Config, thresholds ReplayThresholds) (*Replayer, func(), error) {
+	s := &Service{
+		cl:      cfg.ClockConfig.Clock(),
+		logger:  logger,
+		metrics: metrics.NoopMetrics,
+	}
+	closeClients := func() {
+		if s.rollupClient != nil {
+			s.rollupClient.Close()
+		}
+		if s.l1Client != nil {
+			s.l1Client.Close()
+		}
+	}
+	if err := s.initL1Client(ctx, cfg); err != nil {
+		closeClients()
+		return nil, nil, fmt.Errorf("failed to init l1 client: %w", err)
+	}
+	if err := s.initFactoryContract(cfg); err != nil {
+		closeClients()
+		return nil, nil, fmt.Errorf("failed to create factory contract bindings: %w", err)
+	}
+	if err := s.initOutputRollupClient(ctx, cfg); err != nil {
+		closeClients()
+		return nil, nil, fmt.Errorf("failed to init rollup client: %w", err)
+	}
+	s.initGameCallerCreator()
+	s.initExtractor(cfg)
+	return newReplayer(logger, s.extractor.Extract, s.l1Client.HeaderByNumber, cfg.GameWindow, thresholds), closeClients, nil
+}
+
 func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error {
 	if err := s.initL1Client(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init l1 client: %w", err)
@@ -91,6 +130,8 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	s.initClaimMonitor(cfg)
 	s.initResolutionMonitor()
 	s.initWithdrawalMonitor()
+	s.initResolutionTimeMonitor()
+	s.initBondForecaster()
 
 	s.initGameCallerCreator() // Must be called before initForecast
 
@@ -98,9 +139,14 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 
 	s.initForecast(cfg)
 	s.initBonds()
+	s.initProposalMonitor(cfg)
 
 	s.initMonitor(ctx, cfg) // Monitor must be initialized last
 
+	if err := s.initRPCServer(cfg); err != nil {
+		return fmt.Errorf("failed to start RPC server: %w", err)
+	}
+
 	s.metrics.RecordInfo(version.SimpleWithMeta)
 	s.metrics.RecordUp()
 
@@ -119,6 +165,14 @@ func (s *Service) initWithdrawalMonitor() {
 	s.withdrawals = NewWithdrawalMonitor(s.logger, s.cl, s.metrics, s.honestActors)
 }
 
+func (s *Service) initResolutionTimeMonitor() {
+	s.resolutionTimes = NewResolutionTimeMonitor(s.logger, s.cl, s.metrics)
+}
+
+func (s *Service) initBondForecaster() {
+	s.bondForecasts = NewBondForecaster(s.logger, s.metrics, s.honestActors)
+}
+
 func (s *Service) initGameCallerCreator() {
 	s.game = extract.NewGameCallerCreator(s.metrics, batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
 }
@@ -135,11 +189,23 @@ func (s *Service) initExtractor(cfg *config.Config) {
 		extract.NewWithdrawalsEnricher(),
 		extract.NewBondEnricher(),
 		extract.NewBalanceEnricher(),
+		extract.NewClockEnricher(),
 		extract.NewL1HeadBlockNumEnricher(s.l1Client),
 		extract.NewAgreementEnricher(s.logger, s.metrics, s.rollupClient),
 	)
 }
 
+func (s *Service) initProposalMonitor(cfg *config.Config) {
+	var legacyExtract LegacyExtract
+	if cfg.L2OutputOracleAddress != (common.Address{}) {
+		oracle := contracts.NewL2OutputOracleContract(s.metrics, cfg.L2OutputOracleAddress,
+			batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+		extractor := extract.NewLegacyExtractor(s.logger, oracle, extract.NewAgreementEnricher(s.logger, s.metrics, s.rollupClient))
+		legacyExtract = extractor.Extract
+	}
+	s.proposals = NewProposalMonitor(s.logger, s.metrics, legacyExtract)
+}
+
 func (s *Service) initForecast(cfg *config.Config) {
 	s.forecast = NewForecast(s.logger, s.metrics)
 }
@@ -175,6 +241,7 @@ func (s *Service) initPProf(cfg *oppprof.CLIConfig) error {
 		cfg.ProfileDir,
 		cfg.ProfileFilename,
 	)
+	s.pprofService.EnableUpload(cfg.Upload, "op-dispute-mon", version.SimpleWithMeta)
 
 	if err := s.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
@@ -201,6 +268,26 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	return nil
 }
 
+func (s *Service) initRPCServer(cfg *config.Config) error {
+	if !cfg.RPCEnabled {
+		return nil
+	}
+	server := oprpc.NewServer(
+		cfg.RPCConfig.ListenAddr,
+		cfg.RPCConfig.ListenPort,
+		version.SimpleWithMeta,
+		oprpc.WithLogger(s.logger),
+	)
+	server.AddAPI(rpc.GetDisputeMonAPI(rpc.NewDisputeMonAPI(s.resolutionTimes)))
+	s.logger.Info("Starting JSON-RPC server")
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("unable to start RPC server: %w", err)
+	}
+	s.logger.Info("Started JSON-RPC server", "endpoint", server.Endpoint())
+	s.rpcServer = server
+	return nil
+}
+
 func (s *Service) initFactoryContract(cfg *config.Config) error {
 	factoryContract := contracts.NewDisputeGameFactoryContract(s.metrics, cfg.GameFactoryAddress,
 		batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
@@ -230,6 +317,9 @@ func (s *Service) initMonitor(ctx context.Context, cfg *config.Config) {
 		s.claims.CheckClaims,
 		s.withdrawals.CheckWithdrawals,
 		l2ChallengesMonitor.CheckL2Challenges,
+		s.resolutionTimes.CheckResolutionTimes,
+		s.bondForecasts.CheckBondForecasts,
+		s.proposals.CheckProposals,
 		s.extractor.Extract,
 		s.l1Client.BlockNumber,
 		blockHashFetcher,
@@ -262,6 +352,11 @@ func (s *Service) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to close metrics server: %w", err))
 		}
 	}
+	if s.rpcServer != nil {
+		if err := s.rpcServer.Stop(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop RPC server: %w", err))
+		}
+	}
 	s.stopped.Store(true)
 	s.logger.Info("stopped dispute mon service", "err", result)
 	return result
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProposalSource identifies where an output proposal was made.
+type ProposalSource string
+
+const (
+	// ProposalSourceGame is a proposal made by creating a dispute game.
+	ProposalSourceGame ProposalSource = "game"
+	// ProposalSourceL2OO is a proposal made to the legacy L2OutputOracle, before the chain migrated to fault proofs.
+	ProposalSourceL2OO ProposalSource = "l2oo"
+)
+
+// OutputProposal is an output root claim, either the root claim of a dispute game or an output
+// proposed to the legacy L2OutputOracle. It allows chains that are migrating to fault proofs
+// to monitor all output claims in a unified view.
+type OutputProposal struct {
+	Source ProposalSource
+	// Address is the address of the game proxy, or of the L2OutputOracle.
+	Address common.Address
+	// Index is the index of the proposal in the L2OutputOracle. Unused for games.
+	Index         uint64
+	L2BlockNumber uint64
+	RootClaim     common.Hash
+	Timestamp     uint64
+
+	AgreeWithClaim    bool
+	ExpectedRootClaim common.Hash
+}
+
+// GameOutputProposal returns the output proposal made by a game.
+func GameOutputProposal(game *EnrichedGameData) *OutputProposal {
+	return &OutputProposal{
+		Source:            ProposalSourceGame,
+		Address:           game.Proxy,
+		L2BlockNumber:     game.L2BlockNumber,
+		RootClaim:         game.RootClaim,
+		Timestamp:         game.Timestamp,
+		AgreeWithClaim:    game.AgreeWithClaim,
+		ExpectedRootClaim: game.ExpectedRootClaim,
+	}
+}
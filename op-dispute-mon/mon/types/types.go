@@ -44,6 +44,10 @@ type EnrichedGameData struct {
 	// The contract is potentially shared by multiple games.
 	WETHContract common.Address
 
+	// WETHBalance is the balance of the game in the WETHContract.
+	// It backs the bonds of unresolved claims and the unclaimed credits of the game.
+	WETHBalance *big.Int
+
 	// WETHDelay is the delay applied before credits can be withdrawn.
 	WETHDelay time.Duration
 
@@ -51,6 +55,18 @@ type EnrichedGameData struct {
 	// This ETH balance will be used to pay out any bonds required by the games
 	// that use the same DelayedWETH contract.
 	ETHCollateral *big.Int
+
+	// MaxGameDepth and SplitDepth are the depths of the game tree.
+	// Only set for games in progress, together with the clock parameters below.
+	MaxGameDepth faultTypes.Depth
+	SplitDepth   faultTypes.Depth
+
+	// ClockExtension is the minimum time left on the opposing team's clock after a move.
+	ClockExtension time.Duration
+
+	// OracleChallengePeriod is the challenge period for large preimages,
+	// which is added to the clock extension of moves before an execution step.
+	OracleChallengePeriod time.Duration
 }
 
 // BidirectionalTree is a tree of claims represented as a flat list of claims.
@@ -63,3 +79,13 @@ type BidirectionalClaim struct {
 	Claim    *faultTypes.Claim
 	Children []*BidirectionalClaim
 }
+
+// ResolutionTime is the latest time a game in progress can become resolvable,
+// assuming every remaining move is made as late as the chess clocks allow.
+type ResolutionTime struct {
+	Game common.Address `json:"game"`
+	// Timestamp is the unix timestamp, in seconds, by which the game is guaranteed to be resolvable.
+	Timestamp uint64 `json:"timestamp"`
+	// MaxRemaining is the maximum remaining time until the game is resolvable.
+	MaxRemaining time.Duration `json:"maxRemaining"`
+}
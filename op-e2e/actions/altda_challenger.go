@@ -0,0 +1,153 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-alt-da/bindings"
+)
+
+// trackedCommitment is an alt-DA commitment submitted to L1, as seen by the AltDAChallenger.
+type trackedCommitment struct {
+	comm        []byte // encoded commitment, as submitted to L1
+	blockNumber uint64 // L1 block the commitment was included in
+}
+
+// AltDAChallenger is an actor that challenges alt-DA commitments of which the input is unavailable,
+// and resolves challenges of which the input is available.
+// Transactions are sent to the L1 mempool, and have to be included by the L1 miner:
+// the L1 miner can include them with ActL1IncludeTx(challenger.Address()).
+type AltDAChallenger struct {
+	log      log.Logger
+	l1       *ethclient.Client
+	contract *bindings.DataAvailabilityChallenge
+	server   *AltDAServer
+	privKey  *ecdsa.PrivateKey
+	chainID  *big.Int
+
+	tracked []trackedCommitment
+}
+
+func NewAltDAChallenger(t Testing, log log.Logger, l1 *ethclient.Client, contractAddr common.Address, server *AltDAServer, privKey *ecdsa.PrivateKey) *AltDAChallenger {
+	contract, err := bindings.NewDataAvailabilityChallenge(contractAddr, l1)
+	require.NoError(t, err)
+	chainID, err := l1.ChainID(t.Ctx())
+	require.NoError(t, err)
+	return &AltDAChallenger{
+		log:      log,
+		l1:       l1,
+		contract: contract,
+		server:   server,
+		privKey:  privKey,
+		chainID:  chainID,
+	}
+}
+
+func (c *AltDAChallenger) Address() common.Address {
+	return crypto.PubkeyToAddress(c.privKey.PublicKey)
+}
+
+func (c *AltDAChallenger) txOpts(t Testing) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(c.privKey, c.chainID)
+	require.NoError(t, err)
+	opts.Context = t.Ctx()
+	return opts
+}
+
+// ActTrackCommitment makes the challenger watch the encoded commitment, included in the given L1 block.
+func (c *AltDAChallenger) ActTrackCommitment(t Testing, comm []byte, blockNumber uint64) {
+	c.tracked = append(c.tracked, trackedCommitment{comm: comm, blockNumber: blockNumber})
+}
+
+// ActTrackBatcherTx tracks the commitment of the alt-DA batcher transaction, included in the given L1 block.
+func (c *AltDAChallenger) ActTrackBatcherTx(t Testing, tx *types.Transaction, blockNumber uint64) {
+	data := tx.Data()
+	require.NotEmpty(t, data, "batcher tx has no data")
+	// skip txdata version byte
+	c.ActTrackCommitment(t, data[1:], blockNumber)
+}
+
+// ActDeposit deposits the bond required to challenge a commitment.
+func (c *AltDAChallenger) ActDeposit(t Testing) {
+	bondSize, err := c.contract.BondSize(&bind.CallOpts{Context: t.Ctx()})
+	require.NoError(t, err)
+	opts := c.txOpts(t)
+	opts.Value = bondSize
+	_, err = c.contract.Deposit(opts)
+	require.NoError(t, err)
+}
+
+// ActChallenge challenges the encoded commitment included in the given L1 block.
+// The bond must have been deposited, and included in L1, before the challenge.
+func (c *AltDAChallenger) ActChallenge(t Testing, comm []byte, blockNumber uint64) {
+	_, err := c.contract.Challenge(c.txOpts(t), new(big.Int).SetUint64(blockNumber), comm)
+	require.NoError(t, err)
+	c.log.Info("Challenged alt-DA commitment", "comm", comm, "block", blockNumber)
+}
+
+// ActResolve resolves the challenge of the encoded commitment with the input served by the DA server.
+func (c *AltDAChallenger) ActResolve(t Testing, comm []byte, blockNumber uint64) {
+	input := c.server.Input(t, comm)
+	_, err := c.contract.Resolve(c.txOpts(t), new(big.Int).SetUint64(blockNumber), comm, input)
+	require.NoError(t, err)
+	c.log.Info("Resolved alt-DA challenge", "comm", comm, "block", blockNumber)
+}
+
+// ActUnlockBond unlocks the bond of the resolved or expired challenge of the encoded commitment.
+func (c *AltDAChallenger) ActUnlockBond(t Testing, comm []byte, blockNumber uint64) {
+	_, err := c.contract.UnlockBond(c.txOpts(t), new(big.Int).SetUint64(blockNumber), comm)
+	require.NoError(t, err)
+}
+
+// ChallengeStatus returns the on-chain status of the challenge of the encoded commitment.
+func (c *AltDAChallenger) ChallengeStatus(t Testing, comm []byte, blockNumber uint64) altda.ChallengeStatus {
+	status, err := c.contract.GetChallengeStatus(&bind.CallOpts{Context: t.Ctx()}, new(big.Int).SetUint64(blockNumber), comm)
+	require.NoError(t, err)
+	return altda.ChallengeStatus(status)
+}
+
+// ActChallengeUnavailable challenges the tracked commitments of which the DA server does not serve the input,
+// and that are still within the challenge window.
+// It returns the number of challenges sent. A bond must have been deposited for each of the challenges.
+func (c *AltDAChallenger) ActChallengeUnavailable(t Testing) int {
+	head, err := c.l1.BlockNumber(t.Ctx())
+	require.NoError(t, err)
+	challengeWindow, err := c.contract.ChallengeWindow(&bind.CallOpts{Context: t.Ctx()})
+	require.NoError(t, err)
+	count := 0
+	for _, tc := range c.tracked {
+		if head > tc.blockNumber+challengeWindow.Uint64() {
+			continue
+		}
+		if c.server.Available(t, tc.comm) || c.ChallengeStatus(t, tc.comm, tc.blockNumber) != altda.ChallengeUninitialized {
+			continue
+		}
+		c.ActChallenge(t, tc.comm, tc.blockNumber)
+		count++
+	}
+	return count
+}
+
+// ActResolveAvailable resolves the active challenges of tracked commitments of which the DA server serves the input.
+// It returns the number of resolutions sent.
+func (c *AltDAChallenger) ActResolveAvailable(t Testing) int {
+	count := 0
+	for _, tc := range c.tracked {
+		if !c.server.Available(t, tc.comm) || c.ChallengeStatus(t, tc.comm, tc.blockNumber) != altda.ChallengeActive {
+			continue
+		}
+		c.ActResolve(t, tc.comm, tc.blockNumber)
+		count++
+	}
+	return count
+}
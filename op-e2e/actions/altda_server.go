@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+)
+
+// AltDAServer is an in-memory alt-DA server actor.
+// The batcher stores inputs with it, and the rollup nodes and the DA challenger retrieve them from it.
+// Inputs can be withheld to simulate unavailable data, and published again to allow resolving challenges.
+type AltDAServer struct {
+	log     log.Logger
+	storage *altda.DAErrFaker
+
+	// withheld inputs, by encoded commitment
+	withheld map[string][]byte
+}
+
+var _ altda.DAStorage = (*AltDAServer)(nil)
+
+func NewAltDAServer(log log.Logger) *AltDAServer {
+	return &AltDAServer{
+		log:      log,
+		storage:  &altda.DAErrFaker{Client: altda.NewMockDAClient(log)},
+		withheld: make(map[string][]byte),
+	}
+}
+
+func (s *AltDAServer) GetInput(ctx context.Context, key altda.CommitmentData) ([]byte, error) {
+	return s.storage.GetInput(ctx, key)
+}
+
+func (s *AltDAServer) SetInput(ctx context.Context, data []byte) (altda.CommitmentData, error) {
+	return s.storage.SetInput(ctx, data)
+}
+
+// Storage returns the underlying storage, to fake errors of individual requests.
+func (s *AltDAServer) Storage() *altda.DAErrFaker {
+	return s.storage
+}
+
+// Available returns true if the input of the encoded commitment is served.
+func (s *AltDAServer) Available(t Testing, comm []byte) bool {
+	key, err := altda.DecodeCommitmentData(comm)
+	require.NoError(t, err)
+	_, err = s.storage.Client.GetInput(t.Ctx(), key)
+	if errors.Is(err, altda.ErrNotFound) {
+		return false
+	}
+	require.NoError(t, err)
+	return true
+}
+
+// Input returns the input of the encoded commitment, also if it is withheld.
+func (s *AltDAServer) Input(t Testing, comm []byte) []byte {
+	if input, ok := s.withheld[string(comm)]; ok {
+		return input
+	}
+	key, err := altda.DecodeCommitmentData(comm)
+	require.NoError(t, err)
+	input, err := s.storage.Client.GetInput(t.Ctx(), key)
+	require.NoError(t, err)
+	return input
+}
+
+// ActWithholdInput stops serving the input of the encoded commitment.
+func (s *AltDAServer) ActWithholdInput(t Testing, comm []byte) {
+	input := s.Input(t, comm)
+	require.NoError(t, s.storage.Client.DeleteData(comm))
+	s.withheld[string(comm)] = input
+	s.log.Info("Withholding alt-DA input", "comm", comm)
+}
+
+// ActPublishInput serves the withheld input of the encoded commitment again.
+func (s *AltDAServer) ActPublishInput(t Testing, comm []byte) {
+	input, ok := s.withheld[string(comm)]
+	require.True(t, ok, "input of commitment is not withheld")
+	_, err := s.storage.Client.SetInput(t.Ctx(), input)
+	require.NoError(t, err)
+	delete(s.withheld, string(comm))
+	s.log.Info("Publishing withheld alt-DA input", "comm", comm)
+}
+
+// ActGetInputFail fails the next input retrieval.
+func (s *AltDAServer) ActGetInputFail(t Testing) {
+	s.storage.ActGetPreImageFail()
+}
+
+// ActSetInputFail fails the next input submission.
+func (s *AltDAServer) ActSetInputFail(t Testing) {
+	s.storage.ActSetPreImageFail()
+}
@@ -28,7 +28,9 @@ import (
 // L2AltDA is a test harness for manipulating AltDA state.
 type L2AltDA struct {
 	log        log.Logger
+	server     *AltDAServer
 	storage    *altda.DAErrFaker
+	challenger *AltDAChallenger
 	daMgr      *altda.DA
 	altDACfg   altda.Config
 	contract   *bindings.DataAvailabilityChallenge
@@ -71,7 +73,8 @@ func NewL2AltDA(t Testing, params ...AltDAParam) *L2AltDA {
 	engine := NewL2Engine(t, log, sd.L2Cfg, sd.RollupCfg.Genesis.L1, jwtPath)
 	engCl := engine.EngineClient(t, sd.RollupCfg)
 
-	storage := &altda.DAErrFaker{Client: altda.NewMockDAClient(log)}
+	server := NewAltDAServer(log)
+	storage := server.Storage()
 
 	l1F, err := sources.NewL1Client(miner.RPCClient(), log, nil, sources.L1ClientDefaultConfig(sd.RollupCfg, false, sources.RPCKindBasic))
 	require.NoError(t, err)
@@ -100,6 +103,7 @@ func NewL2AltDA(t Testing, params ...AltDAParam) *L2AltDA {
 
 	contract, err := bindings.NewDataAvailabilityChallenge(sd.RollupCfg.AltDAConfig.DAChallengeAddress, l1Client)
 	require.NoError(t, err)
+	challenger := NewAltDAChallenger(t, log, l1Client, sd.RollupCfg.AltDAConfig.DAChallengeAddress, server, dp.Secrets.Mallory)
 
 	challengeWindow, err := contract.ChallengeWindow(nil)
 	require.NoError(t, err)
@@ -110,19 +114,21 @@ func NewL2AltDA(t Testing, params ...AltDAParam) *L2AltDA {
 	require.Equal(t, altDACfg.ResolveWindow, resolveWindow.Uint64())
 
 	return &L2AltDA{
-		log:       log,
-		storage:   storage,
-		daMgr:     daMgr,
-		altDACfg:  altDACfg,
-		contract:  contract,
-		batcher:   batcher,
-		sequencer: sequencer,
-		engine:    engine,
-		engCl:     engCl,
-		sd:        sd,
-		dp:        dp,
-		miner:     miner,
-		alice:     alice,
+		log:        log,
+		server:     server,
+		storage:    storage,
+		challenger: challenger,
+		daMgr:      daMgr,
+		altDACfg:   altDACfg,
+		contract:   contract,
+		batcher:    batcher,
+		sequencer:  sequencer,
+		engine:     engine,
+		engCl:      engCl,
+		sd:         sd,
+		dp:         dp,
+		miner:      miner,
+		alice:      alice,
 	}
 }
 
@@ -367,6 +373,59 @@ func TestAltDA_ChallengeResolved(gt *testing.T) {
 	require.Equal(t, syncStatus.SafeL2, verifSyncStatus.SafeL2)
 }
 
+// Input is withheld by the DA server and challenged by the DA challenger, which then resolves the challenge once the
+// input is published again. The sequencer and a new verifier derive the same safe chain.
+func TestAltDA_ChallengerAgent(gt *testing.T) {
+	if !e2eutils.UseAltDA() {
+		gt.Skip("AltDA is not enabled")
+	}
+
+	t := NewDefaultTesting(gt)
+	harness := NewL2AltDA(t)
+	challenger := harness.challenger
+
+	// include a new l2 transaction, submitting an input commitment to the l1.
+	harness.ActNewL2Tx(t)
+	challenger.ActTrackCommitment(t, harness.lastComm, harness.lastCommBn)
+
+	// nothing to challenge while the input is available.
+	require.Zero(t, challenger.ActChallengeUnavailable(t))
+
+	// withhold the input, the challenger challenges it.
+	harness.server.ActWithholdInput(t, harness.lastComm)
+	challenger.ActDeposit(t)
+	harness.miner.ActL1StartBlock(12)(t)
+	harness.miner.ActL1IncludeTx(challenger.Address())(t)
+	harness.miner.ActL1EndBlock(t)
+	require.Equal(t, 1, challenger.ActChallengeUnavailable(t))
+	harness.miner.ActL1StartBlock(12)(t)
+	harness.miner.ActL1IncludeTx(challenger.Address())(t)
+	harness.miner.ActL1EndBlock(t)
+	require.Equal(t, altda.ChallengeActive, challenger.ChallengeStatus(t, harness.lastComm, harness.lastCommBn))
+
+	// the challenge is not resolved while the input is withheld.
+	require.Zero(t, challenger.ActResolveAvailable(t))
+
+	// the input is published again, the challenger resolves the challenge.
+	harness.server.ActPublishInput(t, harness.lastComm)
+	require.Equal(t, 1, challenger.ActResolveAvailable(t))
+	harness.miner.ActL1StartBlock(12)(t)
+	harness.miner.ActL1IncludeTx(challenger.Address())(t)
+	harness.miner.ActL1EndBlock(t)
+	require.Equal(t, altda.ChallengeResolved, challenger.ChallengeStatus(t, harness.lastComm, harness.lastCommBn))
+
+	harness.sequencer.ActL2PipelineFull(t)
+	harness.ActL1Finalized(t)
+	syncStatus := harness.sequencer.SyncStatus()
+	require.Equal(t, harness.GetLastTxBlock(t).Hash(), syncStatus.SafeL2.Hash)
+
+	// the input is served from the resolved challenge, when the DA server no longer serves it.
+	harness.server.ActWithholdInput(t, harness.lastComm)
+	verifier := harness.NewVerifier(t)
+	verifier.ActL2PipelineFull(t)
+	require.Equal(t, syncStatus.SafeL2, verifier.SyncStatus().SafeL2)
+}
+
 // DA storage service goes offline while sequencer keeps making blocks. When storage comes back online, it should be able to catch up.
 func TestAltDA_StorageError(gt *testing.T) {
 	if !e2eutils.UseAltDA() {
@@ -119,7 +119,7 @@ func NewL2Verifier(t Testing, log log.Logger, l1 derive.L1Fetcher,
 	sys.Register("engine-reset",
 		engine.NewEngineResetDeriver(ctx, log, cfg, l1, eng, syncCfg), opts)
 
-	clSync := clsync.NewCLSync(log, cfg, metrics)
+	clSync := clsync.NewCLSync(log, cfg, syncCfg.UnsafeQuarantineTTL, metrics)
 	sys.Register("cl-sync", clSync, opts)
 
 	var finalizer driver.Finalizer
@@ -134,6 +134,9 @@ func NewL2Verifier(t Testing, log log.Logger, l1 derive.L1Fetcher,
 		attributes.NewAttributesHandler(log, cfg, ctx, eng), opts)
 
 	pipeline := derive.NewDerivationPipeline(log, cfg, l1, blobsSrc, altDASrc, eng, metrics)
+	if _, ok := safeHeadListener.(rollup.DerivationReceiptListener); ok && safeHeadListener.Enabled() {
+		pipeline.EnableDerivationReceipts()
+	}
 	sys.Register("pipeline", derive.NewPipelineDeriver(ctx, pipeline), opts)
 
 	testActionEmitter := sys.Register("test-action", nil, opts)
@@ -184,14 +187,14 @@ func NewL2Verifier(t Testing, log log.Logger, l1 derive.L1Fetcher,
 	apis := []rpc.API{
 		{
 			Namespace:     "optimism",
-			Service:       node.NewNodeAPI(cfg, eng, backend, safeHeadListener, log, m),
+			Service:       node.NewNodeAPI(cfg, nil, nil, eng, backend, safeHeadListener, log, m),
 			Public:        true,
 			Authenticated: false,
 		},
 		{
 			Namespace:     "admin",
 			Version:       "",
-			Service:       node.NewAdminAPI(backend, m, log),
+			Service:       node.NewAdminAPI(backend, nil, m, log),
 			Public:        true, // TODO: this field is deprecated. Do we even need this anymore?
 			Authenticated: false,
 		},
@@ -230,10 +233,27 @@ func (s *l2VerifierBackend) SequencerActive(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+func (s *l2VerifierBackend) SequencerStatus(ctx context.Context) (*eth.SequencerStatus, error) {
+	return &eth.SequencerStatus{UnsafeHead: s.verifier.L2Unsafe()}, nil
+}
+
+func (s *l2VerifierBackend) SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	return errors.New("block building limits are not supported by the L2Verifier")
+}
+
 func (s *l2VerifierBackend) OverrideLeader(ctx context.Context) error {
 	return nil
 }
 
+func (s *l2VerifierBackend) AcknowledgeL1Reorg(ctx context.Context) error {
+	return errors.New("halting on L1 reorgs is not supported by the L2Verifier")
+}
+
+func (s *l2VerifierBackend) DerivationPipelineStatus(ctx context.Context) (*eth.DerivationPipelineStatus, error) {
+	status := s.verifier.derivation.Status()
+	return &status, nil
+}
+
 func (s *l2VerifierBackend) OnUnsafeL2Payload(ctx context.Context, envelope *eth.ExecutionPayloadEnvelope) error {
 	return nil
 }
@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/txload"
+)
+
+// TxLoad is an actor that sends a random mix of transactions, as described by the workload profile,
+// and measures their inclusion latency and fees.
+type TxLoad struct {
+	log log.Logger
+	gen *txload.Generator
+	// included tracks the pending txs that were included in a block, but not checked yet.
+	included map[common.Hash]struct{}
+}
+
+// NewTxLoad creates a load actor. The L1 client may be nil if the profile does not include deposits.
+func NewTxLoad(t Testing, log log.Logger, cfg txload.Config, l1 *ethclient.Client, l2 *ethclient.Client) *TxLoad {
+	var l1Client txload.Client
+	if l1 != nil {
+		l1Client = l1
+	}
+	gen, err := txload.NewGenerator(t.Ctx(), log, cfg, l1Client, l2)
+	require.NoError(t, err)
+	return &TxLoad{
+		log:      log,
+		gen:      gen,
+		included: make(map[common.Hash]struct{}),
+	}
+}
+
+// ActSendTxs sends n random transactions of the workload profile.
+func (l *TxLoad) ActSendTxs(n int) Action {
+	return func(t Testing) {
+		for i := 0; i < n; i++ {
+			_, err := l.gen.SendNext(t.Ctx())
+			require.NoError(t, err)
+		}
+	}
+}
+
+// ActL2IncludeTxs includes the pending L2 transactions in the L2 block that is being built.
+func (l *TxLoad) ActL2IncludeTxs(engine *L2Engine) Action {
+	return func(t Testing) {
+		for _, sent := range l.gen.PendingTxs() {
+			if sent.Kind.L1() {
+				continue
+			}
+			if _, ok := l.included[sent.Tx.Hash()]; ok {
+				continue
+			}
+			engine.ActL2IncludeTx(sent.From)(t)
+			l.included[sent.Tx.Hash()] = struct{}{}
+		}
+	}
+}
+
+// ActL1IncludeTxs includes the pending L1 transactions, i.e. deposits, in the L1 block that is being built.
+func (l *TxLoad) ActL1IncludeTxs(miner *L1Miner) Action {
+	return func(t Testing) {
+		for _, sent := range l.gen.PendingTxs() {
+			if !sent.Kind.L1() {
+				continue
+			}
+			if _, ok := l.included[sent.Tx.Hash()]; ok {
+				continue
+			}
+			miner.ActL1IncludeTxByHash(sent.Tx.Hash())(t)
+			l.included[sent.Tx.Hash()] = struct{}{}
+		}
+	}
+}
+
+// ActCheckReceipts records the latency and fees of the transactions that were included.
+func (l *TxLoad) ActCheckReceipts(t Testing) {
+	require.NoError(t, l.gen.CheckReceipts(t.Ctx()))
+	pending := make(map[common.Hash]struct{})
+	for _, sent := range l.gen.PendingTxs() {
+		pending[sent.Tx.Hash()] = struct{}{}
+	}
+	for h := range l.included {
+		if _, ok := pending[h]; !ok {
+			delete(l.included, h)
+		}
+	}
+}
+
+// Pending returns the number of sent transactions that are not yet included.
+func (l *TxLoad) Pending() int {
+	return l.gen.Pending()
+}
+
+func (l *TxLoad) Stats() *txload.Stats {
+	return l.gen.Stats()
+}
@@ -0,0 +1,62 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/txload"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestTxLoad(gt *testing.T) {
+	for _, profile := range txload.Profiles {
+		profile := profile
+		gt.Run(profile.Name, func(gt *testing.T) {
+			t := NewDefaultTesting(gt)
+			dp := e2eutils.MakeDeployParams(t, DefaultRollupTestParams)
+			sd := e2eutils.Setup(t, dp, DefaultAlloc)
+			log := testlog.Logger(t, log.LevelInfo)
+			miner, engine, sequencer := setupSequencerTest(t, sd, log)
+			sequencer.ActL2PipelineFull(t)
+
+			cfg := txload.Config{
+				Profile:        profile,
+				Seed:           1234,
+				Keys:           []*ecdsa.PrivateKey{dp.Secrets.Alice, dp.Secrets.Bob, dp.Secrets.Mallory},
+				OptimismPortal: sd.DeploymentsL1.OptimismPortalProxy,
+			}
+			load := NewTxLoad(t, log, cfg, miner.EthClient(), engine.EthClient())
+
+			for i := 0; i < 5; i++ {
+				load.ActSendTxs(4)(t)
+
+				miner.ActL1StartBlock(12)(t)
+				load.ActL1IncludeTxs(miner)(t)
+				miner.ActL1EndBlock(t)
+
+				sequencer.ActL1HeadSignal(t)
+				sequencer.ActL2StartBlock(t)
+				load.ActL2IncludeTxs(engine)(t)
+				sequencer.ActL2EndBlock(t)
+
+				load.ActCheckReceipts(t)
+				require.Zero(t, load.Pending(), "all load txs must be included")
+			}
+			// the deposits of the L1 blocks are derived into L2
+			sequencer.ActBuildToL1Head(t)
+
+			stats := load.Stats()
+			stats.Log(log)
+			total := stats.Total()
+			require.Equal(t, uint64(20), total.Sent)
+			require.Equal(t, uint64(20), total.Included)
+			require.Zero(t, total.Reverted)
+			require.Equal(t, uint64(1), total.BlockLatencyPercentile(100), "txs must be included in the next block")
+			require.Positive(t, total.TotalFees().Sign())
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package op_e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	batcherFlags "github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum-optimism/optimism/op-e2e/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	opnodeFlags "github.com/ethereum-optimism/optimism/op-node/flags"
+	proposerFlags "github.com/ethereum-optimism/optimism/op-proposer/flags"
+	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// DevnetConfigMount is the path the devnet artifacts directory must be mounted at in each service container.
+// Flags referring to artifacts, such as the rollup config and JWT secret, use paths within this directory.
+const DevnetConfigMount = "/config"
+
+// Container ports used by the devnet services.
+const (
+	DevnetRPCPort     = 8545
+	DevnetWSPort      = 8546
+	DevnetAuthRPCPort = 8551
+	DevnetBeaconPort  = 5052
+	DevnetP2PPort     = 9003
+	DevnetMetricsPort = 7300
+	DevnetDAPort      = 3100
+)
+
+// Artifact file names, within the devnet artifacts directory.
+const (
+	devnetL1GenesisFile    = "genesis-l1.json"
+	devnetL2GenesisFile    = "genesis-l2.json"
+	devnetRollupConfigFile = "rollup.json"
+	devnetJWTSecretFile    = "jwt-secret.txt"
+	devnetDescriptorFile   = "devnet.json"
+)
+
+// Kinds of devnet services.
+const (
+	DevnetKindL1         = "l1"
+	DevnetKindOpGeth     = "op-geth"
+	DevnetKindOpNode     = "op-node"
+	DevnetKindOpBatcher  = "op-batcher"
+	DevnetKindOpProposer = "op-proposer"
+	DevnetKindDAServer   = "da-server"
+)
+
+// DevnetDescriptor describes the services of a system, so that external orchestration such as
+// docker compose or kurtosis can run a container based devnet equivalent to the in-process system.
+// Services address each other by service name.
+type DevnetDescriptor struct {
+	L1ChainID uint64          `json:"l1ChainID"`
+	L2ChainID uint64          `json:"l2ChainID"`
+	Services  []DevnetService `json:"services"`
+	Artifacts DevnetArtifacts `json:"artifacts"`
+}
+
+// DevnetService is a single service of the devnet.
+type DevnetService struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Genesis is the genesis artifact execution clients must be initialized with, if any.
+	Genesis string `json:"genesis,omitempty"`
+	// Flags are the command line flags of the service.
+	Flags []string `json:"flags"`
+	// Ports maps port names to the container ports exposed by the service.
+	Ports     map[string]int `json:"ports"`
+	DependsOn []string       `json:"dependsOn,omitempty"`
+}
+
+// DevnetArtifacts lists the files in the artifacts directory, relative to that directory.
+type DevnetArtifacts struct {
+	L1Genesis    string `json:"l1Genesis"`
+	L2Genesis    string `json:"l2Genesis"`
+	RollupConfig string `json:"rollupConfig"`
+	JWTSecret    string `json:"jwtSecret"`
+}
+
+// ExportDevnet writes the genesis artifacts of the system to dir, along with a devnet.json descriptor
+// of the services the system consists of. The L1 service is expected to provide both an execution
+// RPC and a beacon API, the consensus setup of L1 is left to the orchestration.
+// The L1 genesis timestamp is taken from the deploy config, so it must be recent enough for the devnet to start.
+func (cfg SystemConfig) ExportDevnet(t testing.TB, dir string) (*DevnetDescriptor, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create devnet dir: %w", err)
+	}
+	l1Genesis, l2Genesis, err := cfg.buildGenesis(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build genesis: %w", err)
+	}
+	rollupCfg := cfg.rollupConfig(l1Genesis.ToBlock().Hash(), l2Genesis.ToBlock().Hash())
+	if err := rollupCfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid rollup config: %w", err)
+	}
+
+	desc := &DevnetDescriptor{
+		L1ChainID: rollupCfg.L1ChainID.Uint64(),
+		L2ChainID: rollupCfg.L2ChainID.Uint64(),
+		Artifacts: DevnetArtifacts{
+			L1Genesis:    devnetL1GenesisFile,
+			L2Genesis:    devnetL2GenesisFile,
+			RollupConfig: devnetRollupConfigFile,
+			JWTSecret:    devnetJWTSecretFile,
+		},
+	}
+	for file, v := range map[string]any{
+		devnetL1GenesisFile:    l1Genesis,
+		devnetL2GenesisFile:    l2Genesis,
+		devnetRollupConfigFile: &rollupCfg,
+	} {
+		if err := writeDevnetJSON(filepath.Join(dir, file), v); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, devnetJWTSecretFile), []byte(hexutil.Encode(cfg.JWTSecret[:])), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write jwt secret: %w", err)
+	}
+
+	desc.Services = cfg.devnetServices()
+	if err := writeDevnetJSON(filepath.Join(dir, devnetDescriptorFile), desc); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+func (cfg SystemConfig) devnetServices() []DevnetService {
+	services := []DevnetService{{
+		Name:    RoleL1,
+		Kind:    DevnetKindL1,
+		Genesis: devnetL1GenesisFile,
+		Flags: []string{
+			"--http", "--http.addr=0.0.0.0", fmt.Sprintf("--http.port=%d", DevnetRPCPort),
+			"--ws", "--ws.addr=0.0.0.0", fmt.Sprintf("--ws.port=%d", DevnetWSPort),
+		},
+		Ports: map[string]int{"rpc": DevnetRPCPort, "ws": DevnetWSPort, "beacon": DevnetBeaconPort},
+	}}
+
+	// Sort the roles, so the descriptor is deterministic.
+	roles := make([]string, 0, len(cfg.Nodes))
+	for role := range cfg.Nodes {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		services = append(services, cfg.devnetOpGeth(role), cfg.devnetOpNode(role))
+	}
+
+	if _, ok := cfg.Nodes[RoleSeq]; !ok {
+		// Like the in-process system, the batcher and proposer require a sequencer.
+		return services
+	}
+	if cfg.DeployConfig.UseAltDA {
+		services = append(services, DevnetService{
+			Name: DevnetKindDAServer,
+			Kind: DevnetKindDAServer,
+			Flags: []string{
+				"--file.path=/data", "--addr=0.0.0.0", fmt.Sprintf("--port=%d", DevnetDAPort), "--generic-commitment=true",
+			},
+			Ports: map[string]int{"da": DevnetDAPort},
+		})
+	}
+	services = append(services, cfg.devnetBatcher())
+	if !cfg.DisableProposer {
+		services = append(services, cfg.devnetProposer())
+	}
+	return services
+}
+
+func (cfg SystemConfig) devnetOpGeth(role string) DevnetService {
+	return DevnetService{
+		Name:    devnetOpGethName(role),
+		Kind:    DevnetKindOpGeth,
+		Genesis: devnetL2GenesisFile,
+		Flags: []string{
+			"--http", "--http.addr=0.0.0.0", fmt.Sprintf("--http.port=%d", DevnetRPCPort),
+			"--ws", "--ws.addr=0.0.0.0", fmt.Sprintf("--ws.port=%d", DevnetWSPort),
+			"--authrpc.addr=0.0.0.0", fmt.Sprintf("--authrpc.port=%d", DevnetAuthRPCPort),
+			"--authrpc.jwtsecret=" + devnetArtifact(devnetJWTSecretFile),
+			"--syncmode=full", "--gcmode=archive", "--nodiscover", "--maxpeers=0",
+			"--rollup.disabletxpoolgossip=true",
+		},
+		Ports: map[string]int{"rpc": DevnetRPCPort, "ws": DevnetWSPort, "authrpc": DevnetAuthRPCPort},
+	}
+}
+
+func (cfg SystemConfig) devnetOpNode(role string) DevnetService {
+	nodeCfg := cfg.Nodes[role]
+	flags := []string{
+		devnetFlag(opnodeFlags.L1NodeAddr.Name, fmt.Sprintf("ws://%s:%d", RoleL1, DevnetWSPort)),
+		devnetFlag(opnodeFlags.BeaconAddr.Name, fmt.Sprintf("http://%s:%d", RoleL1, DevnetBeaconPort)),
+		devnetFlag(opnodeFlags.L2EngineAddr.Name, fmt.Sprintf("http://%s:%d", devnetOpGethName(role), DevnetAuthRPCPort)),
+		devnetFlag(opnodeFlags.L2EngineJWTSecret.Name, devnetArtifact(devnetJWTSecretFile)),
+		devnetFlag(opflags.RollupConfigFlagName, devnetArtifact(devnetRollupConfigFile)),
+		devnetFlag(opnodeFlags.RPCListenAddr.Name, "0.0.0.0"),
+		devnetFlag(opnodeFlags.RPCListenPort.Name, strconv.Itoa(DevnetRPCPort)),
+		devnetFlag(opnodeFlags.RPCEnableAdmin.Name, strconv.FormatBool(nodeCfg.RPC.EnableAdmin)),
+		devnetFlag(opnodeFlags.VerifierL1Confs.Name, strconv.FormatUint(nodeCfg.Driver.VerifierConfDepth, 10)),
+		devnetFlag(opnodeFlags.SequencerL1Confs.Name, strconv.FormatUint(nodeCfg.Driver.SequencerConfDepth, 10)),
+		devnetFlag(opnodeFlags.SequencerEnabledFlag.Name, strconv.FormatBool(nodeCfg.Driver.SequencerEnabled)),
+		devnetFlag(opnodeFlags.SyncModeFlag.Name, nodeCfg.Sync.SyncMode.String()),
+		devnetFlag(opnodeFlags.L1EpochPollIntervalFlag.Name, nodeCfg.L1EpochPollInterval.String()),
+		devnetFlag(opnodeFlags.MetricsEnabledFlag.Name, "true"),
+		devnetFlag(opnodeFlags.MetricsAddrFlag.Name, "0.0.0.0"),
+		devnetFlag(opnodeFlags.MetricsPortFlag.Name, strconv.Itoa(DevnetMetricsPort)),
+	}
+	ports := map[string]int{"rpc": DevnetRPCPort, "metrics": DevnetMetricsPort}
+	if _, ok := cfg.P2PTopology[role]; ok || cfg.hasP2PPeer(role) {
+		flags = append(flags,
+			devnetFlag(opnodeFlags.ListenIPName, "0.0.0.0"),
+			devnetFlag(opnodeFlags.ListenTCPPortName, strconv.Itoa(DevnetP2PPort)),
+			devnetFlag(opnodeFlags.ListenUDPPortName, strconv.Itoa(DevnetP2PPort)),
+		)
+		ports["p2p"] = DevnetP2PPort
+	} else {
+		flags = append(flags, devnetFlag(opnodeFlags.DisableP2PName, "true"))
+	}
+	if nodeCfg.Driver.SequencerEnabled {
+		flags = append(flags, devnetFlag(opnodeFlags.SequencerP2PKeyName, hexutil.Encode(crypto.FromECDSA(cfg.Secrets.SequencerP2P))[2:]))
+	}
+	if cfg.DeployConfig.UseAltDA {
+		flags = append(flags, devnetAltDAFlags()...)
+	}
+	return DevnetService{
+		Name:      devnetOpNodeName(role),
+		Kind:      DevnetKindOpNode,
+		Flags:     flags,
+		Ports:     ports,
+		DependsOn: []string{RoleL1, devnetOpGethName(role)},
+	}
+}
+
+// hasP2PPeer returns true if any node in the P2P topology connects to the given node.
+func (cfg SystemConfig) hasP2PPeer(role string) bool {
+	for _, peers := range cfg.P2PTopology {
+		for _, peer := range peers {
+			if peer == role || peer == "~"+role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cfg SystemConfig) devnetBatcher() DevnetService {
+	maxL1TxSize := cfg.BatcherMaxL1TxSizeBytes
+	if maxL1TxSize == 0 {
+		maxL1TxSize = 120_000
+	}
+	targetNumFrames := cfg.BatcherTargetNumFrames
+	if targetNumFrames == 0 {
+		targetNumFrames = 1
+	}
+	flags := []string{
+		devnetFlag(batcherFlags.L1EthRpcFlag.Name, fmt.Sprintf("http://%s:%d", RoleL1, DevnetRPCPort)),
+		devnetFlag(batcherFlags.L2EthRpcFlag.Name, fmt.Sprintf("http://%s:%d", devnetOpGethName(RoleSeq), DevnetRPCPort)),
+		devnetFlag(batcherFlags.RollupRpcFlag.Name, fmt.Sprintf("http://%s:%d", devnetOpNodeName(RoleSeq), DevnetRPCPort)),
+		devnetFlag(batcherFlags.MaxPendingTransactionsFlag.Name, strconv.FormatUint(cfg.BatcherMaxPendingTransactions, 10)),
+		devnetFlag(batcherFlags.MaxChannelDurationFlag.Name, "1"),
+		devnetFlag(batcherFlags.MaxL1TxSizeBytesFlag.Name, strconv.FormatUint(maxL1TxSize, 10)),
+		devnetFlag(batcherFlags.TargetNumFramesFlag.Name, strconv.Itoa(targetNumFrames)),
+		devnetFlag(batcherFlags.ApproxComprRatioFlag.Name, "0.4"),
+		devnetFlag(batcherFlags.SubSafetyMarginFlag.Name, "4"),
+		devnetFlag(batcherFlags.PollIntervalFlag.Name, "1s"),
+		devnetFlag(batcherFlags.BatchTypeFlag.Name, strconv.FormatUint(uint64(cfg.BatcherBatchType), 10)),
+		devnetFlag(batcherFlags.MaxBlocksPerSpanBatch.Name, strconv.Itoa(cfg.BatcherMaxBlocksPerSpanBatch)),
+		devnetFlag(batcherFlags.DataAvailabilityTypeFlag.Name, cfg.DataAvailabilityType.String()),
+		devnetFlag(batcherFlags.StoppedFlag.Name, strconv.FormatBool(cfg.DisableBatcher)),
+		devnetFlag(txmgr.NumConfirmationsFlagName, "1"),
+		devnetFlag(txmgr.SafeAbortNonceTooLowCountFlagName, "3"),
+		devnetFlag(txmgr.PrivateKeyFlagName, hexPriv(cfg.Secrets.Batcher)),
+	}
+	dependsOn := []string{RoleL1, devnetOpGethName(RoleSeq), devnetOpNodeName(RoleSeq)}
+	if cfg.DeployConfig.UseAltDA {
+		flags = append(flags, devnetAltDAFlags()...)
+		dependsOn = append(dependsOn, DevnetKindDAServer)
+	}
+	return DevnetService{
+		Name:      DevnetKindOpBatcher,
+		Kind:      DevnetKindOpBatcher,
+		Flags:     flags,
+		Ports:     map[string]int{},
+		DependsOn: dependsOn,
+	}
+}
+
+func (cfg SystemConfig) devnetProposer() DevnetService {
+	flags := []string{
+		devnetFlag(proposerFlags.L1EthRpcFlag.Name, fmt.Sprintf("http://%s:%d", RoleL1, DevnetRPCPort)),
+		devnetFlag(proposerFlags.RollupRpcFlag.Name, fmt.Sprintf("http://%s:%d", devnetOpNodeName(RoleSeq), DevnetRPCPort)),
+		devnetFlag(proposerFlags.PollIntervalFlag.Name, "1s"),
+		devnetFlag(proposerFlags.AllowNonFinalizedFlag.Name, strconv.FormatBool(cfg.NonFinalizedProposals)),
+		devnetFlag(txmgr.NumConfirmationsFlagName, "1"),
+		devnetFlag(txmgr.PrivateKeyFlagName, hexPriv(cfg.Secrets.Proposer)),
+	}
+	if e2eutils.UseFaultProofs() {
+		flags = append(flags,
+			devnetFlag(proposerFlags.DisputeGameFactoryAddressFlag.Name, config.L1Deployments.DisputeGameFactoryProxy.Hex()),
+			devnetFlag(proposerFlags.ProposalIntervalFlag.Name, "6s"),
+			devnetFlag(proposerFlags.DisputeGameTypeFlag.Name, "254"), // Fast game type
+		)
+	} else {
+		flags = append(flags, devnetFlag(proposerFlags.L2OOAddressFlag.Name, config.L1Deployments.L2OutputOracleProxy.Hex()))
+	}
+	return DevnetService{
+		Name:      DevnetKindOpProposer,
+		Kind:      DevnetKindOpProposer,
+		Flags:     flags,
+		Ports:     map[string]int{},
+		DependsOn: []string{RoleL1, devnetOpNodeName(RoleSeq)},
+	}
+}
+
+func devnetAltDAFlags() []string {
+	return []string{
+		devnetFlag(altda.EnabledFlagName, "true"),
+		devnetFlag(altda.DaServerAddressFlagName, fmt.Sprintf("http://%s:%d", DevnetKindDAServer, DevnetDAPort)),
+		devnetFlag(altda.VerifyOnReadFlagName, "true"),
+		devnetFlag(altda.DaServiceFlagName, "true"),
+	}
+}
+
+func devnetOpGethName(role string) string {
+	return "l2-" + role
+}
+
+func devnetOpNodeName(role string) string {
+	return "op-node-" + role
+}
+
+func devnetFlag(name string, value string) string {
+	return "--" + name + "=" + value
+}
+
+func devnetArtifact(file string) string {
+	return DevnetConfigMount + "/" + file
+}
+
+func writeDevnetJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", filepath.Base(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
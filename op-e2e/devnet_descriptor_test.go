@@ -0,0 +1,167 @@
+package op_e2e
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	batcherFlags "github.com/ethereum-optimism/optimism/op-batcher/flags"
+	opnodeFlags "github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	proposerFlags "github.com/ethereum-optimism/optimism/op-proposer/flags"
+)
+
+func TestExportDevnet(t *testing.T) {
+	InitParallel(t)
+	cfg := DefaultSystemConfig(t)
+	dir := t.TempDir()
+	desc, err := cfg.ExportDevnet(t, dir)
+	require.NoError(t, err)
+
+	require.Equal(t, cfg.DeployConfig.L1ChainID, desc.L1ChainID)
+	require.Equal(t, cfg.DeployConfig.L2ChainID, desc.L2ChainID)
+	require.Equal(t, []string{"l1", "l2-sequencer", "op-node-sequencer", "l2-verifier", "op-node-verifier", "op-batcher", "op-proposer"},
+		devnetServiceNames(desc))
+
+	// The descriptor on disk matches the returned descriptor
+	var written DevnetDescriptor
+	readDevnetJSON(t, filepath.Join(dir, devnetDescriptorFile), &written)
+	require.Equal(t, *desc, written)
+
+	// The rollup config refers to the genesis blocks of the exported genesis artifacts
+	var l1Genesis, l2Genesis core.Genesis
+	readDevnetJSON(t, filepath.Join(dir, desc.Artifacts.L1Genesis), &l1Genesis)
+	readDevnetJSON(t, filepath.Join(dir, desc.Artifacts.L2Genesis), &l2Genesis)
+	var rollupCfg rollup.Config
+	readDevnetJSON(t, filepath.Join(dir, desc.Artifacts.RollupConfig), &rollupCfg)
+	require.NoError(t, rollupCfg.Check())
+	require.Equal(t, l1Genesis.ToBlock().Hash(), rollupCfg.Genesis.L1.Hash)
+	require.Equal(t, l2Genesis.ToBlock().Hash(), rollupCfg.Genesis.L2.Hash)
+
+	jwtSecret, err := os.ReadFile(filepath.Join(dir, desc.Artifacts.JWTSecret))
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(cfg.JWTSecret[:]), string(jwtSecret))
+}
+
+func TestDevnetServices(t *testing.T) {
+	InitParallel(t)
+
+	t.Run("DependenciesDefinedFirst", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		cfg.DeployConfig.UseAltDA = true
+		defined := make(map[string]bool)
+		for _, svc := range cfg.devnetServices() {
+			for _, dep := range svc.DependsOn {
+				require.True(t, defined[dep], "%s depends on %s, which is not defined before it", svc.Name, dep)
+			}
+			defined[svc.Name] = true
+		}
+	})
+
+	t.Run("FlagsParse", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		cfg.DeployConfig.UseAltDA = true
+		cfg.P2PTopology = map[string][]string{RoleVerif: {RoleSeq}}
+		flagsByKind := map[string][]cli.Flag{
+			DevnetKindOpNode:     opnodeFlags.Flags,
+			DevnetKindOpBatcher:  batcherFlags.Flags,
+			DevnetKindOpProposer: proposerFlags.Flags,
+		}
+		for _, svc := range cfg.devnetServices() {
+			flags, ok := flagsByKind[svc.Kind]
+			if !ok {
+				continue
+			}
+			app := cli.NewApp()
+			app.Flags = flags
+			app.Action = func(*cli.Context) error { return nil }
+			require.NoError(t, app.Run(append([]string{svc.Name}, svc.Flags...)), "flags of %s", svc.Name)
+		}
+	})
+
+	t.Run("NoSequencer", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		delete(cfg.Nodes, RoleSeq)
+		require.Equal(t, []string{"l1", "l2-verifier", "op-node-verifier"}, devnetServiceNames(&DevnetDescriptor{Services: cfg.devnetServices()}))
+	})
+
+	t.Run("DisableProposer", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		cfg.DisableProposer = true
+		require.NotContains(t, devnetServiceNames(&DevnetDescriptor{Services: cfg.devnetServices()}), DevnetKindOpProposer)
+	})
+
+	t.Run("AltDA", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		cfg.DeployConfig.UseAltDA = true
+		services := cfg.devnetServices()
+		require.Contains(t, devnetServiceNames(&DevnetDescriptor{Services: services}), DevnetKindDAServer)
+		batcher := devnetService(t, services, DevnetKindOpBatcher)
+		require.Contains(t, batcher.DependsOn, DevnetKindDAServer)
+		require.Contains(t, batcher.Flags, devnetFlag(altda.EnabledFlagName, "true"))
+		require.Contains(t, devnetService(t, services, devnetOpNodeName(RoleSeq)).Flags, devnetFlag(altda.EnabledFlagName, "true"))
+	})
+
+	t.Run("P2P", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		services := cfg.devnetServices()
+		for _, role := range []string{RoleSeq, RoleVerif} {
+			node := devnetService(t, services, devnetOpNodeName(role))
+			require.Contains(t, node.Flags, devnetFlag(opnodeFlags.DisableP2PName, "true"))
+			require.NotContains(t, node.Ports, "p2p")
+		}
+
+		cfg.P2PTopology = map[string][]string{RoleVerif: {RoleSeq}}
+		services = cfg.devnetServices()
+		for _, role := range []string{RoleSeq, RoleVerif} {
+			node := devnetService(t, services, devnetOpNodeName(role))
+			require.NotContains(t, node.Flags, devnetFlag(opnodeFlags.DisableP2PName, "true"))
+			require.Equal(t, DevnetP2PPort, node.Ports["p2p"])
+		}
+	})
+
+	t.Run("SequencerKey", func(t *testing.T) {
+		cfg := DefaultSystemConfig(t)
+		services := cfg.devnetServices()
+		hasKey := func(svc DevnetService) bool {
+			return slices.ContainsFunc(svc.Flags, func(flag string) bool {
+				return strings.HasPrefix(flag, devnetFlag(opnodeFlags.SequencerP2PKeyName, ""))
+			})
+		}
+		require.True(t, hasKey(devnetService(t, services, devnetOpNodeName(RoleSeq))))
+		require.False(t, hasKey(devnetService(t, services, devnetOpNodeName(RoleVerif))))
+	})
+}
+
+func devnetServiceNames(desc *DevnetDescriptor) []string {
+	names := make([]string, 0, len(desc.Services))
+	for _, svc := range desc.Services {
+		names = append(names, svc.Name)
+	}
+	return names
+}
+
+func devnetService(t *testing.T, services []DevnetService, name string) DevnetService {
+	for _, svc := range services {
+		if svc.Name == name {
+			return svc
+		}
+	}
+	t.Fatalf("no service %s", name)
+	return DevnetService{}
+}
+
+func readDevnetJSON(t *testing.T, path string, v any) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
@@ -90,6 +90,14 @@ func defaultNodeConfig(name string, jwtPath string) *node.Config {
 
 type GethOption func(ethCfg *ethconfig.Config, nodeCfg *node.Config) error
 
+// WithIPCPath enables the IPC endpoint of the node, at the given path.
+func WithIPCPath(path string) GethOption {
+	return func(_ *ethconfig.Config, nodeCfg *node.Config) error {
+		nodeCfg.IPCPath = path
+		return nil
+	}
+}
+
 // InitL2 inits a L2 geth node.
 func InitL2(name string, genesis *core.Genesis, jwtPath string, opts ...GethOption) (*GethInstance, error) {
 	ethConfig := &ethconfig.Config{
@@ -19,6 +19,7 @@ func (gi *GethInstance) UserRPC() endpoint.RPC {
 	fallback := endpoint.WsOrHttpRPC{
 		WsURL:   gi.Node.WSEndpoint(),
 		HttpURL: gi.Node.HTTPEndpoint(),
+		IpcPath: gi.Node.IPCEndpoint(),
 	}
 	srv, err := gi.Node.RPCHandler()
 	if err != nil {
@@ -32,6 +33,7 @@ func (gi *GethInstance) UserRPC() endpoint.RPC {
 
 func (gi *GethInstance) AuthRPC() endpoint.RPC {
 	// TODO: can we rely on the in-process RPC server to support the auth namespaces?
+	// The IPC endpoint is not offered: it is not authenticated, so the engine API connection would skip the JWT auth.
 	return endpoint.WsOrHttpRPC{
 		WsURL:   gi.Node.WSAuthEndpoint(),
 		HttpURL: gi.Node.HTTPAuthEndpoint(),
@@ -0,0 +1,358 @@
+package txload
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+const (
+	erc20GasLimit      = 100_000
+	depositGasLimit    = 300_000
+	withdrawalGasLimit = 200_000
+	// bridgedGasLimit is the gas limit of the L2 execution of deposits, and the L1 execution of withdrawals.
+	bridgedGasLimit = 100_000
+)
+
+var (
+	// maxValue bounds the value of transfers, deposits and withdrawals so accounts don't run out of funds.
+	maxValue = big.NewInt(params.GWei * 1000)
+	// wrapAmount is the amount of ETH an account wraps into WETH, before it starts sending token transfers.
+	wrapAmount = big.NewInt(params.GWei * 1_000_000)
+)
+
+// Client is the subset of the ethclient.Client methods used to send the load, for L1 or L2.
+type Client interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+type Config struct {
+	Profile Profile
+	// Seed of the random source, to reproduce the same sequence of transactions.
+	Seed int64
+	// Keys of the accounts that send the transactions. The accounts must be funded on L2,
+	// and on L1 if the profile includes deposits.
+	Keys []*ecdsa.PrivateKey
+	// OptimismPortal is the L1 portal proxy that deposits are sent to.
+	OptimismPortal common.Address
+}
+
+func (c *Config) Check() error {
+	if err := c.Profile.Check(); err != nil {
+		return err
+	}
+	if len(c.Keys) == 0 {
+		return errors.New("no tx load accounts")
+	}
+	if c.Profile.Weights[Deposit] != 0 && c.OptimismPortal == (common.Address{}) {
+		return errors.New("deposits require the OptimismPortal address")
+	}
+	return nil
+}
+
+type account struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+	// nonces on L1 and L2, nil until fetched from the pending state
+	l1Nonce *uint64
+	l2Nonce *uint64
+	// wrapped is the WETH balance wrapped by this account, that is not yet transferred
+	wrapped *big.Int
+}
+
+// SentTx is a transaction sent by the generator.
+type SentTx struct {
+	Kind      Kind
+	Tx        *types.Transaction
+	From      common.Address
+	SentAt    time.Time
+	SentBlock uint64
+}
+
+// Generator produces a random mix of transactions, as described by the workload profile,
+// and measures their inclusion latency and fees.
+// Sending and checking for inclusion are separate, so it can be driven step-by-step by action tests,
+// or with Run in system tests.
+type Generator struct {
+	log log.Logger
+	cfg Config
+	rng *rand.Rand
+
+	l1       Client
+	l2       Client
+	l1Signer types.Signer
+	l2Signer types.Signer
+
+	portalABI *abi.ABI
+	passerABI *abi.ABI
+	wethABI   *abi.ABI
+
+	accounts []*account
+	pending  []*SentTx
+	stats    *Stats
+}
+
+// NewGenerator creates a load generator. The L1 client may be nil if the profile does not include deposits.
+func NewGenerator(ctx context.Context, logger log.Logger, cfg Config, l1 Client, l2 Client) (*Generator, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	g := &Generator{
+		log:   logger,
+		cfg:   cfg,
+		rng:   rand.New(rand.NewSource(cfg.Seed)),
+		l1:    l1,
+		l2:    l2,
+		stats: newStats(),
+	}
+	l2ChainID, err := l2.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 chain ID: %w", err)
+	}
+	g.l2Signer = types.LatestSignerForChainID(l2ChainID)
+	if cfg.Profile.Weights[Deposit] != 0 {
+		if l1 == nil {
+			return nil, errors.New("deposits require an L1 client")
+		}
+		l1ChainID, err := l1.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 chain ID: %w", err)
+		}
+		g.l1Signer = types.LatestSignerForChainID(l1ChainID)
+	}
+	if g.portalABI, err = bindings.OptimismPortalMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	if g.passerABI, err = bindings.L2ToL1MessagePasserMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	if g.wethABI, err = bindings.WETHMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	for _, key := range cfg.Keys {
+		g.accounts = append(g.accounts, &account{
+			key:     key,
+			addr:    crypto.PubkeyToAddress(key.PublicKey),
+			wrapped: new(big.Int),
+		})
+	}
+	return g, nil
+}
+
+// SendNext sends a random transaction of the profile, from a random account.
+func (g *Generator) SendNext(ctx context.Context) (*SentTx, error) {
+	return g.Send(ctx, g.cfg.Profile.pick(g.rng))
+}
+
+// Send sends a random transaction of the given kind, from a random account.
+func (g *Generator) Send(ctx context.Context, kind Kind) (*SentTx, error) {
+	from := g.accounts[g.rng.Intn(len(g.accounts))]
+	sent, err := g.send(ctx, kind, from)
+	if err != nil {
+		g.stats.kind(kind).SendErrors++
+		return nil, fmt.Errorf("failed to send %v tx: %w", kind, err)
+	}
+	g.stats.kind(kind).Sent++
+	g.pending = append(g.pending, sent)
+	g.log.Debug("Sent load tx", "kind", kind, "hash", sent.Tx.Hash(), "from", sent.From, "nonce", sent.Tx.Nonce())
+	return sent, nil
+}
+
+func (g *Generator) send(ctx context.Context, kind Kind, from *account) (*SentTx, error) {
+	client, signer, nonce := g.l2, g.l2Signer, &from.l2Nonce
+	if kind.L1() {
+		client, signer, nonce = g.l1, g.l1Signer, &from.l1Nonce
+	}
+	if *nonce == nil {
+		n, err := client.PendingNonceAt(ctx, from.addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch nonce: %w", err)
+		}
+		*nonce = &n
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gas tip: %w", err)
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+
+	to, value, data, gas, err := g.build(kind, from)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignNewTx(from.key, signer, &types.DynamicFeeTx{
+		ChainID:   signer.ChainID(),
+		Nonce:     **nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		// The nonce may have been consumed, e.g. if the tx was sent but the response got lost, so fetch it again.
+		*nonce = nil
+		return nil, err
+	}
+	**nonce++
+	if kind == ERC20 {
+		g.trackWrapped(from, tx)
+	}
+	return &SentTx{Kind: kind, Tx: tx, From: from.addr, SentAt: time.Now(), SentBlock: head.Number.Uint64()}, nil
+}
+
+// build returns the recipient, value, calldata and gas limit of a new transaction.
+func (g *Generator) build(kind Kind, from *account) (common.Address, *big.Int, []byte, uint64, error) {
+	switch kind {
+	case Transfer:
+		return g.randomRecipient(), g.randomValue(), nil, params.TxGas, nil
+	case ERC20:
+		if from.wrapped.Cmp(maxValue) < 0 {
+			data, err := g.wethABI.Pack("deposit")
+			return predeploys.WETHAddr, new(big.Int).Set(wrapAmount), data, erc20GasLimit, err
+		}
+		data, err := g.wethABI.Pack("transfer", g.randomRecipient(), g.randomValue())
+		return predeploys.WETHAddr, new(big.Int), data, erc20GasLimit, err
+	case Calldata:
+		data := make([]byte, g.cfg.Profile.calldataSize(g.rng))
+		_, _ = g.rng.Read(data)
+		return g.randomRecipient(), new(big.Int), data, calldataGas(data), nil
+	case Deposit:
+		data, err := g.portalABI.Pack("depositTransaction", from.addr, new(big.Int), uint64(bridgedGasLimit), false, []byte{})
+		return g.cfg.OptimismPortal, g.randomValue(), data, depositGasLimit, err
+	case Withdrawal:
+		data, err := g.passerABI.Pack("initiateWithdrawal", from.addr, big.NewInt(bridgedGasLimit), []byte{})
+		return predeploys.L2ToL1MessagePasserAddr, g.randomValue(), data, withdrawalGasLimit, err
+	default:
+		return common.Address{}, nil, nil, 0, fmt.Errorf("unknown tx kind: %v", kind)
+	}
+}
+
+// trackWrapped updates the WETH balance of the account, assuming the tx succeeds.
+func (g *Generator) trackWrapped(from *account, tx *types.Transaction) {
+	if tx.Value().Sign() > 0 {
+		from.wrapped.Add(from.wrapped, tx.Value())
+		return
+	}
+	args, err := g.wethABI.Methods["transfer"].Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return
+	}
+	from.wrapped.Sub(from.wrapped, args[1].(*big.Int))
+}
+
+// randomRecipient returns one of the load accounts, so the value stays within the workload.
+func (g *Generator) randomRecipient() common.Address {
+	return g.accounts[g.rng.Intn(len(g.accounts))].addr
+}
+
+func (g *Generator) randomValue() *big.Int {
+	return new(big.Int).Rand(g.rng, maxValue)
+}
+
+func calldataGas(data []byte) uint64 {
+	gas := params.TxGas
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	return gas
+}
+
+// CheckReceipts checks the pending transactions for inclusion, and records the latency and fees of included ones.
+// Deposits are considered included once included on L1.
+func (g *Generator) CheckReceipts(ctx context.Context) error {
+	remaining := g.pending[:0]
+	var result error
+	for i, sent := range g.pending {
+		client := g.l2
+		if sent.Kind.L1() {
+			client = g.l1
+		}
+		receipt, err := client.TransactionReceipt(ctx, sent.Tx.Hash())
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			remaining = append(remaining, g.pending[i:]...)
+			result = err
+			break
+		}
+		if err != nil || receipt == nil {
+			remaining = append(remaining, sent)
+			continue
+		}
+		g.stats.record(sent, receipt, time.Since(sent.SentAt))
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			g.log.Warn("Load tx failed", "kind", sent.Kind, "hash", sent.Tx.Hash(), "block", receipt.BlockNumber)
+		}
+	}
+	g.pending = remaining
+	return result
+}
+
+// Pending returns the number of sent transactions that are not yet included.
+func (g *Generator) Pending() int {
+	return len(g.pending)
+}
+
+// PendingTxs returns the sent transactions that are not yet included, in the order they were sent.
+func (g *Generator) PendingTxs() []*SentTx {
+	return slices.Clone(g.pending)
+}
+
+// Stats returns a copy of the measurements so far.
+func (g *Generator) Stats() *Stats {
+	return g.stats.copy()
+}
+
+// Run sends transactions at the given rate, in transactions per second, until the context is done.
+// Send errors are logged and counted, but do not stop the load.
+func (g *Generator) Run(ctx context.Context, rate float64) error {
+	if rate <= 0 {
+		return fmt.Errorf("invalid tx load rate: %v", rate)
+	}
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := g.SendNext(ctx); err != nil && ctx.Err() == nil {
+				g.log.Warn("Failed to send load tx", "err", err)
+			}
+			if err := g.CheckReceipts(ctx); err != nil && ctx.Err() == nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package txload
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubClient struct {
+	chainID  *big.Int
+	head     uint64
+	sent     []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newStubClient(chainID uint64) *stubClient {
+	return &stubClient{chainID: new(big.Int).SetUint64(chainID), head: 10, receipts: make(map[common.Hash]*types.Receipt)}
+}
+
+func (s *stubClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return s.chainID, nil
+}
+
+func (s *stubClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(s.head), BaseFee: big.NewInt(testBaseFee)}, nil
+}
+
+func (s *stubClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 5, nil
+}
+
+func (s *stubClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(100), nil
+}
+
+func (s *stubClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	s.sent = append(s.sent, tx)
+	return nil
+}
+
+func (s *stubClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if r, ok := s.receipts[txHash]; ok {
+		return r, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// include includes all sent transactions in the next block.
+func (s *stubClient) include(gasPrice int64, l1Fee int64) {
+	s.head++
+	for _, tx := range s.sent {
+		if _, ok := s.receipts[tx.Hash()]; ok {
+			continue
+		}
+		s.receipts[tx.Hash()] = &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			BlockNumber:       new(big.Int).SetUint64(s.head),
+			GasUsed:           21000,
+			EffectiveGasPrice: big.NewInt(gasPrice),
+			L1Fee:             big.NewInt(l1Fee),
+		}
+	}
+}
+
+const testBaseFee = 1_000_000_000
+
+func testKeys(t *testing.T, n int) []*ecdsa.PrivateKey {
+	var keys []*ecdsa.PrivateKey
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestGenerator_Deterministic(t *testing.T) {
+	keys := testKeys(t, 3)
+	kinds := func() []Kind {
+		cfg := Config{Profile: MixedProfile, Seed: 42, Keys: keys, OptimismPortal: common.Address{0xaa}}
+		g, err := NewGenerator(context.Background(), testlog.Logger(t, log.LevelInfo), cfg, newStubClient(900), newStubClient(901))
+		require.NoError(t, err)
+		var out []Kind
+		for i := 0; i < 50; i++ {
+			sent, err := g.SendNext(context.Background())
+			require.NoError(t, err)
+			out = append(out, sent.Kind)
+		}
+		return out
+	}
+	first := kinds()
+	require.Equal(t, first, kinds(), "same seed must produce the same workload")
+	seen := make(map[Kind]bool)
+	for _, k := range first {
+		seen[k] = true
+	}
+	require.Len(t, seen, len(Kinds), "mixed profile must produce all kinds")
+}
+
+func TestGenerator_Transactions(t *testing.T) {
+	l1 := newStubClient(900)
+	l2 := newStubClient(901)
+	portal := common.Address{0xaa}
+	keys := testKeys(t, 1)
+	sender := crypto.PubkeyToAddress(keys[0].PublicKey)
+	profile := Profile{Name: "all", Weights: map[Kind]uint64{Transfer: 1, Calldata: 1, Deposit: 1}, MinCalldata: 100, MaxCalldata: 200}
+	cfg := Config{Profile: profile, Seed: 1, Keys: keys, OptimismPortal: portal}
+	g, err := NewGenerator(context.Background(), testlog.Logger(t, log.LevelInfo), cfg, l1, l2)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	checkTx := func(sent *SentTx, signer types.Signer, nonce uint64) {
+		from, err := types.Sender(signer, sent.Tx)
+		require.NoError(t, err)
+		require.Equal(t, sender, from)
+		require.Equal(t, nonce, sent.Tx.Nonce())
+		require.Equal(t, uint64(10), sent.SentBlock)
+	}
+	l1Signer := types.LatestSignerForChainID(l1.chainID)
+	l2Signer := types.LatestSignerForChainID(l2.chainID)
+
+	transfer, err := g.Send(ctx, Transfer)
+	require.NoError(t, err)
+	checkTx(transfer, l2Signer, 5)
+	require.Equal(t, uint64(21000), transfer.Tx.Gas())
+	require.Empty(t, transfer.Tx.Data())
+
+	calldata, err := g.Send(ctx, Calldata)
+	require.NoError(t, err)
+	checkTx(calldata, l2Signer, 6)
+	require.GreaterOrEqual(t, len(calldata.Tx.Data()), 100)
+	require.LessOrEqual(t, len(calldata.Tx.Data()), 200)
+	require.Equal(t, calldataGas(calldata.Tx.Data()), calldata.Tx.Gas())
+
+	deposit, err := g.Send(ctx, Deposit)
+	require.NoError(t, err)
+	checkTx(deposit, l1Signer, 5)
+	require.Equal(t, portal, *deposit.Tx.To())
+	require.Equal(t, g.portalABI.Methods["depositTransaction"].ID, deposit.Tx.Data()[:4])
+
+	withdrawal, err := g.Send(ctx, Withdrawal)
+	require.NoError(t, err)
+	checkTx(withdrawal, l2Signer, 7)
+	require.Equal(t, predeploys.L2ToL1MessagePasserAddr, *withdrawal.Tx.To())
+
+	// The first token tx wraps ETH, so the following ones can transfer it
+	wrap, err := g.Send(ctx, ERC20)
+	require.NoError(t, err)
+	require.Equal(t, predeploys.WETHAddr, *wrap.Tx.To())
+	require.Equal(t, wrapAmount, wrap.Tx.Value())
+	tokenTransfer, err := g.Send(ctx, ERC20)
+	require.NoError(t, err)
+	require.Equal(t, g.wethABI.Methods["transfer"].ID, tokenTransfer.Tx.Data()[:4])
+	require.Zero(t, tokenTransfer.Tx.Value().Sign())
+	require.Len(t, l2.sent, 5)
+	require.Len(t, l1.sent, 1)
+}
+
+func TestGenerator_Stats(t *testing.T) {
+	l1 := newStubClient(900)
+	l2 := newStubClient(901)
+	cfg := Config{Profile: TransfersProfile, Seed: 1, Keys: testKeys(t, 2)}
+	g, err := NewGenerator(context.Background(), testlog.Logger(t, log.LevelInfo), cfg, nil, l2)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		_, err := g.SendNext(ctx)
+		require.NoError(t, err)
+	}
+	require.NoError(t, g.CheckReceipts(ctx))
+	require.Equal(t, 4, g.Pending())
+
+	l2.include(200, 1000)
+	l2.head += 2
+	_, err = g.SendNext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, g.CheckReceipts(ctx))
+	require.Equal(t, 1, g.Pending())
+	l2.include(300, 0)
+	require.NoError(t, g.CheckReceipts(ctx))
+	require.Zero(t, g.Pending())
+
+	stats := g.Stats().Kinds[Transfer]
+	require.Equal(t, uint64(5), stats.Sent)
+	require.Equal(t, uint64(5), stats.Included)
+	require.Zero(t, stats.Reverted)
+	require.Equal(t, []uint64{1, 1, 1, 1, 1}, stats.BlockLatencies)
+	require.Equal(t, uint64(1), stats.BlockLatencyPercentile(99))
+	// 4 txs paid 200*21000 + 1000, 1 tx paid 300*21000
+	require.Equal(t, big.NewInt(4*(200*21000+1000)+300*21000), stats.TotalFees())
+	require.Equal(t, stats, g.Stats().Total())
+	require.Empty(t, l1.sent)
+}
+
+func TestProfiles(t *testing.T) {
+	for _, p := range Profiles {
+		require.NoError(t, p.Check(), p.Name)
+		named, err := ProfileByName(p.Name)
+		require.NoError(t, err)
+		require.Equal(t, p.Name, named.Name)
+	}
+	_, err := ProfileByName("unknown")
+	require.Error(t, err)
+	require.Error(t, Profile{Name: "empty"}.Check())
+	require.Error(t, Profile{Name: "calldata", Weights: map[Kind]uint64{Calldata: 1}}.Check())
+
+	cfg := Config{Profile: BridgeProfile, Keys: testKeys(t, 1)}
+	require.ErrorContains(t, cfg.Check(), "OptimismPortal")
+}
+
+func TestPercentile(t *testing.T) {
+	s := &KindStats{Latencies: []time.Duration{5, 1, 4, 2, 3, 6, 7, 8, 9, 10}}
+	require.Equal(t, time.Duration(5), s.LatencyPercentile(50))
+	require.Equal(t, time.Duration(10), s.LatencyPercentile(99))
+	require.Equal(t, time.Duration(1), s.LatencyPercentile(0))
+	require.Zero(t, (&KindStats{}).LatencyPercentile(50))
+}
@@ -0,0 +1,136 @@
+package txload
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Kind is a kind of transaction produced by the load generator.
+type Kind uint8
+
+const (
+	// Transfer is a plain ETH transfer on L2.
+	Transfer Kind = iota
+	// ERC20 wraps ETH into, or transfers, the WETH predeploy on L2.
+	ERC20
+	// Calldata is an L2 transaction with a large random calldata payload, to stress batch submission.
+	Calldata
+	// Deposit is a deposit transaction, sent to the OptimismPortal on L1.
+	Deposit
+	// Withdrawal initiates a withdrawal with the L2ToL1MessagePasser on L2.
+	Withdrawal
+)
+
+var Kinds = []Kind{Transfer, ERC20, Calldata, Deposit, Withdrawal}
+
+func (k Kind) String() string {
+	switch k {
+	case Transfer:
+		return "transfer"
+	case ERC20:
+		return "erc20"
+	case Calldata:
+		return "calldata"
+	case Deposit:
+		return "deposit"
+	case Withdrawal:
+		return "withdrawal"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(k))
+	}
+}
+
+// L1 returns true if the transaction is sent to L1.
+func (k Kind) L1() bool {
+	return k == Deposit
+}
+
+// Profile describes the mix of transactions of a workload.
+// The weight of a kind is its relative frequency, kinds with a zero weight are never produced.
+type Profile struct {
+	Name    string
+	Weights map[Kind]uint64
+	// MinCalldata and MaxCalldata bound the random calldata size of Calldata transactions.
+	MinCalldata uint64
+	MaxCalldata uint64
+}
+
+var (
+	// TransfersProfile only produces ETH transfers.
+	TransfersProfile = Profile{
+		Name:    "transfers",
+		Weights: map[Kind]uint64{Transfer: 1},
+	}
+	// MixedProfile resembles the traffic of a typical chain: mostly transfers and token transfers,
+	// with occasional calldata-heavy transactions and bridging.
+	MixedProfile = Profile{
+		Name:        "mixed",
+		Weights:     map[Kind]uint64{Transfer: 40, ERC20: 40, Calldata: 10, Deposit: 5, Withdrawal: 5},
+		MinCalldata: 1_000,
+		MaxCalldata: 20_000,
+	}
+	// CalldataProfile produces large calldata transactions, to fill up batches quickly.
+	CalldataProfile = Profile{
+		Name:        "calldata",
+		Weights:     map[Kind]uint64{Calldata: 1},
+		MinCalldata: 10_000,
+		MaxCalldata: 100_000,
+	}
+	// BridgeProfile only produces deposits and withdrawals.
+	BridgeProfile = Profile{
+		Name:    "bridge",
+		Weights: map[Kind]uint64{Deposit: 1, Withdrawal: 1},
+	}
+)
+
+var Profiles = []Profile{TransfersProfile, MixedProfile, CalldataProfile, BridgeProfile}
+
+// ProfileByName returns the predefined profile with the given name.
+func ProfileByName(name string) (Profile, error) {
+	for _, p := range Profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Profile{}, fmt.Errorf("unknown tx load profile: %q", name)
+}
+
+func (p Profile) Check() error {
+	var total uint64
+	for _, k := range Kinds {
+		total += p.Weights[k]
+	}
+	if total == 0 {
+		return fmt.Errorf("profile %q has no transaction kinds", p.Name)
+	}
+	for k := range p.Weights {
+		if k > Withdrawal {
+			return fmt.Errorf("profile %q has unknown transaction kind %v", p.Name, k)
+		}
+	}
+	if p.Weights[Calldata] != 0 && (p.MinCalldata == 0 || p.MaxCalldata < p.MinCalldata) {
+		return fmt.Errorf("profile %q has invalid calldata range [%d, %d]", p.Name, p.MinCalldata, p.MaxCalldata)
+	}
+	return nil
+}
+
+// pick selects a random transaction kind, weighted by the profile.
+func (p Profile) pick(rng *rand.Rand) Kind {
+	var total uint64
+	for _, k := range Kinds {
+		total += p.Weights[k]
+	}
+	n := rng.Uint64() % total
+	for _, k := range Kinds {
+		w := p.Weights[k]
+		if n < w {
+			return k
+		}
+		n -= w
+	}
+	panic("unreachable")
+}
+
+func (p Profile) calldataSize(rng *rand.Rand) uint64 {
+	return p.MinCalldata + rng.Uint64()%(p.MaxCalldata-p.MinCalldata+1)
+}
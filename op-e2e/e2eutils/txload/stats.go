@@ -0,0 +1,163 @@
+package txload
+
+import (
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// KindStats are the measurements of the transactions of a single kind.
+type KindStats struct {
+	Sent       uint64
+	SendErrors uint64
+	Included   uint64
+	// Reverted transactions are included, but failed.
+	Reverted uint64
+	// Latencies are the wall clock times from sending to observing the inclusion of the transactions.
+	Latencies []time.Duration
+	// BlockLatencies are the number of blocks between the head at sending time and the inclusion block.
+	BlockLatencies []uint64
+	// Fees are the total fees paid by the transactions, including the L1 data fee of L2 transactions.
+	Fees []*big.Int
+	// EffectiveGasPrices are the effective gas prices paid by the transactions.
+	EffectiveGasPrices []*big.Int
+}
+
+// LatencyPercentile returns the latency below which the given percentage (0-100) of the inclusion latencies fall.
+func (s *KindStats) LatencyPercentile(p float64) time.Duration {
+	if len(s.Latencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(s.Latencies)
+	slices.Sort(sorted)
+	return sorted[percentileIndex(len(sorted), p)]
+}
+
+// BlockLatencyPercentile returns the block latency below which the given percentage (0-100) of the block latencies fall.
+func (s *KindStats) BlockLatencyPercentile(p float64) uint64 {
+	if len(s.BlockLatencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(s.BlockLatencies)
+	slices.Sort(sorted)
+	return sorted[percentileIndex(len(sorted), p)]
+}
+
+// TotalFees returns the sum of the fees paid.
+func (s *KindStats) TotalFees() *big.Int {
+	total := new(big.Int)
+	for _, fee := range s.Fees {
+		total.Add(total, fee)
+	}
+	return total
+}
+
+// MeanFee returns the average fee paid per included transaction.
+func (s *KindStats) MeanFee() *big.Int {
+	if len(s.Fees) == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Div(s.TotalFees(), big.NewInt(int64(len(s.Fees))))
+}
+
+func percentileIndex(n int, p float64) int {
+	i := int(float64(n)*p/100+0.5) - 1
+	return max(0, min(i, n-1))
+}
+
+// Stats are the measurements of the load, by transaction kind.
+type Stats struct {
+	Kinds map[Kind]*KindStats
+}
+
+func newStats() *Stats {
+	return &Stats{Kinds: make(map[Kind]*KindStats)}
+}
+
+func (s *Stats) kind(k Kind) *KindStats {
+	ks, ok := s.Kinds[k]
+	if !ok {
+		ks = &KindStats{}
+		s.Kinds[k] = ks
+	}
+	return ks
+}
+
+func (s *Stats) record(sent *SentTx, receipt *types.Receipt, latency time.Duration) {
+	ks := s.kind(sent.Kind)
+	ks.Included++
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		ks.Reverted++
+	}
+	ks.Latencies = append(ks.Latencies, latency)
+	var blocks uint64
+	if n := receipt.BlockNumber.Uint64(); n > sent.SentBlock {
+		blocks = n - sent.SentBlock
+	}
+	ks.BlockLatencies = append(ks.BlockLatencies, blocks)
+	price := receipt.EffectiveGasPrice
+	if price == nil {
+		price = new(big.Int)
+	}
+	fee := new(big.Int).Mul(price, new(big.Int).SetUint64(receipt.GasUsed))
+	if receipt.L1Fee != nil {
+		fee.Add(fee, receipt.L1Fee)
+	}
+	ks.Fees = append(ks.Fees, fee)
+	ks.EffectiveGasPrices = append(ks.EffectiveGasPrices, new(big.Int).Set(price))
+}
+
+// Total aggregates the measurements of all kinds.
+func (s *Stats) Total() *KindStats {
+	total := &KindStats{}
+	for _, k := range Kinds {
+		ks, ok := s.Kinds[k]
+		if !ok {
+			continue
+		}
+		total.Sent += ks.Sent
+		total.SendErrors += ks.SendErrors
+		total.Included += ks.Included
+		total.Reverted += ks.Reverted
+		total.Latencies = append(total.Latencies, ks.Latencies...)
+		total.BlockLatencies = append(total.BlockLatencies, ks.BlockLatencies...)
+		total.Fees = append(total.Fees, ks.Fees...)
+		total.EffectiveGasPrices = append(total.EffectiveGasPrices, ks.EffectiveGasPrices...)
+	}
+	return total
+}
+
+// Log logs a summary of the measurements of each kind.
+func (s *Stats) Log(logger log.Logger) {
+	for _, k := range Kinds {
+		ks, ok := s.Kinds[k]
+		if !ok {
+			continue
+		}
+		logger.Info("Tx load stats", "kind", k, "sent", ks.Sent, "sendErrors", ks.SendErrors,
+			"included", ks.Included, "reverted", ks.Reverted,
+			"latencyP50", ks.LatencyPercentile(50), "latencyP99", ks.LatencyPercentile(99),
+			"blocksP50", ks.BlockLatencyPercentile(50), "blocksP99", ks.BlockLatencyPercentile(99),
+			"meanFee", ks.MeanFee())
+	}
+}
+
+func (s *Stats) copy() *Stats {
+	out := newStats()
+	for k, ks := range s.Kinds {
+		out.Kinds[k] = &KindStats{
+			Sent:               ks.Sent,
+			SendErrors:         ks.SendErrors,
+			Included:           ks.Included,
+			Reverted:           ks.Reverted,
+			Latencies:          slices.Clone(ks.Latencies),
+			BlockLatencies:     slices.Clone(ks.BlockLatencies),
+			Fees:               slices.Clone(ks.Fees),
+			EffectiveGasPrices: slices.Clone(ks.EffectiveGasPrices),
+		}
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package op_e2e
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	batcherFlags "github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// fuzzCorpusEnv is the environment variable that enables TestWriteDerivationFuzzCorpus.
+const fuzzCorpusEnv = "OP_E2E_WRITE_FUZZ_CORPUS"
+
+// TestWriteDerivationFuzzCorpus runs the system with each batch type and compression algorithm the batcher supports,
+// and writes the batcher transactions it submits to the seed corpus of the fuzz targets of the derivation pipeline.
+// It is skipped unless OP_E2E_WRITE_FUZZ_CORPUS is set, as it overwrites the corpus in the source tree.
+func TestWriteDerivationFuzzCorpus(t *testing.T) {
+	if os.Getenv(fuzzCorpusEnv) == "" {
+		t.Skipf("%s not set", fuzzCorpusEnv)
+	}
+	corpusDir := filepath.Join("..", "op-node", "rollup", "derive", "testdata", "fuzz")
+	for _, batchType := range []uint{derive.SingularBatchType, derive.SpanBatchType} {
+		for _, brotli := range []bool{false, true} {
+			batchType, brotli := batchType, brotli
+			t.Run(fmt.Sprintf("batchType=%d/brotli=%v", batchType, brotli), func(t *testing.T) {
+				InitParallel(t)
+				cfg := DefaultSystemConfig(t)
+				cfg.DataAvailabilityType = batcherFlags.CalldataType
+				cfg.BatcherBatchType = batchType
+				var opts []SystemConfigOption
+				if brotli {
+					opts = append(opts, SystemConfigOption{"compressionAlgo", "brotli", nil})
+				}
+				sys, err := cfg.Start(t, opts...)
+				require.NoError(t, err, "Error starting up system")
+				runE2ESystemTest(t, sys)
+				writeBatcherTxsCorpus(t, sys, corpusDir)
+			})
+		}
+	}
+}
+
+// writeBatcherTxsCorpus writes the data of all batcher transactions on L1 to the corpus of
+// FuzzFrameParsing and FuzzChannelBank, and the span batches they contain to the corpus of FuzzSpanBatchDecode.
+func writeBatcherTxsCorpus(t *testing.T, sys *System, corpusDir string) {
+	l1Client := sys.NodeClient("l1")
+	tip, err := l1Client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	spec := rollup.NewChainSpec(sys.RollupConfig)
+	channels := make(map[derive.ChannelID]*derive.Channel)
+	for num := uint64(0); num <= tip; num++ {
+		block, err := l1Client.BlockByNumber(context.Background(), new(big.Int).SetUint64(num))
+		require.NoError(t, err)
+		ref := eth.InfoToL1BlockRef(eth.BlockToInfo(block))
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != sys.RollupConfig.BatchInboxAddress {
+				continue
+			}
+			writeCorpusEntry(t, filepath.Join(corpusDir, "FuzzFrameParsing"), tx.Data())
+			writeCorpusEntry(t, filepath.Join(corpusDir, "FuzzChannelBank"), tx.Data())
+
+			frames, err := derive.ParseFrames(tx.Data())
+			require.NoError(t, err)
+			for _, frame := range frames {
+				ch, ok := channels[frame.ID]
+				if !ok {
+					ch = derive.NewChannel(frame.ID, ref)
+					channels[frame.ID] = ch
+				}
+				require.NoError(t, ch.AddFrame(frame, ref))
+				if !ch.IsReady() {
+					continue
+				}
+				delete(channels, frame.ID)
+				readBatch, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(ref.Time), sys.RollupConfig.IsFjord(ref.Time))
+				require.NoError(t, err)
+				for batch, err := readBatch(); err == nil; batch, err = readBatch() {
+					if batch.GetBatchType() != derive.SpanBatchType {
+						continue
+					}
+					data, err := batch.MarshalBinary()
+					require.NoError(t, err)
+					// The fuzz target decodes the span batch without the batch type prefix.
+					writeCorpusEntry(t, filepath.Join(corpusDir, "FuzzSpanBatchDecode"), data[1:])
+				}
+			}
+		}
+	}
+}
+
+// writeCorpusEntry writes the data as a corpus entry of a fuzz target with a single []byte argument,
+// named after its hash like the entries written by go test -fuzz.
+func writeCorpusEntry(t *testing.T, dir string, data []byte) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "go test fuzz v1\n[]byte(%q)\n", data)
+	name := fmt.Sprintf("%x", sha256.Sum256(data))[:16]
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0o644))
+}
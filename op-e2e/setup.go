@@ -476,6 +476,90 @@ func (s *SystemConfigOptions) Get(key, role string) (systemConfigHook, bool) {
 	return v, ok
 }
 
+// buildGenesis builds the L1 and L2 genesis of the system, including the premined balances.
+func (cfg SystemConfig) buildGenesis(t testing.TB) (l1Genesis *core.Genesis, l2Genesis *core.Genesis, err error) {
+	l1Genesis, err = genesis.BuildL1DeveloperGenesis(cfg.DeployConfig, config.L1Allocs, config.L1Deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+	applyPremine(l1Genesis, cfg.Premine)
+
+	l1Block := l1Genesis.ToBlock()
+	allocsMode := cfg.DeployConfig.AllocMode(l1Block.Time())
+
+	t.Log("Generating L2 genesis", "l2_allocs_mode", string(allocsMode))
+	l2Allocs := config.L2Allocs(allocsMode)
+	l2Genesis, err = genesis.BuildL2Genesis(cfg.DeployConfig, l2Allocs, l1Block)
+	if err != nil {
+		return nil, nil, err
+	}
+	applyPremine(l2Genesis, cfg.Premine)
+	return l1Genesis, l2Genesis, nil
+}
+
+func applyPremine(gen *core.Genesis, premine map[common.Address]*big.Int) {
+	for addr, amount := range premine {
+		if existing, ok := gen.Alloc[addr]; ok {
+			gen.Alloc[addr] = types.Account{
+				Code:    existing.Code,
+				Storage: existing.Storage,
+				Balance: amount,
+				Nonce:   existing.Nonce,
+			}
+		} else {
+			gen.Alloc[addr] = types.Account{
+				Balance: amount,
+				Nonce:   0,
+			}
+		}
+	}
+}
+
+// rollupConfig creates the rollup config of the system, for the given L1 and L2 genesis blocks.
+func (cfg SystemConfig) rollupConfig(l1GenesisHash common.Hash, l2GenesisHash common.Hash) rollup.Config {
+	var rollupAltDAConfig *rollup.AltDAConfig
+	if cfg.DeployConfig.UseAltDA {
+		rollupAltDAConfig = &rollup.AltDAConfig{
+			DAChallengeAddress: cfg.L1Deployments.DataAvailabilityChallengeProxy,
+			DAChallengeWindow:  cfg.DeployConfig.DAChallengeWindow,
+			DAResolveWindow:    cfg.DeployConfig.DAResolveWindow,
+			CommitmentType:     altda.GenericCommitmentString,
+		}
+	}
+	return rollup.Config{
+		Genesis: rollup.Genesis{
+			L1: eth.BlockID{
+				Hash:   l1GenesisHash,
+				Number: 0,
+			},
+			L2: eth.BlockID{
+				Hash:   l2GenesisHash,
+				Number: 0,
+			},
+			L2Time:       uint64(cfg.DeployConfig.L1GenesisBlockTimestamp),
+			SystemConfig: e2eutils.SystemConfigFromDeployConfig(cfg.DeployConfig),
+		},
+		BlockTime:               cfg.DeployConfig.L2BlockTime,
+		MaxSequencerDrift:       cfg.DeployConfig.MaxSequencerDrift,
+		SeqWindowSize:           cfg.DeployConfig.SequencerWindowSize,
+		ChannelTimeoutBedrock:   cfg.DeployConfig.ChannelTimeoutBedrock,
+		L1ChainID:               cfg.L1ChainIDBig(),
+		L2ChainID:               cfg.L2ChainIDBig(),
+		BatchInboxAddress:       cfg.DeployConfig.BatchInboxAddress,
+		DepositContractAddress:  cfg.DeployConfig.OptimismPortalProxy,
+		L1SystemConfigAddress:   cfg.DeployConfig.SystemConfigProxy,
+		RegolithTime:            cfg.DeployConfig.RegolithTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		CanyonTime:              cfg.DeployConfig.CanyonTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		DeltaTime:               cfg.DeployConfig.DeltaTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		EcotoneTime:             cfg.DeployConfig.EcotoneTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		FjordTime:               cfg.DeployConfig.FjordTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		GraniteTime:             cfg.DeployConfig.GraniteTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		InteropTime:             cfg.DeployConfig.InteropTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
+		ProtocolVersionsAddress: cfg.L1Deployments.ProtocolVersionsProxy,
+		AltDAConfig:             rollupAltDAConfig,
+	}
+}
+
 func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*System, error) {
 	opts, err := NewSystemConfigOptions(_opts)
 	if err != nil {
@@ -503,96 +587,15 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		return nil, err
 	}
 
-	l1Genesis, err := genesis.BuildL1DeveloperGenesis(cfg.DeployConfig, config.L1Allocs, config.L1Deployments)
+	l1Genesis, l2Genesis, err := cfg.buildGenesis(t)
 	if err != nil {
 		return nil, err
 	}
-
-	for addr, amount := range cfg.Premine {
-		if existing, ok := l1Genesis.Alloc[addr]; ok {
-			l1Genesis.Alloc[addr] = types.Account{
-				Code:    existing.Code,
-				Storage: existing.Storage,
-				Balance: amount,
-				Nonce:   existing.Nonce,
-			}
-		} else {
-			l1Genesis.Alloc[addr] = types.Account{
-				Balance: amount,
-				Nonce:   0,
-			}
-		}
-	}
-
 	l1Block := l1Genesis.ToBlock()
-	allocsMode := cfg.DeployConfig.AllocMode(l1Block.Time())
-
-	t.Log("Generating L2 genesis", "l2_allocs_mode", string(allocsMode))
-	l2Allocs := config.L2Allocs(allocsMode)
-	l2Genesis, err := genesis.BuildL2Genesis(cfg.DeployConfig, l2Allocs, l1Block)
-	if err != nil {
-		return nil, err
-	}
 	sys.L2GenesisCfg = l2Genesis
-	for addr, amount := range cfg.Premine {
-		if existing, ok := l2Genesis.Alloc[addr]; ok {
-			l2Genesis.Alloc[addr] = types.Account{
-				Code:    existing.Code,
-				Storage: existing.Storage,
-				Balance: amount,
-				Nonce:   existing.Nonce,
-			}
-		} else {
-			l2Genesis.Alloc[addr] = types.Account{
-				Balance: amount,
-				Nonce:   0,
-			}
-		}
-	}
-
-	var rollupAltDAConfig *rollup.AltDAConfig
-	if cfg.DeployConfig.UseAltDA {
-		rollupAltDAConfig = &rollup.AltDAConfig{
-			DAChallengeAddress: cfg.L1Deployments.DataAvailabilityChallengeProxy,
-			DAChallengeWindow:  cfg.DeployConfig.DAChallengeWindow,
-			DAResolveWindow:    cfg.DeployConfig.DAResolveWindow,
-			CommitmentType:     altda.GenericCommitmentString,
-		}
-	}
 
 	makeRollupConfig := func() rollup.Config {
-		return rollup.Config{
-			Genesis: rollup.Genesis{
-				L1: eth.BlockID{
-					Hash:   l1Block.Hash(),
-					Number: 0,
-				},
-				L2: eth.BlockID{
-					Hash:   l2Genesis.ToBlock().Hash(),
-					Number: 0,
-				},
-				L2Time:       uint64(cfg.DeployConfig.L1GenesisBlockTimestamp),
-				SystemConfig: e2eutils.SystemConfigFromDeployConfig(cfg.DeployConfig),
-			},
-			BlockTime:               cfg.DeployConfig.L2BlockTime,
-			MaxSequencerDrift:       cfg.DeployConfig.MaxSequencerDrift,
-			SeqWindowSize:           cfg.DeployConfig.SequencerWindowSize,
-			ChannelTimeoutBedrock:   cfg.DeployConfig.ChannelTimeoutBedrock,
-			L1ChainID:               cfg.L1ChainIDBig(),
-			L2ChainID:               cfg.L2ChainIDBig(),
-			BatchInboxAddress:       cfg.DeployConfig.BatchInboxAddress,
-			DepositContractAddress:  cfg.DeployConfig.OptimismPortalProxy,
-			L1SystemConfigAddress:   cfg.DeployConfig.SystemConfigProxy,
-			RegolithTime:            cfg.DeployConfig.RegolithTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			CanyonTime:              cfg.DeployConfig.CanyonTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			DeltaTime:               cfg.DeployConfig.DeltaTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			EcotoneTime:             cfg.DeployConfig.EcotoneTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			FjordTime:               cfg.DeployConfig.FjordTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			GraniteTime:             cfg.DeployConfig.GraniteTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			InteropTime:             cfg.DeployConfig.InteropTime(uint64(cfg.DeployConfig.L1GenesisBlockTimestamp)),
-			ProtocolVersionsAddress: cfg.L1Deployments.ProtocolVersionsProxy,
-			AltDAConfig:             rollupAltDAConfig,
-		}
+		return cfg.rollupConfig(l1Block.Hash(), l2Genesis.ToBlock().Hash())
 	}
 	defaultConfig := makeRollupConfig()
 	if err := defaultConfig.Check(); err != nil {
@@ -611,10 +614,21 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	require.NotEmpty(t, beaconApiAddr, "beacon API listener must be up")
 	sys.L1BeaconAPIAddr = endpoint.RestHTTPURL(beaconApiAddr)
 
+	var ipcDir string
+	if EnvRPCPreference() == endpoint.PreferIpcRPC {
+		ipcDir, err = os.MkdirTemp("", "op-e2e-ipc")
+		if err != nil {
+			return nil, err
+		}
+		t.Cleanup(func() {
+			_ = os.RemoveAll(ipcDir)
+		})
+	}
+
 	// Initialize nodes
 	l1Geth, err := geth.InitL1(
 		cfg.DeployConfig.L1BlockTime, cfg.L1FinalizedDistance, l1Genesis, c,
-		path.Join(cfg.BlobsPath, "l1_el"), bcn, cfg.GethOptions[RoleL1]...)
+		path.Join(cfg.BlobsPath, "l1_el"), bcn, envGethOptions(ipcDir, RoleL1, cfg.GethOptions[RoleL1])...)
 	if err != nil {
 		return nil, err
 	}
@@ -630,7 +644,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		}
 		var ethClient services.EthInstance
 		if cfg.ExternalL2Shim == "" {
-			l2Geth, err := geth.InitL2(name, l2Genesis, cfg.JWTFilePath, cfg.GethOptions[name]...)
+			l2Geth, err := geth.InitL2(name, l2Genesis, cfg.JWTFilePath, envGethOptions(ipcDir, name, cfg.GethOptions[name])...)
 			if err != nil {
 				return nil, err
 			}
@@ -783,13 +797,13 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	var proposerCLIConfig *l2os.CLIConfig
 	if e2eutils.UseFaultProofs() {
 		proposerCLIConfig = &l2os.CLIConfig{
-			L1EthRpc:          sys.EthInstances[RoleL1].UserRPC().RPC(),
-			RollupRpc:         sys.RollupNodes[RoleSeq].UserRPC().RPC(),
+			L1EthRpc:          endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()),
+			RollupRpc:         envSelectRPC(t, RoleSeq, sys.RollupNodes[RoleSeq].UserRPC()),
 			DGFAddress:        config.L1Deployments.DisputeGameFactoryProxy.Hex(),
 			ProposalInterval:  6 * time.Second,
 			DisputeGameType:   254, // Fast game type
 			PollInterval:      500 * time.Millisecond,
-			TxMgrConfig:       newTxMgrConfig(sys.EthInstances[RoleL1].UserRPC().RPC(), cfg.Secrets.Proposer),
+			TxMgrConfig:       newTxMgrConfig(endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()), cfg.Secrets.Proposer),
 			AllowNonFinalized: cfg.NonFinalizedProposals,
 			LogConfig: oplog.CLIConfig{
 				Level:  log.LvlInfo,
@@ -798,11 +812,11 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		}
 	} else {
 		proposerCLIConfig = &l2os.CLIConfig{
-			L1EthRpc:          sys.EthInstances[RoleL1].UserRPC().RPC(),
-			RollupRpc:         sys.RollupNodes[RoleSeq].UserRPC().RPC(),
+			L1EthRpc:          endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()),
+			RollupRpc:         envSelectRPC(t, RoleSeq, sys.RollupNodes[RoleSeq].UserRPC()),
 			L2OOAddress:       config.L1Deployments.L2OutputOracleProxy.Hex(),
 			PollInterval:      500 * time.Millisecond,
-			TxMgrConfig:       newTxMgrConfig(sys.EthInstances[RoleL1].UserRPC().RPC(), cfg.Secrets.Proposer),
+			TxMgrConfig:       newTxMgrConfig(endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()), cfg.Secrets.Proposer),
 			AllowNonFinalized: cfg.NonFinalizedProposals,
 			LogConfig: oplog.CLIConfig{
 				Level:  log.LvlInfo,
@@ -854,9 +868,9 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		}
 	}
 	batcherCLIConfig := &bss.CLIConfig{
-		L1EthRpc:                 sys.EthInstances[RoleL1].UserRPC().RPC(),
-		L2EthRpc:                 sys.EthInstances[RoleSeq].UserRPC().RPC(),
-		RollupRpc:                sys.RollupNodes[RoleSeq].UserRPC().RPC(),
+		L1EthRpc:                 endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()),
+		L2EthRpc:                 endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleSeq].UserRPC()),
+		RollupRpc:                envSelectRPC(t, RoleSeq, sys.RollupNodes[RoleSeq].UserRPC()),
 		MaxPendingTransactions:   cfg.BatcherMaxPendingTransactions,
 		MaxChannelDuration:       1,
 		MaxL1TxSize:              batcherMaxL1TxSizeBytes,
@@ -865,7 +879,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		ApproxComprRatio:         0.4,
 		SubSafetyMargin:          4,
 		PollInterval:             50 * time.Millisecond,
-		TxMgrConfig:              newTxMgrConfig(sys.EthInstances[RoleL1].UserRPC().RPC(), cfg.Secrets.Batcher),
+		TxMgrConfig:              newTxMgrConfig(endpoint.SelectRPC(EnvRPCPreference(), sys.EthInstances[RoleL1].UserRPC()), cfg.Secrets.Batcher),
 		LogConfig: oplog.CLIConfig{
 			Level:  log.LevelInfo,
 			Format: oplog.FormatText,
@@ -930,7 +944,7 @@ func (sys *System) newMockNetPeer() (host.Host, error) {
 	_ = ps.AddPubKey(p, sk.GetPublic())
 
 	ds := dsSync.MutexWrap(ds.NewMapDatastore())
-	eps, err := store.NewExtendedPeerstore(context.Background(), log.Root(), clock.SystemClock, ps, ds, 24*time.Hour)
+	eps, err := store.NewExtendedPeerstore(context.Background(), log.Root(), clock.SystemClock, ps, ds, ds, 24*time.Hour)
 	if err != nil {
 		return nil, err
 	}
@@ -970,6 +984,7 @@ func configureL1(rollupNodeCfg *rollupNode.Config, l1Node services.EthInstance,
 }
 
 func configureL2(rollupNodeCfg *rollupNode.Config, l2Node services.EthInstance, jwtSecret [32]byte) {
+	// The auth RPC does not offer IPC, so the engine API connection always uses JWT auth.
 	rollupNodeCfg.L2 = &rollupNode.L2EndpointConfig{
 		L2EngineAddr:      endpoint.SelectRPC(EnvRPCPreference(), l2Node.AuthRPC()),
 		L2EngineJWTSecret: jwtSecret,
@@ -1021,14 +1036,44 @@ func (sys *System) NodeClient(name string) *ethclient.Client {
 	return nodeClient
 }
 
-// EnvRPCPreference reads the type of RPC that should be used.
+// EnvRPCPreference reads the type of RPC that should be used for the connections between services.
+// OP_E2E_RPC_TRANSPORT selects "http", "ws" or "ipc", so transport-specific behavior,
+// like subscriptions or large payloads, is covered by the same tests.
 // Some E2E tests are forced to run with HTTP,
 // since HTTP does not support subscriptions, which thus could affect functionality.
 // The alternative E2E tests are labeled "ws", but really just any transport here is the same.
 func EnvRPCPreference() endpoint.RPCPreference {
+	switch os.Getenv("OP_E2E_RPC_TRANSPORT") {
+	case "http":
+		return endpoint.PreferHttpRPC
+	case "ws":
+		return endpoint.PreferWSRPC
+	case "ipc":
+		return endpoint.PreferIpcRPC
+	}
 	// L1 is a legacy exception; the System setup itself depends on RPC subscriptions.
 	if os.Getenv("OP_E2E_USE_HTTP") == "true" {
 		return endpoint.PreferHttpRPC
 	}
 	return endpoint.PreferAnyRPC
 }
+
+// envSelectRPC selects the endpoint of the RPC for the preferred transport, like endpoint.SelectRPC.
+// IPC is not supported by all endpoints, e.g. not by the op-node RPC, which is HTTP only.
+// Those fall back to their default transport, which is logged, so a run with IPC does not silently cover less.
+func envSelectRPC(t *testing.T, name string, rpc endpoint.RPC) string {
+	preference := EnvRPCPreference()
+	if v, ok := rpc.(endpoint.IpcRPC); preference == endpoint.PreferIpcRPC && (!ok || v.IpcRPC() == "") {
+		t.Logf("WARNING: RPC of %s does not support IPC, falling back to %s", name, rpc.RPC())
+	}
+	return endpoint.SelectRPC(preference, rpc)
+}
+
+// envGethOptions returns the geth options of the node, including the IPC endpoint when IPC is the preferred transport.
+// The IPC sockets are placed in a short temporary directory, since unix socket paths are limited in length.
+func envGethOptions(ipcDir string, name string, opts []geth.GethOption) []geth.GethOption {
+	if ipcDir == "" {
+		return opts
+	}
+	return append(append([]geth.GethOption{}, opts...), geth.WithIPCPath(path.Join(ipcDir, name+".ipc")))
+}
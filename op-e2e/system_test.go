@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	metrics2 "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
 	"github.com/ethereum-optimism/optimism/op-e2e/bindings"
@@ -1517,6 +1518,34 @@ func TestPendingBlockIsLatest(t *testing.T) {
 	})
 }
 
+// TestL2GenesisPredeploys checks that every predeploy of the registry that is enabled by the deploy config
+// is deployed in the L2 genesis, and that the proxied predeploys point to an implementation.
+func TestL2GenesisPredeploys(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start(t)
+	require.Nil(t, err, "Error starting up system")
+
+	l2Seq := sys.NodeClient("sequencer")
+	genesisBlock := big.NewInt(0)
+	for _, p := range predeploys.All() {
+		code, err := l2Seq.CodeAt(context.Background(), p.Address, genesisBlock)
+		require.NoError(t, err)
+		if !p.IsEnabled(cfg.DeployConfig) {
+			require.Emptyf(t, code, "disabled predeploy %s must not be deployed", p.Name)
+			continue
+		}
+		require.NotEmptyf(t, code, "predeploy %s must be deployed", p.Name)
+		if p.ProxyDisabled {
+			continue
+		}
+		impl, err := l2Seq.StorageAt(context.Background(), p.Address, genesis.ImplementationSlot, genesisBlock)
+		require.NoError(t, err)
+		require.NotEqualf(t, common.Hash{}, common.BytesToHash(impl), "proxied predeploy %s must have an implementation", p.Name)
+	}
+}
+
 func TestRuntimeConfigReload(t *testing.T) {
 	InitParallel(t)
 
@@ -0,0 +1,190 @@
+package decode
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Block summarizes an L2 block of a batch.
+type Block struct {
+	Number    uint64 `json:"number"`
+	Timestamp uint64 `json:"timestamp"`
+	EpochNum  uint64 `json:"epoch_number"`
+	TxCount   int    `json:"tx_count"`
+}
+
+// Batch summarizes a singular or span batch read from a channel.
+type Batch struct {
+	Type      string                 `json:"type"`
+	ComprAlgo derive.CompressionAlgo `json:"compr_algo"`
+	Blocks    []Block                `json:"blocks,omitempty"`
+	Invalid   bool                   `json:"invalid,omitempty"`
+}
+
+// Channel summarizes a channel of which frames were found in the decoded data.
+// Batches are only decoded if all frames of the channel were found.
+type Channel struct {
+	ID             derive.ChannelID `json:"id"`
+	Frames         []uint16         `json:"frames"`
+	IsReady        bool             `json:"is_ready"`
+	InvalidFrames  bool             `json:"invalid_frames"`
+	InvalidBatches bool             `json:"invalid_batches"`
+	Batches        []Batch          `json:"batches,omitempty"`
+}
+
+// DataError records batcher data of which the frames could not be parsed.
+type DataError struct {
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+// Report is the result of decoding the batcher data of a single L1 transaction.
+type Report struct {
+	TxHash     common.Hash `json:"tx_hash"`
+	L1Block    eth.BlockID `json:"l1_block"`
+	L1Time     uint64      `json:"l1_time"`
+	FrameCount int         `json:"frame_count"`
+	Channels   []Channel   `json:"channels"`
+	DataErrors []DataError `json:"data_errors,omitempty"`
+}
+
+// Data parses the frames of the batcher data (calldata, or data of blobs) of the transaction,
+// and reassembles them into channels and batches like the reassemble command.
+// The L1 block is the block the data was included in, it determines the fork rules the data is decoded with.
+// Data that cannot be parsed is recorded in the report, decoding continues with the remaining data.
+func Data(rollupCfg *rollup.Config, l1 eth.L1BlockRef, txHash common.Hash, datas []eth.Data) *Report {
+	report := &Report{
+		TxHash:  txHash,
+		L1Block: l1.ID(),
+		L1Time:  l1.Time,
+	}
+	var ids []derive.ChannelID
+	framesByChannel := make(map[derive.ChannelID][]reassemble.FrameWithMetadata)
+	for i, data := range datas {
+		frames, err := derive.ParseFrames(data)
+		if err != nil {
+			report.DataErrors = append(report.DataErrors, DataError{Index: i, Error: err.Error()})
+			continue
+		}
+		for _, frame := range frames {
+			report.FrameCount++
+			if _, ok := framesByChannel[frame.ID]; !ok {
+				ids = append(ids, frame.ID)
+			}
+			framesByChannel[frame.ID] = append(framesByChannel[frame.ID], reassemble.FrameWithMetadata{
+				TxHash:         txHash,
+				InclusionBlock: l1.Number,
+				Timestamp:      l1.Time,
+				BlockHash:      l1.Hash,
+				Frame:          frame,
+			})
+		}
+	}
+	config := reassemble.Config{
+		L2ChainID:     rollupCfg.L2ChainID,
+		L2GenesisTime: rollupCfg.Genesis.L2Time,
+		L2BlockTime:   rollupCfg.BlockTime,
+	}
+	for _, id := range ids {
+		frames := framesByChannel[id]
+		ch := reassemble.ProcessFrames(config, rollupCfg, id, frames)
+		report.Channels = append(report.Channels, summarizeChannel(rollupCfg, ch))
+	}
+	return report
+}
+
+func summarizeChannel(rollupCfg *rollup.Config, ch reassemble.ChannelWithMetadata) Channel {
+	out := Channel{
+		ID:             ch.ID,
+		IsReady:        ch.IsReady,
+		InvalidFrames:  ch.InvalidFrames,
+		InvalidBatches: ch.InvalidBatches,
+	}
+	for _, frame := range ch.Frames {
+		out.Frames = append(out.Frames, frame.Frame.FrameNumber)
+	}
+	for i, batch := range ch.Batches {
+		summary := Batch{ComprAlgo: ch.ComprAlgos[i]}
+		switch b := batch.(type) {
+		case *derive.SingularBatch:
+			summary.Type = "singular"
+			if b == nil {
+				summary.Invalid = true
+				break
+			}
+			summary.Blocks = append(summary.Blocks, newBlock(rollupCfg, b.Timestamp, uint64(b.EpochNum), len(b.Transactions)))
+		case *derive.SpanBatch:
+			summary.Type = "span"
+			if b == nil {
+				summary.Invalid = true
+				break
+			}
+			for j := 0; j < b.GetBlockCount(); j++ {
+				summary.Blocks = append(summary.Blocks, newBlock(rollupCfg, b.GetBlockTimestamp(j), b.GetBlockEpochNum(j), len(b.GetBlockTransactions(j))))
+			}
+		}
+		out.Batches = append(out.Batches, summary)
+	}
+	return out
+}
+
+func newBlock(cfg *rollup.Config, timestamp uint64, epochNum uint64, txCount int) Block {
+	// Batches with a timestamp before genesis or off the block time grid are invalid,
+	// the block number is left at zero for those.
+	num, _ := cfg.TargetBlockNumber(timestamp)
+	return Block{
+		Number:    num,
+		Timestamp: timestamp,
+		EpochNum:  epochNum,
+		TxCount:   txCount,
+	}
+}
+
+// TotalTxCount returns the number of L2 transactions of all decoded batches.
+func (r *Report) TotalTxCount() (count uint64) {
+	for _, ch := range r.Channels {
+		for _, b := range ch.Batches {
+			for _, bl := range b.Blocks {
+				count += uint64(bl.TxCount)
+			}
+		}
+	}
+	return count
+}
+
+// Print writes a human-readable summary of the report.
+func (r *Report) Print(w io.Writer) error {
+	var err error
+	printf := func(format string, args ...any) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	if r.TxHash != (common.Hash{}) {
+		printf("tx %s\n", r.TxHash)
+	}
+	printf("L1 block %s (time %d): %d frames, %d channels, %d L2 txs\n", r.L1Block, r.L1Time, r.FrameCount, len(r.Channels), r.TotalTxCount())
+	for _, dataErr := range r.DataErrors {
+		printf("  data %d: invalid: %s\n", dataErr.Index, dataErr.Error)
+	}
+	for _, ch := range r.Channels {
+		printf("  channel %s: frames %v, ready: %v, invalid frames: %v, invalid batches: %v\n",
+			ch.ID, ch.Frames, ch.IsReady, ch.InvalidFrames, ch.InvalidBatches)
+		for j, b := range ch.Batches {
+			printf("    batch %d: %s (%s), %d blocks\n", j, b.Type, b.ComprAlgo, len(b.Blocks))
+			if b.Invalid {
+				printf("      invalid\n")
+			}
+			for _, bl := range b.Blocks {
+				printf("      block %d: time %d, epoch %d, %d txs\n", bl.Number, bl.Timestamp, bl.EpochNum, bl.TxCount)
+			}
+		}
+	}
+	return err
+}
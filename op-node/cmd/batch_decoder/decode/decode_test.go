@@ -0,0 +1,95 @@
+package decode
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDecode(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	cfg := &rollup.Config{
+		Genesis:   rollup.Genesis{L2: eth.BlockID{Number: 100}, L2Time: 1000},
+		BlockTime: 2,
+		L2ChainID: big.NewInt(901),
+	}
+	cfg.RegolithTime = new(uint64)
+	cfg.CanyonTime = new(uint64)
+	cfg.DeltaTime = new(uint64)
+
+	co, err := derive.NewSpanChannelOut(cfg.Genesis.L2Time, cfg.L2ChainID, 100_000, derive.Zlib, rollup.NewChainSpec(cfg))
+	require.NoError(t, err)
+	var txCount int
+	for i := 0; i < 3; i++ {
+		batch := derive.RandomSingularBatch(rng, 2+i, cfg.L2ChainID)
+		batch.Timestamp = cfg.Genesis.L2Time + uint64(i+1)*cfg.BlockTime
+		batch.EpochNum = 7
+		txCount += len(batch.Transactions)
+		require.NoError(t, co.AddSingularBatch(batch, 0))
+	}
+	require.NoError(t, co.Close())
+
+	// Split the channel in two frames, to decode both an incomplete and a complete channel.
+	frameData := func(maxSize uint64) eth.Data {
+		var buf bytes.Buffer
+		buf.WriteByte(derive.DerivationVersion0)
+		// The last frame is returned with io.EOF
+		if _, err := co.OutputFrame(&buf, maxSize); err != io.EOF {
+			require.NoError(t, err)
+		}
+		return buf.Bytes()
+	}
+	first := frameData(uint64(co.ReadyBytes()/2) + derive.FrameV0OverHeadSize)
+	second := frameData(uint64(co.ReadyBytes()) + derive.FrameV0OverHeadSize)
+	l1 := eth.L1BlockRef{Number: 10, Time: 2000}
+	txHash := common.Hash{0xaa}
+
+	t.Run("Incomplete", func(t *testing.T) {
+		report := Data(cfg, l1, txHash, []eth.Data{first, {0x01, 0x02}})
+		require.Equal(t, 1, report.FrameCount)
+		require.Len(t, report.Channels, 1)
+		require.False(t, report.Channels[0].IsReady)
+		require.Empty(t, report.Channels[0].Batches)
+		require.Equal(t, []DataError{{Index: 1, Error: "invalid derivation format byte: got 1"}}, report.DataErrors)
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		report := Data(cfg, l1, txHash, []eth.Data{first, second})
+		require.Equal(t, 2, report.FrameCount)
+		require.Len(t, report.Channels, 1)
+		ch := report.Channels[0]
+		require.Equal(t, co.ID(), ch.ID)
+		require.Equal(t, []uint16{0, 1}, ch.Frames)
+		require.True(t, ch.IsReady)
+		require.False(t, ch.InvalidFrames)
+		require.False(t, ch.InvalidBatches)
+		require.Len(t, ch.Batches, 1)
+		batch := ch.Batches[0]
+		require.Equal(t, "span", batch.Type)
+		require.False(t, batch.Invalid)
+		require.Len(t, batch.Blocks, 3)
+		for i, block := range batch.Blocks {
+			require.Equal(t, uint64(101+i), block.Number)
+			require.Equal(t, uint64(1002+2*i), block.Timestamp)
+			require.Equal(t, uint64(7), block.EpochNum)
+			require.Equal(t, 2+i, block.TxCount)
+		}
+		require.Equal(t, uint64(txCount), report.TotalTxCount())
+
+		var out strings.Builder
+		require.NoError(t, report.Print(&out))
+		require.Contains(t, out.String(), "tx "+txHash.String())
+		require.Contains(t, out.String(), "2 frames, 1 channels, 9 L2 txs")
+		require.Contains(t, out.String(), "block 103: time 1006, epoch 7, 4 txs")
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
@@ -37,14 +38,22 @@ type TransactionWithMetadata struct {
 }
 
 type Config struct {
-	Start, End         uint64
-	ChainID            *big.Int
-	BatchInbox         common.Address
-	BatchSenders       map[common.Address]struct{}
+	Start, End   uint64
+	ChainID      *big.Int
+	BatchInbox   common.Address
+	BatchSenders map[common.Address]struct{}
+	// BatchInboxSchedule rotates the batch inbox address at later L1 blocks. Optional.
+	BatchInboxSchedule []rollup.BatchInboxUpdate
 	OutDirectory       string
 	ConcurrentRequests uint64
 }
 
+// BatchInboxAt returns the batch inbox address that applies to the L1 block with the given number.
+func (c *Config) BatchInboxAt(l1Num uint64) common.Address {
+	cfg := rollup.Config{BatchInboxAddress: c.BatchInbox, BatchInboxSchedule: c.BatchInboxSchedule}
+	return cfg.BatchInboxAddressAt(l1Num)
+}
+
 // Batches fetches & stores all transactions sent to the batch inbox address in
 // the given block range (inclusive to exclusive).
 // The transactions & metadata are written to the out directory.
@@ -90,9 +99,10 @@ func fetchBatchesPerBlock(ctx context.Context, client *ethclient.Client, beacon
 		return 0, 0, err
 	}
 	fmt.Println("Fetched block: ", number)
+	inbox := config.BatchInboxAt(number)
 	blobIndex := 0 // index of each blob in the block's blob sidecar
 	for i, tx := range block.Transactions() {
-		if tx.To() != nil && *tx.To() == config.BatchInbox {
+		if tx.To() != nil && *tx.To() == inbox {
 			sender, err := signer.Sender(tx)
 			if err != nil {
 				return 0, 0, err
@@ -172,7 +182,7 @@ func fetchBatchesPerBlock(ctx context.Context, client *ethclient.Client, beacon
 				BlockHash:   block.Hash(),
 				BlockTime:   block.Time(),
 				ChainId:     config.ChainID.Uint64(),
-				InboxAddr:   config.BatchInbox,
+				InboxAddr:   inbox,
 				Frames:      frames,
 				FrameErrs:   frameErrors,
 				ValidFrames: validFrames,
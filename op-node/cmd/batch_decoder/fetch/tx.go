@@ -0,0 +1,77 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// L1Client is the L1 RPC client used to fetch a single batcher transaction.
+type L1Client interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+}
+
+// BlobFetcher fetches the blobs of blob transactions.
+type BlobFetcher interface {
+	GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error)
+}
+
+// TxData fetches the batcher data of the L1 transaction: its calldata, or the data of its blobs,
+// and the L1 block it was included in. The blob fetcher may be nil if the transaction is not a blob transaction.
+func TxData(ctx context.Context, l1 L1Client, blobs BlobFetcher, txHash common.Hash) (eth.L1BlockRef, []eth.Data, error) {
+	rec, err := l1.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return eth.L1BlockRef{}, nil, fmt.Errorf("failed to fetch receipt of tx %s: %w", txHash, err)
+	}
+	block, err := l1.BlockByHash(ctx, rec.BlockHash)
+	if err != nil {
+		return eth.L1BlockRef{}, nil, fmt.Errorf("failed to fetch L1 block %s: %w", rec.BlockHash, err)
+	}
+	ref := eth.BlockToL1BlockRef(block)
+	if int(rec.TransactionIndex) >= len(block.Transactions()) {
+		return eth.L1BlockRef{}, nil, fmt.Errorf("tx index %d out of range of L1 block %s", rec.TransactionIndex, ref)
+	}
+	tx := block.Transactions()[rec.TransactionIndex]
+	if tx.Type() != types.BlobTxType {
+		return ref, []eth.Data{tx.Data()}, nil
+	}
+	if blobs == nil {
+		return eth.L1BlockRef{}, nil, fmt.Errorf("tx %s is a blob transaction, but no L1 beacon endpoint is configured", txHash)
+	}
+	// Blobs are indexed by their position in the block, across all blob transactions.
+	blobIndex := 0
+	for _, prev := range block.Transactions()[:rec.TransactionIndex] {
+		blobIndex += len(prev.BlobHashes())
+	}
+	var hashes []eth.IndexedBlobHash
+	for i, h := range tx.BlobHashes() {
+		hashes = append(hashes, eth.IndexedBlobHash{Index: uint64(blobIndex + i), Hash: h})
+	}
+	sidecars, err := blobs.GetBlobs(ctx, ref, hashes)
+	if err != nil {
+		return eth.L1BlockRef{}, nil, fmt.Errorf("failed to fetch blobs of tx %s: %w", txHash, err)
+	}
+	datas := make([]eth.Data, 0, len(sidecars))
+	for i, blob := range sidecars {
+		data, err := blob.ToData()
+		if err != nil {
+			return eth.L1BlockRef{}, nil, fmt.Errorf("failed to decode blob %d of tx %s: %w", i, txHash, err)
+		}
+		datas = append(datas, data)
+	}
+	return ref, datas, nil
+}
+
+// BlobData decodes the data of a raw blob.
+func BlobData(raw []byte) (eth.Data, error) {
+	var blob eth.Blob
+	if len(raw) != len(blob) {
+		return nil, fmt.Errorf("invalid blob length %d, expected %d", len(raw), len(blob))
+	}
+	copy(blob[:], raw)
+	return blob.ToData()
+}
@@ -2,19 +2,24 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math/big"
 	"os"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/decode"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/urfave/cli/v2"
 )
@@ -39,10 +44,10 @@ func main() {
 					Usage:    "Last block (exclusive) to fetch",
 				},
 				&cli.StringFlag{
-					Name:     "inbox",
-					Required: true,
-					Usage:    "Batch Inbox Address",
+					Name:  "inbox",
+					Usage: "Batch Inbox Address. Required unless a rollup config is given.",
 				},
+				rollupConfigFlag,
 				&cli.StringFlag{
 					Name:     "sender",
 					Required: true,
@@ -106,6 +111,14 @@ func main() {
 					OutDirectory:       cliCtx.String("out"),
 					ConcurrentRequests: uint64(cliCtx.Int("concurrent-requests")),
 				}
+				if rollupCfg, err := loadRollupConfig(cliCtx); err != nil {
+					log.Fatal(err)
+				} else if rollupCfg != nil {
+					config.BatchInbox = rollupCfg.BatchInboxAddress
+					config.BatchInboxSchedule = rollupCfg.BatchInboxSchedule
+				} else if !cliCtx.IsSet("inbox") {
+					log.Fatal("either the batch inbox address or the rollup config must be set")
+				}
 				totalValid, totalInvalid := fetch.Batches(l1Client, beacon, config)
 				fmt.Printf("Fetched batches in range [%v,%v). Found %v valid & %v invalid batches\n", config.Start, config.End, totalValid, totalInvalid)
 				fmt.Printf("Fetch Config: Chain ID: %v. Inbox Address: %v. Valid Senders: %v.\n", config.ChainID, config.BatchInbox, config.BatchSenders)
@@ -150,6 +163,7 @@ func main() {
 					Usage: "Batch Inbox Address. Default value from op-mainnet. " +
 						"Superchain-registry prioritized when given value is inconsistent.",
 				},
+				rollupConfigFlag,
 			},
 			Action: func(cliCtx *cli.Context) error {
 				var (
@@ -158,7 +172,15 @@ func main() {
 					BatchInboxAddress common.Address = common.HexToAddress(cliCtx.String("inbox"))
 				)
 				L2ChainID := new(big.Int).SetUint64(cliCtx.Uint64("l2-chain-id"))
-				rollupCfg, err := rollup.LoadOPStackRollupConfig(L2ChainID.Uint64())
+				rollupCfg, err := loadRollupConfig(cliCtx)
+				if err != nil {
+					log.Fatal(err)
+				} else if rollupCfg != nil {
+					L2ChainID = rollupCfg.L2ChainID
+				} else {
+					rollupCfg, err = rollup.LoadOPStackRollupConfig(L2ChainID.Uint64())
+				}
+				var schedule []rollup.BatchInboxUpdate
 				if err == nil {
 					// prioritize superchain config
 					if L2GenesisTime != rollupCfg.Genesis.L2Time {
@@ -173,19 +195,118 @@ func main() {
 						BatchInboxAddress = rollupCfg.BatchInboxAddress
 						fmt.Printf("BatchInboxAddress overridden: %v\n", BatchInboxAddress)
 					}
+					schedule = rollupCfg.BatchInboxSchedule
 				}
 				config := reassemble.Config{
-					BatchInbox:    BatchInboxAddress,
-					InDirectory:   cliCtx.String("in"),
-					OutDirectory:  cliCtx.String("out"),
-					L2ChainID:     L2ChainID,
-					L2GenesisTime: L2GenesisTime,
-					L2BlockTime:   L2BlockTime,
+					BatchInbox:         BatchInboxAddress,
+					BatchInboxSchedule: schedule,
+					InDirectory:        cliCtx.String("in"),
+					OutDirectory:       cliCtx.String("out"),
+					L2ChainID:          L2ChainID,
+					L2GenesisTime:      L2GenesisTime,
+					L2BlockTime:        L2BlockTime,
 				}
 				reassemble.Channels(config, rollupCfg)
 				return nil
 			},
 		},
+		{
+			Name:  "decode",
+			Usage: "Decodes the frames, channels and batches of a single batcher transaction or raw batcher data",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "tx",
+					Usage: "Hash of the L1 batcher transaction to fetch and decode",
+				},
+				&cli.StringFlag{
+					Name:  "data",
+					Usage: "Raw batcher data (hex) to decode, instead of fetching a transaction",
+				},
+				&cli.BoolFlag{
+					Name:  "blob",
+					Usage: "Whether the raw data is a full blob, instead of calldata",
+				},
+				&cli.Uint64Flag{
+					Name:  "l1-time",
+					Usage: "L1 timestamp to select the fork rules the raw data is decoded with",
+				},
+				&cli.StringFlag{
+					Name:    "l1",
+					Usage:   "L1 RPC URL, required to fetch a transaction",
+					EnvVars: []string{"L1_RPC"},
+				},
+				&cli.StringFlag{
+					Name:    "l1.beacon",
+					Usage:   "Address of L1 Beacon-node HTTP endpoint to use, required to fetch a blob transaction",
+					EnvVars: []string{"L1_BEACON"},
+				},
+				&cli.Uint64Flag{
+					Name:  "l2-chain-id",
+					Value: 10,
+					Usage: "L2 chain id to load the rollup config of from the superchain-registry, unless a rollup config is given. Default value from op-mainnet.",
+				},
+				rollupConfigFlag,
+				&cli.BoolFlag{
+					Name:  "json",
+					Usage: "Output the decoded batches as JSON",
+				},
+			},
+			Action: func(cliCtx *cli.Context) error {
+				rollupCfg, err := loadRollupConfig(cliCtx)
+				if err != nil {
+					log.Fatal(err)
+				} else if rollupCfg == nil {
+					if rollupCfg, err = rollup.LoadOPStackRollupConfig(cliCtx.Uint64("l2-chain-id")); err != nil {
+						log.Fatal(err)
+					}
+				}
+				var (
+					ref    eth.L1BlockRef
+					datas  []eth.Data
+					txHash common.Hash
+				)
+				switch {
+				case cliCtx.IsSet("tx"):
+					txHash = common.HexToHash(cliCtx.String("tx"))
+					l1Client, err := ethclient.Dial(cliCtx.String("l1"))
+					if err != nil {
+						log.Fatal(err)
+					}
+					var blobs fetch.BlobFetcher
+					if beaconAddr := cliCtx.String("l1.beacon"); beaconAddr != "" {
+						beaconClient := sources.NewBeaconHTTPClient(client.NewBasicHTTPClient(beaconAddr, nil))
+						blobs = sources.NewL1BeaconClient(beaconClient, sources.L1BeaconClientConfig{})
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					defer cancel()
+					if ref, datas, err = fetch.TxData(ctx, l1Client, blobs, txHash); err != nil {
+						log.Fatal(err)
+					}
+				case cliCtx.IsSet("data"):
+					data, err := hexutil.Decode(cliCtx.String("data"))
+					if err != nil {
+						log.Fatal(fmt.Errorf("failed to decode data: %w", err))
+					}
+					if cliCtx.Bool("blob") {
+						if data, err = fetch.BlobData(data); err != nil {
+							log.Fatal(err)
+						}
+					}
+					// Raw data is not tied to an L1 block, the time is only used to select the fork rules.
+					ref = eth.L1BlockRef{Time: cliCtx.Uint64("l1-time")}
+					datas = []eth.Data{data}
+				default:
+					log.Fatal("either tx or data must be set")
+				}
+				report := decode.Data(rollupCfg, ref, txHash, datas)
+				if cliCtx.Bool("json") {
+					enc := json.NewEncoder(os.Stdout)
+					enc.SetIndent("", "  ")
+					return enc.Encode(report)
+				}
+				return report.Print(os.Stdout)
+			},
+		},
 		{
 			Name:  "force-close",
 			Usage: "Create the tx data which will force close a channel",
@@ -200,6 +321,7 @@ func main() {
 					Value: "0x0000000000000000000000000000000000000000",
 					Usage: "(Optional) Batch Inbox Address",
 				},
+				rollupConfigFlag,
 				&cli.StringFlag{
 					Name:  "in",
 					Value: "/tmp/batch_decoder/transactions_cache",
@@ -211,7 +333,14 @@ func main() {
 				if err := (&id).UnmarshalText([]byte(cliCtx.String("id"))); err != nil {
 					log.Fatal(err)
 				}
-				frames := reassemble.LoadFrames(cliCtx.String("in"), common.HexToAddress(cliCtx.String("inbox")))
+				config := reassemble.Config{BatchInbox: common.HexToAddress(cliCtx.String("inbox"))}
+				if rollupCfg, err := loadRollupConfig(cliCtx); err != nil {
+					log.Fatal(err)
+				} else if rollupCfg != nil {
+					config.BatchInbox = rollupCfg.BatchInboxAddress
+					config.BatchInboxSchedule = rollupCfg.BatchInboxSchedule
+				}
+				frames := reassemble.LoadFrames(cliCtx.String("in"), config.BatchInboxAt)
 				var filteredFrames []derive.Frame
 				for _, frame := range frames {
 					if frame.Frame.ID == id {
@@ -232,3 +361,22 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+var rollupConfigFlag = &cli.StringFlag{
+	Name: "rollup-config",
+	Usage: "(Optional) Path to the rollup config of the chain. " +
+		"The batch inbox address and the batch inbox schedule are read from it, instead of the inbox flag.",
+}
+
+// loadRollupConfig loads the rollup config of the rollup-config flag, or returns nil if the flag is not set.
+func loadRollupConfig(cliCtx *cli.Context) (*rollup.Config, error) {
+	path := cliCtx.String(rollupConfigFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	cfg, err := jsonutil.LoadJSON[rollup.Config](path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rollup config: %w", err)
+	}
+	return cfg, nil
+}
@@ -37,16 +37,26 @@ type FrameWithMetadata struct {
 }
 
 type Config struct {
-	BatchInbox    common.Address
-	InDirectory   string
-	OutDirectory  string
-	L2ChainID     *big.Int
-	L2GenesisTime uint64
-	L2BlockTime   uint64
+	BatchInbox common.Address
+	// BatchInboxSchedule rotates the batch inbox address at later L1 blocks. Optional.
+	BatchInboxSchedule []rollup.BatchInboxUpdate
+	InDirectory        string
+	OutDirectory       string
+	L2ChainID          *big.Int
+	L2GenesisTime      uint64
+	L2BlockTime        uint64
 }
 
-func LoadFrames(directory string, inbox common.Address) []FrameWithMetadata {
-	txns := loadTransactions(directory, inbox)
+// BatchInboxAt returns the batch inbox address that applies to the L1 block with the given number.
+func (c *Config) BatchInboxAt(l1Num uint64) common.Address {
+	cfg := rollup.Config{BatchInboxAddress: c.BatchInbox, BatchInboxSchedule: c.BatchInboxSchedule}
+	return cfg.BatchInboxAddressAt(l1Num)
+}
+
+// LoadFrames loads the frames of the transactions that were sent to the batch inbox of their L1 block,
+// as returned by inboxAt. Transactions to any address are loaded if inboxAt returns the zero address.
+func LoadFrames(directory string, inboxAt func(l1Num uint64) common.Address) []FrameWithMetadata {
+	txns := loadTransactions(directory, inboxAt)
 	// Sort first by block number then by transaction index inside the block number range.
 	// This is to match the order they are processed in derivation.
 	sort.Slice(txns, func(i, j int) bool {
@@ -66,7 +76,7 @@ func Channels(config Config, rollupCfg *rollup.Config) {
 	if err := os.MkdirAll(config.OutDirectory, 0750); err != nil {
 		log.Fatal(err)
 	}
-	frames := LoadFrames(config.InDirectory, config.BatchInbox)
+	frames := LoadFrames(config.InDirectory, config.BatchInboxAt)
 	framesByChannel := make(map[derive.ChannelID][]FrameWithMetadata)
 	for _, frame := range frames {
 		framesByChannel[frame.Frame.ID] = append(framesByChannel[frame.Frame.ID], frame)
@@ -117,7 +127,8 @@ func ProcessFrames(cfg Config, rollupCfg *rollup.Config, id derive.ChannelID, fr
 
 	invalidBatches := false
 	if ch.IsReady() {
-		br, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(ch.HighestBlock().Time), rollupCfg.IsFjord(ch.HighestBlock().Time))
+		l1Time := ch.HighestBlock().Time
+		br, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(l1Time), rollupCfg.IsFjord(l1Time), derive.WithChainZstd(rollupCfg, l1Time))
 		if err == nil {
 			for batchData, err := br(); err != io.EOF; batchData, err = br() {
 				if err != nil {
@@ -186,7 +197,7 @@ func transactionsToFrames(txns []fetch.TransactionWithMetadata) []FrameWithMetad
 }
 
 // if inbox is the zero address, it will load all frames
-func loadTransactions(dir string, inbox common.Address) []fetch.TransactionWithMetadata {
+func loadTransactions(dir string, inboxAt func(l1Num uint64) common.Address) []fetch.TransactionWithMetadata {
 	files, err := os.ReadDir(dir)
 	if err != nil {
 		log.Fatal(err)
@@ -195,7 +206,7 @@ func loadTransactions(dir string, inbox common.Address) []fetch.TransactionWithM
 	for _, file := range files {
 		f := path.Join(dir, file.Name())
 		txm := loadTransactionsFile(f)
-		if (inbox == common.Address{} || txm.InboxAddr == inbox) && txm.ValidSender {
+		if inbox := inboxAt(txm.BlockNumber); (inbox == common.Address{} || txm.InboxAddr == inbox) && txm.ValidSender {
 			out = append(out, txm)
 		}
 	}
@@ -0,0 +1,249 @@
+package genesis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+const (
+	batchL1DeploymentsFile = "l1-deployments.json"
+	batchL2AllocsFile      = "l2-allocs.json"
+	batchOutfileL2         = "genesis-l2.json"
+	batchOutfileRollup     = "rollup.json"
+)
+
+// batchDeployConfigFiles are the accepted names of the deploy config of a chain, in order of preference.
+var batchDeployConfigFiles = []string{"deploy-config.json", "deploy-config.toml", "deploy-config.yaml", "deploy-config.yml"}
+
+var (
+	chainsDirFlag = &cli.PathFlag{
+		Name: "chains-dir",
+		Usage: "Directory with a subdirectory per chain, containing the deploy config of the chain " +
+			"and its l1-deployments.json and l2-allocs.json files",
+		Required: true,
+	}
+	outdirFlag = &cli.PathFlag{
+		Name:     "outdir",
+		Usage:    "Directory to write the genesis-l2.json and rollup.json of each chain to, in a subdirectory per chain",
+		Required: true,
+	}
+	batchL2AllocsFlag = &cli.PathFlag{
+		Name:  "l2-allocs",
+		Usage: "Path to the L2 genesis state dump to use for chains that do not have their own l2-allocs.json",
+	}
+	parallelFlag = &cli.IntFlag{
+		Name:  "parallel",
+		Usage: "Number of chains to generate the genesis of concurrently",
+		Value: runtime.NumCPU(),
+	}
+
+	l2BatchFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     l1RPCFlag.Name,
+			Usage:    "RPC URL for an Ethereum L1 node, shared by all chains",
+			Required: true,
+		},
+		chainsDirFlag,
+		outdirFlag,
+		batchL2AllocsFlag,
+		parallelFlag,
+	}
+)
+
+var l2BatchCommand = &cli.Command{
+	Name:  "l2-batch",
+	Usage: "Generates the L2 genesis files and rollup configs of multiple deployed networks",
+	Description: "Every subdirectory of the chains directory is a chain, with a deploy config, " +
+		"an l1-deployments.json file and, unless a shared one is provided, an l2-allocs.json file. " +
+		"The chains share a single L1 RPC connection, and L1 starting blocks are fetched once for all chains that start at the same block.",
+	Flags: l2BatchFlags,
+	Action: func(ctx *cli.Context) error {
+		logger := oplog.NewLogger(ctx.App.Writer, oplog.DefaultCLIConfig())
+
+		chains, err := findBatchChains(ctx.Path(chainsDirFlag.Name), ctx.Path(batchL2AllocsFlag.Name))
+		if err != nil {
+			return err
+		}
+		if len(chains) == 0 {
+			return errors.New("no chains found")
+		}
+
+		l1RPC := ctx.String(l1RPCFlag.Name)
+		client, err := ethclient.Dial(l1RPC)
+		if err != nil {
+			return fmt.Errorf("cannot dial %s: %w", l1RPC, err)
+		}
+		defer client.Close()
+		caller := batching.NewMultiCaller(client.Client(), batching.DefaultBatchSize)
+		blocks := newL1BlockCache(client)
+
+		outdir := ctx.Path(outdirFlag.Name)
+		var g errgroup.Group
+		g.SetLimit(max(ctx.Int(parallelFlag.Name), 1))
+		for _, chain := range chains {
+			chain := chain
+			g.Go(func() error {
+				logger := logger.New("chain", chain.Name)
+				if err := generateBatchChain(ctx.Context, logger, caller, blocks, chain, filepath.Join(outdir, chain.Name)); err != nil {
+					return fmt.Errorf("chain %s: %w", chain.Name, err)
+				}
+				logger.Info("Generated L2 genesis")
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		logger.Info("Generated L2 genesis of all chains", "chains", len(chains), "l1StartBlocks", blocks.Len())
+		return nil
+	},
+}
+
+// batchChain holds the input files of a chain of the l2-batch command.
+type batchChain struct {
+	Name          string
+	DeployConfig  string
+	L1Deployments string
+	L2Allocs      string
+}
+
+// findBatchChains finds the chains in the subdirectories of dir, sorted by name.
+// Chains without their own L2 allocs use the defaultAllocs, if set.
+func findBatchChains(dir string, defaultAllocs string) ([]batchChain, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read chains directory: %w", err)
+	}
+	var chains []batchChain
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		chainDir := filepath.Join(dir, entry.Name())
+		chain := batchChain{
+			Name:          entry.Name(),
+			L1Deployments: filepath.Join(chainDir, batchL1DeploymentsFile),
+			L2Allocs:      filepath.Join(chainDir, batchL2AllocsFile),
+		}
+		for _, name := range batchDeployConfigFiles {
+			if path := filepath.Join(chainDir, name); fileExists(path) {
+				chain.DeployConfig = path
+				break
+			}
+		}
+		if chain.DeployConfig == "" {
+			return nil, fmt.Errorf("chain %s: missing deploy config", chain.Name)
+		}
+		if !fileExists(chain.L1Deployments) {
+			return nil, fmt.Errorf("chain %s: missing %s", chain.Name, batchL1DeploymentsFile)
+		}
+		if !fileExists(chain.L2Allocs) {
+			if defaultAllocs == "" {
+				return nil, fmt.Errorf("chain %s: missing %s", chain.Name, batchL2AllocsFile)
+			}
+			chain.L2Allocs = defaultAllocs
+		}
+		chains = append(chains, chain)
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i].Name < chains[j].Name
+	})
+	return chains, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func generateBatchChain(ctx context.Context, logger log.Logger, caller *batching.MultiCaller, blocks l1BlockSource, chain batchChain, outdir string) error {
+	config, err := genesis.NewDeployConfig(chain.DeployConfig)
+	if err != nil {
+		return err
+	}
+	deployments, err := genesis.NewL1Deployments(chain.L1Deployments)
+	if err != nil {
+		return fmt.Errorf("cannot read L1 deployments at %s: %w", chain.L1Deployments, err)
+	}
+	config.SetDeployments(deployments)
+
+	l2Allocs, err := foundry.LoadForgeAllocs(chain.L2Allocs)
+	if err != nil {
+		return err
+	}
+
+	l1StartBlock, err := fetchL1StartBlock(ctx, logger, caller, blocks, config.SystemConfigProxy)
+	if err != nil {
+		return err
+	}
+
+	l2Genesis, rollupConfig, err := buildL2Genesis(logger, config, l2Allocs, l1StartBlock)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outdir, 0o755); err != nil {
+		return fmt.Errorf("cannot create output directory: %w", err)
+	}
+	if err := jsonutil.WriteJSON(l2Genesis, ioutil.ToAtomicFile(filepath.Join(outdir, batchOutfileL2), 0o666)); err != nil {
+		return err
+	}
+	return jsonutil.WriteJSON(rollupConfig, ioutil.ToAtomicFile(filepath.Join(outdir, batchOutfileRollup), 0o666))
+}
+
+// l1BlockCache caches the L1 blocks by number, so chains that share an L1 starting block only fetch it once.
+type l1BlockCache struct {
+	source l1BlockSource
+
+	mu     sync.Mutex
+	blocks map[uint64]*types.Block
+}
+
+func newL1BlockCache(source l1BlockSource) *l1BlockCache {
+	return &l1BlockCache{
+		source: source,
+		blocks: make(map[uint64]*types.Block),
+	}
+}
+
+func (c *l1BlockCache) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	// Hold the lock while fetching, so concurrent lookups of the same block do not fetch it twice.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if block, ok := c.blocks[number.Uint64()]; ok {
+		return block, nil
+	}
+	block, err := c.source.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	c.blocks[number.Uint64()] = block
+	return block, nil
+}
+
+// Len returns the number of cached blocks.
+func (c *l1BlockCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.blocks)
+}
@@ -0,0 +1,72 @@
+package genesis
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBatchFiles(t *testing.T, dir string, files ...string) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for _, name := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o644))
+	}
+}
+
+func TestFindBatchChains(t *testing.T) {
+	dir := t.TempDir()
+	writeBatchFiles(t, filepath.Join(dir, "chain-b"), "deploy-config.yaml", batchL1DeploymentsFile)
+	writeBatchFiles(t, filepath.Join(dir, "chain-a"), "deploy-config.json", batchL1DeploymentsFile, batchL2AllocsFile)
+	writeBatchFiles(t, dir, "README.md")
+
+	_, err := findBatchChains(dir, "")
+	require.ErrorContains(t, err, "chain-b: missing l2-allocs.json")
+
+	chains, err := findBatchChains(dir, "shared-allocs.json")
+	require.NoError(t, err)
+	require.Equal(t, []batchChain{
+		{
+			Name:          "chain-a",
+			DeployConfig:  filepath.Join(dir, "chain-a", "deploy-config.json"),
+			L1Deployments: filepath.Join(dir, "chain-a", batchL1DeploymentsFile),
+			L2Allocs:      filepath.Join(dir, "chain-a", batchL2AllocsFile),
+		},
+		{
+			Name:          "chain-b",
+			DeployConfig:  filepath.Join(dir, "chain-b", "deploy-config.yaml"),
+			L1Deployments: filepath.Join(dir, "chain-b", batchL1DeploymentsFile),
+			L2Allocs:      "shared-allocs.json",
+		},
+	}, chains)
+
+	writeBatchFiles(t, filepath.Join(dir, "chain-c"), batchL1DeploymentsFile)
+	_, err = findBatchChains(dir, "shared-allocs.json")
+	require.ErrorContains(t, err, "chain-c: missing deploy config")
+
+	_, err = findBatchChains(filepath.Join(dir, "missing"), "")
+	require.Error(t, err)
+}
+
+type countingBlockSource map[uint64]int
+
+func (s countingBlockSource) BlockByNumber(_ context.Context, number *big.Int) (*types.Block, error) {
+	s[number.Uint64()]++
+	return types.NewBlockWithHeader(&types.Header{Number: number}), nil
+}
+
+func TestL1BlockCache(t *testing.T) {
+	source := make(countingBlockSource)
+	cache := newL1BlockCache(source)
+	for _, num := range []int64{10, 20, 10, 10, 20} {
+		block, err := cache.BlockByNumber(context.Background(), big.NewInt(num))
+		require.NoError(t, err)
+		require.Equal(t, uint64(num), block.NumberU64())
+	}
+	require.Equal(t, countingBlockSource{10: 1, 20: 1}, source)
+	require.Equal(t, 2, cache.Len())
+}
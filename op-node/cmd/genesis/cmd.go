@@ -1,8 +1,10 @@
 package genesis
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
@@ -12,27 +14,39 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
 )
 
 var (
 	l1RPCFlag = &cli.StringFlag{
-		Name:     "l1-rpc",
-		Usage:    "RPC URL for an Ethereum L1 node",
-		Required: true,
+		Name:  "l1-rpc",
+		Usage: "RPC URL for an Ethereum L1 node. Not required if the L1 starting block is provided and the L1 deployments are read from file",
+	}
+	l1StartingBlockFlag = &cli.PathFlag{
+		Name: "l1-starting-block",
+		Usage: "Path to a JSON file with the L1 starting block header, as returned by eth_getBlockByNumber, " +
+			"to generate the L2 genesis offline instead of fetching the start block of the SystemConfig from L1",
 	}
 	deployConfigFlag = &cli.PathFlag{
 		Name:     "deploy-config",
-		Usage:    "Path to deploy config file",
+		Usage:    "Path to deploy config file, in JSON, TOML or YAML format",
 		Required: true,
 	}
 	l1DeploymentsFlag = &cli.PathFlag{
-		Name:     "l1-deployments",
-		Usage:    "Path to L1 deployments JSON file as in superchain-registry",
-		Required: true,
+		Name:  "l1-deployments",
+		Usage: "Path to L1 deployments JSON file as in superchain-registry",
+	}
+	systemConfigFlag = &cli.StringFlag{
+		Name: "system-config",
+		Usage: "Address of the SystemConfig proxy on L1. The other L1 contract addresses are discovered from it, " +
+			"instead of being read from the L1 deployments file",
 	}
 	outfileL2Flag = &cli.PathFlag{
 		Name:  "outfile.l2",
@@ -42,6 +56,21 @@ var (
 		Name:  "outfile.rollup",
 		Usage: "Path to rollup output file",
 	}
+	prevGenesisFlag = &cli.PathFlag{
+		Name: "prev-genesis",
+		Usage: "Path to a previous L2 genesis file, to diff the allocs of the new L2 genesis against. " +
+			"The diff report is written to the diff output file",
+	}
+	outfileDiffFlag = &cli.PathFlag{
+		Name:  "outfile.diff",
+		Usage: "Path to the JSON diff report output file. Required with a previous L2 genesis file",
+	}
+
+	interopDependencySetFlag = &cli.Uint64SliceFlag{
+		Name: "interop.dependency-set",
+		Usage: "Chain IDs of the interop dependency set to include in the rollup config, " +
+			"overriding the interopDependencySet of the deploy config. Requires Interop to be scheduled.",
+	}
 
 	l1AllocsFlag = &cli.StringFlag{
 		Name:  "l1-allocs",
@@ -65,11 +94,16 @@ var (
 
 	l2Flags = []cli.Flag{
 		l1RPCFlag,
+		l1StartingBlockFlag,
 		deployConfigFlag,
 		l2AllocsFlag,
 		l1DeploymentsFlag,
+		systemConfigFlag,
 		outfileL2Flag,
 		outfileRollupFlag,
+		interopDependencySetFlag,
+		prevGenesisFlag,
+		outfileDiffFlag,
 	}
 )
 
@@ -121,7 +155,8 @@ var Subcommands = cli.Commands{
 				return err
 			}
 
-			return jsonutil.WriteJSON(l1Genesis, ioutil.ToStdOutOrFileOrNoop(ctx.String(outfileL1Flag.Name), 0o666))
+			outfile := ctx.String(outfileL1Flag.Name)
+			return jsonutil.Write(l1Genesis, jsonutil.FormatFromPath(outfile), ioutil.ToStdOutOrFileOrNoop(outfile, 0o666))
 		},
 	},
 	{
@@ -130,13 +165,18 @@ var Subcommands = cli.Commands{
 		Description: "Generating the L2 genesis depends on knowledge of L1 contract addresses for the bridge to be secure. " +
 			"A deploy config and either a deployment directory or an L1 deployments file are used to create the L2 genesis. " +
 			"The deploy directory and L1 deployments file are generated by the L1 contract deployments. " +
-			"An L1 starting block is necessary, it can either be fetched dynamically using config in the deploy config " +
-			"or it can be provided as a JSON file.",
+			"For chains that are already deployed, the L1 contract addresses can instead be discovered from the SystemConfig proxy. " +
+			"An L1 starting block is necessary, it can either be fetched dynamically using the startBlock of the SystemConfig " +
+			"or it can be provided as a JSON file, in which case no L1 RPC is needed.",
 		Flags: l2Flags,
 		Action: func(ctx *cli.Context) error {
 			cfg := oplog.DefaultCLIConfig()
 			logger := oplog.NewLogger(ctx.App.Writer, cfg)
 
+			if ctx.IsSet(prevGenesisFlag.Name) && ctx.Path(outfileDiffFlag.Name) == "" {
+				return fmt.Errorf("missing %s to write the diff against %s to", outfileDiffFlag.Name, prevGenesisFlag.Name)
+			}
+
 			deployConfig := ctx.Path(deployConfigFlag.Name)
 			logger.Info("Deploy config", "path", deployConfig)
 			config, err := genesis.NewDeployConfig(deployConfig)
@@ -144,14 +184,54 @@ var Subcommands = cli.Commands{
 				return err
 			}
 
-			l1Deployments := ctx.Path(l1DeploymentsFlag.Name)
+			l1StartingBlock := ctx.Path(l1StartingBlockFlag.Name)
 			l1RPC := ctx.String(l1RPCFlag.Name)
+			var client *ethclient.Client
+			var caller *batching.MultiCaller
+			if l1RPC != "" {
+				client, err = ethclient.Dial(l1RPC)
+				if err != nil {
+					return fmt.Errorf("cannot dial %s: %w", l1RPC, err)
+				}
+				caller = batching.NewMultiCaller(client.Client(), batching.DefaultBatchSize)
+			} else if l1StartingBlock == "" {
+				return fmt.Errorf("missing %s or %s", l1RPCFlag.Name, l1StartingBlockFlag.Name)
+			}
 
-			deployments, err := genesis.NewL1Deployments(l1Deployments)
-			if err != nil {
-				return fmt.Errorf("cannot read L1 deployments at %s: %w", l1Deployments, err)
+			var deployments *genesis.L1Deployments
+			l1Deployments := ctx.Path(l1DeploymentsFlag.Name)
+			switch {
+			case l1Deployments != "" && ctx.IsSet(systemConfigFlag.Name):
+				return fmt.Errorf("only one of %s and %s may be set", l1DeploymentsFlag.Name, systemConfigFlag.Name)
+			case l1Deployments != "":
+				deployments, err = genesis.NewL1Deployments(l1Deployments)
+				if err != nil {
+					return fmt.Errorf("cannot read L1 deployments at %s: %w", l1Deployments, err)
+				}
+			case ctx.IsSet(systemConfigFlag.Name):
+				if client == nil {
+					return fmt.Errorf("discovering the L1 deployments requires %s", l1RPCFlag.Name)
+				}
+				addr := ctx.String(systemConfigFlag.Name)
+				if !common.IsHexAddress(addr) {
+					return fmt.Errorf("invalid SystemConfig address: %q", addr)
+				}
+				deployments, err = DiscoverL1Deployments(ctx.Context, caller, client, common.HexToAddress(addr))
+				if err != nil {
+					return fmt.Errorf("failed to discover L1 deployments: %w", err)
+				}
+				logger.Info("Discovered L1 deployments", "portal", deployments.OptimismPortalProxy,
+					"messenger", deployments.L1CrossDomainMessengerProxy, "bridge", deployments.L1StandardBridgeProxy,
+					"disputeGameFactory", deployments.DisputeGameFactoryProxy, "l2OutputOracle", deployments.L2OutputOracleProxy)
+				// The DA challenge contract is not referenced by the SystemConfig, keep the one of the deploy config
+				deployments.DataAvailabilityChallengeProxy = config.DAChallengeProxy
+			default:
+				return fmt.Errorf("missing %s or %s", l1DeploymentsFlag.Name, systemConfigFlag.Name)
 			}
 			config.SetDeployments(deployments)
+			if ctx.IsSet(interopDependencySetFlag.Name) {
+				config.InteropDependencySet = ctx.Uint64Slice(interopDependencySetFlag.Name)
+			}
 
 			var l2Allocs *foundry.ForgeAllocs
 			if l2AllocsPath := ctx.String(l2AllocsFlag.Name); l2AllocsPath != "" {
@@ -163,52 +243,96 @@ var Subcommands = cli.Commands{
 				return errors.New("missing l2-allocs")
 			}
 
-			// Retrieve SystemConfig.startBlock()
-			client, err := ethclient.Dial(l1RPC)
-			if err != nil {
-				return fmt.Errorf("cannot dial %s: %w", l1RPC, err)
-			}
-
-			caller := batching.NewMultiCaller(client.Client(), batching.DefaultBatchSize)
-			sysCfg := NewSystemConfigContract(caller, config.SystemConfigProxy)
-			startBlock, err := sysCfg.StartBlock(ctx.Context)
-			if err != nil {
-				return fmt.Errorf("failed to fetch startBlock from SystemConfig: %w", err)
+			var l1StartBlock *types.Block
+			if l1StartingBlock != "" {
+				l1StartBlock, err = LoadL1StartingBlock(l1StartingBlock)
+				if err != nil {
+					return fmt.Errorf("cannot read L1 starting block at %s: %w", l1StartingBlock, err)
+				}
+				logger.Info("Loaded L1 Start Block", "number", l1StartBlock.Number(), "hash", l1StartBlock.Hash().Hex())
+			} else {
+				l1StartBlock, err = fetchL1StartBlock(ctx.Context, logger, caller, client, config.SystemConfigProxy)
+				if err != nil {
+					return err
+				}
 			}
 
-			logger.Info("Using L1 Start Block", "number", startBlock)
-			// retry because local devnet can experience a race condition where L1 geth isn't ready yet
-			l1StartBlock, err := retry.Do(ctx.Context, 24, retry.Fixed(1*time.Second), func() (*types.Block, error) { return client.BlockByNumber(ctx.Context, startBlock) })
+			l2Genesis, rollupConfig, err := buildL2Genesis(logger, config, l2Allocs, l1StartBlock)
 			if err != nil {
-				return fmt.Errorf("fetching start block by number: %w", err)
-			}
-			logger.Info("Fetched L1 Start Block", "hash", l1StartBlock.Hash().Hex())
-
-			// Sanity check the config. Do this after filling in the L1StartingBlockTag
-			// if it is not defined.
-			if err := config.Check(logger); err != nil {
 				return err
 			}
 
-			// Build the L2 genesis block
-			l2Genesis, err := genesis.BuildL2Genesis(config, l2Allocs, l1StartBlock)
-			if err != nil {
-				return fmt.Errorf("error creating l2 genesis: %w", err)
-			}
-
-			l2GenesisBlock := l2Genesis.ToBlock()
-			rollupConfig, err := config.RollupConfig(l1StartBlock, l2GenesisBlock.Hash(), l2GenesisBlock.Number().Uint64())
-			if err != nil {
+			outfileL2 := ctx.String(outfileL2Flag.Name)
+			if err := jsonutil.Write(l2Genesis, jsonutil.FormatFromPath(outfileL2), ioutil.ToAtomicFile(outfileL2, 0o666)); err != nil {
 				return err
 			}
-			if err := rollupConfig.Check(); err != nil {
-				return fmt.Errorf("generated rollup config does not pass validation: %w", err)
+			outfileRollup := ctx.String(outfileRollupFlag.Name)
+			if err := jsonutil.Write(rollupConfig, jsonutil.FormatFromPath(outfileRollup), ioutil.ToAtomicFile(outfileRollup, 0o666)); err != nil {
+				return err
 			}
 
-			if err := jsonutil.WriteJSON(l2Genesis, ioutil.ToAtomicFile(ctx.String(outfileL2Flag.Name), 0o666)); err != nil {
-				return err
+			if prevGenesis := ctx.Path(prevGenesisFlag.Name); prevGenesis != "" {
+				prev, err := jsonutil.LoadJSON[core.Genesis](prevGenesis)
+				if err != nil {
+					return fmt.Errorf("cannot read previous L2 genesis at %s: %w", prevGenesis, err)
+				}
+				diff := genesis.DiffAllocs(prev.Alloc, l2Genesis.Alloc)
+				logger.Info("Compared L2 genesis allocs", "prev", prevGenesis, "added", len(diff.Added),
+					"removed", len(diff.Removed), "modified", len(diff.Modified))
+				outfileDiff := ctx.Path(outfileDiffFlag.Name)
+				return jsonutil.Write(diff, jsonutil.FormatJSON, ioutil.ToAtomicFile(outfileDiff, 0o666))
 			}
-			return jsonutil.WriteJSON(rollupConfig, ioutil.ToAtomicFile(ctx.String(outfileRollupFlag.Name), 0o666))
+			return nil
 		},
 	},
+	l2BatchCommand,
+}
+
+// l1BlockSource is the L1 block lookup that is used to fetch the L1 starting block.
+type l1BlockSource interface {
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// fetchL1StartBlock fetches the L1 starting block, as recorded by the startBlock of the SystemConfig.
+func fetchL1StartBlock(ctx context.Context, logger log.Logger, caller *batching.MultiCaller, blocks l1BlockSource, systemConfigProxy common.Address) (*types.Block, error) {
+	// Retrieve SystemConfig.startBlock()
+	sysCfg := NewSystemConfigContract(caller, systemConfigProxy)
+	startBlock, err := sysCfg.StartBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch startBlock from SystemConfig: %w", err)
+	}
+
+	logger.Info("Using L1 Start Block", "number", startBlock)
+	// retry because local devnet can experience a race condition where L1 geth isn't ready yet
+	l1StartBlock, err := retry.Do(ctx, 24, retry.Fixed(1*time.Second), func() (*types.Block, error) { return blocks.BlockByNumber(ctx, startBlock) })
+	if err != nil {
+		return nil, fmt.Errorf("fetching start block by number: %w", err)
+	}
+	logger.Info("Fetched L1 Start Block", "hash", l1StartBlock.Hash().Hex())
+	return l1StartBlock, nil
+}
+
+// buildL2Genesis builds the L2 genesis and the matching rollup config.
+func buildL2Genesis(logger log.Logger, config *genesis.DeployConfig, l2Allocs *foundry.ForgeAllocs, l1StartBlock *types.Block) (*core.Genesis, *rollup.Config, error) {
+	// Sanity check the config. Do this after filling in the L1StartingBlockTag
+	// if it is not defined.
+	if err := config.Check(logger); err != nil {
+		return nil, nil, err
+	}
+
+	// Build the L2 genesis block
+	l2Genesis, err := genesis.BuildL2Genesis(config, l2Allocs, l1StartBlock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating l2 genesis: %w", err)
+	}
+
+	l2GenesisBlock := l2Genesis.ToBlock()
+	rollupConfig, err := config.RollupConfig(l1StartBlock, l2GenesisBlock.Hash(), l2GenesisBlock.Number().Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := rollupConfig.Check(); err != nil {
+		return nil, nil, fmt.Errorf("generated rollup config does not pass validation: %w", err)
+	}
+	return l2Genesis, rollupConfig, nil
 }
@@ -0,0 +1,105 @@
+package genesis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+)
+
+var (
+	methodAddressManager         = "addressManager"
+	methodGetProxyImplementation = "getProxyImplementation"
+	methodL2Oracle               = "l2Oracle"
+)
+
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// DiscoverL1Deployments discovers the L1 contract deployments of a chain from the SystemConfig proxy.
+// The proxy addresses are read from the SystemConfig, and the ProxyAdmin of the SystemConfig proxy
+// provides the AddressManager and the implementation of each proxy.
+// The DataAvailabilityChallenge and ProtocolVersions contracts are not referenced by the SystemConfig and are not discovered.
+func DiscoverL1Deployments(ctx context.Context, caller *batching.MultiCaller, storage StorageReader, systemConfigProxy common.Address) (*genesis.L1Deployments, error) {
+	sysCfg := NewSystemConfigContract(caller, systemConfigProxy)
+	addrs, err := sysCfg.Addresses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SystemConfig at %v: %w", systemConfigProxy, err)
+	}
+	if addrs.OptimismPortal == (common.Address{}) {
+		return nil, errors.New("SystemConfig does not reference an OptimismPortal, it may predate storing contract addresses")
+	}
+
+	admin, err := storage.StorageAt(ctx, systemConfigProxy, genesis.AdminSlot, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SystemConfig proxy admin: %w", err)
+	}
+	proxyAdminAddr := common.BytesToAddress(admin)
+	if proxyAdminAddr == (common.Address{}) {
+		return nil, fmt.Errorf("SystemConfig at %v is not a proxy", systemConfigProxy)
+	}
+	proxyAdmin := batching.NewBoundContract(snapshots.LoadProxyAdminABI(), proxyAdminAddr)
+
+	deployments := &genesis.L1Deployments{
+		ProxyAdmin:                        proxyAdminAddr,
+		SystemConfigProxy:                 systemConfigProxy,
+		L1CrossDomainMessengerProxy:       addrs.L1CrossDomainMessenger,
+		L1ERC721BridgeProxy:               addrs.L1ERC721Bridge,
+		L1StandardBridgeProxy:             addrs.L1StandardBridge,
+		OptimismPortalProxy:               addrs.OptimismPortal,
+		OptimismMintableERC20FactoryProxy: addrs.OptimismMintableERC20Factory,
+		DisputeGameFactoryProxy:           addrs.DisputeGameFactory,
+	}
+	if addrs.DisputeGameFactory == (common.Address{}) {
+		// Chains without fault proofs still propose outputs to the L2OutputOracle referenced by the portal.
+		portal := batching.NewBoundContract(snapshots.LoadOptimismPortalABI(), addrs.OptimismPortal)
+		result, err := caller.SingleCall(ctx, rpcblock.Latest, portal.Call(methodL2Oracle))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L2OutputOracle from OptimismPortal: %w", err)
+		}
+		deployments.L2OutputOracleProxy = result.GetAddress(0)
+	}
+
+	// The ProxyAdmin resolves the implementations of all proxy types, including the legacy
+	// ResolvedDelegateProxy of the L1CrossDomainMessenger that is backed by the AddressManager.
+	proxies := []struct {
+		proxy common.Address
+		impl  *common.Address
+	}{
+		{deployments.SystemConfigProxy, &deployments.SystemConfig},
+		{deployments.L1CrossDomainMessengerProxy, &deployments.L1CrossDomainMessenger},
+		{deployments.L1ERC721BridgeProxy, &deployments.L1ERC721Bridge},
+		{deployments.L1StandardBridgeProxy, &deployments.L1StandardBridge},
+		{deployments.OptimismPortalProxy, &deployments.OptimismPortal},
+		{deployments.OptimismMintableERC20FactoryProxy, &deployments.OptimismMintableERC20Factory},
+		{deployments.DisputeGameFactoryProxy, &deployments.DisputeGameFactory},
+		{deployments.L2OutputOracleProxy, &deployments.L2OutputOracle},
+	}
+	calls := []batching.Call{proxyAdmin.Call(methodAddressManager)}
+	for _, p := range proxies {
+		if p.proxy != (common.Address{}) {
+			calls = append(calls, proxyAdmin.Call(methodGetProxyImplementation, p.proxy))
+		}
+	}
+	results, err := caller.Call(ctx, rpcblock.Latest, calls...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ProxyAdmin at %v: %w", proxyAdminAddr, err)
+	}
+	deployments.AddressManager = results[0].GetAddress(0)
+	results = results[1:]
+	for _, p := range proxies {
+		if p.proxy != (common.Address{}) {
+			*p.impl = results[0].GetAddress(0)
+			results = results[1:]
+		}
+	}
+	return deployments, nil
+}
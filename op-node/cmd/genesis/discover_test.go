@@ -0,0 +1,105 @@
+package genesis
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+)
+
+type stubStorage map[common.Address]map[common.Hash]common.Hash
+
+func (s stubStorage) StorageAt(_ context.Context, account common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	return s[account][key].Bytes(), nil
+}
+
+func TestDiscoverL1Deployments(t *testing.T) {
+	sysCfgProxy := common.Address{0x01}
+	proxyAdmin := common.Address{0x02}
+	expected := &genesis.L1Deployments{
+		SystemConfigProxy:                 sysCfgProxy,
+		SystemConfig:                      common.Address{0x11},
+		ProxyAdmin:                        proxyAdmin,
+		AddressManager:                    common.Address{0x03},
+		L1CrossDomainMessengerProxy:       common.Address{0x04},
+		L1CrossDomainMessenger:            common.Address{0x14},
+		L1ERC721BridgeProxy:               common.Address{0x05},
+		L1ERC721Bridge:                    common.Address{0x15},
+		L1StandardBridgeProxy:             common.Address{0x06},
+		L1StandardBridge:                  common.Address{0x16},
+		OptimismPortalProxy:               common.Address{0x07},
+		OptimismPortal:                    common.Address{0x17},
+		OptimismMintableERC20FactoryProxy: common.Address{0x08},
+		OptimismMintableERC20Factory:      common.Address{0x18},
+		DisputeGameFactoryProxy:           common.Address{0x09},
+		DisputeGameFactory:                common.Address{0x19},
+	}
+
+	setup := func(t *testing.T) (*batchingTest.AbiBasedRpc, *batching.MultiCaller, stubStorage) {
+		stubRpc := batchingTest.NewAbiBasedRpc(t, sysCfgProxy, snapshots.LoadSystemConfigABI())
+		stubRpc.AddContract(proxyAdmin, snapshots.LoadProxyAdminABI())
+		stubRpc.AddContract(expected.OptimismPortalProxy, snapshots.LoadOptimismPortalABI())
+		stubRpc.SetResponse(sysCfgProxy, methodL1CrossDomainMessenger, rpcblock.Latest, nil, []interface{}{expected.L1CrossDomainMessengerProxy})
+		stubRpc.SetResponse(sysCfgProxy, methodL1ERC721Bridge, rpcblock.Latest, nil, []interface{}{expected.L1ERC721BridgeProxy})
+		stubRpc.SetResponse(sysCfgProxy, methodL1StandardBridge, rpcblock.Latest, nil, []interface{}{expected.L1StandardBridgeProxy})
+		stubRpc.SetResponse(sysCfgProxy, methodOptimismPortal, rpcblock.Latest, nil, []interface{}{expected.OptimismPortalProxy})
+		stubRpc.SetResponse(sysCfgProxy, methodOptimismMintableERC20Factory, rpcblock.Latest, nil, []interface{}{expected.OptimismMintableERC20FactoryProxy})
+		stubRpc.SetResponse(proxyAdmin, methodAddressManager, rpcblock.Latest, nil, []interface{}{expected.AddressManager})
+		for proxy, impl := range map[common.Address]common.Address{
+			expected.SystemConfigProxy:                 expected.SystemConfig,
+			expected.L1CrossDomainMessengerProxy:       expected.L1CrossDomainMessenger,
+			expected.L1ERC721BridgeProxy:               expected.L1ERC721Bridge,
+			expected.L1StandardBridgeProxy:             expected.L1StandardBridge,
+			expected.OptimismPortalProxy:               expected.OptimismPortal,
+			expected.OptimismMintableERC20FactoryProxy: expected.OptimismMintableERC20Factory,
+			expected.DisputeGameFactoryProxy:           expected.DisputeGameFactory,
+		} {
+			stubRpc.SetResponse(proxyAdmin, methodGetProxyImplementation, rpcblock.Latest, []interface{}{proxy}, []interface{}{impl})
+		}
+		storage := stubStorage{sysCfgProxy: {genesis.AdminSlot: common.BytesToHash(proxyAdmin.Bytes())}}
+		return stubRpc, batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize), storage
+	}
+
+	t.Run("FaultProofs", func(t *testing.T) {
+		stubRpc, caller, storage := setup(t)
+		stubRpc.SetResponse(sysCfgProxy, methodDisputeGameFactory, rpcblock.Latest, nil, []interface{}{expected.DisputeGameFactoryProxy})
+
+		deployments, err := DiscoverL1Deployments(context.Background(), caller, storage, sysCfgProxy)
+		require.NoError(t, err)
+		require.Equal(t, expected, deployments)
+	})
+
+	t.Run("L2OutputOracle", func(t *testing.T) {
+		stubRpc, caller, storage := setup(t)
+		stubRpc.SetResponse(sysCfgProxy, methodDisputeGameFactory, rpcblock.Latest, nil, []interface{}{common.Address{}})
+		oracleProxy := common.Address{0x0a}
+		oracle := common.Address{0x1a}
+		stubRpc.SetResponse(expected.OptimismPortalProxy, methodL2Oracle, rpcblock.Latest, nil, []interface{}{oracleProxy})
+		stubRpc.SetResponse(proxyAdmin, methodGetProxyImplementation, rpcblock.Latest, []interface{}{oracleProxy}, []interface{}{oracle})
+
+		deployments, err := DiscoverL1Deployments(context.Background(), caller, storage, sysCfgProxy)
+		require.NoError(t, err)
+		legacy := *expected
+		legacy.DisputeGameFactoryProxy = common.Address{}
+		legacy.DisputeGameFactory = common.Address{}
+		legacy.L2OutputOracleProxy = oracleProxy
+		legacy.L2OutputOracle = oracle
+		require.Equal(t, &legacy, deployments)
+	})
+
+	t.Run("NotProxy", func(t *testing.T) {
+		stubRpc, caller, _ := setup(t)
+		stubRpc.SetResponse(sysCfgProxy, methodDisputeGameFactory, rpcblock.Latest, nil, []interface{}{expected.DisputeGameFactoryProxy})
+
+		_, err := DiscoverL1Deployments(context.Background(), caller, stubStorage{}, sysCfgProxy)
+		require.ErrorContains(t, err, "is not a proxy")
+	})
+}
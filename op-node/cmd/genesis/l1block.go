@@ -0,0 +1,49 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// l1StartingBlock is the L1 starting block header as returned by eth_getBlockByNumber.
+// Other block fields, like the transactions, are ignored.
+type l1StartingBlock struct {
+	Header *types.Header
+	// Hash is optional. If present, it must match the hash of the header.
+	Hash *common.Hash
+}
+
+func (b *l1StartingBlock) UnmarshalJSON(data []byte) error {
+	var header types.Header
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	var hash struct {
+		Hash *common.Hash `json:"hash"`
+	}
+	if err := json.Unmarshal(data, &hash); err != nil {
+		return err
+	}
+	b.Header = &header
+	b.Hash = hash.Hash
+	return nil
+}
+
+// LoadL1StartingBlock reads the L1 starting block header from a JSON file,
+// so the L2 genesis can be generated without access to an L1 RPC.
+func LoadL1StartingBlock(path string) (*types.Block, error) {
+	b, err := jsonutil.LoadJSON[l1StartingBlock](path)
+	if err != nil {
+		return nil, err
+	}
+	block := types.NewBlockWithHeader(b.Header)
+	if b.Hash != nil && *b.Hash != block.Hash() {
+		return nil, fmt.Errorf("L1 starting block hash %v does not match the hash of the header %v, header fields may be missing", *b.Hash, block.Hash())
+	}
+	return block, nil
+}
@@ -0,0 +1,59 @@
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func writeL1StartingBlock(t *testing.T, header *types.Header, hash common.Hash) string {
+	data, err := json.Marshal(header)
+	require.NoError(t, err)
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	fields["hash"] = hash
+	// eth_getBlockByNumber also returns fields that are not part of the header
+	fields["transactions"] = []common.Hash{{0x01}}
+	data, err = json.Marshal(fields)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "l1-starting-block.json")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func TestLoadL1StartingBlock(t *testing.T) {
+	header := &types.Header{
+		ParentHash: common.Hash{0xaa},
+		Number:     big.NewInt(1234),
+		GasLimit:   30_000_000,
+		Time:       1700000000,
+		BaseFee:    big.NewInt(7),
+		Difficulty: common.Big0,
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		path := writeL1StartingBlock(t, header, header.Hash())
+		block, err := LoadL1StartingBlock(path)
+		require.NoError(t, err)
+		require.Equal(t, header.Hash(), block.Hash())
+		require.Equal(t, uint64(1234), block.NumberU64())
+		require.Equal(t, uint64(1700000000), block.Time())
+	})
+
+	t.Run("HashMismatch", func(t *testing.T) {
+		path := writeL1StartingBlock(t, header, common.Hash{0xbb})
+		_, err := LoadL1StartingBlock(path)
+		require.ErrorContains(t, err, "does not match")
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := LoadL1StartingBlock(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+}
@@ -12,9 +12,26 @@ import (
 )
 
 var (
-	methodStartBlock = "startBlock"
+	methodStartBlock                   = "startBlock"
+	methodL1CrossDomainMessenger       = "l1CrossDomainMessenger"
+	methodL1ERC721Bridge               = "l1ERC721Bridge"
+	methodL1StandardBridge             = "l1StandardBridge"
+	methodOptimismPortal               = "optimismPortal"
+	methodOptimismMintableERC20Factory = "optimismMintableERC20Factory"
+	methodDisputeGameFactory           = "disputeGameFactory"
 )
 
+// SystemConfigAddresses are the proxy addresses of the L1 contracts referenced by the SystemConfig.
+type SystemConfigAddresses struct {
+	L1CrossDomainMessenger       common.Address
+	L1ERC721Bridge               common.Address
+	L1StandardBridge             common.Address
+	OptimismPortal               common.Address
+	OptimismMintableERC20Factory common.Address
+	// DisputeGameFactory is zero for chains that still use the L2OutputOracle.
+	DisputeGameFactory common.Address
+}
+
 type SystemConfigContract struct {
 	caller   *batching.MultiCaller
 	contract *batching.BoundContract
@@ -34,3 +51,26 @@ func (c *SystemConfigContract) StartBlock(ctx context.Context) (*big.Int, error)
 	}
 	return result.GetBigInt(0), nil
 }
+
+// Addresses returns the contract addresses stored in the SystemConfig.
+// Only SystemConfig versions 1.12.0 and later store the contract addresses.
+func (c *SystemConfigContract) Addresses(ctx context.Context) (*SystemConfigAddresses, error) {
+	results, err := c.caller.Call(ctx, rpcblock.Latest,
+		c.contract.Call(methodL1CrossDomainMessenger),
+		c.contract.Call(methodL1ERC721Bridge),
+		c.contract.Call(methodL1StandardBridge),
+		c.contract.Call(methodOptimismPortal),
+		c.contract.Call(methodOptimismMintableERC20Factory),
+		c.contract.Call(methodDisputeGameFactory))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch contract addresses: %w", err)
+	}
+	return &SystemConfigAddresses{
+		L1CrossDomainMessenger:       results[0].GetAddress(0),
+		L1ERC721Bridge:               results[1].GetAddress(0),
+		L1StandardBridge:             results[2].GetAddress(0),
+		OptimismPortal:               results[3].GetAddress(0),
+		OptimismMintableERC20Factory: results[4].GetAddress(0),
+		DisputeGameFactory:           results[5].GetAddress(0),
+	}, nil
+}
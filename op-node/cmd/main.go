@@ -14,9 +14,12 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/cmd/genesis"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/networks"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/replay"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/node"
+	// Register the example file data source of the derivation.
+	_ "github.com/ethereum-optimism/optimism/op-node/rollup/derive/filesource"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
@@ -62,6 +65,7 @@ func main() {
 			Name:        "networks",
 			Subcommands: networks.Subcommands,
 		},
+		replay.Command,
 	}
 
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
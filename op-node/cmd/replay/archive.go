@@ -0,0 +1,194 @@
+package replay
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+const (
+	l1Dir = "l1"
+	l2Dir = "l2"
+
+	// l1CacheSize is the number of decoded L1 blocks to keep in memory, L1 blocks with receipts are large.
+	l1CacheSize = 64
+)
+
+// L1Block is the archived data of an L1 block, as read by the derivation pipeline.
+type L1Block struct {
+	Header       *types.Header      `json:"header"`
+	Transactions types.Transactions `json:"transactions"`
+	Receipts     types.Receipts     `json:"receipts"`
+	// Blobs holds the blobs that were read by the derivation pipeline, by versioned hash.
+	Blobs map[common.Hash]*eth.Blob `json:"blobs,omitempty"`
+}
+
+// L2Block is the archived data of an L2 block, as read by the derivation pipeline.
+type L2Block struct {
+	Ref          eth.L2BlockRef   `json:"ref"`
+	SystemConfig eth.SystemConfig `json:"systemConfig"`
+	// Payload is only archived if the derivation pipeline read it,
+	// to check a span batch against the existing L2 chain.
+	Payload *eth.ExecutionPayloadEnvelope `json:"payload,omitempty"`
+}
+
+type archiveIndex struct {
+	byNumber map[uint64]common.Hash
+	byHash   map[common.Hash]uint64
+}
+
+func (idx *archiveIndex) add(num uint64, hash common.Hash) {
+	idx.byNumber[num] = hash
+	idx.byHash[hash] = num
+}
+
+// Archive stores the L1 and L2 data that the derivation pipeline reads as files in a directory,
+// so the derivation can be replayed without access to an L1 or L2 node.
+// Every block is stored in a JSON file named by the number and hash of the block.
+type Archive struct {
+	dir string
+
+	l1      archiveIndex
+	l2      archiveIndex
+	l1Cache *lru.Cache[common.Hash, *L1Block]
+	l2Cache map[common.Hash]*L2Block
+}
+
+// OpenArchive opens the archive in the directory, and creates the directory if it does not exist yet.
+func OpenArchive(dir string) (*Archive, error) {
+	l1Cache, err := lru.New[common.Hash, *L1Block](l1CacheSize)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{
+		dir:     dir,
+		l1:      archiveIndex{byNumber: make(map[uint64]common.Hash), byHash: make(map[common.Hash]uint64)},
+		l2:      archiveIndex{byNumber: make(map[uint64]common.Hash), byHash: make(map[common.Hash]uint64)},
+		l1Cache: l1Cache,
+		l2Cache: make(map[common.Hash]*L2Block),
+	}
+	for _, sub := range []struct {
+		name string
+		idx  *archiveIndex
+	}{{l1Dir, &a.l1}, {l2Dir, &a.l2}} {
+		if err := os.MkdirAll(filepath.Join(dir, sub.name), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create archive directory: %w", err)
+		}
+		entries, err := os.ReadDir(filepath.Join(dir, sub.name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive directory: %w", err)
+		}
+		for _, entry := range entries {
+			num, hash, ok := parseArchiveFileName(entry.Name())
+			if !ok {
+				continue
+			}
+			sub.idx.add(num, hash)
+		}
+	}
+	return a, nil
+}
+
+func archiveFileName(num uint64, hash common.Hash) string {
+	return fmt.Sprintf("%d-%s.json", num, hash)
+}
+
+func parseArchiveFileName(name string) (uint64, common.Hash, bool) {
+	numStr, hashStr, ok := strings.Cut(strings.TrimSuffix(name, ".json"), "-")
+	if !ok || !strings.HasSuffix(name, ".json") {
+		return 0, common.Hash{}, false
+	}
+	num, err := strconv.ParseUint(numStr, 10, 64)
+	if err != nil {
+		return 0, common.Hash{}, false
+	}
+	var hash common.Hash
+	if err := hash.UnmarshalText([]byte(hashStr)); err != nil {
+		return 0, common.Hash{}, false
+	}
+	return num, hash, true
+}
+
+// L1BlockByNumber returns the archived L1 block, or ethereum.NotFound if it is not archived.
+func (a *Archive) L1BlockByNumber(num uint64) (*L1Block, error) {
+	hash, ok := a.l1.byNumber[num]
+	if !ok {
+		return nil, fmt.Errorf("%w: L1 block %d", ethereum.NotFound, num)
+	}
+	return a.L1BlockByHash(hash)
+}
+
+// L1BlockByHash returns the archived L1 block, or ethereum.NotFound if it is not archived.
+func (a *Archive) L1BlockByHash(hash common.Hash) (*L1Block, error) {
+	if b, ok := a.l1Cache.Get(hash); ok {
+		return b, nil
+	}
+	num, ok := a.l1.byHash[hash]
+	if !ok {
+		return nil, fmt.Errorf("%w: L1 block %s", ethereum.NotFound, hash)
+	}
+	b, err := jsonutil.LoadJSON[L1Block](filepath.Join(a.dir, l1Dir, archiveFileName(num, hash)))
+	if err != nil {
+		return nil, err
+	}
+	a.l1Cache.Add(hash, b)
+	return b, nil
+}
+
+// PutL1Block archives the L1 block, replacing the existing data of the block.
+func (a *Archive) PutL1Block(b *L1Block) error {
+	hash, num := b.Header.Hash(), b.Header.Number.Uint64()
+	if err := jsonutil.WriteJSON(b, ioutil.ToAtomicFile(filepath.Join(a.dir, l1Dir, archiveFileName(num, hash)), 0o644)); err != nil {
+		return fmt.Errorf("failed to archive L1 block %d: %w", num, err)
+	}
+	a.l1.add(num, hash)
+	a.l1Cache.Add(hash, b)
+	return nil
+}
+
+// L2BlockByNumber returns the archived L2 block, or ethereum.NotFound if it is not archived.
+func (a *Archive) L2BlockByNumber(num uint64) (*L2Block, error) {
+	hash, ok := a.l2.byNumber[num]
+	if !ok {
+		return nil, fmt.Errorf("%w: L2 block %d", ethereum.NotFound, num)
+	}
+	return a.L2BlockByHash(hash)
+}
+
+// L2BlockByHash returns the archived L2 block, or ethereum.NotFound if it is not archived.
+func (a *Archive) L2BlockByHash(hash common.Hash) (*L2Block, error) {
+	if b, ok := a.l2Cache[hash]; ok {
+		return b, nil
+	}
+	num, ok := a.l2.byHash[hash]
+	if !ok {
+		return nil, fmt.Errorf("%w: L2 block %s", ethereum.NotFound, hash)
+	}
+	b, err := jsonutil.LoadJSON[L2Block](filepath.Join(a.dir, l2Dir, archiveFileName(num, hash)))
+	if err != nil {
+		return nil, err
+	}
+	a.l2Cache[hash] = b
+	return b, nil
+}
+
+// PutL2Block archives the L2 block, replacing the existing data of the block.
+func (a *Archive) PutL2Block(b *L2Block) error {
+	if err := jsonutil.WriteJSON(b, ioutil.ToAtomicFile(filepath.Join(a.dir, l2Dir, archiveFileName(b.Ref.Number, b.Ref.Hash)), 0o644)); err != nil {
+		return fmt.Errorf("failed to archive L2 block %d: %w", b.Ref.Number, err)
+	}
+	a.l2.add(b.Ref.Number, b.Ref.Hash)
+	a.l2Cache[b.Ref.Hash] = b
+	return nil
+}
@@ -0,0 +1,143 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	opnode "github.com/ethereum-optimism/optimism/op-node"
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+var (
+	l1StartFlag = &cli.Uint64Flag{
+		Name:     "l1-start",
+		Usage:    "L1 block to start the replay at. The L2 chain is derived from the L2 block with the timestamp of this L1 block",
+		Required: true,
+	}
+	l1EndFlag = &cli.Uint64Flag{
+		Name:     "l1-end",
+		Usage:    "Last L1 block (inclusive) to derive from",
+		Required: true,
+	}
+	l2StartFlag = &cli.Uint64Flag{
+		Name:  "l2-start",
+		Usage: "L2 block to start deriving after, overriding the L2 block that is selected by the L1 start block",
+	}
+	archiveFlag = &cli.PathFlag{
+		Name:     "archive",
+		Usage:    "Directory with the archived L1 and L2 data to replay the derivation with",
+		Required: true,
+	}
+	outFlag = &cli.PathFlag{
+		Name:     "out",
+		Usage:    "Directory to write the derived payload attributes to, one JSON file per L2 block",
+		Required: true,
+	}
+	l1RPCFlag = &cli.StringFlag{
+		Name:  "l1",
+		Usage: "Optional L1 RPC to fetch and archive the L1 data that is not archived yet",
+	}
+	l1BeaconFlag = &cli.StringFlag{
+		Name:  "l1.beacon",
+		Usage: "Optional L1 beacon API to fetch and archive the blobs that are not archived yet",
+	}
+	l2RPCFlag = &cli.StringFlag{
+		Name:  "l2",
+		Usage: "Optional L2 RPC to fetch and archive the L2 blocks that are not archived yet",
+	}
+)
+
+// Command replays the derivation of an L1 block range, without an engine.
+var Command = &cli.Command{
+	Name:  "replay-derivation",
+	Usage: "Derives the L2 chain from a range of L1 blocks and writes the derived payload attributes to disk",
+	Description: "Runs the derivation pipeline against archived L1 data, without an engine, for auditing and differential testing. " +
+		"Instead of executing the derived attributes, the existing L2 chain is read from the archive and checked against them. " +
+		"When RPCs are provided, data that is missing in the archive is fetched and archived, so later replays can run offline.",
+	Flags: []cli.Flag{
+		opflags.CLINetworkFlag(flags.EnvVarPrefix, ""),
+		opflags.CLIRollupConfigFlag(flags.EnvVarPrefix, ""),
+		l1StartFlag,
+		l1EndFlag,
+		l2StartFlag,
+		archiveFlag,
+		outFlag,
+		l1RPCFlag,
+		l1BeaconFlag,
+		l2RPCFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.ReadCLIConfig(ctx))
+
+		rollupCfg, err := opnode.NewRollupConfig(logger, ctx.String(opflags.NetworkFlagName), ctx.String(opflags.RollupConfigFlagName))
+		if err != nil {
+			return err
+		}
+		l1Start, l1End := ctx.Uint64(l1StartFlag.Name), ctx.Uint64(l1EndFlag.Name)
+		if l1End < l1Start {
+			return fmt.Errorf("L1 end block %d is before the L1 start block %d", l1End, l1Start)
+		}
+
+		archive, err := OpenArchive(ctx.Path(archiveFlag.Name))
+		if err != nil {
+			return err
+		}
+
+		var l1RPC L1RPC
+		if addr := ctx.String(l1RPCFlag.Name); addr != "" {
+			rpc, err := client.NewRPC(ctx.Context, logger, addr)
+			if err != nil {
+				return fmt.Errorf("failed to dial L1 RPC: %w", err)
+			}
+			l1Client, err := sources.NewL1Client(rpc, logger, nil, sources.L1ClientDefaultConfig(rollupCfg, true, sources.RPCKindStandard))
+			if err != nil {
+				return err
+			}
+			l1RPC = l1Client
+		}
+		var l1Blobs derive.L1BlobsFetcher
+		if addr := ctx.String(l1BeaconFlag.Name); addr != "" {
+			beacon := sources.NewBeaconHTTPClient(client.NewBasicHTTPClient(addr, logger))
+			l1Blobs = sources.NewL1BeaconClient(beacon, sources.L1BeaconClientConfig{})
+		}
+		var l2RPC L2RPC
+		if addr := ctx.String(l2RPCFlag.Name); addr != "" {
+			rpc, err := client.NewRPC(ctx.Context, logger, addr)
+			if err != nil {
+				return fmt.Errorf("failed to dial L2 RPC: %w", err)
+			}
+			l2Client, err := sources.NewL2Client(rpc, logger, nil, sources.L2ClientDefaultConfig(rollupCfg, true))
+			if err != nil {
+				return err
+			}
+			l2RPC = l2Client
+		}
+
+		l1 := NewL1Source(logger, archive, l1End, l1RPC, l1Blobs)
+		l2 := NewL2Source(logger, archive, l2RPC)
+
+		l2Start := ctx.Uint64(l2StartFlag.Name)
+		if !ctx.IsSet(l2StartFlag.Name) {
+			startRef, err := l1.L1BlockRefByNumber(ctx.Context, l1Start)
+			if err != nil {
+				return fmt.Errorf("failed to get L1 start block: %w", err)
+			}
+			l2Start, err = startL2Block(rollupCfg, startRef.Time)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err := Replay(ctx.Context, logger, rollupCfg, l1, l1, l2, l2Start, ctx.Path(outFlag.Name))
+		if result != nil {
+			logger.Info("Replayed derivation", "derived", result.Derived, "safeHead", result.SafeHead, "origin", result.Origin)
+		}
+		return err
+	},
+}
@@ -0,0 +1,175 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// ErrMismatch is returned when the derived attributes do not match the existing L2 chain.
+var ErrMismatch = errors.New("derived attributes do not match the L2 chain")
+
+// maxRetries is the number of consecutive temporary or reset errors the replay retries before it fails.
+const maxRetries = 5
+
+// retryDelay is the delay before the derivation is retried after a temporary or reset error.
+var retryDelay = time.Second
+
+// DerivedBlock is written to the output directory for every derived L2 block.
+type DerivedBlock struct {
+	Number       uint64                 `json:"number"`
+	Parent       eth.L2BlockRef         `json:"parent"`
+	DerivedFrom  eth.L1BlockRef         `json:"derivedFrom"`
+	IsLastInSpan bool                   `json:"isLastInSpan"`
+	Attributes   *eth.PayloadAttributes `json:"attributes"`
+}
+
+// Result summarizes a replay.
+type Result struct {
+	// Derived is the number of derived L2 blocks.
+	Derived uint64
+	// SafeHead is the last derived L2 block.
+	SafeHead eth.L2BlockRef
+	// Origin is the last L1 block that the derivation read.
+	Origin eth.L1BlockRef
+}
+
+// Replay runs the derivation pipeline from the L2 start block until the L1 source runs out of data,
+// and writes every derived block to the output directory.
+// There is no engine to execute the derived attributes, instead the next safe head is read from the L2 source,
+// after checking that it is consistent with the attributes.
+// The replay stops early at the end of the L2 source, and fails with ErrMismatch if the L2 source diverges.
+// Like the derivation in the node, the replay continues when the pipeline needs more data,
+// and retries temporary errors and resets, up to maxRetries times in a row.
+func Replay(ctx context.Context, logger log.Logger, rollupCfg *rollup.Config, l1 derive.L1Fetcher, l1Blobs derive.L1BlobsFetcher,
+	l2 derive.L2Source, l2Start uint64, outDir string) (*Result, error) {
+	safeHead, err := l2.L2BlockRefByNumber(ctx, l2Start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 start block %d: %w", l2Start, err)
+	}
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	pipeline := derive.NewDerivationPipeline(logger, rollupCfg, l1, l1Blobs, altda.Disabled, l2, metrics.NoopMetrics)
+	pipeline.Reset()
+	// The existing L2 chain is the engine state, there is nothing to reset.
+	pipeline.ConfirmEngineReset()
+
+	result := &Result{SafeHead: safeHead}
+	logger.Info("Starting derivation replay", "l2Start", safeHead)
+	retries := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		attrs, err := pipeline.Step(ctx, safeHead)
+		result.Origin = pipeline.Origin()
+		if errors.Is(err, io.EOF) {
+			logger.Info("Reached the end of the L1 data", "origin", result.Origin)
+			return result, nil
+		} else if errors.Is(err, derive.NotEnoughData) {
+			continue
+		} else if errors.Is(err, derive.ErrReset) || errors.Is(err, derive.ErrTemporary) {
+			if retries >= maxRetries {
+				return result, fmt.Errorf("derivation failed at L1 origin %s after %d retries: %w", result.Origin, retries, err)
+			}
+			retries++
+			logger.Warn("Derivation error, retrying", "origin", result.Origin, "attempt", retries, "err", err)
+			if errors.Is(err, derive.ErrReset) {
+				pipeline.Reset()
+				pipeline.ConfirmEngineReset()
+			}
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(retryDelay):
+			}
+			continue
+		} else if err != nil {
+			return result, fmt.Errorf("derivation failed at L1 origin %s: %w", result.Origin, err)
+		}
+		retries = 0
+		if attrs == nil {
+			continue
+		}
+
+		out := &DerivedBlock{
+			Number:       attrs.Parent.Number + 1,
+			Parent:       attrs.Parent,
+			DerivedFrom:  attrs.DerivedFrom,
+			IsLastInSpan: attrs.IsLastInSpan,
+			Attributes:   attrs.Attributes,
+		}
+		if err := jsonutil.WriteJSON(out, ioutil.ToAtomicFile(filepath.Join(outDir, fmt.Sprintf("%d.json", out.Number)), 0o644)); err != nil {
+			return result, fmt.Errorf("failed to write derived block %d: %w", out.Number, err)
+		}
+		result.Derived++
+
+		next, err := l2.L2BlockRefByNumber(ctx, out.Number)
+		if errors.Is(err, ethereum.NotFound) {
+			logger.Info("Reached the end of the L2 chain", "safeHead", safeHead)
+			return result, nil
+		} else if err != nil {
+			return result, fmt.Errorf("failed to get L2 block %d: %w", out.Number, err)
+		}
+		if err := checkDerived(rollupCfg, attrs, next); err != nil {
+			return result, fmt.Errorf("L2 block %s: %w", next, err)
+		}
+		safeHead = next
+		result.SafeHead = next
+		logger.Debug("Derived L2 block", "block", next, "derivedFrom", attrs.DerivedFrom)
+	}
+}
+
+// checkDerived checks that the existing L2 block is the block that the attributes build on the parent.
+func checkDerived(rollupCfg *rollup.Config, attrs *derive.AttributesWithParent, block eth.L2BlockRef) error {
+	if block.ParentHash != attrs.Parent.Hash {
+		return fmt.Errorf("%w: parent %s, expected %s", ErrMismatch, block.ParentHash, attrs.Parent.Hash)
+	}
+	if block.Time != uint64(attrs.Attributes.Timestamp) {
+		return fmt.Errorf("%w: time %d, expected %d", ErrMismatch, block.Time, uint64(attrs.Attributes.Timestamp))
+	}
+	if len(attrs.Attributes.Transactions) == 0 {
+		return fmt.Errorf("%w: missing L1 info deposit", ErrMismatch)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(attrs.Attributes.Transactions[0]); err != nil {
+		return fmt.Errorf("failed to decode L1 info deposit: %w", err)
+	}
+	info, err := derive.L1BlockInfoFromBytes(rollupCfg, block.Time, tx.Data())
+	if err != nil {
+		return fmt.Errorf("failed to parse L1 info deposit: %w", err)
+	}
+	if origin := (eth.BlockID{Hash: info.BlockHash, Number: info.Number}); block.L1Origin != origin {
+		return fmt.Errorf("%w: L1 origin %s, expected %s", ErrMismatch, block.L1Origin, origin)
+	}
+	if block.SequenceNumber != info.SequenceNumber {
+		return fmt.Errorf("%w: sequence number %d, expected %d", ErrMismatch, block.SequenceNumber, info.SequenceNumber)
+	}
+	return nil
+}
+
+// startL2Block returns the L2 block with the timestamp of the L1 block, or the L2 genesis block if the L1 block is older.
+func startL2Block(rollupCfg *rollup.Config, l1Time uint64) (uint64, error) {
+	if l1Time <= rollupCfg.Genesis.L2Time {
+		return rollupCfg.Genesis.L2.Number, nil
+	}
+	return rollupCfg.TargetBlockNumber(l1Time)
+}
@@ -0,0 +1,354 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type stubL1RPC struct {
+	blocks   map[common.Hash]*types.Block
+	receipts map[common.Hash]types.Receipts
+	fetched  int
+	failures int // number of block fetches to fail
+}
+
+func (s *stubL1RPC) InfoByNumber(_ context.Context, number uint64) (eth.BlockInfo, error) {
+	for _, b := range s.blocks {
+		if b.NumberU64() == number {
+			return eth.BlockToInfo(b), nil
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+func (s *stubL1RPC) InfoAndTxsByHash(_ context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	if s.failures > 0 {
+		s.failures--
+		return nil, nil, errors.New("unavailable")
+	}
+	b, ok := s.blocks[hash]
+	if !ok {
+		return nil, nil, ethereum.NotFound
+	}
+	s.fetched++
+	return eth.BlockToInfo(b), b.Transactions(), nil
+}
+
+func (s *stubL1RPC) FetchReceipts(_ context.Context, hash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	b, ok := s.blocks[hash]
+	if !ok {
+		return nil, nil, ethereum.NotFound
+	}
+	return eth.BlockToInfo(b), s.receipts[hash], nil
+}
+
+type stubBlobs map[common.Hash]*eth.Blob
+
+func (s stubBlobs) GetBlobs(_ context.Context, _ eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	out := make([]*eth.Blob, len(hashes))
+	for i, h := range hashes {
+		blob, ok := s[h.Hash]
+		if !ok {
+			return nil, errors.New("unknown blob")
+		}
+		out[i] = blob
+	}
+	return out, nil
+}
+
+func TestL1Source(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	logger := testlog.Logger(t, log.LevelInfo)
+	rpc := &stubL1RPC{blocks: make(map[common.Hash]*types.Block), receipts: make(map[common.Hash]types.Receipts)}
+	var blocks []*types.Block
+	for i := 0; i < 3; i++ {
+		b, receipts := testutils.RandomBlock(rng, 2)
+		b = b.WithSeal(&types.Header{Number: big.NewInt(int64(100 + i)), ParentHash: b.ParentHash(), Time: b.Time(), BaseFee: b.BaseFee(),
+			Difficulty: common.Big0, TxHash: b.TxHash(), ReceiptHash: b.ReceiptHash()})
+		rpc.blocks[b.Hash()] = b
+		rpc.receipts[b.Hash()] = receipts
+		blocks = append(blocks, b)
+	}
+
+	dir := t.TempDir()
+	archive, err := OpenArchive(dir)
+	require.NoError(t, err)
+	blob := &eth.Blob{0x01}
+	blobHash := eth.IndexedBlobHash{Index: 0, Hash: common.Hash{0xbb}}
+	src := NewL1Source(logger, archive, 101, rpc, stubBlobs{blobHash.Hash: blob})
+
+	ref, err := src.L1BlockRefByNumber(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, blocks[0].Hash(), ref.Hash)
+	_, txs, err := src.InfoAndTxsByHash(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, blocks[0].Transactions()[1].Hash(), txs[1].Hash())
+	_, receipts, err := src.FetchReceipts(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, rpc.receipts[ref.Hash][1].TxHash, receipts[1].TxHash)
+	blobs, err := src.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{blobHash})
+	require.NoError(t, err)
+	require.Equal(t, []*eth.Blob{blob}, blobs)
+	require.Equal(t, 1, rpc.fetched, "block must be fetched once")
+
+	_, err = src.L1BlockRefByNumber(context.Background(), 102)
+	require.ErrorIs(t, err, ethereum.NotFound, "blocks after the end must not be served")
+
+	// Reopen the archive, and replay without RPCs
+	archive, err = OpenArchive(dir)
+	require.NoError(t, err)
+	src = NewL1Source(logger, archive, 101, nil, nil)
+	head, err := src.L1BlockRefByLabel(context.Background(), eth.Unsafe)
+	require.ErrorIs(t, err, ethereum.NotFound, "block 101 was never archived")
+	require.Zero(t, head)
+	offline, err := src.L1BlockRefByNumber(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, ref, offline)
+	_, receipts, err = src.FetchReceipts(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	blobs, err = src.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{blobHash})
+	require.NoError(t, err)
+	require.Equal(t, []*eth.Blob{blob}, blobs)
+	_, err = src.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{{Index: 1, Hash: common.Hash{0xcc}}})
+	require.ErrorIs(t, err, ethereum.NotFound)
+}
+
+type stubL2RPC map[uint64]eth.L2BlockRef
+
+func (s stubL2RPC) L2BlockRefByNumber(_ context.Context, num uint64) (eth.L2BlockRef, error) {
+	ref, ok := s[num]
+	if !ok {
+		return eth.L2BlockRef{}, ethereum.NotFound
+	}
+	return ref, nil
+}
+
+func (s stubL2RPC) L2BlockRefByHash(_ context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	for _, ref := range s {
+		if ref.Hash == hash {
+			return ref, nil
+		}
+	}
+	return eth.L2BlockRef{}, ethereum.NotFound
+}
+
+func (s stubL2RPC) SystemConfigByL2Hash(_ context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	return eth.SystemConfig{GasLimit: 30_000_000}, nil
+}
+
+func (s stubL2RPC) PayloadByHash(_ context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error) {
+	return &eth.ExecutionPayloadEnvelope{ExecutionPayload: &eth.ExecutionPayload{BlockHash: hash}}, nil
+}
+
+func TestL2Source(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	logger := testlog.Logger(t, log.LevelInfo)
+	ref := testutils.RandomL2BlockRef(rng)
+	rpc := stubL2RPC{ref.Number: ref}
+
+	dir := t.TempDir()
+	archive, err := OpenArchive(dir)
+	require.NoError(t, err)
+	src := NewL2Source(logger, archive, rpc)
+	got, err := src.L2BlockRefByNumber(context.Background(), ref.Number)
+	require.NoError(t, err)
+	require.Equal(t, ref, got)
+	payload, err := src.PayloadByNumber(context.Background(), ref.Number)
+	require.NoError(t, err)
+	require.Equal(t, ref.Hash, payload.ExecutionPayload.BlockHash)
+	_, err = src.L2BlockRefByLabel(context.Background(), eth.Safe)
+	require.ErrorIs(t, err, errNoEngine)
+
+	archive, err = OpenArchive(dir)
+	require.NoError(t, err)
+	src = NewL2Source(logger, archive, nil)
+	got, err = src.L2BlockRefByHash(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, ref, got)
+	sysCfg, err := src.SystemConfigByL2Hash(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30_000_000), sysCfg.GasLimit)
+	payload, err = src.PayloadByHash(context.Background(), ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, ref.Hash, payload.ExecutionPayload.BlockHash)
+	_, err = src.L2BlockRefByNumber(context.Background(), ref.Number+1)
+	require.ErrorIs(t, err, ethereum.NotFound)
+}
+
+func TestCheckDerived(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	rollupCfg := &rollup.Config{BlockTime: 2, Genesis: rollup.Genesis{L2Time: 1000}}
+	parent := testutils.RandomL2BlockRef(rng)
+	l1Info := testutils.RandomBlockInfo(rng)
+	depositTx, err := derive.L1InfoDepositBytes(rollupCfg, eth.SystemConfig{}, 3, l1Info, parent.Time+2)
+	require.NoError(t, err)
+	attrs := &derive.AttributesWithParent{
+		Parent: parent,
+		Attributes: &eth.PayloadAttributes{
+			Timestamp:    hexutil.Uint64(parent.Time + 2),
+			Transactions: []eth.Data{depositTx},
+		},
+	}
+	block := eth.L2BlockRef{
+		Hash:           common.Hash{0xaa},
+		Number:         parent.Number + 1,
+		ParentHash:     parent.Hash,
+		Time:           parent.Time + 2,
+		L1Origin:       l1Info.ID(),
+		SequenceNumber: 3,
+	}
+	require.NoError(t, checkDerived(rollupCfg, attrs, block))
+
+	for _, modify := range []func(b *eth.L2BlockRef){
+		func(b *eth.L2BlockRef) { b.ParentHash = common.Hash{0x01} },
+		func(b *eth.L2BlockRef) { b.Time++ },
+		func(b *eth.L2BlockRef) { b.L1Origin.Number++ },
+		func(b *eth.L2BlockRef) { b.SequenceNumber = 0 },
+	} {
+		modified := block
+		modify(&modified)
+		require.ErrorIs(t, checkDerived(rollupCfg, attrs, modified), ErrMismatch)
+	}
+}
+
+func TestStartL2Block(t *testing.T) {
+	rollupCfg := &rollup.Config{BlockTime: 2, Genesis: rollup.Genesis{L2Time: 1000, L2: eth.BlockID{Number: 10}}}
+	num, err := startL2Block(rollupCfg, 900)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), num)
+	num, err = startL2Block(rollupCfg, 1021)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), num)
+}
+
+type stubL2Chain struct {
+	stubL2RPC
+	sysCfg eth.SystemConfig
+}
+
+func (s *stubL2Chain) SystemConfigByL2Hash(_ context.Context, _ common.Hash) (eth.SystemConfig, error) {
+	return s.sysCfg, nil
+}
+
+func TestReplayChannelData(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	batcherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sysCfg := eth.SystemConfig{
+		BatcherAddr: crypto.PubkeyToAddress(batcherKey.PublicKey),
+		Overhead:    eth.Bytes32{31: 188},
+		Scalar:      eth.Bytes32{29: 0x0a, 30: 0x00},
+		GasLimit:    30_000_000,
+	}
+
+	// L1 chain: the genesis block, a block with the batcher transaction, and an empty block
+	l1RPC := &stubL1RPC{blocks: make(map[common.Hash]*types.Block), receipts: make(map[common.Hash]types.Receipts)}
+	l1Header := func(num uint64, parent common.Hash) *types.Header {
+		return &types.Header{Number: new(big.Int).SetUint64(num), ParentHash: parent, Time: 1000 + num*12,
+			BaseFee: big.NewInt(7), Difficulty: common.Big0}
+	}
+	l1Genesis := types.NewBlockWithHeader(l1Header(0, common.Hash{}))
+	rollupCfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:           eth.BlockID{Hash: l1Genesis.Hash(), Number: 0},
+			L2:           eth.BlockID{Hash: common.Hash{0x20}, Number: 0},
+			L2Time:       l1Genesis.Time(),
+			SystemConfig: sysCfg,
+		},
+		BlockTime:              2,
+		MaxSequencerDrift:      600,
+		SeqWindowSize:          10,
+		ChannelTimeoutBedrock:  10,
+		L1ChainID:              big.NewInt(900),
+		L2ChainID:              big.NewInt(901),
+		BatchInboxAddress:      common.Address{0xff, 0x01},
+		DepositContractAddress: common.Address{0xdd},
+		L1SystemConfigAddress:  common.Address{0x5c},
+	}
+
+	// L2 chain: three blocks in the genesis epoch after the L2 genesis block
+	l2Genesis := eth.L2BlockRef{Hash: rollupCfg.Genesis.L2.Hash, Time: rollupCfg.Genesis.L2Time, L1Origin: rollupCfg.Genesis.L1}
+	l2RPC := &stubL2Chain{stubL2RPC: stubL2RPC{0: l2Genesis}, sysCfg: sysCfg}
+	ch, err := compressor.NewNonCompressor(compressor.Config{TargetOutputSize: 100_000, CompressionAlgo: derive.Zlib})
+	require.NoError(t, err)
+	co, err := derive.NewSingularChannelOut(ch, rollup.NewChainSpec(rollupCfg))
+	require.NoError(t, err)
+	parent := l2Genesis
+	for i := uint64(1); i <= 3; i++ {
+		block := eth.L2BlockRef{Hash: common.Hash{0x20, byte(i)}, Number: i, ParentHash: parent.Hash,
+			Time: parent.Time + rollupCfg.BlockTime, L1Origin: rollupCfg.Genesis.L1, SequenceNumber: i}
+		l2RPC.stubL2RPC[i] = block
+		require.NoError(t, co.AddSingularBatch(&derive.SingularBatch{
+			ParentHash: parent.Hash,
+			EpochNum:   rollup.Epoch(block.L1Origin.Number),
+			EpochHash:  block.L1Origin.Hash,
+			Timestamp:  block.Time,
+		}, 0))
+		parent = block
+	}
+	require.NoError(t, co.Close())
+	var txData bytes.Buffer
+	txData.WriteByte(derive.DerivationVersion0)
+	_, err = co.OutputFrame(&txData, 100_000)
+	require.ErrorIs(t, err, io.EOF, "channel must fit in a single frame")
+	batchTx, err := types.SignNewTx(batcherKey, rollupCfg.L1Signer(), &types.DynamicFeeTx{
+		ChainID:   rollupCfg.L1ChainID,
+		Gas:       100_000,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		To:        &rollupCfg.BatchInboxAddress,
+		Data:      txData.Bytes(),
+	})
+	require.NoError(t, err)
+	l1Batch := types.NewBlockWithHeader(l1Header(1, l1Genesis.Hash())).WithBody(types.Body{Transactions: []*types.Transaction{batchTx}})
+	l1Empty := types.NewBlockWithHeader(l1Header(2, l1Batch.Hash()))
+	for _, b := range []*types.Block{l1Genesis, l1Batch, l1Empty} {
+		l1RPC.blocks[b.Hash()] = b
+	}
+
+	// Temporary errors are retried
+	l1RPC.failures = 2
+	retryDelay = 0
+	t.Cleanup(func() { retryDelay = time.Second })
+
+	archive, err := OpenArchive(t.TempDir())
+	require.NoError(t, err)
+	l1 := NewL1Source(logger, archive, 2, l1RPC, nil)
+	l2 := NewL2Source(logger, archive, l2RPC)
+	outDir := t.TempDir()
+	result, err := Replay(context.Background(), logger, rollupCfg, l1, l1, l2, 0, outDir)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), result.Derived)
+	require.Equal(t, l2RPC.stubL2RPC[3], result.SafeHead)
+	for i := 1; i <= 3; i++ {
+		_, err := os.Stat(filepath.Join(outDir, fmt.Sprintf("%d.json", i)))
+		require.NoError(t, err, "derived block %d must be written", i)
+	}
+	require.Zero(t, l1RPC.failures)
+}
@@ -0,0 +1,309 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var errNoEngine = errors.New("not available without an engine")
+
+// L1RPC is the L1 node that archives missing L1 data.
+type L1RPC interface {
+	InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error)
+	InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
+}
+
+// L2RPC is the L2 node that archives missing L2 data.
+type L2RPC interface {
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+	L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error)
+	SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error)
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error)
+}
+
+// L1Source serves the L1 data to the derivation pipeline from the archive.
+// L1 blocks after the end block are not served, so the derivation stops there.
+// If an RPC is set, data that is not archived yet is fetched and archived.
+type L1Source struct {
+	log     log.Logger
+	archive *Archive
+	end     uint64
+	rpc     L1RPC                 // optional
+	blobs   derive.L1BlobsFetcher // optional
+}
+
+var (
+	_ derive.L1Fetcher      = (*L1Source)(nil)
+	_ derive.L1BlobsFetcher = (*L1Source)(nil)
+)
+
+// NewL1Source creates an L1 source. The RPC and the blobs fetcher may be nil, to replay from the archive only.
+func NewL1Source(log log.Logger, archive *Archive, end uint64, rpc L1RPC, blobs derive.L1BlobsFetcher) *L1Source {
+	return &L1Source{
+		log:     log,
+		archive: archive,
+		end:     end,
+		rpc:     rpc,
+		blobs:   blobs,
+	}
+}
+
+func (s *L1Source) blockByHash(ctx context.Context, hash common.Hash) (*L1Block, error) {
+	b, err := s.archive.L1BlockByHash(hash)
+	if errors.Is(err, ethereum.NotFound) && s.rpc != nil {
+		return s.fetch(ctx, hash)
+	}
+	return b, err
+}
+
+func (s *L1Source) blockByNumber(ctx context.Context, num uint64) (*L1Block, error) {
+	if num > s.end {
+		return nil, fmt.Errorf("%w: L1 block %d is after the end block %d", ethereum.NotFound, num, s.end)
+	}
+	b, err := s.archive.L1BlockByNumber(num)
+	if errors.Is(err, ethereum.NotFound) && s.rpc != nil {
+		info, err := s.rpc.InfoByNumber(ctx, num)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 block %d: %w", num, err)
+		}
+		return s.fetch(ctx, info.Hash())
+	}
+	return b, err
+}
+
+func (s *L1Source) fetch(ctx context.Context, hash common.Hash) (*L1Block, error) {
+	info, txs, err := s.rpc.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 block %s: %w", hash, err)
+	}
+	_, receipts, err := s.rpc.FetchReceipts(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipts of L1 block %s: %w", hash, err)
+	}
+	headerRLP, err := info.HeaderRLP()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode header of L1 block %s: %w", hash, err)
+	}
+	var header types.Header
+	if err := rlp.DecodeBytes(headerRLP, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode header of L1 block %s: %w", hash, err)
+	}
+	b := &L1Block{Header: &header, Transactions: txs, Receipts: receipts}
+	if err := s.archive.PutL1Block(b); err != nil {
+		return nil, err
+	}
+	s.log.Debug("Archived L1 block", "number", header.Number, "hash", hash)
+	return b, nil
+}
+
+// L1BlockRefByLabel returns the end block for every label, it is the head of the replayed L1 chain.
+func (s *L1Source) L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	return s.L1BlockRefByNumber(ctx, s.end)
+}
+
+func (s *L1Source) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	b, err := s.blockByNumber(ctx, num)
+	if err != nil {
+		return eth.L1BlockRef{}, err
+	}
+	return eth.HeaderToL1BlockRef(b.Header), nil
+}
+
+func (s *L1Source) L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	info, err := s.InfoByHash(ctx, hash)
+	if err != nil {
+		return eth.L1BlockRef{}, err
+	}
+	return eth.InfoToL1BlockRef(info), nil
+}
+
+func (s *L1Source) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	b, err := s.blockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), nil
+}
+
+func (s *L1Source) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	b, err := s.blockByHash(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), b.Receipts, nil
+}
+
+func (s *L1Source) InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	b, err := s.blockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), b.Transactions, nil
+}
+
+// GetBlobs returns the archived blobs. Blobs that are not archived yet are fetched, if a blobs fetcher is set.
+func (s *L1Source) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	b, err := s.blockByHash(ctx, ref.Hash)
+	if err != nil {
+		return nil, err
+	}
+	var missing []eth.IndexedBlobHash
+	for _, h := range hashes {
+		if _, ok := b.Blobs[h.Hash]; !ok {
+			missing = append(missing, h)
+		}
+	}
+	if len(missing) > 0 {
+		if s.blobs == nil {
+			return nil, fmt.Errorf("%w: %d blobs of L1 block %s", ethereum.NotFound, len(missing), ref)
+		}
+		fetched, err := s.blobs.GetBlobs(ctx, ref, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch blobs of L1 block %s: %w", ref, err)
+		}
+		if b.Blobs == nil {
+			b.Blobs = make(map[common.Hash]*eth.Blob)
+		}
+		for i, h := range missing {
+			b.Blobs[h.Hash] = fetched[i]
+		}
+		if err := s.archive.PutL1Block(b); err != nil {
+			return nil, err
+		}
+	}
+	blobs := make([]*eth.Blob, len(hashes))
+	for i, h := range hashes {
+		blobs[i] = b.Blobs[h.Hash]
+	}
+	return blobs, nil
+}
+
+// L2Source serves the existing L2 chain to the derivation pipeline from the archive,
+// in place of an engine. If an RPC is set, data that is not archived yet is fetched and archived.
+type L2Source struct {
+	log     log.Logger
+	archive *Archive
+	rpc     L2RPC // optional
+}
+
+var _ derive.L2Source = (*L2Source)(nil)
+
+// NewL2Source creates an L2 source. The RPC may be nil, to replay from the archive only.
+func NewL2Source(log log.Logger, archive *Archive, rpc L2RPC) *L2Source {
+	return &L2Source{
+		log:     log,
+		archive: archive,
+		rpc:     rpc,
+	}
+}
+
+func (s *L2Source) blockByNumber(ctx context.Context, num uint64) (*L2Block, error) {
+	b, err := s.archive.L2BlockByNumber(num)
+	if errors.Is(err, ethereum.NotFound) && s.rpc != nil {
+		ref, err := s.rpc.L2BlockRefByNumber(ctx, num)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L2 block %d: %w", num, err)
+		}
+		return s.fetch(ctx, ref)
+	}
+	return b, err
+}
+
+func (s *L2Source) blockByHash(ctx context.Context, hash common.Hash) (*L2Block, error) {
+	b, err := s.archive.L2BlockByHash(hash)
+	if errors.Is(err, ethereum.NotFound) && s.rpc != nil {
+		ref, err := s.rpc.L2BlockRefByHash(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L2 block %s: %w", hash, err)
+		}
+		return s.fetch(ctx, ref)
+	}
+	return b, err
+}
+
+func (s *L2Source) fetch(ctx context.Context, ref eth.L2BlockRef) (*L2Block, error) {
+	sysCfg, err := s.rpc.SystemConfigByL2Hash(ctx, ref.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch system config of L2 block %s: %w", ref, err)
+	}
+	b := &L2Block{Ref: ref, SystemConfig: sysCfg}
+	if err := s.archive.PutL2Block(b); err != nil {
+		return nil, err
+	}
+	s.log.Debug("Archived L2 block", "block", ref)
+	return b, nil
+}
+
+func (s *L2Source) L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error) {
+	b, err := s.blockByNumber(ctx, num)
+	if err != nil {
+		return eth.L2BlockRef{}, err
+	}
+	return b.Ref, nil
+}
+
+func (s *L2Source) L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	b, err := s.blockByHash(ctx, hash)
+	if err != nil {
+		return eth.L2BlockRef{}, err
+	}
+	return b.Ref, nil
+}
+
+func (s *L2Source) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	b, err := s.blockByHash(ctx, hash)
+	if err != nil {
+		return eth.SystemConfig{}, err
+	}
+	return b.SystemConfig, nil
+}
+
+// L2BlockRefByLabel is not supported, the replay has no engine to track the L2 heads.
+func (s *L2Source) L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	return eth.L2BlockRef{}, fmt.Errorf("L2 block by label %s: %w", label, errNoEngine)
+}
+
+func (s *L2Source) PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayloadEnvelope, error) {
+	b, err := s.blockByNumber(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	return s.payload(ctx, b)
+}
+
+func (s *L2Source) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayloadEnvelope, error) {
+	b, err := s.blockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return s.payload(ctx, b)
+}
+
+func (s *L2Source) payload(ctx context.Context, b *L2Block) (*eth.ExecutionPayloadEnvelope, error) {
+	if b.Payload != nil {
+		return b.Payload, nil
+	}
+	if s.rpc == nil {
+		return nil, fmt.Errorf("%w: payload of L2 block %s", ethereum.NotFound, b.Ref)
+	}
+	payload, err := s.rpc.PayloadByHash(ctx, b.Ref.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch payload of L2 block %s: %w", b.Ref, err)
+	}
+	b.Payload = payload
+	if err := s.archive.PutL2Block(b); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum/go-ethereum/params"
+
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
@@ -66,6 +68,14 @@ var (
 		Destination: new(string),
 		Category:    RollupCategory,
 	}
+	L2EngineJWTRotationWindow = &cli.DurationFlag{
+		Name: "l2.jwt-secret-rotation-window",
+		Usage: "Reload the JWT secret when the file of l2.jwt-secret changes, and keep accepting the previous secret for this duration after a change, " +
+			"to rotate the engine credentials without a restart. Disabled if set to 0.",
+		EnvVars:  prefixEnvVars("L2_JWT_SECRET_ROTATION_WINDOW"),
+		Value:    0,
+		Category: RollupCategory,
+	}
 	BeaconAddr = &cli.StringFlag{
 		Name:     "l1.beacon",
 		Usage:    "Address of L1 Beacon-node HTTP endpoint to use.",
@@ -89,9 +99,10 @@ var (
 		Category: L1RPCCategory,
 	}
 	BeaconFallbackAddrs = &cli.StringSliceFlag{
-		Name:     "l1.beacon-fallbacks",
-		Aliases:  []string{"l1.beacon-archiver"},
-		Usage:    "Addresses of L1 Beacon-API compatible HTTP fallback endpoints. Used to fetch blob sidecars not availalbe at the l1.beacon (e.g. expired blobs).",
+		Name:    "l1.beacon-fallbacks",
+		Aliases: []string{"l1.beacon-archiver"},
+		Usage: "Addresses of L1 Beacon-API compatible HTTP fallback endpoints, e.g. blob archivers. Used to fetch blob sidecars not available at the l1.beacon (e.g. expired blobs). " +
+			"Queried in the given order, and health checked periodically.",
 		EnvVars:  prefixEnvVars("L1_BEACON_FALLBACKS", "L1_BEACON_ARCHIVER"),
 		Category: L1RPCCategory,
 	}
@@ -121,6 +132,14 @@ var (
 		}(),
 		Category: RollupCategory,
 	}
+	UnsafeQuarantineTTL = &cli.DurationFlag{
+		Name: "l2.unsafe-quarantine-ttl",
+		Usage: "Time to hold unsafe payloads referencing an L1 origin ahead of the local L1 head, " +
+			"until the L1 head catches up, instead of processing them right away. 0 to disable.",
+		EnvVars:  prefixEnvVars("L2_UNSAFE_QUARANTINE_TTL"),
+		Value:    0,
+		Category: RollupCategory,
+	}
 	RPCListenAddr = &cli.StringFlag{
 		Name:     "rpc.addr",
 		Usage:    "RPC listening address",
@@ -141,6 +160,35 @@ var (
 		EnvVars:  prefixEnvVars("RPC_ENABLE_ADMIN"),
 		Category: OperationsCategory,
 	}
+	RPCEnableTxConditional = &cli.BoolFlag{
+		Name: "rpc.enable-tx-conditional",
+		Usage: "Serve eth_sendRawTransactionConditional on the sequencer, enforcing the known-account and block-range conditionals " +
+			"before forwarding the transactions. The execution engine must support conditional transactions.",
+		EnvVars:  prefixEnvVars("RPC_ENABLE_TX_CONDITIONAL"),
+		Category: SequencerCategory,
+	}
+	RPCTxConditionalMaxCost = &cli.IntFlag{
+		Name:     "rpc.tx-conditional-max-cost",
+		Usage:    "Maximum cost, in storage lookups, of the conditional of a transaction",
+		EnvVars:  prefixEnvVars("RPC_TX_CONDITIONAL_MAX_COST"),
+		Value:    params.TransactionConditionalMaxCost,
+		Category: SequencerCategory,
+	}
+	RPCEnableP2PTopology = &cli.BoolFlag{
+		Name: "rpc.enable-p2p-topology",
+		Usage: "Serve the connected peers, their agent versions, ENRs and gossip mesh membership as JSON on /p2p/topology, " +
+			"for network-health crawlers to map the P2P network",
+		EnvVars:  prefixEnvVars("RPC_ENABLE_P2P_TOPOLOGY"),
+		Category: P2PCategory,
+	}
+	RPCP2PTopologyAddresses = &cli.StringFlag{
+		Name: "rpc.p2p-topology-addresses",
+		Usage: "Addresses of the node and its peers included in the P2P topology. " +
+			"'all' includes LAN and loopback addresses, 'public' only publicly routable addresses, 'none' omits addresses and ENRs",
+		EnvVars:  prefixEnvVars("RPC_P2P_TOPOLOGY_ADDRESSES"),
+		Value:    "public",
+		Category: P2PCategory,
+	}
 	RPCAdminPersistence = &cli.StringFlag{
 		Name:     "rpc.admin-state",
 		Usage:    "File path used to persist state changes made via the admin API so they persist across restarts. Disabled if not set.",
@@ -164,6 +212,14 @@ var (
 		}(),
 		Category: L1RPCCategory,
 	}
+	L1DataSourceFlag = &cli.StringFlag{
+		Name: "l1.data-source",
+		Usage: "Data source to read the batch data of L1 blocks from, for derivation, as <name>[:<arg>]. " +
+			"The batch data is read from L1 transactions and blobs by default. " +
+			"The built-in 'file:<directory>' source reads the batch data from JSON files named by L1 block hash, and falls back to L1.",
+		EnvVars:  prefixEnvVars("L1_DATA_SOURCE"),
+		Category: L1RPCCategory,
+	}
 	L1RPCMaxConcurrency = &cli.IntFlag{
 		Name:     "l1.max-concurrency",
 		Usage:    "Maximum number of concurrent RPC requests to make to the L1 RPC provider.",
@@ -192,6 +248,26 @@ var (
 		Value:    time.Second * 12,
 		Category: L1RPCCategory,
 	}
+	L1CacheDirFlag = &cli.StringFlag{
+		Name:     "l1.cache-dir",
+		Usage:    "Directory to persist fetched L1 headers and receipts in, to avoid refetching them after a restart. Disabled if empty.",
+		EnvVars:  prefixEnvVars("L1_CACHE_DIR"),
+		Category: L1RPCCategory,
+	}
+	L1CacheSizeFlag = &cli.Uint64Flag{
+		Name:     "l1.cache-size",
+		Usage:    "Maximum size in MiB of each of the persistent L1 header and receipt caches. Only used if the persistent L1 cache is enabled.",
+		EnvVars:  prefixEnvVars("L1_CACHE_SIZE"),
+		Value:    1024,
+		Category: L1RPCCategory,
+	}
+	L1MaxReorgDepthFlag = &cli.Uint64Flag{
+		Name:     "l1.max-reorg-depth",
+		Usage:    "Maximum depth of an L1 reorg to tolerate. Derivation halts on deeper L1 reorgs, until acknowledged with the admin_acknowledgeL1Reorg RPC. Disabled if set to 0.",
+		EnvVars:  prefixEnvVars("L1_MAX_REORG_DEPTH"),
+		Value:    0,
+		Category: L1RPCCategory,
+	}
 	L2EngineKind = &cli.GenericFlag{
 		Name: "l2.enginekind",
 		Usage: "The kind of engine client, used to control the behavior of optimism in respect to different types of engine clients. Valid options: " +
@@ -229,6 +305,42 @@ var (
 		Value:    0,
 		Category: SequencerCategory,
 	}
+	SequencerClockTargetDriftFlag = &cli.DurationFlag{
+		Name: "sequencer.clock-target-drift",
+		Usage: "Maximum desired distance between the timestamp of a new L2 block and its L1 origin. " +
+			"When exceeded, e.g. due to local clock drift, block production is slowed down to let L1 catch up. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_CLOCK_TARGET_DRIFT"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerClockMaxAdjustmentFlag = &cli.DurationFlag{
+		Name:     "sequencer.clock-max-adjustment",
+		Usage:    "Maximum time block production may fall behind the local wall clock when slowed down by sequencer.clock-target-drift.",
+		EnvVars:  prefixEnvVars("SEQUENCER_CLOCK_MAX_ADJUSTMENT"),
+		Value:    time.Minute,
+		Category: SequencerCategory,
+	}
+	SequencerBudgetFCUFlag = &cli.DurationFlag{
+		Name:     "sequencer.budget.fcu",
+		Usage:    "Latency budget of the forkchoice-update that starts building a block. Blocks that exceed the budget are followed by a block without tx-pool transactions. Not enforced if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_BUDGET_FCU"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerBudgetGetPayloadFlag = &cli.DurationFlag{
+		Name:     "sequencer.budget.get-payload",
+		Usage:    "Latency budget of retrieving the built block from the engine. Blocks that exceed the budget are followed by a block without tx-pool transactions. Not enforced if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_BUDGET_GET_PAYLOAD"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerBudgetPublishFlag = &cli.DurationFlag{
+		Name:     "sequencer.budget.publish",
+		Usage:    "Latency budget of committing a built block to the conductor and handing it off for gossip. Blocks that exceed the budget are followed by a block without tx-pool transactions. Not enforced if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_BUDGET_PUBLISH"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
 	SequencerL1Confs = &cli.Uint64Flag{
 		Name:     "sequencer.l1-confs",
 		Usage:    "Number of L1 blocks to keep distance from the L1 head as a sequencer for picking an L1 origin.",
@@ -243,6 +355,26 @@ var (
 		Value:    time.Second * 12 * 32,
 		Category: L1RPCCategory,
 	}
+	L1HealthIntervalFlag = &cli.DurationFlag{
+		Name:     "l1.health.interval",
+		Usage:    "Interval between checks of the L1 RPC's head and finalized blocks against the L1 beacon node and the secondary L1 RPC, to detect a lagging or forked L1 RPC. Disabled if 0.",
+		EnvVars:  prefixEnvVars("L1_HEALTH_INTERVAL"),
+		Value:    0,
+		Category: L1RPCCategory,
+	}
+	L1HealthMaxLagFlag = &cli.Uint64Flag{
+		Name:     "l1.health.max-lag",
+		Usage:    "Number of blocks the L1 RPC may trail the L1 beacon node or the secondary L1 RPC before it is reported as lagging.",
+		EnvVars:  prefixEnvVars("L1_HEALTH_MAX_LAG"),
+		Value:    3,
+		Category: L1RPCCategory,
+	}
+	L1HealthSecondaryRPCFlag = &cli.StringFlag{
+		Name:     "l1.health.secondary-rpc",
+		Usage:    "Optional second L1 RPC to compare the L1 RPC against in the L1 health checks.",
+		EnvVars:  prefixEnvVars("L1_HEALTH_SECONDARY_RPC"),
+		Category: L1RPCCategory,
+	}
 	RuntimeConfigReloadIntervalFlag = &cli.DurationFlag{
 		Name:     "l1.runtime-config-reload-interval",
 		Usage:    "Poll interval for reloading the runtime config, useful when config events are not being picked up. Disabled if 0 or negative.",
@@ -304,6 +436,14 @@ var (
 		EnvVars:  prefixEnvVars("ROLLUP_HALT"),
 		Category: RollupCategory,
 	}
+	RollupHaltAction = &cli.StringFlag{
+		Name: "rollup.halt-action",
+		Usage: "Action when halting on incompatible protocol version requirements: 'shutdown' to stop the node, " +
+			"or 'pause' to stop sequencing and derivation until overridden with admin_overrideProtocolVersionHalt",
+		EnvVars:  prefixEnvVars("ROLLUP_HALT_ACTION"),
+		Value:    "shutdown",
+		Category: RollupCategory,
+	}
 	RollupLoadProtocolVersions = &cli.BoolFlag{
 		Name:     "rollup.load-protocol-versions",
 		Usage:    "Load protocol versions from the superchain L1 ProtocolVersions contract (if available), and report in logs and metrics",
@@ -381,6 +521,7 @@ var requiredFlags = []cli.Flag{
 }
 
 var optionalFlags = []cli.Flag{
+	L2EngineJWTRotationWindow,
 	SupervisorAddr,
 	BeaconAddr,
 	BeaconHeader,
@@ -388,6 +529,7 @@ var optionalFlags = []cli.Flag{
 	BeaconCheckIgnore,
 	BeaconFetchAllSidecars,
 	SyncModeFlag,
+	UnsafeQuarantineTTL,
 	RPCListenAddr,
 	RPCListenPort,
 	L1TrustRPC,
@@ -395,16 +537,32 @@ var optionalFlags = []cli.Flag{
 	L1RPCRateLimit,
 	L1RPCMaxBatchSize,
 	L1RPCMaxConcurrency,
+	L1DataSourceFlag,
 	L1HTTPPollInterval,
+	L1CacheDirFlag,
+	L1CacheSizeFlag,
+	L1MaxReorgDepthFlag,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
 	SequencerStoppedFlag,
 	SequencerMaxSafeLagFlag,
+	SequencerClockTargetDriftFlag,
+	SequencerClockMaxAdjustmentFlag,
+	SequencerBudgetFCUFlag,
+	SequencerBudgetGetPayloadFlag,
+	SequencerBudgetPublishFlag,
 	SequencerL1Confs,
 	L1EpochPollIntervalFlag,
+	L1HealthIntervalFlag,
+	L1HealthMaxLagFlag,
+	L1HealthSecondaryRPCFlag,
 	RuntimeConfigReloadIntervalFlag,
 	RPCEnableAdmin,
 	RPCAdminPersistence,
+	RPCEnableTxConditional,
+	RPCTxConditionalMaxCost,
+	RPCEnableP2PTopology,
+	RPCP2PTopologyAddresses,
 	MetricsEnabledFlag,
 	MetricsAddrFlag,
 	MetricsPortFlag,
@@ -413,6 +571,7 @@ var optionalFlags = []cli.Flag{
 	HeartbeatMonikerFlag,
 	HeartbeatURLFlag,
 	RollupHalt,
+	RollupHaltAction,
 	RollupLoadProtocolVersions,
 	ConductorEnabledFlag,
 	ConductorRpcFlag,
@@ -22,6 +22,7 @@ var (
 	BanningName             = "p2p.ban.peers"
 	BanningThresholdName    = "p2p.ban.threshold"
 	BanningDurationName     = "p2p.ban.duration"
+	ScorePersistName        = "p2p.score.persist"
 	TopicScoringName        = "p2p.scoring.topics"
 	P2PPrivPathName         = "p2p.priv.path"
 	P2PPrivRawName          = "p2p.priv.raw"
@@ -52,6 +53,13 @@ var (
 	GossipMeshDhiName       = "p2p.gossip.mesh.dhi"
 	GossipMeshDlazyName     = "p2p.gossip.mesh.dlazy"
 	GossipFloodPublishName  = "p2p.gossip.mesh.floodpublish"
+	GossipTraceFileName     = "p2p.gossip.trace.file"
+	GossipTraceMaxSizeName  = "p2p.gossip.trace.file.max-size"
+	GossipTraceMaxFilesName = "p2p.gossip.trace.file.max-files"
+	GossipTraceRemoteName   = "p2p.gossip.trace.remote"
+	GossipTxsName           = "p2p.gossip.txs"
+	GossipTxsRateLimitName  = "p2p.gossip.txs.rate-limit"
+	GossipTxsRateBurstName  = "p2p.gossip.txs.rate-burst"
 	SyncReqRespName         = "p2p.sync.req-resp"
 	SyncOnlyReqToStaticName = "p2p.sync.onlyreqtostatic"
 	P2PPingName             = "p2p.ping"
@@ -135,6 +143,15 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			EnvVars:  p2pEnv(envPrefix, "PEER_BANNING_DURATION"),
 			Category: P2PCategory,
 		},
+		&cli.BoolFlag{
+			Name: ScorePersistName,
+			Usage: "Keep peer scores in the peerstore across restarts. Peers whose persisted score is below the ban threshold are banned again on startup. " +
+				"Scores are only kept in memory if disabled.",
+			Required: false,
+			Value:    true,
+			EnvVars:  p2pEnv(envPrefix, "SCORE_PERSIST"),
+			Category: P2PCategory,
+		},
 		&cli.StringFlag{
 			Name: P2PPrivPathName,
 			Usage: "Read the hex-encoded 32-byte private key for the peer ID from this txt file. Created if not already exists." +
@@ -386,6 +403,61 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			EnvVars:  p2pEnv(envPrefix, "GOSSIP_FLOOD_PUBLISH"),
 			Category: P2PCategory,
 		},
+		&cli.PathFlag{
+			Name:      GossipTraceFileName,
+			Usage:     "File to write gossip publish, deliver and reject events to, in the libp2p pubsub JSON tracing format. Disabled if empty.",
+			Required:  false,
+			TakesFile: true,
+			EnvVars:   p2pEnv(envPrefix, "GOSSIP_TRACE_FILE"),
+			Category:  P2PCategory,
+		},
+		&cli.Uint64Flag{
+			Name:     GossipTraceMaxSizeName,
+			Usage:    "Size in MiB after which the gossip trace file is rotated.",
+			Required: false,
+			Value:    100,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TRACE_FILE_MAX_SIZE"),
+			Category: P2PCategory,
+		},
+		&cli.UintFlag{
+			Name:     GossipTraceMaxFilesName,
+			Usage:    "Number of rotated gossip trace files to keep.",
+			Required: false,
+			Value:    10,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TRACE_FILE_MAX_FILES"),
+			Category: P2PCategory,
+		},
+		&cli.StringFlag{
+			Name:     GossipTraceRemoteName,
+			Usage:    "Multi-address, including the peer ID, of a libp2p trace collector to stream gossip publish, deliver and reject events to. Disabled if empty.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TRACE_REMOTE"),
+			Category: P2PCategory,
+		},
+		&cli.BoolFlag{
+			Name: GossipTxsName,
+			Usage: "Enables the gossip of user transactions. Followers publish the transactions submitted to their RPC with eth_sendRawTransaction, " +
+				"and the sequencer forwards gossiped transactions to its execution engine.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TXS"),
+			Category: P2PCategory,
+		},
+		&cli.Float64Flag{
+			Name:     GossipTxsRateLimitName,
+			Usage:    "Number of gossiped transactions per second accepted from a single peer.",
+			Required: false,
+			Value:    100,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TXS_RATE_LIMIT"),
+			Category: P2PCategory,
+		},
+		&cli.IntFlag{
+			Name:     GossipTxsRateBurstName,
+			Usage:    "Number of gossiped transactions a single peer may send at once, before being rate limited.",
+			Required: false,
+			Value:    200,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_TXS_RATE_BURST"),
+			Category: P2PCategory,
+		},
 		&cli.BoolFlag{
 			Name:     SyncReqRespName,
 			Usage:    "Enables P2P req-resp alternative sync method, on both server and client side.",
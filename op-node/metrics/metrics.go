@@ -47,9 +47,11 @@ type Metricer interface {
 	RecordL1Ref(name string, ref eth.L1BlockRef)
 	RecordL2Ref(name string, ref eth.L2BlockRef)
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
+	RecordUnsafePayloadsQuarantine(length uint64)
 	RecordDerivedBatches(batchType string)
 	CountSequencedTxs(count int)
 	RecordL1ReorgDepth(d uint64)
+	RecordL1ReorgHalted(halted bool)
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
 	RecordGossipEvent(evType int32)
@@ -60,6 +62,13 @@ type Metricer interface {
 	RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.BandwidthCounter)
 	RecordSequencerBuildingDiffTime(duration time.Duration)
 	RecordSequencerSealingTime(duration time.Duration)
+	RecordSequencerClockDrift(l2HeadDrift time.Duration, l1OriginDrift time.Duration)
+	RecordSequencerClockAdjustment(delay time.Duration)
+	RecordSequencerSealingMargin(margin time.Duration)
+	RecordSequencerDeadlineMiss()
+	RecordSequencerDeadlineMissAvoided()
+	RecordSequencerStageLatency(stage string, latency time.Duration)
+	RecordSequencerAdmission(action string)
 	Document() []metrics.DocumentedMetric
 	RecordChannelInputBytes(num int)
 	RecordHeadChannelOpened()
@@ -75,6 +84,11 @@ type Metricer interface {
 	RecordDial(allow bool)
 	RecordAccept(allow bool)
 	ReportProtocolVersions(local, engine, recommended, required params.ProtocolVersion)
+	RecordConditionalTxRejected(policy string)
+	RecordConditionalTxForwarded()
+	RecordGossipTx(event string)
+	RecordBlobSourceRequest(source string, result string)
+	RecordBlobSourceHealth(source string, healthy bool)
 }
 
 // Metrics tracks all the metrics for the op-node.
@@ -126,12 +140,26 @@ type Metrics struct {
 	SequencerSealingDurationSeconds prometheus.Histogram
 	SequencerSealingTotal           prometheus.Counter
 
+	SequencerL2HeadDriftSeconds     prometheus.Gauge
+	SequencerL1OriginDriftSeconds   prometheus.Gauge
+	SequencerClockAdjustmentSeconds prometheus.Counter
+	SequencerClockAdjustmentTotal   prometheus.Counter
+
+	SequencerSealingMarginSeconds     prometheus.Gauge
+	SequencerDeadlineMissTotal        prometheus.Counter
+	SequencerDeadlineMissAvoidedTotal prometheus.Counter
+
+	SequencerStageDurationSeconds *prometheus.HistogramVec
+	SequencerAdmissionTotal       *prometheus.CounterVec
+
 	UnsafePayloadsBufferLen     prometheus.Gauge
 	UnsafePayloadsBufferMemSize prometheus.Gauge
+	UnsafePayloadsQuarantineLen prometheus.Gauge
 
 	metrics.RefMetrics
 
-	L1ReorgDepth prometheus.Histogram
+	L1ReorgDepth  prometheus.Histogram
+	L1ReorgHalted prometheus.Gauge
 
 	TransactionsSequencedTotal prometheus.Counter
 
@@ -161,6 +189,14 @@ type Metrics struct {
 	// ProtocolVersions is pseudo-metric to report the exact protocol version info
 	ProtocolVersions *prometheus.GaugeVec
 
+	ConditionalTxRejections *prometheus.CounterVec
+	ConditionalTxsForwarded prometheus.Counter
+
+	GossipTxs *prometheus.CounterVec
+
+	BlobSourceRequests *prometheus.CounterVec
+	BlobSourceHealth   *prometheus.GaugeVec
+
 	registry *prometheus.Registry
 	factory  metrics.Factory
 }
@@ -251,6 +287,11 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "unsafe_payloads_buffer_mem_size",
 			Help:      "Total estimated memory size of buffered L2 unsafe payloads",
 		}),
+		UnsafePayloadsQuarantineLen: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "unsafe_payloads_quarantine_len",
+			Help:      "Number of quarantined L2 unsafe payloads, referencing L1 origins ahead of the L1 head",
+		}),
 
 		RefMetrics: metrics.MakeRefMetrics(ns, factory),
 
@@ -260,6 +301,11 @@ func NewMetrics(procName string) *Metrics {
 			Buckets:   []float64{0.5, 1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5, 20.5, 50.5, 100.5},
 			Help:      "Histogram of L1 Reorg Depths",
 		}),
+		L1ReorgHalted: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "l1_reorg_halted",
+			Help:      "1 if sequencing and derivation are halted on a L1 reorg deeper than the max reorg depth, until acknowledged by the operator",
+		}),
 
 		TransactionsSequencedTotal: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -325,6 +371,35 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "accepts",
 			Help:      "Count of incoming dial attempts to accept, with label to filter to allowed attempts",
 		}, []string{"allow"}),
+		ConditionalTxRejections: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "conditional_tx_rejections_total",
+			Help:      "Count of conditional transactions rejected at ingress, by the policy of which the conditional was not met",
+		}, []string{"policy"}),
+		ConditionalTxsForwarded: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "conditional_txs_forwarded_total",
+			Help:      "Count of conditional transactions that passed all ingress policies and were forwarded to the execution engine",
+		}),
+
+		GossipTxs: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "gossip_txs_total",
+			Help:      "Count of user transactions published to, or received from, the tx gossip topic, by event",
+		}, []string{"event"}),
+
+		BlobSourceRequests: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "l1_beacon",
+			Name:      "blob_source_requests_total",
+			Help:      "Count of blob sidecar requests to the L1 beacon node and its fallbacks, by source and result",
+		}, []string{"source", "result"}),
+		BlobSourceHealth: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "l1_beacon",
+			Name:      "blob_source_healthy",
+			Help:      "1 if the last request or health check of the blob source succeeded, 0 otherwise",
+		}, []string{"source"}),
 
 		headChannelOpenedEvent: metrics.NewEvent(factory, ns, "", "head_channel", "New channel at the front of the channel bank"),
 		channelTimedOutEvent:   metrics.NewEvent(factory, ns, "", "channel_timeout", "Channel has timed out"),
@@ -406,6 +481,52 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "sequencer_sealing_total",
 			Help:      "Number of sequencer block sealing jobs",
 		}),
+		SequencerL2HeadDriftSeconds: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "sequencer_l2_head_drift_seconds",
+			Help:      "Timestamp of the latest unsafe L2 head minus the local wall clock time",
+		}),
+		SequencerL1OriginDriftSeconds: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "sequencer_l1_origin_drift_seconds",
+			Help:      "Timestamp of the next L2 block minus the timestamp of its L1 origin",
+		}),
+		SequencerClockAdjustmentSeconds: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "sequencer_clock_adjustment_seconds",
+			Help:      "Total time block production was delayed by sequencer clock discipline",
+		}),
+		SequencerClockAdjustmentTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "sequencer_clock_adjustment_total",
+			Help:      "Number of times block production was delayed by sequencer clock discipline",
+		}),
+		SequencerSealingMarginSeconds: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "sequencer_sealing_margin_seconds",
+			Help:      "Time before the payload time the sequencer starts sealing, adapted to the recent sealing latency",
+		}),
+		SequencerDeadlineMissTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "sequencer_deadline_miss_total",
+			Help:      "Number of sequenced blocks that were not inserted by their payload time",
+		}),
+		SequencerDeadlineMissAvoidedTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "sequencer_deadline_miss_avoided_total",
+			Help:      "Number of sequenced blocks inserted by their payload time, that would have missed it with the minimum sealing margin",
+		}),
+		SequencerStageDurationSeconds: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "sequencer_stage_seconds",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+			Help:      "Histogram of the latency of the stages of sequencer block building",
+		}, []string{"stage"}),
+		SequencerAdmissionTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "sequencer_admission_total",
+			Help:      "Number of blocks with limited tx-pool transactions, because block building was behind the latency budget",
+		}, []string{"action"}),
 
 		ProtocolVersionDelta: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -512,6 +633,10 @@ func (m *Metrics) RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next
 	m.UnsafePayloadsBufferMemSize.Set(float64(memSize))
 }
 
+func (m *Metrics) RecordUnsafePayloadsQuarantine(length uint64) {
+	m.UnsafePayloadsQuarantineLen.Set(float64(length))
+}
+
 func (m *Metrics) RecordDerivedBatches(batchType string) {
 	m.DerivedBatches.Record(batchType)
 }
@@ -524,6 +649,14 @@ func (m *Metrics) RecordL1ReorgDepth(d uint64) {
 	m.L1ReorgDepth.Observe(float64(d))
 }
 
+func (m *Metrics) RecordL1ReorgHalted(halted bool) {
+	var val float64
+	if halted {
+		val = 1
+	}
+	m.L1ReorgHalted.Set(val)
+}
+
 func (m *Metrics) RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 	m.SequencerInconsistentL1Origin.Record()
 	m.RecordRef("l1_origin", "inconsistent_from", from.Number, 0, from.Hash)
@@ -590,6 +723,45 @@ func (m *Metrics) RecordSequencerSealingTime(duration time.Duration) {
 	m.SequencerSealingDurationSeconds.Observe(float64(duration) / float64(time.Second))
 }
 
+// RecordSequencerClockDrift tracks the drift of the unsafe L2 head relative to the local wall clock,
+// and of the next L2 block relative to its L1 origin.
+func (m *Metrics) RecordSequencerClockDrift(l2HeadDrift time.Duration, l1OriginDrift time.Duration) {
+	m.SequencerL2HeadDriftSeconds.Set(l2HeadDrift.Seconds())
+	m.SequencerL1OriginDriftSeconds.Set(l1OriginDrift.Seconds())
+}
+
+// RecordSequencerClockAdjustment tracks delays applied to block production by sequencer clock discipline.
+func (m *Metrics) RecordSequencerClockAdjustment(delay time.Duration) {
+	m.SequencerClockAdjustmentTotal.Inc()
+	m.SequencerClockAdjustmentSeconds.Add(delay.Seconds())
+}
+
+// RecordSequencerSealingMargin tracks how long before the payload time the sequencer starts sealing.
+func (m *Metrics) RecordSequencerSealingMargin(margin time.Duration) {
+	m.SequencerSealingMarginSeconds.Set(margin.Seconds())
+}
+
+// RecordSequencerDeadlineMiss tracks sequenced blocks that were not inserted by their payload time.
+func (m *Metrics) RecordSequencerDeadlineMiss() {
+	m.SequencerDeadlineMissTotal.Inc()
+}
+
+// RecordSequencerDeadlineMissAvoided tracks sequenced blocks that were inserted in time thanks to
+// starting to seal earlier than the minimum sealing margin.
+func (m *Metrics) RecordSequencerDeadlineMissAvoided() {
+	m.SequencerDeadlineMissAvoidedTotal.Inc()
+}
+
+// RecordSequencerStageLatency tracks the latency of a stage of sequencer block building.
+func (m *Metrics) RecordSequencerStageLatency(stage string, latency time.Duration) {
+	m.SequencerStageDurationSeconds.WithLabelValues(stage).Observe(latency.Seconds())
+}
+
+// RecordSequencerAdmission tracks the tx-pool admission control actions of the sequencer latency budget.
+func (m *Metrics) RecordSequencerAdmission(action string) {
+	m.SequencerAdmissionTotal.WithLabelValues(action).Inc()
+}
+
 // StartServer starts the metrics server on the given hostname and port.
 func (m *Metrics) StartServer(hostname string, port int) (*ophttp.HTTPServer, error) {
 	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
@@ -671,6 +843,30 @@ func (m *Metrics) ReportProtocolVersions(local, engine, recommended, required pa
 	m.ProtocolVersions.WithLabelValues(local.String(), engine.String(), recommended.String(), required.String()).Set(1)
 }
 
+func (m *Metrics) RecordConditionalTxRejected(policy string) {
+	m.ConditionalTxRejections.WithLabelValues(policy).Inc()
+}
+
+func (m *Metrics) RecordConditionalTxForwarded() {
+	m.ConditionalTxsForwarded.Inc()
+}
+
+func (m *Metrics) RecordGossipTx(event string) {
+	m.GossipTxs.WithLabelValues(event).Inc()
+}
+
+func (m *Metrics) RecordBlobSourceRequest(source string, result string) {
+	m.BlobSourceRequests.WithLabelValues(source, result).Inc()
+}
+
+func (m *Metrics) RecordBlobSourceHealth(source string, healthy bool) {
+	if healthy {
+		m.BlobSourceHealth.WithLabelValues(source).Set(1)
+	} else {
+		m.BlobSourceHealth.WithLabelValues(source).Set(0)
+	}
+}
+
 type noopMetricer struct {
 	metrics.NoopRPCMetrics
 }
@@ -722,6 +918,9 @@ func (n *noopMetricer) RecordL2Ref(name string, ref eth.L2BlockRef) {
 func (n *noopMetricer) RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID) {
 }
 
+func (n *noopMetricer) RecordUnsafePayloadsQuarantine(length uint64) {
+}
+
 func (n *noopMetricer) RecordDerivedBatches(batchType string) {
 }
 
@@ -731,6 +930,9 @@ func (n *noopMetricer) CountSequencedTxs(count int) {
 func (n *noopMetricer) RecordL1ReorgDepth(d uint64) {
 }
 
+func (n *noopMetricer) RecordL1ReorgHalted(halted bool) {
+}
+
 func (n *noopMetricer) RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 }
 
@@ -764,6 +966,27 @@ func (n *noopMetricer) RecordSequencerBuildingDiffTime(duration time.Duration) {
 func (n *noopMetricer) RecordSequencerSealingTime(duration time.Duration) {
 }
 
+func (n *noopMetricer) RecordSequencerClockDrift(l2HeadDrift time.Duration, l1OriginDrift time.Duration) {
+}
+
+func (n *noopMetricer) RecordSequencerClockAdjustment(delay time.Duration) {
+}
+
+func (n *noopMetricer) RecordSequencerSealingMargin(margin time.Duration) {
+}
+
+func (n *noopMetricer) RecordSequencerDeadlineMiss() {
+}
+
+func (n *noopMetricer) RecordSequencerDeadlineMissAvoided() {
+}
+
+func (n *noopMetricer) RecordSequencerStageLatency(stage string, latency time.Duration) {
+}
+
+func (n *noopMetricer) RecordSequencerAdmission(action string) {
+}
+
 func (n *noopMetricer) Document() []metrics.DocumentedMetric {
 	return nil
 }
@@ -802,3 +1025,18 @@ func (n *noopMetricer) RecordAccept(allow bool) {
 }
 func (n *noopMetricer) ReportProtocolVersions(local, engine, recommended, required params.ProtocolVersion) {
 }
+
+func (n *noopMetricer) RecordConditionalTxRejected(policy string) {
+}
+
+func (n *noopMetricer) RecordConditionalTxForwarded() {
+}
+
+func (n *noopMetricer) RecordGossipTx(event string) {
+}
+
+func (n *noopMetricer) RecordBlobSourceRequest(source string, result string) {
+}
+
+func (n *noopMetricer) RecordBlobSourceHealth(source string, healthy bool) {
+}
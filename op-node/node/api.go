@@ -32,23 +32,41 @@ type driverClient interface {
 	StartSequencer(ctx context.Context, blockHash common.Hash) error
 	StopSequencer(context.Context) (common.Hash, error)
 	SequencerActive(context.Context) (bool, error)
+	SequencerStatus(context.Context) (*eth.SequencerStatus, error)
+	SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error
 	OnUnsafeL2Payload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error
 	OverrideLeader(ctx context.Context) error
+	AcknowledgeL1Reorg(ctx context.Context) error
+	DerivationPipelineStatus(ctx context.Context) (*eth.DerivationPipelineStatus, error)
+}
+
+// L1HealthReader provides the latest result of the L1 health monitor.
+type L1HealthReader interface {
+	L1Health() *eth.L1Health
 }
 
 type SafeDBReader interface {
 	SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1 eth.BlockID, l2 eth.BlockID, err error)
+	DerivationReceipt(ctx context.Context, l2BlockNum uint64) (*eth.DerivationReceipt, error)
+}
+
+// ProtocolVersionHalter allows the operator to override a halt on an incompatible protocol version.
+type ProtocolVersionHalter interface {
+	OverrideProtocolVersionHalt(ctx context.Context) error
 }
 
 type adminAPI struct {
 	*rpc.CommonAdminAPI
-	dr driverClient
+	dr     driverClient
+	halter ProtocolVersionHalter
 }
 
-func NewAdminAPI(dr driverClient, m metrics.RPCMetricer, log log.Logger) *adminAPI {
+// NewAdminAPI creates the admin namespace API. The protocol version halter is optional.
+func NewAdminAPI(dr driverClient, halter ProtocolVersionHalter, m metrics.RPCMetricer, log log.Logger) *adminAPI {
 	return &adminAPI{
 		CommonAdminAPI: rpc.NewCommonAdminAPI(m, log),
 		dr:             dr,
+		halter:         halter,
 	}
 }
 
@@ -76,6 +94,23 @@ func (n *adminAPI) SequencerActive(ctx context.Context) (bool, error) {
 	return n.dr.SequencerActive(ctx)
 }
 
+// SequencerStatus returns the detailed sequencing state, for monitoring and failover automation.
+func (n *adminAPI) SequencerStatus(ctx context.Context) (*eth.SequencerStatus, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_sequencerStatus")
+	defer recordDur()
+	return n.dr.SequencerStatus(ctx)
+}
+
+// SetBlockBuildingLimits sets soft limits on the tx-pool transactions of the blocks that the sequencer builds,
+// to throttle the production of chain data without a restart. Zero values disable a limit.
+// Blocks that exceed the limits are rebuilt without the transactions that do not fit,
+// which delays their publishing by about the latency of building a block.
+func (n *adminAPI) SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setBlockBuildingLimits")
+	defer recordDur()
+	return n.dr.SetBlockBuildingLimits(ctx, limits)
+}
+
 // PostUnsafePayload is a special API that allows posting an unsafe payload to the L2 derivation pipeline.
 // It should only be used by op-conductor for sequencer failover scenarios.
 func (n *adminAPI) PostUnsafePayload(ctx context.Context, envelope *eth.ExecutionPayloadEnvelope) error {
@@ -98,23 +133,47 @@ func (n *adminAPI) OverrideLeader(ctx context.Context) error {
 	return n.dr.OverrideLeader(ctx)
 }
 
+// OverrideProtocolVersionHalt resumes sequencing and derivation after the node paused on an incompatible
+// required protocol version. It should only be used after the operator reviewed the protocol change.
+func (n *adminAPI) OverrideProtocolVersionHalt(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_overrideProtocolVersionHalt")
+	defer recordDur()
+	if n.halter == nil {
+		return errors.New("protocol version halting is not supported")
+	}
+	return n.halter.OverrideProtocolVersionHalt(ctx)
+}
+
+// AcknowledgeL1Reorg resumes sequencing and derivation after the node halted on a L1 reorg deeper than
+// the max L1 reorg depth. It should only be used after the operator reviewed the L1 chain.
+func (n *adminAPI) AcknowledgeL1Reorg(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_acknowledgeL1Reorg")
+	defer recordDur()
+	return n.dr.AcknowledgeL1Reorg(ctx)
+}
+
 type nodeAPI struct {
-	config *rollup.Config
-	client l2EthClient
-	dr     driverClient
-	safeDB SafeDBReader
-	log    log.Logger
-	m      metrics.RPCMetricer
+	config    *rollup.Config
+	overrides *eth.ConfigOverrides
+	l1Health  L1HealthReader
+	client    l2EthClient
+	dr        driverClient
+	safeDB    SafeDBReader
+	log       log.Logger
+	m         metrics.RPCMetricer
 }
 
-func NewNodeAPI(config *rollup.Config, l2Client l2EthClient, dr driverClient, safeDB SafeDBReader, log log.Logger, m metrics.RPCMetricer) *nodeAPI {
+// NewNodeAPI creates the optimism namespace API. The overrides and L1 health reader are optional.
+func NewNodeAPI(config *rollup.Config, overrides *eth.ConfigOverrides, l1Health L1HealthReader, l2Client l2EthClient, dr driverClient, safeDB SafeDBReader, log log.Logger, m metrics.RPCMetricer) *nodeAPI {
 	return &nodeAPI{
-		config: config,
-		client: l2Client,
-		dr:     dr,
-		safeDB: safeDB,
-		log:    log,
-		m:      m,
+		config:    config,
+		overrides: overrides,
+		l1Health:  l1Health,
+		client:    l2Client,
+		dr:        dr,
+		safeDB:    safeDB,
+		log:       log,
+		m:         m,
 	}
 }
 
@@ -156,10 +215,47 @@ func (n *nodeAPI) SafeHeadAtL1Block(ctx context.Context, number hexutil.Uint64)
 	}, nil
 }
 
+// DerivationReceipt returns the L1 batcher transactions, frames and deposit events the safe L2 block was derived from.
+func (n *nodeAPI) DerivationReceipt(ctx context.Context, number hexutil.Uint64) (*eth.DerivationReceipt, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_derivationReceipt")
+	defer recordDur()
+	receipt, err := n.safeDB.DerivationReceipt(ctx, uint64(number))
+	if errors.Is(err, safedb.ErrNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get derivation receipt of l2 block %s: %w", number, err)
+	}
+	return receipt, nil
+}
+
+// SafeBlockJustification returns the channel, frames, batcher transactions and span batch coordinates
+// of the batcher data that justified the safe L2 block.
+func (n *nodeAPI) SafeBlockJustification(ctx context.Context, number hexutil.Uint64) (*eth.SafeBlockJustification, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_safeBlockJustification")
+	defer recordDur()
+	receipt, err := n.safeDB.DerivationReceipt(ctx, uint64(number))
+	if errors.Is(err, safedb.ErrNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get derivation receipt of l2 block %s: %w", number, err)
+	}
+	return receipt.Justification(), nil
+}
+
 func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	recordDur := n.m.RecordRPCServerRequest("optimism_syncStatus")
 	defer recordDur()
-	return n.dr.SyncStatus(ctx)
+	status, err := n.dr.SyncStatus(ctx)
+	if err != nil || (n.overrides == nil && n.l1Health == nil) {
+		return status, err
+	}
+	// The driver shares the status between callers, so attach the node-level info to a copy.
+	extended := *status
+	extended.ConfigOverrides = n.overrides
+	if n.l1Health != nil {
+		extended.L1Health = n.l1Health.L1Health()
+	}
+	return &extended, nil
 }
 
 func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
@@ -173,3 +269,24 @@ func (n *nodeAPI) Version(ctx context.Context) (string, error) {
 	defer recordDur()
 	return version.Version + "-" + version.Meta, nil
 }
+
+type debugAPI struct {
+	dr driverClient
+	m  metrics.RPCMetricer
+}
+
+// NewDebugAPI creates the debug namespace API, to introspect the state of the node.
+func NewDebugAPI(dr driverClient, m metrics.RPCMetricer) *debugAPI {
+	return &debugAPI{
+		dr: dr,
+		m:  m,
+	}
+}
+
+// DerivationPipeline returns the origin of the derivation pipeline, and the queue depth and
+// last skipped invalid data of every stage, to diagnose a stalled safe head.
+func (n *debugAPI) DerivationPipeline(ctx context.Context) (*eth.DerivationPipelineStatus, error) {
+	recordDur := n.m.RecordRPCServerRequest("debug_derivationPipeline")
+	defer recordDur()
+	return n.dr.DerivationPipelineStatus(ctx)
+}
@@ -47,6 +47,13 @@ type L2EndpointConfig struct {
 	// JWT secrets for L2 Engine API authentication during HTTP or initial Websocket communication.
 	// Any value for an IPC connection.
 	L2EngineJWTSecret [32]byte
+
+	// L2EngineJWTSecretPath is the file the JWT secret was read from.
+	L2EngineJWTSecretPath string
+
+	// L2EngineJWTRotationWindow enables reloading the JWT secret when L2EngineJWTSecretPath changes,
+	// and is how long the previous secret remains acceptable after a change. Disabled if 0.
+	L2EngineJWTRotationWindow time.Duration
 }
 
 var _ L2EndpointSetup = (*L2EndpointConfig)(nil)
@@ -55,6 +62,12 @@ func (cfg *L2EndpointConfig) Check() error {
 	if cfg.L2EngineAddr == "" {
 		return errors.New("empty L2 Engine Address")
 	}
+	if cfg.L2EngineJWTRotationWindow < 0 {
+		return errors.New("negative JWT secret rotation window")
+	}
+	if cfg.L2EngineJWTRotationWindow > 0 && cfg.L2EngineJWTSecretPath == "" {
+		return errors.New("JWT secret rotation requires the path of the JWT secret")
+	}
 
 	return nil
 }
@@ -63,6 +76,9 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	if err := cfg.Check(); err != nil {
 		return nil, nil, err
 	}
+	if cfg.L2EngineJWTRotationWindow > 0 {
+		return cfg.setupWithJWTRotation(ctx, log, rollupCfg)
+	}
 	auth := rpc.WithHTTPAuth(gn.NewJWTAuth(cfg.L2EngineJWTSecret))
 	opts := []client.RPCOption{
 		client.WithGethRPCOptions(auth),
@@ -76,6 +92,27 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
 }
 
+// setupWithJWTRotation sets up the engine RPC client with a JWT secret that is reloaded when its file changes.
+func (cfg *L2EndpointConfig) setupWithJWTRotation(ctx context.Context, log log.Logger, rollupCfg *rollup.Config) (client.RPC, *sources.EngineClientConfig, error) {
+	secrets, err := sources.NewJWTSecretWatcher(log, cfg.L2EngineJWTSecretPath, cfg.L2EngineJWTRotationWindow)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := secrets.Watch(); err != nil {
+		return nil, nil, err
+	}
+	opts := []client.RPCOption{
+		client.WithGethRPCOptions(rpc.WithHTTPAuth(secrets.Auth)),
+		client.WithDialBackoff(10),
+	}
+	l2Node, err := client.NewRPC(ctx, log, cfg.L2EngineAddr, opts...)
+	if err != nil {
+		secrets.Stop()
+		return nil, nil, err
+	}
+	return sources.NewJWTRotationRPC(l2Node, secrets), sources.EngineClientDefaultConfig(rollupCfg), nil
+}
+
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
 type PreparedL2Endpoints struct {
 	Client client.RPC
@@ -120,6 +157,13 @@ type L1EndpointConfig struct {
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
 	// Setting this to 0 disables polling.
 	HttpPollInterval time.Duration
+
+	// CacheDir specifies the directory to persist fetched L1 headers and receipts in.
+	// The persistent cache is disabled if empty.
+	CacheDir string
+
+	// CacheSize specifies the maximum size in bytes of each of the persistent caches.
+	CacheSize uint64
 }
 
 var _ L1EndpointSetup = (*L1EndpointConfig)(nil)
@@ -134,6 +178,9 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.MaxConcurrency < 1 {
 		return fmt.Errorf("max concurrent requests cannot be less than 1, was %d", cfg.MaxConcurrency)
 	}
+	if cfg.CacheDir != "" && cfg.CacheSize == 0 {
+		return errors.New("persistent L1 cache size must be set if the cache is enabled")
+	}
 	return nil
 }
 
@@ -153,6 +200,8 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
 	rpcCfg.MaxConcurrentRequests = cfg.MaxConcurrency
+	rpcCfg.PersistentCacheDir = cfg.CacheDir
+	rpcCfg.PersistentCacheSize = cfg.CacheSize
 	return l1Node, rpcCfg, nil
 }
 
@@ -11,8 +11,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -44,6 +46,9 @@ type Config struct {
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
+	// L1Health configures the monitoring of the L1 RPC for lagging or forked conditions.
+	L1Health L1HealthConfig
+
 	ConfigPersistence ConfigPersistence
 
 	// Path to store safe head database. Disabled when set to empty string
@@ -64,6 +69,15 @@ type Config struct {
 	// change of the given severity (major/minor/patch). Disabled if empty.
 	RollupHalt string
 
+	// RollupHaltAction is what to do upon halting on a protocol version: shutdown (the default) or pause.
+	// When paused, sequencing and derivation stop until the operator overrides the halt with
+	// admin_overrideProtocolVersionHalt, while the RPC and P2P services stay up.
+	RollupHaltAction string
+
+	// RollupOverrides are the rollup config parameters overridden at startup, reported in the sync status.
+	// The overrides are already applied to the Rollup config.
+	RollupOverrides *eth.ConfigOverrides
+
 	// Cancel to request a premature shutdown of the node itself, e.g. when halting. This may be nil.
 	Cancel context.CancelCauseFunc
 
@@ -80,6 +94,19 @@ type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+
+	// EnableTxConditional serves eth_sendRawTransactionConditional,
+	// enforcing the conditionals at ingress before forwarding the transactions to the execution engine.
+	EnableTxConditional bool
+	// TxConditionalMaxCost is the maximum number of storage lookups of a conditional.
+	TxConditionalMaxCost int
+	// TxConditionalPolicies are enforced in addition to the default policies.
+	TxConditionalPolicies []TxConditionalPolicy
+
+	// EnableP2PTopology serves the peers and gossip mesh of the node on p2p.TopologyPath, for network crawlers.
+	EnableP2PTopology bool
+	// P2PTopologyAddresses controls which addresses of the node and its peers are included in the topology.
+	P2PTopologyAddresses p2p.AddressPolicy
 }
 
 func (cfg *RPCConfig) HttpEndpoint() string {
@@ -146,9 +173,15 @@ func (cfg *Config) Check() error {
 			return fmt.Errorf("misconfigured supervisor RPC endpoint: %w", err)
 		}
 	}
+	if err := cfg.L1Health.Check(); err != nil {
+		return fmt.Errorf("l1 health config error: %w", err)
+	}
 	if err := cfg.Rollup.Check(); err != nil {
 		return fmt.Errorf("rollup config error: %w", err)
 	}
+	if _, _, err := derive.LookupDataSource(cfg.Driver.DataSource); err != nil {
+		return fmt.Errorf("driver config error: %w", err)
+	}
 	if err := cfg.Metrics.Check(); err != nil {
 		return fmt.Errorf("metrics config error: %w", err)
 	}
@@ -163,6 +196,9 @@ func (cfg *Config) Check() error {
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
+	if !(cfg.RollupHaltAction == "" || cfg.RollupHaltAction == RollupHaltShutdown || cfg.RollupHaltAction == RollupHaltPause) {
+		return fmt.Errorf("invalid rollup halt action: %q", cfg.RollupHaltAction)
+	}
 	if cfg.ConductorEnabled {
 		if state, _ := cfg.ConfigPersistence.SequencerState(); state != StateUnset {
 			return fmt.Errorf("config persistence must be disabled when conductor is enabled")
@@ -171,6 +207,22 @@ func (cfg *Config) Check() error {
 			return fmt.Errorf("sequencer must be enabled when conductor is enabled")
 		}
 	}
+	if cfg.RPC.EnableTxConditional {
+		if !cfg.Driver.SequencerEnabled {
+			return fmt.Errorf("sequencer must be enabled when tx conditional ingress is enabled")
+		}
+		if cfg.RPC.TxConditionalMaxCost <= 0 {
+			return fmt.Errorf("tx conditional max cost must be positive, got %d", cfg.RPC.TxConditionalMaxCost)
+		}
+	}
+	if cfg.RPC.EnableP2PTopology {
+		if !cfg.P2PEnabled() {
+			return fmt.Errorf("p2p must be enabled to serve the p2p topology")
+		}
+		if err := cfg.RPC.P2PTopologyAddresses.Check(); err != nil {
+			return fmt.Errorf("p2p topology config error: %w", err)
+		}
+	}
 	if err := cfg.AltDA.Check(); err != nil {
 		return fmt.Errorf("altDA config error: %w", err)
 	}
@@ -0,0 +1,194 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const l1HealthCheckTimeout = 20 * time.Second
+
+// L1HealthConfig configures the monitoring of the L1 RPC's view of the chain.
+type L1HealthConfig struct {
+	// Interval is the interval between checks. Disabled if 0.
+	Interval time.Duration
+
+	// MaxLag is the number of blocks the L1 RPC may trail a reference source before it is considered stale.
+	MaxLag uint64
+
+	// Secondary is an optional second L1 RPC to compare the L1 RPC against, in addition to the L1 beacon node.
+	Secondary L1EndpointSetup
+}
+
+func (c *L1HealthConfig) Check() error {
+	if c.Secondary != nil {
+		if err := c.Secondary.Check(); err != nil {
+			return fmt.Errorf("invalid secondary L1 endpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+type l1HealthSource interface {
+	L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error)
+	L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error)
+}
+
+type l1HealthBeacon interface {
+	ExecutionBlockID(ctx context.Context, blockID string) (eth.BlockID, error)
+}
+
+// l1HealthReference is a source of the head and finalized L1 blocks to compare the L1 RPC against.
+type l1HealthReference struct {
+	name      string
+	head      func(ctx context.Context) (eth.BlockID, error)
+	finalized func(ctx context.Context) (eth.BlockID, error)
+}
+
+// L1HealthMonitor periodically compares the head and finalized blocks of the L1 RPC against the L1 beacon node
+// and an optional secondary L1 RPC, to surface a lagging or forked L1 RPC instead of silently stalling derivation.
+type L1HealthMonitor struct {
+	log    log.Logger
+	maxLag uint64
+	l1     l1HealthSource
+	refs   []l1HealthReference
+
+	mu     sync.RWMutex
+	health *eth.L1Health
+}
+
+// NewL1HealthMonitor creates a monitor of the L1 RPC. The beacon and secondary sources are optional.
+func NewL1HealthMonitor(log log.Logger, maxLag uint64, l1 l1HealthSource, beacon l1HealthBeacon, secondary l1HealthSource) *L1HealthMonitor {
+	m := &L1HealthMonitor{
+		log:    log,
+		maxLag: maxLag,
+		l1:     l1,
+	}
+	if beacon != nil {
+		m.refs = append(m.refs, l1HealthReference{
+			name: "beacon",
+			head: func(ctx context.Context) (eth.BlockID, error) {
+				return beacon.ExecutionBlockID(ctx, "head")
+			},
+			finalized: func(ctx context.Context) (eth.BlockID, error) {
+				return beacon.ExecutionBlockID(ctx, "finalized")
+			},
+		})
+	}
+	if secondary != nil {
+		byLabel := func(label eth.BlockLabel) func(ctx context.Context) (eth.BlockID, error) {
+			return func(ctx context.Context) (eth.BlockID, error) {
+				ref, err := secondary.L1BlockRefByLabel(ctx, label)
+				return ref.ID(), err
+			}
+		}
+		m.refs = append(m.refs, l1HealthReference{
+			name:      "secondary L1 RPC",
+			head:      byLabel(eth.Unsafe),
+			finalized: byLabel(eth.Finalized),
+		})
+	}
+	return m
+}
+
+// L1Health returns the result of the latest check, or nil if no check completed yet.
+func (m *L1HealthMonitor) L1Health() *eth.L1Health {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.health
+}
+
+// Start runs the checks at the given interval until the context is done.
+func (m *L1HealthMonitor) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			m.update(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (m *L1HealthMonitor) update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, l1HealthCheckTimeout)
+	defer cancel()
+	health := m.Check(ctx)
+	if ctx.Err() != nil && !health.Degraded {
+		return
+	}
+	prev := m.L1Health()
+	if health.Degraded {
+		m.log.Warn("L1 RPC is degraded, derivation may stall or follow a non-canonical chain", "reasons", health.Reasons)
+	} else if prev != nil && prev.Degraded {
+		m.log.Info("L1 RPC recovered")
+	}
+	m.mu.Lock()
+	m.health = health
+	m.mu.Unlock()
+}
+
+// Check compares the L1 RPC against each reference source.
+// Errors of the reference sources are logged but do not degrade the L1 health,
+// since they do not affect derivation.
+func (m *L1HealthMonitor) Check(ctx context.Context) *eth.L1Health {
+	health := &eth.L1Health{CheckedAt: uint64(time.Now().Unix())}
+	head, err := m.l1.L1BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		health.Degraded = true
+		health.Reasons = append(health.Reasons, fmt.Sprintf("failed to fetch L1 head: %v", err))
+		return health
+	}
+	finalized, err := m.l1.L1BlockRefByLabel(ctx, eth.Finalized)
+	if err != nil {
+		health.Degraded = true
+		health.Reasons = append(health.Reasons, fmt.Sprintf("failed to fetch L1 finalized block: %v", err))
+		return health
+	}
+	for _, ref := range m.refs {
+		refHead, err := ref.head(ctx)
+		if err != nil {
+			m.log.Warn("Failed to fetch L1 head for health check", "source", ref.name, "err", err)
+		} else {
+			health.Reasons = append(health.Reasons, m.compare(ctx, ref.name, "head", head, refHead)...)
+		}
+		refFinalized, err := ref.finalized(ctx)
+		if err != nil {
+			m.log.Warn("Failed to fetch L1 finalized block for health check", "source", ref.name, "err", err)
+		} else {
+			health.Reasons = append(health.Reasons, m.compare(ctx, ref.name, "finalized block", finalized, refFinalized)...)
+		}
+	}
+	health.Degraded = len(health.Reasons) > 0
+	return health
+}
+
+// compare checks the L1 RPC's block against the block of a reference source.
+// The L1 RPC is stale if it trails the reference by more than the max lag,
+// and forked if it has a different block at the height of the reference block.
+func (m *L1HealthMonitor) compare(ctx context.Context, source string, kind string, ours eth.L1BlockRef, theirs eth.BlockID) []string {
+	if theirs.Number > ours.Number+m.maxLag {
+		return []string{fmt.Sprintf("L1 RPC %s %d is %d blocks behind the %s %s %d",
+			kind, ours.Number, theirs.Number-ours.Number, source, kind, theirs.Number)}
+	}
+	if theirs.Number > ours.Number {
+		return nil // within the allowed lag, and not yet known to the L1 RPC to compare.
+	}
+	canonical, err := m.l1.L1BlockRefByNumber(ctx, theirs.Number)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to fetch L1 block %d to compare with the %s %s: %v", theirs.Number, source, kind, err)}
+	}
+	if canonical.Hash != theirs.Hash {
+		return []string{fmt.Sprintf("L1 RPC block %s conflicts with the %s %s %s", canonical.ID(), source, kind, theirs)}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type stubBeacon map[string]eth.BlockID
+
+func (b stubBeacon) ExecutionBlockID(_ context.Context, blockID string) (eth.BlockID, error) {
+	id, ok := b[blockID]
+	if !ok {
+		return eth.BlockID{}, errors.New("unavailable")
+	}
+	return id, nil
+}
+
+func l1Ref(num uint64, fork byte) eth.L1BlockRef {
+	return eth.L1BlockRef{Number: num, Hash: common.Hash{fork, byte(num)}}
+}
+
+func TestL1HealthMonitor(t *testing.T) {
+	ctx := context.Background()
+	logger := testlog.Logger(t, log.LevelDebug)
+
+	t.Run("Healthy", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(100, 0), nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(36, 0), nil)
+		l1.ExpectL1BlockRefByNumber(99, l1Ref(99, 0), nil)
+		l1.ExpectL1BlockRefByNumber(36, l1Ref(36, 0), nil)
+		beacon := stubBeacon{"head": l1Ref(99, 0).ID(), "finalized": l1Ref(36, 0).ID()}
+
+		health := NewL1HealthMonitor(logger, 3, l1, beacon, nil).Check(ctx)
+		require.False(t, health.Degraded)
+		require.Empty(t, health.Reasons)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("WithinLag", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(100, 0), nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(36, 0), nil)
+		l1.ExpectL1BlockRefByNumber(36, l1Ref(36, 0), nil)
+		beacon := stubBeacon{"head": l1Ref(103, 0).ID(), "finalized": l1Ref(36, 0).ID()}
+
+		health := NewL1HealthMonitor(logger, 3, l1, beacon, nil).Check(ctx)
+		require.False(t, health.Degraded)
+	})
+
+	t.Run("Lagging", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(100, 0), nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(36, 0), nil)
+		secondary := &testutils.MockL1Source{}
+		secondary.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(110, 0), nil)
+		secondary.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(68, 0), nil)
+
+		health := NewL1HealthMonitor(logger, 3, l1, nil, secondary).Check(ctx)
+		require.True(t, health.Degraded)
+		require.Equal(t, []string{
+			"L1 RPC head 100 is 10 blocks behind the secondary L1 RPC head 110",
+			"L1 RPC finalized block 36 is 32 blocks behind the secondary L1 RPC finalized block 68",
+		}, health.Reasons)
+	})
+
+	t.Run("Forked", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(100, 1), nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(36, 0), nil)
+		l1.ExpectL1BlockRefByNumber(100, l1Ref(100, 1), nil)
+		l1.ExpectL1BlockRefByNumber(36, l1Ref(36, 0), nil)
+		beacon := stubBeacon{"head": l1Ref(100, 0).ID(), "finalized": l1Ref(36, 0).ID()}
+
+		health := NewL1HealthMonitor(logger, 3, l1, beacon, nil).Check(ctx)
+		require.True(t, health.Degraded)
+		require.Len(t, health.Reasons, 1)
+		require.Contains(t, health.Reasons[0], "conflicts with the beacon head")
+	})
+
+	t.Run("ReferenceUnavailable", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, l1Ref(100, 0), nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, l1Ref(36, 0), nil)
+
+		health := NewL1HealthMonitor(logger, 3, l1, stubBeacon{}, nil).Check(ctx)
+		require.False(t, health.Degraded)
+	})
+
+	t.Run("L1Unavailable", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectL1BlockRefByLabel(eth.Unsafe, eth.L1BlockRef{}, errors.New("connection refused"))
+
+		m := NewL1HealthMonitor(logger, 3, l1, nil, nil)
+		require.Nil(t, m.L1Health())
+		m.update(ctx)
+		health := m.L1Health()
+		require.True(t, health.Degraded)
+		require.Equal(t, []string{"failed to fetch L1 head: connection refused"}, health.Reasons)
+	})
+}
@@ -14,8 +14,12 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -35,6 +39,9 @@ import (
 
 var ErrAlreadyClosed = errors.New("node is already closed")
 
+// blobSourcesCheckInterval is the interval between health checks of the L1 blob sources.
+const blobSourcesCheckInterval = 5 * time.Minute
+
 type closableSafeDB interface {
 	rollup.SafeHeadListener
 	SafeDBReader
@@ -63,13 +70,20 @@ type OpNode struct {
 
 	safeDB closableSafeDB
 
-	rollupHalt string // when to halt the rollup, disabled if empty
+	rollupHalt       string // when to halt the rollup, disabled if empty
+	rollupHaltAction string // what to do when halting the rollup
+
+	protocolPaused atomic.Bool                            // sequencing and derivation paused on a protocol version
+	haltOverride   atomic.Pointer[params.ProtocolVersion] // required protocol version the operator overrode the halt for
 
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
 
 	beacon *sources.L1BeaconClient
 
+	l1Health          *L1HealthMonitor  // monitor of the L1 RPC's view of the chain, nil if disabled
+	l1HealthSecondary *sources.L1Client // optional second L1 RPC the L1 RPC is compared against
+
 	supervisor *sources.SupervisorClient
 
 	// some resources cannot be stopped directly, like the p2p gossipsub router (not our design),
@@ -99,12 +113,13 @@ func New(ctx context.Context, cfg *Config, log log.Logger, appVersion string, m
 	}
 
 	n := &OpNode{
-		cfg:        cfg,
-		log:        log,
-		appVersion: appVersion,
-		metrics:    m,
-		rollupHalt: cfg.RollupHalt,
-		cancel:     cfg.Cancel,
+		cfg:              cfg,
+		log:              log,
+		appVersion:       appVersion,
+		metrics:          m,
+		rollupHalt:       cfg.RollupHalt,
+		rollupHaltAction: cfg.RollupHaltAction,
+		cancel:           cfg.Cancel,
 	}
 	// not a context leak, gossipsub is closed with a context.
 	n.resourcesCtx, n.resourcesClose = context.WithCancel(context.Background())
@@ -132,6 +147,9 @@ func (n *OpNode) init(ctx context.Context, cfg *Config) error {
 	if err := n.initL1BeaconAPI(ctx, cfg); err != nil {
 		return err
 	}
+	if err := n.initL1Health(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init L1 health monitor: %w", err)
+	}
 	if err := n.initL2(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init L2: %w", err)
 	}
@@ -321,6 +339,9 @@ func (n *OpNode) initL1BeaconAPI(ctx context.Context, cfg *Config) error {
 	beaconCfg := sources.L1BeaconClientConfig{
 		FetchAllSidecars: cfg.Beacon.ShouldFetchAllSidecars(),
 	}
+	if n.metrics != nil {
+		beaconCfg.Metrics = n.metrics
+	}
 	n.beacon = sources.NewL1BeaconClient(beaconClient, beaconCfg, fallbacks...)
 
 	// Retry retrieval of the Beacon API version, to be more robust on startup against Beacon API connection issues.
@@ -360,10 +381,69 @@ func (n *OpNode) initL1BeaconAPI(ctx context.Context, cfg *Config) error {
 		}
 	} else {
 		n.log.Info("Connected to L1 Beacon API, ready for EIP-4844 blobs retrieval.", "version", beaconVersion)
+		if len(fallbacks) > 0 {
+			n.checkBlobSources(ctx)
+			go n.monitorBlobSources(n.resourcesCtx)
+		}
 		return nil
 	}
 }
 
+// checkBlobSources checks the health of the L1 beacon node and the blob fallbacks, and logs the unhealthy ones.
+func (n *OpNode) checkBlobSources(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	for _, src := range n.beacon.CheckBlobSources(ctx) {
+		if src.Err != nil {
+			n.log.Warn("L1 blob source is unhealthy", "source", src.Name, "err", src.Err)
+		} else {
+			n.log.Debug("L1 blob source is healthy", "source", src.Name, "version", src.Version)
+		}
+	}
+}
+
+// monitorBlobSources periodically checks the health of the blob sources, until the context is canceled.
+func (n *OpNode) monitorBlobSources(ctx context.Context) {
+	ticker := time.NewTicker(blobSourcesCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			n.checkBlobSources(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (n *OpNode) initL1Health(ctx context.Context, cfg *Config) error {
+	if cfg.L1Health.Interval <= 0 {
+		return nil
+	}
+	var beacon l1HealthBeacon
+	if n.beacon != nil {
+		beacon = n.beacon
+	}
+	var secondary l1HealthSource
+	if cfg.L1Health.Secondary != nil {
+		l1Node, rpcCfg, err := cfg.L1Health.Secondary.Setup(ctx, n.log, &cfg.Rollup)
+		if err != nil {
+			return fmt.Errorf("failed to get secondary L1 RPC client: %w", err)
+		}
+		n.l1HealthSecondary, err = sources.NewL1Client(l1Node, n.log, nil, rpcCfg)
+		if err != nil {
+			return fmt.Errorf("failed to create secondary L1 source: %w", err)
+		}
+		secondary = n.l1HealthSecondary
+	}
+	if beacon == nil && secondary == nil {
+		n.log.Warn("L1 health monitor has no L1 beacon node or secondary L1 RPC to compare against, only L1 RPC availability is checked")
+	}
+	n.l1Health = NewL1HealthMonitor(n.log.New("module", "l1-health"), cfg.L1Health.MaxLag, n.l1Source, beacon, secondary)
+	n.l1Health.Start(n.resourcesCtx, cfg.L1Health.Interval)
+	return nil
+}
+
 func (n *OpNode) initL2(ctx context.Context, cfg *Config) error {
 	rpcClient, rpcCfg, err := cfg.L2.Setup(ctx, n.log, &cfg.Rollup)
 	if err != nil {
@@ -416,17 +496,43 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config) error {
 }
 
 func (n *OpNode) initRPCServer(cfg *Config) error {
-	server, err := newRPCServer(&cfg.RPC, &cfg.Rollup, n.l2Source.L2Client, n.l2Driver, n.safeDB, n.log, n.appVersion, n.metrics)
+	var l1Health L1HealthReader
+	if n.l1Health != nil {
+		l1Health = n.l1Health
+	}
+	server, err := newRPCServer(&cfg.RPC, &cfg.Rollup, cfg.RollupOverrides, l1Health, n.l2Source.L2Client, n.l2Driver, n.safeDB, n.log, n.appVersion, n.metrics)
 	if err != nil {
 		return err
 	}
 	if n.p2pEnabled() {
 		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
+		if cfg.RPC.EnableP2PTopology {
+			server.EnableP2PTopology(p2p.NewTopologyReader(n.p2pNode, cfg.RPC.P2PTopologyAddresses, n.log.New("p2p", "topology")))
+			n.log.Info("P2P topology endpoint enabled", "path", p2p.TopologyPath, "addresses", cfg.RPC.P2PTopologyAddresses)
+		}
+		// The sequencer receives transactions directly, followers publish them to the sequencer
+		if txGossip := n.p2pNode.TxGossip(); txGossip != nil && !cfg.Driver.SequencerEnabled {
+			server.EnableTxGossipAPI(NewTxGossipAPI(n.log.New("rpc", "tx-gossip"), n.metrics, txGossip))
+			n.log.Info("Tx gossip RPC enabled")
+		}
 	}
 	if cfg.RPC.EnableAdmin {
-		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log))
+		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n, n.metrics, n.log))
 		n.log.Info("Admin RPC enabled")
 	}
+	if cfg.RPC.EnableTxConditional {
+		policies := append(DefaultTxConditionalPolicies(n.l2Source.L2Client, cfg.RPC.TxConditionalMaxCost), cfg.RPC.TxConditionalPolicies...)
+		server.EnableTxConditionalAPI(NewTxConditionalAPI(n.log.New("rpc", "tx-conditional"), n.metrics, n.l2Source.L2Client, n.l2Source.RPC, policies))
+		n.log.Info("Tx conditional RPC enabled", "max_cost", cfg.RPC.TxConditionalMaxCost)
+	}
+	server.AddReadinessCheck("l1", func(ctx context.Context) error {
+		_, err := n.l1Source.L1BlockRefByLabel(ctx, eth.Unsafe)
+		return err
+	})
+	server.AddReadinessCheck("engine", func(ctx context.Context) error {
+		_, err := n.l2Source.L2BlockRefByLabel(ctx, eth.Unsafe)
+		return err
+	})
 	n.log.Info("Starting JSON-RPC server")
 	if err := server.Start(); err != nil {
 		return fmt.Errorf("unable to start RPC server: %w", err)
@@ -459,6 +565,7 @@ func (n *OpNode) initPProf(cfg *Config) error {
 		cfg.Pprof.ProfileDir,
 		cfg.Pprof.ProfileFilename,
 	)
+	n.pprofService.EnableUpload(cfg.Pprof.Upload, "op-node", n.appVersion)
 
 	if err := n.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
@@ -585,6 +692,30 @@ func (n *OpNode) OnUnsafeL2Payload(ctx context.Context, from peer.ID, envelope *
 	return nil
 }
 
+// OnGossipTransaction forwards transactions gossiped by followers to the execution engine of the sequencer.
+// Other nodes only relay the gossiped transactions.
+func (n *OpNode) OnGossipTransaction(ctx context.Context, from peer.ID, tx *types.Transaction) error {
+	// ignore if it's from ourselves
+	if from == n.p2pNode.Host().ID() || !n.cfg.Driver.SequencerEnabled {
+		return nil
+	}
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode gossiped transaction: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	var hash common.Hash
+	if err := n.l2Source.RPC.CallContext(ctx, &hash, "eth_sendRawTransaction", hexutil.Bytes(data)); err != nil {
+		// Expected for transactions the execution engine already knows, or that became invalid since
+		n.metrics.RecordGossipTx("forward_failed")
+		n.log.Debug("Failed to forward gossiped transaction to execution engine", "tx", tx.Hash(), "peer", from, "err", err)
+		return nil
+	}
+	n.metrics.RecordGossipTx("forwarded")
+	return nil
+}
+
 func (n *OpNode) RequestL2Range(ctx context.Context, start, end eth.L2BlockRef) error {
 	if n.p2pEnabled() && n.p2pNode.AltSyncEnabled() {
 		if unixTimeStale(start.Time, 12*time.Hour) {
@@ -704,6 +835,9 @@ func (n *OpNode) Stop(ctx context.Context) error {
 	if n.l1Source != nil {
 		n.l1Source.Close()
 	}
+	if n.l1HealthSecondary != nil {
+		n.l1HealthSecondary.Close()
+	}
 
 	if result == nil { // mark as closed if we successfully fully closed
 		n.closed.Store(true)
@@ -27,6 +27,14 @@ func (d *DisabledDB) SafeHeadAtL1(_ context.Context, _ uint64) (l1 eth.BlockID,
 	return
 }
 
+func (d *DisabledDB) DerivationReceiptUpdated(_ *eth.DerivationReceipt) error {
+	return nil
+}
+
+func (d *DisabledDB) DerivationReceipt(_ context.Context, _ uint64) (*eth.DerivationReceipt, error) {
+	return nil, ErrNotEnabled
+}
+
 func (d *DisabledDB) SafeHeadReset(_ eth.L2BlockRef) error {
 	return nil
 }
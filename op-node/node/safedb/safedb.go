@@ -3,6 +3,7 @@ package safedb
 import (
 	"context"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -21,11 +22,13 @@ var (
 
 const (
 	// Keys are prefixed with a constant byte to allow us to differentiate different "columns" within the data
-	keyPrefixSafeByL1BlockNum byte = 0
+	keyPrefixSafeByL1BlockNum    byte = 0
+	keyPrefixReceiptByL2BlockNum byte = 1
 )
 
 var (
-	safeByL1BlockNumKey = uint64Key{prefix: keyPrefixSafeByL1BlockNum}
+	safeByL1BlockNumKey    = uint64Key{prefix: keyPrefixSafeByL1BlockNum}
+	receiptByL2BlockNumKey = uint64Key{prefix: keyPrefixReceiptByL2BlockNum}
 )
 
 type uint64Key struct {
@@ -112,9 +115,27 @@ func (d *SafeDB) SafeHeadUpdated(safeHead eth.L2BlockRef, l1Head eth.BlockID) er
 	return nil
 }
 
+// DerivationReceiptUpdated records the L1 data the new safe block was derived from.
+func (d *SafeDB) DerivationReceiptUpdated(receipt *eth.DerivationReceipt) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	val, err := json.Marshal(receipt)
+	if err != nil {
+		return fmt.Errorf("failed to encode derivation receipt: %w", err)
+	}
+	if err := d.db.Set(receiptByL2BlockNumKey.Of(receipt.L2Block.Number), val, d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record derivation receipt: %w", err)
+	}
+	return nil
+}
+
 func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
 	d.m.Lock()
 	defer d.m.Unlock()
+	// Blocks after the new safe head are no longer safe, their receipts are recorded again once they are re-derived.
+	if err := d.db.DeleteRange(receiptByL2BlockNumKey.Of(safeHead.Number+1), receiptByL2BlockNumKey.Max(), d.writeOpts); err != nil {
+		return fmt.Errorf("reset failed to delete derivation receipts after %v: %w", safeHead.ID(), err)
+	}
 	iter, err := d.db.NewIter(safeByL1BlockNumKey.IterRange())
 	if err != nil {
 		return fmt.Errorf("reset failed to create iterator: %w", err)
@@ -184,6 +205,24 @@ func (d *SafeDB) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1Block e
 	return
 }
 
+// DerivationReceipt returns the receipt of the L1 data the safe L2 block was derived from.
+func (d *SafeDB) DerivationReceipt(_ context.Context, l2BlockNum uint64) (*eth.DerivationReceipt, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	val, closer, err := d.db.Get(receiptByL2BlockNumKey.Of(l2BlockNum))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	var receipt eth.DerivationReceipt
+	if err := json.Unmarshal(val, &receipt); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEntry, err)
+	}
+	return &receipt, nil
+}
+
 func (d *SafeDB) Close() error {
 	d.m.Lock()
 	defer d.m.Unlock()
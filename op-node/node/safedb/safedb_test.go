@@ -367,3 +367,54 @@ func TestDecodeSafeByL1BlockNum(t *testing.T) {
 		require.ErrorIs(t, err, ErrInvalidEntry)
 	})
 }
+
+func TestStoreDerivationReceipts(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	dir := t.TempDir()
+	db, err := NewSafeDB(logger, dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	receiptA := &eth.DerivationReceipt{
+		L2Block:     eth.BlockID{Hash: common.Hash{0x02, 0xaa}, Number: 20},
+		L1Origin:    eth.BlockID{Hash: common.Hash{0x01, 0x99}, Number: 95},
+		DerivedFrom: eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100},
+		ChannelID:   []byte{0xca, 0xfe},
+		Frames: []eth.BatcherFrameRef{
+			{L1Block: eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100}, TxHash: common.Hash{0x03}, FrameNumber: 0},
+		},
+		BatchBlockIndex: 0,
+		BatchBlockCount: 1,
+		Deposits:        []eth.DepositRef{{TxHash: common.Hash{0x04}, LogIndex: 2}},
+	}
+	receiptB := &eth.DerivationReceipt{
+		L2Block:         eth.BlockID{Hash: common.Hash{0x02, 0xbb}, Number: 25},
+		L1Origin:        eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100},
+		DerivedFrom:     eth.BlockID{Hash: common.Hash{0x01, 0xbb}, Number: 150},
+		BatchBlockIndex: 0,
+		BatchBlockCount: 1,
+	}
+	require.NoError(t, db.DerivationReceiptUpdated(receiptA))
+	require.NoError(t, db.DerivationReceiptUpdated(receiptB))
+
+	actual, err := db.DerivationReceipt(context.Background(), 20)
+	require.NoError(t, err)
+	require.Equal(t, receiptA, actual)
+
+	actual, err = db.DerivationReceipt(context.Background(), 25)
+	require.NoError(t, err)
+	require.Equal(t, receiptB, actual)
+
+	_, err = db.DerivationReceipt(context.Background(), 21)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Receipts of blocks after the new safe head are removed on reset
+	require.NoError(t, db.SafeHeadReset(eth.L2BlockRef{Hash: common.Hash{0x02, 0xcc}, Number: 22}))
+
+	actual, err = db.DerivationReceipt(context.Background(), 20)
+	require.NoError(t, err)
+	require.Equal(t, receiptA, actual)
+
+	_, err = db.DerivationReceipt(context.Background(), 25)
+	require.ErrorIs(t, err, ErrNotFound)
+}
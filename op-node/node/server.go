@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	ophttp "github.com/ethereum-optimism/optimism/op-service/httputil"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 )
 
@@ -23,12 +25,14 @@ type rpcServer struct {
 	apis       []rpc.API
 	httpServer *ophttp.HTTPServer
 	appVersion string
+	readiness  *oprpc.HealthChecks
+	topology   http.Handler
 	log        log.Logger
 	sources.L2Client
 }
 
-func newRPCServer(rpcCfg *RPCConfig, rollupCfg *rollup.Config, l2Client l2EthClient, dr driverClient, safedb SafeDBReader, log log.Logger, appVersion string, m metrics.Metricer) (*rpcServer, error) {
-	api := NewNodeAPI(rollupCfg, l2Client, dr, safedb, log.New("rpc", "node"), m)
+func newRPCServer(rpcCfg *RPCConfig, rollupCfg *rollup.Config, overrides *eth.ConfigOverrides, l1Health L1HealthReader, l2Client l2EthClient, dr driverClient, safedb SafeDBReader, log log.Logger, appVersion string, m metrics.Metricer) (*rpcServer, error) {
+	api := NewNodeAPI(rollupCfg, overrides, l1Health, l2Client, dr, safedb, log.New("rpc", "node"), m)
 	// TODO: extend RPC config with options for WS, IPC and HTTP RPC connections
 	endpoint := net.JoinHostPort(rpcCfg.ListenAddr, strconv.Itoa(rpcCfg.ListenPort))
 	r := &rpcServer{
@@ -37,8 +41,13 @@ func newRPCServer(rpcCfg *RPCConfig, rollupCfg *rollup.Config, l2Client l2EthCli
 			Namespace:     "optimism",
 			Service:       api,
 			Authenticated: false,
+		}, {
+			Namespace:     "debug",
+			Service:       NewDebugAPI(dr, m),
+			Authenticated: false,
 		}},
 		appVersion: appVersion,
+		readiness:  oprpc.NewHealthChecks(appVersion),
 		log:        log,
 	}
 	return r, nil
@@ -53,6 +62,24 @@ func (s *rpcServer) EnableAdminAPI(api *adminAPI) {
 	})
 }
 
+func (s *rpcServer) EnableTxConditionalAPI(api *txConditionalAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "eth",
+		Version:       "",
+		Service:       api,
+		Authenticated: false,
+	})
+}
+
+func (s *rpcServer) EnableTxGossipAPI(api *txGossipAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "eth",
+		Version:       "",
+		Service:       api,
+		Authenticated: false,
+	})
+}
+
 func (s *rpcServer) EnableP2P(backend *p2p.APIBackend) {
 	s.apis = append(s.apis, rpc.API{
 		Namespace:     p2p.NamespaceRPC,
@@ -62,6 +89,16 @@ func (s *rpcServer) EnableP2P(backend *p2p.APIBackend) {
 	})
 }
 
+// EnableP2PTopology serves the P2P topology on p2p.TopologyPath.
+func (s *rpcServer) EnableP2PTopology(topology *p2p.TopologyReader) {
+	s.topology = topology
+}
+
+// AddReadinessCheck registers a named dependency check that is served on /readyz.
+func (s *rpcServer) AddReadinessCheck(name string, check oprpc.HealthCheck) {
+	s.readiness.Register(name, check)
+}
+
 func (s *rpcServer) Start() error {
 	srv := rpc.NewServer()
 	if err := node.RegisterApis(s.apis, nil, srv); err != nil {
@@ -77,6 +114,10 @@ func (s *rpcServer) Start() error {
 	mux := http.NewServeMux()
 	mux.Handle("/", nodeHandler)
 	mux.HandleFunc("/healthz", healthzHandler(s.appVersion))
+	mux.Handle("/readyz", s.readiness.Handler())
+	if s.topology != nil {
+		mux.Handle(p2p.TopologyPath, s.topology)
+	}
 
 	hs, err := ophttp.StartHTTPServer(s.endpoint, mux)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
@@ -102,7 +103,7 @@ func TestOutputAtBlock(t *testing.T) {
 	status := randomSyncStatus(rand.New(rand.NewSource(123)))
 	drClient.ExpectBlockRefWithStatus(0xdcdc89, ref, status, nil)
 
-	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
 	require.NoError(t, err)
 	require.NoError(t, server.Start())
 	defer func() {
@@ -138,7 +139,7 @@ func TestVersion(t *testing.T) {
 	rollupCfg := &rollup.Config{
 		// ignore other rollup config info in this test
 	}
-	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
 	assert.NoError(t, err)
 	assert.NoError(t, server.Start())
 	defer func() {
@@ -184,7 +185,7 @@ func TestSyncStatus(t *testing.T) {
 	rollupCfg := &rollup.Config{
 		// ignore other rollup config info in this test
 	}
-	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
 	assert.NoError(t, err)
 	assert.NoError(t, server.Start())
 	defer func() {
@@ -200,6 +201,85 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, status, out)
 }
 
+func TestSequencerStatus(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	rng := rand.New(rand.NewSource(1234))
+	status := &eth.SequencerStatus{
+		Active:                true,
+		UnsafeHead:            testutils.RandomL2BlockRef(rng),
+		TimeSinceLastBlock:    1500,
+		BlockedOnL1Origin:     true,
+		L1OriginError:         "L1 origin not found",
+		ConductorLeader:       true,
+		GossipPublishFailures: 3,
+		BuildingLimits:        eth.BlockBuildingLimits{MaxTxs: 100, GasTarget: 15_000_000},
+	}
+	drClient.On("SequencerStatus").Return(status)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	server.EnableAdminAPI(NewAdminAPI(drClient, nil, metrics.NoopMetrics, log))
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.SequencerStatus
+	err = client.CallContext(context.Background(), &out, "admin_sequencerStatus")
+	require.NoError(t, err)
+	require.Equal(t, status, out)
+}
+
+func TestSyncStatusWithOverrides(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	rng := rand.New(rand.NewSource(1234))
+	status := randomSyncStatus(rng)
+	drClient.On("SyncStatus").Return(status)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	seqWindowSize := uint64(7200)
+	overrides := &eth.ConfigOverrides{SeqWindowSize: &seqWindowSize}
+	server, err := newRPCServer(rpcCfg, rollupCfg, overrides, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.SyncStatus
+	err = client.CallContext(context.Background(), &out, "optimism_syncStatus")
+	require.NoError(t, err)
+	require.Equal(t, overrides, out.ConfigOverrides)
+	require.Nil(t, status.ConfigOverrides, "must not modify the status shared by the driver")
+	out.ConfigOverrides = nil
+	require.Equal(t, status, out)
+}
+
 func TestSafeHeadAtL1Block(t *testing.T) {
 	log := testlog.Logger(t, log.LevelError)
 	l2Client := &testutils.MockL2Client{}
@@ -227,7 +307,7 @@ func TestSafeHeadAtL1Block(t *testing.T) {
 	rollupCfg := &rollup.Config{
 		// ignore other rollup config info in this test
 	}
-	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
 	require.NoError(t, err)
 	require.NoError(t, server.Start())
 	defer func() {
@@ -246,6 +326,152 @@ func TestSafeHeadAtL1Block(t *testing.T) {
 	safeReader.Mock.AssertExpectations(t)
 }
 
+func TestDerivationReceipt(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	l2BlockNum := uint64(223)
+	expected := &eth.DerivationReceipt{
+		L2Block:     eth.BlockID{Hash: common.Hash{0xee}, Number: l2BlockNum},
+		L1Origin:    eth.BlockID{Hash: common.Hash{0xcc}, Number: 5200},
+		DerivedFrom: eth.BlockID{Hash: common.Hash{0xdd}, Number: 5221},
+		ChannelID:   hexutil.Bytes{0x01, 0x02},
+		Frames: []eth.BatcherFrameRef{
+			{L1Block: eth.BlockID{Hash: common.Hash{0xdd}, Number: 5221}, TxHash: common.Hash{0xaa}, FrameNumber: 0},
+		},
+		BatchBlockIndex: 2,
+		BatchBlockCount: 5,
+		Deposits:        []eth.DepositRef{{TxHash: common.Hash{0xbb}, LogIndex: 3}},
+	}
+	safeReader.ExpectDerivationReceipt(l2BlockNum, expected, nil)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.DerivationReceipt
+	err = client.CallContext(context.Background(), &out, "optimism_derivationReceipt", hexutil.Uint64(l2BlockNum).String())
+	require.NoError(t, err)
+	require.Equal(t, expected, out)
+	l2Client.Mock.AssertExpectations(t)
+	drClient.Mock.AssertExpectations(t)
+	safeReader.Mock.AssertExpectations(t)
+}
+
+func TestSafeBlockJustification(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	l2BlockNum := uint64(223)
+	l1Block := eth.BlockID{Hash: common.Hash{0xdd}, Number: 5221}
+	receipt := &eth.DerivationReceipt{
+		L2Block:     eth.BlockID{Hash: common.Hash{0xee}, Number: l2BlockNum},
+		L1Origin:    eth.BlockID{Hash: common.Hash{0xcc}, Number: 5200},
+		DerivedFrom: l1Block,
+		ChannelID:   hexutil.Bytes{0x01, 0x02},
+		Frames: []eth.BatcherFrameRef{
+			{L1Block: l1Block, TxHash: common.Hash{0xaa}, FrameNumber: 0},
+			{L1Block: l1Block, TxHash: common.Hash{0xab}, FrameNumber: 1},
+		},
+		BatchBlockIndex: 2,
+		BatchBlockCount: 5,
+	}
+	safeReader.ExpectDerivationReceipt(l2BlockNum, receipt, nil)
+	safeReader.ExpectDerivationReceipt(l2BlockNum+1, nil, safedb.ErrNotFound)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.SafeBlockJustification
+	err = client.CallContext(context.Background(), &out, "optimism_safeBlockJustification", hexutil.Uint64(l2BlockNum).String())
+	require.NoError(t, err)
+	require.Equal(t, &eth.SafeBlockJustification{
+		L2Block:        receipt.L2Block,
+		DerivedFrom:    l1Block,
+		ChannelID:      hexutil.Bytes{0x01, 0x02},
+		FrameNumbers:   []uint16{0, 1},
+		BatcherTxs:     []common.Hash{{0xaa}, {0xab}},
+		SpanBatchIndex: 2,
+		SpanBatchCount: 5,
+	}, out)
+
+	err = client.CallContext(context.Background(), &out, "optimism_safeBlockJustification", hexutil.Uint64(l2BlockNum+1).String())
+	require.ErrorContains(t, err, safedb.ErrNotFound.Error())
+	safeReader.Mock.AssertExpectations(t)
+}
+
+func TestDerivationPipelineStatus(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	rng := rand.New(rand.NewSource(1234))
+	origin := testutils.RandomBlockRef(rng)
+	expected := &eth.DerivationPipelineStatus{
+		Origin:    origin,
+		Ready:     true,
+		LastError: "derivation failed: boom",
+		Stages: []eth.DerivationStageStatus{
+			{Name: "frame_queue", Origin: origin, QueueDepth: 3},
+			{Name: "channel_bank", Origin: origin, QueueDepth: 2, LastError: "channel timed out"},
+			{Name: "channel_in_reader", Origin: origin, QueueDepth: 1},
+			{Name: "batch_queue", Origin: origin, QueueDepth: 7},
+		},
+	}
+	drClient.On("DerivationPipelineStatus").Return(expected)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, nil, nil, l2Client, drClient, nil, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.DerivationPipelineStatus
+	err = client.CallContext(context.Background(), &out, "debug_derivationPipeline")
+	require.NoError(t, err)
+	require.Equal(t, expected, out)
+	drClient.Mock.AssertExpectations(t)
+}
+
 type mockDriverClient struct {
 	mock.Mock
 }
@@ -279,6 +505,14 @@ func (c *mockDriverClient) SequencerActive(ctx context.Context) (bool, error) {
 	return c.Mock.MethodCalled("SequencerActive").Get(0).(bool), nil
 }
 
+func (c *mockDriverClient) SequencerStatus(ctx context.Context) (*eth.SequencerStatus, error) {
+	return c.Mock.MethodCalled("SequencerStatus").Get(0).(*eth.SequencerStatus), nil
+}
+
+func (c *mockDriverClient) SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	return c.Mock.MethodCalled("SetBlockBuildingLimits", limits).Get(0).(error)
+}
+
 func (c *mockDriverClient) OnUnsafeL2Payload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error {
 	return c.Mock.MethodCalled("OnUnsafeL2Payload").Get(0).(error)
 }
@@ -287,6 +521,14 @@ func (c *mockDriverClient) OverrideLeader(ctx context.Context) error {
 	return c.Mock.MethodCalled("OverrideLeader").Get(0).(error)
 }
 
+func (c *mockDriverClient) AcknowledgeL1Reorg(ctx context.Context) error {
+	return c.Mock.MethodCalled("AcknowledgeL1Reorg").Error(0)
+}
+
+func (c *mockDriverClient) DerivationPipelineStatus(ctx context.Context) (*eth.DerivationPipelineStatus, error) {
+	return c.Mock.MethodCalled("DerivationPipelineStatus").Get(0).(*eth.DerivationPipelineStatus), nil
+}
+
 type mockSafeDBReader struct {
 	mock.Mock
 }
@@ -299,3 +541,12 @@ func (m *mockSafeDBReader) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64)
 func (m *mockSafeDBReader) ExpectSafeHeadAtL1(l1BlockNum uint64, l1 eth.BlockID, safeHead eth.BlockID, err error) {
 	m.Mock.On("SafeHeadAtL1", l1BlockNum).Return(l1, safeHead, &err)
 }
+
+func (m *mockSafeDBReader) DerivationReceipt(ctx context.Context, l2BlockNum uint64) (*eth.DerivationReceipt, error) {
+	r := m.Mock.MethodCalled("DerivationReceipt", l2BlockNum)
+	return r[0].(*eth.DerivationReceipt), *r[1].(*error)
+}
+
+func (m *mockSafeDBReader) ExpectDerivationReceipt(l2BlockNum uint64, receipt *eth.DerivationReceipt, err error) {
+	m.Mock.On("DerivationReceipt", l2BlockNum).Return(receipt, &err)
+}
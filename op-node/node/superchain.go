@@ -11,6 +11,14 @@ import (
 
 var errNodeHalt = errors.New("opted to halt, unprepared for protocol change")
 
+const (
+	// RollupHaltShutdown shuts down the node when halting on a protocol version.
+	RollupHaltShutdown = "shutdown"
+	// RollupHaltPause pauses sequencing and derivation when halting on a protocol version,
+	// until the halt is overridden by the operator.
+	RollupHaltPause = "pause"
+)
+
 func (n *OpNode) handleProtocolVersionsUpdate(ctx context.Context) error {
 	recommended := n.runCfg.RecommendedProtocolVersion()
 	required := n.runCfg.RequiredProtocolVersion()
@@ -38,14 +46,51 @@ func (n *OpNode) handleProtocolVersionsUpdate(ctx context.Context) error {
 
 // haltMaybe returns errNodeHalt if the runtime config indicates an incompatible required protocol change
 // and the node is configured to opt-in to halting at this protocol-change level.
+// If configured to pause instead, sequencing and derivation are paused, and nil is returned.
+// A required protocol version that the operator overrode the halt for is ignored.
 func (n *OpNode) haltMaybe() error {
 	local := rollup.OPStackSupport
 	required := n.runCfg.RequiredProtocolVersion()
-	if haltMaybe(n.rollupHalt, local.Compare(required)) { // halt if we opted in to do so at this granularity
-		n.log.Error("Opted to halt, unprepared for protocol change", "required", required, "local", local)
-		// Avoid deadlocking the runtime config reloader by closing the OpNode elsewhere
-		return errNodeHalt
+	if !haltMaybe(n.rollupHalt, local.Compare(required)) { // halt if we opted in to do so at this granularity
+		n.setProtocolVersionPause(false)
+		return nil
+	}
+	if override := n.haltOverride.Load(); override != nil && *override == required {
+		n.log.Warn("Ignoring protocol change, halt was overridden by operator", "required", required, "local", local)
+		n.setProtocolVersionPause(false)
+		return nil
+	}
+	if n.rollupHaltAction == RollupHaltPause {
+		n.log.Error("Opted to pause sequencing and derivation, unprepared for protocol change", "required", required, "local", local)
+		n.setProtocolVersionPause(true)
+		return nil
 	}
+	n.log.Error("Opted to halt, unprepared for protocol change", "required", required, "local", local)
+	// Avoid deadlocking the runtime config reloader by closing the OpNode elsewhere
+	return errNodeHalt
+}
+
+// setProtocolVersionPause pauses or resumes sequencing and derivation, when halting on a protocol version.
+func (n *OpNode) setProtocolVersionPause(paused bool) {
+	if n.protocolPaused.Swap(paused) == paused {
+		return
+	}
+	if n.l2Driver != nil {
+		n.l2Driver.SetHalted(paused)
+	}
+}
+
+// OverrideProtocolVersionHalt resumes sequencing and derivation after pausing on an incompatible
+// required protocol version. The node ignores the current required protocol version after the override,
+// but halts again if a different incompatible version is required later.
+func (n *OpNode) OverrideProtocolVersionHalt(ctx context.Context) error {
+	if !n.protocolPaused.Load() {
+		return errors.New("not halted on a protocol version")
+	}
+	required := n.runCfg.RequiredProtocolVersion()
+	n.haltOverride.Store(&required)
+	n.log.Warn("Operator overrode halt on protocol change", "required", required, "local", rollup.OPStackSupport)
+	n.setProtocolVersionPause(false)
 	return nil
 }
 
@@ -1,12 +1,16 @@
 package node
 
 import (
+	"context"
 	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestHaltMaybe(t *testing.T) {
@@ -37,3 +41,44 @@ func TestHaltMaybe(t *testing.T) {
 	haltTest("minor", params.OutdatedMajor, params.OutdatedMinor)
 	haltTest("patch", params.OutdatedMajor, params.OutdatedMinor, params.OutdatedPatch)
 }
+
+func TestProtocolVersionHaltAction(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	requireMajor := func(major uint32) params.ProtocolVersion {
+		return params.ProtocolVersionV0{Major: major}.Encode()
+	}
+	newNode := func(action string) *OpNode {
+		runCfg := NewRuntimeConfig(logger, nil, nil)
+		runCfg.required = requireMajor(100)
+		return &OpNode{log: logger, runCfg: runCfg, rollupHalt: "major", rollupHaltAction: action}
+	}
+
+	t.Run("Shutdown", func(t *testing.T) {
+		n := newNode(RollupHaltShutdown)
+		require.ErrorIs(t, n.haltMaybe(), errNodeHalt)
+		require.False(t, n.protocolPaused.Load())
+		require.ErrorContains(t, n.OverrideProtocolVersionHalt(context.Background()), "not halted")
+	})
+
+	t.Run("Pause", func(t *testing.T) {
+		n := newNode(RollupHaltPause)
+		require.NoError(t, n.haltMaybe())
+		require.True(t, n.protocolPaused.Load())
+
+		require.NoError(t, n.OverrideProtocolVersionHalt(context.Background()))
+		require.False(t, n.protocolPaused.Load())
+		// The overridden version no longer halts
+		require.NoError(t, n.haltMaybe())
+		require.False(t, n.protocolPaused.Load())
+
+		// A different incompatible version halts again
+		n.runCfg.required = requireMajor(101)
+		require.NoError(t, n.haltMaybe())
+		require.True(t, n.protocolPaused.Load())
+
+		// Resumes when the required version becomes compatible again
+		n.runCfg.required = params.ProtocolVersion{}
+		require.NoError(t, n.haltMaybe())
+		require.False(t, n.protocolPaused.Load())
+	})
+}
@@ -0,0 +1,193 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// TxConditionalPolicy enforces the preconditions of conditional transactions at the ingress of the sequencer,
+// before the transaction is forwarded to the execution engine.
+type TxConditionalPolicy interface {
+	// Name identifies the policy in logs and metrics.
+	Name() string
+	// Check returns a rejection, created with NewTxConditionalRejection, if the conditional is not met at the given L2 head.
+	// Errors that do not implement rpc.Error are failures to check the conditional, not rejections.
+	Check(ctx context.Context, head eth.BlockInfo, cond *types.TransactionConditional) error
+}
+
+// TxConditionalState is the L2 state the policies check the conditionals against.
+type TxConditionalState interface {
+	InfoByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockInfo, error)
+	GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error)
+}
+
+// txConditionalError is a JSON-RPC error with the error codes of the eth_sendRawTransactionConditional API.
+type txConditionalError struct {
+	code int
+	msg  string
+}
+
+func (e *txConditionalError) Error() string  { return e.msg }
+func (e *txConditionalError) ErrorCode() int { return e.code }
+
+// NewTxConditionalRejection returns the error of a conditional that is not met.
+func NewTxConditionalRejection(format string, args ...any) error {
+	return &txConditionalError{code: params.TransactionConditionalRejectedErrCode, msg: fmt.Sprintf(format, args...)}
+}
+
+// CostPolicy rejects conditionals that require more storage lookups than the maximum cost.
+type CostPolicy struct {
+	MaxCost int
+}
+
+func (p *CostPolicy) Name() string { return "cost" }
+
+func (p *CostPolicy) Check(_ context.Context, _ eth.BlockInfo, cond *types.TransactionConditional) error {
+	if cost := cond.Cost(); cost > p.MaxCost {
+		return &txConditionalError{
+			code: params.TransactionConditionalCostExceededMaxErrCode,
+			msg:  fmt.Sprintf("conditional cost %d exceeds maximum of %d", cost, p.MaxCost),
+		}
+	}
+	return nil
+}
+
+// BlockRangePolicy rejects conditionals of which the block number or timestamp bounds do not contain the L2 head.
+type BlockRangePolicy struct{}
+
+func (p *BlockRangePolicy) Name() string { return "block_range" }
+
+func (p *BlockRangePolicy) Check(_ context.Context, head eth.BlockInfo, cond *types.TransactionConditional) error {
+	num := new(big.Int).SetUint64(head.NumberU64())
+	if cond.BlockNumberMin != nil && num.Cmp(cond.BlockNumberMin) < 0 {
+		return NewTxConditionalRejection("block number %d is before minimum %s", head.NumberU64(), cond.BlockNumberMin)
+	}
+	if cond.BlockNumberMax != nil && num.Cmp(cond.BlockNumberMax) > 0 {
+		return NewTxConditionalRejection("block number %d is after maximum %s", head.NumberU64(), cond.BlockNumberMax)
+	}
+	if cond.TimestampMin != nil && head.Time() < *cond.TimestampMin {
+		return NewTxConditionalRejection("timestamp %d is before minimum %d", head.Time(), *cond.TimestampMin)
+	}
+	if cond.TimestampMax != nil && head.Time() > *cond.TimestampMax {
+		return NewTxConditionalRejection("timestamp %d is after maximum %d", head.Time(), *cond.TimestampMax)
+	}
+	return nil
+}
+
+// KnownAccountsPolicy rejects conditionals of which the expected storage root or storage slot values
+// of the known accounts do not match the state of the L2 head.
+type KnownAccountsPolicy struct {
+	State TxConditionalState
+}
+
+func (p *KnownAccountsPolicy) Name() string { return "known_accounts" }
+
+func (p *KnownAccountsPolicy) Check(ctx context.Context, head eth.BlockInfo, cond *types.TransactionConditional) error {
+	for addr, account := range cond.KnownAccounts {
+		slots, isSlots := account.Slots()
+		keys := make([]common.Hash, 0, len(slots))
+		if isSlots {
+			for key := range slots {
+				keys = append(keys, key)
+			}
+			slices.SortFunc(keys, func(a, b common.Hash) int { return a.Cmp(b) })
+		}
+		res, err := p.State.GetProof(ctx, addr, keys, head.Hash().String())
+		if err != nil {
+			return fmt.Errorf("failed to fetch state of account %s: %w", addr, err)
+		}
+		if root, ok := account.Root(); ok {
+			if res.StorageHash != root {
+				return NewTxConditionalRejection("storage root of account %s is %s, expected %s", addr, res.StorageHash, root)
+			}
+			continue
+		}
+		for _, entry := range res.StorageProof {
+			value := common.BigToHash(entry.Value.ToInt())
+			if expected := slots[entry.Key]; value != expected {
+				return NewTxConditionalRejection("storage slot %s of account %s is %s, expected %s", entry.Key, addr, value, expected)
+			}
+		}
+	}
+	return nil
+}
+
+// DefaultTxConditionalPolicies returns the policies that are always enforced:
+// the cost limit, the block number and timestamp bounds, and the known accounts preconditions.
+func DefaultTxConditionalPolicies(state TxConditionalState, maxCost int) []TxConditionalPolicy {
+	return []TxConditionalPolicy{
+		&CostPolicy{MaxCost: maxCost},
+		&BlockRangePolicy{},
+		&KnownAccountsPolicy{State: state},
+	}
+}
+
+// txConditionalAPI serves eth_sendRawTransactionConditional at the ingress of the sequencer.
+// Transactions of which the conditional passes all policies are forwarded, with the conditional,
+// to the execution engine, which enforces the conditional again when the transaction is included.
+type txConditionalAPI struct {
+	log      log.Logger
+	m        metrics.Metricer
+	state    TxConditionalState
+	engine   client.RPC
+	policies []TxConditionalPolicy
+}
+
+func NewTxConditionalAPI(log log.Logger, m metrics.Metricer, state TxConditionalState, engine client.RPC, policies []TxConditionalPolicy) *txConditionalAPI {
+	return &txConditionalAPI{
+		log:      log,
+		m:        m,
+		state:    state,
+		engine:   engine,
+		policies: policies,
+	}
+}
+
+func (api *txConditionalAPI) SendRawTransactionConditional(ctx context.Context, txBytes hexutil.Bytes, cond types.TransactionConditional) (common.Hash, error) {
+	recordDur := api.m.RecordRPCServerRequest("eth_sendRawTransactionConditional")
+	defer recordDur()
+
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if err := cond.Validate(); err != nil {
+		api.m.RecordConditionalTxRejected("invalid")
+		return common.Hash{}, NewTxConditionalRejection("invalid conditional: %v", err)
+	}
+	head, err := api.state.InfoByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch L2 head: %w", err)
+	}
+	for _, policy := range api.policies {
+		err := policy.Check(ctx, head, &cond)
+		var rejection *txConditionalError
+		if errors.As(err, &rejection) {
+			api.m.RecordConditionalTxRejected(policy.Name())
+			api.log.Debug("Rejected conditional transaction", "tx", tx.Hash(), "policy", policy.Name(), "head", eth.ToBlockID(head), "err", err)
+			return common.Hash{}, rejection
+		} else if err != nil {
+			api.log.Warn("Failed to check conditional transaction", "tx", tx.Hash(), "policy", policy.Name(), "err", err)
+			return common.Hash{}, fmt.Errorf("failed to check %s conditional: %w", policy.Name(), err)
+		}
+	}
+	var hash common.Hash
+	if err := api.engine.CallContext(ctx, &hash, "eth_sendRawTransactionConditional", txBytes, &cond); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to forward conditional transaction: %w", err)
+	}
+	api.m.RecordConditionalTxForwarded()
+	return hash, nil
+}
@@ -0,0 +1,132 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func requireRejected(t *testing.T, err error, code int) {
+	var rpcErr rpc.Error
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, code, rpcErr.ErrorCode())
+}
+
+func TestTxConditionalPolicies(t *testing.T) {
+	head := &testutils.MockBlockInfo{InfoHash: common.Hash{0xaa}, InfoNum: 100, InfoTime: 1000}
+	u64 := func(v uint64) *uint64 { return &v }
+	ctx := context.Background()
+
+	t.Run("BlockRange", func(t *testing.T) {
+		p := &BlockRangePolicy{}
+		require.NoError(t, p.Check(ctx, head, &types.TransactionConditional{}))
+		require.NoError(t, p.Check(ctx, head, &types.TransactionConditional{
+			BlockNumberMin: big.NewInt(100), BlockNumberMax: big.NewInt(100),
+			TimestampMin: u64(1000), TimestampMax: u64(1000),
+		}))
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{BlockNumberMin: big.NewInt(101)}), params.TransactionConditionalRejectedErrCode)
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{BlockNumberMax: big.NewInt(99)}), params.TransactionConditionalRejectedErrCode)
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{TimestampMin: u64(1001)}), params.TransactionConditionalRejectedErrCode)
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{TimestampMax: u64(999)}), params.TransactionConditionalRejectedErrCode)
+	})
+
+	t.Run("Cost", func(t *testing.T) {
+		p := &CostPolicy{MaxCost: 2}
+		cond := &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			common.Address{0x01}: {StorageSlots: map[common.Hash]common.Hash{{0x01}: {}}},
+		}}
+		require.NoError(t, p.Check(ctx, head, cond))
+		cond.KnownAccounts[common.Address{0x02}] = types.KnownAccount{StorageRoot: &common.Hash{}}
+		requireRejected(t, p.Check(ctx, head, cond), params.TransactionConditionalCostExceededMaxErrCode)
+	})
+
+	t.Run("KnownAccounts", func(t *testing.T) {
+		addr := common.Address{0x01}
+		root := common.Hash{0x02}
+		slotA, slotB := common.Hash{0x0a}, common.Hash{0x0b}
+		state := &testutils.MockL2Client{}
+		p := &KnownAccountsPolicy{State: state}
+		blockTag := head.Hash().String()
+
+		state.ExpectGetProof(addr, []common.Hash{}, blockTag, &eth.AccountResult{StorageHash: root}, nil)
+		require.NoError(t, p.Check(ctx, head, &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			addr: {StorageRoot: &root},
+		}}))
+
+		state.ExpectGetProof(addr, []common.Hash{}, blockTag, &eth.AccountResult{StorageHash: common.Hash{0x03}}, nil)
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			addr: {StorageRoot: &root},
+		}}), params.TransactionConditionalRejectedErrCode)
+
+		slots := map[common.Hash]common.Hash{slotA: common.BigToHash(big.NewInt(1)), slotB: {}}
+		proof := &eth.AccountResult{StorageProof: []eth.StorageProofEntry{
+			{Key: slotA, Value: hexutil.Big(*big.NewInt(1))},
+			{Key: slotB, Value: hexutil.Big(*big.NewInt(0))},
+		}}
+		state.ExpectGetProof(addr, []common.Hash{slotA, slotB}, blockTag, proof, nil)
+		require.NoError(t, p.Check(ctx, head, &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			addr: {StorageSlots: slots},
+		}}))
+
+		slots[slotB] = common.BigToHash(big.NewInt(2))
+		state.ExpectGetProof(addr, []common.Hash{slotA, slotB}, blockTag, proof, nil)
+		requireRejected(t, p.Check(ctx, head, &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			addr: {StorageSlots: slots},
+		}}), params.TransactionConditionalRejectedErrCode)
+
+		// Failures to fetch the state are not rejections
+		state.ExpectGetProof(addr, []common.Hash{slotA, slotB}, blockTag, nil, errors.New("boom"))
+		err := p.Check(ctx, head, &types.TransactionConditional{KnownAccounts: types.KnownAccounts{
+			addr: {StorageSlots: slots},
+		}})
+		require.ErrorContains(t, err, "boom")
+		var rejection *txConditionalError
+		require.False(t, errors.As(err, &rejection))
+		state.AssertExpectations(t)
+	})
+}
+
+func TestTxConditionalAPI(t *testing.T) {
+	head := &testutils.MockBlockInfo{InfoHash: common.Hash{0xaa}, InfoNum: 100, InfoTime: 1000}
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(901), Nonce: 1, Gas: 21000})
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	state := &testutils.MockL2Client{}
+	engine := &testutils.MockRPC{}
+	api := NewTxConditionalAPI(testlog.Logger(t, log.LevelInfo), metrics.NoopMetrics, state, engine,
+		DefaultTxConditionalPolicies(state, params.TransactionConditionalMaxCost))
+
+	// Rejected conditionals are not forwarded
+	state.ExpectInfoByLabel(eth.Unsafe, head, nil)
+	_, err = api.SendRawTransactionConditional(context.Background(), txBytes, types.TransactionConditional{BlockNumberMax: big.NewInt(99)})
+	requireRejected(t, err, params.TransactionConditionalRejectedErrCode)
+
+	_, err = api.SendRawTransactionConditional(context.Background(), txBytes, types.TransactionConditional{
+		BlockNumberMin: big.NewInt(101), BlockNumberMax: big.NewInt(99),
+	})
+	requireRejected(t, err, params.TransactionConditionalRejectedErrCode)
+
+	cond := types.TransactionConditional{BlockNumberMin: big.NewInt(100)}
+	state.ExpectInfoByLabel(eth.Unsafe, head, nil)
+	engine.ExpectCallContext(new(common.Hash), "eth_sendRawTransactionConditional", []any{hexutil.Bytes(txBytes), &cond}, nil)
+	_, err = api.SendRawTransactionConditional(context.Background(), txBytes, cond)
+	require.NoError(t, err)
+
+	state.AssertExpectations(t)
+	engine.AssertExpectations(t)
+}
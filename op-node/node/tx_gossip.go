@@ -0,0 +1,52 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+)
+
+// TxPublisher publishes user transactions to the tx gossip topic.
+type TxPublisher interface {
+	PublishTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// txGossipAPI serves eth_sendRawTransaction on follower nodes, and publishes the transactions to the tx gossip topic,
+// to reach the sequencer over the P2P network. The execution engine of the follower can forward transactions
+// to this API, instead of to a centralized sequencer RPC endpoint.
+type txGossipAPI struct {
+	log       log.Logger
+	m         metrics.Metricer
+	publisher TxPublisher
+}
+
+func NewTxGossipAPI(log log.Logger, m metrics.Metricer, publisher TxPublisher) *txGossipAPI {
+	return &txGossipAPI{
+		log:       log,
+		m:         m,
+		publisher: publisher,
+	}
+}
+
+func (api *txGossipAPI) SendRawTransaction(ctx context.Context, txBytes hexutil.Bytes) (common.Hash, error) {
+	recordDur := api.m.RecordRPCServerRequest("eth_sendRawTransaction")
+	defer recordDur()
+
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	// The transaction is validated by the gossip topic validator before it is published
+	if err := api.publisher.PublishTransaction(ctx, &tx); err != nil {
+		api.log.Debug("Failed to publish transaction", "tx", tx.Hash(), "err", err)
+		return common.Hash{}, fmt.Errorf("failed to publish transaction: %w", err)
+	}
+	api.m.RecordGossipTx("published")
+	return tx.Hash(), nil
+}
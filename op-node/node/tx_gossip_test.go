@@ -0,0 +1,54 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestTxGossipAPI(t *testing.T) {
+	publisher := &stubTxPublisher{}
+	api := NewTxGossipAPI(testlog.Logger(t, log.LevelCrit), metrics.NoopMetrics, publisher)
+	ctx := context.Background()
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, To: &common.Address{0x01}, Gas: 21000, GasPrice: big.NewInt(1)})
+	data, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	hash, err := api.SendRawTransaction(ctx, data)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), hash)
+	require.Len(t, publisher.published, 1)
+	require.Equal(t, tx.Hash(), publisher.published[0].Hash())
+
+	_, err = api.SendRawTransaction(ctx, hexutil.Bytes{0x02, 0x03})
+	require.ErrorContains(t, err, "failed to decode transaction")
+
+	publisher.err = errors.New("boom")
+	_, err = api.SendRawTransaction(ctx, data)
+	require.ErrorIs(t, err, publisher.err)
+	require.Len(t, publisher.published, 1)
+}
+
+type stubTxPublisher struct {
+	published []*types.Transaction
+	err       error
+}
+
+func (s *stubTxPublisher) PublishTransaction(_ context.Context, tx *types.Transaction) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.published = append(s.published, tx)
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ipfs/go-datastore/sync"
 	leveldb "github.com/ipfs/go-ds-leveldb"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 
 	"github.com/ethereum-optimism/optimism/op-node/flags"
@@ -110,6 +111,7 @@ func loadBanningOptions(conf *p2p.Config, ctx *cli.Context) error {
 	conf.BanningEnabled = ctx.Bool(flags.BanningName)
 	conf.BanningThreshold = ctx.Float64(flags.BanningThresholdName)
 	conf.BanningDuration = ctx.Duration(flags.BanningDurationName)
+	conf.ScoresInMemory = !ctx.Bool(flags.ScorePersistName)
 	return nil
 }
 
@@ -340,5 +342,20 @@ func loadGossipOptions(conf *p2p.Config, ctx *cli.Context) error {
 	conf.MeshDHi = ctx.Int(flags.GossipMeshDhiName)
 	conf.MeshDLazy = ctx.Int(flags.GossipMeshDlazyName)
 	conf.FloodPublish = ctx.Bool(flags.GossipFloodPublishName)
+
+	conf.GossipTrace.File = ctx.Path(flags.GossipTraceFileName)
+	conf.GossipTrace.MaxFileSize = ctx.Uint64(flags.GossipTraceMaxSizeName) * 1024 * 1024
+	conf.GossipTrace.MaxFiles = ctx.Uint(flags.GossipTraceMaxFilesName)
+	if remote := ctx.String(flags.GossipTraceRemoteName); remote != "" {
+		pi, err := peer.AddrInfoFromString(remote)
+		if err != nil {
+			return fmt.Errorf("failed to parse gossip trace collector address %q: %w", remote, err)
+		}
+		conf.GossipTrace.Remote = pi
+	}
+
+	conf.TxGossip.Enabled = ctx.Bool(flags.GossipTxsName)
+	conf.TxGossip.RateLimit = ctx.Float64(flags.GossipTxsRateLimitName)
+	conf.TxGossip.RateBurst = ctx.Int(flags.GossipTxsRateBurstName)
 	return nil
 }
@@ -83,6 +83,10 @@ type Config struct {
 	// Minimum score before peers are disconnected and banned
 	BanningThreshold float64
 	BanningDuration  time.Duration
+	// Whether to keep peer scores in memory only, instead of in [Store], so they start from zero on restart.
+	// By default scores are kept in [Store], and peers with a persisted score below the [BanningThreshold]
+	// are banned again on startup.
+	ScoresInMemory bool
 
 	ListenIP      net.IP
 	ListenTCPPort uint16
@@ -115,6 +119,12 @@ type Config struct {
 	// FloodPublish publishes messages from ourselves to peers outside of the gossip topic mesh but supporting the same topic.
 	FloodPublish bool
 
+	// GossipTrace configures the optional export of gossip trace events.
+	GossipTrace GossipTraceConfig
+
+	// TxGossip configures the optional gossip of user transactions.
+	TxGossip TxGossipConfig
+
 	// If true a NAT manager will host a NAT port mapping that is updated with PMP and UPNP by libp2p/go-nat
 	NAT bool
 
@@ -157,6 +167,20 @@ func (conf *Config) PeerScoringParams() *ScoringParams {
 	return conf.ScoringParams
 }
 
+func (conf *Config) GossipTracing() *GossipTraceConfig {
+	if !conf.GossipTrace.Enabled() {
+		return nil
+	}
+	return &conf.GossipTrace
+}
+
+func (conf *Config) TxGossiping() *TxGossipConfig {
+	if !conf.TxGossip.Enabled {
+		return nil
+	}
+	return &conf.TxGossip
+}
+
 func (conf *Config) BanPeers() bool {
 	return conf.BanningEnabled
 }
@@ -205,5 +229,11 @@ func (conf *Config) Check() error {
 	if conf.MeshDLazy <= 0 || conf.MeshDLazy > maxMeshParam {
 		return fmt.Errorf("mesh Dlazy param must not be 0 or exceed %d, but got %d", maxMeshParam, conf.MeshDLazy)
 	}
+	if err := conf.TxGossip.Check(); err != nil {
+		return err
+	}
+	if err := conf.GossipTrace.Check(); err != nil {
+		return fmt.Errorf("invalid gossip trace config: %w", err)
+	}
 	return nil
 }
@@ -55,6 +55,10 @@ type GossipSetupConfigurables interface {
 	PeerScoringParams() *ScoringParams
 	// ConfigureGossip creates configuration options to apply to the GossipSub setup
 	ConfigureGossip(rollupCfg *rollup.Config) []pubsub.Option
+	// GossipTracing returns the configuration of the gossip trace export, or nil if not enabled.
+	GossipTracing() *GossipTraceConfig
+	// TxGossiping returns the configuration of the gossip of user transactions, or nil if not enabled.
+	TxGossiping() *TxGossipConfig
 }
 
 type GossipRuntimeConfig interface {
@@ -81,7 +85,7 @@ func blocksTopicV3(cfg *rollup.Config) string {
 // BuildSubscriptionFilter builds a simple subscription filter,
 // to help protect against peers spamming useless subscriptions.
 func BuildSubscriptionFilter(cfg *rollup.Config) pubsub.SubscriptionFilter {
-	return pubsub.NewAllowlistSubscriptionFilter(blocksTopicV1(cfg), blocksTopicV2(cfg), blocksTopicV3(cfg)) // add more topics here in the future, if any.
+	return pubsub.NewAllowlistSubscriptionFilter(blocksTopicV1(cfg), blocksTopicV2(cfg), blocksTopicV3(cfg), txsTopicV1(cfg)) // add more topics here in the future, if any.
 }
 
 var msgBufPool = sync.Pool{New: func() any {
@@ -164,7 +168,8 @@ func BuildGlobalGossipParams(cfg *rollup.Config) pubsub.GossipSubParams {
 
 // NewGossipSub configures a new pubsub instance with the specified parameters.
 // PubSub uses a GossipSubRouter as it's router under the hood.
-func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossipConf GossipSetupConfigurables, scorer Scorer, m GossipMetricer, log log.Logger) (*pubsub.PubSub, error) {
+// The trace exporter and mesh tracker are optional, and may be nil.
+func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossipConf GossipSetupConfigurables, scorer Scorer, m GossipMetricer, trace *GossipTraceExporter, mesh *GossipMesh, log log.Logger) (*pubsub.PubSub, error) {
 	denyList, err := pubsub.NewTimeCachedBlacklist(30 * time.Second)
 	if err != nil {
 		return nil, err
@@ -181,7 +186,7 @@ func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossi
 		pubsub.WithSeenMessagesTTL(seenMessagesTTL),
 		pubsub.WithPeerExchange(false),
 		pubsub.WithBlacklist(denyList),
-		pubsub.WithEventTracer(&gossipTracer{m: m}),
+		pubsub.WithEventTracer(&gossipTracer{m: m, export: trace, mesh: mesh}),
 	}
 	gossipOpts = append(gossipOpts, ConfigurePeerScoring(gossipConf, scorer, log)...)
 	gossipOpts = append(gossipOpts, gossipConf.ConfigureGossip(cfg)...)
@@ -609,6 +614,10 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 }
 
 func newBlockTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log log.Logger, gossipIn GossipIn, validator pubsub.ValidatorEx) (*blockTopic, error) {
+	return newGossipTopic(ctx, topicId, ps, log, BlocksHandler(gossipIn.OnUnsafeL2Payload), validator)
+}
+
+func newGossipTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log log.Logger, handler MessageHandler, validator pubsub.ValidatorEx) (*blockTopic, error) {
 	err := ps.RegisterTopicValidator(topicId,
 		validator,
 		pubsub.WithValidatorTimeout(3*time.Second),
@@ -636,7 +645,7 @@ func newBlockTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log l
 		return nil, fmt.Errorf("failed to subscribe to blocks gossip topic: %w", err)
 	}
 
-	subscriber := MakeSubscriber(log, BlocksHandler(gossipIn.OnUnsafeL2Payload))
+	subscriber := MakeSubscriber(log, handler)
 	go subscriber(ctx, subscription)
 
 	return &blockTopic{
@@ -697,11 +706,19 @@ func LogTopicEvents(ctx context.Context, log log.Logger, evHandler *pubsub.Topic
 }
 
 type gossipTracer struct {
-	m GossipMetricer
+	m      GossipMetricer
+	export *GossipTraceExporter
+	mesh   *GossipMesh
 }
 
 func (g *gossipTracer) Trace(evt *pb.TraceEvent) {
 	if g.m != nil {
 		g.m.RecordGossipEvent(int32(*evt.Type))
 	}
+	if g.export != nil {
+		g.export.Trace(evt)
+	}
+	if g.mesh != nil {
+		g.mesh.Trace(evt)
+	}
 }
@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// GossipMesh tracks the gossipsub mesh of the local node, i.e. the peers we exchange full messages with per topic.
+// The pubsub library does not expose the mesh, so it is reconstructed from the GRAFT and PRUNE trace events.
+type GossipMesh struct {
+	mu     sync.RWMutex
+	topics map[string]map[peer.ID]struct{}
+}
+
+func NewGossipMesh() *GossipMesh {
+	return &GossipMesh{topics: make(map[string]map[peer.ID]struct{})}
+}
+
+// Trace updates the mesh with the given gossipsub trace event.
+func (m *GossipMesh) Trace(evt *pb.TraceEvent) {
+	switch evt.GetType() {
+	case pb.TraceEvent_GRAFT:
+		graft := evt.GetGraft()
+		if id, err := peer.IDFromBytes(graft.GetPeerID()); err == nil {
+			m.graft(id, graft.GetTopic())
+		}
+	case pb.TraceEvent_PRUNE:
+		prune := evt.GetPrune()
+		if id, err := peer.IDFromBytes(prune.GetPeerID()); err == nil {
+			m.prune(id, prune.GetTopic())
+		}
+	case pb.TraceEvent_REMOVE_PEER:
+		if id, err := peer.IDFromBytes(evt.GetRemovePeer().GetPeerID()); err == nil {
+			m.remove(id)
+		}
+	case pb.TraceEvent_LEAVE:
+		m.leave(evt.GetLeave().GetTopic())
+	}
+}
+
+func (m *GossipMesh) graft(id peer.ID, topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	peers, ok := m.topics[topic]
+	if !ok {
+		peers = make(map[peer.ID]struct{})
+		m.topics[topic] = peers
+	}
+	peers[id] = struct{}{}
+}
+
+func (m *GossipMesh) prune(id peer.ID, topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if peers, ok := m.topics[topic]; ok {
+		delete(peers, id)
+		if len(peers) == 0 {
+			delete(m.topics, topic)
+		}
+	}
+}
+
+func (m *GossipMesh) remove(id peer.ID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for topic, peers := range m.topics {
+		delete(peers, id)
+		if len(peers) == 0 {
+			delete(m.topics, topic)
+		}
+	}
+}
+
+func (m *GossipMesh) leave(topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.topics, topic)
+}
+
+// Topics returns the sorted topics in which the peer is part of our mesh.
+func (m *GossipMesh) Topics(id peer.ID) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []string
+	for topic, peers := range m.topics {
+		if _, ok := peers[id]; ok {
+			out = append(out, topic)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Peers returns the peers in our mesh of the given topic.
+func (m *GossipMesh) Peers(topic string) []peer.ID {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]peer.ID, 0, len(m.topics[topic]))
+	for id := range m.topics[topic] {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
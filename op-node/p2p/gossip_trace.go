@@ -0,0 +1,240 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// maxQueuedTraceEvents is the number of trace events buffered for the trace file before events are dropped,
+// to never block the gossip router on disk writes.
+const maxQueuedTraceEvents = 4096
+
+// tracedEventTypes are the gossip events that are exported, to debug the propagation of messages:
+// the publishing of a message, and its delivery or rejection, attributed to the peer it was received from.
+var tracedEventTypes = map[pb.TraceEvent_Type]struct{}{
+	pb.TraceEvent_PUBLISH_MESSAGE: {},
+	pb.TraceEvent_DELIVER_MESSAGE: {},
+	pb.TraceEvent_REJECT_MESSAGE:  {},
+}
+
+// GossipTraceConfig configures the export of gossip trace events, in the libp2p pubsub tracing format.
+type GossipTraceConfig struct {
+	// File is the path of the trace file, with one JSON encoded event per line. Empty to disable.
+	File string
+	// MaxFileSize is the size in bytes after which the trace file is rotated.
+	MaxFileSize uint64
+	// MaxFiles is the number of rotated trace files to keep, in addition to the current file.
+	MaxFiles uint
+
+	// Remote is the libp2p trace collector to stream events to. Nil to disable.
+	Remote *peer.AddrInfo
+}
+
+func (c *GossipTraceConfig) Enabled() bool {
+	return c.File != "" || c.Remote != nil
+}
+
+func (c *GossipTraceConfig) Check() error {
+	if c.File != "" && c.MaxFileSize == 0 {
+		return errors.New("gossip trace file requires a non-zero max file size")
+	}
+	return nil
+}
+
+// GossipTraceExporter exports gossip trace events to a rotating trace file and/or a remote trace collector.
+type GossipTraceExporter struct {
+	log log.Logger
+
+	remote *pubsub.RemoteTracer
+
+	file    *rotatingFile
+	events  chan *pb.TraceEvent
+	dropped atomic.Uint64
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewGossipTraceExporter creates an exporter of gossip trace events, or returns nil if tracing is disabled.
+func NewGossipTraceExporter(ctx context.Context, h host.Host, cfg *GossipTraceConfig, log log.Logger) (*GossipTraceExporter, error) {
+	if cfg == nil || !cfg.Enabled() {
+		return nil, nil
+	}
+	e := &GossipTraceExporter{
+		log:     log,
+		closing: make(chan struct{}),
+	}
+	if cfg.File != "" {
+		file, err := openRotatingFile(cfg.File, cfg.MaxFileSize, cfg.MaxFiles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open gossip trace file: %w", err)
+		}
+		e.file = file
+		e.events = make(chan *pb.TraceEvent, maxQueuedTraceEvents)
+		e.wg.Add(1)
+		go e.writeLoop()
+		log.Info("Writing gossip trace events to file", "file", cfg.File, "max_size", cfg.MaxFileSize, "max_files", cfg.MaxFiles)
+	}
+	if cfg.Remote != nil {
+		remote, err := pubsub.NewRemoteTracer(ctx, h, *cfg.Remote)
+		if err != nil {
+			e.Close()
+			return nil, fmt.Errorf("failed to create remote gossip tracer: %w", err)
+		}
+		e.remote = remote
+		log.Info("Streaming gossip trace events to remote collector", "collector", cfg.Remote)
+	}
+	return e, nil
+}
+
+// Trace exports the event, if it is of a traced type. It never blocks: events are dropped if the file writes fall behind.
+func (e *GossipTraceExporter) Trace(evt *pb.TraceEvent) {
+	if _, ok := tracedEventTypes[evt.GetType()]; !ok {
+		return
+	}
+	if e.remote != nil {
+		e.remote.Trace(evt)
+	}
+	if e.file != nil {
+		select {
+		case <-e.closing:
+		case e.events <- evt:
+		default:
+			e.dropped.Add(1)
+		}
+	}
+}
+
+func (e *GossipTraceExporter) writeLoop() {
+	defer e.wg.Done()
+	for {
+		select {
+		case evt := <-e.events:
+			e.write(evt)
+		case <-e.closing:
+			for len(e.events) > 0 {
+				e.write(<-e.events)
+			}
+			return
+		}
+	}
+}
+
+func (e *GossipTraceExporter) write(evt *pb.TraceEvent) {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		e.log.Warn("Failed to encode gossip trace event", "err", err)
+		return
+	}
+	if _, err := e.file.Write(append(data, '\n')); err != nil {
+		e.log.Warn("Failed to write gossip trace event", "err", err)
+	}
+}
+
+// Close flushes the queued events and closes the trace file and the remote collector stream.
+func (e *GossipTraceExporter) Close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.closing)
+		e.wg.Wait()
+		if e.remote != nil {
+			e.remote.Close()
+		}
+		if e.file != nil {
+			err = e.file.Close()
+		}
+		if dropped := e.dropped.Load(); dropped > 0 {
+			e.log.Warn("Dropped gossip trace events, the trace file could not keep up", "dropped", dropped)
+		}
+	})
+	return err
+}
+
+// rotatingFile is a file that is renamed to path.1 when it exceeds the max size,
+// shifting the previously rotated files up to path.<maxFiles>.
+type rotatingFile struct {
+	path     string
+	maxSize  uint64
+	maxFiles uint
+
+	f    *os.File
+	size uint64
+}
+
+func openRotatingFile(path string, maxSize uint64, maxFiles uint) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	r.f = f
+	r.size = uint64(info.Size())
+	return nil
+}
+
+// Write writes p to the file, rotating it first if p would exceed the max size.
+// A write is never split across files.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	if r.size > 0 && r.size+uint64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, fmt.Errorf("failed to rotate %s: %w", r.path, err)
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += uint64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+	if r.maxFiles == 0 {
+		if err := os.Remove(r.path); err != nil {
+			return err
+		}
+		return r.open()
+	}
+	for i := r.maxFiles - 1; i >= 1; i-- {
+		err := os.Rename(r.rotatedPath(i), r.rotatedPath(i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if err := os.Rename(r.path, r.rotatedPath(1)); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *rotatingFile) rotatedPath(i uint) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
+
+func (r *rotatingFile) Close() error {
+	return r.f.Close()
+}
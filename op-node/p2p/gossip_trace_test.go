@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func traceEvent(typ pb.TraceEvent_Type, topic string) *pb.TraceEvent {
+	evt := &pb.TraceEvent{Type: typ.Enum(), PeerID: []byte("local")}
+	switch typ {
+	case pb.TraceEvent_PUBLISH_MESSAGE:
+		evt.PublishMessage = &pb.TraceEvent_PublishMessage{MessageID: []byte("msg"), Topic: &topic}
+	case pb.TraceEvent_DELIVER_MESSAGE:
+		evt.DeliverMessage = &pb.TraceEvent_DeliverMessage{MessageID: []byte("msg"), Topic: &topic, ReceivedFrom: []byte("remote")}
+	case pb.TraceEvent_REJECT_MESSAGE:
+		reason := "validation failed"
+		evt.RejectMessage = &pb.TraceEvent_RejectMessage{MessageID: []byte("msg"), Topic: &topic, ReceivedFrom: []byte("remote"), Reason: &reason}
+	}
+	return evt
+}
+
+func readTraceEvents(t *testing.T, path string) []*pb.TraceEvent {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var events []*pb.TraceEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var evt pb.TraceEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &evt))
+		events = append(events, &evt)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestGossipTraceExporter(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+
+	t.Run("Disabled", func(t *testing.T) {
+		e, err := NewGossipTraceExporter(context.Background(), nil, &GossipTraceConfig{}, logger)
+		require.NoError(t, err)
+		require.Nil(t, e)
+	})
+
+	t.Run("TracedEventsOnly", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "trace.json")
+		e, err := NewGossipTraceExporter(context.Background(), nil, &GossipTraceConfig{File: path, MaxFileSize: 1 << 20}, logger)
+		require.NoError(t, err)
+		e.Trace(traceEvent(pb.TraceEvent_PUBLISH_MESSAGE, "blocks"))
+		e.Trace(traceEvent(pb.TraceEvent_GRAFT, "blocks"))
+		e.Trace(traceEvent(pb.TraceEvent_DELIVER_MESSAGE, "blocks"))
+		e.Trace(traceEvent(pb.TraceEvent_REJECT_MESSAGE, "blocks"))
+		require.NoError(t, e.Close())
+		// Events traced after closing are ignored.
+		e.Trace(traceEvent(pb.TraceEvent_PUBLISH_MESSAGE, "blocks"))
+
+		events := readTraceEvents(t, path)
+		require.Len(t, events, 3)
+		require.Equal(t, pb.TraceEvent_PUBLISH_MESSAGE, events[0].GetType())
+		require.Equal(t, "blocks", events[0].GetPublishMessage().GetTopic())
+		require.Equal(t, pb.TraceEvent_DELIVER_MESSAGE, events[1].GetType())
+		require.Equal(t, []byte("remote"), events[1].GetDeliverMessage().GetReceivedFrom())
+		require.Equal(t, pb.TraceEvent_REJECT_MESSAGE, events[2].GetType())
+		require.Equal(t, "validation failed", events[2].GetRejectMessage().GetReason())
+	})
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	f, err := openRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n", "dddd\n", "eeee\n", "ffffffffffff\n", "gggg\n"} {
+		_, err := f.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	read := func(p string) string {
+		data, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return string(data)
+	}
+	require.Equal(t, "gggg\n", read(path))
+	require.Equal(t, "ffffffffffff\n", read(path+".1"))
+	require.Equal(t, "eeee\n", read(path+".2"))
+	require.NoFileExists(t, path+".3")
+
+	// Reopening appends to the existing file.
+	f, err = openRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("hh\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "gggg\nhh\n", read(path))
+}
@@ -10,6 +10,9 @@ import (
 	//nolint:all
 	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoreds"
 
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+
 	libp2p "github.com/libp2p/go-libp2p"
 	mplex "github.com/libp2p/go-libp2p-mplex"
 	lconf "github.com/libp2p/go-libp2p/config"
@@ -181,7 +184,12 @@ func (conf *Config) Host(log log.Logger, reporter metrics.Reporter, metrics Host
 		// Disable score GC if peer scoring is disabled
 		scoreRetention = 0
 	}
-	ps, err := store.NewExtendedPeerstore(context.Background(), log, clock.SystemClock, basePs, conf.Store, scoreRetention)
+	scoreStore := conf.Store
+	if conf.ScoresInMemory {
+		// Keep scores in memory only, so they start from zero on restart
+		scoreStore = dssync.MutexWrap(ds.NewMapDatastore())
+	}
+	ps, err := store.NewExtendedPeerstore(context.Background(), log, clock.SystemClock, basePs, conf.Store, scoreStore, scoreRetention)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open extended peerstore: %w", err)
 	}
@@ -253,6 +261,10 @@ func (conf *Config) Host(log log.Logger, reporter metrics.Reporter, metrics Host
 	if err != nil {
 		return nil, err
 	}
+	// identify only records the agent version of remote peers, record our own for the local peer info.
+	if err := h.Peerstore().Put(h.ID(), "AgentVersion", conf.UserAgent); err != nil {
+		return nil, fmt.Errorf("failed to record local agent version: %w", err)
+	}
 
 	staticPeers := make([]*peer.AddrInfo, 0, len(conf.StaticPeers))
 	staticPeerIDs := make(map[peer.ID]struct{})
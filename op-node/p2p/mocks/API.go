@@ -13,6 +13,8 @@ import (
 	p2p "github.com/ethereum-optimism/optimism/op-node/p2p"
 
 	peer "github.com/libp2p/go-libp2p/core/peer"
+
+	store "github.com/ethereum-optimism/optimism/op-node/p2p/store"
 )
 
 // API is an autogenerated mock type for the API type
@@ -157,6 +159,49 @@ func (_c *API_BlockSubnet_Call) RunAndReturn(run func(context.Context, *net.IPNe
 	return _c
 }
 
+// ClearPeerScores provides a mock function with given fields: ctx, id
+func (_m *API) ClearPeerScores(ctx context.Context, id peer.ID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, peer.ID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_ClearPeerScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearPeerScores'
+type API_ClearPeerScores_Call struct {
+	*mock.Call
+}
+
+// ClearPeerScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id peer.ID
+func (_e *API_Expecter) ClearPeerScores(ctx interface{}, id interface{}) *API_ClearPeerScores_Call {
+	return &API_ClearPeerScores_Call{Call: _e.mock.On("ClearPeerScores", ctx, id)}
+}
+
+func (_c *API_ClearPeerScores_Call) Run(run func(ctx context.Context, id peer.ID)) *API_ClearPeerScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(peer.ID))
+	})
+	return _c
+}
+
+func (_c *API_ClearPeerScores_Call) Return(_a0 error) *API_ClearPeerScores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_ClearPeerScores_Call) RunAndReturn(run func(context.Context, peer.ID) error) *API_ClearPeerScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConnectPeer provides a mock function with given fields: ctx, addr
 func (_m *API) ConnectPeer(ctx context.Context, addr string) error {
 	ret := _m.Called(ctx, addr)
@@ -665,6 +710,60 @@ func (_c *API_Self_Call) RunAndReturn(run func(context.Context) (*p2p.PeerInfo,
 	return _c
 }
 
+// StoredPeerScores provides a mock function with given fields: ctx
+func (_m *API) StoredPeerScores(ctx context.Context) ([]store.StoredPeerScores, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []store.StoredPeerScores
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]store.StoredPeerScores, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []store.StoredPeerScores); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.StoredPeerScores)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_StoredPeerScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoredPeerScores'
+type API_StoredPeerScores_Call struct {
+	*mock.Call
+}
+
+// StoredPeerScores is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *API_Expecter) StoredPeerScores(ctx interface{}) *API_StoredPeerScores_Call {
+	return &API_StoredPeerScores_Call{Call: _e.mock.On("StoredPeerScores", ctx)}
+}
+
+func (_c *API_StoredPeerScores_Call) Run(run func(ctx context.Context)) *API_StoredPeerScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *API_StoredPeerScores_Call) Return(_a0 []store.StoredPeerScores, _a1 error) *API_StoredPeerScores_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_StoredPeerScores_Call) RunAndReturn(run func(context.Context) ([]store.StoredPeerScores, error)) *API_StoredPeerScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnblockAddr provides a mock function with given fields: ctx, ip
 func (_m *API) UnblockAddr(ctx context.Context, ip net.IP) error {
 	ret := _m.Called(ctx, ip)
@@ -0,0 +1,52 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p/store"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+type PersistedScores interface {
+	AllPeerScores() ([]store.StoredPeerScores, error)
+	GetPeerBanExpiration(id peer.ID) (time.Time, error)
+	SetPeerBanExpiration(id peer.ID, expiry time.Time) error
+}
+
+// RestoreBans bans peers whose persisted score is below minScore, as the PeerMonitor would have done
+// had they still been connected. Gossip scores restart from zero when a peer reconnects, so without this
+// a misbehaving peer could clear its score by waiting for the node to restart.
+// The ban lasts banDuration from when the score was last updated, so restarts never extend a ban.
+func RestoreBans(l log.Logger, clock clock.Clock, scores PersistedScores, minScore float64, banDuration time.Duration) error {
+	stored, err := scores.AllPeerScores()
+	if err != nil {
+		return err
+	}
+	restored := 0
+	for _, s := range stored {
+		if s.Scores.Gossip.Total >= minScore {
+			continue
+		}
+		expiry := s.LastUpdate.Add(banDuration)
+		if !expiry.After(clock.Now()) {
+			continue
+		}
+		existing, err := scores.GetPeerBanExpiration(s.Peer)
+		if err == nil && !existing.Before(expiry) {
+			continue
+		} else if err != nil && !errors.Is(err, store.ErrUnknownBan) {
+			return fmt.Errorf("failed to get ban expiration of peer %v: %w", s.Peer, err)
+		}
+		if err := scores.SetPeerBanExpiration(s.Peer, expiry); err != nil {
+			return fmt.Errorf("failed to ban peer %v: %w", s.Peer, err)
+		}
+		l.Debug("Restored ban of peer with low persisted score", "peer", s.Peer, "score", s.Scores.Gossip.Total, "until", expiry)
+		restored++
+	}
+	l.Info("Restored peer bans from persisted scores", "scores", len(stored), "banned", restored)
+	return nil
+}
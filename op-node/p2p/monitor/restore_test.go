@@ -0,0 +1,69 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p/store"
+	clock2 "github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreBans(t *testing.T) {
+	l := testlog.Logger(t, log.LevelInfo)
+	clock := clock2.NewDeterministicClock(time.Unix(100_000, 0))
+	now := clock.Now()
+	scored := func(id peer.ID, score float64, age time.Duration) store.StoredPeerScores {
+		return store.StoredPeerScores{
+			Peer:       id,
+			LastUpdate: now.Add(-age),
+			Scores:     store.PeerScores{Gossip: store.GossipScores{Total: score}},
+		}
+	}
+	scores := &stubPersistedScores{
+		scores: []store.StoredPeerScores{
+			scored("good", -100, time.Minute),
+			scored("bad", -101, time.Minute),
+			scored("expired", -101, testBanDuration),
+			scored("longer", -101, time.Minute),
+			scored("shorter", -101, time.Minute),
+		},
+		bans: map[peer.ID]time.Time{
+			"longer":  now.Add(testBanDuration),
+			"shorter": now,
+		},
+	}
+
+	require.NoError(t, RestoreBans(l, clock, scores, -100, testBanDuration))
+	expected := map[peer.ID]time.Time{
+		"bad":     now.Add(testBanDuration - time.Minute),
+		"longer":  now.Add(testBanDuration),
+		"shorter": now.Add(testBanDuration - time.Minute),
+	}
+	require.Equal(t, expected, scores.bans)
+}
+
+type stubPersistedScores struct {
+	scores []store.StoredPeerScores
+	bans   map[peer.ID]time.Time
+}
+
+func (s *stubPersistedScores) AllPeerScores() ([]store.StoredPeerScores, error) {
+	return s.scores, nil
+}
+
+func (s *stubPersistedScores) GetPeerBanExpiration(id peer.ID) (time.Time, error) {
+	expiry, ok := s.bans[id]
+	if !ok {
+		return time.Time{}, store.ErrUnknownBan
+	}
+	return expiry, nil
+}
+
+func (s *stubPersistedScores) SetPeerBanExpiration(id peer.ID, expiry time.Time) error {
+	s.bans[id] = expiry
+	return nil
+}
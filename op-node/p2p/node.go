@@ -42,10 +42,13 @@ type NodeP2P struct {
 	appScorer   ApplicationScorer
 	log         log.Logger
 	// the below components are all optional, and may be nil. They require the host to not be nil.
-	dv5Local *enode.LocalNode // p2p discovery identity
-	dv5Udp   *discover.UDPv5  // p2p discovery service
-	gs       *pubsub.PubSub   // p2p gossip router
-	gsOut    GossipOut        // p2p gossip application interface for publishing
+	dv5Local *enode.LocalNode     // p2p discovery identity
+	dv5Udp   *discover.UDPv5      // p2p discovery service
+	gs       *pubsub.PubSub       // p2p gossip router
+	gsOut    GossipOut            // p2p gossip application interface for publishing
+	gsTrace  *GossipTraceExporter // p2p gossip trace export
+	gsMesh   *GossipMesh          // p2p gossip mesh membership
+	txGossip *TxGossip            // p2p gossip of user transactions
 	syncCl   *SyncClient
 	syncSrv  *ReqRespServer
 }
@@ -157,7 +160,12 @@ func (n *NodeP2P) init(
 	// notify of any new connections/streams/etc.
 	n.host.Network().Notify(NewNetworkNotifier(log, metrics))
 	// note: the IDDelta functionality was removed from libP2P, and no longer needs to be explicitly disabled.
-	n.gs, err = NewGossipSub(resourcesCtx, n.host, rollupCfg, setup, n.scorer, metrics, log)
+	n.gsTrace, err = NewGossipTraceExporter(resourcesCtx, n.host, setup.GossipTracing(), log)
+	if err != nil {
+		return fmt.Errorf("failed to start gossip trace export: %w", err)
+	}
+	n.gsMesh = NewGossipMesh()
+	n.gs, err = NewGossipSub(resourcesCtx, n.host, rollupCfg, setup, n.scorer, metrics, n.gsTrace, n.gsMesh, log)
 	if err != nil {
 		return fmt.Errorf("failed to start gossipsub router: %w", err)
 	}
@@ -165,6 +173,14 @@ func (n *NodeP2P) init(
 	if err != nil {
 		return fmt.Errorf("failed to join blocks gossip topic: %w", err)
 	}
+	if txConf := setup.TxGossiping(); txConf != nil {
+		// Nodes that do not process gossiped transactions still validate and relay them
+		txIn, _ := gossipIn.(TxGossipIn)
+		n.txGossip, err = JoinTxGossip(n.host.ID(), n.gs, log, rollupCfg, txConf, txIn)
+		if err != nil {
+			return fmt.Errorf("failed to join txs gossip topic: %w", err)
+		}
+	}
 	log.Info("started p2p host", "addrs", n.host.Addrs(), "peerID", n.host.ID().String())
 
 	tcpPort, err := FindActiveTCPPort(n.host)
@@ -183,6 +199,10 @@ func (n *NodeP2P) init(
 	}
 
 	if setup.BanPeers() {
+		// Peer scores outlive restarts, unless they are kept in memory only or the peerstore itself is in memory.
+		if err := monitor.RestoreBans(log, clock.SystemClock, eps, setup.BanThreshold(), setup.BanDuration()); err != nil {
+			return fmt.Errorf("failed to restore peer bans: %w", err)
+		}
 		n.peerMonitor = monitor.NewPeerMonitor(resourcesCtx, log, clock.SystemClock, n, setup.BanThreshold(), setup.BanDuration())
 		n.peerMonitor.Start()
 	}
@@ -222,6 +242,15 @@ func (n *NodeP2P) GossipOut() GossipOut {
 	return n.gsOut
 }
 
+// TxGossip returns the gossip of user transactions, nil if disabled.
+func (n *NodeP2P) TxGossip() *TxGossip {
+	return n.txGossip
+}
+
+func (n *NodeP2P) GossipMesh() *GossipMesh {
+	return n.gsMesh
+}
+
 func (n *NodeP2P) ConnectionGater() gating.BlockingConnectionGater {
 	return n.gater
 }
@@ -285,6 +314,16 @@ func (n *NodeP2P) Close() error {
 			result = multierror.Append(result, fmt.Errorf("failed to close gossip cleanly: %w", err))
 		}
 	}
+	if n.txGossip != nil {
+		if err := n.txGossip.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close tx gossip cleanly: %w", err))
+		}
+	}
+	if n.gsTrace != nil {
+		if err := n.gsTrace.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close gossip trace export cleanly: %w", err))
+		}
+	}
 	if n.host != nil {
 		if err := n.host.Close(); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close p2p host cleanly: %w", err))
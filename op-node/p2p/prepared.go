@@ -69,6 +69,14 @@ func (p *Prepared) ConfigureGossip(rollupCfg *rollup.Config) []pubsub.Option {
 	}
 }
 
+func (p *Prepared) GossipTracing() *GossipTraceConfig {
+	return nil
+}
+
+func (p *Prepared) TxGossiping() *TxGossipConfig {
+	return nil
+}
+
 func (p *Prepared) PeerScoringParams() *ScoringParams {
 	return nil
 }
@@ -60,4 +60,6 @@ type API interface {
 	UnprotectPeer(ctx context.Context, p peer.ID) error
 	ConnectPeer(ctx context.Context, addr string) error
 	DisconnectPeer(ctx context.Context, id peer.ID) error
+	StoredPeerScores(ctx context.Context) ([]store.StoredPeerScores, error)
+	ClearPeerScores(ctx context.Context, id peer.ID) error
 }
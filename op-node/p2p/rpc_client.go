@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p/store"
 )
 
 var NamespaceRPC = "opp2p"
@@ -107,3 +109,13 @@ func (c *Client) ConnectPeer(ctx context.Context, addr string) error {
 func (c *Client) DisconnectPeer(ctx context.Context, id peer.ID) error {
 	return c.c.CallContext(ctx, nil, prefixRPC("disconnectPeer"), id)
 }
+
+func (c *Client) StoredPeerScores(ctx context.Context) ([]store.StoredPeerScores, error) {
+	var out []store.StoredPeerScores
+	err := c.c.CallContext(ctx, &out, prefixRPC("storedPeerScores"))
+	return out, err
+}
+
+func (c *Client) ClearPeerScores(ctx context.Context, id peer.ID) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("clearPeerScores"), id)
+}
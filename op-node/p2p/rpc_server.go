@@ -37,6 +37,7 @@ var (
 	ErrNoConnectionManager = errors.New("no connection manager")
 	ErrNoConnectionGater   = errors.New("no connection gater")
 	ErrInvalidRequest      = errors.New("invalid request")
+	ErrNoPeerScores        = errors.New("no peer score book")
 )
 
 type Node interface {
@@ -50,6 +51,8 @@ type Node interface {
 	GossipSub() *pubsub.PubSub
 	// GossipOut returns the gossip output/info control
 	GossipOut() GossipOut
+	// GossipMesh returns the tracked gossip mesh membership, may be nil
+	GossipMesh() *GossipMesh
 	// ConnectionGater returns the connection gater, to ban/unban peers with, may be nil
 	ConnectionGater() gating.BlockingConnectionGater
 	// ConnectionManager returns the connection manager, to protect peers with, may be nil
@@ -428,3 +431,37 @@ func (s *APIBackend) DisconnectPeer(_ context.Context, id peer.ID) error {
 	}
 	return nil
 }
+
+func (s *APIBackend) peerScores() (store.ScoreDatastore, error) {
+	eps, ok := s.node.Host().Peerstore().(store.ExtendedPeerstore)
+	if !ok {
+		return nil, ErrNoPeerScores
+	}
+	return eps, nil
+}
+
+// StoredPeerScores returns the scores of all peers in the score book, including disconnected peers.
+func (s *APIBackend) StoredPeerScores(_ context.Context) ([]store.StoredPeerScores, error) {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_storedPeerScores")
+	defer recordDur()
+	scores, err := s.peerScores()
+	if err != nil {
+		return nil, err
+	}
+	return scores.AllPeerScores()
+}
+
+// ClearPeerScores deletes the stored scores of a peer. The peer is not unbanned.
+func (s *APIBackend) ClearPeerScores(_ context.Context, id peer.ID) error {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_clearPeerScores")
+	if err := id.Validate(); err != nil {
+		s.log.Warn("invalid peer ID", "method", "ClearPeerScores", "peer", id, "err", err)
+		return ErrInvalidRequest
+	}
+	defer recordDur()
+	scores, err := s.peerScores()
+	if err != nil {
+		return err
+	}
+	return scores.ClearPeerScores(id)
+}
@@ -83,6 +83,13 @@ type PeerScores struct {
 	ReqResp ReqRespScores `json:"reqResp"`
 }
 
+// StoredPeerScores are the scores of a peer as kept in the score book, with the time they were last updated.
+type StoredPeerScores struct {
+	Peer       peer.ID    `json:"peer"`
+	LastUpdate time.Time  `json:"lastUpdate"`
+	Scores     PeerScores `json:"scores"`
+}
+
 // ScoreDatastore defines a type-safe API for getting and setting libp2p peer score information
 type ScoreDatastore interface {
 	// GetPeerScores returns the current scores for the specified peer
@@ -93,6 +100,12 @@ type ScoreDatastore interface {
 
 	// SetScore applies the given store diff to the specified peer
 	SetScore(id peer.ID, diff ScoreDiff) (PeerScores, error)
+
+	// AllPeerScores returns the stored scores of all peers that have not expired, ordered by peer ID
+	AllPeerScores() ([]StoredPeerScores, error)
+
+	// ClearPeerScores deletes the stored scores of the specified peer
+	ClearPeerScores(id peer.ID) error
 }
 
 // ScoreDiff defines a type-safe batch of changes to apply to the peer-scoring record of the peer.
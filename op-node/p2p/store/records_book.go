@@ -149,6 +149,34 @@ func (d *recordsBook[K, V]) setRecord(key K, diff recordDiff[V]) (V, error) {
 	return rec, nil
 }
 
+// forEach calls fn with the key and value of every stored entry that has not expired.
+// You must read lock the recordsBook before calling this.
+func (d *recordsBook[K, V]) forEach(fn func(key ds.Key, v V) error) error {
+	results, err := d.store.Query(d.ctx, query.Query{
+		Prefix: d.dsBaseKey.String(),
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		v := d.newRecord()
+		if err := v.UnmarshalBinary(result.Value); err != nil {
+			return fmt.Errorf("invalid value for key %v: %w", result.Key, err)
+		}
+		if d.hasExpired(v) {
+			continue
+		}
+		if err := fn(ds.NewKey(result.Key), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // prune deletes entries from the store that are older than the configured prune expiration.
 // Entries that are eligible for deletion may still be present either because the prune function hasn't yet run or
 // because they are still preserved in the in-memory cache after having been deleted from the database.
@@ -2,6 +2,9 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -96,6 +99,37 @@ func (d *scoreBook) SetScore(id peer.ID, diff ScoreDiff) (PeerScores, error) {
 	return v.PeerScores, err
 }
 
+func (d *scoreBook) AllPeerScores() ([]StoredPeerScores, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var result []StoredPeerScores
+	err := d.book.forEach(func(key ds.Key, v *scoreRecord) error {
+		id, err := base32.RawStdEncoding.DecodeString(key.BaseNamespace())
+		if err != nil {
+			return fmt.Errorf("invalid peer ID key %v: %w", key, err)
+		}
+		result = append(result, StoredPeerScores{
+			Peer:       peer.ID(id),
+			LastUpdate: v.LastUpdated(),
+			Scores:     v.PeerScores,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load peer scores: %w", err)
+	}
+	slices.SortFunc(result, func(a, b StoredPeerScores) int {
+		return strings.Compare(string(a.Peer), string(b.Peer))
+	})
+	return result, nil
+}
+
+func (d *scoreBook) ClearPeerScores(id peer.ID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.book.deleteRecord(id)
+}
+
 func (d *scoreBook) Close() {
 	d.book.Close()
 }
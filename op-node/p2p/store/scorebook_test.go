@@ -157,6 +157,39 @@ func TestPersistData(t *testing.T) {
 	assertPeerScores(t, store, id, PeerScores{Gossip: GossipScores{Total: score}})
 }
 
+func TestAllPeerScores(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	logger := testlog.Logger(t, log.LevelInfo)
+	clock := clock.NewDeterministicClock(time.Unix(1000, 0))
+	retentionPeriod := 24 * time.Hour
+	book, err := newScoreBook(ctx, logger, clock, sync.MutexWrap(ds.NewMapDatastore()), retentionPeriod)
+	require.NoError(t, err)
+
+	setScoreRequired(t, book, "expired", &GossipScores{Total: 1})
+	clock.AdvanceTime(retentionPeriod)
+	setScoreRequired(t, book, "bbbb", &GossipScores{Total: -2})
+	setScoreRequired(t, book, "aaaa", IncrementValidResponses{Cap: 10})
+	clock.AdvanceTime(1)
+
+	scores, err := book.AllPeerScores()
+	require.NoError(t, err)
+	require.Equal(t, []StoredPeerScores{
+		{Peer: "aaaa", LastUpdate: time.Unix(1000, 0).Add(retentionPeriod), Scores: PeerScores{ReqResp: ReqRespScores{ValidResponses: 1}}},
+		{Peer: "bbbb", LastUpdate: time.Unix(1000, 0).Add(retentionPeriod), Scores: PeerScores{Gossip: GossipScores{Total: -2}}},
+	}, scores)
+
+	require.NoError(t, book.ClearPeerScores("bbbb"))
+	require.NoError(t, book.ClearPeerScores("unknown"))
+	scores, err = book.AllPeerScores()
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	require.Equal(t, peer.ID("aaaa"), scores[0].Peer)
+	score, err := book.GetPeerScore("bbbb")
+	require.NoError(t, err)
+	require.Zero(t, score)
+}
+
 func TestCloseCompletes(t *testing.T) {
 	store := createMemoryStore(t)
 	require.NoError(t, store.Close())
@@ -291,7 +324,7 @@ func createPeerstoreWithBacking(t *testing.T, store *sync.MutexDatastore) Extend
 	require.NoError(t, err, "Failed to create peerstore")
 	logger := testlog.Logger(t, log.LevelInfo)
 	c := clock.NewDeterministicClock(time.UnixMilli(100))
-	eps, err := NewExtendedPeerstore(context.Background(), logger, c, ps, store, 24*time.Hour)
+	eps, err := NewExtendedPeerstore(context.Background(), logger, c, ps, store, store, 24*time.Hour)
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		_ = eps.Close()
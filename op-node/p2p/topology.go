@@ -0,0 +1,213 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// TopologyPath is the HTTP path the P2P topology is served on, if enabled.
+const TopologyPath = "/p2p/topology"
+
+// AddressPolicy controls which listen addresses of the node and its peers are exposed in the topology.
+type AddressPolicy string
+
+const (
+	// AddressPolicyAll exposes all known addresses, including LAN and loopback addresses.
+	AddressPolicyAll AddressPolicy = "all"
+	// AddressPolicyPublic only exposes publicly routable addresses, and ENRs that advertise a public IP.
+	AddressPolicyPublic AddressPolicy = "public"
+	// AddressPolicyNone does not expose any addresses or ENRs.
+	AddressPolicyNone AddressPolicy = "none"
+)
+
+var AddressPolicies = []AddressPolicy{AddressPolicyAll, AddressPolicyPublic, AddressPolicyNone}
+
+func (p AddressPolicy) Check() error {
+	for _, v := range AddressPolicies {
+		if p == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown address policy: %q", string(p))
+}
+
+// TopologyPeer describes a node in the P2P network.
+type TopologyPeer struct {
+	PeerID          peer.ID  `json:"peerID"`
+	NodeID          enode.ID `json:"nodeID"`
+	AgentVersion    string   `json:"agentVersion"`
+	ProtocolVersion string   `json:"protocolVersion"`
+	ENR             string   `json:"enr,omitempty"`
+	Addresses       []string `json:"addresses,omitempty"`
+	Direction       string   `json:"direction,omitempty"`
+	ChainID         uint64   `json:"chainID"`
+	// Topics the peer is subscribed to.
+	Topics []string `json:"topics"`
+	// MeshTopics are the topics in which the peer is part of our gossip mesh.
+	MeshTopics []string `json:"meshTopics"`
+}
+
+// Topology is a snapshot of the local node and its connected peers,
+// for network-health crawlers to map the rollup P2P network.
+type Topology struct {
+	Time  uint64          `json:"time"`
+	Self  *TopologyPeer   `json:"self"`
+	Peers []*TopologyPeer `json:"peers"`
+}
+
+// TopologyReader builds topology snapshots of a P2P node.
+type TopologyReader struct {
+	node   Node
+	policy AddressPolicy
+	log    log.Logger
+}
+
+func NewTopologyReader(node Node, policy AddressPolicy, log log.Logger) *TopologyReader {
+	return &TopologyReader{node: node, policy: policy, log: log}
+}
+
+func (t *TopologyReader) Topology() (*Topology, error) {
+	h := t.node.Host()
+	nw := h.Network()
+	pstore := h.Peerstore()
+
+	subscriptions := make(map[peer.ID][]string)
+	if gs := t.node.GossipSub(); gs != nil {
+		for _, topic := range gs.GetTopics() {
+			for _, id := range gs.ListPeers(topic) {
+				subscriptions[id] = append(subscriptions[id], topic)
+			}
+		}
+	}
+	mesh := t.node.GossipMesh()
+
+	self, err := dumpPeer(h.ID(), nw, pstore, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe local node: %w", err)
+	}
+	if local := t.node.Dv5Local(); local != nil {
+		self.ENR = local.Node().String()
+	}
+	out := &Topology{
+		Time: uint64(time.Now().Unix()),
+		Self: t.toTopologyPeer(self, nil, nil),
+	}
+	// the local node has no connection direction
+	out.Self.Direction = ""
+	if gs := t.node.GossipSub(); gs != nil {
+		out.Self.Topics = gs.GetTopics()
+		sort.Strings(out.Self.Topics)
+	}
+
+	peers := nw.Peers()
+	sort.Slice(peers, func(i, j int) bool { return peers[i] < peers[j] })
+	out.Peers = make([]*TopologyPeer, 0, len(peers))
+	for _, id := range peers {
+		info, err := dumpPeer(id, nw, pstore, nil)
+		if err != nil {
+			t.log.Debug("Failed to describe peer for topology", "peer", id, "err", err)
+			continue
+		}
+		topics := subscriptions[id]
+		sort.Strings(topics)
+		var meshTopics []string
+		if mesh != nil {
+			meshTopics = mesh.Topics(id)
+		}
+		out.Peers = append(out.Peers, t.toTopologyPeer(info, topics, meshTopics))
+	}
+	return out, nil
+}
+
+func (t *TopologyReader) toTopologyPeer(info *PeerInfo, topics []string, meshTopics []string) *TopologyPeer {
+	if topics == nil {
+		topics = []string{}
+	}
+	if meshTopics == nil {
+		meshTopics = []string{}
+	}
+	return &TopologyPeer{
+		PeerID:          info.PeerID,
+		NodeID:          info.NodeID,
+		AgentVersion:    info.UserAgent,
+		ProtocolVersion: info.ProtocolVersion,
+		ENR:             filterENR(t.policy, info.ENR),
+		Addresses:       filterAddresses(t.policy, info.Addresses),
+		Direction:       info.Direction.String(),
+		ChainID:         info.ChainID,
+		Topics:          topics,
+		MeshTopics:      meshTopics,
+	}
+}
+
+// filterAddresses drops the multi-addresses that may not be exposed under the policy.
+func filterAddresses(policy AddressPolicy, addrs []string) []string {
+	switch policy {
+	case AddressPolicyAll:
+		return addrs
+	case AddressPolicyPublic:
+		var out []string
+		for _, addr := range addrs {
+			m, err := ma.NewMultiaddr(addr)
+			if err != nil {
+				continue
+			}
+			if manet.IsPublicAddr(m) {
+				out = append(out, addr)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// filterENR drops the ENR if it may not be exposed under the policy.
+func filterENR(policy AddressPolicy, enr string) string {
+	switch policy {
+	case AddressPolicyAll:
+		return enr
+	case AddressPolicyPublic:
+		if enr == "" {
+			return ""
+		}
+		n, err := enode.Parse(enode.ValidSchemes, enr)
+		if err != nil {
+			return ""
+		}
+		// ENRs without an IP only expose the identity of the node
+		if ip := n.IP(); ip != nil && (ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified()) {
+			return ""
+		}
+		return enr
+	default:
+		return ""
+	}
+}
+
+// ServeHTTP serves the topology as JSON.
+func (t *TopologyReader) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	topology, err := t.Topology()
+	if err != nil {
+		t.log.Warn("Failed to read P2P topology", "err", err)
+		http.Error(w, "failed to read topology", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(topology); err != nil {
+		t.log.Debug("Failed to write P2P topology", "err", err)
+	}
+}
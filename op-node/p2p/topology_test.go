@@ -0,0 +1,210 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	libp2p "github.com/libp2p/go-libp2p"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestGossipMesh(t *testing.T) {
+	mesh := NewGossipMesh()
+	newPeerID := func() peer.ID {
+		_, pub, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPublicKey(pub)
+		require.NoError(t, err)
+		return id
+	}
+	peerA, peerB := newPeerID(), newPeerID()
+	if peerB < peerA {
+		peerA, peerB = peerB, peerA
+	}
+	topic1 := "topic-1"
+	topic2 := "topic-2"
+	graft := func(id peer.ID, topic string) *pb.TraceEvent {
+		typ := pb.TraceEvent_GRAFT
+		return &pb.TraceEvent{Type: &typ, Graft: &pb.TraceEvent_Graft{PeerID: []byte(id), Topic: &topic}}
+	}
+	prune := func(id peer.ID, topic string) *pb.TraceEvent {
+		typ := pb.TraceEvent_PRUNE
+		return &pb.TraceEvent{Type: &typ, Prune: &pb.TraceEvent_Prune{PeerID: []byte(id), Topic: &topic}}
+	}
+
+	mesh.Trace(graft(peerA, topic1))
+	mesh.Trace(graft(peerA, topic2))
+	mesh.Trace(graft(peerB, topic1))
+	require.Equal(t, []string{topic1, topic2}, mesh.Topics(peerA))
+	require.Equal(t, []peer.ID{peerA, peerB}, mesh.Peers(topic1))
+
+	mesh.Trace(prune(peerA, topic1))
+	require.Equal(t, []string{topic2}, mesh.Topics(peerA))
+	require.Equal(t, []peer.ID{peerB}, mesh.Peers(topic1))
+
+	typ := pb.TraceEvent_REMOVE_PEER
+	mesh.Trace(&pb.TraceEvent{Type: &typ, RemovePeer: &pb.TraceEvent_RemovePeer{PeerID: []byte(peerA)}})
+	require.Empty(t, mesh.Topics(peerA))
+
+	typ = pb.TraceEvent_LEAVE
+	mesh.Trace(&pb.TraceEvent{Type: &typ, Leave: &pb.TraceEvent_Leave{Topic: &topic1}})
+	require.Empty(t, mesh.Topics(peerB))
+	require.Empty(t, mesh.Peers(topic1))
+}
+
+func TestFilterAddresses(t *testing.T) {
+	addrs := []string{
+		"/ip4/127.0.0.1/tcp/9222",
+		"/ip4/10.0.0.4/tcp/9222",
+		"/ip4/1.2.3.4/tcp/9222",
+		"/ip6/::1/tcp/9222",
+	}
+	require.Equal(t, addrs, filterAddresses(AddressPolicyAll, addrs))
+	require.Equal(t, []string{"/ip4/1.2.3.4/tcp/9222"}, filterAddresses(AddressPolicyPublic, addrs))
+	require.Empty(t, filterAddresses(AddressPolicyNone, addrs))
+}
+
+func TestFilterENR(t *testing.T) {
+	makeENR := func(ip net.IP) string {
+		key, err := gcrypto.GenerateKey()
+		require.NoError(t, err)
+		var r enr.Record
+		if ip != nil {
+			r.Set(enr.IP(ip))
+		}
+		require.NoError(t, enode.SignV4(&r, key))
+		n, err := enode.New(enode.ValidSchemes, &r)
+		require.NoError(t, err)
+		return n.String()
+	}
+	public := makeENR(net.IP{1, 2, 3, 4})
+	private := makeENR(net.IP{192, 168, 1, 2})
+	noIP := makeENR(nil)
+
+	require.Equal(t, private, filterENR(AddressPolicyAll, private))
+	require.Equal(t, public, filterENR(AddressPolicyPublic, public))
+	require.Empty(t, filterENR(AddressPolicyPublic, private))
+	require.Equal(t, noIP, filterENR(AddressPolicyPublic, noIP))
+	require.Empty(t, filterENR(AddressPolicyNone, public))
+}
+
+func TestAddressPolicyCheck(t *testing.T) {
+	for _, p := range AddressPolicies {
+		require.NoError(t, p.Check())
+	}
+	require.Error(t, AddressPolicy("").Check())
+	require.Error(t, AddressPolicy("private").Check())
+}
+
+func TestTopology(t *testing.T) {
+	pA, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pB, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+
+	confA := Config{
+		Priv:               (pA).(*crypto.Secp256k1PrivateKey),
+		NoDiscovery:        true,
+		ListenIP:           net.IP{127, 0, 0, 1},
+		HostMux:            []libp2p.Option{YamuxC()},
+		HostSecurity:       []libp2p.Option{NoiseC()},
+		PeersLo:            1,
+		PeersHi:            10,
+		PeersGrace:         time.Second * 10,
+		MeshD:              DefaultMeshD,
+		MeshDLo:            DefaultMeshDlo,
+		MeshDHi:            DefaultMeshDhi,
+		MeshDLazy:          DefaultMeshDlazy,
+		UserAgent:          "optimism-testing",
+		TimeoutNegotiation: time.Second * 2,
+		TimeoutAccept:      time.Second * 2,
+		TimeoutDial:        time.Second * 2,
+		Store:              sync.MutexWrap(ds.NewMapDatastore()),
+	}
+	confB := confA
+	confB.Priv = (pB).(*crypto.Secp256k1PrivateKey)
+	confB.Store = sync.MutexWrap(ds.NewMapDatastore())
+
+	runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: common.Address{0x42}}
+	logA := testlog.Logger(t, log.LevelError).New("host", "A")
+	nodeA, err := NewNodeP2P(context.Background(), &rollup.Config{}, logA, &confA, &mockGossipIn{}, nil, runCfg, metrics.NoopMetrics, false)
+	require.NoError(t, err)
+	defer nodeA.Close()
+	hostA := nodeA.Host()
+
+	confB.StaticPeers, err = peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: hostA.ID(), Addrs: hostA.Addrs()})
+	require.NoError(t, err)
+	logB := testlog.Logger(t, log.LevelError).New("host", "B")
+	nodeB, err := NewNodeP2P(context.Background(), &rollup.Config{}, logB, &confB, &mockGossipIn{}, nil, runCfg, metrics.NoopMetrics, false)
+	require.NoError(t, err)
+	defer nodeB.Close()
+	hostB := nodeB.Host()
+
+	fetch := func(policy AddressPolicy) *Topology {
+		srv := httptest.NewServer(NewTopologyReader(nodeA, policy, logA))
+		defer srv.Close()
+		resp, err := http.Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var topology Topology
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&topology))
+		return &topology
+	}
+
+	// wait for B to be subscribed to all block topics and grafted into the mesh of A
+	topics := nodeA.GossipSub().GetTopics()
+	require.NotEmpty(t, topics)
+	require.Eventually(t, func() bool {
+		topology := fetch(AddressPolicyAll)
+		return len(topology.Peers) == 1 && len(topology.Peers[0].Topics) == len(topics) && len(topology.Peers[0].MeshTopics) > 0
+	}, 30*time.Second, 100*time.Millisecond)
+
+	topology := fetch(AddressPolicyAll)
+	require.Equal(t, hostA.ID(), topology.Self.PeerID)
+	require.Equal(t, "optimism-testing", topology.Self.AgentVersion)
+	require.Len(t, topology.Self.Topics, len(topics))
+	require.Empty(t, topology.Self.Direction)
+	require.NotEmpty(t, topology.Self.Addresses)
+
+	peerB := topology.Peers[0]
+	require.Equal(t, hostB.ID(), peerB.PeerID)
+	require.Equal(t, "optimism-testing", peerB.AgentVersion)
+	require.Equal(t, "Inbound", peerB.Direction)
+	require.NotEmpty(t, peerB.Addresses)
+
+	// loopback addresses are not public
+	topology = fetch(AddressPolicyPublic)
+	require.Empty(t, topology.Self.Addresses)
+	require.Empty(t, topology.Peers[0].Addresses)
+	require.Equal(t, hostB.ID(), topology.Peers[0].PeerID)
+
+	srv := httptest.NewServer(NewTopologyReader(nodeA, AddressPolicyNone, logA))
+	defer srv.Close()
+	resp, err := http.Post(srv.URL, "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
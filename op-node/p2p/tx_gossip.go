@@ -0,0 +1,199 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	lru "github.com/hashicorp/golang-lru/v2"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+const (
+	// maxTxGossipSize is the maximum size of a gossiped transaction, matching the tx pool limit of the execution engine.
+	maxTxGossipSize = 4 * 32 * 1024
+	// txRateLimitPeers is the number of peers of which the transaction rate is tracked.
+	txRateLimitPeers = 1000
+)
+
+func txsTopicV1(cfg *rollup.Config) string {
+	return fmt.Sprintf("/optimism/%s/0/txs", cfg.L2ChainID.String())
+}
+
+// TxGossipConfig configures the optional gossip of user transactions, to forward transactions
+// from follower nodes to the sequencer over the P2P network.
+type TxGossipConfig struct {
+	Enabled bool
+	// RateLimit is the number of transactions per second accepted from a single peer.
+	RateLimit float64
+	// RateBurst is the number of transactions a single peer may send at once.
+	RateBurst int
+}
+
+func (c *TxGossipConfig) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RateLimit <= 0 {
+		return errors.New("tx gossip rate limit must be positive")
+	}
+	if c.RateBurst <= 0 {
+		return errors.New("tx gossip rate burst must be positive")
+	}
+	return nil
+}
+
+// TxGossipIn receives the transactions gossiped by other nodes.
+type TxGossipIn interface {
+	OnGossipTransaction(ctx context.Context, from peer.ID, tx *types.Transaction) error
+}
+
+// peerRateLimiter limits the rate of messages accepted from each peer.
+type peerRateLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters *lru.Cache[peer.ID, *rate.Limiter]
+}
+
+func newPeerRateLimiter(limit rate.Limit, burst int) *peerRateLimiter {
+	limiters, err := lru.New[peer.ID, *rate.Limiter](txRateLimitPeers)
+	if err != nil {
+		panic(fmt.Errorf("failed to set up peer rate limit LRU cache: %w", err))
+	}
+	return &peerRateLimiter{limit: limit, burst: burst, limiters: limiters}
+}
+
+// Allow returns true if a message of the peer is within its rate limit.
+func (r *peerRateLimiter) Allow(id peer.ID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters.Get(id)
+	if !ok {
+		l = rate.NewLimiter(r.limit, r.burst)
+		r.limiters.Add(id, l)
+	}
+	return l.Allow()
+}
+
+// BuildTxValidator builds the validator of gossiped transactions. Transactions are pre-validated,
+// so invalid transactions are never forwarded to the sequencer, and the peers sending them are penalized.
+func BuildTxValidator(log log.Logger, cfg *rollup.Config, self peer.ID, limiter *peerRateLimiter) pubsub.ValidatorEx {
+	signer := types.LatestSignerForChainID(cfg.L2ChainID)
+	return func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		// [IGNORE] if the peer exceeds its rate limit. Transactions published by ourselves are not limited.
+		if id != self && !limiter.Allow(id) {
+			log.Debug("peer exceeded tx gossip rate limit", "peer", id)
+			return pubsub.ValidationIgnore
+		}
+		// [REJECT] if the compression is not valid, or the transaction is too large
+		outLen, err := snappy.DecodedLen(message.Data)
+		if err != nil {
+			log.Warn("invalid snappy compression length data", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		if outLen > maxTxGossipSize {
+			log.Warn("gossiped transaction is too large", "decoded_length", outLen, "peer", id)
+			return pubsub.ValidationReject
+		}
+		data, err := snappy.Decode(nil, message.Data)
+		if err != nil {
+			log.Warn("invalid snappy compression", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+
+		// [REJECT] if the transaction cannot be decoded
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(data); err != nil {
+			log.Warn("invalid gossiped transaction", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		// [REJECT] if the transaction is a deposit, which can only be derived from L1
+		if tx.IsDepositTx() {
+			log.Warn("rejecting gossiped deposit transaction", "tx", tx.Hash(), "peer", id)
+			return pubsub.ValidationReject
+		}
+		// [REJECT] if the transaction is not replay-protected for the L2 chain
+		if !tx.Protected() || tx.ChainId().Cmp(cfg.L2ChainID) != 0 {
+			log.Warn("rejecting gossiped transaction for other chain", "tx", tx.Hash(), "chain_id", tx.ChainId(), "peer", id)
+			return pubsub.ValidationReject
+		}
+		// [REJECT] if the signature of the transaction is not valid
+		if _, err := types.Sender(signer, &tx); err != nil {
+			log.Warn("invalid gossiped transaction signature", "tx", tx.Hash(), "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+
+		message.ValidatorData = &tx
+		return pubsub.ValidationAccept
+	}
+}
+
+func TxHandler(onTx func(ctx context.Context, from peer.ID, tx *types.Transaction) error) MessageHandler {
+	return func(ctx context.Context, from peer.ID, msg any) error {
+		tx, ok := msg.(*types.Transaction)
+		if !ok {
+			return fmt.Errorf("expected topic validator to parse and validate data into transaction, but got %T", msg)
+		}
+		return onTx(ctx, from, tx)
+	}
+}
+
+// TxGossip publishes and receives user transactions on the tx gossip topic.
+type TxGossip struct {
+	p2pCancel context.CancelFunc
+	txs       *blockTopic
+}
+
+// JoinTxGossip joins the tx gossip topic. Received transactions are passed to txIn, if not nil.
+// Without txIn, transactions are only validated and relayed to other peers.
+func JoinTxGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, conf *TxGossipConfig, txIn TxGossipIn) (*TxGossip, error) {
+	p2pCtx, p2pCancel := context.WithCancel(context.Background())
+
+	txLogger := log.New("topic", "txs")
+	limiter := newPeerRateLimiter(rate.Limit(conf.RateLimit), conf.RateBurst)
+	validator := guardGossipValidator(log, logValidationResult(self, "validated tx", txLogger, BuildTxValidator(txLogger, cfg, self, limiter)))
+	handler := func(ctx context.Context, from peer.ID, tx *types.Transaction) error {
+		if txIn == nil {
+			return nil
+		}
+		return txIn.OnGossipTransaction(ctx, from, tx)
+	}
+	txs, err := newGossipTopic(p2pCtx, txsTopicV1(cfg), ps, txLogger, TxHandler(handler), validator)
+	if err != nil {
+		p2pCancel()
+		return nil, fmt.Errorf("failed to setup txs p2p: %w", err)
+	}
+	return &TxGossip{
+		p2pCancel: p2pCancel,
+		txs:       txs,
+	}, nil
+}
+
+// PublishTransaction gossips the transaction to the network.
+func (g *TxGossip) PublishTransaction(ctx context.Context, tx *types.Transaction) error {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction to publish: %w", err)
+	}
+	return g.txs.topic.Publish(ctx, snappy.Encode(nil, data))
+}
+
+// Peers returns the peers subscribed to the tx gossip topic.
+func (g *TxGossip) Peers() []peer.ID {
+	return g.txs.topic.ListPeers()
+}
+
+func (g *TxGossip) Close() error {
+	g.p2pCancel()
+	return g.txs.Close()
+}
@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestTxValidator(t *testing.T) {
+	cfg := &rollup.Config{L2ChainID: big.NewInt(100)}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signTx := func(chainID *big.Int) []byte {
+		tx := types.MustSignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21000,
+			To:        &common.Address{0x01},
+			Value:     big.NewInt(1),
+		})
+		data, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		return snappy.Encode(nil, data)
+	}
+	deposit, err := types.NewTx(&types.DepositTx{To: &common.Address{0x01}, Gas: 21000}).MarshalBinary()
+	require.NoError(t, err)
+
+	self := peer.ID("self")
+	newValidator := func(burst int) pubsub.ValidatorEx {
+		return BuildTxValidator(testlog.Logger(t, log.LevelCrit), cfg, self, newPeerRateLimiter(rate.Limit(1), burst))
+	}
+	validate := func(v pubsub.ValidatorEx, from peer.ID, data []byte) pubsub.ValidationResult {
+		return v(context.Background(), from, &pubsub.Message{Message: &pubsub_pb.Message{Data: data}})
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		v := newValidator(10)
+		message := &pubsub.Message{Message: &pubsub_pb.Message{Data: signTx(cfg.L2ChainID)}}
+		require.Equal(t, pubsub.ValidationAccept, v(context.Background(), "foo", message))
+		require.IsType(t, &types.Transaction{}, message.ValidatorData)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		v := newValidator(10)
+		require.Equal(t, pubsub.ValidationReject, validate(v, "foo", signTx(big.NewInt(101))))
+		require.Equal(t, pubsub.ValidationReject, validate(v, "foo", snappy.Encode(nil, deposit)))
+		require.Equal(t, pubsub.ValidationReject, validate(v, "foo", snappy.Encode(nil, []byte{0x02, 0x03})))
+		require.Equal(t, pubsub.ValidationReject, validate(v, "foo", []byte{0xff, 0xff, 0xff, 0xff}))
+		require.Equal(t, pubsub.ValidationReject, validate(v, "foo", snappy.Encode(nil, make([]byte, maxTxGossipSize+1))))
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		v := newValidator(2)
+		data := signTx(cfg.L2ChainID)
+		require.Equal(t, pubsub.ValidationAccept, validate(v, "foo", data))
+		require.Equal(t, pubsub.ValidationAccept, validate(v, "foo", data))
+		require.Equal(t, pubsub.ValidationIgnore, validate(v, "foo", data))
+		// Other peers have their own limit
+		require.Equal(t, pubsub.ValidationAccept, validate(v, "bar", data))
+		// Our own transactions are not limited
+		for i := 0; i < 5; i++ {
+			require.Equal(t, pubsub.ValidationAccept, validate(v, self, data))
+		}
+	})
+}
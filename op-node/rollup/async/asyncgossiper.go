@@ -15,6 +15,7 @@ type AsyncGossiper interface {
 	Clear()
 	Stop()
 	Start()
+	PublishFailures() uint64
 }
 
 // SimpleAsyncGossiper is a component that stores and gossips a single payload at a time
@@ -23,6 +24,8 @@ type AsyncGossiper interface {
 // exposed functions are synchronous, and block until the async routine is able to start handling the request
 type SimpleAsyncGossiper struct {
 	running atomic.Bool
+	// number of payloads that failed to be published
+	publishFailures atomic.Uint64
 	// channel to add new payloads to gossip
 	set chan *eth.ExecutionPayloadEnvelope
 	// channel to request getting the currently gossiping payload
@@ -140,9 +143,15 @@ func (p *SimpleAsyncGossiper) gossip(ctx context.Context, payload *eth.Execution
 			"hash", payload.ExecutionPayload.BlockHash,
 			"err", err)
 		p.metrics.RecordPublishingError()
+		p.publishFailures.Add(1)
 	}
 }
 
+// PublishFailures returns the number of payloads that failed to be published
+func (p *SimpleAsyncGossiper) PublishFailures() uint64 {
+	return p.publishFailures.Load()
+}
+
 // getPayload is the internal handler function for getting the current payload
 // c is the channel the caller expects to receive the payload on
 func (p *SimpleAsyncGossiper) getPayload(c chan *eth.ExecutionPayloadEnvelope) {
@@ -163,3 +172,4 @@ func (NoOpGossiper) Get() *eth.ExecutionPayloadEnvelope           { return nil }
 func (NoOpGossiper) Clear()                                       {}
 func (NoOpGossiper) Stop()                                        {}
 func (NoOpGossiper) Start()                                       {}
+func (NoOpGossiper) PublishFailures() uint64                      { return 0 }
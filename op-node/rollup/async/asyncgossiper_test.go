@@ -140,6 +140,7 @@ func TestAsyncGossiperFailToPublish(t *testing.T) {
 	require.Never(t, func() bool {
 		return p.Get() == envelope
 	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(1), p.PublishFailures())
 	// Stop the AsyncGossiper
 	p.Stop()
 	// Test that the AsyncGossiper stops within a short duration
@@ -1,8 +1,12 @@
 package rollup
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -54,6 +58,54 @@ var nextFork = map[ForkName]ForkName{
 	Interop:  None,
 }
 
+// ScheduledFork is a network upgrade that is activated at a timestamp configured in the rollup config.
+type ScheduledFork struct {
+	Name ForkName
+	// Time returns the activation time field of the fork in the rollup config.
+	Time func(cfg *Config) **uint64
+}
+
+// ScheduledForks are the network upgrades that are activated by timestamp, in activation order.
+// New forks must be registered here, to check their ordering and to support overriding their activation time.
+var ScheduledForks = []ScheduledFork{
+	{Regolith, func(cfg *Config) **uint64 { return &cfg.RegolithTime }},
+	{Canyon, func(cfg *Config) **uint64 { return &cfg.CanyonTime }},
+	{Delta, func(cfg *Config) **uint64 { return &cfg.DeltaTime }},
+	{Ecotone, func(cfg *Config) **uint64 { return &cfg.EcotoneTime }},
+	{Fjord, func(cfg *Config) **uint64 { return &cfg.FjordTime }},
+	{Granite, func(cfg *Config) **uint64 { return &cfg.GraniteTime }},
+	{Holocene, func(cfg *Config) **uint64 { return &cfg.HoloceneTime }},
+	{Interop, func(cfg *Config) **uint64 { return &cfg.InteropTime }},
+}
+
+// IsForkActive returns true if the named fork is active at the given timestamp.
+// Bedrock is active from genesis, and forks that are not scheduled are never active.
+func (cfg *Config) IsForkActive(name ForkName, timestamp uint64) bool {
+	if name == Bedrock {
+		return true
+	}
+	for _, fork := range ScheduledForks {
+		if fork.Name == name {
+			activation := *fork.Time(cfg)
+			return activation != nil && timestamp >= *activation
+		}
+	}
+	return false
+}
+
+// checkPredeployForks checks that every registered predeploy was introduced by Bedrock or a scheduled fork,
+// so the activation of every predeploy can be resolved.
+func checkPredeployForks() error {
+	for _, p := range predeploys.All() {
+		name := ForkName(p.Fork)
+		if name == Bedrock || slices.ContainsFunc(ScheduledForks, func(fork ScheduledFork) bool { return fork.Name == name }) {
+			continue
+		}
+		return fmt.Errorf("predeploy %s is introduced by unknown fork %q", p.Name, p.Fork)
+	}
+	return nil
+}
+
 type ChainSpec struct {
 	config      *Config
 	currentFork ForkName
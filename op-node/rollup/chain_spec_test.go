@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -203,3 +204,45 @@ func TestCheckForkActivation(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledForks(t *testing.T) {
+	cfg := &Config{}
+	for i, fork := range ScheduledForks {
+		if i > 0 {
+			require.Equal(t, fork.Name, nextFork[ScheduledForks[i-1].Name], "scheduled forks must be in activation order")
+		}
+		activation := uint64(i)
+		*fork.Time(cfg) = &activation
+	}
+	require.NoError(t, cfg.CheckForkOrder())
+	require.True(t, cfg.IsRegolith(0))
+	require.False(t, cfg.IsCanyon(0))
+	require.True(t, cfg.IsInterop(uint64(len(ScheduledForks)-1)))
+	require.False(t, cfg.IsInterop(uint64(len(ScheduledForks)-2)))
+	require.Equal(t, Interop, ScheduledForks[len(ScheduledForks)-1].Name, "all timestamp-activated forks must be scheduled")
+
+	// Overriding a fork to activate before its prior fork is invalid.
+	early := uint64(0)
+	cfg.HoloceneTime = &early
+	require.ErrorContains(t, cfg.CheckForkOrder(), "fork holocene set to 0, but prior fork granite has higher offset")
+}
+
+func TestIsForkActive(t *testing.T) {
+	canyonTime := uint64(10)
+	cfg := &Config{RegolithTime: new(uint64), CanyonTime: &canyonTime}
+	require.True(t, cfg.IsForkActive(Bedrock, 0))
+	require.True(t, cfg.IsForkActive(Regolith, 0))
+	require.False(t, cfg.IsForkActive(Canyon, canyonTime-1))
+	require.True(t, cfg.IsForkActive(Canyon, canyonTime))
+	require.False(t, cfg.IsForkActive(Delta, canyonTime))
+	require.False(t, cfg.IsForkActive("unknown", canyonTime))
+}
+
+func TestCheckPredeployForks(t *testing.T) {
+	require.NoError(t, checkPredeployForks())
+
+	addr := common.Address{0xaa}
+	predeploys.Predeploys["Unknown"] = &predeploys.Predeploy{Name: "Unknown", Address: addr, Fork: "unknown"}
+	t.Cleanup(func() { delete(predeploys.Predeploys, "Unknown") })
+	require.ErrorContains(t, checkPredeployForks(), `predeploy Unknown is introduced by unknown fork "unknown"`)
+}
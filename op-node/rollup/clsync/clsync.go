@@ -2,6 +2,7 @@ package clsync
 
 import (
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/status"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -17,6 +19,7 @@ const maxUnsafePayloadsMemory = 500 * 1024 * 1024
 
 type Metrics interface {
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
+	RecordUnsafePayloadsQuarantine(length uint64)
 }
 
 // CLSync holds on to a queue of received unsafe payloads,
@@ -31,14 +34,25 @@ type CLSync struct {
 	mu sync.Mutex
 
 	unsafePayloads *PayloadsQueue // queue of unsafe payloads, ordered by ascending block number, may have gaps and duplicates
+
+	// quarantineTTL is how long unsafe payloads referencing an L1 origin ahead of l1Head are held,
+	// before they are dropped. Quarantine is disabled if 0.
+	quarantineTTL time.Duration
+	quarantine    []quarantinedPayload
+	l1Head        eth.L1BlockRef
+	// now returns the current time, time.Now if nil.
+	now func() time.Time
 }
 
-func NewCLSync(log log.Logger, cfg *rollup.Config, metrics Metrics) *CLSync {
+// NewCLSync creates a CLSync, quarantining unsafe payloads that reference an L1 origin ahead of the L1 head
+// for up to quarantineTTL, or processing them right away if quarantineTTL is 0.
+func NewCLSync(log log.Logger, cfg *rollup.Config, quarantineTTL time.Duration, metrics Metrics) *CLSync {
 	return &CLSync{
 		log:            log,
 		cfg:            cfg,
 		metrics:        metrics,
 		unsafePayloads: NewPayloadsQueue(log, maxUnsafePayloadsMemory, payloadMemSize),
+		quarantineTTL:  quarantineTTL,
 	}
 }
 
@@ -79,6 +93,8 @@ func (eq *CLSync) OnEvent(ev event.Event) bool {
 		eq.onForkchoiceUpdate(x)
 	case ReceivedUnsafePayloadEvent:
 		eq.onUnsafePayload(x)
+	case status.L1UnsafeEvent:
+		eq.onL1Unsafe(x)
 	default:
 		return false
 	}
@@ -172,14 +188,25 @@ func (eq *CLSync) onUnsafePayload(x ReceivedUnsafePayloadEvent) {
 		return
 	}
 
+	if eq.quarantinePayload(envelope) {
+		return
+	}
+	if !eq.pushPayload(envelope) {
+		return
+	}
+
+	// request forkchoice signal, so we can process the payload maybe
+	eq.emitter.Emit(engine.ForkchoiceRequestEvent{})
+}
+
+// pushPayload adds the payload to the queue of payloads to process, and returns whether it was added.
+func (eq *CLSync) pushPayload(envelope *eth.ExecutionPayloadEnvelope) bool {
 	if err := eq.unsafePayloads.Push(envelope); err != nil {
 		eq.log.Warn("Could not add unsafe payload", "id", envelope.ExecutionPayload.ID(), "timestamp", uint64(envelope.ExecutionPayload.Timestamp), "err", err)
-		return
+		return false
 	}
 	p := eq.unsafePayloads.Peek()
 	eq.metrics.RecordUnsafePayloadsBuffer(uint64(eq.unsafePayloads.Len()), eq.unsafePayloads.MemSize(), p.ExecutionPayload.ID())
 	eq.log.Trace("Next unsafe payload to process", "next", p.ExecutionPayload.ID(), "timestamp", uint64(p.ExecutionPayload.Timestamp))
-
-	// request forkchoice signal, so we can process the payload maybe
-	eq.emitter.Emit(engine.ForkchoiceRequestEvent{})
+	return true
 }
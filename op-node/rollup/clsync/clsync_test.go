@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"math/rand" // nosemgrep
 	"testing"
+	"time"
 
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/status"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
@@ -128,7 +130,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -150,7 +152,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -173,7 +175,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -194,7 +196,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -215,7 +217,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 		emitter.AssertExpectations(t) // nothing to process yet
 
@@ -274,7 +276,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -319,7 +321,7 @@ func TestCLSync(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
@@ -360,7 +362,7 @@ func TestCLSync(t *testing.T) {
 	t.Run("invalid payload error", func(t *testing.T) {
 		logger := testlog.Logger(t, log.LevelError)
 		emitter := &testutils.MockEmitter{}
-		cl := NewCLSync(logger, cfg, metrics)
+		cl := NewCLSync(logger, cfg, 0, metrics)
 		cl.AttachEmitter(emitter)
 
 		// CLSync gets payload and requests engine state, to later determine if payload should be forwarded
@@ -382,4 +384,59 @@ func TestCLSync(t *testing.T) {
 		emitter.AssertExpectations(t)
 		require.Nil(t, cl.unsafePayloads.Peek(), "pop because invalid")
 	})
+
+	l1BeforeA := eth.L1BlockRef{Hash: refA.ParentHash, Number: refA.Number - 1}
+
+	t.Run("quarantine unknown L1 origin", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LevelError)
+		emitter := &testutils.MockEmitter{}
+		cl := NewCLSync(logger, cfg, time.Minute, metrics)
+		cl.AttachEmitter(emitter)
+
+		cl.OnEvent(status.L1UnsafeEvent{L1Unsafe: l1BeforeA})
+		// The L1 origin of the payload is ahead of the L1 head, so it is not processed yet
+		cl.OnEvent(ReceivedUnsafePayloadEvent{Envelope: payloadA1})
+		emitter.AssertExpectations(t)
+		require.Nil(t, cl.unsafePayloads.Peek())
+		require.Len(t, cl.quarantine, 1)
+
+		// Once L1 catches up, the payload is released and processed
+		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
+		cl.OnEvent(status.L1UnsafeEvent{L1Unsafe: refA})
+		emitter.AssertExpectations(t)
+		require.Empty(t, cl.quarantine)
+		require.Equal(t, payloadA1, cl.unsafePayloads.Peek())
+	})
+
+	t.Run("drop expired quarantine", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LevelError)
+		emitter := &testutils.MockEmitter{}
+		cl := NewCLSync(logger, cfg, time.Minute, metrics)
+		cl.AttachEmitter(emitter)
+		now := time.Unix(1000, 0)
+		cl.now = func() time.Time { return now }
+
+		cl.OnEvent(status.L1UnsafeEvent{L1Unsafe: l1BeforeA})
+		cl.OnEvent(ReceivedUnsafePayloadEvent{Envelope: payloadA1})
+		require.Len(t, cl.quarantine, 1)
+
+		now = now.Add(time.Minute + time.Second)
+		cl.OnEvent(status.L1UnsafeEvent{L1Unsafe: refA})
+		emitter.AssertExpectations(t) // no forkchoice request, nothing to process
+		require.Empty(t, cl.quarantine)
+		require.Nil(t, cl.unsafePayloads.Peek(), "dropped because expired")
+	})
+
+	t.Run("no quarantine without L1 head", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LevelError)
+		emitter := &testutils.MockEmitter{}
+		cl := NewCLSync(logger, cfg, time.Minute, metrics)
+		cl.AttachEmitter(emitter)
+
+		emitter.ExpectOnce(engine.ForkchoiceRequestEvent{})
+		cl.OnEvent(ReceivedUnsafePayloadEvent{Envelope: payloadA1})
+		emitter.AssertExpectations(t)
+		require.Empty(t, cl.quarantine)
+		require.Equal(t, payloadA1, cl.unsafePayloads.Peek())
+	})
 }
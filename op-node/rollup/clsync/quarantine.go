@@ -0,0 +1,93 @@
+package clsync
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/status"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxQuarantinedPayloads bounds the number of quarantined unsafe payloads.
+// The oldest payload is dropped to make room for a new one.
+const maxQuarantinedPayloads = 256
+
+// quarantinedPayload is an unsafe payload referencing an L1 origin ahead of the L1 head,
+// held until the L1 head catches up.
+type quarantinedPayload struct {
+	envelope   *eth.ExecutionPayloadEnvelope
+	ref        eth.L2BlockRef
+	receivedAt time.Time
+}
+
+func (eq *CLSync) time() time.Time {
+	if eq.now == nil {
+		return time.Now()
+	}
+	return eq.now()
+}
+
+// quarantinePayload quarantines the payload if it references an L1 origin ahead of the L1 head,
+// and returns whether it did so. Payloads are never quarantined before the L1 head is known.
+func (eq *CLSync) quarantinePayload(envelope *eth.ExecutionPayloadEnvelope) bool {
+	if eq.quarantineTTL == 0 || eq.l1Head == (eth.L1BlockRef{}) {
+		return false
+	}
+	ref, err := derive.PayloadToBlockRef(eq.cfg, envelope.ExecutionPayload)
+	if err != nil {
+		// Invalid payloads are left to the engine to reject.
+		return false
+	}
+	if ref.L1Origin.Number <= eq.l1Head.Number {
+		return false
+	}
+	eq.expireQuarantine()
+	if len(eq.quarantine) >= maxQuarantinedPayloads {
+		eq.log.Warn("Quarantine full, dropping oldest unsafe payload", "dropped", eq.quarantine[0].ref)
+		eq.quarantine = eq.quarantine[1:]
+	}
+	eq.log.Info("Quarantining unsafe payload until L1 origin is known",
+		"payload", ref, "l1_origin", ref.L1Origin, "l1_head", eq.l1Head.ID())
+	eq.quarantine = append(eq.quarantine, quarantinedPayload{envelope: envelope, ref: ref, receivedAt: eq.time()})
+	eq.metrics.RecordUnsafePayloadsQuarantine(uint64(len(eq.quarantine)))
+	return true
+}
+
+// onL1Unsafe re-evaluates the quarantined payloads against the new L1 head,
+// and releases the payloads with a known L1 origin to be processed.
+func (eq *CLSync) onL1Unsafe(x status.L1UnsafeEvent) {
+	eq.l1Head = x.L1Unsafe
+	if len(eq.quarantine) == 0 {
+		return
+	}
+	eq.expireQuarantine()
+	released := false
+	remaining := eq.quarantine[:0]
+	for _, q := range eq.quarantine {
+		if q.ref.L1Origin.Number > eq.l1Head.Number {
+			remaining = append(remaining, q)
+			continue
+		}
+		eq.log.Info("Releasing unsafe payload from quarantine", "payload", q.ref, "l1_head", eq.l1Head.ID())
+		if eq.pushPayload(q.envelope) {
+			released = true
+		}
+	}
+	eq.quarantine = remaining
+	eq.metrics.RecordUnsafePayloadsQuarantine(uint64(len(eq.quarantine)))
+	if released {
+		eq.emitter.Emit(engine.ForkchoiceRequestEvent{})
+	}
+}
+
+// expireQuarantine drops the payloads that were quarantined for longer than the TTL.
+func (eq *CLSync) expireQuarantine() {
+	cutoff := eq.time().Add(-eq.quarantineTTL)
+	for len(eq.quarantine) > 0 && eq.quarantine[0].receivedAt.Before(cutoff) {
+		eq.log.Warn("Dropping unsafe payload, L1 origin is still unknown after quarantine",
+			"payload", eq.quarantine[0].ref, "l1_origin", eq.quarantine[0].ref.L1Origin, "l1_head", eq.l1Head.ID())
+		eq.quarantine = eq.quarantine[1:]
+	}
+	eq.metrics.RecordUnsafePayloadsQuarantine(uint64(len(eq.quarantine)))
+}
@@ -7,6 +7,7 @@ import (
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -32,6 +33,15 @@ func NewAltDADataSource(log log.Logger, src DataIter, l1 L1Fetcher, fetcher AltD
 	}
 }
 
+// TxHash returns the hash of the transaction of the commitment of the data last returned by Next,
+// or of the data itself if it was not an altDA commitment.
+func (s *AltDADataSource) TxHash() common.Hash {
+	if iter, ok := s.src.(txDataIter); ok {
+		return iter.TxHash()
+	}
+	return common.Hash{}
+}
+
 func (s *AltDADataSource) Next(ctx context.Context) (eth.Data, error) {
 	// Process origin syncs the challenge contract events and updates the local challenge states
 	// before we can proceed to fetch the input data. This function can be called multiple times
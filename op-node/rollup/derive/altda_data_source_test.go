@@ -101,7 +101,7 @@ func TestAltDADataSource(t *testing.T) {
 
 	signer := cfg.L1Signer()
 
-	factory := NewDataSourceFactory(logger, cfg, l1F, nil, da)
+	factory := NewL1DataSourceFactory(logger, cfg, l1F, nil, da)
 
 	nc := 0
 	firstChallengeExpirationBlock := uint64(95)
@@ -150,9 +150,9 @@ func TestAltDADataSource(t *testing.T) {
 
 		}
 		logger.Info("included commitments", "count", c)
-		l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+		l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), txs, nil)
 		// called once per derivation
-		l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+		l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), txs, nil)
 
 		if ref.Number == 2 {
 			l1F.ExpectL1BlockRefByNumber(ref.Number, ref, nil)
@@ -252,7 +252,7 @@ func TestAltDADataSource(t *testing.T) {
 
 			}
 			logger.Info("included commitments", "count", c)
-			l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+			l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), txs, nil)
 		}
 
 		// create a new data source for each block
@@ -340,7 +340,7 @@ func TestAltDADataSourceStall(t *testing.T) {
 
 	signer := cfg.L1Signer()
 
-	factory := NewDataSourceFactory(logger, cfg, l1F, nil, da)
+	factory := NewL1DataSourceFactory(logger, cfg, l1F, nil, da)
 
 	parent := l1Refs[0]
 	// create a new mock l1 ref
@@ -369,7 +369,7 @@ func TestAltDADataSourceStall(t *testing.T) {
 
 	txs := []*types.Transaction{tx}
 
-	l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+	l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), txs, nil)
 
 	// delete the input from the DA provider so it returns not found
 	require.NoError(t, storage.DeleteData(comm.Encode()))
@@ -462,7 +462,7 @@ func TestAltDADataSourceInvalidData(t *testing.T) {
 
 	signer := cfg.L1Signer()
 
-	factory := NewDataSourceFactory(logger, cfg, l1F, nil, da)
+	factory := NewL1DataSourceFactory(logger, cfg, l1F, nil, da)
 
 	parent := l1Refs[0]
 	// create a new mock l1 ref
@@ -520,7 +520,7 @@ func TestAltDADataSourceInvalidData(t *testing.T) {
 
 	txs := []*types.Transaction{tx1, tx2, tx3}
 
-	l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+	l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), txs, nil)
 
 	src, err := factory.OpenData(ctx, ref, batcherAddr)
 	require.NoError(t, err)
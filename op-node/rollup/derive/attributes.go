@@ -28,6 +28,8 @@ type FetchingAttributesBuilder struct {
 	rollupCfg *rollup.Config
 	l1        L1ReceiptsFetcher
 	l2        SystemConfigL2Fetcher
+
+	receipts *receiptTracker
 }
 
 func NewFetchingAttributesBuilder(rollupCfg *rollup.Config, l1 L1ReceiptsFetcher, l2 SystemConfigL2Fetcher) *FetchingAttributesBuilder {
@@ -77,6 +79,8 @@ func (ba *FetchingAttributesBuilder) PreparePayloadAttributes(ctx context.Contex
 			return nil, NewCriticalError(fmt.Errorf("failed to apply derived L1 sysCfg updates: %w", err))
 		}
 
+		ba.receipts.onDeposits(l2Parent, receipts, ba.rollupCfg.DepositContractAddress)
+
 		l1Info = info
 		depositTxs = deposits
 		seqNumber = 0
@@ -130,6 +134,11 @@ func (ba *FetchingAttributesBuilder) PreparePayloadAttributes(ctx context.Contex
 		afterForceIncludeTxs = append(afterForceIncludeTxs, depositsCompleteTx)
 	}
 
+	systemTxs := append([]hexutil.Bytes{l1InfoTx}, afterForceIncludeTxs...)
+	if err := checkSystemDeposits(ba.rollupCfg, nextL2Time, append(systemTxs, upgradeTxs...)); err != nil {
+		return nil, NewCriticalError(fmt.Errorf("invalid system deposits: %w", err))
+	}
+
 	txs := make([]hexutil.Bytes, 0, 1+len(depositTxs)+len(afterForceIncludeTxs)+len(upgradeTxs))
 	txs = append(txs, l1InfoTx)
 	txs = append(txs, depositTxs...)
@@ -60,6 +60,11 @@ type BatchQueue struct {
 	nextSpan []*SingularBatch
 
 	l2 SafeBlockFetcher
+
+	receipts *receiptTracker
+
+	// lastErr is the last dropped batch, kept for introspection of the pipeline
+	lastErr error
 }
 
 // NewBatchQueue creates a BatchQueue, which should be Reset(origin) before use.
@@ -105,6 +110,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, parent eth.L2BlockRef) (*Si
 			// Given parent block does not match the next batch. It means the previously returned batch is invalid.
 			// Drop cached batches and find another batch.
 			bq.log.Warn("parent block does not match the next batch. dropped cached batches", "parent", parent.ID(), "nextBatchTime", bq.nextSpan[0].GetTimestamp())
+			bq.lastErr = fmt.Errorf("dropped cached span batch at time %d, parent %s does not match", bq.nextSpan[0].GetTimestamp(), parent.ID())
 			bq.nextSpan = bq.nextSpan[:0]
 		}
 	}
@@ -167,7 +173,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, parent eth.L2BlockRef) (*Si
 	}
 
 	// Finally attempt to derive more batches
-	batch, err := bq.deriveNextBatch(ctx, outOfData, parent)
+	next, err := bq.deriveNextBatch(ctx, outOfData, parent)
 	if err == io.EOF && outOfData {
 		return nil, false, io.EOF
 	} else if err == io.EOF {
@@ -176,6 +182,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, parent eth.L2BlockRef) (*Si
 		return nil, false, err
 	}
 
+	batch := next.Batch
 	var nextBatch *SingularBatch
 	switch typ := batch.GetBatchType(); typ {
 	case SingularBatchType:
@@ -184,6 +191,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, parent eth.L2BlockRef) (*Si
 			return nil, false, NewCriticalError(errors.New("failed type assertion to SingularBatch"))
 		}
 		nextBatch = singularBatch
+		bq.receipts.onBatch(parent, next.channel, 1)
 	case SpanBatchType:
 		spanBatch, ok := batch.AsSpanBatch()
 		if !ok {
@@ -195,6 +203,7 @@ func (bq *BatchQueue) NextBatch(ctx context.Context, parent eth.L2BlockRef) (*Si
 			return nil, false, NewCriticalError(err)
 		}
 		bq.nextSpan = singularBatches
+		bq.receipts.onBatch(parent, next.channel, len(singularBatches))
 		// span-batches are non-empty, so the below pop is safe.
 		nextBatch = bq.popNextBatch(parent)
 	default:
@@ -217,6 +226,7 @@ func (bq *BatchQueue) Reset(ctx context.Context, base eth.L1BlockRef, _ eth.Syst
 	bq.l1Blocks = bq.l1Blocks[:0]
 	bq.l1Blocks = append(bq.l1Blocks, base)
 	bq.nextSpan = bq.nextSpan[:0]
+	bq.lastErr = nil
 	return io.EOF
 }
 
@@ -227,10 +237,13 @@ func (bq *BatchQueue) AddBatch(ctx context.Context, batch Batch, parent eth.L2Bl
 	data := BatchWithL1InclusionBlock{
 		L1InclusionBlock: bq.origin,
 		Batch:            batch,
+		channel:          bq.receipts.currentChannel(),
 	}
 	validity := CheckBatch(ctx, bq.config, bq.log, bq.l1Blocks, parent, &data, bq.l2)
 	if validity == BatchDrop {
-		return // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		// if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		bq.lastErr = fmt.Errorf("dropped batch at time %d included in L1 block %s", batch.GetTimestamp(), bq.origin)
+		return
 	}
 	batch.LogContext(bq.log).Debug("Adding batch")
 	bq.batches = append(bq.batches, &data)
@@ -240,7 +253,7 @@ func (bq *BatchQueue) AddBatch(ctx context.Context, batch Batch, parent eth.L2Bl
 // following the validity rules imposed on consecutive batches,
 // based on currently available buffered batch and L1 origin information.
 // If no batch can be derived yet, then (nil, io.EOF) is returned.
-func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, parent eth.L2BlockRef) (Batch, error) {
+func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, parent eth.L2BlockRef) (*BatchWithL1InclusionBlock, error) {
 	if len(bq.l1Blocks) == 0 {
 		return nil, NewCriticalError(errors.New("cannot derive next batch, no origin was prepared"))
 	}
@@ -275,6 +288,8 @@ batchLoop:
 				"parent", parent.ID(),
 				"parent_time", parent.Time,
 			)
+			bq.lastErr = fmt.Errorf("dropped batch at time %d included in L1 block %s, on parent %s",
+				batch.Batch.GetTimestamp(), batch.L1InclusionBlock, parent.ID())
 			continue
 		case BatchAccept:
 			nextBatch = batch
@@ -294,7 +309,7 @@ batchLoop:
 
 	if nextBatch != nil {
 		nextBatch.Batch.LogContext(bq.log).Info("Found next batch")
-		return nextBatch.Batch, nil
+		return nextBatch, nil
 	}
 
 	// If the current epoch is too old compared to the L1 block we are at,
@@ -323,12 +338,15 @@ batchLoop:
 	// batch to ensure that we at least have one batch per epoch.
 	if nextTimestamp < nextEpoch.Time || firstOfEpoch {
 		bq.log.Info("Generating next batch", "epoch", epoch, "timestamp", nextTimestamp)
-		return &SingularBatch{
-			ParentHash:   parent.Hash,
-			EpochNum:     rollup.Epoch(epoch.Number),
-			EpochHash:    epoch.Hash,
-			Timestamp:    nextTimestamp,
-			Transactions: nil,
+		return &BatchWithL1InclusionBlock{
+			L1InclusionBlock: bq.origin,
+			Batch: &SingularBatch{
+				ParentHash:   parent.Hash,
+				EpochNum:     rollup.Epoch(epoch.Number),
+				EpochHash:    epoch.Hash,
+				Timestamp:    nextTimestamp,
+				Transactions: nil,
+			},
 		}, nil
 	}
 
@@ -338,3 +356,7 @@ batchLoop:
 	bq.l1Blocks = bq.l1Blocks[1:]
 	return nil, io.EOF
 }
+
+func (bq *BatchQueue) status() eth.DerivationStageStatus {
+	return stageStatus("batch_queue", bq.Origin(), len(bq.batches), bq.lastErr)
+}
@@ -13,6 +13,9 @@ import (
 type BatchWithL1InclusionBlock struct {
 	Batch
 	L1InclusionBlock eth.L1BlockRef
+
+	// channel the batch was read from, if derivation receipts are tracked
+	channel *channelReceipt
 }
 
 type BatchValidity uint8
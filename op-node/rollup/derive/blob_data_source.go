@@ -18,6 +18,8 @@ type blobOrCalldata struct {
 	// union type. exactly one of calldata or blob should be non-nil
 	blob     *eth.Blob
 	calldata *eth.Data
+	// hash of the transaction that included the blob or calldata
+	txHash common.Hash
 }
 
 // BlobDataSource fetches blobs or calldata as appropriate and transforms them into usable rollup
@@ -27,17 +29,18 @@ type BlobDataSource struct {
 	ref          eth.L1BlockRef
 	batcherAddr  common.Address
 	dsCfg        DataSourceConfig
-	fetcher      L1TransactionFetcher
+	block        *eth.LazyBlock
 	blobsFetcher L1BlobsFetcher
 	log          log.Logger
+	txHash       common.Hash // hash of the transaction of the data last returned by Next
 }
 
-// NewBlobDataSource creates a new blob data source.
-func NewBlobDataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConfig, fetcher L1TransactionFetcher, blobsFetcher L1BlobsFetcher, ref eth.L1BlockRef, batcherAddr common.Address) DataIter {
+// NewBlobDataSource creates a new blob data source for the L1 block ref, which transactions are loaded from the block.
+func NewBlobDataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConfig, block *eth.LazyBlock, blobsFetcher L1BlobsFetcher, ref eth.L1BlockRef, batcherAddr common.Address) DataIter {
 	return &BlobDataSource{
 		ref:          ref,
 		dsCfg:        dsCfg,
-		fetcher:      fetcher,
+		block:        block,
 		log:          log.New("origin", ref),
 		batcherAddr:  batcherAddr,
 		blobsFetcher: blobsFetcher,
@@ -61,6 +64,7 @@ func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
 
 	next := ds.data[0]
 	ds.data = ds.data[1:]
+	ds.txHash = next.txHash
 	if next.calldata != nil {
 		return *next.calldata, nil
 	}
@@ -73,12 +77,17 @@ func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
 	return data, nil
 }
 
+// TxHash returns the hash of the transaction of the data last returned by Next.
+func (ds *BlobDataSource) TxHash() common.Hash {
+	return ds.txHash
+}
+
 // open fetches and returns the blob or calldata (as appropriate) from all valid batcher
 // transactions in the referenced block. Returns an empty (non-nil) array if no batcher
 // transactions are found. It returns ResetError if it cannot find the referenced block or a
 // referenced blob, or TemporaryError for any other failure to fetch a block or blob.
 func (ds *BlobDataSource) open(ctx context.Context) ([]blobOrCalldata, error) {
-	_, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.ref.Hash)
+	txs, err := ds.block.Transactions(ctx)
 	if err != nil {
 		if errors.Is(err, ethereum.NotFound) {
 			return nil, NewResetError(fmt.Errorf("failed to open blob data source: %w", err))
@@ -128,7 +137,7 @@ func dataAndHashesFromTxs(txs types.Transactions, config *DataSourceConfig, batc
 		// handle non-blob batcher transactions by extracting their calldata
 		if tx.Type() != types.BlobTxType {
 			calldata := eth.Data(tx.Data())
-			data = append(data, blobOrCalldata{nil, &calldata})
+			data = append(data, blobOrCalldata{nil, &calldata, tx.Hash()})
 			continue
 		}
 		// handle blob batcher transactions by extracting their blob hashes, ignoring any calldata.
@@ -141,7 +150,7 @@ func dataAndHashesFromTxs(txs types.Transactions, config *DataSourceConfig, batc
 				Hash:  h,
 			}
 			hashes = append(hashes, idh)
-			data = append(data, blobOrCalldata{nil, nil}) // will fill in blob pointers after we download them below
+			data = append(data, blobOrCalldata{nil, nil, tx.Hash()}) // will fill in blob pointers after we download them below
 			blobIndex += 1
 		}
 	}
@@ -15,38 +15,40 @@ import (
 )
 
 // CalldataSource is a fault tolerant approach to fetching data.
-// The constructor will never fail & it will instead re-attempt the fetcher
+// The constructor will never fail & it will instead re-attempt loading the block
 // at a later point.
 type CalldataSource struct {
 	// Internal state + data
-	open bool
-	data []eth.Data
+	open   bool
+	data   []eth.Data
+	hashes []common.Hash // hashes of the transactions of the data
+	txHash common.Hash   // hash of the transaction of the data last returned by Next
 	// Required to re-attempt fetching
-	ref     eth.L1BlockRef
-	dsCfg   DataSourceConfig
-	fetcher L1TransactionFetcher
-	log     log.Logger
+	block *eth.LazyBlock
+	dsCfg DataSourceConfig
+	log   log.Logger
 
 	batcherAddr common.Address
 }
 
 // NewCalldataSource creates a new calldata source. It suppresses errors in fetching the L1 block if they occur.
 // If there is an error, it will attempt to fetch the result on the next call to `Next`.
-func NewCalldataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConfig, fetcher L1TransactionFetcher, ref eth.L1BlockRef, batcherAddr common.Address) DataIter {
-	_, txs, err := fetcher.InfoAndTxsByHash(ctx, ref.Hash)
+func NewCalldataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConfig, block *eth.LazyBlock, batcherAddr common.Address) DataIter {
+	txs, err := block.Transactions(ctx)
 	if err != nil {
 		return &CalldataSource{
 			open:        false,
-			ref:         ref,
+			block:       block,
 			dsCfg:       dsCfg,
-			fetcher:     fetcher,
 			log:         log,
 			batcherAddr: batcherAddr,
 		}
 	}
+	data, hashes := calldataFromTxs(dsCfg, batcherAddr, txs, log.New("origin", block.ID()))
 	return &CalldataSource{
-		open: true,
-		data: DataFromEVMTransactions(dsCfg, batcherAddr, txs, log.New("origin", ref)),
+		open:   true,
+		data:   data,
+		hashes: hashes,
 	}
 }
 
@@ -55,9 +57,9 @@ func NewCalldataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConf
 // otherwise it returns a temporary error if fetching the block returns an error.
 func (ds *CalldataSource) Next(ctx context.Context) (eth.Data, error) {
 	if !ds.open {
-		if _, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.ref.Hash); err == nil {
+		if txs, err := ds.block.Transactions(ctx); err == nil {
 			ds.open = true
-			ds.data = DataFromEVMTransactions(ds.dsCfg, ds.batcherAddr, txs, ds.log)
+			ds.data, ds.hashes = calldataFromTxs(ds.dsCfg, ds.batcherAddr, txs, ds.log)
 		} else if errors.Is(err, ethereum.NotFound) {
 			return nil, NewResetError(fmt.Errorf("failed to open calldata source: %w", err))
 		} else {
@@ -68,20 +70,35 @@ func (ds *CalldataSource) Next(ctx context.Context) (eth.Data, error) {
 		return nil, io.EOF
 	} else {
 		data := ds.data[0]
+		ds.txHash = ds.hashes[0]
 		ds.data = ds.data[1:]
+		ds.hashes = ds.hashes[1:]
 		return data, nil
 	}
 }
 
+// TxHash returns the hash of the transaction of the data last returned by Next.
+func (ds *CalldataSource) TxHash() common.Hash {
+	return ds.txHash
+}
+
 // DataFromEVMTransactions filters all of the transactions and returns the calldata from transactions
 // that are sent to the batch inbox address from the batch sender address.
 // This will return an empty array if no valid transactions are found.
 func DataFromEVMTransactions(dsCfg DataSourceConfig, batcherAddr common.Address, txs types.Transactions, log log.Logger) []eth.Data {
+	out, _ := calldataFromTxs(dsCfg, batcherAddr, txs, log)
+	return out
+}
+
+// calldataFromTxs is like DataFromEVMTransactions, but also returns the hashes of the transactions of the data.
+func calldataFromTxs(dsCfg DataSourceConfig, batcherAddr common.Address, txs types.Transactions, log log.Logger) ([]eth.Data, []common.Hash) {
 	out := []eth.Data{}
+	var hashes []common.Hash
 	for _, tx := range txs {
 		if isValidBatchTx(tx, dsCfg.l1Signer, dsCfg.batchInboxAddress, batcherAddr, log) {
 			out = append(out, tx.Data())
+			hashes = append(hashes, tx.Hash())
 		}
 	}
-	return out
+	return out, hashes
 }
@@ -1,7 +1,9 @@
 package derive
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"io"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -52,6 +54,14 @@ type calldataTest struct {
 // TestDataFromEVMTransactions creates some transactions from a specified template and asserts
 // that DataFromEVMTransactions properly filters and returns the data from the authorized transactions
 // inside the transaction set.
+// randomBlockInfoOf returns random block info of the block ref, as returned by the L1 fetcher for it.
+func randomBlockInfoOf(rng *rand.Rand, ref eth.L1BlockRef) *testutils.MockBlockInfo {
+	info := testutils.RandomBlockInfo(rng)
+	info.InfoHash = ref.Hash
+	info.InfoNum = ref.Number
+	return info
+}
+
 func TestDataFromEVMTransactions(t *testing.T) {
 	inboxPriv := testutils.RandomKey()
 	batcherPriv := testutils.RandomKey()
@@ -126,3 +136,42 @@ func TestDataFromEVMTransactions(t *testing.T) {
 	}
 
 }
+
+// TestCalldataSourceBatchInboxSchedule checks that the data source reads batches
+// from the batch inbox address that is scheduled for the L1 block.
+func TestCalldataSourceBatchInboxSchedule(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	batcherPriv := testutils.RandomKey()
+	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
+	oldInbox, newInbox := testutils.RandomAddress(rng), testutils.RandomAddress(rng)
+	cfg := &rollup.Config{
+		L1ChainID:          big.NewInt(100),
+		BatchInboxAddress:  oldInbox,
+		BatchInboxSchedule: []rollup.BatchInboxUpdate{{L1Block: 10, Address: newInbox}},
+	}
+	signer := cfg.L1Signer()
+	toOld := (&testTx{to: &oldInbox, dataLen: 100, author: batcherPriv}).Create(t, signer, rng)
+	toNew := (&testTx{to: &newInbox, dataLen: 100, author: batcherPriv}).Create(t, signer, rng)
+
+	l1F := &testutils.MockL1Source{}
+	factory := NewL1DataSourceFactory(testlog.Logger(t, log.LevelCrit), cfg, l1F, nil, nil)
+	for _, tc := range []struct {
+		num      uint64
+		expected *types.Transaction
+	}{
+		{num: 9, expected: toOld},
+		{num: 10, expected: toNew},
+	} {
+		ref := eth.L1BlockRef{Hash: testutils.RandomHash(rng), Number: tc.num}
+		l1F.ExpectInfoAndTxsByHash(ref.Hash, randomBlockInfoOf(rng, ref), types.Transactions{toOld, toNew}, nil)
+
+		src, err := factory.OpenData(context.Background(), ref, batcherAddr)
+		require.NoError(t, err)
+		data, err := src.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, eth.Data(tc.expected.Data()), data)
+		_, err = src.Next(context.Background())
+		require.ErrorIs(t, err, io.EOF)
+	}
+	l1F.AssertExpectations(t)
+}
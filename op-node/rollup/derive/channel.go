@@ -8,8 +8,10 @@ import (
 	"io"
 
 	"github.com/andybalholm/brotli"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
@@ -154,11 +156,43 @@ func (ch *Channel) Reader() io.Reader {
 	return io.MultiReader(readers...)
 }
 
+// BatchReaderOption customizes the BatchReader.
+type BatchReaderOption func(opts *batchReaderOptions)
+
+type batchReaderOptions struct {
+	zstd       bool
+	dictionary []byte
+}
+
+// WithZstd makes the BatchReader accept zstd compressed channels, optionally compressed with the given dictionary.
+func WithZstd(dict []byte) BatchReaderOption {
+	return func(opts *batchReaderOptions) {
+		opts.zstd = true
+		opts.dictionary = dict
+	}
+}
+
+// WithChainZstd makes the BatchReader accept zstd compressed channels,
+// if they are active in the chain config for the L1 block with the given timestamp.
+func WithChainZstd(cfg *rollup.Config, l1Time uint64) BatchReaderOption {
+	return func(opts *batchReaderOptions) {
+		if cfg.IsZstd(l1Time) {
+			WithZstd(cfg.ZstdConfig.Dictionary)(opts)
+		}
+	}
+}
+
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
 // Warning: the batch reader can read every batch-type.
 // The caller of the batch-reader should filter the results.
-func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool) (func() (*BatchData, error), error) {
+// Zstd compressed channels are only accepted with the WithZstd or WithChainZstd options.
+func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool, opts ...BatchReaderOption) (func() (*BatchData, error), error) {
+	var options batchReaderOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	// use buffered reader so can peek the first byte
 	bufReader := bufio.NewReader(r)
 	compressionType, err := bufReader.Peek(1)
@@ -189,6 +223,25 @@ func BatchReader(r io.Reader, maxRLPBytesPerChannel uint64, isFjord bool) (func(
 		}
 		zr = brotli.NewReader(bufReader)
 		comprAlgo = Brotli
+	} else if compressionType[0] == ChannelVersionZstd {
+		if !options.zstd {
+			return nil, fmt.Errorf("cannot accept zstd compressed batch before zstd activation")
+		}
+		// discard the first byte
+		_, err := bufReader.Discard(1)
+		if err != nil {
+			return nil, err
+		}
+		// Decode synchronously, so the decoder does not start goroutines that need to be closed.
+		zstdOpts := []zstd.DOption{zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(ZstdMaxWindowSize)}
+		if len(options.dictionary) > 0 {
+			zstdOpts = append(zstdOpts, zstd.WithDecoderDicts(options.dictionary))
+		}
+		zr, err = zstd.NewReader(bufReader, zstdOpts...)
+		if err != nil {
+			return nil, err
+		}
+		comprAlgo = Zstd
 	} else {
 		return nil, fmt.Errorf("cannot distinguish the compression algo used given type byte %v", compressionType[0])
 	}
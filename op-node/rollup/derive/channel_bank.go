@@ -2,6 +2,7 @@ package derive
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"slices"
 
@@ -39,6 +40,11 @@ type ChannelBank struct {
 
 	prev    NextFrameProvider
 	fetcher L1Fetcher
+
+	receipts *receiptTracker
+
+	// lastErr is the last error ingesting a frame, kept for introspection of the pipeline
+	lastErr error
 }
 
 var _ ResettableStage = (*ChannelBank)(nil)
@@ -72,6 +78,7 @@ func (cb *ChannelBank) prune() {
 		ch := cb.channels[id]
 		cb.channelQueue = cb.channelQueue[1:]
 		delete(cb.channels, id)
+		cb.receipts.onChannelDropped(id)
 		cb.log.Info("pruning channel", "channel", id, "totalSize", totalSize, "channel_size", ch.size, "remaining_channel_count", len(cb.channels))
 		totalSize -= ch.size
 	}
@@ -100,15 +107,18 @@ func (cb *ChannelBank) IngestFrame(f Frame) {
 	// check if the channel is not timed out
 	if currentCh.OpenBlockNumber()+cb.spec.ChannelTimeout(origin.Time) < origin.Number {
 		log.Warn("channel is timed out, ignore frame")
+		cb.lastErr = fmt.Errorf("ignored frame %d of timed out channel %s in L1 block %s", f.FrameNumber, f.ID, origin)
 		return
 	}
 
 	log.Trace("ingesting frame")
 	if err := currentCh.AddFrame(f, origin); err != nil {
 		log.Warn("failed to ingest frame into channel", "err", err)
+		cb.lastErr = fmt.Errorf("failed to ingest frame %d of channel %s in L1 block %s: %w", f.FrameNumber, f.ID, origin, err)
 		return
 	}
 	cb.metrics.RecordFrame()
+	cb.receipts.onFrame(f)
 
 	// Prune after the frame is loaded.
 	cb.prune()
@@ -131,6 +141,7 @@ func (cb *ChannelBank) Read() (data []byte, err error) {
 		cb.metrics.RecordChannelTimedOut()
 		delete(cb.channels, first)
 		cb.channelQueue = cb.channelQueue[1:]
+		cb.receipts.onChannelDropped(first)
 		return nil, nil // multiple different channels may all be timed out
 	}
 
@@ -166,6 +177,7 @@ func (cb *ChannelBank) tryReadChannelAtIndex(i int) (data []byte, err error) {
 	delete(cb.channels, chanID)
 	cb.channelQueue = slices.Delete(cb.channelQueue, i, i+1)
 	cb.metrics.RecordHeadChannelOpened()
+	cb.receipts.onChannelRead(chanID)
 	r := ch.Reader()
 	// Suppress error here. io.ReadAll does return nil instead of io.EOF though.
 	data, _ = io.ReadAll(r)
@@ -202,9 +214,14 @@ func (cb *ChannelBank) NextData(ctx context.Context) ([]byte, error) {
 func (cb *ChannelBank) Reset(ctx context.Context, base eth.L1BlockRef, _ eth.SystemConfig) error {
 	cb.channels = make(map[ChannelID]*Channel)
 	cb.channelQueue = make([]ChannelID, 0, 10)
+	cb.lastErr = nil
 	return io.EOF
 }
 
 type L1BlockRefByHashFetcher interface {
 	L1BlockRefByHash(context.Context, common.Hash) (eth.L1BlockRef, error)
 }
+
+func (cb *ChannelBank) status() eth.DerivationStageStatus {
+	return stageStatus("channel_bank", cb.Origin(), len(cb.channelQueue), cb.lastErr)
+}
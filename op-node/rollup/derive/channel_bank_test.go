@@ -290,6 +290,9 @@ func TestChannelBankDuplicates(t *testing.T) {
 	out, err = cb.NextData(context.Background())
 	require.ErrorIs(t, err, NotEnoughData)
 	require.Equal(t, []byte(nil), out)
+	status := cb.status()
+	require.Equal(t, uint64(1), status.QueueDepth)
+	require.Contains(t, status.LastError, "failed to ingest frame 2 of channel")
 
 	// Load the second frame
 	out, err = cb.NextData(context.Background())
@@ -300,6 +303,7 @@ func TestChannelBankDuplicates(t *testing.T) {
 	out, err = cb.NextData(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, "firstsecondthird", string(out))
+	require.Zero(t, cb.status().QueueDepth)
 
 	// No more data
 	out, err = cb.NextData(context.Background())
@@ -7,12 +7,19 @@ import (
 	"io"
 
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
 	ChannelVersionBrotli byte = 0x01
+	ChannelVersionZstd   byte = 0x02
 )
 
+// ZstdMaxWindowSize is the maximum window size of zstd compressed channels.
+// Channels are compressed with at most this window, and decompressed with at most this window,
+// to bound the memory used to decompress a channel.
+const ZstdMaxWindowSize = 8 << 20
+
 type ChannelCompressor interface {
 	Write([]byte) (int, error)
 	Flush() error
@@ -66,7 +73,37 @@ func (bc *BrotliCompressor) Reset() {
 	bc.CompressorWriter.Reset(bc.compressed)
 }
 
-func NewChannelCompressor(algo CompressionAlgo) (ChannelCompressor, error) {
+type ZstdCompressor struct {
+	BaseChannelCompressor
+}
+
+func (zc *ZstdCompressor) Reset() {
+	zc.compressed.Reset()
+	zc.compressed.WriteByte(ChannelVersionZstd)
+	zc.CompressorWriter.Reset(zc.compressed)
+}
+
+// ChannelCompressorOption customizes a ChannelCompressor.
+type ChannelCompressorOption func(opts *channelCompressorOptions)
+
+type channelCompressorOptions struct {
+	dictionary []byte
+}
+
+// WithCompressionDictionary makes zstd compressors use the given zstd dictionary,
+// which must be the dictionary of the chain config to be decompressed by the nodes.
+// It is ignored by the other compression algorithms.
+func WithCompressionDictionary(dict []byte) ChannelCompressorOption {
+	return func(opts *channelCompressorOptions) {
+		opts.dictionary = dict
+	}
+}
+
+func NewChannelCompressor(algo CompressionAlgo, opts ...ChannelCompressorOption) (ChannelCompressor, error) {
+	var options channelCompressorOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 	compressed := &bytes.Buffer{}
 	if algo == Zlib {
 		writer, err := zlib.NewWriterLevel(compressed, zlib.BestCompression)
@@ -88,6 +125,26 @@ func NewChannelCompressor(algo CompressionAlgo) (ChannelCompressor, error) {
 				compressed:       compressed,
 			},
 		}, nil
+	} else if algo.IsZstd() {
+		compressed.WriteByte(ChannelVersionZstd)
+		zstdOpts := []zstd.EOption{
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(GetZstdLevel(algo))),
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithWindowSize(ZstdMaxWindowSize),
+		}
+		if len(options.dictionary) > 0 {
+			zstdOpts = append(zstdOpts, zstd.WithEncoderDict(options.dictionary))
+		}
+		writer, err := zstd.NewWriter(compressed, zstdOpts...)
+		if err != nil {
+			return nil, err
+		}
+		return &ZstdCompressor{
+			BaseChannelCompressor{
+				CompressorWriter: writer,
+				compressed:       compressed,
+			},
+		}, nil
 	} else {
 		return nil, fmt.Errorf("unsupported compression algorithm: %s", algo)
 	}
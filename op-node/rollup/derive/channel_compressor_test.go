@@ -1,9 +1,12 @@
 package derive
 
 import (
+	"bytes"
+	"math/big"
 	"math/rand"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +26,7 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 	testCases := []struct {
 		name              string
 		algo              CompressionAlgo
+		opts              []ChannelCompressorOption
 		expectedResetSize int
 		expectErr         bool
 	}{
@@ -38,7 +42,25 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 		},
 		{
 			name:              "zstd",
-			algo:              CompressionAlgo("zstd"),
+			algo:              Zstd,
+			expectedResetSize: 1,
+		},
+		{
+			name:              "zstd-dictionary",
+			algo:              Zstd19,
+			opts:              []ChannelCompressorOption{WithCompressionDictionary(testZstdDictionary(t))},
+			expectedResetSize: 1,
+		},
+		{
+			name:              "zstd-invalid-dictionary",
+			algo:              Zstd,
+			opts:              []ChannelCompressorOption{WithCompressionDictionary([]byte("invalid"))},
+			expectedResetSize: 0,
+			expectErr:         true,
+		},
+		{
+			name:              "invalid",
+			algo:              CompressionAlgo("invalid"),
 			expectedResetSize: 0,
 			expectErr:         true,
 		},
@@ -46,7 +68,7 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			scc, err := NewChannelCompressor(tc.algo)
+			scc, err := NewChannelCompressor(tc.algo, tc.opts...)
 			if tc.expectErr {
 				require.Error(t, err)
 				return
@@ -65,3 +87,19 @@ func TestChannelCompressor_NewReset(t *testing.T) {
 		})
 	}
 }
+
+// testZstdDictionary builds a zstd dictionary from encoded random batches.
+func testZstdDictionary(t *testing.T) []byte {
+	rng := rand.New(rand.NewSource(0xd1c7))
+	var contents [][]byte
+	var history []byte
+	for i := 0; i < 20; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, NewBatchData(RandomSingularBatch(rng, 5, big.NewInt(333))).EncodeRLP(&buf))
+		contents = append(contents, buf.Bytes())
+		history = append(history, buf.Bytes()[:100]...)
+	}
+	dict, err := zstd.BuildDict(zstd.BuildDictOptions{ID: 1, Contents: contents, History: history, Offsets: [3]int{1, 4, 8}})
+	require.NoError(t, err)
+	return dict
+}
@@ -23,6 +23,9 @@ type ChannelInReader struct {
 	nextBatchFn func() (*BatchData, error)
 	prev        *ChannelBank
 	metrics     Metrics
+
+	// lastErr is the last error reading batches from a channel, kept for introspection of the pipeline
+	lastErr error
 }
 
 var _ ResettableStage = (*ChannelInReader)(nil)
@@ -44,12 +47,14 @@ func (cr *ChannelInReader) Origin() eth.L1BlockRef {
 
 // TODO: Take full channel for better logging
 func (cr *ChannelInReader) WriteChannel(data []byte) error {
-	if f, err := BatchReader(bytes.NewBuffer(data), cr.spec.MaxRLPBytesPerChannel(cr.prev.Origin().Time), cr.cfg.IsFjord(cr.prev.Origin().Time)); err == nil {
+	origin := cr.prev.Origin()
+	if f, err := BatchReader(bytes.NewBuffer(data), cr.spec.MaxRLPBytesPerChannel(origin.Time), cr.cfg.IsFjord(origin.Time), WithChainZstd(cr.cfg, origin.Time)); err == nil {
 		cr.nextBatchFn = f
 		cr.metrics.RecordChannelInputBytes(len(data))
 		return nil
 	} else {
 		cr.log.Error("Error creating batch reader from channel data", "err", err)
+		cr.lastErr = fmt.Errorf("failed to read channel in L1 block %s: %w", origin, err)
 		return err
 	}
 }
@@ -84,6 +89,7 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (Batch, error) {
 		return nil, NotEnoughData
 	} else if err != nil {
 		cr.log.Warn("failed to read batch from channel reader, skipping to next channel now", "err", err)
+		cr.lastErr = fmt.Errorf("failed to read batch from channel in L1 block %s: %w", cr.Origin(), err)
 		cr.NextChannel()
 		return nil, NotEnoughData
 	}
@@ -120,5 +126,15 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (Batch, error) {
 
 func (cr *ChannelInReader) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.SystemConfig) error {
 	cr.nextBatchFn = nil
+	cr.lastErr = nil
 	return io.EOF
 }
+
+// status reports a queue depth of 1 while a channel is being read.
+func (cr *ChannelInReader) status() eth.DerivationStageStatus {
+	depth := 0
+	if cr.nextBatchFn != nil {
+		depth = 1
+	}
+	return stageStatus("channel_in_reader", cr.Origin(), depth, cr.lastErr)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/andybalholm/brotli"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
@@ -121,8 +122,8 @@ func TestBatchReader(t *testing.T) {
 	err := batchDataInput.EncodeRLP(encodedBatch)
 	require.NoError(t, err)
 
-	const Zstd CompressionAlgo = "zstd" // invalid algo
-	compressor := func(ca CompressionAlgo) func(buf *bytes.Buffer, t *testing.T) {
+	dict := testZstdDictionary(t)
+	compressor := func(ca CompressionAlgo, dict []byte) func(buf *bytes.Buffer, t *testing.T) {
 		switch {
 		case ca == Zlib:
 			return func(buf *bytes.Buffer, t *testing.T) {
@@ -140,10 +141,14 @@ func TestBatchReader(t *testing.T) {
 				require.NoError(t, err)
 				require.NoError(t, writer.Close())
 			}
-		case ca == Zstd: // invalid algo
+		case ca.IsZstd():
 			return func(buf *bytes.Buffer, t *testing.T) {
-				buf.WriteByte(0x02) // invalid channel version byte
-				writer, err := zstd.NewWriter(buf)
+				buf.WriteByte(ChannelVersionZstd)
+				opts := []zstd.EOption{zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(GetZstdLevel(ca)))}
+				if dict != nil {
+					opts = append(opts, zstd.WithEncoderDict(dict))
+				}
+				writer, err := zstd.NewWriter(buf, opts...)
 				require.NoError(t, err)
 				_, err = writer.Write(encodedBatch.Bytes())
 				require.NoError(t, err)
@@ -157,7 +162,9 @@ func TestBatchReader(t *testing.T) {
 	testCases := []struct {
 		name      string
 		algo      CompressionAlgo
+		dict      []byte
 		isFjord   bool
+		opts      []BatchReaderOption
 		expectErr bool
 	}{
 		{
@@ -203,10 +210,52 @@ func TestBatchReader(t *testing.T) {
 			isFjord: true,
 		},
 		{
-			name:      "zstd-post-fjord",
+			name:      "zstd-before-activation",
 			algo:      Zstd,
-			expectErr: true,
+			expectErr: true, // expect an error because zstd is not enabled
+			isFjord:   true,
+		},
+		{
+			name:    "zstd",
+			algo:    Zstd,
+			isFjord: true,
+			opts:    []BatchReaderOption{WithZstd(nil)},
+		},
+		{
+			name:    "zstd19",
+			algo:    Zstd19,
+			isFjord: true,
+			opts:    []BatchReaderOption{WithZstd(nil)},
+		},
+		{
+			name:    "zstd-dictionary",
+			algo:    Zstd,
+			dict:    dict,
+			isFjord: true,
+			opts:    []BatchReaderOption{WithZstd(dict)},
+		},
+		{
+			name:      "zstd-missing-dictionary",
+			algo:      Zstd,
+			dict:      dict,
 			isFjord:   true,
+			opts:      []BatchReaderOption{WithZstd(nil)},
+			expectErr: true, // expect an error because the dictionary is unknown
+		},
+		{
+			name:    "zstd-chain-config",
+			algo:    Zstd,
+			dict:    dict,
+			isFjord: true,
+			opts:    []BatchReaderOption{WithChainZstd(&rollup.Config{ZstdConfig: &rollup.ZstdConfig{ActivationTime: 10, Dictionary: dict}}, 10)},
+		},
+		{
+			name:      "zstd-chain-config-before-activation",
+			algo:      Zstd,
+			dict:      dict,
+			isFjord:   true,
+			opts:      []BatchReaderOption{WithChainZstd(&rollup.Config{ZstdConfig: &rollup.ZstdConfig{ActivationTime: 10, Dictionary: dict}}, 9)},
+			expectErr: true,
 		},
 	}
 
@@ -214,9 +263,13 @@ func TestBatchReader(t *testing.T) {
 		compressed := new(bytes.Buffer)
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			compressor(tc.algo)(compressed, t)
-			reader, err := BatchReader(bytes.NewReader(compressed.Bytes()), 120000, tc.isFjord)
+			compressor(tc.algo, tc.dict)(compressed, t)
+			reader, err := BatchReader(bytes.NewReader(compressed.Bytes()), 120000, tc.isFjord, tc.opts...)
 			if tc.expectErr {
+				if err == nil {
+					// a missing dictionary is only detected when decoding
+					_, err = reader()
+				}
 				require.Error(t, err)
 				return
 			}
@@ -229,6 +282,8 @@ func TestBatchReader(t *testing.T) {
 			if tc.algo.IsBrotli() {
 				// special case because reader doesn't decode level
 				batchDataInput.ComprAlgo = Brotli
+			} else if tc.algo.IsZstd() {
+				batchDataInput.ComprAlgo = Zstd
 			} else {
 				batchDataInput.ComprAlgo = tc.algo
 			}
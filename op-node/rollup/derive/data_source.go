@@ -35,46 +35,62 @@ type AltDAInputFetcher interface {
 	Reset(ctx context.Context, base eth.L1BlockRef, baseCfg eth.SystemConfig) error
 }
 
-// DataSourceFactory reads raw transactions from a given block & then filters for
+// DataSourceFactory opens the batch data of L1 blocks, for the L1 retrieval stage.
+// Alternative DA backends implement this interface, and are registered with RegisterDataSource.
+type DataSourceFactory interface {
+	// OpenData returns an iterator over the batch data, posted by the batcher in the L1 block `ref`.
+	OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error)
+}
+
+// L1DataSourceFactory reads raw transactions from a given block & then filters for
 // batch submitter transactions.
 // This is not a stage in the pipeline, but a wrapper for another stage in the pipeline
-type DataSourceFactory struct {
+type L1DataSourceFactory struct {
 	log          log.Logger
 	dsCfg        DataSourceConfig
 	fetcher      L1Fetcher
 	blobsFetcher L1BlobsFetcher
 	altDAFetcher AltDAInputFetcher
 	ecotoneTime  *uint64
+	batchInboxAt func(l1Num uint64) common.Address
 }
 
-func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1Fetcher, blobsFetcher L1BlobsFetcher, altDAFetcher AltDAInputFetcher) *DataSourceFactory {
+var _ DataSourceFactory = (*L1DataSourceFactory)(nil)
+
+func NewL1DataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1Fetcher, blobsFetcher L1BlobsFetcher, altDAFetcher AltDAInputFetcher) *L1DataSourceFactory {
 	config := DataSourceConfig{
 		l1Signer:          cfg.L1Signer(),
 		batchInboxAddress: cfg.BatchInboxAddress,
 		altDAEnabled:      cfg.AltDAEnabled(),
 	}
-	return &DataSourceFactory{
+	return &L1DataSourceFactory{
 		log:          log,
 		dsCfg:        config,
 		fetcher:      fetcher,
 		blobsFetcher: blobsFetcher,
 		altDAFetcher: altDAFetcher,
 		ecotoneTime:  cfg.EcotoneTime,
+		batchInboxAt: cfg.BatchInboxAddressAt,
 	}
 }
 
 // OpenData returns the appropriate data source for the L1 block `ref`.
-func (ds *DataSourceFactory) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error) {
+func (ds *L1DataSourceFactory) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error) {
 	// Creates a data iterator from blob or calldata source so we can forward it to the altDA source
 	// if enabled as it still requires an L1 data source for fetching input commmitments.
+	// The batch inbox address may be rotated by the rollup config, so it is resolved per L1 block.
+	dsCfg := ds.dsCfg
+	dsCfg.batchInboxAddress = ds.batchInboxAt(ref.Number)
+	// The transactions of the block are loaded once, and retained when opening the data fails and is retried.
+	block := eth.NewLazyBlock(ref.ID(), ds.fetcher)
 	var src DataIter
 	if ds.ecotoneTime != nil && ref.Time >= *ds.ecotoneTime {
 		if ds.blobsFetcher == nil {
 			return nil, fmt.Errorf("ecotone upgrade active but beacon endpoint not configured")
 		}
-		src = NewBlobDataSource(ctx, ds.log, ds.dsCfg, ds.fetcher, ds.blobsFetcher, ref, batcherAddr)
+		src = NewBlobDataSource(ctx, ds.log, dsCfg, block, ds.blobsFetcher, ref, batcherAddr)
 	} else {
-		src = NewCalldataSource(ctx, ds.log, ds.dsCfg, ds.fetcher, ref, batcherAddr)
+		src = NewCalldataSource(ctx, ds.log, dsCfg, block, batcherAddr)
 	}
 	if ds.dsCfg.altDAEnabled {
 		// altDA([calldata | blobdata](l1Ref)) -> data
@@ -0,0 +1,84 @@
+package derive
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// DefaultDataSource is the name of the data source that reads the batch data from L1 transactions and blobs.
+const DefaultDataSource = "l1"
+
+// DataSourceDeps are the dependencies a DataSourceFactory is created with.
+type DataSourceDeps struct {
+	Log     log.Logger
+	Cfg     *rollup.Config
+	L1      L1Fetcher
+	L1Blobs L1BlobsFetcher
+	AltDA   AltDAInputFetcher
+	// Arg is the data source specific configuration, that follows the name of the data source, e.g. a directory.
+	Arg string
+}
+
+// DataSourceCreator creates a DataSourceFactory.
+// The default L1 data source can be created with NewL1DataSourceFactory, e.g. to fall back to.
+type DataSourceCreator func(deps DataSourceDeps) DataSourceFactory
+
+var (
+	dataSourcesLock sync.RWMutex
+	dataSources     = map[string]DataSourceCreator{
+		DefaultDataSource: newL1DataSource,
+	}
+)
+
+func newL1DataSource(deps DataSourceDeps) DataSourceFactory {
+	return NewL1DataSourceFactory(deps.Log, deps.Cfg, deps.L1, deps.L1Blobs, deps.AltDA)
+}
+
+// RegisterDataSource registers a data source by name, so it can be selected with LookupDataSource.
+// Data sources are registered from the init function of the package that implements them.
+// Panics if the name is already registered, since this indicates a significant programmer error.
+func RegisterDataSource(name string, creator DataSourceCreator) {
+	dataSourcesLock.Lock()
+	defer dataSourcesLock.Unlock()
+	if strings.Contains(name, ":") {
+		panic(fmt.Errorf("invalid data source name %q", name))
+	}
+	if _, ok := dataSources[name]; ok {
+		panic(fmt.Errorf("duplicate data source %q", name))
+	}
+	dataSources[name] = creator
+}
+
+// DataSources returns the names of all registered data sources, sorted by name.
+func DataSources() []string {
+	dataSourcesLock.RLock()
+	defer dataSourcesLock.RUnlock()
+	names := make([]string, 0, len(dataSources))
+	for name := range dataSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupDataSource returns the registered data source of the "<name>[:<arg>]" spec, and its argument.
+// An empty spec selects the default L1 data source.
+func LookupDataSource(spec string) (DataSourceCreator, string, error) {
+	if spec == "" {
+		spec = DefaultDataSource
+	}
+	name, arg, _ := strings.Cut(spec, ":")
+	dataSourcesLock.RLock()
+	creator, ok := dataSources[name]
+	dataSourcesLock.RUnlock()
+	if !ok {
+		return nil, "", fmt.Errorf("unknown data source %q, available: %s", name, strings.Join(DataSources(), ", "))
+	}
+	return creator, arg, nil
+}
@@ -0,0 +1,56 @@
+package derive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type stubDataSourceFactory struct {
+	arg string
+}
+
+func (s *stubDataSourceFactory) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error) {
+	return nil, nil
+}
+
+func TestLookupDataSource(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		creator, arg, err := LookupDataSource("")
+		require.NoError(t, err)
+		require.Empty(t, arg)
+		require.IsType(t, &L1DataSourceFactory{}, creator(DataSourceDeps{Cfg: &rollup.Config{}}))
+
+		creator, _, err = LookupDataSource(DefaultDataSource)
+		require.NoError(t, err)
+		require.IsType(t, &L1DataSourceFactory{}, creator(DataSourceDeps{Cfg: &rollup.Config{}}))
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, _, err := LookupDataSource("unknown:arg")
+		require.ErrorContains(t, err, "unknown data source \"unknown\"")
+	})
+
+	t.Run("Registered", func(t *testing.T) {
+		RegisterDataSource("test-stub", func(deps DataSourceDeps) DataSourceFactory {
+			return &stubDataSourceFactory{arg: deps.Arg}
+		})
+		require.Contains(t, DataSources(), "test-stub")
+		creator, arg, err := LookupDataSource("test-stub:some:arg")
+		require.NoError(t, err)
+		require.Equal(t, "some:arg", arg)
+		require.Equal(t, &stubDataSourceFactory{arg: arg}, creator(DataSourceDeps{Arg: arg}))
+
+		require.Panics(t, func() {
+			RegisterDataSource("test-stub", creator)
+		}, "duplicate")
+		require.Panics(t, func() {
+			RegisterDataSource("invalid:name", creator)
+		})
+	})
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -116,3 +118,21 @@ func TestEip4788Params(t *testing.T) {
 	require.Equal(t, eip4788CreationData, common.FromHex("0x60618060095f395ff33373fffffffffffffffffffffffffffffffffffffffe14604d57602036146024575f5ffd5b5f35801560495762001fff810690815414603c575f5ffd5b62001fff01545f5260205ff35b5f5ffd5b62001fff42064281555f359062001fff015500"))
 	require.NotEmpty(t, eip4788CreationData)
 }
+
+func TestUpgradeTransactionTargets(t *testing.T) {
+	ecotoneTxns, err := EcotoneNetworkUpgradeTransactions()
+	require.NoError(t, err)
+	fjordTxns, err := FjordNetworkUpgradeTransactions()
+	require.NoError(t, err)
+	for _, data := range append(ecotoneTxns, fjordTxns...) {
+		_, txn := toDepositTxn(t, data)
+		if txn.To() == nil {
+			continue
+		}
+		// Upgrade transactions may only call proxied predeploys that already exist before the upgrade.
+		p, ok := predeploys.ByAddress(*txn.To())
+		require.Truef(t, ok, "upgrade tx %s targets unknown predeploy %s", txn.SourceHash(), txn.To())
+		require.False(t, p.ProxyDisabled, "upgrade tx targets non-proxied predeploy %s", p.Name)
+		require.Equal(t, predeploys.Bedrock, p.Fork)
+	}
+}
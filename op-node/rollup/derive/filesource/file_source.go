@@ -0,0 +1,85 @@
+// Package filesource is an example of a custom derivation data source.
+// It reads the batch data of L1 blocks from files, e.g. a mirror of the batches of a chain,
+// and falls back to the L1 data source for blocks without a file.
+//
+// The data source is registered as "file", and is selected with "file:<directory>".
+package filesource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// Name is the name the data source is registered as.
+const Name = "file"
+
+func init() {
+	derive.RegisterDataSource(Name, func(deps derive.DataSourceDeps) derive.DataSourceFactory {
+		return NewFileDataSourceFactory(deps.Log, deps.Arg, derive.NewL1DataSourceFactory(deps.Log, deps.Cfg, deps.L1, deps.L1Blobs, deps.AltDA))
+	})
+}
+
+// FileName returns the name of the file with the batch data of the L1 block.
+func FileName(block common.Hash) string {
+	return block.Hex() + ".json"
+}
+
+// FileDataSourceFactory reads the batch data of an L1 block from a JSON file in a directory.
+// Each file is named by the hash of the L1 block, and contains a list of the hex-encoded batch data
+// that the batcher posted in the block, in order.
+// The data in the files is trusted: it is not checked against the batch inbox and batcher address.
+type FileDataSourceFactory struct {
+	log      log.Logger
+	dir      string
+	fallback derive.DataSourceFactory
+}
+
+var _ derive.DataSourceFactory = (*FileDataSourceFactory)(nil)
+
+// NewFileDataSourceFactory creates a file data source, that opens the fallback data source for blocks without a file.
+// The fallback is optional.
+func NewFileDataSourceFactory(log log.Logger, dir string, fallback derive.DataSourceFactory) *FileDataSourceFactory {
+	return &FileDataSourceFactory{
+		log:      log,
+		dir:      dir,
+		fallback: fallback,
+	}
+}
+
+func (f *FileDataSourceFactory) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (derive.DataIter, error) {
+	data, err := jsonutil.LoadJSON[[]eth.Data](filepath.Join(f.dir, FileName(ref.Hash)))
+	if errors.Is(err, fs.ErrNotExist) {
+		if f.fallback == nil {
+			return nil, derive.NewResetError(fmt.Errorf("no batch data file for L1 block %s", ref))
+		}
+		f.log.Debug("No batch data file, falling back", "block", ref)
+		return f.fallback.OpenData(ctx, ref, batcherAddr)
+	} else if err != nil {
+		return nil, derive.NewTemporaryError(fmt.Errorf("failed to read batch data of L1 block %s: %w", ref, err))
+	}
+	return &dataIter{data: *data}, nil
+}
+
+type dataIter struct {
+	data []eth.Data
+}
+
+func (d *dataIter) Next(_ context.Context) (eth.Data, error) {
+	if len(d.data) == 0 {
+		return nil, io.EOF
+	}
+	next := d.data[0]
+	d.data = d.data[1:]
+	return next, nil
+}
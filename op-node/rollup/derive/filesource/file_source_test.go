@@ -0,0 +1,84 @@
+package filesource
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubFallback struct {
+	opened []eth.L1BlockRef
+}
+
+func (s *stubFallback) OpenData(_ context.Context, ref eth.L1BlockRef, _ common.Address) (derive.DataIter, error) {
+	s.opened = append(s.opened, ref)
+	return &dataIter{data: []eth.Data{{0xff}}}, nil
+}
+
+func TestFileDataSource(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	dir := t.TempDir()
+	withFile := eth.L1BlockRef{Hash: common.Hash{0xaa}, Number: 10}
+	withoutFile := eth.L1BlockRef{Hash: common.Hash{0xbb}, Number: 11}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, FileName(withFile.Hash)), []byte(`["0x0102","0x03"]`), 0o644))
+
+	readAll := func(iter derive.DataIter) []eth.Data {
+		var out []eth.Data
+		for {
+			data, err := iter.Next(context.Background())
+			if err == io.EOF {
+				return out
+			}
+			require.NoError(t, err)
+			out = append(out, data)
+		}
+	}
+
+	t.Run("FromFile", func(t *testing.T) {
+		fallback := &stubFallback{}
+		src := NewFileDataSourceFactory(logger, dir, fallback)
+		iter, err := src.OpenData(context.Background(), withFile, common.Address{})
+		require.NoError(t, err)
+		require.Equal(t, []eth.Data{{0x01, 0x02}, {0x03}}, readAll(iter))
+		require.Empty(t, fallback.opened)
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		fallback := &stubFallback{}
+		src := NewFileDataSourceFactory(logger, dir, fallback)
+		iter, err := src.OpenData(context.Background(), withoutFile, common.Address{})
+		require.NoError(t, err)
+		require.Equal(t, []eth.Data{{0xff}}, readAll(iter))
+		require.Equal(t, []eth.L1BlockRef{withoutFile}, fallback.opened)
+	})
+
+	t.Run("NoFallback", func(t *testing.T) {
+		src := NewFileDataSourceFactory(logger, dir, nil)
+		_, err := src.OpenData(context.Background(), withoutFile, common.Address{})
+		require.ErrorIs(t, err, derive.ErrReset)
+	})
+
+	t.Run("InvalidFile", func(t *testing.T) {
+		invalid := eth.L1BlockRef{Hash: common.Hash{0xcc}, Number: 12}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, FileName(invalid.Hash)), []byte(`not json`), 0o644))
+		src := NewFileDataSourceFactory(logger, dir, nil)
+		_, err := src.OpenData(context.Background(), invalid, common.Address{})
+		require.ErrorIs(t, err, derive.ErrTemporary)
+	})
+}
+
+func TestRegistered(t *testing.T) {
+	_, arg, err := derive.LookupDataSource("file:/data/batches")
+	require.NoError(t, err)
+	require.Equal(t, "/data/batches", arg)
+}
@@ -2,6 +2,7 @@ package derive
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -20,6 +21,9 @@ type FrameQueue struct {
 	log    log.Logger
 	frames []Frame
 	prev   NextDataProvider
+
+	// lastErr is the last error parsing frames, kept for introspection of the pipeline
+	lastErr error
 }
 
 func NewFrameQueue(log log.Logger, prev NextDataProvider) *FrameQueue {
@@ -43,6 +47,7 @@ func (fq *FrameQueue) NextFrame(ctx context.Context) (Frame, error) {
 				fq.frames = append(fq.frames, new...)
 			} else {
 				fq.log.Warn("Failed to parse frames", "origin", fq.prev.Origin(), "err", err)
+				fq.lastErr = fmt.Errorf("failed to parse frames in L1 block %s: %w", fq.prev.Origin(), err)
 			}
 		}
 	}
@@ -58,5 +63,10 @@ func (fq *FrameQueue) NextFrame(ctx context.Context) (Frame, error) {
 
 func (fq *FrameQueue) Reset(_ context.Context, _ eth.L1BlockRef, _ eth.SystemConfig) error {
 	fq.frames = fq.frames[:0]
+	fq.lastErr = nil
 	return io.EOF
 }
+
+func (fq *FrameQueue) status() eth.DerivationStageStatus {
+	return stageStatus("frame_queue", fq.Origin(), len(fq.frames), fq.lastErr)
+}
@@ -0,0 +1,152 @@
+package derive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// fuzzRollupCfg is the rollup config of the pipeline fuzz targets, with all forks affecting
+// frame and batch parsing active.
+func fuzzRollupCfg() *rollup.Config {
+	cfg := rollupCfg
+	zero := uint64(0)
+	cfg.CanyonTime = &zero
+	cfg.DeltaTime = &zero
+	cfg.EcotoneTime = &zero
+	cfg.FjordTime = &zero
+	cfg.ChannelTimeoutBedrock = 300
+	return &cfg
+}
+
+// channelTxData returns the batcher transaction data of a span batch channel of random blocks,
+// as it is submitted by the batcher, with the frames of the channel in a single transaction.
+func channelTxData(t testing.TB, rng *rand.Rand, algo CompressionAlgo, numBatches int, maxFrameSize uint64) []byte {
+	cfg := fuzzRollupCfg()
+	cout, err := NewSpanChannelOut(cfg.Genesis.L2Time, cfg.L2ChainID, 100_000, algo, rollup.NewChainSpec(cfg))
+	require.NoError(t, err)
+	for i := 0; i < numBatches; i++ {
+		batch := RandomSingularBatch(rng, 1+rng.Intn(3), cfg.L2ChainID)
+		batch.Timestamp = cfg.Genesis.L2Time + cfg.BlockTime*uint64(i+1)
+		require.NoError(t, cout.AddSingularBatch(batch, 0))
+	}
+	require.NoError(t, cout.Close())
+
+	var buf bytes.Buffer
+	buf.WriteByte(DerivationVersion0)
+	for {
+		_, err := cout.OutputFrame(&buf, maxFrameSize)
+		if err == io.EOF {
+			return buf.Bytes()
+		}
+		require.NoError(t, err)
+	}
+}
+
+// addChannelSeeds seeds the corpus with batcher transaction data of each compression algorithm.
+// The seed corpus in testdata/fuzz additionally holds batcher transactions submitted by the batcher,
+// which can be regenerated with TestWriteDerivationFuzzCorpus of op-e2e.
+func addChannelSeeds(f *testing.F) {
+	rng := rand.New(rand.NewSource(0x5eed))
+	for _, algo := range CompressionAlgos {
+		f.Add(channelTxData(f, rng, algo, 1, 100_000))
+		f.Add(channelTxData(f, rng, algo, 4, 300))
+	}
+}
+
+// FuzzFrameParsing asserts that frames parsed from batcher transaction data encode back to the same data.
+func FuzzFrameParsing(f *testing.F) {
+	addChannelSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		frames, err := ParseFrames(data)
+		if err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		buf.WriteByte(DerivationVersion0)
+		for _, frame := range frames {
+			require.NoError(t, frame.MarshalBinary(&buf))
+		}
+		require.Equal(t, data, buf.Bytes())
+	})
+}
+
+// FuzzSpanBatchDecode asserts that decoding arbitrary span batch data does not panic,
+// and that decoded span batches survive an encoding round trip.
+func FuzzSpanBatchDecode(f *testing.F) {
+	cfg := fuzzRollupCfg()
+	rng := rand.New(rand.NewSource(0x5eed))
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		require.NoError(f, RandomRawSpanBatch(rng, cfg.L2ChainID).encode(&buf))
+		f.Add(buf.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var batch RawSpanBatch
+		if err := batch.decode(bytes.NewReader(data)); err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		require.NoError(t, batch.encode(&buf))
+		var decoded RawSpanBatch
+		require.NoError(t, decoded.decode(bytes.NewReader(buf.Bytes())))
+		require.Equal(t, batch, decoded)
+
+		// Deriving may fail on invalid transactions, but must not panic
+		_, _ = batch.derive(cfg.BlockTime, cfg.Genesis.L2Time, cfg.L2ChainID)
+	})
+}
+
+// FuzzChannelBank feeds the frames of arbitrary batcher transaction data through the channel bank,
+// and reads the batches of all completed channels.
+func FuzzChannelBank(f *testing.F) {
+	addChannelSeeds(f)
+	cfg := fuzzRollupCfg()
+	spec := rollup.NewChainSpec(cfg)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		frames, err := ParseFrames(data)
+		if err != nil {
+			return
+		}
+		input := &fakeChannelBankInput{origin: testutils.RandomBlockRef(rand.New(rand.NewSource(0)))}
+		for _, frame := range frames {
+			input.AddFrame(frame, nil)
+		}
+		input.AddFrame(Frame{}, io.EOF)
+		cb := NewChannelBank(testlog.Logger(t, log.LevelCrit), cfg, input, nil, metrics.NoopMetrics)
+
+		for {
+			channel, err := cb.NextData(context.Background())
+			if err == io.EOF {
+				return
+			} else if errors.Is(err, NotEnoughData) {
+				continue
+			}
+			require.NoError(t, err)
+			readBatch, err := BatchReader(bytes.NewReader(channel), spec.MaxRLPBytesPerChannel(input.origin.Time), true, WithZstd(nil))
+			if err != nil {
+				continue
+			}
+			for {
+				batchData, err := readBatch()
+				if err != nil {
+					break
+				}
+				if batchData.GetBatchType() == SpanBatchType {
+					_, _ = DeriveSpanBatch(batchData, cfg.BlockTime, cfg.Genesis.L2Time, cfg.L2ChainID)
+				}
+			}
+		}
+	})
+}
@@ -5,16 +5,11 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
-type DataAvailabilitySource interface {
-	OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error)
-}
-
 type NextBlockProvider interface {
 	NextL1Block(context.Context) (eth.L1BlockRef, error)
 	Origin() eth.L1BlockRef
@@ -23,15 +18,17 @@ type NextBlockProvider interface {
 
 type L1Retrieval struct {
 	log     log.Logger
-	dataSrc DataAvailabilitySource
+	dataSrc DataSourceFactory
 	prev    NextBlockProvider
 
 	datas DataIter
+
+	receipts *receiptTracker
 }
 
 var _ ResettableStage = (*L1Retrieval)(nil)
 
-func NewL1Retrieval(log log.Logger, dataSrc DataAvailabilitySource, prev NextBlockProvider) *L1Retrieval {
+func NewL1Retrieval(log log.Logger, dataSrc DataSourceFactory, prev NextBlockProvider) *L1Retrieval {
 	return &L1Retrieval{
 		log:     log,
 		dataSrc: dataSrc,
@@ -68,6 +65,9 @@ func (l1r *L1Retrieval) NextData(ctx context.Context) ([]byte, error) {
 		// CalldataSource appropriately wraps the error so avoid double wrapping errors here.
 		return nil, err
 	} else {
+		if l1r.receipts.active() {
+			l1r.receipts.onData(l1r.Origin().ID(), l1r.datas)
+		}
 		return data, nil
 	}
 }
@@ -44,7 +44,7 @@ func (m *MockDataSource) ExpectOpenData(ref eth.L1BlockRef, iter DataIter, batch
 	m.Mock.On("OpenData", ref, batcherAddr).Return(iter)
 }
 
-var _ DataAvailabilitySource = (*MockDataSource)(nil)
+var _ DataSourceFactory = (*MockDataSource)(nil)
 
 type MockL1Traversal struct {
 	mock.Mock
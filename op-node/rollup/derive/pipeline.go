@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -64,7 +66,13 @@ type DerivationPipeline struct {
 	// Special stages to keep track of
 	traversal *L1Traversal
 
-	attrib *AttributesQueue
+	frameQueue *FrameQueue
+	bank       *ChannelBank
+	chInReader *ChannelInReader
+	batchQueue *BatchQueue
+	attrib     *AttributesQueue
+
+	receipts *receiptTracker
 
 	// L1 block that the next returned attributes are derived from, i.e. at the L2-end of the pipeline.
 	origin         eth.L1BlockRef
@@ -72,16 +80,51 @@ type DerivationPipeline struct {
 	resetSysConfig eth.SystemConfig
 	engineIsReset  bool
 
+	// lastErr is the last error that interrupted a step
+	lastErr error
+
+	// status is a snapshot of the pipeline, updated after every step, so it can be read concurrently.
+	statusLock sync.Mutex
+	status     eth.DerivationPipelineStatus
+
 	metrics Metrics
 }
 
+// PipelineOption customizes a DerivationPipeline.
+type PipelineOption func(opts *pipelineOptions)
+
+type pipelineOptions struct {
+	dataSource    DataSourceCreator
+	dataSourceArg string
+}
+
+// WithDataSource makes the pipeline retrieve the batch data of L1 blocks from a custom data source,
+// instead of the L1 transactions and blobs.
+func WithDataSource(creator DataSourceCreator, arg string) PipelineOption {
+	return func(opts *pipelineOptions) {
+		opts.dataSource = creator
+		opts.dataSourceArg = arg
+	}
+}
+
 // NewDerivationPipeline creates a DerivationPipeline, to turn L1 data into L2 block-inputs.
 func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L1Fetcher, l1Blobs L1BlobsFetcher,
-	altDA AltDAInputFetcher, l2Source L2Source, metrics Metrics) *DerivationPipeline {
+	altDA AltDAInputFetcher, l2Source L2Source, metrics Metrics, opts ...PipelineOption) *DerivationPipeline {
+	options := pipelineOptions{dataSource: newL1DataSource}
+	for _, opt := range opts {
+		opt(&options)
+	}
 
 	// Pull stages
 	l1Traversal := NewL1Traversal(log, rollupCfg, l1Fetcher)
-	dataSrc := NewDataSourceFactory(log, rollupCfg, l1Fetcher, l1Blobs, altDA) // auxiliary stage for L1Retrieval
+	dataSrc := options.dataSource(DataSourceDeps{ // auxiliary stage for L1Retrieval
+		Log:     log,
+		Cfg:     rollupCfg,
+		L1:      l1Fetcher,
+		L1Blobs: l1Blobs,
+		AltDA:   altDA,
+		Arg:     options.dataSourceArg,
+	})
 	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
 	frameQueue := NewFrameQueue(log, l1Src)
 	bank := NewChannelBank(log, rollupCfg, frameQueue, l1Fetcher, metrics)
@@ -90,23 +133,50 @@ func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L
 	attrBuilder := NewFetchingAttributesBuilder(rollupCfg, l1Fetcher, l2Source)
 	attributesQueue := NewAttributesQueue(log, rollupCfg, attrBuilder, batchQueue)
 
+	receipts := newReceiptTracker()
+	l1Src.receipts = receipts
+	bank.receipts = receipts
+	batchQueue.receipts = receipts
+	attrBuilder.receipts = receipts
+
 	// Reset from ResetEngine then up from L1 Traversal. The stages do not talk to each other during
 	// the ResetEngine, but after the ResetEngine, this is the order in which the stages could talk to each other.
 	// Note: The ResetEngine is the only reset that can fail.
 	stages := []ResettableStage{l1Traversal, l1Src, altDA, frameQueue, bank, chInReader, batchQueue, attributesQueue}
 
-	return &DerivationPipeline{
-		log:       log,
-		rollupCfg: rollupCfg,
-		l1Fetcher: l1Fetcher,
-		altDA:     altDA,
-		resetting: 0,
-		stages:    stages,
-		metrics:   metrics,
-		traversal: l1Traversal,
-		attrib:    attributesQueue,
-		l2:        l2Source,
+	dp := &DerivationPipeline{
+		log:        log,
+		rollupCfg:  rollupCfg,
+		l1Fetcher:  l1Fetcher,
+		altDA:      altDA,
+		resetting:  0,
+		stages:     stages,
+		metrics:    metrics,
+		traversal:  l1Traversal,
+		frameQueue: frameQueue,
+		bank:       bank,
+		chInReader: chInReader,
+		batchQueue: batchQueue,
+		attrib:     attributesQueue,
+		receipts:   receipts,
+		l2:         l2Source,
 	}
+	dp.updateStatus()
+	return dp
+}
+
+// EnableDerivationReceipts makes the pipeline track the L1 data every L2 block is derived from,
+// to be retrieved with DerivationReceipt. It must be called before the pipeline is used.
+func (dp *DerivationPipeline) EnableDerivationReceipts() {
+	dp.receipts.enabled = true
+}
+
+// DerivationReceipt returns the receipt of the L1 data the L2 block was derived from,
+// if the block was derived by this pipeline and receipts are enabled.
+// Receipts of the block and of all prior blocks are forgotten, it is meant to be called once the block is safe.
+// The DerivedFrom field is left to the caller, as the block becomes safe after it leaves the pipeline.
+func (dp *DerivationPipeline) DerivationReceipt(ref eth.L2BlockRef) (*eth.DerivationReceipt, bool) {
+	return dp.receipts.take(ref)
 }
 
 // DerivationReady returns true if the derivation pipeline is ready to be used.
@@ -120,6 +190,9 @@ func (dp *DerivationPipeline) Reset() {
 	dp.resetSysConfig = eth.SystemConfig{}
 	dp.resetL2Safe = eth.L2BlockRef{}
 	dp.engineIsReset = false
+	dp.receipts.reset()
+	dp.lastErr = nil
+	dp.updateStatus()
 }
 
 // Origin is the L1 block of the inner-most stage of the derivation pipeline,
@@ -141,7 +214,13 @@ func (dp *DerivationPipeline) Step(ctx context.Context, pendingSafeHead eth.L2Bl
 	defer func() {
 		if outErr == io.EOF || errors.Is(outErr, EngineELSyncing) {
 			dp.metrics.SetDerivationIdle(true)
+		} else if outErr != nil && !errors.Is(outErr, NotEnoughData) {
+			dp.lastErr = outErr
+		} else if outAttrib != nil {
+			// derivation recovered from any previous error
+			dp.lastErr = nil
 		}
+		dp.updateStatus()
 	}()
 
 	// if any stages need to be reset, do that first.
@@ -241,3 +320,41 @@ func (dp *DerivationPipeline) initialReset(ctx context.Context, resetL2Safe eth.
 func (dp *DerivationPipeline) ConfirmEngineReset() {
 	dp.engineIsReset = true
 }
+
+// Status returns a snapshot of the pipeline as of the last step: the origin,
+// and the queue depth and last skipped invalid data of every buffering stage.
+// It is safe to call concurrently with the pipeline being stepped.
+func (dp *DerivationPipeline) Status() eth.DerivationPipelineStatus {
+	dp.statusLock.Lock()
+	defer dp.statusLock.Unlock()
+	status := dp.status
+	status.Stages = slices.Clone(status.Stages)
+	return status
+}
+
+func (dp *DerivationPipeline) updateStatus() {
+	status := eth.DerivationPipelineStatus{
+		Origin: dp.origin,
+		Ready:  dp.DerivationReady(),
+		Stages: []eth.DerivationStageStatus{
+			dp.frameQueue.status(),
+			dp.bank.status(),
+			dp.chInReader.status(),
+			dp.batchQueue.status(),
+		},
+	}
+	if dp.lastErr != nil {
+		status.LastError = dp.lastErr.Error()
+	}
+	dp.statusLock.Lock()
+	defer dp.statusLock.Unlock()
+	dp.status = status
+}
+
+func stageStatus(name string, origin eth.L1BlockRef, depth int, lastErr error) eth.DerivationStageStatus {
+	status := eth.DerivationStageStatus{Name: name, Origin: origin, QueueDepth: uint64(depth)}
+	if lastErr != nil {
+		status.LastError = lastErr.Error()
+	}
+	return status
+}
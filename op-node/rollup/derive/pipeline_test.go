@@ -1,7 +1,63 @@
 package derive
 
-import "github.com/ethereum-optimism/optimism/op-service/testutils"
+import (
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
 
 var _ L1Fetcher = (*testutils.MockL1Source)(nil)
 
 var _ Metrics = (*testutils.TestDerivationMetrics)(nil)
+
+func TestDerivationPipelineStatus(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	cfg := &rollup.Config{ChannelTimeoutBedrock: 10}
+	dp := NewDerivationPipeline(testlog.Logger(t, log.LevelCrit), cfg, &testutils.MockL1Source{}, nil, nil,
+		&testutils.MockL2Client{}, metrics.NoopMetrics)
+
+	status := dp.Status()
+	require.False(t, status.Ready)
+	require.Empty(t, status.LastError)
+	require.Len(t, status.Stages, 4)
+	for i, name := range []string{"frame_queue", "channel_bank", "channel_in_reader", "batch_queue"} {
+		require.Equal(t, name, status.Stages[i].Name)
+		require.Zero(t, status.Stages[i].QueueDepth)
+		require.Empty(t, status.Stages[i].LastError)
+	}
+
+	// Stepping before the engine is reset fails, which is reported as the last error
+	_, err := dp.Step(context.Background(), testutils.RandomL2BlockRef(rng))
+	require.Error(t, err)
+	require.Equal(t, err.Error(), dp.Status().LastError)
+
+	dp.frameQueue.frames = []Frame{{}, {}}
+	dp.bank.IngestFrame(testFrame("a:0:first").ToFrame())
+	dp.bank.IngestFrame(testFrame("a:0:first").ToFrame())
+	dp.updateStatus()
+	status = dp.Status()
+	require.Equal(t, uint64(2), status.Stages[0].QueueDepth)
+	require.Equal(t, uint64(1), status.Stages[1].QueueDepth)
+	require.Contains(t, status.Stages[1].LastError, "failed to ingest frame 0 of channel")
+
+	// The status is a snapshot, which is not modified by later updates
+	status.Stages[0].QueueDepth = 5
+	require.Equal(t, uint64(2), dp.Status().Stages[0].QueueDepth)
+
+	// Errors from before a reset are not reported anymore after the reset
+	dp.Reset()
+	require.Empty(t, dp.Status().LastError)
+	require.Equal(t, io.EOF, dp.bank.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{}))
+	dp.updateStatus()
+	require.Empty(t, dp.Status().Stages[1].LastError)
+}
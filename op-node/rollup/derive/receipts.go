@@ -0,0 +1,209 @@
+package derive
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// txDataIter is implemented by data iterators that know the L1 transaction
+// of the data that was last returned by Next.
+type txDataIter interface {
+	TxHash() common.Hash
+}
+
+// channelReceipt records the frames of a channel.
+type channelReceipt struct {
+	id     ChannelID
+	frames []eth.BatcherFrameRef
+}
+
+// blockReceipt records what an L2 block is derived from, before the block is safe.
+type blockReceipt struct {
+	channel    *channelReceipt
+	blockIndex uint64
+	blockCount uint64
+	deposits   []eth.DepositRef
+}
+
+// receiptTracker follows batcher data through the pipeline stages, to produce a derivation receipt
+// for every derived L2 block. Stages hold a nil tracker when receipts are not tracked.
+//
+// Stages are pulled in order, so the tracker only needs to remember the latest data read from L1,
+// and the latest channel read from the channel bank, to attribute frames and batches.
+type receiptTracker struct {
+	mu      sync.Mutex
+	enabled bool
+
+	// data source of the frames currently ingested by the channel bank
+	dataL1Block eth.BlockID
+	dataTxHash  common.Hash
+
+	// channels being assembled in the channel bank
+	channels map[ChannelID]*channelReceipt
+	// channel that batches are currently read from
+	current *channelReceipt
+
+	// receipts by L2 block number, of blocks that were derived but are not yet safe
+	blocks map[uint64]*blockReceipt
+}
+
+func newReceiptTracker() *receiptTracker {
+	return &receiptTracker{
+		channels: make(map[ChannelID]*channelReceipt),
+		blocks:   make(map[uint64]*blockReceipt),
+	}
+}
+
+func (t *receiptTracker) active() bool {
+	return t != nil && t.enabled
+}
+
+func (t *receiptTracker) onData(l1Block eth.BlockID, datas DataIter) {
+	if !t.active() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dataL1Block = l1Block
+	t.dataTxHash = common.Hash{}
+	if iter, ok := datas.(txDataIter); ok {
+		t.dataTxHash = iter.TxHash()
+	}
+}
+
+func (t *receiptTracker) onFrame(f Frame) {
+	if !t.active() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ch, ok := t.channels[f.ID]
+	if !ok {
+		ch = &channelReceipt{id: f.ID}
+		t.channels[f.ID] = ch
+	}
+	ch.frames = append(ch.frames, eth.BatcherFrameRef{
+		L1Block:     t.dataL1Block,
+		TxHash:      t.dataTxHash,
+		FrameNumber: f.FrameNumber,
+	})
+}
+
+// onChannelRead marks the channel as the source of the next batches.
+func (t *receiptTracker) onChannelRead(id ChannelID) {
+	if !t.active() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.current = t.channels[id]
+	delete(t.channels, id)
+}
+
+func (t *receiptTracker) onChannelDropped(id ChannelID) {
+	if !t.active() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.channels, id)
+}
+
+// currentChannel returns the channel batches are currently read from.
+func (t *receiptTracker) currentChannel() *channelReceipt {
+	if !t.active() {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.current
+}
+
+// onBatch records the channel of the batch of the count blocks following the parent block.
+// The channel is nil for batches generated because the sequencing window expired.
+func (t *receiptTracker) onBatch(parent eth.L2BlockRef, channel *channelReceipt, count int) {
+	if !t.active() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := 0; i < count; i++ {
+		t.blocks[parent.Number+1+uint64(i)] = &blockReceipt{
+			channel:    channel,
+			blockIndex: uint64(i),
+			blockCount: uint64(count),
+		}
+	}
+}
+
+// onDeposits records the user deposits of the block following the parent block.
+func (t *receiptTracker) onDeposits(parent eth.L2BlockRef, receipts types.Receipts, depositContractAddr common.Address) {
+	if !t.active() {
+		return
+	}
+	var deposits []eth.DepositRef
+	for _, rec := range receipts {
+		if rec.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, log := range rec.Logs {
+			if log.Address == depositContractAddr && len(log.Topics) > 0 && log.Topics[0] == DepositEventABIHash {
+				deposits = append(deposits, eth.DepositRef{TxHash: rec.TxHash, LogIndex: log.Index})
+			}
+		}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if b, ok := t.blocks[parent.Number+1]; ok {
+		b.deposits = deposits
+	} else {
+		t.blocks[parent.Number+1] = &blockReceipt{deposits: deposits}
+	}
+}
+
+// take returns the receipt of the block, and forgets the receipts of the block and all prior blocks.
+func (t *receiptTracker) take(ref eth.L2BlockRef) (*eth.DerivationReceipt, bool) {
+	if !t.active() {
+		return nil, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b, ok := t.blocks[ref.Number]
+	for num := range t.blocks {
+		if num <= ref.Number {
+			delete(t.blocks, num)
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+	receipt := &eth.DerivationReceipt{
+		L2Block:         ref.ID(),
+		L1Origin:        ref.L1Origin,
+		BatchBlockIndex: b.blockIndex,
+		BatchBlockCount: b.blockCount,
+		Deposits:        b.deposits,
+	}
+	if b.channel != nil {
+		receipt.ChannelID = b.channel.id[:]
+		receipt.Frames = b.channel.frames
+	}
+	return receipt, true
+}
+
+func (t *receiptTracker) reset() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dataL1Block = eth.BlockID{}
+	t.dataTxHash = common.Hash{}
+	t.channels = make(map[ChannelID]*channelReceipt)
+	t.current = nil
+	t.blocks = make(map[uint64]*blockReceipt)
+}
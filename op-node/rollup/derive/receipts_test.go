@@ -0,0 +1,130 @@
+package derive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type stubTxDataIter struct {
+	txHash common.Hash
+}
+
+func (s *stubTxDataIter) Next(_ context.Context) (eth.Data, error) {
+	return nil, nil
+}
+
+func (s *stubTxDataIter) TxHash() common.Hash {
+	return s.txHash
+}
+
+func TestReceiptTracker_Disabled(t *testing.T) {
+	var nilTracker *receiptTracker
+	nilTracker.onFrame(Frame{ID: ChannelID{0x01}})
+	nilTracker.onChannelRead(ChannelID{0x01})
+	nilTracker.onBatch(eth.L2BlockRef{}, nil, 1)
+	nilTracker.reset()
+	_, ok := nilTracker.take(eth.L2BlockRef{Number: 1})
+	require.False(t, ok)
+
+	tracker := newReceiptTracker()
+	tracker.onFrame(Frame{ID: ChannelID{0x01}})
+	tracker.onChannelRead(ChannelID{0x01})
+	require.Nil(t, tracker.currentChannel())
+	tracker.onBatch(eth.L2BlockRef{}, nil, 1)
+	_, ok = tracker.take(eth.L2BlockRef{Number: 1})
+	require.False(t, ok)
+}
+
+func TestReceiptTracker(t *testing.T) {
+	depositContract := common.Address{0xde}
+	tracker := newReceiptTracker()
+	tracker.enabled = true
+
+	l1A := eth.BlockID{Hash: common.Hash{0x0a}, Number: 100}
+	l1B := eth.BlockID{Hash: common.Hash{0x0b}, Number: 101}
+	chID := ChannelID{0xc1}
+	otherID := ChannelID{0xc2}
+
+	// Frames of the channel are spread over two L1 transactions, interleaved with another channel
+	tracker.onData(l1A, &stubTxDataIter{txHash: common.Hash{0x01}})
+	tracker.onFrame(Frame{ID: chID, FrameNumber: 0})
+	tracker.onFrame(Frame{ID: otherID, FrameNumber: 0})
+	tracker.onData(l1B, &stubTxDataIter{txHash: common.Hash{0x02}})
+	tracker.onFrame(Frame{ID: chID, FrameNumber: 1})
+
+	tracker.onChannelDropped(otherID)
+	tracker.onChannelRead(chID)
+	channel := tracker.currentChannel()
+	require.NotNil(t, channel)
+	require.Equal(t, chID, channel.id)
+	require.Empty(t, tracker.channels)
+
+	parent := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10}
+	// a span batch of three blocks
+	tracker.onBatch(parent, channel, 3)
+	tracker.onDeposits(parent, types.Receipts{
+		{
+			Status: types.ReceiptStatusSuccessful,
+			TxHash: common.Hash{0x03},
+			Logs: []*types.Log{
+				{Address: depositContract, Topics: []common.Hash{DepositEventABIHash}, Index: 4},
+				{Address: common.Address{0x01}, Topics: []common.Hash{DepositEventABIHash}, Index: 5},
+			},
+		},
+		{
+			Status: types.ReceiptStatusFailed,
+			TxHash: common.Hash{0x04},
+			Logs: []*types.Log{
+				{Address: depositContract, Topics: []common.Hash{DepositEventABIHash}, Index: 6},
+			},
+		},
+	}, depositContract)
+
+	block11 := eth.L2BlockRef{Hash: common.Hash{0xbb}, Number: 11, L1Origin: l1A}
+	receipt, ok := tracker.take(block11)
+	require.True(t, ok)
+	require.Equal(t, &eth.DerivationReceipt{
+		L2Block:   block11.ID(),
+		L1Origin:  l1A,
+		ChannelID: chID[:],
+		Frames: []eth.BatcherFrameRef{
+			{L1Block: l1A, TxHash: common.Hash{0x01}, FrameNumber: 0},
+			{L1Block: l1B, TxHash: common.Hash{0x02}, FrameNumber: 1},
+		},
+		BatchBlockIndex: 0,
+		BatchBlockCount: 3,
+		Deposits:        []eth.DepositRef{{TxHash: common.Hash{0x03}, LogIndex: 4}},
+	}, receipt)
+
+	// Taking a later block forgets the receipts of prior blocks
+	block13 := eth.L2BlockRef{Hash: common.Hash{0xcc}, Number: 13, L1Origin: l1A}
+	receipt, ok = tracker.take(block13)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), receipt.BatchBlockIndex)
+	require.Empty(t, receipt.Deposits)
+	_, ok = tracker.take(eth.L2BlockRef{Number: 12})
+	require.False(t, ok)
+
+	// Batches generated for an expired sequencing window have no channel
+	tracker.onBatch(block13, nil, 1)
+	block14 := eth.L2BlockRef{Hash: common.Hash{0xdd}, Number: 14, L1Origin: l1B}
+	receipt, ok = tracker.take(block14)
+	require.True(t, ok)
+	require.Nil(t, receipt.ChannelID)
+	require.Nil(t, receipt.Frames)
+	require.Equal(t, uint64(1), receipt.BatchBlockCount)
+
+	tracker.onFrame(Frame{ID: chID})
+	tracker.onBatch(block14, channel, 1)
+	tracker.reset()
+	require.Nil(t, tracker.currentChannel())
+	require.Empty(t, tracker.channels)
+	_, ok = tracker.take(eth.L2BlockRef{Number: 15})
+	require.False(t, ok)
+}
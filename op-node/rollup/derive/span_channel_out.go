@@ -27,6 +27,8 @@ type SpanChannelOut struct {
 	lastCompressedRLPSize int
 	// the compressor for the channel
 	compressor ChannelCompressor
+	// compressorOpts customize the compressor of the channel
+	compressorOpts []ChannelCompressorOption
 	// target is the target size of the compressed data
 	target uint64
 	// closed indicates if the channel is closed
@@ -65,6 +67,13 @@ func WithMaxBlocksPerSpanBatch(maxBlock int) SpanChannelOutOption {
 	}
 }
 
+// WithCompressorOptions customizes the channel compressor of the SpanChannelOut.
+func WithCompressorOptions(opts ...ChannelCompressorOption) SpanChannelOutOption {
+	return func(co *SpanChannelOut) {
+		co.compressorOpts = append(co.compressorOpts, opts...)
+	}
+}
+
 func NewSpanChannelOut(genesisTimestamp uint64, chainID *big.Int, targetOutputSize uint64, compressionAlgo CompressionAlgo, chainSpec *rollup.ChainSpec, opts ...SpanChannelOutOption) (*SpanChannelOut, error) {
 	c := &SpanChannelOut{
 		id:        ChannelID{},
@@ -79,14 +88,14 @@ func NewSpanChannelOut(genesisTimestamp uint64, chainID *big.Int, targetOutputSi
 		return nil, err
 	}
 
-	if c.compressor, err = NewChannelCompressor(compressionAlgo); err != nil {
-		return nil, err
-	}
-
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if c.compressor, err = NewChannelCompressor(compressionAlgo, c.compressorOpts...); err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -0,0 +1,34 @@
+package derive
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+// checkSystemDeposits checks that the system deposits of a block, i.e. the L1 info and network upgrade deposits,
+// only call predeploys that are active at the L2 time of the block. Contract creations are not checked.
+func checkSystemDeposits(cfg *rollup.Config, l2Time uint64, txs []hexutil.Bytes) error {
+	for i, data := range txs {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return fmt.Errorf("failed to decode system deposit %d: %w", i, err)
+		}
+		if tx.To() == nil {
+			continue
+		}
+		p, ok := predeploys.ByAddress(*tx.To())
+		if !ok {
+			return fmt.Errorf("system deposit %d with source %s calls %s, which is not a predeploy", i, tx.SourceHash(), tx.To())
+		}
+		if !cfg.IsForkActive(rollup.ForkName(p.Fork), l2Time) {
+			return fmt.Errorf("system deposit %d with source %s calls predeploy %s, which is not active before %s",
+				i, tx.SourceHash(), p.Name, p.Fork)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package derive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+func systemDeposit(t *testing.T, to *common.Address) hexutil.Bytes {
+	source := UpgradeDepositSource{Intent: "test"}
+	data, err := types.NewTx(&types.DepositTx{
+		SourceHash: source.SourceHash(),
+		From:       L1InfoDepositerAddress,
+		To:         to,
+		Gas:        50_000,
+	}).MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+func TestCheckSystemDeposits(t *testing.T) {
+	interopTime := uint64(1000)
+	cfg := &rollup.Config{InteropTime: &interopTime}
+
+	t.Run("UpgradeTxs", func(t *testing.T) {
+		ecotone, err := EcotoneNetworkUpgradeTransactions()
+		require.NoError(t, err)
+		fjord, err := FjordNetworkUpgradeTransactions()
+		require.NoError(t, err)
+		require.NoError(t, checkSystemDeposits(cfg, 0, append(ecotone, fjord...)))
+	})
+
+	t.Run("ContractCreation", func(t *testing.T) {
+		require.NoError(t, checkSystemDeposits(cfg, 0, []hexutil.Bytes{systemDeposit(t, nil)}))
+	})
+
+	t.Run("NotAPredeploy", func(t *testing.T) {
+		to := common.Address{0xaa}
+		err := checkSystemDeposits(cfg, 0, []hexutil.Bytes{systemDeposit(t, &to)})
+		require.ErrorContains(t, err, "not a predeploy")
+	})
+
+	t.Run("PredeployNotActive", func(t *testing.T) {
+		txs := []hexutil.Bytes{systemDeposit(t, &predeploys.CrossL2InboxAddr)}
+		require.ErrorContains(t, checkSystemDeposits(cfg, interopTime-1, txs), "not active before interop")
+		require.NoError(t, checkSystemDeposits(cfg, interopTime, txs))
+
+		// Predeploys of any scheduled fork are resolved through the fork's activation time
+		txs = []hexutil.Bytes{systemDeposit(t, &predeploys.Create2DeployerAddr)}
+		require.ErrorContains(t, checkSystemDeposits(cfg, interopTime, txs), "not active before canyon")
+		canyonTime := uint64(10)
+		require.NoError(t, checkSystemDeposits(&rollup.Config{CanyonTime: &canyonTime}, canyonTime, txs))
+	})
+
+	t.Run("InvalidTx", func(t *testing.T) {
+		require.ErrorContains(t, checkSystemDeposits(cfg, 0, []hexutil.Bytes{{0x7e, 0x01}}), "failed to decode")
+	})
+}
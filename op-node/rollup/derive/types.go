@@ -14,6 +14,11 @@ const (
 	Brotli9  CompressionAlgo = "brotli-9"
 	Brotli10 CompressionAlgo = "brotli-10"
 	Brotli11 CompressionAlgo = "brotli-11"
+	Zstd     CompressionAlgo = "zstd" // default level
+	Zstd1    CompressionAlgo = "zstd-1"
+	Zstd3    CompressionAlgo = "zstd-3"
+	Zstd9    CompressionAlgo = "zstd-9"
+	Zstd19   CompressionAlgo = "zstd-19"
 )
 
 var CompressionAlgos = []CompressionAlgo{
@@ -22,9 +27,17 @@ var CompressionAlgos = []CompressionAlgo{
 	Brotli9,
 	Brotli10,
 	Brotli11,
+	Zstd,
+	Zstd1,
+	Zstd3,
+	Zstd9,
+	Zstd19,
 }
 
-var brotliRegexp = regexp.MustCompile(`^brotli(|-(9|10|11))$`)
+var (
+	brotliRegexp = regexp.MustCompile(`^brotli(|-(9|10|11))$`)
+	zstdRegexp   = regexp.MustCompile(`^zstd(|-(1|3|9|19))$`)
+)
 
 func (algo CompressionAlgo) String() string {
 	return string(algo)
@@ -60,6 +73,27 @@ func GetBrotliLevel(algo CompressionAlgo) int {
 	}
 }
 
+func (algo *CompressionAlgo) IsZstd() bool {
+	return zstdRegexp.MatchString(algo.String())
+}
+
+// GetZstdLevel returns the zstd reference level of the algo.
+// The encoder applies the closest of its speed settings to the level.
+func GetZstdLevel(algo CompressionAlgo) int {
+	switch algo {
+	case Zstd1:
+		return 1
+	case Zstd3:
+		return 3
+	case Zstd9, Zstd: // make level 9 the default
+		return 9
+	case Zstd19:
+		return 19
+	default:
+		panic("Unsupported zstd level")
+	}
+}
+
 func ValidCompressionAlgo(value CompressionAlgo) bool {
 	for _, k := range CompressionAlgos {
 		if k == value {
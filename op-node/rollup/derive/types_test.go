@@ -13,6 +13,8 @@ func TestCompressionAlgo(t *testing.T) {
 		isValidCompressionAlgoType bool
 		isBrotli                   bool
 		brotliLevel                int
+		isZstd                     bool
+		zstdLevel                  int
 	}{
 		{
 			name:                       "zlib",
@@ -48,6 +50,32 @@ func TestCompressionAlgo(t *testing.T) {
 			isBrotli:                   true,
 			brotliLevel:                11,
 		},
+		{
+			name:                       "zstd",
+			algo:                       Zstd,
+			isValidCompressionAlgoType: true,
+			isZstd:                     true,
+			zstdLevel:                  9,
+		},
+		{
+			name:                       "zstd-1",
+			algo:                       Zstd1,
+			isValidCompressionAlgoType: true,
+			isZstd:                     true,
+			zstdLevel:                  1,
+		},
+		{
+			name:                       "zstd-19",
+			algo:                       Zstd19,
+			isValidCompressionAlgoType: true,
+			isZstd:                     true,
+			zstdLevel:                  19,
+		},
+		{
+			name:                       "zstd-22",
+			algo:                       CompressionAlgo("zstd-22"),
+			isValidCompressionAlgoType: false,
+		},
 		{
 			name:                       "invalid",
 			algo:                       CompressionAlgo("invalid"),
@@ -67,6 +95,12 @@ func TestCompressionAlgo(t *testing.T) {
 			} else {
 				require.Panics(t, func() { GetBrotliLevel(tc.algo) })
 			}
+			require.Equal(t, tc.isZstd, tc.algo.IsZstd())
+			if tc.isZstd {
+				require.Equal(t, tc.zstdLevel, GetZstdLevel(tc.algo))
+			} else {
+				require.Panics(t, func() { GetZstdLevel(tc.algo) })
+			}
 			require.Equal(t, tc.isValidCompressionAlgoType, ValidCompressionAlgo(tc.algo))
 		})
 	}
@@ -1,5 +1,7 @@
 package driver
 
+import "time"
+
 type Config struct {
 	// VerifierConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
 	VerifierConfDepth uint64 `json:"verifier_conf_depth"`
@@ -20,4 +22,28 @@ type Config struct {
 	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// SequencerClockTargetDrift is the maximum desired distance between the timestamp of a new L2 block
+	// and its L1 origin, before the sequencer slows down block production. Disabled if 0.
+	SequencerClockTargetDrift time.Duration `json:"sequencer_clock_target_drift"`
+
+	// SequencerClockMaxAdjustment bounds how far the sequencer may fall behind the local wall clock
+	// when slowing down block production due to SequencerClockTargetDrift.
+	SequencerClockMaxAdjustment time.Duration `json:"sequencer_clock_max_adjustment"`
+
+	// SequencerBudgetFCU, SequencerBudgetGetPayload and SequencerBudgetPublish are the latency budgets
+	// of the stages of sequencer block building. When a block exceeds the budget, the sequencer limits
+	// the tx-pool transactions of the next block, to keep up with the block time. Not enforced if 0.
+	SequencerBudgetFCU        time.Duration `json:"sequencer_budget_fcu"`
+	SequencerBudgetGetPayload time.Duration `json:"sequencer_budget_get_payload"`
+	SequencerBudgetPublish    time.Duration `json:"sequencer_budget_publish"`
+
+	// MaxL1ReorgDepth is the max depth of L1 reorgs that is handled automatically. Sequencing and derivation halt
+	// on a deeper L1 reorg, until the operator acknowledges it. Disabled if 0.
+	MaxL1ReorgDepth uint64 `json:"max_l1_reorg_depth"`
+
+	// DataSource selects the data source that the derivation reads the batch data of L1 blocks from,
+	// as "<name>[:<arg>]" of a data source registered with derive.RegisterDataSource.
+	// The batch data is read from L1 transactions and blobs if empty.
+	DataSource string `json:"data_source"`
 }
@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -47,10 +48,12 @@ type Metrics interface {
 	RecordDerivedBatches(batchType string)
 
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
+	RecordUnsafePayloadsQuarantine(length uint64)
 
 	SetDerivationIdle(idle bool)
 
 	RecordL1ReorgDepth(d uint64)
+	RecordL1ReorgHalted(halted bool)
 
 	engine.Metrics
 	L1FetcherMetrics
@@ -76,6 +79,8 @@ type DerivationPipeline interface {
 	Origin() eth.L1BlockRef
 	DerivationReady() bool
 	ConfirmEngineReset()
+	DerivationReceipt(ref eth.L2BlockRef) (*eth.DerivationReceipt, bool)
+	Status() eth.DerivationPipelineStatus
 }
 
 type EngineController interface {
@@ -197,6 +202,9 @@ func NewDriver(
 	l1Tracker := status.NewL1Tracker(l1)
 	sys.Register("l1-blocks", l1Tracker, opts)
 
+	l1ReorgTracker := status.NewL1ReorgTracker(driverCtx, log, l1, driverCfg.MaxL1ReorgDepth)
+	sys.Register("l1-reorgs", l1ReorgTracker, opts)
+
 	l1 = NewMeteredL1Fetcher(l1Tracker, metrics)
 	verifConfDepth := confdepth.NewConfDepth(driverCfg.VerifierConfDepth, statusTracker.L1Head, l1)
 
@@ -206,7 +214,7 @@ func NewDriver(
 	sys.Register("engine-reset",
 		engine.NewEngineResetDeriver(driverCtx, log, cfg, l1, l2, syncCfg), opts)
 
-	clSync := clsync.NewCLSync(log, cfg, metrics) // alt-sync still uses cl-sync state to determine what to sync to
+	clSync := clsync.NewCLSync(log, cfg, syncCfg.UnsafeQuarantineTTL, metrics) // alt-sync still uses cl-sync state to determine what to sync to
 	sys.Register("cl-sync", clSync, opts)
 
 	var finalizer Finalizer
@@ -220,7 +228,19 @@ func NewDriver(
 	sys.Register("attributes-handler",
 		attributes.NewAttributesHandler(log, cfg, driverCtx, l2), opts)
 
-	derivationPipeline := derive.NewDerivationPipeline(log, cfg, verifConfDepth, l1Blobs, altDA, l2, metrics)
+	var pipelineOpts []derive.PipelineOption
+	if driverCfg.DataSource != "" {
+		creator, arg, err := derive.LookupDataSource(driverCfg.DataSource)
+		if err != nil {
+			// The data source is checked as part of the node config.
+			panic(fmt.Errorf("invalid derivation data source: %w", err))
+		}
+		pipelineOpts = append(pipelineOpts, derive.WithDataSource(creator, arg))
+	}
+	derivationPipeline := derive.NewDerivationPipeline(log, cfg, verifConfDepth, l1Blobs, altDA, l2, metrics, pipelineOpts...)
+	if _, ok := safeHeadListener.(rollup.DerivationReceiptListener); ok && safeHeadListener.Enabled() {
+		derivationPipeline.EnableDerivationReceipts()
+	}
 
 	sys.Register("pipeline",
 		derive.NewPipelineDeriver(driverCtx, derivationPipeline), opts)
@@ -268,6 +288,7 @@ func NewDriver(
 		drain:            drain,
 		stateReq:         make(chan chan struct{}),
 		forceReset:       make(chan chan struct{}, 10),
+		haltSig:          make(chan struct{}, 1),
 		driverConfig:     driverCfg,
 		driverCtx:        driverCtx,
 		driverCancel:     driverCancel,
@@ -278,9 +299,11 @@ func NewDriver(
 		l1HeadSig:        make(chan eth.L1BlockRef, 10),
 		l1SafeSig:        make(chan eth.L1BlockRef, 10),
 		l1FinalizedSig:   make(chan eth.L1BlockRef, 10),
+		l1Reorgs:         l1ReorgTracker.Reorgs(),
 		unsafeL2Payloads: make(chan *eth.ExecutionPayloadEnvelope, 10),
 		altSync:          altSync,
 	}
+	sys.Register("l1-reorg-halt", event.DeriverFunc(driver.onL1Reorg), opts)
 
 	return driver
 }
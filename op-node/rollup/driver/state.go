@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	gosync "sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -44,6 +45,13 @@ type Driver struct {
 	// It tells the caller that the reset occurred by closing the passed in channel.
 	forceReset chan chan struct{}
 
+	// When halted, the driver does not run sequencer actions or derivation steps.
+	halted atomic.Bool
+	// Halted on a L1 reorg deeper than the max L1 reorg depth, until acknowledged by the operator.
+	reorgHalted atomic.Bool
+	// Wakes up the event loop when the driver is halted or resumed.
+	haltSig chan struct{}
+
 	// Driver config: verifier and sequencer settings.
 	// May not be modified after starting the Driver.
 	driverConfig *Config
@@ -57,6 +65,8 @@ type Driver struct {
 	l1HeadSig      chan eth.L1BlockRef
 	l1SafeSig      chan eth.L1BlockRef
 	l1FinalizedSig chan eth.L1BlockRef
+	// L1 reorgs, detected in the background by the L1 reorg tracker.
+	l1Reorgs <-chan status.L1ReorgEvent
 
 	// Interface to signal the L2 block range to sync.
 	altSync AltSync
@@ -86,6 +96,19 @@ func (s *Driver) Start() error {
 		if err := s.sequencer.SetMaxSafeLag(s.driverCtx, s.driverConfig.SequencerMaxSafeLag); err != nil {
 			return fmt.Errorf("failed to set sequencer max safe lag: %w", err)
 		}
+		if err := s.sequencer.SetClockDiscipline(s.driverCtx, sequencing.ClockDiscipline{
+			TargetDrift:   s.driverConfig.SequencerClockTargetDrift,
+			MaxAdjustment: s.driverConfig.SequencerClockMaxAdjustment,
+		}); err != nil {
+			return fmt.Errorf("failed to set sequencer clock discipline: %w", err)
+		}
+		if err := s.sequencer.SetLatencyBudget(s.driverCtx, sequencing.LatencyBudget{
+			FCU:        s.driverConfig.SequencerBudgetFCU,
+			GetPayload: s.driverConfig.SequencerBudgetGetPayload,
+			Publish:    s.driverConfig.SequencerBudgetPublish,
+		}); err != nil {
+			return fmt.Errorf("failed to set sequencer latency budget: %w", err)
+		}
 		if err := s.sequencer.Init(s.driverCtx, !s.driverConfig.SequencerStopped); err != nil {
 			return fmt.Errorf("persist initial sequencer state: %w", err)
 		}
@@ -170,6 +193,11 @@ func (s *Driver) eventLoop() {
 	// The sequencerCh is nil (indefinitely blocks on read) if no action needs to be performed,
 	// or set to the timer channel if there is an action scheduled.
 	planSequencerAction := func() {
+		if s.isHalted() {
+			sequencerCh = nil
+			prevTime = time.Time{} // reschedule upon resuming
+			return
+		}
 		nextAction, ok := s.sequencer.NextAction()
 		if !ok {
 			if sequencerCh != nil {
@@ -217,6 +245,12 @@ func (s *Driver) eventLoop() {
 
 		planSequencerAction()
 
+		// Pending derivation steps are kept, and performed upon resuming.
+		stepCh, delayedStepCh := s.sched.NextStep(), s.sched.NextDelayedStep()
+		if s.isHalted() {
+			stepCh, delayedStepCh = nil, nil
+		}
+
 		// If the engine is not ready, or if the L2 head is actively changing, then reset the alt-sync:
 		// there is no need to request L2 blocks when we are syncing already.
 		if head := s.Engine.UnsafeL2Head(); head != lastUnsafeL2 || !s.Derivation.DerivationReady() {
@@ -226,6 +260,9 @@ func (s *Driver) eventLoop() {
 
 		select {
 		case <-sequencerCh:
+			if s.isHalted() { // halted after the action was planned
+				continue
+			}
 			s.Emitter.Emit(sequencing.SequencerActionEvent{})
 		case <-altSyncTicker.C:
 			// Check if there is a gap in the current unsafe payload queue.
@@ -265,10 +302,16 @@ func (s *Driver) eventLoop() {
 		case newL1Finalized := <-s.l1FinalizedSig:
 			s.emitter.Emit(finality.FinalizeL1Event{FinalizedL1: newL1Finalized})
 			reqStep() // we may be able to mark more L2 data as finalized now
-		case <-s.sched.NextDelayedStep():
+		case reorg := <-s.l1Reorgs:
+			s.emitter.Emit(reorg)
+		case <-delayedStepCh:
 			s.emitter.Emit(StepAttemptEvent{})
-		case <-s.sched.NextStep():
+		case <-stepCh:
 			s.emitter.Emit(StepAttemptEvent{})
+		case <-s.haltSig:
+			if !s.isHalted() {
+				reqStep()
+			}
 		case respCh := <-s.stateReq:
 			respCh <- struct{}{}
 		case respCh := <-s.forceReset:
@@ -376,6 +419,21 @@ func (s *SyncDeriver) onSafeDerivedBlock(x engine.SafeDerivedEvent) {
 			// in the execution client but failed to post process it. Reset the pipeline so the safe head rolls back
 			// a little (it always rolls back at least 1 block) and then it will retry storing the entry
 			s.Emitter.Emit(rollup.ResetEvent{Err: fmt.Errorf("safe head notifications failed: %w", err)})
+			return
+		}
+		listener, ok := s.SafeHeadNotifs.(rollup.DerivationReceiptListener)
+		if !ok {
+			return
+		}
+		receipt, ok := s.Derivation.DerivationReceipt(x.Safe)
+		if !ok {
+			// Blocks that were derived before the last pipeline reset have no receipt
+			s.Log.Debug("No derivation receipt for safe block", "safe", x.Safe)
+			return
+		}
+		receipt.DerivedFrom = x.DerivedFrom.ID()
+		if err := listener.DerivationReceiptUpdated(receipt); err != nil {
+			s.Emitter.Emit(rollup.ResetEvent{Err: fmt.Errorf("derivation receipt notification failed: %w", err)})
 		}
 	}
 }
@@ -503,15 +561,92 @@ func (s *Driver) SequencerActive(ctx context.Context) (bool, error) {
 	return s.sequencer.Active(), nil
 }
 
+func (s *Driver) SequencerStatus(ctx context.Context) (*eth.SequencerStatus, error) {
+	return s.sequencer.Status(ctx)
+}
+
+func (s *Driver) SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	return s.sequencer.SetBuildingLimits(ctx, limits)
+}
+
 func (s *Driver) OverrideLeader(ctx context.Context) error {
 	return s.sequencer.OverrideLeader(ctx)
 }
 
+// SetHalted halts or resumes the sequencing and derivation of L2 blocks.
+// Unsafe L2 payloads are still accepted while halted.
+func (s *Driver) SetHalted(halted bool) {
+	if s.halted.Swap(halted) == halted {
+		return
+	}
+	if halted {
+		s.log.Warn("Halting sequencing and derivation")
+	} else {
+		s.log.Info("Resuming sequencing and derivation")
+	}
+	select {
+	case s.haltSig <- struct{}{}:
+	default: // the event loop is already signaled
+	}
+}
+
+// Halted returns true if the sequencing and derivation of L2 blocks is halted.
+func (s *Driver) Halted() bool {
+	return s.isHalted()
+}
+
+func (s *Driver) isHalted() bool {
+	return s.halted.Load() || s.reorgHalted.Load()
+}
+
+// onL1Reorg halts sequencing and derivation when a L1 reorg deeper than the max L1 reorg depth is observed.
+func (s *Driver) onL1Reorg(ev event.Event) bool {
+	x, ok := ev.(status.L1ReorgEvent)
+	if !ok {
+		return false
+	}
+	maxDepth := s.driverConfig.MaxL1ReorgDepth
+	if maxDepth == 0 || x.Depth <= maxDepth {
+		return true
+	}
+	s.log.Error("L1 reorg deeper than the max L1 reorg depth, halting until acknowledged by the operator",
+		"depth", x.Depth, "max_depth", maxDepth, "old_l1_head", x.OldHead, "new_l1_head", x.NewHead)
+	s.setReorgHalted(true)
+	return true
+}
+
+func (s *Driver) setReorgHalted(halted bool) {
+	if s.reorgHalted.Swap(halted) == halted {
+		return
+	}
+	s.metrics.RecordL1ReorgHalted(halted)
+	select {
+	case s.haltSig <- struct{}{}:
+	default: // the event loop is already signaled
+	}
+}
+
+// AcknowledgeL1Reorg resumes sequencing and derivation after halting on a L1 reorg deeper than the max L1 reorg depth.
+func (s *Driver) AcknowledgeL1Reorg(ctx context.Context) error {
+	if !s.reorgHalted.Load() {
+		return errors.New("not halted on a L1 reorg")
+	}
+	s.log.Warn("Operator acknowledged deep L1 reorg, resuming sequencing and derivation")
+	s.setReorgHalted(false)
+	return nil
+}
+
 // SyncStatus blocks the driver event loop and captures the syncing status.
 func (s *Driver) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	return s.statusTracker.SyncStatus(), nil
 }
 
+// DerivationPipelineStatus returns a snapshot of the derivation pipeline, as of its last step.
+func (s *Driver) DerivationPipelineStatus(ctx context.Context) (*eth.DerivationPipelineStatus, error) {
+	status := s.Derivation.Status()
+	return &status, nil
+}
+
 // BlockRefWithStatus blocks the driver event loop and captures the syncing status,
 // along with an L2 block reference by number consistent with that same status.
 // If the event loop is too busy and the context expires, a context error is returned.
@@ -0,0 +1,63 @@
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/status"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type reorgHaltMetrics struct {
+	Metrics
+	halted bool
+}
+
+func (m *reorgHaltMetrics) RecordL1ReorgHalted(halted bool) {
+	m.halted = halted
+}
+
+func TestL1ReorgHalt(t *testing.T) {
+	newDriver := func(maxDepth uint64) *Driver {
+		return &Driver{
+			log:          testlog.Logger(t, log.LevelError),
+			metrics:      &reorgHaltMetrics{},
+			haltSig:      make(chan struct{}, 1),
+			driverConfig: &Config{MaxL1ReorgDepth: maxDepth},
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		d := newDriver(0)
+		require.True(t, d.onL1Reorg(status.L1ReorgEvent{Depth: 100}))
+		require.False(t, d.Halted())
+	})
+	t.Run("within max depth", func(t *testing.T) {
+		d := newDriver(5)
+		require.True(t, d.onL1Reorg(status.L1ReorgEvent{Depth: 5}))
+		require.False(t, d.Halted())
+		require.ErrorContains(t, d.AcknowledgeL1Reorg(context.Background()), "not halted")
+	})
+	t.Run("halt and acknowledge", func(t *testing.T) {
+		d := newDriver(5)
+		require.True(t, d.onL1Reorg(status.L1ReorgEvent{Depth: 6}))
+		require.True(t, d.Halted())
+		require.True(t, d.metrics.(*reorgHaltMetrics).halted)
+		require.Len(t, d.haltSig, 1)
+		<-d.haltSig
+
+		require.NoError(t, d.AcknowledgeL1Reorg(context.Background()))
+		require.False(t, d.Halted())
+		require.False(t, d.metrics.(*reorgHaltMetrics).halted)
+		require.Len(t, d.haltSig, 1)
+		require.ErrorContains(t, d.AcknowledgeL1Reorg(context.Background()), "not halted")
+	})
+	t.Run("ignores other events", func(t *testing.T) {
+		d := newDriver(5)
+		require.False(t, d.onL1Reorg(status.L1UnsafeEvent{}))
+	})
+}
@@ -20,3 +20,10 @@ type SafeHeadListener interface {
 	// The L1 block that made the new safe head safe is unknown.
 	SafeHeadReset(resetSafeHead eth.L2BlockRef) error
 }
+
+// DerivationReceiptListener is optionally implemented by a SafeHeadListener,
+// to be notified of the L1 data each new safe block was derived from.
+type DerivationReceiptListener interface {
+	// DerivationReceiptUpdated is called after SafeHeadUpdated, with the receipt of the new safe block.
+	DerivationReceiptUpdated(receipt *eth.DerivationReceipt) error
+}
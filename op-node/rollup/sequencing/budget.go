@@ -0,0 +1,49 @@
+package sequencing
+
+import (
+	"time"
+)
+
+// Stages of block building, as measured against the LatencyBudget.
+const (
+	// StageFCU is the forkchoice-update that starts the block building job.
+	StageFCU = "fcu"
+	// StageGetPayload is the retrieval of the built payload from the engine.
+	StageGetPayload = "get_payload"
+	// StagePublish is the commitment of the payload to the conductor, and the hand-off for gossip.
+	StagePublish = "publish"
+)
+
+// Admission control actions, taken when block building falls behind the LatencyBudget.
+const (
+	// AdmissionSkip builds the next block without tx-pool transactions, when a stage of the previous block
+	// exceeded its budget, or when there is not enough time left for the budget of the block.
+	AdmissionSkip = "skip"
+)
+
+// LatencyBudget configures how long each stage of block building is expected to take.
+// A stage with a zero budget is measured, but never considered to be over budget.
+type LatencyBudget struct {
+	FCU        time.Duration
+	GetPayload time.Duration
+	Publish    time.Duration
+}
+
+// Of returns the budget of the given stage.
+func (b *LatencyBudget) Of(stage string) time.Duration {
+	switch stage {
+	case StageFCU:
+		return b.FCU
+	case StageGetPayload:
+		return b.GetPayload
+	case StagePublish:
+		return b.Publish
+	default:
+		return 0
+	}
+}
+
+// Total returns the budget of all the stages of a block combined.
+func (b *LatencyBudget) Total() time.Duration {
+	return b.FCU + b.GetPayload + b.Publish
+}
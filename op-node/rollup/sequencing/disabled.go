@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 var ErrSequencerNotEnabled = errors.New("sequencer is not enabled")
@@ -44,8 +45,24 @@ func (ds DisabledSequencer) SetMaxSafeLag(ctx context.Context, v uint64) error {
 	return ErrSequencerNotEnabled
 }
 
+func (ds DisabledSequencer) SetClockDiscipline(ctx context.Context, cfg ClockDiscipline) error {
+	return ErrSequencerNotEnabled
+}
+
+func (ds DisabledSequencer) SetBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	return ErrSequencerNotEnabled
+}
+
+func (ds DisabledSequencer) SetLatencyBudget(ctx context.Context, budget LatencyBudget) error {
+	return ErrSequencerNotEnabled
+}
+
 func (ds DisabledSequencer) OverrideLeader(ctx context.Context) error {
 	return ErrSequencerNotEnabled
 }
 
+func (ds DisabledSequencer) Status(ctx context.Context) (*eth.SequencerStatus, error) {
+	return nil, ErrSequencerNotEnabled
+}
+
 func (ds DisabledSequencer) Close() {}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 type SequencerIface interface {
@@ -18,6 +19,10 @@ type SequencerIface interface {
 	Start(ctx context.Context, head common.Hash) error
 	Stop(ctx context.Context) (hash common.Hash, err error)
 	SetMaxSafeLag(ctx context.Context, v uint64) error
+	SetClockDiscipline(ctx context.Context, cfg ClockDiscipline) error
+	SetBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error
+	SetLatencyBudget(ctx context.Context, budget LatencyBudget) error
 	OverrideLeader(ctx context.Context) error
+	Status(ctx context.Context) (*eth.SequencerStatus, error)
 	Close()
 }
@@ -0,0 +1,35 @@
+package sequencing
+
+import (
+	"time"
+)
+
+// sealingWindow is the number of recent sealing latencies the sealing margin is based on.
+const sealingWindow = 32
+
+// sealingEstimator tracks the latency of recent blocks from the seal request until the block is
+// processed by the engine: retrieving the payload, committing it to the conductor, and inserting it.
+// The zero value is ready to use.
+type sealingEstimator struct {
+	latencies [sealingWindow]time.Duration
+	next      int
+	count     int
+}
+
+// Add records the sealing latency of a block.
+func (e *sealingEstimator) Add(latency time.Duration) {
+	e.latencies[e.next] = latency
+	e.next = (e.next + 1) % sealingWindow
+	e.count = min(e.count+1, sealingWindow)
+}
+
+// Margin returns how long before the payload time sealing has to start, for the block to be ready in time.
+// It is the highest recent sealing latency plus a quarter of safety margin, bounded to [sealingDuration, limit].
+// Sealing as late as possible maximizes the time the engine has to include transactions into the block.
+func (e *sealingEstimator) Margin(limit time.Duration) time.Duration {
+	var highest time.Duration
+	for _, latency := range e.latencies[:e.count] {
+		highest = max(highest, latency)
+	}
+	return max(sealingDuration, min(highest+highest/4, limit))
+}
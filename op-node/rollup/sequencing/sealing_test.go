@@ -0,0 +1,62 @@
+package sequencing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestSealingEstimator(t *testing.T) {
+	var e sealingEstimator
+	limit := time.Second
+	require.Equal(t, sealingDuration, e.Margin(limit), "minimum margin without latencies")
+
+	e.Add(10 * time.Millisecond)
+	require.Equal(t, sealingDuration, e.Margin(limit), "minimum margin with fast sealing")
+
+	e.Add(400 * time.Millisecond)
+	e.Add(100 * time.Millisecond)
+	require.Equal(t, 500*time.Millisecond, e.Margin(limit), "highest latency with safety margin")
+	require.Equal(t, 300*time.Millisecond, e.Margin(300*time.Millisecond), "bounded by limit")
+
+	// The slow latency is forgotten once it leaves the window
+	for i := 0; i < sealingWindow-1; i++ {
+		e.Add(200 * time.Millisecond)
+	}
+	require.Equal(t, 250*time.Millisecond, e.Margin(limit))
+}
+
+func TestSequencerSealingMargin(t *testing.T) {
+	seq, deps := createSequencer(testlog.Logger(t, log.LevelError))
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+
+	head := eth.L2BlockRef{Hash: common.Hash{0x22}, Number: 100, Time: deps.cfg.Genesis.L2Time}
+	payloadTime := time.Unix(int64(head.Time+deps.cfg.BlockTime), 0)
+	buildStarted := func() time.Time {
+		testClock.Set(time.Unix(int64(head.Time), 0))
+		seq.latest = BuildingState{Onto: head}
+		seq.OnEvent(engine.BuildStartedEvent{Info: eth.PayloadInfo{ID: eth.PayloadID{0x42}}, Parent: head})
+		return seq.nextAction
+	}
+	require.Equal(t, payloadTime.Add(-sealingDuration), buildStarted())
+
+	// A block that took 400ms from the seal request until it was inserted makes the sequencer seal earlier.
+	ref := eth.L2BlockRef{Number: 101, Time: head.Time + deps.cfg.BlockTime}
+	testClock.Set(payloadTime)
+	seq.recordSealingLatency(ref, payloadTime.Add(-400*time.Millisecond))
+	require.Equal(t, payloadTime.Add(-500*time.Millisecond), buildStarted())
+
+	// Sealing never takes more than half of the block time.
+	seq.recordSealingLatency(ref, payloadTime.Add(-3*time.Second))
+	require.Equal(t, payloadTime.Add(-time.Second), buildStarted())
+}
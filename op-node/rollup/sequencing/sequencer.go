@@ -10,7 +10,10 @@ import (
 	"github.com/protolambda/ctxlock"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
@@ -20,7 +23,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
-// sealingDuration defines the expected time it takes to seal the block
+// sealingDuration defines the minimum time it takes to seal the block.
+// The actual margin for sealing is adapted to the recent sealing latency, see sealingEstimator.
 const sealingDuration = time.Millisecond * 50
 
 var (
@@ -36,6 +40,23 @@ type Metrics interface {
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
 	RecordSequencingError()
+	RecordSequencerClockDrift(l2HeadDrift time.Duration, l1OriginDrift time.Duration)
+	RecordSequencerClockAdjustment(delay time.Duration)
+	RecordSequencerSealingMargin(margin time.Duration)
+	RecordSequencerDeadlineMiss()
+	RecordSequencerDeadlineMissAvoided()
+	RecordSequencerStageLatency(stage string, latency time.Duration)
+	RecordSequencerAdmission(action string)
+}
+
+// ClockDiscipline configures how the sequencer adapts block production timing to clock drift.
+type ClockDiscipline struct {
+	// TargetDrift is the maximum desired distance between the timestamp of a new L2 block and its L1 origin.
+	// When exceeded, e.g. because the local clock runs ahead of L1, block production is slowed down
+	// to let L1 catch up, rather than approaching the protocol max sequencer drift. Disabled if 0.
+	TargetDrift time.Duration
+	// MaxAdjustment bounds how far block production may fall behind the local wall clock due to slowing down.
+	MaxAdjustment time.Duration
 }
 
 type SequencerStateListener interface {
@@ -49,6 +70,8 @@ type AsyncGossiper interface {
 	Clear()
 	Stop()
 	Start()
+	// PublishFailures returns the number of payloads that failed to be published.
+	PublishFailures() uint64
 }
 
 // SequencerActionEvent triggers the sequencer to start/seal a block, if active and ready to act.
@@ -66,7 +89,17 @@ type BuildingState struct {
 	Onto eth.L2BlockRef
 	Info eth.PayloadInfo
 
+	// StartRequested is when the block building job was requested, to measure the forkchoice-update latency.
+	StartRequested time.Time
+
 	Started time.Time
+	// SealStarted is when sealing was requested, to measure the sealing latency.
+	SealStarted time.Time
+
+	// Attributes are the attributes of the block building job, to rebuild the block if it exceeds the Limits.
+	Attributes *derive.AttributesWithParent
+	// Limits are the building limits of the tx-pool transactions of the block, nil if not limited.
+	Limits *eth.BlockBuildingLimits
 
 	// Set once known
 	Ref eth.L2BlockRef
@@ -85,6 +118,12 @@ type Sequencer struct {
 
 	maxSafeLag atomic.Uint64
 
+	clockDiscipline atomic.Pointer[ClockDiscipline]
+
+	buildingLimits atomic.Pointer[eth.BlockBuildingLimits]
+
+	latencyBudget atomic.Pointer[LatencyBudget]
+
 	// active identifies whether the sequencer is running.
 	// This is an atomic value, so it can be read without locking the whole sequencer.
 	active atomic.Bool
@@ -117,6 +156,22 @@ type Sequencer struct {
 
 	latestHeadSet chan struct{}
 
+	// lastL1OriginDrift is the drift between the last L2 block the sequencer started building and its L1 origin
+	lastL1OriginDrift time.Duration
+
+	// clockAdjustedOnto is the parent of the last block delayed by clock discipline,
+	// to record the adjustment once per block, rather than on every retry while waiting for it.
+	clockAdjustedOnto eth.L2BlockRef
+
+	// sealing tracks the recent sealing latency, to start sealing just in time for the payload time.
+	sealing sealingEstimator
+
+	// overBudget is true if a stage of the last block exceeded the latency budget.
+	overBudget bool
+
+	// l1OriginBlocked is the reason the last block building attempt was blocked on the L1 origin, nil if not blocked.
+	l1OriginBlocked error
+
 	// toBlockRef converts a payload to a block-ref, and is only configurable for test-purposes
 	toBlockRef func(rollupCfg *rollup.Config, payload *eth.ExecutionPayload) (eth.L2BlockRef, error)
 }
@@ -217,6 +272,9 @@ func (d *Sequencer) onBuildStarted(x engine.BuildStartedEvent) {
 	// if not a derived block, then it is work of the sequencer
 	d.log.Debug("Sequencer started building new block",
 		"payloadID", x.Info.ID, "parent", x.Parent, "parent_time", x.Parent.Time)
+	if !d.latest.StartRequested.IsZero() {
+		d.recordStageLatency(StageFCU, d.timeNow().Sub(d.latest.StartRequested))
+	}
 	d.latest.Info = x.Info
 	d.latest.Started = x.BuildStarted
 
@@ -226,11 +284,14 @@ func (d *Sequencer) onBuildStarted(x engine.BuildStartedEvent) {
 	now := d.timeNow()
 	payloadTime := time.Unix(int64(x.Parent.Time+d.rollupCfg.BlockTime), 0)
 	remainingTime := payloadTime.Sub(now)
-	if remainingTime < sealingDuration {
+	// Never spend more than half of the block time on sealing, to leave time to include transactions.
+	margin := d.sealing.Margin(time.Duration(d.rollupCfg.BlockTime) * time.Second / 2)
+	d.metrics.RecordSequencerSealingMargin(margin)
+	if remainingTime < margin {
 		d.nextAction = now // if there's not enough time for sealing, don't wait.
 	} else {
 		// finish with margin of sealing duration before payloadTime
-		d.nextAction = payloadTime.Add(-sealingDuration)
+		d.nextAction = payloadTime.Add(-margin)
 	}
 }
 
@@ -264,6 +325,22 @@ func (d *Sequencer) onBuildSealed(x engine.BuildSealedEvent) {
 		"parent", x.Envelope.ExecutionPayload.ParentID(),
 		"txs", len(x.Envelope.ExecutionPayload.Transactions),
 		"time", uint64(x.Envelope.ExecutionPayload.Timestamp))
+	if !d.latest.SealStarted.IsZero() {
+		d.recordStageLatency(StageGetPayload, d.timeNow().Sub(d.latest.SealStarted))
+	}
+	if d.latest.Limits != nil && d.latest.Attributes != nil {
+		deposits := len(d.latest.Attributes.Attributes.Transactions)
+		txs := x.Envelope.ExecutionPayload.Transactions
+		if deposits > len(txs) {
+			d.log.Error("Sealed block is missing transactions of its attributes", "txs", len(txs), "attributes_txs", deposits)
+		} else if keep, err := limitTxPoolTxs(*d.latest.Limits, txs[deposits:], uint64(x.Envelope.ExecutionPayload.GasUsed)); err != nil {
+			d.log.Error("Failed to check block building limits of sealed block", "err", err)
+		} else if keep < len(txs)-deposits {
+			d.rebuildWithinLimits(txs[:deposits+keep])
+			return
+		}
+	}
+	publishStarted := d.timeNow()
 
 	// generous timeout, the conductor is important
 	ctx, cancel := context.WithTimeout(d.ctx, time.Second*30)
@@ -278,6 +355,7 @@ func (d *Sequencer) onBuildSealed(x engine.BuildSealedEvent) {
 	// asyncGossip.Clear() will be called later if an non-temporary error is found,
 	// or if the payload is successfully inserted
 	d.asyncGossip.Gossip(x.Envelope)
+	d.recordStageLatency(StagePublish, d.timeNow().Sub(publishStarted))
 	// Now after having gossiped the block, try to put it in our own canonical chain
 	d.emitter.Emit(engine.PayloadProcessEvent{
 		IsLastInSpan: x.IsLastInSpan,
@@ -325,6 +403,9 @@ func (d *Sequencer) onPayloadSuccess(x engine.PayloadSuccessEvent) {
 		// Not a payload that was built by this sequencer. We can ignore it, and continue upon forkchoice update.
 		return
 	}
+	if !d.latest.SealStarted.IsZero() {
+		d.recordSealingLatency(x.Ref, d.latest.SealStarted)
+	}
 	d.latest = BuildingState{}
 	d.log.Info("Sequencer inserted block",
 		"block", x.Ref, "parent", x.Envelope.ExecutionPayload.ParentID())
@@ -333,6 +414,20 @@ func (d *Sequencer) onPayloadSuccess(x engine.PayloadSuccessEvent) {
 	d.asyncGossip.Clear()
 }
 
+// recordSealingLatency records the latency of the block from the seal request until it was inserted,
+// and whether the block missed its payload time, or would have missed it with the minimum sealing margin.
+func (d *Sequencer) recordSealingLatency(ref eth.L2BlockRef, sealStarted time.Time) {
+	now := d.timeNow()
+	latency := now.Sub(sealStarted)
+	d.sealing.Add(latency)
+	if now.After(time.Unix(int64(ref.Time), 0)) {
+		d.log.Warn("Sequenced block was not ready by its payload time", "block", ref, "sealing_latency", latency)
+		d.metrics.RecordSequencerDeadlineMiss()
+	} else if latency > sealingDuration {
+		d.metrics.RecordSequencerDeadlineMissAvoided()
+	}
+}
+
 func (d *Sequencer) onSequencerAction(x SequencerActionEvent) {
 	d.log.Debug("Sequencer action")
 	payload := d.asyncGossip.Get()
@@ -365,6 +460,7 @@ func (d *Sequencer) onSequencerAction(x SequencerActionEvent) {
 		if d.latest.Info != (eth.PayloadInfo{}) {
 			// We should not repeat the seal request.
 			d.nextActionOK = false
+			d.latest.SealStarted = d.timeNow()
 			// No known payload for block building job,
 			// we have to retrieve it first.
 			d.emitter.Emit(engine.BuildSealEvent{
@@ -449,6 +545,7 @@ func (d *Sequencer) onForkchoiceUpdate(x engine.ForkchoiceUpdateEvent) {
 	if x.UnsafeL2Head.Number > d.latestHead.Number {
 		d.nextActionOK = true
 		now := d.timeNow()
+		d.metrics.RecordSequencerClockDrift(time.Unix(int64(x.UnsafeL2Head.Time), 0).Sub(now), d.lastL1OriginDrift)
 		blockTime := time.Duration(d.rollupCfg.BlockTime) * time.Second
 		payloadTime := time.Unix(int64(x.UnsafeL2Head.Time+d.rollupCfg.BlockTime), 0)
 		remainingTime := payloadTime.Sub(now)
@@ -490,6 +587,7 @@ func (d *Sequencer) startBuildingBlock() {
 	l1Origin, err := d.l1OriginSelector.FindL1Origin(ctx, l2Head)
 	if err != nil {
 		d.log.Error("Error finding next L1 Origin", "err", err)
+		d.l1OriginBlocked = err
 		d.emitter.Emit(rollup.L1TemporaryErrorEvent{Err: err})
 		return
 	}
@@ -501,6 +599,28 @@ func (d *Sequencer) startBuildingBlock() {
 		return
 	}
 
+	if delay := d.clockDisciplineDelay(l2Head, l1Origin); delay > 0 {
+		slot := time.Unix(int64(l2Head.Time+d.rollupCfg.BlockTime), 0)
+		start := slot.Add(delay)
+		if now := d.timeNow(); now.Before(start) {
+			if d.clockAdjustedOnto != l2Head {
+				d.clockAdjustedOnto = l2Head
+				// Only the part of the delay past the slot slows down block production,
+				// a block built early would not have started before its slot anyway.
+				if now.After(slot) {
+					delay = start.Sub(now)
+				}
+				d.log.Warn("Delaying block production to let L1 origin catch up",
+					"parent", l2Head, "l1Origin", l1Origin, "delay", delay, "start", start)
+				d.metrics.RecordSequencerClockAdjustment(delay)
+			}
+			d.nextAction = start
+			d.l1OriginBlocked = fmt.Errorf("delaying block production until %s to let L1 origin %s catch up", start, l1Origin)
+			return
+		}
+	}
+	d.l1OriginBlocked = nil
+
 	d.log.Info("Started sequencing new block", "parent", l2Head, "l1Origin", l1Origin)
 
 	fetchCtx, cancel := context.WithTimeout(ctx, time.Second*20)
@@ -546,6 +666,12 @@ func (d *Sequencer) startBuildingBlock() {
 		d.log.Info("Sequencing Granite upgrade block")
 	}
 
+	d.applyLatencyBudget(attrs)
+	var limits *eth.BlockBuildingLimits
+	if !attrs.NoTxPool {
+		limits = d.buildingLimits.Load()
+	}
+
 	d.log.Debug("prepared attributes for new block",
 		"num", l2Head.Number+1, "time", uint64(attrs.Timestamp),
 		"origin", l1Origin, "origin_time", l1Origin.Time, "noTxPool", attrs.NoTxPool)
@@ -563,13 +689,34 @@ func (d *Sequencer) startBuildingBlock() {
 
 	// Reset building state, and remember what we are building on.
 	// If we get a forkchoice update that conflicts, we will have to abort building.
-	d.latest = BuildingState{Onto: l2Head}
+	d.latest = BuildingState{Onto: l2Head, StartRequested: d.timeNow(), Attributes: withParent, Limits: limits}
 
 	d.emitter.Emit(engine.BuildStartEvent{
 		Attributes: withParent,
 	})
 }
 
+// rebuildWithinLimits builds the block that is being built again, with exactly the given transactions,
+// after the sealed block exceeded the building limits. The dropped transactions remain in the tx pool.
+// The engine API cannot limit the transactions the engine includes, so this costs a second forkchoice-update
+// and get-payload round trip: the publishing of a limited block is delayed by about the latency of building it.
+// The rebuilt block does not wait for tx-pool transactions, and is sealed as soon as it is started.
+func (d *Sequencer) rebuildWithinLimits(txs []eth.Data) {
+	attrs := *d.latest.Attributes.Attributes
+	attrs.Transactions = txs
+	attrs.NoTxPool = true
+	withParent := *d.latest.Attributes
+	withParent.Attributes = &attrs
+	d.log.Info("Sealed block exceeds the block building limits, rebuilding it",
+		"parent", withParent.Parent, "timestamp", uint64(attrs.Timestamp), "txs", len(txs))
+
+	d.nextActionOK = false
+	d.latest = BuildingState{Onto: d.latest.Onto, StartRequested: d.timeNow(), Attributes: &withParent}
+	d.emitter.Emit(engine.BuildStartEvent{
+		Attributes: &withParent,
+	})
+}
+
 func (d *Sequencer) NextAction() (t time.Time, ok bool) {
 	d.l.Lock()
 	defer d.l.Unlock()
@@ -708,6 +855,169 @@ func (d *Sequencer) SetMaxSafeLag(ctx context.Context, v uint64) error {
 	return nil
 }
 
+func (d *Sequencer) SetClockDiscipline(ctx context.Context, cfg ClockDiscipline) error {
+	d.clockDiscipline.Store(&cfg)
+	return nil
+}
+
+// SetBuildingLimits sets the soft limits on the tx-pool transactions of new blocks.
+// The limits are enforced on the sealed blocks, see BlockBuildingLimits.
+func (d *Sequencer) SetBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	if limits.GasTarget != 0 && uint64(limits.GasTarget) < params.TxGas {
+		return fmt.Errorf("gas target %d is lower than the gas of a transaction", limits.GasTarget)
+	}
+	d.buildingLimits.Store(&limits)
+	d.log.Info("Updated block building limits",
+		"max_txs", limits.MaxTxs, "max_calldata_bytes", limits.MaxCalldataBytes, "gas_target", limits.GasTarget)
+	return nil
+}
+
+// limitTxPoolTxs returns how many of the leading tx-pool transactions of a sealed block fit within the building limits.
+// The engine does not report the gas used by each transaction of a payload, so the gas target is checked against
+// the gas used by the whole block. If the block exceeds it, trailing transactions are dropped until their intrinsic gas,
+// a lower bound of the gas they used, covers the excess. The kept transactions thus never exceed the gas target.
+func limitTxPoolTxs(limits eth.BlockBuildingLimits, txs []eth.Data, blockGasUsed uint64) (int, error) {
+	keep := len(txs)
+	intrinsicGas := make([]uint64, len(txs))
+	var calldata uint64
+	for i, data := range txs {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return 0, fmt.Errorf("failed to decode transaction %d: %w", i, err)
+		}
+		gas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.AuthList(), tx.To() == nil, true, true, true)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute intrinsic gas of transaction %d: %w", i, err)
+		}
+		intrinsicGas[i] = gas
+		calldata += uint64(len(tx.Data()))
+		if keep == len(txs) && limits.MaxCalldataBytes != 0 && calldata > uint64(limits.MaxCalldataBytes) {
+			keep = i
+		}
+	}
+	if limits.MaxTxs != 0 && uint64(keep) > uint64(limits.MaxTxs) {
+		keep = int(limits.MaxTxs)
+	}
+	if limits.GasTarget == 0 || blockGasUsed <= uint64(limits.GasTarget) {
+		return keep, nil
+	}
+	excess := blockGasUsed - uint64(limits.GasTarget)
+	var dropped uint64
+	for _, gas := range intrinsicGas[keep:] {
+		dropped += gas
+	}
+	for keep > 0 && dropped < excess {
+		keep--
+		dropped += intrinsicGas[keep]
+	}
+	return keep, nil
+}
+
+// SetLatencyBudget sets the latency budget of the stages of block building.
+func (d *Sequencer) SetLatencyBudget(ctx context.Context, budget LatencyBudget) error {
+	if budget.FCU < 0 || budget.GetPayload < 0 || budget.Publish < 0 {
+		return errors.New("latency budget cannot be negative")
+	}
+	if blockTime := time.Duration(d.rollupCfg.BlockTime) * time.Second; budget.Total() >= blockTime {
+		return fmt.Errorf("latency budget %s does not fit in the block time %s", budget.Total(), blockTime)
+	}
+	d.latencyBudget.Store(&budget)
+	return nil
+}
+
+// recordStageLatency records the latency of a stage of block building, and whether it exceeded its budget.
+func (d *Sequencer) recordStageLatency(stage string, latency time.Duration) {
+	d.metrics.RecordSequencerStageLatency(stage, latency)
+	budget := d.latencyBudget.Load()
+	if budget == nil {
+		return
+	}
+	if limit := budget.Of(stage); limit != 0 && latency > limit {
+		d.log.Warn("Block building stage exceeded its latency budget", "stage", stage, "latency", latency, "budget", limit)
+		d.overBudget = true
+	}
+}
+
+// applyLatencyBudget builds the block without tx-pool transactions, when block building is behind the latency budget:
+// if a stage of the previous block exceeded its budget, or if there is not enough time left for the budget of the block.
+// Blocks without tx-pool transactions are quick to build, so the sequencer catches up.
+func (d *Sequencer) applyLatencyBudget(attrs *eth.PayloadAttributes) {
+	overBudget := d.overBudget
+	d.overBudget = false
+	budget := d.latencyBudget.Load()
+	if budget == nil || budget.Total() == 0 || attrs.NoTxPool {
+		return
+	}
+	if remaining := time.Unix(int64(attrs.Timestamp), 0).Sub(d.timeNow()); remaining < budget.Total() {
+		d.log.Warn("Not enough time left for the latency budget, building block without tx-pool transactions",
+			"timestamp", uint64(attrs.Timestamp), "remaining", remaining, "budget", budget.Total())
+	} else if overBudget {
+		d.log.Warn("Previous block exceeded the latency budget, building block without tx-pool transactions",
+			"timestamp", uint64(attrs.Timestamp))
+	} else {
+		return
+	}
+	attrs.NoTxPool = true
+	d.metrics.RecordSequencerAdmission(AdmissionSkip)
+}
+
+// clockDisciplineDelay returns how long after its timestamp to start building a block on top of l2Head with the
+// given L1 origin, to keep the distance between the L2 block time and the L1 origin time within the configured target drift.
+// The delay is at most one block time per step, and never puts block production further behind the local
+// wall clock than the configured max adjustment.
+// The delay is relative to the block timestamp, not to the time of the building attempt,
+// so it does not grow when the attempt is retried, and is not absorbed by building early.
+func (d *Sequencer) clockDisciplineDelay(l2Head eth.L2BlockRef, l1Origin eth.L1BlockRef) time.Duration {
+	nextTime := l2Head.Time + d.rollupCfg.BlockTime
+	d.lastL1OriginDrift = time.Duration(int64(nextTime)-int64(l1Origin.Time)) * time.Second
+
+	cfg := d.clockDiscipline.Load()
+	if cfg == nil || cfg.TargetDrift == 0 {
+		return 0
+	}
+	excess := d.lastL1OriginDrift - cfg.TargetDrift
+	if excess <= 0 {
+		return 0
+	}
+	blockTime := time.Duration(d.rollupCfg.BlockTime) * time.Second
+	return min(excess, blockTime, cfg.MaxAdjustment)
+}
+
+// Status returns a snapshot of the sequencing state.
+func (d *Sequencer) Status(ctx context.Context) (*eth.SequencerStatus, error) {
+	// Like Start, the conductor is checked before locking, to not stall the event-processing on a slow conductor.
+	isLeader, leaderErr := d.conductor.Leader(ctx)
+
+	if err := d.l.LockCtx(ctx); err != nil {
+		return nil, err
+	}
+	status := &eth.SequencerStatus{
+		Active:                d.active.Load(),
+		UnsafeHead:            d.latestHead,
+		ConductorLeader:       isLeader,
+		GossipPublishFailures: d.asyncGossip.PublishFailures(),
+	}
+	if limits := d.buildingLimits.Load(); limits != nil {
+		status.BuildingLimits = *limits
+	}
+	if d.l1OriginBlocked != nil {
+		status.BlockedOnL1Origin = true
+		status.L1OriginError = d.l1OriginBlocked.Error()
+	}
+	d.l.Unlock()
+
+	if leaderErr != nil {
+		status.ConductorLeader = false
+		status.ConductorError = leaderErr.Error()
+	}
+	if status.UnsafeHead != (eth.L2BlockRef{}) {
+		if age := d.timeNow().Sub(time.Unix(int64(status.UnsafeHead.Time), 0)); age > 0 {
+			status.TimeSinceLastBlock = uint64(age.Milliseconds())
+		}
+	}
+	return status, nil
+}
+
 func (d *Sequencer) OverrideLeader(ctx context.Context) error {
 	return d.conductor.OverrideLeader(ctx)
 }
@@ -3,6 +3,7 @@ package sequencing
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"math/rand" // nosemgrep
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -124,9 +126,10 @@ func (c *FakeConductor) Close() {
 }
 
 type FakeAsyncGossip struct {
-	payload *eth.ExecutionPayloadEnvelope
-	started bool
-	stopped bool
+	payload  *eth.ExecutionPayloadEnvelope
+	started  bool
+	stopped  bool
+	failures uint64
 }
 
 func (f *FakeAsyncGossip) Gossip(payload *eth.ExecutionPayloadEnvelope) {
@@ -149,6 +152,10 @@ func (f *FakeAsyncGossip) Start() {
 	f.started = true
 }
 
+func (f *FakeAsyncGossip) PublishFailures() uint64 {
+	return f.failures
+}
+
 var _ AsyncGossiper = (*FakeAsyncGossip)(nil)
 
 // TestSequencer_StartStop runs through start/stop state back and forth to test state changes.
@@ -668,3 +675,357 @@ func createSequencer(log log.Logger) (*Sequencer, *sequencerTestDeps) {
 	}
 	return seq, deps
 }
+
+func TestSequencerClockDiscipline(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+
+	l1Origin := eth.L1BlockRef{Number: 100, Time: deps.cfg.Genesis.L2Time}
+	head := eth.L2BlockRef{Number: 10, Time: deps.cfg.Genesis.L2Time + 60}
+	nextTime := time.Unix(int64(head.Time+deps.cfg.BlockTime), 0)
+
+	t.Run("Disabled", func(t *testing.T) {
+		testClock.Set(nextTime)
+		require.Zero(t, seq.clockDisciplineDelay(head, l1Origin))
+		require.Equal(t, 62*time.Second, seq.lastL1OriginDrift)
+	})
+
+	require.NoError(t, seq.SetClockDiscipline(context.Background(), ClockDiscipline{
+		TargetDrift:   61 * time.Second,
+		MaxAdjustment: 10 * time.Second,
+	}))
+
+	t.Run("WithinTarget", func(t *testing.T) {
+		testClock.Set(nextTime)
+		withinTarget := head
+		withinTarget.Time -= 1
+		require.Zero(t, seq.clockDisciplineDelay(withinTarget, l1Origin))
+	})
+
+	t.Run("DelayByExcess", func(t *testing.T) {
+		testClock.Set(nextTime)
+		require.Equal(t, time.Second, seq.clockDisciplineDelay(head, l1Origin))
+	})
+
+	t.Run("DelayAtMostOneBlock", func(t *testing.T) {
+		ahead := head
+		ahead.Time += 30
+		require.Equal(t, time.Duration(deps.cfg.BlockTime)*time.Second, seq.clockDisciplineDelay(ahead, l1Origin))
+	})
+
+	t.Run("IndependentOfAttemptTime", func(t *testing.T) {
+		blockTime := time.Duration(deps.cfg.BlockTime) * time.Second
+		testClock.Set(nextTime.Add(-blockTime))
+		require.Equal(t, time.Second, seq.clockDisciplineDelay(head, l1Origin))
+		testClock.Set(nextTime.Add(500 * time.Millisecond))
+		require.Equal(t, time.Second, seq.clockDisciplineDelay(head, l1Origin))
+	})
+
+	t.Run("BoundedByMaxAdjustment", func(t *testing.T) {
+		require.NoError(t, seq.SetClockDiscipline(context.Background(), ClockDiscipline{
+			TargetDrift:   61 * time.Second,
+			MaxAdjustment: 500 * time.Millisecond,
+		}))
+		require.Equal(t, 500*time.Millisecond, seq.clockDisciplineDelay(head, l1Origin))
+	})
+}
+
+type clockAdjustmentMetrics struct {
+	metrics.Metricer
+	adjustments []time.Duration
+}
+
+func (m *clockAdjustmentMetrics) RecordSequencerClockAdjustment(delay time.Duration) {
+	m.adjustments = append(m.adjustments, delay)
+}
+
+func TestSequencerClockDisciplineRetry(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+	m := &clockAdjustmentMetrics{Metricer: metrics.NoopMetrics}
+	seq.metrics = m
+	emitter := &testutils.MockEmitter{}
+	seq.AttachEmitter(emitter)
+	require.NoError(t, seq.SetClockDiscipline(context.Background(), ClockDiscipline{
+		TargetDrift:   61 * time.Second,
+		MaxAdjustment: 10 * time.Second,
+	}))
+
+	head := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10, Time: deps.cfg.Genesis.L2Time + 60}
+	seq.OnEvent(engine.ForkchoiceUpdateEvent{UnsafeL2Head: head})
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{Hash: l2Head.L1Origin.Hash, Number: l2Head.L1Origin.Number, Time: deps.cfg.Genesis.L2Time}, nil
+	}
+	slot := time.Unix(int64(head.Time+deps.cfg.BlockTime), 0)
+
+	// Building late, at the slot the drift exceeds the target by 1s
+	testClock.Set(slot.Add(200 * time.Millisecond))
+	seq.startBuildingBlock()
+	require.Equal(t, slot.Add(time.Second), seq.nextAction)
+	require.Equal(t, []time.Duration{800 * time.Millisecond}, m.adjustments)
+
+	// Retrying before the start time keeps the start time, and doesn't record the adjustment again
+	testClock.Set(slot.Add(600 * time.Millisecond))
+	seq.startBuildingBlock()
+	require.Equal(t, slot.Add(time.Second), seq.nextAction)
+	require.Len(t, m.adjustments, 1)
+
+	// Starts building once the start time is reached
+	testClock.Set(slot.Add(time.Second))
+	emitter.ExpectOnceType("BuildStartEvent")
+	seq.startBuildingBlock()
+	emitter.AssertExpectations(t)
+	require.Len(t, m.adjustments, 1)
+}
+
+func TestSequencerStatus(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+	emitter := &testutils.MockEmitter{}
+	seq.AttachEmitter(emitter)
+
+	head := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10, Time: deps.cfg.Genesis.L2Time + 20}
+	seq.OnEvent(engine.ForkchoiceUpdateEvent{UnsafeL2Head: head})
+	testClock.Set(time.Unix(int64(head.Time), 0).Add(2500 * time.Millisecond))
+	deps.conductor.leader = true
+	deps.asyncGossip.failures = 2
+
+	status, err := seq.Status(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &eth.SequencerStatus{
+		UnsafeHead:            head,
+		TimeSinceLastBlock:    2500,
+		ConductorLeader:       true,
+		GossipPublishFailures: 2,
+	}, status)
+
+	// Fail to find the L1 origin of the next block
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{}, errors.New("L1 origin not found")
+	}
+	emitter.ExpectOnceType("L1TemporaryErrorEvent")
+	seq.startBuildingBlock()
+	emitter.AssertExpectations(t)
+	status, err = seq.Status(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.BlockedOnL1Origin)
+	require.Equal(t, "L1 origin not found", status.L1OriginError)
+
+	// Recover once the L1 origin is found
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{Hash: l2Head.L1Origin.Hash, Number: l2Head.L1Origin.Number}, nil
+	}
+	emitter.ExpectOnceType("BuildStartEvent")
+	seq.startBuildingBlock()
+	emitter.AssertExpectations(t)
+	status, err = seq.Status(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.BlockedOnL1Origin)
+	require.Empty(t, status.L1OriginError)
+}
+
+func TestSequencerBuildingLimits(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	emitter := &testutils.MockEmitter{}
+	seq.AttachEmitter(emitter)
+
+	head := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10, Time: deps.cfg.Genesis.L2Time + 20}
+	seq.OnEvent(engine.ForkchoiceUpdateEvent{UnsafeL2Head: head})
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{Hash: l2Head.L1Origin.Hash, Number: l2Head.L1Origin.Number, Time: l2Head.Time}, nil
+	}
+	payloadInfo := eth.PayloadInfo{ID: eth.PayloadID{0x42}, Timestamp: head.Time + deps.cfg.BlockTime}
+	// buildBlock starts building a block, and returns the attributes and the sealed event of a block
+	// that includes the given tx-pool transactions, and uses the given gas.
+	buildBlock := func(gasUsed uint64, poolTxs ...eth.Data) (*derive.AttributesWithParent, engine.BuildSealedEvent) {
+		var attrs *derive.AttributesWithParent
+		emitter.ExpectOnceRun(func(ev event.Event) {
+			x, ok := ev.(engine.BuildStartEvent)
+			require.True(t, ok)
+			attrs = x.Attributes
+		})
+		seq.latest = BuildingState{} // build on top of the same head again
+		seq.startBuildingBlock()
+		emitter.AssertExpectations(t)
+		seq.OnEvent(engine.BuildStartedEvent{Info: payloadInfo, Parent: head})
+		envelope := &eth.ExecutionPayloadEnvelope{ExecutionPayload: &eth.ExecutionPayload{
+			ParentHash:   head.Hash,
+			BlockNumber:  eth.Uint64Quantity(head.Number + 1),
+			BlockHash:    common.Hash{0x12, 0x34},
+			Timestamp:    attrs.Attributes.Timestamp,
+			GasUsed:      eth.Uint64Quantity(gasUsed),
+			Transactions: append(append([]eth.Data{}, attrs.Attributes.Transactions...), poolTxs...),
+		}}
+		ref, err := seq.toBlockRef(deps.cfg, envelope.ExecutionPayload)
+		require.NoError(t, err)
+		return attrs, engine.BuildSealedEvent{Info: payloadInfo, Envelope: envelope, Ref: ref}
+	}
+	expectPublished := func(sealed engine.BuildSealedEvent) {
+		emitter.ExpectOnce(engine.PayloadProcessEvent{Envelope: sealed.Envelope, Ref: sealed.Ref})
+		seq.OnEvent(sealed)
+		emitter.AssertExpectations(t)
+		require.Equal(t, sealed.Envelope, deps.conductor.committed)
+	}
+	expectRebuilt := func(sealed engine.BuildSealedEvent, txs []eth.Data) {
+		deps.conductor.committed = nil
+		emitter.ExpectOnceRun(func(ev event.Event) {
+			x, ok := ev.(engine.BuildStartEvent)
+			require.True(t, ok)
+			require.True(t, x.Attributes.Attributes.NoTxPool)
+			require.Equal(t, txs, x.Attributes.Attributes.Transactions)
+			require.Equal(t, sealed.Envelope.ExecutionPayload.Timestamp, x.Attributes.Attributes.Timestamp)
+			require.Equal(t, head, x.Attributes.Parent)
+		})
+		seq.OnEvent(sealed)
+		emitter.AssertExpectations(t)
+		require.Nil(t, deps.conductor.committed, "must not publish a block that exceeds the limits")
+		require.Nil(t, seq.latest.Limits, "rebuilt block is not limited again")
+	}
+	tx := func(gas uint64, dataSize int) eth.Data {
+		data, err := types.NewTx(&types.DynamicFeeTx{To: &common.Address{0x01}, Gas: gas, Data: make([]byte, dataSize)}).MarshalBinary()
+		require.NoError(t, err)
+		return data
+	}
+	// The intrinsic gas of the txs is 25_000, 29_000 and 21_000, their gas limits add up to 621_000.
+	poolTxs := []eth.Data{tx(100_000, 1000), tx(500_000, 2000), tx(21_000, 0)}
+	const gasUsed = 150_000
+
+	t.Run("Unlimited", func(t *testing.T) {
+		_, sealed := buildBlock(gasUsed, poolTxs...)
+		expectPublished(sealed)
+	})
+
+	t.Run("WithinLimits", func(t *testing.T) {
+		// The gas target is checked against the gas used, not against the gas limits of the txs.
+		limits := eth.BlockBuildingLimits{MaxTxs: 3, MaxCalldataBytes: 3000, GasTarget: gasUsed}
+		require.NoError(t, seq.SetBuildingLimits(context.Background(), limits))
+		_, sealed := buildBlock(gasUsed, poolTxs...)
+		expectPublished(sealed)
+
+		status, err := seq.Status(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, limits, status.BuildingLimits)
+	})
+
+	for _, test := range []struct {
+		name   string
+		limits eth.BlockBuildingLimits
+		keep   int
+	}{
+		{name: "MaxTxs", limits: eth.BlockBuildingLimits{MaxTxs: 2}, keep: 2},
+		{name: "MaxCalldataBytes", limits: eth.BlockBuildingLimits{MaxCalldataBytes: 2999}, keep: 1},
+		// The last tx covers the excess gas with its intrinsic gas
+		{name: "GasTarget", limits: eth.BlockBuildingLimits{GasTarget: gasUsed - 21_000}, keep: 2},
+		// The intrinsic gas of the last two txs covers the excess gas
+		{name: "GasTargetMultipleTxs", limits: eth.BlockBuildingLimits{GasTarget: gasUsed - 21_001}, keep: 1},
+		// The gas of the txs dropped for other limits counts towards the excess gas
+		{name: "GasTargetAndMaxTxs", limits: eth.BlockBuildingLimits{MaxTxs: 2, GasTarget: gasUsed - 50_000}, keep: 1},
+		{name: "GasTargetAllTxs", limits: eth.BlockBuildingLimits{GasTarget: 21_000}, keep: 0},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, seq.SetBuildingLimits(context.Background(), test.limits))
+			attrs, sealed := buildBlock(gasUsed, poolTxs...)
+			txs := append(append([]eth.Data{}, attrs.Attributes.Transactions...), poolTxs[:test.keep]...)
+			expectRebuilt(sealed, txs)
+
+			// The rebuilt block is published as is.
+			seq.OnEvent(engine.BuildStartedEvent{Info: payloadInfo, Parent: head})
+			sealed.Envelope.ExecutionPayload.Transactions = txs
+			expectPublished(sealed)
+		})
+	}
+
+	t.Run("NoTxPool", func(t *testing.T) {
+		require.NoError(t, seq.SetBuildingLimits(context.Background(), eth.BlockBuildingLimits{MaxTxs: 1}))
+		// An L1 origin beyond the max sequencer drift forces NoTxPool, which leaves the block unlimited
+		deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+			return eth.L1BlockRef{Hash: l2Head.L1Origin.Hash, Number: l2Head.L1Origin.Number, Time: l2Head.Time - 3600}, nil
+		}
+		attrs, _ := buildBlock(0)
+		require.True(t, attrs.Attributes.NoTxPool)
+		require.Nil(t, seq.latest.Limits)
+	})
+
+	t.Run("InvalidGasTarget", func(t *testing.T) {
+		require.ErrorContains(t, seq.SetBuildingLimits(context.Background(), eth.BlockBuildingLimits{GasTarget: 20_000}), "gas target")
+	})
+}
+
+func TestSequencerLatencyBudget(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	seq, deps := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+	emitter := &testutils.MockEmitter{}
+	seq.AttachEmitter(emitter)
+
+	head := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10, Time: deps.cfg.Genesis.L2Time + 20}
+	seq.OnEvent(engine.ForkchoiceUpdateEvent{UnsafeL2Head: head})
+	deps.l1OriginSelector.l1OriginFn = func(l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{Hash: l2Head.L1Origin.Hash, Number: l2Head.L1Origin.Number, Time: l2Head.Time}, nil
+	}
+	payloadTime := time.Unix(int64(head.Time+deps.cfg.BlockTime), 0)
+	buildAttributes := func() *eth.PayloadAttributes {
+		var attrs *eth.PayloadAttributes
+		emitter.ExpectOnceRun(func(ev event.Event) {
+			x, ok := ev.(engine.BuildStartEvent)
+			require.True(t, ok)
+			attrs = x.Attributes.Attributes
+		})
+		seq.latest = BuildingState{} // build on top of the same head again
+		seq.startBuildingBlock()
+		emitter.AssertExpectations(t)
+		return attrs
+	}
+
+	t.Run("InvalidBudget", func(t *testing.T) {
+		require.ErrorContains(t, seq.SetLatencyBudget(context.Background(), LatencyBudget{FCU: -time.Millisecond}), "negative")
+		require.ErrorContains(t, seq.SetLatencyBudget(context.Background(), LatencyBudget{GetPayload: 2 * time.Second}), "block time")
+	})
+
+	require.NoError(t, seq.SetLatencyBudget(context.Background(), LatencyBudget{
+		FCU:        100 * time.Millisecond,
+		GetPayload: 200 * time.Millisecond,
+		Publish:    100 * time.Millisecond,
+	}))
+
+	t.Run("WithinBudget", func(t *testing.T) {
+		testClock.Set(payloadTime.Add(-2 * time.Second))
+		attrs := buildAttributes()
+		require.False(t, attrs.NoTxPool)
+
+		testClock.Set(payloadTime.Add(-2*time.Second + 50*time.Millisecond))
+		seq.OnEvent(engine.BuildStartedEvent{Info: eth.PayloadInfo{ID: eth.PayloadID{0x01}}, Parent: head})
+		require.False(t, seq.overBudget)
+	})
+
+	t.Run("OverBudget", func(t *testing.T) {
+		testClock.Set(payloadTime.Add(-2 * time.Second))
+		buildAttributes()
+		testClock.Set(payloadTime.Add(-2*time.Second + 150*time.Millisecond))
+		seq.OnEvent(engine.BuildStartedEvent{Info: eth.PayloadInfo{ID: eth.PayloadID{0x02}}, Parent: head})
+		require.True(t, seq.overBudget)
+
+		testClock.Set(payloadTime.Add(-2 * time.Second))
+		attrs := buildAttributes()
+		require.True(t, attrs.NoTxPool, "previous block exceeded the budget")
+		require.False(t, seq.overBudget, "only the next block is limited")
+
+		attrs = buildAttributes()
+		require.False(t, attrs.NoTxPool)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		testClock.Set(payloadTime.Add(-350 * time.Millisecond))
+		attrs := buildAttributes()
+		require.True(t, attrs.NoTxPool, "not enough time left for the budget")
+	})
+}
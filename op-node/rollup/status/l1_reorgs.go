@@ -0,0 +1,219 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// L1ReorgEvent is emitted when the L1 head changes to a chain that does not include the previous L1 head.
+type L1ReorgEvent struct {
+	OldHead eth.L1BlockRef
+	NewHead eth.L1BlockRef
+	// Depth is the number of blocks of the previous L1 chain that are no longer canonical.
+	// It is measured up to one block more than the max L1 reorg depth: deeper reorgs are reported at that depth.
+	// If the max L1 reorg depth is 0, it is estimated as the difference in height of the old and new head.
+	Depth uint64
+}
+
+func (ev L1ReorgEvent) String() string {
+	return "l1-reorg"
+}
+
+// l1ReorgFetchTimeout bounds the fetching of the L1 blocks of the new chain, to find the common ancestor.
+const l1ReorgFetchTimeout = 10 * time.Second
+
+// l1ReorgQueueSize is the number of L1 head changes that can be queued to be checked for a reorg.
+const l1ReorgQueueSize = 16
+
+type L1BlockRefs interface {
+	L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error)
+	L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error)
+}
+
+// l1ReorgCheck is a change of the L1 head that does not extend the previous head,
+// with the history of the previous chain at the time of the change.
+type l1ReorgCheck struct {
+	prev    eth.L1BlockRef
+	head    eth.L1BlockRef
+	history map[uint64]eth.L1BlockRef
+}
+
+// hashAt returns the hash of the block of the previous chain at the given height, if it is known.
+func (c *l1ReorgCheck) hashAt(num uint64) (common.Hash, bool) {
+	if ref, ok := c.history[num]; ok {
+		return ref.Hash, true
+	}
+	if ref, ok := c.history[num+1]; ok {
+		return ref.ParentHash, true
+	}
+	return common.Hash{}, false
+}
+
+// L1ReorgTracker detects L1 reorgs, and measures their depth by finding the common ancestor
+// of the new L1 head and the recent L1 heads. It handles the L1UnsafeEvent, and sends the L1ReorgEvent
+// of detected reorgs to the Reorgs channel, for the driver to emit.
+// The common ancestor is searched in the background, by following the parent hashes of the new chain,
+// so the event loop is never blocked on L1 RPCs. The search stops after one block more than the max L1 reorg depth.
+// If the max L1 reorg depth is 0, no L1 blocks are fetched: the depth is estimated from the heights of the
+// previous and new head, for metrics only.
+type L1ReorgTracker struct {
+	ctx      context.Context
+	log      log.Logger
+	l1       L1BlockRefs
+	maxDepth uint64
+
+	mu sync.Mutex
+	// history holds the tracked L1 heads by number, down to the max L1 reorg depth below the latest head.
+	// Unlike the L1 head buffer, it is not reset when a head skips blocks, or does not build on the previous head.
+	history map[uint64]eth.L1BlockRef
+	head    eth.L1BlockRef
+
+	checks chan l1ReorgCheck
+	reorgs chan L1ReorgEvent
+}
+
+func NewL1ReorgTracker(ctx context.Context, log log.Logger, l1 L1BlockRefs, maxDepth uint64) *L1ReorgTracker {
+	t := &L1ReorgTracker{
+		ctx:      ctx,
+		log:      log,
+		l1:       l1,
+		maxDepth: maxDepth,
+		history:  make(map[uint64]eth.L1BlockRef),
+		checks:   make(chan l1ReorgCheck, l1ReorgQueueSize),
+		reorgs:   make(chan L1ReorgEvent, l1ReorgQueueSize),
+	}
+	if maxDepth > 0 {
+		go t.run()
+	}
+	return t
+}
+
+// Reorgs returns the channel the detected L1 reorgs are sent to.
+func (t *L1ReorgTracker) Reorgs() <-chan L1ReorgEvent {
+	return t.reorgs
+}
+
+func (t *L1ReorgTracker) OnEvent(ev event.Event) bool {
+	switch x := ev.(type) {
+	case L1UnsafeEvent:
+		t.onL1Unsafe(x.L1Unsafe)
+	default:
+		return false
+	}
+	return true
+}
+
+func (t *L1ReorgTracker) onL1Unsafe(head eth.L1BlockRef) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	prev := t.head
+	t.head = head
+	reorged := prev != (eth.L1BlockRef{}) && prev.Hash != head.Hash && prev.Hash != head.ParentHash
+	if t.maxDepth == 0 {
+		if reorged && prev.Number >= head.Number {
+			select {
+			case t.reorgs <- L1ReorgEvent{OldHead: prev, NewHead: head, Depth: prev.Number - head.Number}:
+			default: // the estimate is only used for metrics, and dropped if the driver is behind
+			}
+		}
+		return
+	}
+	if reorged {
+		check := l1ReorgCheck{prev: prev, head: head, history: maps.Clone(t.history)}
+		select {
+		case t.checks <- check:
+		default:
+			t.log.Warn("Too many L1 head changes queued, not checking for L1 reorg", "old_l1_head", prev, "new_l1_head", head)
+		}
+	}
+	for num := range t.history {
+		if num >= head.Number || num+t.maxDepth < head.Number {
+			delete(t.history, num)
+		}
+	}
+	t.history[head.Number] = head
+}
+
+func (t *L1ReorgTracker) run() {
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case check := <-t.checks:
+			depth, err := t.reorgDepth(&check)
+			if err != nil {
+				t.log.Warn("Failed to determine L1 reorg depth", "old_l1_head", check.prev, "new_l1_head", check.head, "err", err)
+				continue
+			}
+			if depth == 0 {
+				continue
+			}
+			t.log.Warn("Detected L1 reorg", "old_l1_head", check.prev, "new_l1_head", check.head, "depth", depth)
+			select {
+			case t.reorgs <- L1ReorgEvent{OldHead: check.prev, NewHead: check.head, Depth: depth}:
+			case <-t.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// reorgDepth returns the number of blocks of the previous chain, up to the previous head, that are not part of the chain of the new head.
+// It follows the parent hashes of the new chain, starting at the height of the previous head, until a block of the previous chain is found,
+// or until the depth exceeds the max L1 reorg depth. Blocks of the previous chain that were skipped, and are thus unknown,
+// are counted as reorged, so the depth may be over-estimated, but is never under-estimated.
+func (t *L1ReorgTracker) reorgDepth(check *l1ReorgCheck) (uint64, error) {
+	ctx, cancel := context.WithTimeout(t.ctx, l1ReorgFetchTimeout)
+	defer cancel()
+	prev, ref := check.prev, check.head
+	var fetched []eth.L1BlockRef
+	if ref.Number > prev.Number {
+		var err error
+		ref, err = t.l1.L1BlockRefByNumber(ctx, prev.Number)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch L1 block %d of the new chain: %w", prev.Number, err)
+		}
+		fetched = append(fetched, ref)
+	}
+	defer func() { t.replaceReorged(check, fetched) }()
+	for {
+		if hash, ok := check.hashAt(ref.Number); ok && hash == ref.Hash {
+			return prev.Number - ref.Number, nil
+		}
+		depth := prev.Number - ref.Number + 1
+		if depth > t.maxDepth || ref.Number == 0 {
+			return depth, nil
+		}
+		if hash, ok := check.hashAt(ref.Number - 1); ok && hash == ref.ParentHash {
+			return depth, nil
+		}
+		parentHash := ref.ParentHash
+		var err error
+		ref, err = t.l1.L1BlockRefByHash(ctx, parentHash)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch L1 block %s of the new chain: %w", parentHash, err)
+		}
+		fetched = append(fetched, ref)
+	}
+}
+
+// replaceReorged replaces the tracked blocks of the previous chain with the fetched blocks of the new chain,
+// so a later reorg is measured against the new chain. Blocks that were replaced by newer heads meanwhile are kept.
+func (t *L1ReorgTracker) replaceReorged(check *l1ReorgCheck, fetched []eth.L1BlockRef) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, ref := range fetched {
+		if cur, ok := t.history[ref.Number]; ok && cur == check.history[ref.Number] {
+			t.history[ref.Number] = ref
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// mockAltL1BlockRef returns a block of an alternative L1 chain, that is not built on the blocks of mockL1BlockRef.
+func mockAltL1BlockRef(num uint64, parent common.Hash) eth.L1BlockRef {
+	return eth.L1BlockRef{Number: num, Hash: common.Hash{0xaa, byte(num)}, ParentHash: parent}
+}
+
+func newTestL1ReorgTracker(t *testing.T, maxDepth uint64, heads ...eth.L1BlockRef) (*L1ReorgTracker, *testutils.MockL1Source) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	l1 := &testutils.MockL1Source{}
+	tracker := NewL1ReorgTracker(ctx, testlog.Logger(t, log.LevelDebug), l1, maxDepth)
+	for _, head := range heads {
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+	}
+	return tracker, l1
+}
+
+func mockL1BlockRefs(first, last uint64) []eth.L1BlockRef {
+	var refs []eth.L1BlockRef
+	for i := first; i <= last; i++ {
+		refs = append(refs, mockL1BlockRef(i))
+	}
+	return refs
+}
+
+func requireL1Reorg(t *testing.T, tracker *L1ReorgTracker, expected L1ReorgEvent) {
+	select {
+	case ev := <-tracker.Reorgs():
+		require.Equal(t, expected, ev)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for L1 reorg")
+	}
+}
+
+func TestL1ReorgTracker(t *testing.T) {
+	t.Run("one block reorg", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 102)...)
+		head := mockAltL1BlockRef(102, mockL1BlockRef(101).Hash)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(102), NewHead: head, Depth: 1})
+		l1.AssertExpectations(t)
+	})
+	t.Run("deep reorg", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 105)...)
+		alt103 := mockAltL1BlockRef(103, mockL1BlockRef(102).Hash)
+		head := mockAltL1BlockRef(104, alt103.Hash)
+		l1.ExpectL1BlockRefByHash(alt103.Hash, alt103, nil)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(105), NewHead: head, Depth: 3})
+		l1.AssertExpectations(t)
+	})
+	t.Run("reorg to higher head", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 102)...)
+		alt102 := mockAltL1BlockRef(102, mockL1BlockRef(101).Hash)
+		head := mockAltL1BlockRef(105, common.Hash{0xaa, 104})
+		l1.ExpectL1BlockRefByNumber(102, alt102, nil)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(102), NewHead: head, Depth: 1})
+		l1.AssertExpectations(t)
+	})
+	t.Run("history kept on skipped heads", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 102)...)
+		// Skip ahead without reorg
+		l1.ExpectL1BlockRefByNumber(102, mockL1BlockRef(102), nil)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: mockL1BlockRef(104)})
+		// Block 103 was skipped, its hash is known from the parent hash of block 104
+		alt103 := mockAltL1BlockRef(103, mockL1BlockRef(102).Hash)
+		head := mockAltL1BlockRef(104, alt103.Hash)
+		l1.ExpectL1BlockRefByHash(alt103.Hash, alt103, nil)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		// The skip ahead is not reported, the reorg is reported at its full depth
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(104), NewHead: head, Depth: 2})
+		l1.AssertExpectations(t)
+	})
+	t.Run("consecutive reorgs", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 104)...)
+		alt103 := mockAltL1BlockRef(103, mockL1BlockRef(102).Hash)
+		alt104 := mockAltL1BlockRef(104, alt103.Hash)
+		l1.ExpectL1BlockRefByHash(alt103.Hash, alt103, nil)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: alt104})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(104), NewHead: alt104, Depth: 2})
+
+		// The second reorg is measured against the new chain, of which block 103 was fetched
+		head := eth.L1BlockRef{Number: 104, Hash: common.Hash{0xbb, 104}, ParentHash: alt103.Hash}
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: alt104, NewHead: head, Depth: 1})
+		l1.AssertExpectations(t)
+	})
+	t.Run("deeper than max depth", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 1, mockL1BlockRefs(100, 105)...)
+		alt103 := mockAltL1BlockRef(103, mockL1BlockRef(102).Hash)
+		head := mockAltL1BlockRef(104, alt103.Hash)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		// The search for the common ancestor stops once the depth exceeds the max depth
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(105), NewHead: head, Depth: 2})
+		l1.AssertExpectations(t)
+	})
+	t.Run("fetch error", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 10, mockL1BlockRefs(100, 105)...)
+		alt103 := mockAltL1BlockRef(103, mockL1BlockRef(102).Hash)
+		head := mockAltL1BlockRef(104, alt103.Hash)
+		l1.ExpectL1BlockRefByHash(alt103.Hash, eth.L1BlockRef{}, errors.New("boom"))
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+
+		// the new head is tracked regardless, and a later reorg of it is reported
+		next := mockAltL1BlockRef(105, head.Hash)
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: next})
+		reorged := eth.L1BlockRef{Number: 105, Hash: common.Hash{0xbb, 105}, ParentHash: head.Hash}
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: reorged})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: next, NewHead: reorged, Depth: 1})
+		l1.AssertExpectations(t)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		tracker, l1 := newTestL1ReorgTracker(t, 0, mockL1BlockRefs(100, 105)...)
+		// No blocks are fetched, the depth is estimated from the heights of the heads
+		head := mockAltL1BlockRef(104, common.Hash{0xaa, 103})
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: head})
+		requireL1Reorg(t, tracker, L1ReorgEvent{OldHead: mockL1BlockRef(105), NewHead: head, Depth: 1})
+		tracker.OnEvent(L1UnsafeEvent{L1Unsafe: mockL1BlockRef(110)})
+		require.Empty(t, tracker.Reorgs())
+		require.Empty(t, tracker.checks)
+		require.Empty(t, tracker.history)
+		l1.AssertExpectations(t)
+	})
+}
@@ -91,15 +91,14 @@ func (st *StatusTracker) OnEvent(ev event.Event) bool {
 			// dealing with a linear extension (new block is the immediate child of the old one).
 			st.log.Debug("L1 head moved forward", "l1_head", x.L1Unsafe)
 		} else {
-			if st.data.HeadL1.Number >= x.L1Unsafe.Number {
-				st.metrics.RecordL1ReorgDepth(st.data.HeadL1.Number - x.L1Unsafe.Number)
-			}
 			// New L1 block is not the same as the current head or a single step linear extension.
 			// This could either be a long L1 extension, or a reorg, or we simply missed a head update.
 			st.log.Warn("L1 head signal indicates a possible L1 re-org",
 				"old_l1_head", st.data.HeadL1, "new_l1_head_parent", x.L1Unsafe.ParentHash, "new_l1_head", x.L1Unsafe)
 		}
 		st.data.HeadL1 = x.L1Unsafe
+	case L1ReorgEvent:
+		st.metrics.RecordL1ReorgDepth(x.Depth)
 	case L1SafeEvent:
 		st.log.Info("New L1 safe block", "l1_safe", x.L1Safe)
 		st.metrics.RecordL1Ref("l1_safe", x.L1Safe)
@@ -3,6 +3,7 @@ package sync
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type Mode int
@@ -72,4 +73,8 @@ type Config struct {
 	SkipSyncStartCheck bool `json:"skip_sync_start_check"`
 
 	SupportsPostFinalizationELSync bool `json:"supports_post_finalization_elsync"`
+
+	// UnsafeQuarantineTTL is how long unsafe payloads referencing an L1 origin ahead of the L1 head are held,
+	// until the L1 head catches up. If 0, unsafe payloads are processed regardless of their L1 origin.
+	UnsafeQuarantineTTL time.Duration `json:"unsafe_quarantine_ttl"`
 }
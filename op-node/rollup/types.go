@@ -1,6 +1,7 @@
 package rollup
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -50,6 +52,92 @@ type Genesis struct {
 	SystemConfig eth.SystemConfig `json:"system_config"`
 }
 
+// InteropDependencySet is the set of chains that may send interop messages to the chain.
+type InteropDependencySet struct {
+	// ChainIDs of the chains in the dependency set. The chain itself is always part of its dependency set.
+	ChainIDs []*big.Int `json:"chain_ids"`
+	// ActivationTime is the L2 timestamp from which the dependency set applies.
+	// It must be at or after the Interop activation.
+	ActivationTime uint64 `json:"activation_time"`
+}
+
+// Check verifies the dependency set of the chain with the given chain ID and Interop activation time.
+func (d *InteropDependencySet) Check(l2ChainID *big.Int, interopTime *uint64) error {
+	if interopTime == nil {
+		return errors.New("interop dependency set requires interop to be scheduled")
+	}
+	if d.ActivationTime < *interopTime {
+		return fmt.Errorf("interop dependency set activates at %d, before interop at %d", d.ActivationTime, *interopTime)
+	}
+	includesSelf := false
+	seen := make(map[string]struct{}, len(d.ChainIDs))
+	for _, id := range d.ChainIDs {
+		if id == nil || id.Sign() < 1 {
+			return fmt.Errorf("interop dependency set has invalid chain ID %v", id)
+		}
+		if _, ok := seen[id.String()]; ok {
+			return fmt.Errorf("interop dependency set has duplicate chain ID %v", id)
+		}
+		seen[id.String()] = struct{}{}
+		includesSelf = includesSelf || id.Cmp(l2ChainID) == 0
+	}
+	if !includesSelf {
+		return fmt.Errorf("interop dependency set does not include the chain itself (%v)", l2ChainID)
+	}
+	return nil
+}
+
+// BatchInboxUpdate changes the batch inbox address, from the given L1 block onwards.
+type BatchInboxUpdate struct {
+	// L1Block is the first L1 block number in which batches are read from the new address.
+	L1Block uint64 `json:"l1_block"`
+	// Address is the new batch inbox address.
+	Address common.Address `json:"address"`
+}
+
+// checkBatchInboxSchedule verifies that the schedule only has non-zero addresses, in strictly increasing L1 block order,
+// and that no update activates at or before the L1 genesis block, where the initial batch inbox address applies.
+func checkBatchInboxSchedule(genesisL1 uint64, schedule []BatchInboxUpdate) error {
+	prev := genesisL1
+	for i, update := range schedule {
+		if update.Address == (common.Address{}) {
+			return fmt.Errorf("batch inbox update %d has no address", i)
+		}
+		if update.L1Block <= prev {
+			return fmt.Errorf("batch inbox update %d activates at L1 block %d, but must be after L1 block %d", i, update.L1Block, prev)
+		}
+		prev = update.L1Block
+	}
+	return nil
+}
+
+// zstdDictMagic is the magic number that zstd dictionaries start with, in little-endian order.
+var zstdDictMagic = []byte{0x37, 0xa4, 0x30, 0xec}
+
+// ZstdConfig enables zstd compressed batcher channels, activated like a hardfork.
+type ZstdConfig struct {
+	// ActivationTime is the L1 timestamp from which zstd compressed channels are accepted.
+	// It must be at or after the Fjord activation, which introduced versioned channel compression.
+	ActivationTime uint64 `json:"activation_time"`
+	// Dictionary is the zstd dictionary that channels may be compressed with. Optional.
+	// It is part of the chain config, as every node needs it to decompress the channels.
+	Dictionary hexutil.Bytes `json:"dictionary,omitempty"`
+}
+
+// Check verifies the zstd config against the Fjord activation time.
+func (z *ZstdConfig) Check(fjordTime *uint64) error {
+	if fjordTime == nil {
+		return errors.New("zstd compression requires fjord to be scheduled")
+	}
+	if z.ActivationTime < *fjordTime {
+		return fmt.Errorf("zstd compression activates at %d, before fjord at %d", z.ActivationTime, *fjordTime)
+	}
+	if len(z.Dictionary) > 0 && !bytes.HasPrefix(z.Dictionary, zstdDictMagic) {
+		return errors.New("zstd dictionary is not in the zstd dictionary format")
+	}
+	return nil
+}
+
 type AltDAConfig struct {
 	// L1 DataAvailabilityChallenge contract proxy address
 	DAChallengeAddress common.Address `json:"da_challenge_contract_address,omitempty"`
@@ -126,11 +214,20 @@ type Config struct {
 	// Active if InteropTime != nil && L2 block timestamp >= *InteropTime, inactive otherwise.
 	InteropTime *uint64 `json:"interop_time,omitempty"`
 
+	// InteropDependencySet is the set of chains that may send interop messages to this chain. Optional.
+	InteropDependencySet *InteropDependencySet `json:"interop_dependency_set,omitempty"`
+
+	// ZstdConfig enables zstd compressed batcher channels. Optional.
+	ZstdConfig *ZstdConfig `json:"zstd,omitempty"`
+
 	// Note: below addresses are part of the block-derivation process,
 	// and required to be the same network-wide to stay in consensus.
 
 	// L1 address that batches are sent to.
 	BatchInboxAddress common.Address `json:"batch_inbox_address"`
+	// BatchInboxSchedule rotates the L1 address that batches are sent to, at later L1 blocks. Optional.
+	// Before the first update, batches are sent to BatchInboxAddress.
+	BatchInboxSchedule []BatchInboxUpdate `json:"batch_inbox_schedule,omitempty"`
 	// L1 Deposit Contract Address
 	DepositContractAddress common.Address `json:"deposit_contract_address"`
 	// L1 System Config Address
@@ -290,6 +387,9 @@ func (cfg *Config) Check() error {
 	if cfg.BatchInboxAddress == (common.Address{}) {
 		return ErrMissingBatchInboxAddress
 	}
+	if err := checkBatchInboxSchedule(cfg.Genesis.L1.Number, cfg.BatchInboxSchedule); err != nil {
+		return err
+	}
 	if cfg.DepositContractAddress == (common.Address{}) {
 		return ErrMissingDepositContractAddress
 	}
@@ -311,23 +411,22 @@ func (cfg *Config) Check() error {
 	if err := validateAltDAConfig(cfg); err != nil {
 		return err
 	}
-
-	if err := checkFork(cfg.RegolithTime, cfg.CanyonTime, Regolith, Canyon); err != nil {
-		return err
-	}
-	if err := checkFork(cfg.CanyonTime, cfg.DeltaTime, Canyon, Delta); err != nil {
-		return err
-	}
-	if err := checkFork(cfg.DeltaTime, cfg.EcotoneTime, Delta, Ecotone); err != nil {
-		return err
+	if cfg.InteropDependencySet != nil {
+		if err := cfg.InteropDependencySet.Check(cfg.L2ChainID, cfg.InteropTime); err != nil {
+			return err
+		}
 	}
-	if err := checkFork(cfg.EcotoneTime, cfg.FjordTime, Ecotone, Fjord); err != nil {
-		return err
+
+	if cfg.ZstdConfig != nil {
+		if err := cfg.ZstdConfig.Check(cfg.FjordTime); err != nil {
+			return err
+		}
 	}
-	if err := checkFork(cfg.FjordTime, cfg.GraniteTime, Fjord, Granite); err != nil {
+
+	if err := cfg.CheckForkOrder(); err != nil {
 		return err
 	}
-	if err := checkFork(cfg.GraniteTime, cfg.HoloceneTime, Granite, Holocene); err != nil {
+	if err := checkPredeployForks(); err != nil {
 		return err
 	}
 
@@ -350,6 +449,17 @@ func validateAltDAConfig(cfg *Config) error {
 	return nil
 }
 
+// CheckForkOrder checks that every scheduled fork that is set activates at or after the fork before it.
+func (cfg *Config) CheckForkOrder() error {
+	for i := 1; i < len(ScheduledForks); i++ {
+		prev, fork := ScheduledForks[i-1], ScheduledForks[i]
+		if err := checkFork(*prev.Time(cfg), *fork.Time(cfg), prev.Name, fork.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // checkFork checks that fork A is before or at the same time as fork B
 func checkFork(a, b *uint64, aName, bName ForkName) error {
 	if a == nil && b == nil {
@@ -371,6 +481,23 @@ func (c *Config) L1Signer() types.Signer {
 	return types.NewCancunSigner(c.L1ChainID)
 }
 
+// BatchInboxAddressAt returns the batch inbox address that applies to the L1 block with the given number.
+func (c *Config) BatchInboxAddressAt(l1Num uint64) common.Address {
+	addr := c.BatchInboxAddress
+	for _, update := range c.BatchInboxSchedule {
+		if update.L1Block > l1Num {
+			break
+		}
+		addr = update.Address
+	}
+	return addr
+}
+
+// IsZstd returns true if zstd compressed channels are accepted in L1 blocks at or past the given timestamp.
+func (c *Config) IsZstd(l1Timestamp uint64) bool {
+	return c.ZstdConfig != nil && l1Timestamp >= c.ZstdConfig.ActivationTime
+}
+
 // IsRegolith returns true if the Regolith hardfork is active at or past the given timestamp.
 func (c *Config) IsRegolith(timestamp uint64) bool {
 	return c.RegolithTime != nil && timestamp >= *c.RegolithTime
@@ -615,6 +742,10 @@ func (c *Config) Description(l2Chains map[string]string) string {
 	banner += fmt.Sprintf("  - Interop: %s\n", fmtForkTimeOrUnset(c.InteropTime))
 	// Report the protocol version
 	banner += fmt.Sprintf("Node supports up to OP-Stack Protocol Version: %s\n", OPStackSupport)
+	if c.ZstdConfig != nil {
+		banner += fmt.Sprintf("Zstd channel compression: %s (L1 time), dictionary: %d bytes\n",
+			fmtForkTimeOrUnset(&c.ZstdConfig.ActivationTime), len(c.ZstdConfig.Dictionary))
+	}
 	if c.AltDAConfig != nil {
 		banner += fmt.Sprintf("Node supports Alt-DA Mode with CommitmentType %v\n", c.AltDAConfig.CommitmentType)
 	}
@@ -649,6 +780,7 @@ func (c *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 		"granite_time", fmtForkTimeOrUnset(c.GraniteTime),
 		"holocene_time", fmtForkTimeOrUnset(c.HoloceneTime),
 		"interop_time", fmtForkTimeOrUnset(c.InteropTime),
+		"zstd", c.ZstdConfig != nil,
 		"alt_da", c.AltDAConfig != nil,
 	)
 }
@@ -528,6 +528,148 @@ func TestConfig_Check(t *testing.T) {
 	}
 }
 
+func TestInteropDependencySetCheck(t *testing.T) {
+	interopTime := uint64(100)
+	tests := []struct {
+		name        string
+		modifier    func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:     "Valid",
+			modifier: func(cfg *Config) {},
+		},
+		{
+			name:        "InteropNotScheduled",
+			modifier:    func(cfg *Config) { cfg.InteropTime = nil },
+			expectedErr: "requires interop to be scheduled",
+		},
+		{
+			name:        "ActivationBeforeInterop",
+			modifier:    func(cfg *Config) { cfg.InteropDependencySet.ActivationTime = interopTime - 1 },
+			expectedErr: "before interop",
+		},
+		{
+			name: "DuplicateChainID",
+			modifier: func(cfg *Config) {
+				cfg.InteropDependencySet.ChainIDs = append(cfg.InteropDependencySet.ChainIDs, big.NewInt(12345))
+			},
+			expectedErr: "duplicate chain ID 12345",
+		},
+		{
+			name: "InvalidChainID",
+			modifier: func(cfg *Config) {
+				cfg.InteropDependencySet.ChainIDs = append(cfg.InteropDependencySet.ChainIDs, big.NewInt(0))
+			},
+			expectedErr: "invalid chain ID 0",
+		},
+		{
+			name:        "MissingSelf",
+			modifier:    func(cfg *Config) { cfg.InteropDependencySet.ChainIDs = []*big.Int{big.NewInt(12345)} },
+			expectedErr: "does not include the chain itself",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := randConfig()
+			cfg.ActivateAtGenesis(Holocene)
+			cfg.InteropTime = &interopTime
+			cfg.InteropDependencySet = &InteropDependencySet{
+				ChainIDs:       []*big.Int{new(big.Int).Set(cfg.L2ChainID), big.NewInt(12345)},
+				ActivationTime: interopTime,
+			}
+			test.modifier(cfg)
+			err := cfg.Check()
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestBatchInboxSchedule(t *testing.T) {
+	cfg := randConfig()
+	cfg.Genesis.L1.Number = 100
+	initial, second, third := common.Address{0x01}, common.Address{0x02}, common.Address{0x03}
+	cfg.BatchInboxAddress = initial
+	cfg.BatchInboxSchedule = []BatchInboxUpdate{{L1Block: 200, Address: second}, {L1Block: 300, Address: third}}
+	require.NoError(t, cfg.Check())
+
+	require.Equal(t, initial, cfg.BatchInboxAddressAt(100))
+	require.Equal(t, initial, cfg.BatchInboxAddressAt(199))
+	require.Equal(t, second, cfg.BatchInboxAddressAt(200))
+	require.Equal(t, second, cfg.BatchInboxAddressAt(299))
+	require.Equal(t, third, cfg.BatchInboxAddressAt(300))
+	require.Equal(t, third, cfg.BatchInboxAddressAt(1000))
+
+	cfg.BatchInboxSchedule = []BatchInboxUpdate{{L1Block: 300, Address: second}, {L1Block: 300, Address: third}}
+	require.ErrorContains(t, cfg.Check(), "batch inbox update 1 activates at L1 block 300")
+	cfg.BatchInboxSchedule = []BatchInboxUpdate{{L1Block: 100, Address: second}}
+	require.ErrorContains(t, cfg.Check(), "batch inbox update 0 activates at L1 block 100")
+	cfg.BatchInboxSchedule = []BatchInboxUpdate{{L1Block: 200}}
+	require.ErrorContains(t, cfg.Check(), "batch inbox update 0 has no address")
+}
+
+func TestZstdConfigCheck(t *testing.T) {
+	fjordTime := uint64(100)
+	dict := append([]byte{0x37, 0xa4, 0x30, 0xec}, 0x01, 0x02, 0x03)
+	tests := []struct {
+		name        string
+		modifier    func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:     "Valid",
+			modifier: func(cfg *Config) {},
+		},
+		{
+			name:     "NoDictionary",
+			modifier: func(cfg *Config) { cfg.ZstdConfig.Dictionary = nil },
+		},
+		{
+			name:        "FjordNotScheduled",
+			modifier:    func(cfg *Config) { cfg.FjordTime = nil },
+			expectedErr: "requires fjord to be scheduled",
+		},
+		{
+			name:        "ActivationBeforeFjord",
+			modifier:    func(cfg *Config) { cfg.ZstdConfig.ActivationTime = fjordTime - 1 },
+			expectedErr: "before fjord",
+		},
+		{
+			name:        "InvalidDictionary",
+			modifier:    func(cfg *Config) { cfg.ZstdConfig.Dictionary = []byte{0x01, 0x02, 0x03, 0x04} },
+			expectedErr: "not in the zstd dictionary format",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := randConfig()
+			cfg.ActivateAtGenesis(Ecotone)
+			cfg.FjordTime = &fjordTime
+			cfg.ZstdConfig = &ZstdConfig{ActivationTime: fjordTime, Dictionary: dict}
+			test.modifier(cfg)
+			err := cfg.Check()
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestIsZstd(t *testing.T) {
+	cfg := randConfig()
+	require.False(t, cfg.IsZstd(1000))
+	cfg.ZstdConfig = &ZstdConfig{ActivationTime: 100}
+	require.False(t, cfg.IsZstd(99))
+	require.True(t, cfg.IsZstd(100))
+	require.True(t, cfg.IsZstd(1000))
+}
+
 func TestTimestampForBlock(t *testing.T) {
 	config := randConfig()
 
@@ -1,6 +1,7 @@
 package opnode
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,8 @@ import (
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +23,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/node"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	p2pcli "github.com/ethereum-optimism/optimism/op-node/p2p/cli"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
@@ -92,6 +96,12 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			ListenAddr:  ctx.String(flags.RPCListenAddr.Name),
 			ListenPort:  ctx.Int(flags.RPCListenPort.Name),
 			EnableAdmin: ctx.Bool(flags.RPCEnableAdmin.Name),
+
+			EnableTxConditional:  ctx.Bool(flags.RPCEnableTxConditional.Name),
+			TxConditionalMaxCost: ctx.Int(flags.RPCTxConditionalMaxCost.Name),
+
+			EnableP2PTopology:    ctx.Bool(flags.RPCEnableP2PTopology.Name),
+			P2PTopologyAddresses: p2p.AddressPolicy(ctx.String(flags.RPCP2PTopologyAddresses.Name)),
 		},
 		Metrics: node.MetricsConfig{
 			Enabled:    ctx.Bool(flags.MetricsEnabledFlag.Name),
@@ -102,11 +112,14 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2P:                         p2pConfig,
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
+		L1Health:                    NewL1HealthConfig(ctx, l1Endpoint),
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
 		ConfigPersistence:           configPersistence,
 		SafeDBPath:                  ctx.String(flags.SafeDBPath.Name),
 		Sync:                        *syncConfig,
 		RollupHalt:                  haltOption,
+		RollupHaltAction:            ctx.String(flags.RollupHaltAction.Name),
+		RollupOverrides:             NewConfigOverridesFromCLI(ctx),
 
 		ConductorEnabled:    ctx.Bool(flags.ConductorEnabledFlag.Name),
 		ConductorRpc:        ctx.String(flags.ConductorRpcFlag.Name),
@@ -130,6 +143,21 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 	return cfg, nil
 }
 
+// NewL1HealthConfig reads the L1 health monitor config. The secondary L1 RPC uses the same client settings as the L1 RPC.
+func NewL1HealthConfig(ctx *cli.Context, l1Endpoint *node.L1EndpointConfig) node.L1HealthConfig {
+	cfg := node.L1HealthConfig{
+		Interval: ctx.Duration(flags.L1HealthIntervalFlag.Name),
+		MaxLag:   ctx.Uint64(flags.L1HealthMaxLagFlag.Name),
+	}
+	if addr := ctx.String(flags.L1HealthSecondaryRPCFlag.Name); addr != "" {
+		secondary := *l1Endpoint
+		secondary.L1NodeAddr = addr
+		secondary.L1TrustRPC = false
+		cfg.Secondary = &secondary
+	}
+	return cfg
+}
+
 func NewSupervisorEndpointConfig(ctx *cli.Context) node.SupervisorEndpointSetup {
 	return &node.SupervisorEndpointConfig{
 		SupervisorAddr: ctx.String(flags.SupervisorAddr.Name),
@@ -155,24 +183,20 @@ func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
 		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
 		MaxConcurrency:   ctx.Int(flags.L1RPCMaxConcurrency.Name),
+		CacheDir:         ctx.String(flags.L1CacheDirFlag.Name),
+		CacheSize:        ctx.Uint64(flags.L1CacheSizeFlag.Name) * 1024 * 1024,
 	}
 }
 
 func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConfig, error) {
 	l2Addr := ctx.String(flags.L2EngineAddr.Name)
 	fileName := ctx.String(flags.L2EngineJWTSecret.Name)
-	var secret [32]byte
 	fileName = strings.TrimSpace(fileName)
 	if fileName == "" {
 		return nil, fmt.Errorf("file-name of jwt secret is empty")
 	}
-	if data, err := os.ReadFile(fileName); err == nil {
-		jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
-		if len(jwtSecret) != 32 {
-			return nil, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", fileName)
-		}
-		copy(secret[:], jwtSecret)
-	} else {
+	secret, err := sources.ReadJWTSecret(fileName)
+	if errors.Is(err, os.ErrNotExist) {
 		log.Warn("Failed to read JWT secret from file, generating a new one now. Configure L2 geth with --authrpc.jwt-secret=" + fmt.Sprintf("%q", fileName))
 		if _, err := io.ReadFull(rand.Reader, secret[:]); err != nil {
 			return nil, fmt.Errorf("failed to generate jwt secret: %w", err)
@@ -180,11 +204,15 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 		if err := os.WriteFile(fileName, []byte(hexutil.Encode(secret[:])), 0o600); err != nil {
 			return nil, err
 		}
+	} else if err != nil {
+		return nil, err
 	}
 
 	return &node.L2EndpointConfig{
-		L2EngineAddr:      l2Addr,
-		L2EngineJWTSecret: secret,
+		L2EngineAddr:              l2Addr,
+		L2EngineJWTSecret:         secret,
+		L2EngineJWTSecretPath:     fileName,
+		L2EngineJWTRotationWindow: ctx.Duration(flags.L2EngineJWTRotationWindow.Name),
 	}, nil
 }
 
@@ -203,6 +231,17 @@ func NewDriverConfig(ctx *cli.Context) *driver.Config {
 		SequencerEnabled:    ctx.Bool(flags.SequencerEnabledFlag.Name),
 		SequencerStopped:    ctx.Bool(flags.SequencerStoppedFlag.Name),
 		SequencerMaxSafeLag: ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
+
+		SequencerClockTargetDrift:   ctx.Duration(flags.SequencerClockTargetDriftFlag.Name),
+		SequencerClockMaxAdjustment: ctx.Duration(flags.SequencerClockMaxAdjustmentFlag.Name),
+
+		SequencerBudgetFCU:        ctx.Duration(flags.SequencerBudgetFCUFlag.Name),
+		SequencerBudgetGetPayload: ctx.Duration(flags.SequencerBudgetGetPayloadFlag.Name),
+		SequencerBudgetPublish:    ctx.Duration(flags.SequencerBudgetPublishFlag.Name),
+
+		DataSource: ctx.String(flags.L1DataSourceFlag.Name),
+
+		MaxL1ReorgDepth: ctx.Uint64(flags.L1MaxReorgDepthFlag.Name),
 	}
 }
 
@@ -216,7 +255,9 @@ func NewRollupConfigFromCLI(log log.Logger, ctx *cli.Context) (*rollup.Config, e
 	if err != nil {
 		return nil, err
 	}
-	applyOverrides(ctx, rollupConfig)
+	if err := applyOverrides(log, ctx, rollupConfig); err != nil {
+		return nil, err
+	}
 	return rollupConfig, nil
 }
 
@@ -235,14 +276,17 @@ Conflicting configuration is deprecated, and will stop the op-node from starting
 		return rollupConfig, nil
 	}
 
-	file, err := os.Open(rollupConfigPath)
+	file, err := os.ReadFile(rollupConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rollup config: %w", err)
+	}
+	file, err = jsonutil.ToJSON(file, jsonutil.FormatFromPath(rollupConfigPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read rollup config: %w", err)
 	}
-	defer file.Close()
 
 	var rollupConfig rollup.Config
-	dec := json.NewDecoder(file)
+	dec := json.NewDecoder(bytes.NewReader(file))
 	dec.DisallowUnknownFields()
 	if err := dec.Decode(&rollupConfig); err != nil {
 		return nil, fmt.Errorf("failed to decode rollup config: %w", err)
@@ -250,31 +294,78 @@ Conflicting configuration is deprecated, and will stop the op-node from starting
 	return &rollupConfig, nil
 }
 
-func applyOverrides(ctx *cli.Context, rollupConfig *rollup.Config) {
-	if ctx.IsSet(opflags.CanyonOverrideFlagName) {
-		canyon := ctx.Uint64(opflags.CanyonOverrideFlagName)
-		rollupConfig.CanyonTime = &canyon
+type rollupOverride struct {
+	flag  string
+	field func(o *eth.ConfigOverrides) **uint64
+}
+
+var rollupOverrides = []rollupOverride{
+	{opflags.SeqWindowSizeOverrideFlagName, func(o *eth.ConfigOverrides) **uint64 { return &o.SeqWindowSize }},
+	{opflags.ChannelTimeoutOverrideFlagName, func(o *eth.ConfigOverrides) **uint64 { return &o.ChannelTimeout }},
+}
+
+// NewConfigOverridesFromCLI returns the rollup config overrides set by the --override.* flags,
+// or nil if none are set.
+func NewConfigOverridesFromCLI(ctx *cli.Context) *eth.ConfigOverrides {
+	var overrides *eth.ConfigOverrides
+	for _, o := range rollupOverrides {
+		if !ctx.IsSet(o.flag) {
+			continue
+		}
+		if overrides == nil {
+			overrides = new(eth.ConfigOverrides)
+		}
+		value := ctx.Uint64(o.flag)
+		*o.field(overrides) = &value
 	}
-	if ctx.IsSet(opflags.DeltaOverrideFlagName) {
-		delta := ctx.Uint64(opflags.DeltaOverrideFlagName)
-		rollupConfig.DeltaTime = &delta
+	for _, fork := range rollup.ScheduledForks {
+		flag := opflags.ForkOverrideFlagName(fork.Name)
+		if !ctx.IsSet(flag) {
+			continue
+		}
+		if overrides == nil {
+			overrides = new(eth.ConfigOverrides)
+		}
+		if overrides.ForkTimes == nil {
+			overrides.ForkTimes = make(map[string]uint64)
+		}
+		overrides.ForkTimes[string(fork.Name)] = ctx.Uint64(flag)
 	}
-	if ctx.IsSet(opflags.EcotoneOverrideFlagName) {
-		ecotone := ctx.Uint64(opflags.EcotoneOverrideFlagName)
-		rollupConfig.EcotoneTime = &ecotone
+	return overrides
+}
+
+// applyOverrides applies the --override.* flags to the rollup config,
+// and checks that the fork activation times remain in order.
+func applyOverrides(log log.Logger, ctx *cli.Context, rollupConfig *rollup.Config) error {
+	overrides := NewConfigOverridesFromCLI(ctx)
+	if overrides == nil {
+		return nil
+	}
+	for _, o := range rollupOverrides {
+		if value := *o.field(overrides); value != nil {
+			log.Warn("!!! ROLLUP CONFIG OVERRIDDEN !!! Running with a parameter that differs from the bundled or configured rollup config. "+
+				"This must only be used as part of a coordinated response, as it will cause the node to diverge from nodes without the same override.",
+				"flag", o.flag, "value", *value)
+		}
+	}
+	if overrides.SeqWindowSize != nil {
+		rollupConfig.SeqWindowSize = *overrides.SeqWindowSize
 	}
-	if ctx.IsSet(opflags.FjordOverrideFlagName) {
-		fjord := ctx.Uint64(opflags.FjordOverrideFlagName)
-		rollupConfig.FjordTime = &fjord
+	if overrides.ChannelTimeout != nil {
+		rollupConfig.ChannelTimeoutBedrock = *overrides.ChannelTimeout
 	}
-	if ctx.IsSet(opflags.GraniteOverrideFlagName) {
-		granite := ctx.Uint64(opflags.GraniteOverrideFlagName)
-		rollupConfig.GraniteTime = &granite
+	for _, fork := range rollup.ScheduledForks {
+		value, ok := overrides.ForkTimes[string(fork.Name)]
+		if !ok {
+			continue
+		}
+		log.Warn("Overriding fork activation time", "fork", fork.Name, "time", value)
+		*fork.Time(rollupConfig) = &value
 	}
-	if ctx.IsSet(opflags.HoloceneOverrideFlagName) {
-		holocene := ctx.Uint64(opflags.HoloceneOverrideFlagName)
-		rollupConfig.HoloceneTime = &holocene
+	if err := rollupConfig.CheckForkOrder(); err != nil {
+		return fmt.Errorf("invalid fork overrides: %w", err)
 	}
+	return nil
 }
 
 func NewSyncConfig(ctx *cli.Context, log log.Logger) (*sync.Config, error) {
@@ -292,6 +383,7 @@ func NewSyncConfig(ctx *cli.Context, log log.Logger) (*sync.Config, error) {
 	cfg := &sync.Config{
 		SyncMode:                       mode,
 		SkipSyncStartCheck:             ctx.Bool(flags.SkipSyncStartCheck.Name),
+		UnsafeQuarantineTTL:            ctx.Duration(flags.UnsafeQuarantineTTL.Name),
 		SupportsPostFinalizationELSync: engineKind.SupportsPostFinalizationELSync(),
 	}
 	if ctx.Bool(flags.L2EngineSyncEnabled.Name) {
@@ -0,0 +1,133 @@
+package preimage
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// KeyTypeInfo describes a pre-image key type, shared by the VMs, the host and the challenger,
+// so new key types only have to be registered once.
+type KeyTypeInfo struct {
+	Type KeyType
+	// Name is the short name of the key type, e.g. as used in CLI flags.
+	Name string
+	// Local is true if the pre-images are specific to the local program instance,
+	// rather than global data that is shared by all program instances.
+	Local bool
+}
+
+var (
+	keyTypesLock sync.RWMutex
+	keyTypes     = make(map[KeyType]KeyTypeInfo)
+)
+
+// RegisterKeyType adds a pre-image key type. Key types must be registered before use, i.e. from an init function.
+// Panics if the key type or name is already registered, or the key type is zero,
+// since this indicates a significant programmer error.
+func RegisterKeyType(info KeyTypeInfo) {
+	keyTypesLock.Lock()
+	defer keyTypesLock.Unlock()
+	if info.Type == 0 {
+		panic("the zero key type is illegal")
+	}
+	if existing, ok := keyTypes[info.Type]; ok {
+		panic(fmt.Errorf("key type %d is already registered as %q", info.Type, existing.Name))
+	}
+	for _, existing := range keyTypes {
+		if existing.Name == info.Name {
+			panic(fmt.Errorf("key type name %q is already registered for key type %d", info.Name, existing.Type))
+		}
+	}
+	keyTypes[info.Type] = info
+}
+
+// LookupKeyType returns the info of a registered key type.
+func LookupKeyType(t KeyType) (KeyTypeInfo, bool) {
+	keyTypesLock.RLock()
+	defer keyTypesLock.RUnlock()
+	info, ok := keyTypes[t]
+	return info, ok
+}
+
+// KeyTypeByName returns the info of the registered key type with the given name.
+func KeyTypeByName(name string) (KeyTypeInfo, bool) {
+	keyTypesLock.RLock()
+	defer keyTypesLock.RUnlock()
+	for _, info := range keyTypes {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return KeyTypeInfo{}, false
+}
+
+// KeyTypes returns all registered key types, ordered by key type.
+func KeyTypes() []KeyTypeInfo {
+	keyTypesLock.RLock()
+	defer keyTypesLock.RUnlock()
+	result := make([]KeyTypeInfo, 0, len(keyTypes))
+	for _, info := range keyTypes {
+		result = append(result, info)
+	}
+	slices.SortFunc(result, func(a, b KeyTypeInfo) int {
+		return int(a.Type) - int(b.Type)
+	})
+	return result
+}
+
+// KeyTypeBehaviors holds the behavior of a component for each key type, e.g. how the pre-images of the key type
+// are loaded or uploaded, so that a new key type is supported by registering its behaviors rather than by
+// changing the component. Behaviors must be registered before use, i.e. from an init function.
+// The zero value is ready to use.
+type KeyTypeBehaviors[B any] struct {
+	lock      sync.RWMutex
+	behaviors map[KeyType]B
+}
+
+// Register adds the behavior of a key type.
+// Panics if the key type is not registered, or already has a behavior, since this indicates a significant programmer error.
+func (r *KeyTypeBehaviors[B]) Register(t KeyType, behavior B) {
+	if _, ok := LookupKeyType(t); !ok {
+		panic(fmt.Errorf("key type %d is not registered", t))
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.behaviors[t]; ok {
+		panic(fmt.Errorf("duplicate behavior for key type %v", t))
+	}
+	if r.behaviors == nil {
+		r.behaviors = make(map[KeyType]B)
+	}
+	r.behaviors[t] = behavior
+}
+
+// Lookup returns the behavior of the key type, if it has one.
+func (r *KeyTypeBehaviors[B]) Lookup(t KeyType) (B, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	behavior, ok := r.behaviors[t]
+	return behavior, ok
+}
+
+// IsLocal returns true if the key type is a registered local key type.
+func (t KeyType) IsLocal() bool {
+	info, ok := LookupKeyType(t)
+	return ok && info.Local
+}
+
+func (t KeyType) String() string {
+	if info, ok := LookupKeyType(t); ok {
+		return info.Name
+	}
+	return fmt.Sprintf("unknown(%d)", byte(t))
+}
+
+func init() {
+	RegisterKeyType(KeyTypeInfo{Type: LocalKeyType, Name: "local", Local: true})
+	RegisterKeyType(KeyTypeInfo{Type: Keccak256KeyType, Name: "keccak"})
+	RegisterKeyType(KeyTypeInfo{Type: GlobalGenericKeyType, Name: "global-generic"})
+	RegisterKeyType(KeyTypeInfo{Type: Sha256KeyType, Name: "sha256"})
+	RegisterKeyType(KeyTypeInfo{Type: BlobKeyType, Name: "blob"})
+	RegisterKeyType(KeyTypeInfo{Type: PrecompileKeyType, Name: "precompile"})
+}
@@ -0,0 +1,57 @@
+package preimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInKeyTypes(t *testing.T) {
+	for _, info := range KeyTypes() {
+		byName, ok := KeyTypeByName(info.Name)
+		require.True(t, ok)
+		require.Equal(t, info, byName)
+		require.Equal(t, info.Name, info.Type.String())
+	}
+	require.True(t, LocalKeyType.IsLocal())
+	require.False(t, Keccak256KeyType.IsLocal())
+	require.Equal(t, "precompile", PrecompileKeyType.String())
+	require.Equal(t, "unknown(200)", KeyType(200).String())
+	require.False(t, KeyType(200).IsLocal())
+}
+
+func TestRegisterKeyType(t *testing.T) {
+	custom := KeyTypeInfo{Type: 100, Name: "test-custom"}
+	RegisterKeyType(custom)
+	info, ok := LookupKeyType(100)
+	require.True(t, ok)
+	require.Equal(t, custom, info)
+	require.Contains(t, KeyTypes(), custom)
+
+	require.Panics(t, func() { RegisterKeyType(KeyTypeInfo{Type: 100, Name: "other"}) }, "duplicate type")
+	require.Panics(t, func() { RegisterKeyType(KeyTypeInfo{Type: 101, Name: "keccak"}) }, "duplicate name")
+	require.Panics(t, func() { RegisterKeyType(KeyTypeInfo{Type: 0, Name: "zero"}) }, "zero type")
+}
+
+func TestKeyTypeBehaviors(t *testing.T) {
+	var behaviors KeyTypeBehaviors[string]
+	_, ok := behaviors.Lookup(Keccak256KeyType)
+	require.False(t, ok)
+
+	behaviors.Register(Keccak256KeyType, "keccak behavior")
+	behavior, ok := behaviors.Lookup(Keccak256KeyType)
+	require.True(t, ok)
+	require.Equal(t, "keccak behavior", behavior)
+	_, ok = behaviors.Lookup(Sha256KeyType)
+	require.False(t, ok)
+
+	require.Panics(t, func() { behaviors.Register(Keccak256KeyType, "other") }, "duplicate behavior")
+	require.Panics(t, func() { behaviors.Register(KeyType(201), "unknown") }, "unregistered key type")
+}
+
+func TestKeyTypesOrdered(t *testing.T) {
+	all := KeyTypes()
+	for i := 1; i < len(all); i++ {
+		require.Less(t, all[i-1].Type, all[i].Type)
+	}
+}
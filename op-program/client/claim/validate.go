@@ -12,6 +12,23 @@ import (
 
 var ErrClaimNotValid = errors.New("invalid claim")
 
+// ClaimMismatchError is returned when the claimed output root does not match the output root derived from L1 data.
+// It records the derived output root, and the block it is at, which is before the claimed block if the L1 data
+// does not include the batches of the claimed block.
+type ClaimMismatchError struct {
+	Claimed     eth.Bytes32
+	Actual      eth.Bytes32
+	BlockNumber uint64
+}
+
+func (e *ClaimMismatchError) Error() string {
+	return fmt.Sprintf("%v: claim: %v actual: %v", ErrClaimNotValid, e.Claimed, e.Actual)
+}
+
+func (e *ClaimMismatchError) Unwrap() error {
+	return ErrClaimNotValid
+}
+
 type L2Source interface {
 	L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error)
 	L2OutputRoot(uint64) (eth.Bytes32, error)
@@ -22,13 +39,14 @@ func ValidateClaim(log log.Logger, l2ClaimBlockNum uint64, claimedOutputRoot eth
 	if err != nil {
 		return fmt.Errorf("cannot retrieve safe head: %w", err)
 	}
-	outputRoot, err := src.L2OutputRoot(min(l2ClaimBlockNum, l2Head.Number))
+	blockNum := min(l2ClaimBlockNum, l2Head.Number)
+	outputRoot, err := src.L2OutputRoot(blockNum)
 	if err != nil {
 		return fmt.Errorf("calculate L2 output root: %w", err)
 	}
 	log.Info("Validating claim", "head", l2Head, "output", outputRoot, "claim", claimedOutputRoot)
 	if claimedOutputRoot != outputRoot {
-		return fmt.Errorf("%w: claim: %v actual: %v", ErrClaimNotValid, claimedOutputRoot, outputRoot)
+		return &ClaimMismatchError{Claimed: claimedOutputRoot, Actual: outputRoot, BlockNumber: blockNum}
 	}
 	return nil
 }
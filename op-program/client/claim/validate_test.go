@@ -86,6 +86,9 @@ func TestValidateClaim(t *testing.T) {
 		err := ValidateClaim(logger, uint64(20), eth.Bytes32{0x55}, l2)
 		require.ErrorIs(t, err, ErrClaimNotValid)
 		require.Equal(t, uint64(10), l2.requestedOutputRoot)
+		var mismatch *ClaimMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		require.Equal(t, &ClaimMismatchError{Claimed: eth.Bytes32{0x55}, Actual: eth.Bytes32{0x22}, BlockNumber: 10}, mismatch)
 	})
 
 	t.Run("Error-safe-head", func(t *testing.T) {
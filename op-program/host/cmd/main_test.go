@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 	"strconv"
 	"testing"
@@ -160,6 +161,52 @@ func TestL2Genesis(t *testing.T) {
 	})
 }
 
+func TestAdditionalL2Chains(t *testing.T) {
+	writeRollupConfig := func(t *testing.T, chainID int64) string {
+		rollupCfg := *chaincfg.Sepolia
+		rollupCfg.L2ChainID = big.NewInt(chainID)
+		j, err := json.Marshal(rollupCfg)
+		require.NoError(t, err)
+		cfgFile := t.TempDir() + "/rollup.json"
+		require.NoError(t, os.WriteFile(cfgFile, j, 0666))
+		return cfgFile
+	}
+
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.L2Chains)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--rollup.config.additional", writeRollupConfig(t, 902),
+			"--rollup.config.additional", writeRollupConfig(t, 903),
+			"--l2.additional", "902=http://example.com:9902",
+			"--l2.additional", "903=http://example.com:9903"))
+		require.Len(t, cfg.L2Chains, 2)
+		require.EqualValues(t, 902, cfg.L2Chains[902].Rollup.L2ChainID.Uint64())
+		require.Equal(t, "http://example.com:9902", cfg.L2Chains[902].L2URL)
+		require.Equal(t, "http://example.com:9903", cfg.L2Chains[903].L2URL)
+	})
+
+	t.Run("InvalidL2Format", func(t *testing.T) {
+		verifyArgsInvalid(t, "expected <chain ID>=<url>", addRequiredArgs(
+			"--rollup.config.additional", writeRollupConfig(t, 902),
+			"--l2.additional", "http://example.com:9902"))
+	})
+
+	t.Run("L2WithoutRollupConfig", func(t *testing.T) {
+		verifyArgsInvalid(t, "no rollup config for l2 rpc", addRequiredArgs(
+			"--l2.additional", "902=http://example.com:9902"))
+	})
+
+	t.Run("DuplicateRollupConfig", func(t *testing.T) {
+		verifyArgsInvalid(t, "duplicate rollup config", addRequiredArgs(
+			"--rollup.config.additional", writeRollupConfig(t, 902),
+			"--rollup.config.additional", writeRollupConfig(t, 902)))
+	})
+}
+
 func TestL2Head(t *testing.T) {
 	t.Run("Required", func(t *testing.T) {
 		verifyArgsInvalid(t, "flag l2.head is required", addRequiredArgsExcept("--l2.head"))
@@ -301,6 +348,17 @@ func TestExec(t *testing.T) {
 	})
 }
 
+func TestPrefetchWorkers(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.PrefetchWorkers)
+	})
+	t.Run("Set", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--prefetch.workers", "16"))
+		require.Equal(t, uint(16), cfg.PrefetchWorkers)
+	})
+}
+
 func TestServerMode(t *testing.T) {
 	t.Run("DefaultFalse", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
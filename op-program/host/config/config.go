@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
@@ -34,6 +36,7 @@ var (
 	ErrDataDirRequired     = errors.New("datadir must be specified when in non-fetching mode")
 	ErrNoExecInServerMode  = errors.New("exec command must not be set when in server mode")
 	ErrInvalidDataFormat   = errors.New("invalid data format")
+	ErrInvalidL2Chain      = errors.New("invalid additional l2 chain")
 )
 
 type Config struct {
@@ -68,6 +71,10 @@ type Config struct {
 	// If unset, the fault proof client is run in the same process.
 	ExecCmd string
 
+	// PrefetchWorkers is the number of concurrent fetches warming up the pre-images the client is expected to request.
+	// Warming up is disabled if 0.
+	PrefetchWorkers uint
+
 	// ServerMode indicates that the program should run in pre-image server mode and wait for requests.
 	// No client program is run.
 	ServerMode bool
@@ -75,12 +82,22 @@ type Config struct {
 	// IsCustomChainConfig indicates that the program uses a custom chain configuration
 	IsCustomChainConfig bool
 
+	// L2Chains are the additional L2 chains to serve pre-images for, keyed by chain ID.
+	// L2 hints that specify a chain ID are served from these chains, other L2 hints from the default chain above.
+	L2Chains map[uint64]*L2ChainConfig
+
 	// Optional process sources. Will be favored over the RPC sources if set.
 	L1ProcessSource       hostSources.L1Source
 	L1BeaconProcessSource hostSources.L1BlobSource
 	L2ProcessSource       hostSources.L2Source
 }
 
+// L2ChainConfig is the config of an additional L2 chain served by the host.
+type L2ChainConfig struct {
+	Rollup *rollup.Config
+	L2URL  string
+}
+
 func (c *Config) Check() error {
 	if c.Rollup == nil {
 		return ErrMissingRollupConfig
@@ -115,6 +132,30 @@ func (c *Config) Check() error {
 	if c.DataDir != "" && !slices.Contains(types.SupportedDataFormats, c.DataFormat) {
 		return ErrInvalidDataFormat
 	}
+	for chainID, chain := range c.L2Chains {
+		if err := c.checkL2Chain(chainID, chain); err != nil {
+			return fmt.Errorf("%w %d: %w", ErrInvalidL2Chain, chainID, err)
+		}
+	}
+	return nil
+}
+
+func (c *Config) checkL2Chain(chainID uint64, chain *L2ChainConfig) error {
+	if chain.Rollup == nil {
+		return ErrMissingRollupConfig
+	}
+	if err := chain.Rollup.Check(); err != nil {
+		return err
+	}
+	if chain.Rollup.L2ChainID.Uint64() != chainID {
+		return fmt.Errorf("rollup config is for chain %v", chain.Rollup.L2ChainID)
+	}
+	if chainID == c.Rollup.L2ChainID.Uint64() {
+		return errors.New("chain is already the default chain")
+	}
+	if c.L2URL != "" && chain.L2URL == "" {
+		return errors.New("missing l2 rpc url")
+	}
 	return nil
 }
 
@@ -182,25 +223,14 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 		return nil, ErrInvalidL1Head
 	}
 	l2GenesisPath := ctx.String(flags.L2GenesisPath.Name)
-	var l2ChainConfig *params.ChainConfig
-	var isCustomConfig bool
-	if l2GenesisPath == "" {
-		networkName := ctx.String(flags.Network.Name)
-		ch := chaincfg.ChainByName(networkName)
-		if ch == nil {
-			return nil, fmt.Errorf("flag %s is required for network %s", flags.L2GenesisPath.Name, networkName)
-		}
-		cfg, err := params.LoadOPStackChainConfig(ch.ChainID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load chain config for chain %d: %w", ch.ChainID, err)
-		}
-		l2ChainConfig = cfg
-	} else {
-		l2ChainConfig, err = loadChainConfigFromGenesis(l2GenesisPath)
-		isCustomConfig = true
+	l2ChainConfig, err := NewL2ChainConfig(ctx.String(flags.Network.Name), l2GenesisPath)
+	if err != nil {
+		return nil, err
 	}
+	isCustomConfig := l2GenesisPath != ""
+	l2Chains, err := loadL2Chains(log, ctx)
 	if err != nil {
-		return nil, fmt.Errorf("invalid genesis: %w", err)
+		return nil, err
 	}
 	dbFormat := types.DataFormat(ctx.String(flags.DataFormat.Name))
 	if !slices.Contains(types.SupportedDataFormats, dbFormat) {
@@ -223,10 +253,68 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 		L1RPCKind:           sources.RPCProviderKind(ctx.String(flags.L1RPCProviderKind.Name)),
 		ExecCmd:             ctx.String(flags.Exec.Name),
 		ServerMode:          ctx.Bool(flags.Server.Name),
+		PrefetchWorkers:     ctx.Uint(flags.PrefetchWorkers.Name),
 		IsCustomChainConfig: isCustomConfig,
+		L2Chains:            l2Chains,
 	}, nil
 }
 
+// loadL2Chains loads the rollup configs and L2 RPC urls of the additional L2 chains, keyed by chain ID.
+func loadL2Chains(log log.Logger, ctx *cli.Context) (map[uint64]*L2ChainConfig, error) {
+	chains := make(map[uint64]*L2ChainConfig)
+	for _, path := range ctx.StringSlice(flags.AdditionalRollupConfigs.Name) {
+		rollupCfg, err := opnode.NewRollupConfig(log, "", path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load rollup config %v: %w", path, err)
+		}
+		chainID := rollupCfg.L2ChainID.Uint64()
+		if _, ok := chains[chainID]; ok {
+			return nil, fmt.Errorf("%w %d: duplicate rollup config", ErrInvalidL2Chain, chainID)
+		}
+		chains[chainID] = &L2ChainConfig{Rollup: rollupCfg}
+	}
+	for _, entry := range ctx.StringSlice(flags.AdditionalL2NodeAddrs.Name) {
+		chainIDStr, url, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: expected <chain ID>=<url> but got %q", ErrInvalidL2Chain, entry)
+		}
+		chainID, err := strconv.ParseUint(chainIDStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid chain ID %q: %w", ErrInvalidL2Chain, chainIDStr, err)
+		}
+		chain, ok := chains[chainID]
+		if !ok {
+			return nil, fmt.Errorf("%w %d: no rollup config for l2 rpc %v", ErrInvalidL2Chain, chainID, url)
+		}
+		chain.L2URL = url
+	}
+	if len(chains) == 0 {
+		return nil, nil
+	}
+	return chains, nil
+}
+
+// NewL2ChainConfig loads the op-geth chain config of the L2 chain from the genesis file at l2GenesisPath if set,
+// or else from the superchain registry entry of the named network.
+func NewL2ChainConfig(network string, l2GenesisPath string) (*params.ChainConfig, error) {
+	if l2GenesisPath != "" {
+		l2ChainConfig, err := loadChainConfigFromGenesis(l2GenesisPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis: %w", err)
+		}
+		return l2ChainConfig, nil
+	}
+	ch := chaincfg.ChainByName(network)
+	if ch == nil {
+		return nil, fmt.Errorf("flag %s is required for network %s", flags.L2GenesisPath.Name, network)
+	}
+	l2ChainConfig, err := params.LoadOPStackChainConfig(ch.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chain config for chain %d: %w", ch.ChainID, err)
+	}
+	return l2ChainConfig, nil
+}
+
 func loadChainConfigFromGenesis(path string) (*params.ChainConfig, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -192,6 +192,59 @@ func TestDBFormat(t *testing.T) {
 	}
 }
 
+func TestAdditionalL2Chains(t *testing.T) {
+	chain := func(chainID int64, url string) *L2ChainConfig {
+		rollupCfg := *validRollupConfig
+		rollupCfg.L2ChainID = big.NewInt(chainID)
+		return &L2ChainConfig{Rollup: &rollupCfg, L2URL: url}
+	}
+	fetchingConfig := func() *Config {
+		cfg := validConfig()
+		cfg.L1URL = "http://localhost:8545"
+		cfg.L1BeaconURL = "http://localhost:5052"
+		cfg.L2URL = "http://localhost:9545"
+		return cfg
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := fetchingConfig()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{902: chain(902, "http://localhost:9902")}
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("MissingRollupConfig", func(t *testing.T) {
+		cfg := fetchingConfig()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{902: {L2URL: "http://localhost:9902"}}
+		require.ErrorIs(t, cfg.Check(), ErrInvalidL2Chain)
+		require.ErrorIs(t, cfg.Check(), ErrMissingRollupConfig)
+	})
+
+	t.Run("MismatchedChainID", func(t *testing.T) {
+		cfg := fetchingConfig()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{902: chain(903, "http://localhost:9903")}
+		require.ErrorIs(t, cfg.Check(), ErrInvalidL2Chain)
+	})
+
+	t.Run("DefaultChain", func(t *testing.T) {
+		cfg := fetchingConfig()
+		chainID := validRollupConfig.L2ChainID.Uint64()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{chainID: chain(int64(chainID), "http://localhost:9902")}
+		require.ErrorIs(t, cfg.Check(), ErrInvalidL2Chain)
+	})
+
+	t.Run("MissingL2URLWhenFetching", func(t *testing.T) {
+		cfg := fetchingConfig()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{902: chain(902, "")}
+		require.ErrorIs(t, cfg.Check(), ErrInvalidL2Chain)
+	})
+
+	t.Run("L2URLNotRequiredOffline", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.L2Chains = map[uint64]*L2ChainConfig{902: chain(902, "")}
+		require.NoError(t, cfg.Check())
+	})
+}
+
 func validConfig() *Config {
 	cfg := NewConfig(validRollupConfig, validL2Genesis, validL1Head, validL2Head, validL2OutputRoot, validL2Claim, validL2ClaimBlockNum)
 	cfg.DataDir = "/tmp/configTest"
@@ -26,6 +26,11 @@ var (
 		Usage:   "Rollup chain parameters",
 		EnvVars: prefixEnvVars("ROLLUP_CONFIG"),
 	}
+	AdditionalRollupConfigs = &cli.StringSliceFlag{
+		Name:    "rollup.config.additional",
+		Usage:   "Rollup chain parameters of additional L2 chains to serve pre-images for. L2 hints that specify a chain ID are served from the matching chain.",
+		EnvVars: prefixEnvVars("ROLLUP_CONFIG_ADDITIONAL"),
+	}
 	Network = &cli.StringFlag{
 		Name:    "network",
 		Usage:   fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
@@ -47,6 +52,11 @@ var (
 		Usage:   "Address of L2 JSON-RPC endpoint to use (eth and debug namespace required)",
 		EnvVars: prefixEnvVars("L2_RPC"),
 	}
+	AdditionalL2NodeAddrs = &cli.StringSliceFlag{
+		Name:    "l2.additional",
+		Usage:   "Address of the L2 JSON-RPC endpoint of an additional L2 chain, as <chain ID>=<url> (eth and debug namespace required)",
+		EnvVars: prefixEnvVars("L2_RPC_ADDITIONAL"),
+	}
 	L1Head = &cli.StringFlag{
 		Name:    "l1.head",
 		Usage:   "Hash of the L1 head block. Derivation stops after this block is processed.",
@@ -112,6 +122,12 @@ var (
 		Usage:   "Run in pre-image server mode without executing any client program.",
 		EnvVars: prefixEnvVars("SERVER"),
 	}
+	PrefetchWorkers = &cli.UintFlag{
+		Name: "prefetch.workers",
+		Usage: "Number of concurrent fetches warming up the pre-images the client is expected to request, " +
+			"from the L1 and L2 nodes ahead of the client. Disabled if 0.",
+		EnvVars: prefixEnvVars("PREFETCH_WORKERS"),
+	}
 )
 
 // Flags contains the list of configuration options available to the binary.
@@ -132,12 +148,15 @@ var programFlags = []cli.Flag{
 	DataFormat,
 	L2NodeAddr,
 	L2GenesisPath,
+	AdditionalRollupConfigs,
+	AdditionalL2NodeAddrs,
 	L1NodeAddr,
 	L1BeaconAddr,
 	L1TrustRPC,
 	L1RPCProviderKind,
 	Exec,
 	Server,
+	PrefetchWorkers,
 }
 
 func init() {
@@ -10,6 +10,7 @@ import (
 	"os/exec"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	cl "github.com/ethereum-optimism/optimism/op-program/client"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
@@ -129,8 +130,10 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 	var hinterDone chan error
 	logger.Info("Starting preimage server")
 	var kv kvstore.KV
+	var warmDone chan struct{}
+	warmCtx, stopWarm := context.WithCancel(ctx)
 
-	// Close the preimage/hint channels, and then kv store once the server and hinter have exited.
+	// Close the preimage/hint channels, and then kv store once the server, hinter and warm-up have exited.
 	defer func() {
 		preimageChannel.Close()
 		hintChannel.Close()
@@ -143,6 +146,11 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 			<-hinterDone
 		}
 
+		stopWarm()
+		if warmDone != nil {
+			// Wait for the warm-up to stop writing to the kv store
+			<-warmDone
+		}
 		if kv != nil {
 			kv.Close()
 		}
@@ -173,10 +181,17 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 		hinter      preimage.HintHandler
 	)
 	if cfg.FetchingEnabled() {
-		prefetch, err := makePrefetcher(ctx, logger, kv, cfg)
+		prefetch, warm, err := makePrefetcher(ctx, logger, kv, cfg)
 		if err != nil {
 			return fmt.Errorf("failed to create prefetcher: %w", err)
 		}
+		if warm != nil {
+			warmDone = make(chan struct{})
+			go func() {
+				defer close(warmDone)
+				warm(warmCtx)
+			}()
+		}
 		getPreimage = func(key common.Hash) ([]byte, error) { return prefetch.GetPreimage(ctx, key) }
 		hinter = prefetch.Hint
 	} else {
@@ -205,10 +220,14 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 	}
 }
 
-func makePrefetcher(ctx context.Context, logger log.Logger, kv kvstore.KV, cfg *config.Config) (*prefetcher.Prefetcher, error) {
+// makePrefetcher creates the prefetcher, and the function warming up its pre-images if enabled.
+func makePrefetcher(ctx context.Context, logger log.Logger, kv kvstore.KV, cfg *config.Config) (*prefetcher.Prefetcher, func(ctx context.Context), error) {
 	var l1Cl hostSources.L1Source
 	var l1BlobFetcher hostSources.L1BlobSource
 	var l2DebugCl hostSources.L2Source
+	l2Chains := make(map[uint64]hostSources.L2Source)
+	var warmL1 prefetcher.WarmL1Source
+	var warmL2 prefetcher.WarmL2Source
 
 	if cfg.InProcessSourcesEnabled() {
 		logger.Debug("Using in-process sources for preimage fetching.")
@@ -219,30 +238,59 @@ func makePrefetcher(ctx context.Context, logger log.Logger, kv kvstore.KV, cfg *
 		logger.Info("Connecting to L1 node", "l1", cfg.L1URL)
 		l1RPC, err := client.NewRPC(ctx, logger, cfg.L1URL, client.WithDialBackoff(10))
 		if err != nil {
-			return nil, fmt.Errorf("failed to setup L1 RPC: %w", err)
-		}
-
-		logger.Info("Connecting to L2 node", "l2", cfg.L2URL)
-		l2RPC, err := client.NewRPC(ctx, logger, cfg.L2URL, client.WithDialBackoff(10))
-		if err != nil {
-			return nil, fmt.Errorf("failed to setup L2 RPC: %w", err)
+			return nil, nil, fmt.Errorf("failed to setup L1 RPC: %w", err)
 		}
 
 		l1ClCfg := sources.L1ClientDefaultConfig(cfg.Rollup, cfg.L1TrustRPC, cfg.L1RPCKind)
-		l2ClCfg := sources.L2ClientDefaultConfig(cfg.Rollup, true)
-		l1Cl, err = sources.NewL1Client(l1RPC, logger, nil, l1ClCfg)
+		l1Client, err := sources.NewL1Client(l1RPC, logger, nil, l1ClCfg)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create L1 client: %w", err)
+			return nil, nil, fmt.Errorf("failed to create L1 client: %w", err)
 		}
+		l1Cl, warmL1 = l1Client, l1Client
 		l1Beacon := sources.NewBeaconHTTPClient(client.NewBasicHTTPClient(cfg.L1BeaconURL, logger))
 		l1BlobFetcher = sources.NewL1BeaconClient(l1Beacon, sources.L1BeaconClientConfig{FetchAllSidecars: false})
-		l2Cl, err := NewL2Client(l2RPC, logger, nil, &L2ClientConfig{L2ClientConfig: l2ClCfg, L2Head: cfg.L2Head})
+		l2Source, err := newL2Source(ctx, logger, cfg.L2URL, cfg.Rollup, cfg.L2Head)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create L2 client: %w", err)
+			return nil, nil, err
+		}
+		l2DebugCl, warmL2 = l2Source, l2Source
+		for chainID, chain := range cfg.L2Chains {
+			// Outputs are only served for the default chain, so no L2 head is required.
+			l2Chains[chainID], err = newL2Source(ctx, logger.New("chain", chainID), chain.L2URL, chain.Rollup, common.Hash{})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create source for L2 chain %d: %w", chainID, err)
+			}
 		}
-		l2DebugCl = &L2Source{L2Client: l2Cl, DebugClient: sources.NewDebugClient(l2RPC.CallContext)}
 	}
-	return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, l2DebugCl, kv), nil
+	prefetch := prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, l2DebugCl, l2Chains, kv)
+	if cfg.PrefetchWorkers == 0 || warmL1 == nil || warmL2 == nil {
+		return prefetch, nil, nil
+	}
+	warm := func(ctx context.Context) {
+		prefetch.Warm(ctx, warmL1, warmL2, prefetcher.WarmConfig{
+			L1Head:             cfg.L1Head,
+			L2Head:             cfg.L2Head,
+			L2ClaimBlockNumber: cfg.L2ClaimBlockNumber,
+			L2ChainID:          cfg.Rollup.L2ChainID,
+			SeqWindowSize:      cfg.Rollup.SeqWindowSize,
+			Workers:            int(cfg.PrefetchWorkers),
+		})
+	}
+	return prefetch, warm, nil
+}
+
+func newL2Source(ctx context.Context, logger log.Logger, url string, rollupCfg *rollup.Config, l2Head common.Hash) (*L2Source, error) {
+	logger.Info("Connecting to L2 node", "l2", url)
+	l2RPC, err := client.NewRPC(ctx, logger, url, client.WithDialBackoff(10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup L2 RPC: %w", err)
+	}
+	l2ClCfg := sources.L2ClientDefaultConfig(rollupCfg, true)
+	l2Cl, err := NewL2Client(l2RPC, logger, nil, &L2ClientConfig{L2ClientConfig: l2ClCfg, L2Head: l2Head})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create L2 client: %w", err)
+	}
+	return &L2Source{L2Client: l2Cl, DebugClient: sources.NewDebugClient(l2RPC.CallContext)}, nil
 }
 
 func routeHints(logger log.Logger, hHostRW io.ReadWriter, hinter preimage.HintHandler) chan error {
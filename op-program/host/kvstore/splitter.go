@@ -20,7 +20,7 @@ func NewPreimageSourceSplitter(local PreimageSource, global PreimageSource) *Pre
 }
 
 func (s *PreimageSourceSplitter) Get(key [32]byte) ([]byte, error) {
-	if key[0] == byte(preimage.LocalKeyType) {
+	if preimage.KeyType(key[0]).IsLocal() {
 		return s.local(key)
 	}
 	return s.global(key)
@@ -0,0 +1,236 @@
+package prefetcher
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// HintHandler prefetches the pre-images requested by a hint, and stores them in the key-value store of the prefetcher.
+type HintHandler func(ctx context.Context, p *Prefetcher, hintBytes []byte) error
+
+var (
+	hintHandlersLock sync.RWMutex
+	hintHandlers     = make(map[string]HintHandler)
+)
+
+// RegisterHintHandler adds the handler of a hint type, so new kinds of pre-images can be prefetched
+// without changes to the prefetcher. Handlers must be registered before use, i.e. from an init function.
+// Panics if a handler is already registered for the hint type, since this indicates a significant programmer error.
+func RegisterHintHandler(hintType string, handler HintHandler) {
+	hintHandlersLock.Lock()
+	defer hintHandlersLock.Unlock()
+	if _, ok := hintHandlers[hintType]; ok {
+		panic(fmt.Errorf("duplicate hint handler for hint type %q", hintType))
+	}
+	hintHandlers[hintType] = handler
+}
+
+func lookupHintHandler(hintType string) (HintHandler, bool) {
+	hintHandlersLock.RLock()
+	defer hintHandlersLock.RUnlock()
+	handler, ok := hintHandlers[hintType]
+	return handler, ok
+}
+
+func init() {
+	RegisterHintHandler(l1.HintL1BlockHeader, prefetchL1BlockHeader)
+	RegisterHintHandler(l1.HintL1Transactions, prefetchL1Transactions)
+	RegisterHintHandler(l1.HintL1Receipts, prefetchL1Receipts)
+	RegisterHintHandler(l1.HintL1Blob, prefetchL1Blob)
+	RegisterHintHandler(l1.HintL1Precompile, func(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+		if len(hintBytes) < 20 {
+			return fmt.Errorf("invalid precompile hint: %x", hintBytes)
+		}
+		return p.storePrecompileResult(hintBytes, common.BytesToAddress(hintBytes[:20]), hintBytes[20:])
+	})
+	RegisterHintHandler(l1.HintL1PrecompileV2, func(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+		if len(hintBytes) < 28 {
+			return fmt.Errorf("invalid precompile hint: %x", hintBytes)
+		}
+		// requiredGas := hintBytes[20:28] - unused by the host. Since the client already validates gas requirements.
+		// The requiredGas is only used by the L1 PreimageOracle to enforce complete precompile execution.
+		return p.storePrecompileResult(hintBytes, common.BytesToAddress(hintBytes[:20]), hintBytes[28:])
+	})
+	RegisterHintHandler(l2.HintL2BlockHeader, prefetchL2BlockHeaderAndTransactions)
+	RegisterHintHandler(l2.HintL2Transactions, prefetchL2BlockHeaderAndTransactions)
+	RegisterHintHandler(l2.HintL2StateNode, prefetchL2StateNode)
+	RegisterHintHandler(l2.HintL2Code, prefetchL2Code)
+	RegisterHintHandler(l2.HintL2Output, prefetchL2Output)
+}
+
+func prefetchL1BlockHeader(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	if len(hintBytes) != 32 {
+		return fmt.Errorf("invalid L1 block hint: %x", hintBytes)
+	}
+	hash := common.Hash(hintBytes)
+	header, err := p.l1Fetcher.InfoByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %s header: %w", hash, err)
+	}
+	data, err := header.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("marshall header: %w", err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), data)
+}
+
+func prefetchL1Transactions(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	if len(hintBytes) != 32 {
+		return fmt.Errorf("invalid L1 transactions hint: %x", hintBytes)
+	}
+	hash := common.Hash(hintBytes)
+	_, txs, err := p.l1Fetcher.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %s txs: %w", hash, err)
+	}
+	return p.storeTransactions(txs)
+}
+
+func prefetchL1Receipts(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	if len(hintBytes) != 32 {
+		return fmt.Errorf("invalid L1 receipts hint: %x", hintBytes)
+	}
+	hash := common.Hash(hintBytes)
+	_, receipts, err := p.l1Fetcher.FetchReceipts(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %s receipts: %w", hash, err)
+	}
+	return p.storeReceipts(receipts)
+}
+
+func prefetchL1Blob(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	if len(hintBytes) != 48 {
+		return fmt.Errorf("invalid blob hint: %x", hintBytes)
+	}
+
+	blobVersionHash := common.Hash(hintBytes[:32])
+	blobHashIndex := binary.BigEndian.Uint64(hintBytes[32:40])
+	refTimestamp := binary.BigEndian.Uint64(hintBytes[40:48])
+
+	// Fetch the blob sidecar for the indexed blob hash passed in the hint.
+	indexedBlobHash := eth.IndexedBlobHash{
+		Hash:  blobVersionHash,
+		Index: blobHashIndex,
+	}
+	// We pass an `eth.L1BlockRef`, but `GetBlobSidecars` only uses the timestamp, which we received in the hint.
+	sidecars, err := p.l1BlobFetcher.GetBlobSidecars(ctx, eth.L1BlockRef{Time: refTimestamp}, []eth.IndexedBlobHash{indexedBlobHash})
+	if err != nil || len(sidecars) != 1 {
+		return fmt.Errorf("failed to fetch blob sidecars for %s %d: %w", blobVersionHash, blobHashIndex, err)
+	}
+	sidecar := sidecars[0]
+
+	// Put the preimage for the versioned hash into the kv store
+	if err = p.kvStore.Put(preimage.Sha256Key(blobVersionHash).PreimageKey(), sidecar.KZGCommitment[:]); err != nil {
+		return err
+	}
+
+	// Put all of the blob's field elements into the kv store. There should be 4096. The preimage oracle key for
+	// each field element is the keccak256 hash of `abi.encodePacked(sidecar.KZGCommitment, uint256(i))`
+	blobKey := make([]byte, 80)
+	copy(blobKey[:48], sidecar.KZGCommitment[:])
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		binary.BigEndian.PutUint64(blobKey[72:], uint64(i))
+		blobKeyHash := crypto.Keccak256Hash(blobKey)
+		if err := p.kvStore.Put(preimage.Keccak256Key(blobKeyHash).PreimageKey(), blobKey); err != nil {
+			return err
+		}
+		if err = p.kvStore.Put(preimage.BlobKey(blobKeyHash).PreimageKey(), sidecar.Blob[i<<5:(i+1)<<5]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storePrecompileResult runs the precompile, and stores the hint data and the result as pre-images.
+func (p *Prefetcher) storePrecompileResult(hintBytes []byte, precompileAddress common.Address, input []byte) error {
+	// For extra safety, avoid accelerating unexpected precompiles
+	if !slices.Contains(acceleratedPrecompiles, precompileAddress) {
+		return fmt.Errorf("unsupported precompile address: %s", precompileAddress)
+	}
+	// NOTE: We use the precompiled contracts from Cancun because it's the only set that contains the addresses of all accelerated precompiles
+	// We assume the precompile Run function behavior does not change across EVM upgrades.
+	// As such, we must not rely on upgrade-specific behavior such as precompile.RequiredGas.
+	precompile := getPrecompiledContract(precompileAddress)
+
+	// KZG Point Evaluation precompile also verifies its input
+	result, err := precompile.Run(input)
+	if err == nil {
+		result = append(precompileSuccess[:], result...)
+	} else {
+		result = append(precompileFailure[:], result...)
+	}
+	inputHash := crypto.Keccak256Hash(hintBytes)
+	// Put the input preimage so it can be loaded later
+	if err := p.kvStore.Put(preimage.Keccak256Key(inputHash).PreimageKey(), hintBytes); err != nil {
+		return err
+	}
+	return p.kvStore.Put(preimage.PrecompileKey(inputHash).PreimageKey(), result)
+}
+
+func prefetchL2BlockHeaderAndTransactions(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	hash, l2Fetcher, err := p.parseL2Hint(hintBytes)
+	if err != nil {
+		return fmt.Errorf("invalid L2 header/tx hint: %x: %w", hintBytes, err)
+	}
+	header, txs, err := l2Fetcher.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %s: %w", hash, err)
+	}
+	data, err := header.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to encode header to RLP: %w", err)
+	}
+	err = p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), data)
+	if err != nil {
+		return err
+	}
+	return p.storeTransactions(txs)
+}
+
+func prefetchL2StateNode(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	hash, l2Fetcher, err := p.parseL2Hint(hintBytes)
+	if err != nil {
+		return fmt.Errorf("invalid L2 state node hint: %x: %w", hintBytes, err)
+	}
+	node, err := l2Fetcher.NodeByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 state node %s: %w", hash, err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), node)
+}
+
+func prefetchL2Code(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	hash, l2Fetcher, err := p.parseL2Hint(hintBytes)
+	if err != nil {
+		return fmt.Errorf("invalid L2 code hint: %x: %w", hintBytes, err)
+	}
+	code, err := l2Fetcher.CodeByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 contract code %s: %w", hash, err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), code)
+}
+
+func prefetchL2Output(ctx context.Context, p *Prefetcher, hintBytes []byte) error {
+	// Outputs are only served at the agreed L2 head, which is only known for the default chain.
+	if len(hintBytes) != 32 {
+		return fmt.Errorf("invalid L2 output hint: %x", hintBytes)
+	}
+	hash := common.Hash(hintBytes)
+	output, err := p.l2Fetcher.OutputByRoot(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 output root %s: %w", hash, err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), output.Marshal())
+}
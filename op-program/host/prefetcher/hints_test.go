@@ -0,0 +1,34 @@
+package prefetcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+)
+
+func TestRegisterHintHandler(t *testing.T) {
+	const hintType = "test-echo"
+	RegisterHintHandler(hintType, func(_ context.Context, p *Prefetcher, hintBytes []byte) error {
+		return p.KVStore().Put(preimage.Keccak256Key(crypto.Keccak256Hash(hintBytes)).PreimageKey(), hintBytes)
+	})
+
+	prefetcher, _, _, _, _ := createPrefetcher(t)
+	data := []byte{1, 2, 3}
+	key := preimage.Keccak256Key(crypto.Keccak256Hash(data)).PreimageKey()
+	require.NoError(t, prefetcher.Hint(hintType+" 0x010203"))
+	pre, err := prefetcher.GetPreimage(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, data, pre)
+
+	require.Panics(t, func() {
+		RegisterHintHandler(hintType, prefetchL1BlockHeader)
+	})
+	require.Panics(t, func() {
+		RegisterHintHandler(l1.HintL1BlockHeader, prefetchL1BlockHeader)
+	}, "built-in hint types are registered")
+}
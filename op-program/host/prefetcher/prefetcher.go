@@ -5,12 +5,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"slices"
 	"strings"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
-	"github.com/ethereum-optimism/optimism/op-program/client/l1"
-	"github.com/ethereum-optimism/optimism/op-program/client/l2"
 	"github.com/ethereum-optimism/optimism/op-program/client/mpt"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
 	"github.com/ethereum-optimism/optimism/op-program/host/sources"
@@ -21,7 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
@@ -40,16 +36,24 @@ type Prefetcher struct {
 	l1Fetcher     sources.L1Source
 	l1BlobFetcher sources.L1BlobSource
 	l2Fetcher     sources.L2Source
+	l2Chains      map[uint64]sources.L2Source
 	lastHint      string
 	kvStore       kvstore.KV
 }
 
-func NewPrefetcher(logger log.Logger, l1Fetcher sources.L1Source, l1BlobFetcher sources.L1BlobSource, l2Fetcher sources.L2Source, kvStore kvstore.KV) *Prefetcher {
+// NewPrefetcher creates a prefetcher serving L2 hints from l2Fetcher, the default chain.
+// L2 hints that specify a chain ID are served from the matching source of l2Chains instead.
+func NewPrefetcher(logger log.Logger, l1Fetcher sources.L1Source, l1BlobFetcher sources.L1BlobSource, l2Fetcher sources.L2Source, l2Chains map[uint64]sources.L2Source, kvStore kvstore.KV) *Prefetcher {
+	retryingChains := make(map[uint64]sources.L2Source, len(l2Chains))
+	for chainID, source := range l2Chains {
+		retryingChains[chainID] = NewRetryingL2Source(logger.New("chain", chainID), source)
+	}
 	return &Prefetcher{
 		logger:        logger,
 		l1Fetcher:     NewRetryingL1Source(logger, l1Fetcher),
 		l1BlobFetcher: NewRetryingL1BlobSource(logger, l1BlobFetcher),
 		l2Fetcher:     NewRetryingL2Source(logger, l2Fetcher),
+		l2Chains:      retryingChains,
 		kvStore:       kvStore,
 	}
 }
@@ -85,189 +89,31 @@ func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
 		return err
 	}
 	p.logger.Debug("Prefetching", "type", hintType, "bytes", hexutil.Bytes(hintBytes))
-	switch hintType {
-	case l1.HintL1BlockHeader:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L1 block hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		header, err := p.l1Fetcher.InfoByHash(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L1 block %s header: %w", hash, err)
-		}
-		data, err := header.HeaderRLP()
-		if err != nil {
-			return fmt.Errorf("marshall header: %w", err)
-		}
-		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), data)
-	case l1.HintL1Transactions:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L1 transactions hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		_, txs, err := p.l1Fetcher.InfoAndTxsByHash(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L1 block %s txs: %w", hash, err)
-		}
-		return p.storeTransactions(txs)
-	case l1.HintL1Receipts:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L1 receipts hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		_, receipts, err := p.l1Fetcher.FetchReceipts(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L1 block %s receipts: %w", hash, err)
-		}
-		return p.storeReceipts(receipts)
-	case l1.HintL1Blob:
-		if len(hintBytes) != 48 {
-			return fmt.Errorf("invalid blob hint: %x", hint)
-		}
-
-		blobVersionHash := common.Hash(hintBytes[:32])
-		blobHashIndex := binary.BigEndian.Uint64(hintBytes[32:40])
-		refTimestamp := binary.BigEndian.Uint64(hintBytes[40:48])
-
-		// Fetch the blob sidecar for the indexed blob hash passed in the hint.
-		indexedBlobHash := eth.IndexedBlobHash{
-			Hash:  blobVersionHash,
-			Index: blobHashIndex,
-		}
-		// We pass an `eth.L1BlockRef`, but `GetBlobSidecars` only uses the timestamp, which we received in the hint.
-		sidecars, err := p.l1BlobFetcher.GetBlobSidecars(ctx, eth.L1BlockRef{Time: refTimestamp}, []eth.IndexedBlobHash{indexedBlobHash})
-		if err != nil || len(sidecars) != 1 {
-			return fmt.Errorf("failed to fetch blob sidecars for %s %d: %w", blobVersionHash, blobHashIndex, err)
-		}
-		sidecar := sidecars[0]
-
-		// Put the preimage for the versioned hash into the kv store
-		if err = p.kvStore.Put(preimage.Sha256Key(blobVersionHash).PreimageKey(), sidecar.KZGCommitment[:]); err != nil {
-			return err
-		}
+	handler, ok := lookupHintHandler(hintType)
+	if !ok {
+		return fmt.Errorf("unknown hint type: %v", hintType)
+	}
+	return handler(ctx, p, hintBytes)
+}
 
-		// Put all of the blob's field elements into the kv store. There should be 4096. The preimage oracle key for
-		// each field element is the keccak256 hash of `abi.encodePacked(sidecar.KZGCommitment, uint256(i))`
-		blobKey := make([]byte, 80)
-		copy(blobKey[:48], sidecar.KZGCommitment[:])
-		for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
-			binary.BigEndian.PutUint64(blobKey[72:], uint64(i))
-			blobKeyHash := crypto.Keccak256Hash(blobKey)
-			if err := p.kvStore.Put(preimage.Keccak256Key(blobKeyHash).PreimageKey(), blobKey); err != nil {
-				return err
-			}
-			if err = p.kvStore.Put(preimage.BlobKey(blobKeyHash).PreimageKey(), sidecar.Blob[i<<5:(i+1)<<5]); err != nil {
-				return err
-			}
-		}
-		return nil
-	case l1.HintL1Precompile:
-		if len(hintBytes) < 20 {
-			return fmt.Errorf("invalid precompile hint: %x", hint)
-		}
-		precompileAddress := common.BytesToAddress(hintBytes[:20])
-		// For extra safety, avoid accelerating unexpected precompiles
-		if !slices.Contains(acceleratedPrecompiles, precompileAddress) {
-			return fmt.Errorf("unsupported precompile address: %s", precompileAddress)
-		}
-		// NOTE: We use the precompiled contracts from Cancun because it's the only set that contains the addresses of all accelerated precompiles
-		// We assume the precompile Run function behavior does not change across EVM upgrades.
-		// As such, we must not rely on upgrade-specific behavior such as precompile.RequiredGas.
-		precompile := getPrecompiledContract(precompileAddress)
+// KVStore returns the key-value store that prefetched pre-images are stored in.
+func (p *Prefetcher) KVStore() kvstore.KV {
+	return p.kvStore
+}
 
-		// KZG Point Evaluation precompile also verifies its input
-		result, err := precompile.Run(hintBytes[20:])
-		if err == nil {
-			result = append(precompileSuccess[:], result...)
-		} else {
-			result = append(precompileFailure[:], result...)
-		}
-		inputHash := crypto.Keccak256Hash(hintBytes)
-		// Put the input preimage so it can be loaded later
-		if err := p.kvStore.Put(preimage.Keccak256Key(inputHash).PreimageKey(), hintBytes); err != nil {
-			return err
-		}
-		return p.kvStore.Put(preimage.PrecompileKey(inputHash).PreimageKey(), result)
-	case l1.HintL1PrecompileV2:
-		if len(hintBytes) < 28 {
-			return fmt.Errorf("invalid precompile hint: %x", hint)
-		}
-		precompileAddress := common.BytesToAddress(hintBytes[:20])
-		// requiredGas := hintBytes[20:28] - unused by the host. Since the client already validates gas requirements.
-		// The requiredGas is only used by the L1 PreimageOracle to enforce complete precompile execution.
+// L1Source returns the source of L1 data, for use by hint handlers.
+func (p *Prefetcher) L1Source() sources.L1Source {
+	return p.l1Fetcher
+}
 
-		// For extra safety, avoid accelerating unexpected precompiles
-		if !slices.Contains(acceleratedPrecompiles, precompileAddress) {
-			return fmt.Errorf("unsupported precompile address: %s", precompileAddress)
-		}
-		// NOTE: We use the precompiled contracts from Cancun because it's the only set that contains the addresses of all accelerated precompiles
-		// We assume the precompile Run function behavior does not change across EVM upgrades.
-		// As such, we must not rely on upgrade-specific behavior such as precompile.RequiredGas.
-		precompile := getPrecompiledContract(precompileAddress)
+// L1BlobSource returns the source of L1 blobs, for use by hint handlers.
+func (p *Prefetcher) L1BlobSource() sources.L1BlobSource {
+	return p.l1BlobFetcher
+}
 
-		// KZG Point Evaluation precompile also verifies its input
-		result, err := precompile.Run(hintBytes[28:])
-		if err == nil {
-			result = append(precompileSuccess[:], result...)
-		} else {
-			result = append(precompileFailure[:], result...)
-		}
-		inputHash := crypto.Keccak256Hash(hintBytes)
-		// Put the input preimage so it can be loaded later
-		if err := p.kvStore.Put(preimage.Keccak256Key(inputHash).PreimageKey(), hintBytes); err != nil {
-			return err
-		}
-		return p.kvStore.Put(preimage.PrecompileKey(inputHash).PreimageKey(), result)
-	case l2.HintL2BlockHeader, l2.HintL2Transactions:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L2 header/tx hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		header, txs, err := p.l2Fetcher.InfoAndTxsByHash(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L2 block %s: %w", hash, err)
-		}
-		data, err := header.HeaderRLP()
-		if err != nil {
-			return fmt.Errorf("failed to encode header to RLP: %w", err)
-		}
-		err = p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), data)
-		if err != nil {
-			return err
-		}
-		return p.storeTransactions(txs)
-	case l2.HintL2StateNode:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L2 state node hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		node, err := p.l2Fetcher.NodeByHash(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L2 state node %s: %w", hash, err)
-		}
-		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), node)
-	case l2.HintL2Code:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L2 code hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		code, err := p.l2Fetcher.CodeByHash(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L2 contract code %s: %w", hash, err)
-		}
-		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), code)
-	case l2.HintL2Output:
-		if len(hintBytes) != 32 {
-			return fmt.Errorf("invalid L2 output hint: %x", hint)
-		}
-		hash := common.Hash(hintBytes)
-		output, err := p.l2Fetcher.OutputByRoot(ctx, hash)
-		if err != nil {
-			return fmt.Errorf("failed to fetch L2 output root %s: %w", hash, err)
-		}
-		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), output.Marshal())
-	}
-	return fmt.Errorf("unknown hint type: %v", hintType)
+// L2Source returns the source of the default L2 chain, for use by hint handlers.
+func (p *Prefetcher) L2Source() sources.L2Source {
+	return p.l2Fetcher
 }
 
 func (p *Prefetcher) storeReceipts(receipts types.Receipts) error {
@@ -297,6 +143,25 @@ func (p *Prefetcher) storeTrieNodes(values []hexutil.Bytes) error {
 	return nil
 }
 
+// parseL2Hint parses the data of an L2 hint, and returns the requested hash and the source of the chain to fetch it from.
+// The data is either the 32 byte hash, served from the default chain,
+// or the hash followed by the big-endian uint64 chain ID, served from the matching chain.
+func (p *Prefetcher) parseL2Hint(hintBytes []byte) (common.Hash, sources.L2Source, error) {
+	switch len(hintBytes) {
+	case 32:
+		return common.Hash(hintBytes), p.l2Fetcher, nil
+	case 40:
+		chainID := binary.BigEndian.Uint64(hintBytes[32:])
+		source, ok := p.l2Chains[chainID]
+		if !ok {
+			return common.Hash{}, nil, fmt.Errorf("unknown L2 chain ID %d", chainID)
+		}
+		return common.Hash(hintBytes[:32]), source, nil
+	default:
+		return common.Hash{}, nil, fmt.Errorf("invalid length %d", len(hintBytes))
+	}
+}
+
 // parseHint parses a hint string in wire protocol. Returns the hint type, requested hash and error (if any).
 func parseHint(hint string) (string, []byte, error) {
 	hintType, bytesStr, found := strings.Cut(hint, " ")
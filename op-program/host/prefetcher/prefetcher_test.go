@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
@@ -21,6 +22,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
 	"github.com/ethereum-optimism/optimism/op-program/client/mpt"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
+	"github.com/ethereum-optimism/optimism/op-program/host/sources"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
@@ -512,6 +514,58 @@ func TestFetchL2Code(t *testing.T) {
 	})
 }
 
+func TestFetchL2MultipleChains(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	node := testutils.RandomData(rng, 30)
+	hash := crypto.Keccak256Hash(node)
+	key := preimage.Keccak256Key(hash).PreimageKey()
+	chainHint := func(chainID uint64) string {
+		return l2.HintL2StateNode + " " + hexutil.Encode(binary.BigEndian.AppendUint64(hash.Bytes(), chainID))
+	}
+
+	setup := func(t *testing.T) (*Prefetcher, *l2Client, *l2Client) {
+		_, l1Source, l1BlobSource, defaultChain, kv := createPrefetcher(t)
+		otherChain := &l2Client{
+			MockL2Client:    new(testutils.MockL2Client),
+			MockDebugClient: new(testutils.MockDebugClient),
+		}
+		prefetcher := NewPrefetcher(testlog.Logger(t, log.LevelInfo), l1Source, l1BlobSource, defaultChain,
+			map[uint64]sources.L2Source{902: otherChain}, kv)
+		return prefetcher, defaultChain, otherChain
+	}
+
+	t.Run("DefaultChain", func(t *testing.T) {
+		prefetcher, defaultChain, otherChain := setup(t)
+		defaultChain.ExpectNodeByHash(hash, node, nil)
+		defer defaultChain.MockDebugClient.AssertExpectations(t)
+		defer otherChain.MockDebugClient.AssertExpectations(t)
+
+		require.NoError(t, prefetcher.Hint(l2.StateNodeHint(hash).Hint()))
+		result, err := prefetcher.GetPreimage(context.Background(), key)
+		require.NoError(t, err)
+		require.EqualValues(t, node, result)
+	})
+
+	t.Run("RoutedByChainID", func(t *testing.T) {
+		prefetcher, defaultChain, otherChain := setup(t)
+		otherChain.ExpectNodeByHash(hash, node, nil)
+		defer defaultChain.MockDebugClient.AssertExpectations(t)
+		defer otherChain.MockDebugClient.AssertExpectations(t)
+
+		require.NoError(t, prefetcher.Hint(chainHint(902)))
+		result, err := prefetcher.GetPreimage(context.Background(), key)
+		require.NoError(t, err)
+		require.EqualValues(t, node, result)
+	})
+
+	t.Run("UnknownChainID", func(t *testing.T) {
+		prefetcher, _, _ := setup(t)
+		require.NoError(t, prefetcher.Hint(chainHint(903)))
+		_, err := prefetcher.GetPreimage(context.Background(), key)
+		require.ErrorContains(t, err, "unknown L2 chain ID 903")
+	})
+}
+
 func TestBadHints(t *testing.T) {
 	prefetcher, _, _, _, kv := createPrefetcher(t)
 	hash := common.Hash{0xad}
@@ -569,7 +623,7 @@ func TestRetryWhenNotAvailableAfterPrefetching(t *testing.T) {
 	_, l1Source, l1BlobSource, l2Cl, kv := createPrefetcher(t)
 	putsToIgnore := 2
 	kv = &unreliableKvStore{KV: kv, putsToIgnore: putsToIgnore}
-	prefetcher := NewPrefetcher(testlog.Logger(t, log.LevelInfo), l1Source, l1BlobSource, l2Cl, kv)
+	prefetcher := NewPrefetcher(testlog.Logger(t, log.LevelInfo), l1Source, l1BlobSource, l2Cl, nil, kv)
 
 	// Expect one call for each ignored put, plus one more request for when the put succeeds
 	for i := 0; i < putsToIgnore+1; i++ {
@@ -621,7 +675,7 @@ func createPrefetcher(t *testing.T) (*Prefetcher, *testutils.MockL1Source, *test
 		MockDebugClient: new(testutils.MockDebugClient),
 	}
 
-	prefetcher := NewPrefetcher(logger, l1Source, l1BlobSource, l2Source, kv)
+	prefetcher := NewPrefetcher(logger, l1Source, l1BlobSource, l2Source, nil, kv)
 	return prefetcher, l1Source, l1BlobSource, l2Source, kv
 }
 
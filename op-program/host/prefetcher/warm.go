@@ -0,0 +1,205 @@
+package prefetcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/locks"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// WarmL1Source is the source of the L1 blocks walked by the warm-up phase.
+type WarmL1Source interface {
+	InfoByHash(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, error)
+	InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error)
+	InfoAndTxsByNumber(ctx context.Context, number uint64) (eth.BlockInfo, types.Transactions, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
+}
+
+// WarmL2Source is the source of the L2 blocks and state walked by the warm-up phase.
+type WarmL2Source interface {
+	L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error)
+	InfoAndTxsByNumber(ctx context.Context, number uint64) (eth.BlockInfo, types.Transactions, error)
+	GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error)
+	CodeByHash(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// WarmConfig describes the execution of the client walked by the warm-up phase.
+type WarmConfig struct {
+	L1Head             common.Hash
+	L2Head             common.Hash
+	L2ClaimBlockNumber uint64
+	L2ChainID          *big.Int
+	// SeqWindowSize is how far before the L1 origin of the L2 head the derivation pipeline resets from.
+	SeqWindowSize uint64
+	// Workers is the maximum number of concurrent fetches.
+	Workers int
+}
+
+// warmAccounts are the accounts that are accessed by every L2 block.
+var warmAccounts = []common.Address{
+	predeploys.L1BlockAddr,
+	predeploys.GasPriceOracleAddr,
+	predeploys.SequencerFeeVaultAddr,
+	predeploys.BaseFeeVaultAddr,
+	predeploys.L1FeeVaultAddr,
+}
+
+// Warm prefetches the pre-images the client is expected to request, ahead of the client and concurrently with
+// a bounded number of workers, so they are served from the key-value store instead of being fetched one by one
+// as the client requests them. It walks the L1 blocks from the sequencing window before the L1 origin of the
+// L2 head up to the L1 head with their transactions and receipts, the L2 blocks from the L2 head up to the claimed
+// block with their transactions, and the state of the accounts accessed by the L2 transactions.
+// Warming up is best-effort: pre-images that fail to be fetched are fetched when the client requests them.
+func (p *Prefetcher) Warm(ctx context.Context, l1 WarmL1Source, l2 WarmL2Source, cfg WarmConfig) {
+	if cfg.Workers <= 0 {
+		return
+	}
+	l1Head, err := l1.InfoByHash(ctx, cfg.L1Head)
+	if err != nil {
+		p.logger.Warn("Failed to fetch L1 head, skipping warm-up", "err", err)
+		return
+	}
+	l2Head, err := l2.L2BlockRefByHash(ctx, cfg.L2Head)
+	if err != nil {
+		p.logger.Warn("Failed to fetch L2 head, skipping warm-up", "err", err)
+		return
+	}
+	p.logger.Info("Warming up pre-images", "l1_head", eth.InfoToL1BlockRef(l1Head), "l2_head", l2Head,
+		"l2_claim_block", cfg.L2ClaimBlockNumber, "workers", cfg.Workers)
+
+	g, _ := locks.NewWorkGroup(ctx, cfg.Workers)
+	var failures atomic.Int64
+	run := func(fn func() error) {
+		// no more work is started once the context is done
+		_ = g.Go(func() error {
+			if err := fn(); err != nil {
+				failures.Add(1)
+				p.logger.Debug("Failed to warm up pre-images", "err", err)
+			}
+			return nil
+		})
+	}
+
+	origin := l2Head.L1Origin.Number
+	for n := origin - min(origin, cfg.SeqWindowSize); n < origin; n++ {
+		n := n
+		run(func() error { return p.warmL1Header(ctx, l1, n) })
+	}
+	for n := origin; n <= l1Head.NumberU64(); n++ {
+		n := n
+		run(func() error { return p.warmL1Block(ctx, l1, n) })
+	}
+	signer := types.LatestSignerForChainID(cfg.L2ChainID)
+	for n := l2Head.Number; n <= cfg.L2ClaimBlockNumber; n++ {
+		n := n
+		run(func() error { return p.warmL2Block(ctx, l2, signer, n, n > l2Head.Number) })
+	}
+	_ = g.Wait()
+	if ctx.Err() != nil {
+		p.logger.Info("Warm-up stopped", "err", ctx.Err())
+		return
+	}
+	p.logger.Info("Completed warm-up of pre-images", "failures", failures.Load())
+}
+
+func (p *Prefetcher) warmL1Header(ctx context.Context, l1 WarmL1Source, number uint64) error {
+	header, err := l1.InfoByNumber(ctx, number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %d: %w", number, err)
+	}
+	return p.storeHeader(header)
+}
+
+func (p *Prefetcher) warmL1Block(ctx context.Context, l1 WarmL1Source, number uint64) error {
+	header, txs, err := l1.InfoAndTxsByNumber(ctx, number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %d: %w", number, err)
+	}
+	if err := p.storeHeader(header); err != nil {
+		return err
+	}
+	if err := p.storeTransactions(txs); err != nil {
+		return fmt.Errorf("failed to store L1 block %d txs: %w", number, err)
+	}
+	_, receipts, err := l1.FetchReceipts(ctx, header.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %d receipts: %w", number, err)
+	}
+	return p.storeReceipts(receipts)
+}
+
+// warmL2Block stores the L2 block and its transactions, and if the block is executed by the client,
+// the state of the accounts accessed by the block in the state of its parent.
+func (p *Prefetcher) warmL2Block(ctx context.Context, l2 WarmL2Source, signer types.Signer, number uint64, executed bool) error {
+	header, txs, err := l2.InfoAndTxsByNumber(ctx, number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d: %w", number, err)
+	}
+	if err := p.storeHeader(header); err != nil {
+		return err
+	}
+	if err := p.storeTransactions(txs); err != nil {
+		return fmt.Errorf("failed to store L2 block %d txs: %w", number, err)
+	}
+	if !executed {
+		return nil
+	}
+	accounts := make(map[common.Address]bool)
+	for _, addr := range warmAccounts {
+		accounts[addr] = true
+	}
+	accounts[header.Coinbase()] = true
+	for _, tx := range txs {
+		if sender, err := types.Sender(signer, tx); err == nil {
+			accounts[sender] = true
+		}
+		if to := tx.To(); to != nil {
+			accounts[*to] = true
+		}
+	}
+	parent := hexutil.Uint64(number - 1).String()
+	for addr := range accounts {
+		if err := p.warmAccount(ctx, l2, addr, parent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// warmAccount stores the state trie nodes of the account at the given block, and its code.
+func (p *Prefetcher) warmAccount(ctx context.Context, l2 WarmL2Source, addr common.Address, blockTag string) error {
+	result, err := l2.GetProof(ctx, addr, nil, blockTag)
+	if err != nil {
+		return fmt.Errorf("failed to fetch proof of account %v at block %v: %w", addr, blockTag, err)
+	}
+	for _, node := range result.AccountProof {
+		if err := p.kvStore.Put(preimage.Keccak256Key(crypto.Keccak256Hash(node)).PreimageKey(), node); err != nil {
+			return fmt.Errorf("failed to store node: %w", err)
+		}
+	}
+	if result.CodeHash == (common.Hash{}) || result.CodeHash == types.EmptyCodeHash {
+		return nil
+	}
+	code, err := l2.CodeByHash(ctx, result.CodeHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch code of account %v: %w", addr, err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(result.CodeHash).PreimageKey(), code)
+}
+
+func (p *Prefetcher) storeHeader(header eth.BlockInfo) error {
+	data, err := header.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to encode header of block %v: %w", header.Hash(), err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(header.Hash()).PreimageKey(), data)
+}
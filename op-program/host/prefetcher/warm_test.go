@@ -0,0 +1,240 @@
+package prefetcher
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestWarm(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	l1Blocks := make(map[uint64]*types.Block)
+	l1Receipts := make(map[common.Hash]types.Receipts)
+	for i := uint64(0); i <= 4; i++ {
+		block, receipts := randomBlockAt(rng, i)
+		l1Blocks[i] = block
+		l1Receipts[block.Hash()] = receipts
+	}
+	l2Blocks := make(map[uint64]*types.Block)
+	for i := uint64(10); i <= 12; i++ {
+		l2Blocks[i], _ = randomBlockAt(rng, i)
+	}
+	l1Src := &stubWarmL1Source{blocks: l1Blocks, receipts: l1Receipts}
+	l2Src := &stubWarmL2Source{
+		blocks: l2Blocks,
+		head: eth.L2BlockRef{
+			Hash:     l2Blocks[10].Hash(),
+			Number:   10,
+			L1Origin: eth.BlockID{Hash: l1Blocks[2].Hash(), Number: 2},
+		},
+		proofBlocks: make(map[string]bool),
+		code:        make(map[common.Hash][]byte),
+	}
+	cfg := WarmConfig{
+		L1Head:             l1Blocks[3].Hash(),
+		L2Head:             l2Blocks[10].Hash(),
+		L2ClaimBlockNumber: 11,
+		L2ChainID:          big.NewInt(10),
+		SeqWindowSize:      1,
+		Workers:            4,
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		prefetcher, kv := createWarmPrefetcher(t)
+		cfg := cfg
+		cfg.Workers = 0
+		prefetcher.Warm(context.Background(), l1Src, l2Src, cfg)
+		_, err := kv.Get(preimage.Keccak256Key(l1Blocks[3].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound)
+	})
+
+	t.Run("Warm", func(t *testing.T) {
+		prefetcher, kv := createWarmPrefetcher(t)
+		prefetcher.Warm(context.Background(), l1Src, l2Src, cfg)
+
+		// Headers of the sequencing window before the L1 origin
+		oracle := l1.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher))
+		require.Equal(t, l1Blocks[1].Hash(), oracle.HeaderByBlockHash(l1Blocks[1].Hash()).Hash())
+		_, err := kv.Get(preimage.Keccak256Key(l1Blocks[0].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound, "should not warm up blocks before the sequencing window")
+
+		// Full blocks from the L1 origin up to the L1 head
+		for i := uint64(2); i <= 3; i++ {
+			block := l1Blocks[i]
+			_, txs := oracle.TransactionsByBlockHash(block.Hash())
+			assertTransactionsEqual(t, block.Transactions(), txs)
+			_, receipts := oracle.ReceiptsByBlockHash(block.Hash())
+			assertReceiptsEqual(t, l1Receipts[block.Hash()], receipts)
+		}
+		_, err = kv.Get(preimage.Keccak256Key(l1Blocks[4].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound, "should not warm up blocks after the L1 head")
+
+		// L2 blocks from the L2 head up to the claimed block
+		l2Oracle := l2.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher))
+		for i := uint64(10); i <= 11; i++ {
+			block := l2Blocks[i]
+			result := l2Oracle.BlockByHash(block.Hash())
+			assertTransactionsEqual(t, block.Transactions(), result.Transactions())
+		}
+		_, err = kv.Get(preimage.Keccak256Key(l2Blocks[12].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound, "should not warm up blocks after the claimed block")
+
+		// State accessed by the executed block, in the state of its parent
+		require.Equal(t, map[string]bool{hexutil.Uint64(10).String(): true}, l2Src.proofBlocks)
+		accounts := []common.Address{predeploys.L1BlockAddr, l2Blocks[11].Coinbase()}
+		for _, tx := range l2Blocks[11].Transactions() {
+			if tx.To() != nil {
+				accounts = append(accounts, *tx.To())
+			}
+		}
+		for _, addr := range accounts {
+			node := accountNode(addr)
+			require.Equal(t, node, l2Oracle.NodeByHash(crypto.Keccak256Hash(node)))
+			code := accountCode(addr)
+			require.Equal(t, code, l2Oracle.CodeByHash(crypto.Keccak256Hash(code)))
+		}
+	})
+
+	t.Run("BestEffort", func(t *testing.T) {
+		prefetcher, kv := createWarmPrefetcher(t)
+		failing := &stubWarmL1Source{blocks: l1Blocks, receipts: l1Receipts, failNumber: 2}
+		prefetcher.Warm(context.Background(), failing, l2Src, cfg)
+
+		_, err := kv.Get(preimage.Keccak256Key(l1Blocks[2].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound)
+		_, err = kv.Get(preimage.Keccak256Key(l1Blocks[3].Hash()).PreimageKey())
+		require.NoError(t, err, "should warm up other blocks")
+	})
+}
+
+func createWarmPrefetcher(t *testing.T) (*Prefetcher, kvstore.KV) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	kv := kvstore.NewMemKV()
+	return NewPrefetcher(logger, nil, nil, nil, nil, kv), kv
+}
+
+func randomBlockAt(rng *rand.Rand, number uint64) (*types.Block, types.Receipts) {
+	block, receipts := testutils.RandomBlock(rng, 3)
+	header := block.Header()
+	header.Number = new(big.Int).SetUint64(number)
+	block = block.WithSeal(header)
+	for _, receipt := range receipts {
+		receipt.BlockHash = block.Hash()
+		receipt.BlockNumber = header.Number
+		for _, l := range receipt.Logs {
+			l.BlockHash = block.Hash()
+			l.BlockNumber = number
+		}
+	}
+	return block, receipts
+}
+
+func accountNode(addr common.Address) []byte {
+	return append([]byte("node"), addr.Bytes()...)
+}
+
+func accountCode(addr common.Address) []byte {
+	return append([]byte("code"), addr.Bytes()...)
+}
+
+type stubWarmL1Source struct {
+	blocks     map[uint64]*types.Block
+	receipts   map[common.Hash]types.Receipts
+	failNumber uint64
+}
+
+func (s *stubWarmL1Source) InfoByHash(_ context.Context, blockHash common.Hash) (eth.BlockInfo, error) {
+	for _, block := range s.blocks {
+		if block.Hash() == blockHash {
+			return eth.BlockToInfo(block), nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *stubWarmL1Source) InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error) {
+	info, _, err := s.InfoAndTxsByNumber(ctx, number)
+	return info, err
+}
+
+func (s *stubWarmL1Source) InfoAndTxsByNumber(_ context.Context, number uint64) (eth.BlockInfo, types.Transactions, error) {
+	block, ok := s.blocks[number]
+	if !ok || (s.failNumber != 0 && number == s.failNumber) {
+		return nil, nil, errors.New("not found")
+	}
+	return eth.BlockToInfo(block), block.Transactions(), nil
+}
+
+func (s *stubWarmL1Source) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	info, err := s.InfoByHash(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, s.receipts[blockHash], nil
+}
+
+type stubWarmL2Source struct {
+	blocks map[uint64]*types.Block
+	head   eth.L2BlockRef
+
+	mu          sync.Mutex
+	proofBlocks map[string]bool
+	code        map[common.Hash][]byte
+}
+
+func (s *stubWarmL2Source) L2BlockRefByHash(_ context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	if hash != s.head.Hash {
+		return eth.L2BlockRef{}, errors.New("not found")
+	}
+	return s.head, nil
+}
+
+func (s *stubWarmL2Source) InfoAndTxsByNumber(_ context.Context, number uint64) (eth.BlockInfo, types.Transactions, error) {
+	block, ok := s.blocks[number]
+	if !ok {
+		return nil, nil, errors.New("not found")
+	}
+	return eth.BlockToInfo(block), block.Transactions(), nil
+}
+
+func (s *stubWarmL2Source) GetProof(_ context.Context, address common.Address, _ []common.Hash, blockTag string) (*eth.AccountResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.proofBlocks[blockTag] = true
+	code := accountCode(address)
+	s.code[crypto.Keccak256Hash(code)] = code
+	return &eth.AccountResult{
+		AccountProof: []hexutil.Bytes{accountNode(address)},
+		Address:      address,
+		CodeHash:     crypto.Keccak256Hash(code),
+	}, nil
+}
+
+func (s *stubWarmL2Source) CodeByHash(_ context.Context, hash common.Hash) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code, ok := s.code[hash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return code, nil
+}
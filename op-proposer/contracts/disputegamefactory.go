@@ -18,17 +18,61 @@ const (
 	methodGameCount   = "gameCount"
 	methodGameAtIndex = "gameAtIndex"
 	methodInitBonds   = "initBonds"
+	methodGameImpls   = "gameImpls"
 	methodCreateGame  = "create"
 	methodVersion     = "version"
 
-	methodClaim = "claimData"
+	methodClaim         = "claimData"
+	methodL2BlockNumber = "l2BlockNumber"
+	methodStatus        = "status"
 )
 
+// claimedBondFlag is the bond of a claim after its bond has been paid out.
+var claimedBondFlag = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// GameStatus is the resolution status of a dispute game.
+type GameStatus uint8
+
+const (
+	GameStatusInProgress GameStatus = iota
+	GameStatusChallengerWon
+	GameStatusDefenderWon
+)
+
+func (s GameStatus) String() string {
+	switch s {
+	case GameStatusInProgress:
+		return "In Progress"
+	case GameStatusChallengerWon:
+		return "Challenger Won"
+	case GameStatusDefenderWon:
+		return "Defender Won"
+	default:
+		return fmt.Sprintf("Unknown status: %d", int(s))
+	}
+}
+
 type gameMetadata struct {
 	GameType  uint32
 	Timestamp time.Time
 	Address   common.Address
 	Proposer  common.Address
+	RootClaim common.Hash
+	Bond      *big.Int
+}
+
+// GameProposal is an output proposal made by creating a dispute game.
+type GameProposal struct {
+	Proposal
+	Address   common.Address
+	Proposer  common.Address
+	Timestamp time.Time
+}
+
+// ProposerGame is a dispute game created by a proposer, with the bond of the proposer's root claim.
+type ProposerGame struct {
+	GameProposal
+	Bond *big.Int
 }
 
 type DisputeGameFactory struct {
@@ -36,16 +80,23 @@ type DisputeGameFactory struct {
 	contract       *batching.BoundContract
 	gameABI        *abi.ABI
 	networkTimeout time.Duration
+	extraData      *ExtraDataEncoders
 }
 
-func NewDisputeGameFactory(addr common.Address, caller *batching.MultiCaller, networkTimeout time.Duration) *DisputeGameFactory {
+// NewDisputeGameFactory creates a new DisputeGameFactory binding.
+// If extraData is nil, the extra data for all game types is the L2 block number.
+func NewDisputeGameFactory(addr common.Address, caller *batching.MultiCaller, networkTimeout time.Duration, extraData *ExtraDataEncoders) *DisputeGameFactory {
 	factoryABI := snapshots.LoadDisputeGameFactoryABI()
 	gameABI := snapshots.LoadFaultDisputeGameABI()
+	if extraData == nil {
+		extraData = NewExtraDataEncoders()
+	}
 	return &DisputeGameFactory{
 		caller:         caller,
 		contract:       batching.NewBoundContract(factoryABI, addr),
 		gameABI:        gameABI,
 		networkTimeout: networkTimeout,
+		extraData:      extraData,
 	}
 }
 
@@ -90,15 +141,153 @@ func (f *DisputeGameFactory) HasProposedSince(ctx context.Context, proposer comm
 	}
 }
 
-func (f *DisputeGameFactory) ProposalTx(ctx context.Context, gameType uint32, outputRoot common.Hash, l2BlockNum uint64) (txmgr.TxCandidate, error) {
+// ProposalsSince returns the proposals of the specified game type made after the given cut off time,
+// by any proposer, ordered from the most recent to the oldest.
+func (f *DisputeGameFactory) ProposalsSince(ctx context.Context, cutoff time.Time, gameType uint32) ([]GameProposal, error) {
+	gameCount, err := f.gameCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute game count: %w", err)
+	}
+	var proposals []GameProposal
+	for idx := gameCount; idx > 0; idx-- {
+		game, err := f.gameAtIndex(ctx, idx-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dispute game %d: %w", idx-1, err)
+		}
+		if game.Timestamp.Before(cutoff) {
+			break
+		}
+		if game.GameType != gameType {
+			continue
+		}
+		l2BlockNum, err := f.gameL2BlockNumber(ctx, game.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L2 block number of dispute game %d: %w", idx-1, err)
+		}
+		proposals = append(proposals, GameProposal{
+			Proposal: Proposal{
+				GameType:   game.GameType,
+				OutputRoot: game.RootClaim,
+				L2BlockNum: l2BlockNum,
+			},
+			Address:   game.Address,
+			Proposer:  game.Proposer,
+			Timestamp: game.Timestamp,
+		})
+	}
+	return proposals, nil
+}
+
+// LatestProposal finds the most recent game with the specified game type created by the specified proposer
+// after the given cut off time. Returns nil if there is no such game.
+func (f *DisputeGameFactory) LatestProposal(ctx context.Context, proposer common.Address, cutoff time.Time, gameType uint32) (*GameProposal, error) {
+	gameCount, err := f.gameCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute game count: %w", err)
+	}
+	for idx := gameCount; idx > 0; idx-- {
+		game, err := f.gameAtIndex(ctx, idx-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dispute game %d: %w", idx-1, err)
+		}
+		if game.Timestamp.Before(cutoff) {
+			return nil, nil
+		}
+		if game.GameType != gameType || game.Proposer != proposer {
+			continue
+		}
+		l2BlockNum, err := f.gameL2BlockNumber(ctx, game.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L2 block number of dispute game %d: %w", idx-1, err)
+		}
+		return &GameProposal{
+			Proposal: Proposal{
+				GameType:   game.GameType,
+				OutputRoot: game.RootClaim,
+				L2BlockNum: l2BlockNum,
+			},
+			Address:   game.Address,
+			Proposer:  game.Proposer,
+			Timestamp: game.Timestamp,
+		}, nil
+	}
+	return nil, nil
+}
+
+// ProposerGames returns the games created by the proposer, with a game index of at least fromIndex and created
+// after the given cut off time, ordered from the most recent to the oldest.
+// It also returns the current game count, as the game index to continue from.
+func (f *DisputeGameFactory) ProposerGames(ctx context.Context, proposer common.Address, fromIndex uint64, cutoff time.Time) ([]ProposerGame, uint64, error) {
+	gameCount, err := f.gameCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get dispute game count: %w", err)
+	}
+	var games []ProposerGame
+	for idx := gameCount; idx > fromIndex; idx-- {
+		game, err := f.gameAtIndex(ctx, idx-1)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get dispute game %d: %w", idx-1, err)
+		}
+		if game.Timestamp.Before(cutoff) {
+			break
+		}
+		if game.Proposer != proposer {
+			continue
+		}
+		l2BlockNum, err := f.gameL2BlockNumber(ctx, game.Address)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get L2 block number of dispute game %d: %w", idx-1, err)
+		}
+		bond := game.Bond
+		if bond.Cmp(claimedBondFlag) == 0 {
+			// The bond was already paid out, fall back to the bond required to create the game.
+			bond, err = f.initBond(ctx, game.GameType)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		games = append(games, ProposerGame{
+			GameProposal: GameProposal{
+				Proposal: Proposal{
+					GameType:   game.GameType,
+					OutputRoot: game.RootClaim,
+					L2BlockNum: l2BlockNum,
+				},
+				Address:   game.Address,
+				Proposer:  game.Proposer,
+				Timestamp: game.Timestamp,
+			},
+			Bond: bond,
+		})
+	}
+	return games, gameCount, nil
+}
+
+// GameStatus returns the resolution status of the game.
+func (f *DisputeGameFactory) GameStatus(ctx context.Context, game common.Address) (GameStatus, error) {
 	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
 	defer cancel()
-	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, f.contract.Call(methodInitBonds, gameType))
+	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, batching.NewBoundContract(f.gameABI, game).Call(methodStatus))
 	if err != nil {
-		return txmgr.TxCandidate{}, fmt.Errorf("failed to fetch init bond: %w", err)
+		return 0, fmt.Errorf("failed to load status of game %v: %w", game, err)
 	}
-	initBond := result.GetBigInt(0)
-	call := f.contract.Call(methodCreateGame, gameType, outputRoot, common.BigToHash(big.NewInt(int64(l2BlockNum))).Bytes())
+	return GameStatus(result.GetUint8(0)), nil
+}
+
+func (f *DisputeGameFactory) ProposalTx(ctx context.Context, gameType uint32, outputRoot common.Hash, l2BlockNum uint64) (txmgr.TxCandidate, error) {
+	initBond, err := f.initBond(ctx, gameType)
+	if err != nil {
+		return txmgr.TxCandidate{}, err
+	}
+	extraData, err := f.extraData.Encode(Proposal{
+		GameType:   gameType,
+		OutputRoot: outputRoot,
+		L2BlockNum: l2BlockNum,
+	})
+	if err != nil {
+		return txmgr.TxCandidate{}, err
+	}
+	call := f.contract.Call(methodCreateGame, gameType, outputRoot, extraData)
 	candidate, err := call.ToTxCandidate()
 	if err != nil {
 		return txmgr.TxCandidate{}, err
@@ -107,6 +296,27 @@ func (f *DisputeGameFactory) ProposalTx(ctx context.Context, gameType uint32, ou
 	return candidate, err
 }
 
+// HasImplementation returns true if the factory has an implementation to create games of the game type.
+func (f *DisputeGameFactory) HasImplementation(ctx context.Context, gameType uint32) (bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, f.contract.Call(methodGameImpls, gameType))
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch game implementation: %w", err)
+	}
+	return result.GetAddress(0) != (common.Address{}), nil
+}
+
+func (f *DisputeGameFactory) initBond(ctx context.Context, gameType uint32) (*big.Int, error) {
+	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, f.contract.Call(methodInitBonds, gameType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch init bond: %w", err)
+	}
+	return result.GetBigInt(0), nil
+}
+
 func (f *DisputeGameFactory) gameCount(ctx context.Context) (uint64, error) {
 	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
 	defer cancel()
@@ -135,13 +345,27 @@ func (f *DisputeGameFactory) gameAtIndex(ctx context.Context, idx uint64) (gameM
 	if err != nil {
 		return gameMetadata{}, fmt.Errorf("failed to load root claim of game %v: %w", idx, err)
 	}
-	// We don't need most of the claim data, only the claimant which is the game proposer
+	// We don't need most of the claim data, only the claimant which is the game proposer, its bond, and the root claim
 	claimant := result.GetAddress(2)
+	bond := result.GetBigInt(3)
+	rootClaim := result.GetHash(4)
 
 	return gameMetadata{
 		GameType:  gameType,
 		Timestamp: time.Unix(int64(timestamp), 0),
 		Address:   address,
 		Proposer:  claimant,
+		RootClaim: rootClaim,
+		Bond:      bond,
 	}, nil
 }
+
+func (f *DisputeGameFactory) gameL2BlockNumber(ctx context.Context, game common.Address) (uint64, error) {
+	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, batching.NewBoundContract(f.gameABI, game).Call(methodL2BlockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("failed to load L2 block number: %w", err)
+	}
+	return result.GetBigInt(0).Uint64(), nil
+}
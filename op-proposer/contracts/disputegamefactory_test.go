@@ -179,6 +179,212 @@ func TestHasProposedSince(t *testing.T) {
 	})
 }
 
+func TestProposalsSince(t *testing.T) {
+	cutOffTime := time.Unix(1000, 0)
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	withClaims(
+		stubRpc,
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(999, 0), // Before cut off
+			Address:   common.Address{0x11},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x01},
+		},
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(1600, 0),
+			Address:   common.Address{0x22},
+			Proposer:  common.Address{0xee},
+			RootClaim: common.Hash{0x02},
+		},
+		gameMetadata{
+			GameType:  1, // Wrong game type
+			Timestamp: time.Unix(1700, 0),
+			Address:   common.Address{0x33},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x03},
+		},
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(1800, 0),
+			Address:   common.Address{0x44},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x04},
+		},
+	)
+
+	proposals, err := factory.ProposalsSince(context.Background(), cutOffTime, 0)
+	require.NoError(t, err)
+	require.Equal(t, []GameProposal{
+		{
+			Proposal:  Proposal{GameType: 0, OutputRoot: common.Hash{0x04}, L2BlockNum: 0x44},
+			Address:   common.Address{0x44},
+			Proposer:  proposerAddr,
+			Timestamp: time.Unix(1800, 0),
+		},
+		{
+			Proposal:  Proposal{GameType: 0, OutputRoot: common.Hash{0x02}, L2BlockNum: 0x22},
+			Address:   common.Address{0x22},
+			Proposer:  common.Address{0xee},
+			Timestamp: time.Unix(1600, 0),
+		},
+	}, proposals)
+}
+
+func TestLatestProposal(t *testing.T) {
+	cutOffTime := time.Unix(1000, 0)
+	games := []gameMetadata{
+		{
+			GameType:  0,
+			Timestamp: time.Unix(1600, 0),
+			Address:   common.Address{0x22},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x02},
+		},
+		{
+			GameType:  0,
+			Timestamp: time.Unix(1700, 0),
+			Address:   common.Address{0x33},
+			Proposer:  common.Address{0xee}, // Other proposer
+			RootClaim: common.Hash{0x03},
+		},
+		{
+			GameType:  1, // Wrong game type
+			Timestamp: time.Unix(1800, 0),
+			Address:   common.Address{0x44},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x04},
+		},
+	}
+
+	t.Run("Found", func(t *testing.T) {
+		stubRpc, factory := setupDisputeGameFactoryTest(t)
+		withClaims(stubRpc, games...)
+		proposal, err := factory.LatestProposal(context.Background(), proposerAddr, cutOffTime, 0)
+		require.NoError(t, err)
+		require.Equal(t, &GameProposal{
+			Proposal:  Proposal{GameType: 0, OutputRoot: common.Hash{0x02}, L2BlockNum: 0x22},
+			Address:   common.Address{0x22},
+			Proposer:  proposerAddr,
+			Timestamp: time.Unix(1600, 0),
+		}, proposal)
+	})
+
+	t.Run("BeforeCutOff", func(t *testing.T) {
+		stubRpc, factory := setupDisputeGameFactoryTest(t)
+		withClaims(stubRpc, games...)
+		proposal, err := factory.LatestProposal(context.Background(), proposerAddr, time.Unix(1650, 0), 0)
+		require.NoError(t, err)
+		require.Nil(t, proposal)
+	})
+}
+
+func TestProposerGames(t *testing.T) {
+	games := []gameMetadata{
+		{
+			GameType:  0,
+			Timestamp: time.Unix(1000, 0),
+			Address:   common.Address{0x11},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x01},
+		},
+		{
+			GameType:  1,
+			Timestamp: time.Unix(1600, 0),
+			Address:   common.Address{0x22},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x02},
+			Bond:      claimedBondFlag, // already paid out
+		},
+		{
+			GameType:  0,
+			Timestamp: time.Unix(1700, 0),
+			Address:   common.Address{0x33},
+			Proposer:  common.Address{0xee}, // Other proposer
+			RootClaim: common.Hash{0x03},
+		},
+		{
+			GameType:  0,
+			Timestamp: time.Unix(1800, 0),
+			Address:   common.Address{0x44},
+			Proposer:  proposerAddr,
+			RootClaim: common.Hash{0x04},
+			Bond:      big.NewInt(500),
+		},
+	}
+
+	t.Run("AfterCutOff", func(t *testing.T) {
+		stubRpc, factory := setupDisputeGameFactoryTest(t)
+		withClaims(stubRpc, games...)
+		stubRpc.SetResponse(factoryAddr, methodInitBonds, rpcblock.Latest, []interface{}{uint32(1)}, []interface{}{big.NewInt(2000)})
+		found, next, err := factory.ProposerGames(context.Background(), proposerAddr, 0, time.Unix(1500, 0))
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), next)
+		require.Equal(t, []ProposerGame{
+			{
+				GameProposal: GameProposal{
+					Proposal:  Proposal{GameType: 0, OutputRoot: common.Hash{0x04}, L2BlockNum: 0x44},
+					Address:   common.Address{0x44},
+					Proposer:  proposerAddr,
+					Timestamp: time.Unix(1800, 0),
+				},
+				Bond: big.NewInt(500),
+			},
+			{
+				GameProposal: GameProposal{
+					Proposal:  Proposal{GameType: 1, OutputRoot: common.Hash{0x02}, L2BlockNum: 0x22},
+					Address:   common.Address{0x22},
+					Proposer:  proposerAddr,
+					Timestamp: time.Unix(1600, 0),
+				},
+				Bond: big.NewInt(2000),
+			},
+		}, found)
+	})
+
+	t.Run("FromIndex", func(t *testing.T) {
+		stubRpc, factory := setupDisputeGameFactoryTest(t)
+		withClaims(stubRpc, games...)
+		found, next, err := factory.ProposerGames(context.Background(), proposerAddr, 2, time.Unix(0, 0))
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), next)
+		require.Len(t, found, 1)
+		require.Equal(t, common.Address{0x44}, found[0].Address)
+	})
+
+	t.Run("NoNewGames", func(t *testing.T) {
+		stubRpc, factory := setupDisputeGameFactoryTest(t)
+		withClaims(stubRpc, games...)
+		found, next, err := factory.ProposerGames(context.Background(), proposerAddr, 4, time.Unix(0, 0))
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), next)
+		require.Empty(t, found)
+	})
+}
+
+func TestGameStatus(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	game := common.Address{0x11}
+	stubRpc.AddContract(game, snapshots.LoadFaultDisputeGameABI())
+	stubRpc.SetResponse(game, methodStatus, rpcblock.Latest, nil, []interface{}{uint8(GameStatusChallengerWon)})
+	status, err := factory.GameStatus(context.Background(), game)
+	require.NoError(t, err)
+	require.Equal(t, GameStatusChallengerWon, status)
+}
+
+func TestHasImplementation(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	stubRpc.SetResponse(factoryAddr, methodGameImpls, rpcblock.Latest, []interface{}{uint32(1)}, []interface{}{common.Address{0xaa}})
+	stubRpc.SetResponse(factoryAddr, methodGameImpls, rpcblock.Latest, []interface{}{uint32(2)}, []interface{}{common.Address{}})
+	has, err := factory.HasImplementation(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = factory.HasImplementation(context.Background(), 2)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
 func TestProposalTx(t *testing.T) {
 	stubRpc, factory := setupDisputeGameFactoryTest(t)
 	traceType := uint32(123)
@@ -194,10 +400,27 @@ func TestProposalTx(t *testing.T) {
 	require.Truef(t, bond.Cmp(tx.Value) == 0, "Expected bond %v but was %v", bond, tx.Value)
 }
 
+func TestProposalTxWithCustomExtraData(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	factory.extraData.Register(124, L2BlockNumberWithParamsExtraData([]byte{0xaa, 0xbb}))
+	outputRoot := common.Hash{0x01}
+	bond := big.NewInt(1000)
+	expectedExtraData := append(common.BigToHash(big.NewInt(456)).Bytes(), 0xaa, 0xbb)
+	stubRpc.SetResponse(factoryAddr, methodInitBonds, rpcblock.Latest, []interface{}{uint32(124)}, []interface{}{bond})
+	stubRpc.SetResponse(factoryAddr, methodCreateGame, rpcblock.Latest, []interface{}{uint32(124), outputRoot, expectedExtraData}, nil)
+	tx, err := factory.ProposalTx(context.Background(), 124, outputRoot, uint64(456))
+	require.NoError(t, err)
+	stubRpc.VerifyTxCandidate(tx)
+}
+
 func withClaims(stubRpc *batchingTest.AbiBasedRpc, games ...gameMetadata) {
 	gameAbi := snapshots.LoadFaultDisputeGameABI()
 	stubRpc.SetResponse(factoryAddr, methodGameCount, rpcblock.Latest, nil, []interface{}{big.NewInt(int64(len(games)))})
 	for i, game := range games {
+		bond := game.Bond
+		if bond == nil {
+			bond = big.NewInt(1000)
+		}
 		stubRpc.SetResponse(factoryAddr, methodGameAtIndex, rpcblock.Latest, []interface{}{big.NewInt(int64(i))}, []interface{}{
 			game.GameType,
 			uint64(game.Timestamp.Unix()),
@@ -208,11 +431,15 @@ func withClaims(stubRpc *batchingTest.AbiBasedRpc, games ...gameMetadata) {
 			uint32(math.MaxUint32), // Parent address (none for root claim)
 			common.Address{},       // Countered by
 			game.Proposer,          // Claimant
-			big.NewInt(1000),       // Bond
-			common.Hash{0xdd},      // Claim
+			bond,                   // Bond
+			game.RootClaim,         // Claim
 			big.NewInt(1),          // Position (gindex 1 for root position)
 			big.NewInt(100),        // Clock
 		})
+		// The L2 block number is encoded in the game address in tests
+		stubRpc.SetResponse(game.Address, methodL2BlockNumber, rpcblock.Latest, nil, []interface{}{
+			new(big.Int).SetBytes(game.Address[:1]),
+		})
 	}
 }
 
@@ -221,6 +448,6 @@ func setupDisputeGameFactoryTest(t *testing.T) (*batchingTest.AbiBasedRpc, *Disp
 
 	stubRpc := batchingTest.NewAbiBasedRpc(t, factoryAddr, fdgAbi)
 	caller := batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize)
-	factory := NewDisputeGameFactory(factoryAddr, caller, time.Minute)
+	factory := NewDisputeGameFactory(factoryAddr, caller, time.Minute, nil)
 	return stubRpc, factory
 }
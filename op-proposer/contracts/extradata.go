@@ -0,0 +1,75 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Proposal describes the output being proposed when creating a new dispute game.
+type Proposal struct {
+	GameType   uint32
+	OutputRoot common.Hash
+	L2BlockNum uint64
+}
+
+// ExtraDataEncoder produces the extra data passed to DisputeGameFactory.create for a proposal.
+type ExtraDataEncoder func(proposal Proposal) ([]byte, error)
+
+// L2BlockNumberExtraData encodes the L2 block number as a single 32 byte big-endian word.
+// This is the extra data format used by the FaultDisputeGame and PermissionedDisputeGame.
+func L2BlockNumberExtraData(proposal Proposal) ([]byte, error) {
+	return common.BigToHash(new(big.Int).SetUint64(proposal.L2BlockNum)).Bytes(), nil
+}
+
+// L2BlockNumberWithParamsExtraData encodes the L2 block number as a 32 byte big-endian word,
+// followed by the supplied static game parameters.
+func L2BlockNumberWithParamsExtraData(params []byte) ExtraDataEncoder {
+	return func(proposal Proposal) ([]byte, error) {
+		blockNum, err := L2BlockNumberExtraData(proposal)
+		if err != nil {
+			return nil, err
+		}
+		return append(blockNum, params...), nil
+	}
+}
+
+// ExtraDataEncoders maps game types to the encoder used to create the extra data for that game type.
+// Game types without a registered encoder use the default encoder.
+type ExtraDataEncoders struct {
+	mu             sync.RWMutex
+	encoders       map[uint32]ExtraDataEncoder
+	defaultEncoder ExtraDataEncoder
+}
+
+// NewExtraDataEncoders creates a new registry with L2BlockNumberExtraData as the default encoder.
+func NewExtraDataEncoders() *ExtraDataEncoders {
+	return &ExtraDataEncoders{
+		encoders:       make(map[uint32]ExtraDataEncoder),
+		defaultEncoder: L2BlockNumberExtraData,
+	}
+}
+
+// Register sets the encoder used for the specified game type, replacing any existing encoder.
+func (e *ExtraDataEncoders) Register(gameType uint32, encoder ExtraDataEncoder) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.encoders[gameType] = encoder
+}
+
+// Encode creates the extra data for the proposal using the encoder registered for its game type.
+func (e *ExtraDataEncoders) Encode(proposal Proposal) ([]byte, error) {
+	e.mu.RLock()
+	encoder, ok := e.encoders[proposal.GameType]
+	e.mu.RUnlock()
+	if !ok {
+		encoder = e.defaultEncoder
+	}
+	extraData, err := encoder(proposal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extra data for game type %v: %w", proposal.GameType, err)
+	}
+	return extraData, nil
+}
@@ -0,0 +1,47 @@
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtraDataEncoders(t *testing.T) {
+	proposal := Proposal{GameType: 1, OutputRoot: common.Hash{0xaa}, L2BlockNum: 4829}
+	expectedBlockNum := common.BigToHash(big.NewInt(4829)).Bytes()
+
+	t.Run("Default", func(t *testing.T) {
+		encoders := NewExtraDataEncoders()
+		extraData, err := encoders.Encode(proposal)
+		require.NoError(t, err)
+		require.Equal(t, expectedBlockNum, extraData)
+	})
+
+	t.Run("RegisteredForGameType", func(t *testing.T) {
+		encoders := NewExtraDataEncoders()
+		encoders.Register(1, L2BlockNumberWithParamsExtraData([]byte{0x01, 0x02}))
+		extraData, err := encoders.Encode(proposal)
+		require.NoError(t, err)
+		require.Equal(t, append(expectedBlockNum, 0x01, 0x02), extraData)
+
+		// Other game types still use the default
+		other := proposal
+		other.GameType = 2
+		extraData, err = encoders.Encode(other)
+		require.NoError(t, err)
+		require.Equal(t, expectedBlockNum, extraData)
+	})
+
+	t.Run("EncoderError", func(t *testing.T) {
+		encoders := NewExtraDataEncoders()
+		expectedErr := errors.New("boom")
+		encoders.Register(1, func(proposal Proposal) ([]byte, error) {
+			return nil, expectedErr
+		})
+		_, err := encoders.Encode(proposal)
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -66,12 +67,52 @@ var (
 		Value:   0,
 		EnvVars: prefixEnvVars("GAME_TYPE"),
 	}
+	FallbackGameTypesFlag = &cli.UintSliceFlag{
+		Name: "fallback-game-types",
+		Usage: "Dispute game types to fall back to, in order of preference, if creating a game of the preferred game type " +
+			"would revert, e.g. because the DisputeGameFactory configuration changed",
+		EnvVars: prefixEnvVars("FALLBACK_GAME_TYPES"),
+	}
+	GameExtraDataParamsFlag = &cli.StringSliceFlag{
+		Name: "game-extra-data-params",
+		Usage: "Static game parameters to append to the L2 block number in the extra data of created dispute games, " +
+			"per game type, formatted as <game-type>=<hex params>. Game types without params use the L2 block number only.",
+		EnvVars: prefixEnvVars("GAME_EXTRA_DATA_PARAMS"),
+	}
 	ActiveSequencerCheckDurationFlag = &cli.DurationFlag{
 		Name:    "active-sequencer-check-duration",
 		Usage:   "The duration between checks to determine the active sequencer endpoint.",
 		Value:   2 * time.Minute,
 		EnvVars: prefixEnvVars("ACTIVE_SEQUENCER_CHECK_DURATION"),
 	}
+	SkipRedundantProposalsFlag = &cli.BoolFlag{
+		Name: "skip-redundant-proposals",
+		Usage: "Skip a proposal when another proposer already created a game with a valid output root " +
+			"within the proposal interval. Only applies when the DisputeGameFactory is used.",
+		Value:   false,
+		EnvVars: prefixEnvVars("SKIP_REDUNDANT_PROPOSALS"),
+	}
+	CatchUpWindowFlag = &cli.DurationFlag{
+		Name: "catch-up-window",
+		Usage: "How far back to look for the last proposal of this proposer, to make the proposals missed since then, " +
+			"e.g. after downtime. Disabled if 0. Only applies when the DisputeGameFactory is used.",
+		Value:   0,
+		EnvVars: prefixEnvVars("CATCH_UP_WINDOW"),
+	}
+	CatchUpMaxProposalsFlag = &cli.Uint64Flag{
+		Name: "catch-up-max-proposals",
+		Usage: "Maximum number of proposals to make when catching up on missed proposals. " +
+			"Missed proposals beyond the limit are coalesced. Unlimited if 0.",
+		Value:   0,
+		EnvVars: prefixEnvVars("CATCH_UP_MAX_PROPOSALS"),
+	}
+	GameResolutionWindowFlag = &cli.DurationFlag{
+		Name: "game-resolution-window",
+		Usage: "Watch the games created by this proposer within the window until they resolve, and alert when a game " +
+			"resolves against the proposer. Disabled if 0. Only applies when the DisputeGameFactory is used.",
+		Value:   0,
+		EnvVars: prefixEnvVars("GAME_RESOLUTION_WINDOW"),
+	}
 	WaitNodeSyncFlag = &cli.BoolFlag{
 		Name: "wait-node-sync",
 		Usage: "Indicates if, during startup, the proposer should wait for the rollup node to sync to " +
@@ -79,6 +120,19 @@ var (
 		Value:   false,
 		EnvVars: prefixEnvVars("WAIT_NODE_SYNC"),
 	}
+	DryRunFlag = &cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Follow the proposal schedule, fetch and validate output roots, but only log and record metrics " +
+			"of the proposals that would be made instead of sending transactions.",
+		EnvVars: prefixEnvVars("DRY_RUN"),
+	}
+	VerificationRpcFlag = &cli.StringFlag{
+		Name: "verification-rpc",
+		Usage: "HTTP provider URL for a second rollup node, that is independent of the rollup-rpc nodes. " +
+			"If set, output roots are only proposed if this node returns the same output root, " +
+			"and the proposer halts on a mismatch.",
+		EnvVars: prefixEnvVars("VERIFICATION_RPC"),
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -96,8 +150,16 @@ var optionalFlags = []cli.Flag{
 	DisputeGameFactoryAddressFlag,
 	ProposalIntervalFlag,
 	DisputeGameTypeFlag,
+	FallbackGameTypesFlag,
+	GameExtraDataParamsFlag,
 	ActiveSequencerCheckDurationFlag,
 	WaitNodeSyncFlag,
+	SkipRedundantProposalsFlag,
+	CatchUpWindowFlag,
+	CatchUpMaxProposalsFlag,
+	GameResolutionWindowFlag,
+	DryRunFlag,
+	VerificationRpcFlag,
 }
 
 func init() {
@@ -105,6 +167,7 @@ func init() {
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, clock.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
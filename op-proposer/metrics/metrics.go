@@ -2,11 +2,12 @@ package metrics
 
 import (
 	"io"
+	"math/big"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -31,9 +32,17 @@ type Metricer interface {
 
 	opmetrics.RPCMetricer
 
-	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
+	StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer
 
 	RecordL2BlocksProposed(l2ref eth.L2BlockRef)
+
+	RecordOtherProposerGames(valid int, invalid int)
+	RecordRedundantProposalSkipped()
+	RecordGameTypeSwitch(from uint32, to uint32)
+	RecordDryRunProposal(l2ref eth.L2BlockRef, reverted bool)
+	RecordOutputVerification(agree bool)
+	RecordGameResolution(defenderWon bool, bond *big.Int)
+	RecordPendingGames(count int)
 }
 
 type Metrics struct {
@@ -47,6 +56,16 @@ type Metrics struct {
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
+
+	otherProposerGames        *prometheus.GaugeVec
+	redundantProposalsSkipped prometheus.Counter
+	gameTypeSwitches          *prometheus.CounterVec
+	gameType                  prometheus.Gauge
+	dryRunProposals           *prometheus.CounterVec
+	outputVerifications       *prometheus.CounterVec
+	gameResolutions           *prometheus.CounterVec
+	bondsLost                 prometheus.Counter
+	pendingGames              prometheus.Gauge
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -81,6 +100,62 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-proposer has finished starting up",
 		}),
+		otherProposerGames: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "other_proposer_games",
+			Help:      "Number of games created by other proposers within the proposal interval, by validity of the output root",
+		}, []string{
+			"validity",
+		}),
+		redundantProposalsSkipped: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "redundant_proposals_skipped_total",
+			Help:      "Number of proposals skipped because a valid game of another proposer already covered them",
+		}),
+		gameTypeSwitches: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "game_type_switches_total",
+			Help:      "Number of times the proposer switched the dispute game type, because creating a game of the previous type would revert",
+		}, []string{
+			"from",
+			"to",
+		}),
+		gameType: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "game_type",
+			Help:      "Dispute game type that the proposer creates games of",
+		}),
+		dryRunProposals: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_proposals_total",
+			Help:      "Number of proposals that would have been made in dry-run mode, by whether the simulated proposal reverted",
+		}, []string{
+			"result",
+		}),
+		outputVerifications: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "output_verifications_total",
+			Help:      "Number of output roots verified against the verification node before proposing, by agreement of the nodes",
+		}, []string{
+			"result",
+		}),
+		gameResolutions: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "game_resolutions_total",
+			Help:      "Number of games created by the proposer that resolved, by the winner of the game",
+		}, []string{
+			"result",
+		}),
+		bondsLost: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "bonds_lost_eth_total",
+			Help:      "Total bond in ETH lost by the proposer, on games that resolved against the proposer",
+		}),
+		pendingGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "pending_games",
+			Help:      "Number of games created by the proposer that are watched until they resolve",
+		}),
 	}
 }
 
@@ -88,8 +163,8 @@ func (m *Metrics) Registry() *prometheus.Registry {
 	return m.registry
 }
 
-func (m *Metrics) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
-	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+func (m *Metrics) StartAccountMonitor(l log.Logger, client opmetrics.AccountClient, account common.Address) io.Closer {
+	return opmetrics.LaunchAccountMonitor(l, m.registry, m.ns, client, account)
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
@@ -106,6 +181,7 @@ func (m *Metrics) RecordUp() {
 
 const (
 	BlockProposed = "proposed"
+	BlockDryRun   = "dry_run"
 )
 
 // RecordL2BlocksProposed should be called when new L2 block is proposed
@@ -113,6 +189,58 @@ func (m *Metrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
 	m.RecordL2Ref(BlockProposed, l2ref)
 }
 
+// RecordOtherProposerGames records the number of games with valid and invalid output roots
+// created by other proposers within the proposal interval.
+func (m *Metrics) RecordOtherProposerGames(valid int, invalid int) {
+	m.otherProposerGames.WithLabelValues("valid").Set(float64(valid))
+	m.otherProposerGames.WithLabelValues("invalid").Set(float64(invalid))
+}
+
+func (m *Metrics) RecordRedundantProposalSkipped() {
+	m.redundantProposalsSkipped.Inc()
+}
+
+// RecordGameTypeSwitch records that the proposer switched to another dispute game type.
+func (m *Metrics) RecordGameTypeSwitch(from uint32, to uint32) {
+	m.gameTypeSwitches.WithLabelValues(strconv.FormatUint(uint64(from), 10), strconv.FormatUint(uint64(to), 10)).Inc()
+	m.gameType.Set(float64(to))
+}
+
+// RecordDryRunProposal records a proposal that would have been made in dry-run mode.
+func (m *Metrics) RecordDryRunProposal(l2ref eth.L2BlockRef, reverted bool) {
+	result := "success"
+	if reverted {
+		result = "reverted"
+	}
+	m.dryRunProposals.WithLabelValues(result).Inc()
+	m.RecordL2Ref(BlockDryRun, l2ref)
+}
+
+// RecordOutputVerification records whether the verification node agreed with an output root to propose.
+func (m *Metrics) RecordOutputVerification(agree bool) {
+	result := "agree"
+	if !agree {
+		result = "disagree"
+	}
+	m.outputVerifications.WithLabelValues(result).Inc()
+}
+
+// RecordGameResolution records the resolution of a game created by the proposer.
+// The bond of the proposer is lost if the game did not resolve in favor of the defender.
+func (m *Metrics) RecordGameResolution(defenderWon bool, bond *big.Int) {
+	if defenderWon {
+		m.gameResolutions.WithLabelValues("defender_won").Inc()
+		return
+	}
+	m.gameResolutions.WithLabelValues("challenger_won").Inc()
+	m.bondsLost.Add(eth.WeiToEther(bond))
+}
+
+// RecordPendingGames records the number of unresolved games created by the proposer.
+func (m *Metrics) RecordPendingGames(count int) {
+	m.pendingGames.Set(float64(count))
+}
+
 func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
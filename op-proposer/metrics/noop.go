@@ -2,9 +2,9 @@ package metrics
 
 import (
 	"io"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -24,7 +24,14 @@ func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
 
 func (*noopMetrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {}
-
-func (*noopMetrics) StartBalanceMetrics(log.Logger, *ethclient.Client, common.Address) io.Closer {
+func (*noopMetrics) RecordOtherProposerGames(int, int)           {}
+func (*noopMetrics) RecordRedundantProposalSkipped()             {}
+func (*noopMetrics) RecordGameTypeSwitch(uint32, uint32)         {}
+func (*noopMetrics) RecordDryRunProposal(eth.L2BlockRef, bool)   {}
+func (*noopMetrics) RecordOutputVerification(bool)               {}
+func (*noopMetrics) RecordGameResolution(bool, *big.Int)         {}
+func (*noopMetrics) RecordPendingGames(int)                      {}
+
+func (*noopMetrics) StartAccountMonitor(log.Logger, opmetrics.AccountClient, common.Address) io.Closer {
 	return nil
 }
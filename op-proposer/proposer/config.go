@@ -2,11 +2,16 @@ package proposer
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -47,6 +52,8 @@ type CLIConfig struct {
 
 	PprofConfig oppprof.CLIConfig
 
+	ClockConfig clock.CLIConfig
+
 	// DGFAddress is the DisputeGameFactory contract address.
 	DGFAddress string
 
@@ -56,11 +63,37 @@ type CLIConfig struct {
 	// DisputeGameType is the type of dispute game to create when submitting an output proposal.
 	DisputeGameType uint32
 
+	// FallbackGameTypes are the dispute game types to fall back to, in order of preference,
+	// if creating a game of the DisputeGameType would revert.
+	FallbackGameTypes []uint32
+
+	// GameExtraDataParams are the static game parameters appended to the extra data of created games,
+	// per game type, formatted as <game-type>=<hex params>.
+	GameExtraDataParams []string
+
 	// ActiveSequencerCheckDuration is the duration between checks to determine the active sequencer endpoint.
 	ActiveSequencerCheckDuration time.Duration
 
 	// Whether to wait for the sequencer to sync to a recent block at startup.
 	WaitNodeSync bool
+
+	// SkipRedundantProposals skips proposals already covered by a valid game of another proposer.
+	SkipRedundantProposals bool
+
+	// CatchUpWindow is how far back to look for the last proposal when detecting missed proposals.
+	CatchUpWindow time.Duration
+
+	// CatchUpMaxProposals limits the number of proposals made to catch up on missed proposals.
+	CatchUpMaxProposals uint64
+
+	// GameResolutionWindow is how far back to look for games created by the proposer, to watch until they resolve.
+	GameResolutionWindow time.Duration
+
+	// DryRun logs the proposals that would be made instead of sending transactions.
+	DryRun bool
+
+	// VerificationRpc is the HTTP provider URL for a second rollup node, to verify output roots against before proposing.
+	VerificationRpc string
 }
 
 func (c *CLIConfig) Check() error {
@@ -73,6 +106,9 @@ func (c *CLIConfig) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.ClockConfig.Check(); err != nil {
+		return err
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
@@ -89,6 +125,34 @@ func (c *CLIConfig) Check() error {
 	if c.ProposalInterval != 0 && c.DGFAddress == "" {
 		return errors.New("the `ProposalInterval` was provided but the `DisputeGameFactory` address was not set")
 	}
+	if c.SkipRedundantProposals && c.DGFAddress == "" {
+		return errors.New("skipping redundant proposals requires the `DisputeGameFactory` address to be set")
+	}
+	if c.CatchUpWindow != 0 && c.DGFAddress == "" {
+		return errors.New("catch-up proposals require the `DisputeGameFactory` address to be set")
+	}
+	if c.GameResolutionWindow != 0 && c.DGFAddress == "" {
+		return errors.New("watching game resolutions requires the `DisputeGameFactory` address to be set")
+	}
+	if len(c.FallbackGameTypes) > 0 && c.DGFAddress == "" {
+		return errors.New("fallback game types require the `DisputeGameFactory` address to be set")
+	}
+	seen := map[uint32]bool{c.DisputeGameType: true}
+	for _, gameType := range c.FallbackGameTypes {
+		if seen[gameType] {
+			return fmt.Errorf("game type %d is configured more than once", gameType)
+		}
+		seen[gameType] = true
+	}
+	if len(c.GameExtraDataParams) > 0 && c.DGFAddress == "" {
+		return errors.New("game extra data params require the `DisputeGameFactory` address to be set")
+	}
+	if _, err := ParseGameExtraDataParams(c.GameExtraDataParams); err != nil {
+		return err
+	}
+	if c.CatchUpWindow != 0 && c.CatchUpWindow < c.ProposalInterval {
+		return errors.New("the catch-up window must be at least the `ProposalInterval`")
+	}
 
 	return nil
 }
@@ -108,10 +172,51 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		LogConfig:                    oplog.ReadCLIConfig(ctx),
 		MetricsConfig:                opmetrics.ReadCLIConfig(ctx),
 		PprofConfig:                  oppprof.ReadCLIConfig(ctx),
+		ClockConfig:                  clock.ReadCLIConfig(ctx),
 		DGFAddress:                   ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
 		ProposalInterval:             ctx.Duration(flags.ProposalIntervalFlag.Name),
 		DisputeGameType:              uint32(ctx.Uint(flags.DisputeGameTypeFlag.Name)),
+		FallbackGameTypes:            toGameTypes(ctx.UintSlice(flags.FallbackGameTypesFlag.Name)),
+		GameExtraDataParams:          ctx.StringSlice(flags.GameExtraDataParamsFlag.Name),
 		ActiveSequencerCheckDuration: ctx.Duration(flags.ActiveSequencerCheckDurationFlag.Name),
 		WaitNodeSync:                 ctx.Bool(flags.WaitNodeSyncFlag.Name),
+		SkipRedundantProposals:       ctx.Bool(flags.SkipRedundantProposalsFlag.Name),
+		CatchUpWindow:                ctx.Duration(flags.CatchUpWindowFlag.Name),
+		CatchUpMaxProposals:          ctx.Uint64(flags.CatchUpMaxProposalsFlag.Name),
+		GameResolutionWindow:         ctx.Duration(flags.GameResolutionWindowFlag.Name),
+		DryRun:                       ctx.Bool(flags.DryRunFlag.Name),
+		VerificationRpc:              ctx.String(flags.VerificationRpcFlag.Name),
+	}
+}
+
+// ParseGameExtraDataParams parses the static game parameters of game types, formatted as <game-type>=<hex params>.
+func ParseGameExtraDataParams(values []string) (map[uint32][]byte, error) {
+	params := make(map[uint32][]byte)
+	for _, value := range values {
+		gameTypeStr, paramsStr, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid game extra data params %q, expected <game-type>=<hex params>", value)
+		}
+		gameType, err := strconv.ParseUint(gameTypeStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid game type of game extra data params %q: %w", value, err)
+		}
+		if _, ok := params[uint32(gameType)]; ok {
+			return nil, fmt.Errorf("game extra data params of game type %d are configured more than once", gameType)
+		}
+		data, err := hexutil.Decode(paramsStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid params of game extra data params %q: %w", value, err)
+		}
+		params[uint32(gameType)] = data
+	}
+	return params, nil
+}
+
+func toGameTypes(values []uint) []uint32 {
+	var gameTypes []uint32
+	for _, v := range values {
+		gameTypes = append(gameTypes, uint32(v))
 	}
+	return gameTypes
 }
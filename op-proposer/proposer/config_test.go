@@ -0,0 +1,22 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGameExtraDataParams(t *testing.T) {
+	params, err := ParseGameExtraDataParams([]string{"1=0x0102", "254=0x"})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32][]byte{1: {0x01, 0x02}, 254: {}}, params)
+
+	params, err = ParseGameExtraDataParams(nil)
+	require.NoError(t, err)
+	require.Empty(t, params)
+
+	for _, invalid := range [][]string{{"0x0102"}, {"a=0x01"}, {"1=0102"}, {"1=0x01", "1=0x02"}} {
+		_, err := ParseGameExtraDataParams(invalid)
+		require.Error(t, err, invalid)
+	}
+}
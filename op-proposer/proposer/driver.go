@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/bindings"
 	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
@@ -21,11 +23,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 var (
 	supportedL2OutputVersion = eth.Bytes32{}
 	ErrProposerNotRunning    = errors.New("proposer is not running")
+	ErrOutputMismatch        = errors.New("output root mismatches verification node")
 )
 
 type L1Client interface {
@@ -42,12 +46,18 @@ type L1Client interface {
 type L2OOContract interface {
 	Version(*bind.CallOpts) (string, error)
 	NextBlockNumber(*bind.CallOpts) (*big.Int, error)
+	SubmissionInterval(*bind.CallOpts) (*big.Int, error)
 }
 
 type DGFContract interface {
 	Version(ctx context.Context) (string, error)
 	HasProposedSince(ctx context.Context, proposer common.Address, cutoff time.Time, gameType uint32) (bool, time.Time, error)
 	ProposalTx(ctx context.Context, gameType uint32, outputRoot common.Hash, l2BlockNum uint64) (txmgr.TxCandidate, error)
+	ProposalsSince(ctx context.Context, cutoff time.Time, gameType uint32) ([]contracts.GameProposal, error)
+	HasImplementation(ctx context.Context, gameType uint32) (bool, error)
+	LatestProposal(ctx context.Context, proposer common.Address, cutoff time.Time, gameType uint32) (*contracts.GameProposal, error)
+	ProposerGames(ctx context.Context, proposer common.Address, fromIndex uint64, cutoff time.Time) ([]contracts.ProposerGame, uint64, error)
+	GameStatus(ctx context.Context, game common.Address) (contracts.GameStatus, error)
 }
 
 type RollupClient interface {
@@ -65,6 +75,18 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// ExtraDataEncoders customises the extra data supplied when creating dispute games.
+	// Optional, defaults to encoding only the L2 block number.
+	ExtraDataEncoders *contracts.ExtraDataEncoders
+
+	// VerificationClient is a second, independent rollup node that output roots are verified against
+	// before they are proposed. Optional, output roots are not verified if nil.
+	VerificationClient RollupClient
+
+	// Clock measures the proposal interval, catch-up and game resolution windows.
+	// Optional, defaults to the system clock.
+	Clock clock.Clock
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -84,6 +106,24 @@ type L2OutputSubmitter struct {
 	l2ooABI      *abi.ABI
 
 	dgfContract DGFContract
+
+	// catchUp holds the L2 block numbers of the scheduled catch-up proposals, in the order they are proposed.
+	// Only accessed by the driver loop.
+	catchUp []uint64
+
+	// gameTypeIdx is the index of the game type that games are created of, in the configured game types.
+	// Only accessed by the driver loop.
+	gameTypeIdx int
+
+	// lastDryRun is the output of the last proposal that would have been made in dry-run mode,
+	// and lastDryRunTime when it would have been made. Only accessed by the driver loop.
+	lastDryRun     *eth.OutputResponse
+	lastDryRunTime time.Time
+
+	// pendingGames are the unresolved games created by the proposer, and nextGameIndex the index of the next
+	// game of the factory to check for games of the proposer. Only accessed by the resolution loop.
+	pendingGames  map[common.Address]contracts.ProposerGame
+	nextGameIndex uint64
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -141,7 +181,7 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 }
 
 func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup DriverSetup) (*L2OutputSubmitter, error) {
-	dgfCaller := contracts.NewDisputeGameFactory(*setup.Cfg.DisputeGameFactoryAddr, setup.Multicaller, setup.Cfg.NetworkTimeout)
+	dgfCaller := contracts.NewDisputeGameFactory(*setup.Cfg.DisputeGameFactoryAddr, setup.Multicaller, setup.Cfg.NetworkTimeout, setup.ExtraDataEncoders)
 
 	version, err := dgfCaller.Version(ctx)
 	if err != nil {
@@ -160,6 +200,14 @@ func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup Drive
 	}, nil
 }
 
+// now returns the current time of the clock, or of the system clock if none is set.
+func (l *L2OutputSubmitter) now() time.Time {
+	if l.Clock == nil {
+		return time.Now()
+	}
+	return l.Clock.Now()
+}
+
 func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	l.Log.Info("Starting Proposer")
 
@@ -170,6 +218,8 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 		return errors.New("proposer is already running")
 	}
 	l.running = true
+	// Proposals may have been missed while stopped, detect the gap again
+	l.catchUp = nil
 
 	if l.Cfg.WaitNodeSync {
 		err := l.waitNodeSync()
@@ -181,6 +231,11 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	l.wg.Add(1)
 	go l.loop()
 
+	if l.dgfContract != nil && l.Cfg.GameResolutionWindow != 0 {
+		l.wg.Add(1)
+		go l.resolutionLoop()
+	}
+
 	l.Log.Info("Proposer started")
 	return nil
 }
@@ -233,6 +288,16 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 		return nil, false, fmt.Errorf("querying next block number: %w", err)
 	}
 	nextCheckpointBlock := nextCheckpointBlockBig.Uint64()
+	if l.lastDryRun != nil && l.lastDryRun.BlockRef.Number >= nextCheckpointBlock {
+		// Dry-run proposals are not made on chain, so the next block number of the L2OO does not advance.
+		// Schedule the next proposal one submission interval after the last dry-run proposal instead.
+		interval, err := l.l2ooContract.SubmissionInterval(callOpts)
+		if err != nil {
+			return nil, false, fmt.Errorf("querying submission interval: %w", err)
+		}
+		nextCheckpointBlock = l.lastDryRun.BlockRef.Number + interval.Uint64()
+		l.Log.Debug("Dry run already made proposal for on-chain next block number", "nextBlockNumber", nextCheckpointBlock)
+	}
 	// Fetch the current L2 heads
 	currentBlockNumber, err := l.FetchCurrentBlockNumber(ctx)
 	if err != nil {
@@ -268,14 +333,21 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 // The passed context is expected to be a lifecycle context. A network timeout
 // context will be derived from it.
 func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResponse, bool, error) {
-	cutoff := time.Now().Add(-l.Cfg.ProposalInterval)
-	proposedRecently, proposalTime, err := l.dgfContract.HasProposedSince(ctx, l.Txmgr.From(), cutoff, l.Cfg.DisputeGameType)
+	if len(l.catchUp) > 0 {
+		return l.nextCatchUpOutput(ctx)
+	}
+	cutoff := l.now().Add(-l.Cfg.ProposalInterval)
+	proposedRecently, proposalTime, err := l.dgfContract.HasProposedSince(ctx, l.Txmgr.From(), cutoff, l.gameType())
 	if err != nil {
 		return nil, false, fmt.Errorf("could not check for recent proposal: %w", err)
 	}
+	if !proposedRecently && l.lastDryRunTime.After(cutoff) {
+		// No games are created in dry-run mode, so follow the schedule of the proposals that would have been made
+		proposedRecently, proposalTime = true, l.lastDryRunTime
+	}
 
 	if proposedRecently {
-		l.Log.Debug("Duration since last game not past proposal interval", "duration", time.Since(proposalTime))
+		l.Log.Debug("Duration since last game not past proposal interval", "duration", l.now().Sub(proposalTime))
 		return nil, false, nil
 	}
 	l.Log.Info("No proposals found for at least proposal interval, submitting proposal now", "proposalInterval", l.Cfg.ProposalInterval)
@@ -291,6 +363,27 @@ func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResp
 		return nil, false, nil
 	}
 
+	if l.Cfg.CatchUpWindow != 0 {
+		if err := l.scheduleCatchUp(ctx, currentBlockNumber); err != nil {
+			l.Log.Warn("Failed to check for missed proposals", "err", err)
+		} else if len(l.catchUp) > 0 {
+			return l.nextCatchUpOutput(ctx)
+		}
+	}
+
+	covering, err := l.findCoveringGame(ctx, cutoff, currentBlockNumber)
+	if err != nil {
+		if l.Cfg.SkipRedundantProposals {
+			return nil, false, fmt.Errorf("could not check games of other proposers: %w", err)
+		}
+		l.Log.Warn("Failed to check games of other proposers", "err", err)
+	} else if covering != nil && l.Cfg.SkipRedundantProposals {
+		l.Log.Info("Skipping proposal, already covered by a valid game of another proposer",
+			"game", covering.Address, "proposer", covering.Proposer, "l2_block", covering.L2BlockNum)
+		l.Metr.RecordRedundantProposalSkipped()
+		return nil, false, nil
+	}
+
 	output, err := l.FetchOutput(ctx, currentBlockNumber)
 	if err != nil {
 		return nil, false, fmt.Errorf("could not fetch output at current block number %d: %w", currentBlockNumber, err)
@@ -299,6 +392,119 @@ func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResp
 	return output, true, nil
 }
 
+// scheduleCatchUp detects proposals missed since the last proposal of this proposer, e.g. because of downtime,
+// and schedules catch-up proposals at the proposal interval, up to and including the current block.
+// If more proposals were missed than the configured maximum, the proposals are spread evenly over the gap.
+func (l *L2OutputSubmitter) scheduleCatchUp(ctx context.Context, currentBlockNumber uint64) error {
+	cutoff := l.now().Add(-l.Cfg.CatchUpWindow)
+	last, err := l.dgfContract.LatestProposal(ctx, l.Txmgr.From(), cutoff, l.gameType())
+	if err != nil {
+		return fmt.Errorf("could not find last proposal: %w", err)
+	}
+	if l.lastDryRun != nil && (last == nil || l.lastDryRun.BlockRef.Number > last.L2BlockNum) {
+		last = &contracts.GameProposal{
+			Proposal: contracts.Proposal{
+				GameType:   l.gameType(),
+				OutputRoot: common.Hash(l.lastDryRun.OutputRoot),
+				L2BlockNum: l.lastDryRun.BlockRef.Number,
+			},
+			Timestamp: l.lastDryRunTime,
+		}
+	}
+	if last == nil {
+		l.Log.Info("No previous proposal within catch-up window", "window", l.Cfg.CatchUpWindow)
+		return nil
+	}
+	if last.L2BlockNum >= currentBlockNumber {
+		return nil
+	}
+	lastOutput, err := l.FetchOutput(ctx, last.L2BlockNum)
+	if err != nil {
+		return fmt.Errorf("could not fetch output of last proposal: %w", err)
+	}
+	currentOutput, err := l.FetchOutput(ctx, currentBlockNumber)
+	if err != nil {
+		return fmt.Errorf("could not fetch output at current block number %d: %w", currentBlockNumber, err)
+	}
+	blocks := currentBlockNumber - last.L2BlockNum
+	elapsed := currentOutput.BlockRef.Time - lastOutput.BlockRef.Time
+	if elapsed == 0 {
+		return nil
+	}
+	interval := max(uint64(l.Cfg.ProposalInterval/time.Second), 1)
+	missed := elapsed / interval
+	if missed <= 1 {
+		// Only the regular proposal at the current block is due
+		return nil
+	}
+	proposals := missed
+	if limit := l.Cfg.CatchUpMaxProposals; limit != 0 && proposals > limit {
+		proposals = limit
+	}
+	proposals = min(proposals, blocks)
+	schedule := make([]uint64, 0, proposals)
+	for i := uint64(1); i <= proposals; i++ {
+		schedule = append(schedule, last.L2BlockNum+blocks*i/proposals)
+	}
+	l.Log.Warn("Detected missed proposals, scheduling catch-up proposals",
+		"last_proposal", last.Address, "last_l2_block", last.L2BlockNum, "current_l2_block", currentBlockNumber,
+		"missed", missed, "proposals", proposals)
+	l.catchUp = schedule
+	return nil
+}
+
+// nextCatchUpOutput removes the next scheduled catch-up proposal from the schedule, and returns its output.
+// A catch-up proposal that fails to be sent is not retried, the remaining proposals still cover the gap.
+func (l *L2OutputSubmitter) nextCatchUpOutput(ctx context.Context) (*eth.OutputResponse, bool, error) {
+	blockNum := l.catchUp[0]
+	output, err := l.FetchOutput(ctx, blockNum)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not fetch output of catch-up proposal at block %d: %w", blockNum, err)
+	}
+	l.catchUp = l.catchUp[1:]
+	l.Log.Info("Submitting catch-up proposal", "l2_block", blockNum, "remaining", len(l.catchUp))
+	return output, true, nil
+}
+
+// findCoveringGame finds the most recent game created by another proposer since the cutoff, with an output root
+// that matches the output of the rollup node, for an L2 block up to the given block number.
+// Games of other proposers are verified against the rollup node and recorded in the metrics.
+// Returns nil if there is no such game.
+func (l *L2OutputSubmitter) findCoveringGame(ctx context.Context, cutoff time.Time, maxBlockNumber uint64) (*contracts.GameProposal, error) {
+	proposals, err := l.dgfContract.ProposalsSince(ctx, cutoff, l.gameType())
+	if err != nil {
+		return nil, err
+	}
+	var covering *contracts.GameProposal
+	var valid, invalid int
+	for i, proposal := range proposals {
+		if proposal.Proposer == l.Txmgr.From() {
+			continue
+		}
+		if proposal.L2BlockNum > maxBlockNumber {
+			// Cannot verify the output root yet
+			continue
+		}
+		output, err := l.FetchOutput(ctx, proposal.L2BlockNum)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch output of game %v: %w", proposal.Address, err)
+		}
+		if common.Hash(output.OutputRoot) != proposal.OutputRoot {
+			l.Log.Warn("Game of other proposer has an invalid output root", "game", proposal.Address,
+				"proposer", proposal.Proposer, "l2_block", proposal.L2BlockNum,
+				"output_root", proposal.OutputRoot, "expected", output.OutputRoot)
+			invalid++
+			continue
+		}
+		valid++
+		if covering == nil {
+			covering = &proposals[i]
+		}
+	}
+	l.Metr.RecordOtherProposerGames(valid, invalid)
+	return covering, nil
+}
+
 // FetchCurrentBlockNumber gets the current block number from the [L2OutputSubmitter]'s [RollupClient]. If the `AllowNonFinalized` configuration
 // option is set, it will return the safe head block number, and if not, it will return the finalized head block number.
 func (l *L2OutputSubmitter) FetchCurrentBlockNumber(ctx context.Context) (uint64, error) {
@@ -353,10 +559,60 @@ func proposeL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, er
 		new(big.Int).SetUint64(output.Status.CurrentL1.Number))
 }
 
+// gameTypes returns the configured dispute game types, in order of preference.
+func (l *L2OutputSubmitter) gameTypes() []uint32 {
+	return append([]uint32{l.Cfg.DisputeGameType}, l.Cfg.FallbackGameTypes...)
+}
+
+// gameType returns the dispute game type that games are created of.
+func (l *L2OutputSubmitter) gameType() uint32 {
+	return l.gameTypes()[l.gameTypeIdx]
+}
+
+// selectGameType switches to the first configured game type, in order of preference, that the factory has an
+// implementation for. Only game types without an implementation are skipped, other failures to create a game
+// are not specific to the game type. Preferred game types are checked again for every proposal, to switch back
+// once games of the type can be created again.
+func (l *L2OutputSubmitter) selectGameType(ctx context.Context) error {
+	if len(l.Cfg.FallbackGameTypes) == 0 {
+		return nil
+	}
+	gameTypes := l.gameTypes()
+	for i, gameType := range gameTypes {
+		cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+		hasImpl, err := l.dgfContract.HasImplementation(cCtx, gameType)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to check implementation of game type %d: %w", gameType, err)
+		}
+		if !hasImpl {
+			l.Log.Warn("Dispute game factory has no implementation for game type", "game_type", gameType)
+			continue
+		}
+		if i != l.gameTypeIdx {
+			prev := gameTypes[l.gameTypeIdx]
+			l.Log.Warn("Switching dispute game type", "from", prev, "to", gameType)
+			l.Metr.RecordGameTypeSwitch(prev, gameType)
+			l.gameTypeIdx = i
+		}
+		return nil
+	}
+	return errors.New("dispute game factory has no implementation for any configured game type")
+}
+
+// isRevert returns true if the error of a call indicates that the call reverted.
+func isRevert(err error) bool {
+	if err == nil {
+		return false
+	}
+	var dataErr rpc.DataError
+	return errors.As(err, &dataErr) || strings.Contains(err.Error(), "execution reverted")
+}
+
 func (l *L2OutputSubmitter) ProposeL2OutputDGFTxCandidate(ctx context.Context, output *eth.OutputResponse) (txmgr.TxCandidate, error) {
 	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
 	defer cancel()
-	return l.dgfContract.ProposalTx(cCtx, l.Cfg.DisputeGameType, common.Hash(output.OutputRoot), output.BlockRef.Number)
+	return l.dgfContract.ProposalTx(cCtx, l.gameType(), common.Hash(output.OutputRoot), output.BlockRef.Number)
 }
 
 // We wait until l1head advances beyond blocknum. This is used to make sure proposal tx won't
@@ -395,13 +651,12 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	}
 
 	l.Log.Info("Proposing output root", "output", output.OutputRoot, "block", output.BlockRef)
-	var receipt *types.Receipt
+	var candidate txmgr.TxCandidate
 	if l.Cfg.DisputeGameFactoryAddr != nil {
-		candidate, err := l.ProposeL2OutputDGFTxCandidate(ctx, output)
-		if err != nil {
+		if err := l.selectGameType(ctx); err != nil {
 			return err
 		}
-		receipt, err = l.Txmgr.Send(ctx, candidate)
+		candidate, err = l.ProposeL2OutputDGFTxCandidate(ctx, output)
 		if err != nil {
 			return err
 		}
@@ -410,15 +665,19 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 		if err != nil {
 			return err
 		}
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
+		candidate = txmgr.TxCandidate{
 			TxData:   data,
 			To:       l.Cfg.L2OutputOracleAddr,
 			GasLimit: 0,
-		})
-		if err != nil {
-			return err
 		}
 	}
+	if l.Cfg.DryRun {
+		return l.dryRunProposal(ctx, output, candidate)
+	}
+	receipt, err := l.Txmgr.Send(ctx, candidate)
+	if err != nil {
+		return err
+	}
 
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.Log.Error("Proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
@@ -431,6 +690,38 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	return nil
 }
 
+// dryRunProposal simulates the proposal transaction instead of sending it, and logs the proposal that would be made.
+// A proposal that would revert is still treated as made, so it is reported once per scheduled proposal
+// rather than on every poll.
+func (l *L2OutputSubmitter) dryRunProposal(ctx context.Context, output *eth.OutputResponse, candidate txmgr.TxCandidate) error {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	_, err := l.L1Client.CallContract(cCtx, ethereum.CallMsg{
+		From:  l.Txmgr.From(),
+		To:    candidate.To,
+		Value: candidate.Value,
+		Data:  candidate.TxData,
+	}, nil)
+	reverted := isRevert(err)
+	if err != nil && !reverted {
+		return fmt.Errorf("failed to simulate proposal: %w", err)
+	}
+	l.lastDryRun = output
+	l.lastDryRunTime = l.now()
+	l.Metr.RecordDryRunProposal(output.BlockRef, reverted)
+	if reverted {
+		l.Log.Error("Dry run: proposal would revert", "output", output.OutputRoot, "block", output.BlockRef, "err", err)
+		return nil
+	}
+	l.Log.Info("Dry run: would propose output root",
+		"output", output.OutputRoot,
+		"block", output.BlockRef,
+		"to", candidate.To,
+		"l1blocknum", output.Status.CurrentL1.Number,
+		"l1blockhash", output.Status.CurrentL1.Hash)
+	return nil
+}
+
 // loop is responsible for creating & submitting the next outputs
 // The loop regularly polls the L2 chain to infer whether to make the next proposal.
 func (l *L2OutputSubmitter) loop() {
@@ -468,6 +759,15 @@ func (l *L2OutputSubmitter) loop() {
 				continue
 			}
 
+			if err := l.verifyOutput(ctx, output); errors.Is(err, ErrOutputMismatch) {
+				l.Log.Error("Halting proposer, output root mismatches verification node. "+
+					"Restart the proposer once the mismatch is resolved.", "err", err)
+				return
+			} else if err != nil {
+				l.Log.Warn("Error verifying output", "err", err)
+				continue
+			}
+
 			l.proposeOutput(ctx, output)
 		case <-l.done:
 			return
@@ -476,6 +776,27 @@ func (l *L2OutputSubmitter) loop() {
 
 }
 
+// verifyOutput checks that the verification node, if configured, has the same output root as the output
+// to propose. An ErrOutputMismatch is returned if it does not.
+func (l *L2OutputSubmitter) verifyOutput(ctx context.Context, output *eth.OutputResponse) error {
+	if l.VerificationClient == nil {
+		return nil
+	}
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	expected, err := l.VerificationClient.OutputAtBlock(cCtx, output.BlockRef.Number)
+	if err != nil {
+		return fmt.Errorf("fetching output from verification node at block %d: %w", output.BlockRef.Number, err)
+	}
+	agree := expected.OutputRoot == output.OutputRoot
+	l.Metr.RecordOutputVerification(agree)
+	if !agree {
+		return fmt.Errorf("%w: block %d, output root %v, verification node output root %v",
+			ErrOutputMismatch, output.BlockRef.Number, output.OutputRoot, expected.OutputRoot)
+	}
+	return nil
+}
+
 func (l *L2OutputSubmitter) waitNodeSync() error {
 	cCtx, cancel := context.WithTimeout(l.ctx, l.Cfg.NetworkTimeout)
 	defer cancel()
@@ -505,5 +826,7 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 			"l1head", output.Status.HeadL1.Number)
 		return
 	}
-	l.Metr.RecordL2BlocksProposed(output.BlockRef)
+	if !l.Cfg.DryRun {
+		l.Metr.RecordL2BlocksProposed(output.BlockRef)
+	}
 }
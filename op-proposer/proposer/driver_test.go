@@ -2,13 +2,16 @@ package proposer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/bindings"
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -16,6 +19,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	txmgrmocks "github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -38,8 +42,20 @@ func (m *MockL2OOContract) NextBlockNumber(opts *bind.CallOpts) (*big.Int, error
 	return args.Get(0).(*big.Int), args.Error(1)
 }
 
+func (m *MockL2OOContract) SubmissionInterval(opts *bind.CallOpts) (*big.Int, error) {
+	args := m.Called(opts)
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
 type StubDGFContract struct {
 	hasProposedCount int
+	proposals        []contracts.GameProposal
+	latest           *contracts.GameProposal
+	// games are the games of the proposer, by game index
+	games    []contracts.ProposerGame
+	statuses map[common.Address]contracts.GameStatus
+	// noImpls are the game types the factory has no implementation for
+	noImpls map[uint32]bool
 }
 
 func (m *StubDGFContract) HasProposedSince(_ context.Context, _ common.Address, _ time.Time, _ uint32) (bool, time.Time, error) {
@@ -47,14 +63,47 @@ func (m *StubDGFContract) HasProposedSince(_ context.Context, _ common.Address,
 	return false, time.Unix(1000, 0), nil
 }
 
-func (m *StubDGFContract) ProposalTx(_ context.Context, _ uint32, _ common.Hash, _ uint64) (txmgr.TxCandidate, error) {
-	panic("not implemented")
+func (m *StubDGFContract) ProposalTx(_ context.Context, gameType uint32, _ common.Hash, _ uint64) (txmgr.TxCandidate, error) {
+	return txmgr.TxCandidate{TxData: []byte{byte(gameType)}}, nil
 }
 
 func (m *StubDGFContract) Version(_ context.Context) (string, error) {
 	panic("not implemented")
 }
 
+func (m *StubDGFContract) ProposalsSince(_ context.Context, _ time.Time, _ uint32) ([]contracts.GameProposal, error) {
+	return m.proposals, nil
+}
+
+func (m *StubDGFContract) LatestProposal(_ context.Context, _ common.Address, _ time.Time, _ uint32) (*contracts.GameProposal, error) {
+	return m.latest, nil
+}
+
+func (m *StubDGFContract) ProposerGames(_ context.Context, _ common.Address, fromIndex uint64, _ time.Time) ([]contracts.ProposerGame, uint64, error) {
+	return m.games[fromIndex:], uint64(len(m.games)), nil
+}
+
+func (m *StubDGFContract) HasImplementation(_ context.Context, gameType uint32) (bool, error) {
+	return !m.noImpls[gameType], nil
+}
+
+func (m *StubDGFContract) GameStatus(_ context.Context, game common.Address) (contracts.GameStatus, error) {
+	return m.statuses[game], nil
+}
+
+// stubL1Client executes calls of game creations, which revert for the game types in reverts.
+type stubL1Client struct {
+	L1Client
+	reverts map[uint32]bool
+}
+
+func (c *stubL1Client) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if c.reverts[uint32(msg.Data[0])] {
+		return nil, errors.New("execution reverted")
+	}
+	return nil, nil
+}
+
 type mockRollupEndpointProvider struct {
 	rollupClient    *testutils.MockRollupClient
 	rollupClientErr error
@@ -179,3 +228,506 @@ func TestL2OutputSubmitter_OutputRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestL2OutputSubmitter_FindCoveringGame(t *testing.T) {
+	proposerAddr := common.Address{0xab}
+	otherAddr := common.Address{0xcd}
+	validRoot := eth.Bytes32{0x01}
+	proposal := func(proposer common.Address, l2BlockNum uint64, root eth.Bytes32) contracts.GameProposal {
+		return contracts.GameProposal{
+			Proposal: contracts.Proposal{OutputRoot: common.Hash(root), L2BlockNum: l2BlockNum},
+			Address:  common.Address{byte(l2BlockNum)},
+			Proposer: proposer,
+		}
+	}
+	expectOutput := func(ep *mockRollupEndpointProvider, l2BlockNum uint64) {
+		ep.rollupClient.ExpectOutputAtBlock(l2BlockNum, &eth.OutputResponse{
+			Version:    supportedL2OutputVersion,
+			OutputRoot: validRoot,
+			BlockRef:   eth.L2BlockRef{Number: l2BlockNum},
+		}, nil)
+	}
+	newSubmitter := func(proposals ...contracts.GameProposal) (*L2OutputSubmitter, *mockRollupEndpointProvider) {
+		ep := newEndpointProvider()
+		txmgr := txmgrmocks.NewTxManager(t)
+		txmgr.On("From").Return(proposerAddr).Maybe()
+		return &L2OutputSubmitter{
+			DriverSetup: DriverSetup{
+				Log:            testlog.Logger(t, log.LevelDebug),
+				Metr:           metrics.NoopMetrics,
+				Txmgr:          txmgr,
+				RollupProvider: ep,
+			},
+			dgfContract: &StubDGFContract{proposals: proposals},
+		}, ep
+	}
+
+	t.Run("NoGames", func(t *testing.T) {
+		ps, _ := newSubmitter()
+		covering, err := ps.findCoveringGame(context.Background(), time.Unix(1000, 0), 100)
+		require.NoError(t, err)
+		require.Nil(t, covering)
+	})
+
+	t.Run("OwnAndUnverifiableGamesIgnored", func(t *testing.T) {
+		ps, _ := newSubmitter(
+			proposal(otherAddr, 101, validRoot), // beyond the current block
+			proposal(proposerAddr, 90, validRoot),
+		)
+		covering, err := ps.findCoveringGame(context.Background(), time.Unix(1000, 0), 100)
+		require.NoError(t, err)
+		require.Nil(t, covering)
+	})
+
+	t.Run("InvalidGame", func(t *testing.T) {
+		ps, ep := newSubmitter(proposal(otherAddr, 90, eth.Bytes32{0xba}))
+		expectOutput(ep, 90)
+		covering, err := ps.findCoveringGame(context.Background(), time.Unix(1000, 0), 100)
+		require.NoError(t, err)
+		require.Nil(t, covering)
+	})
+
+	t.Run("ValidGame", func(t *testing.T) {
+		ps, ep := newSubmitter(
+			proposal(otherAddr, 95, eth.Bytes32{0xba}),
+			proposal(otherAddr, 90, validRoot),
+			proposal(otherAddr, 80, validRoot),
+		)
+		expectOutput(ep, 95)
+		expectOutput(ep, 90)
+		expectOutput(ep, 80)
+		covering, err := ps.findCoveringGame(context.Background(), time.Unix(1000, 0), 100)
+		require.NoError(t, err)
+		require.NotNil(t, covering)
+		require.Equal(t, uint64(90), covering.L2BlockNum)
+	})
+}
+
+func TestL2OutputSubmitter_CatchUp(t *testing.T) {
+	proposerAddr := common.Address{0xab}
+	// 2s block time, the last proposal at block 100 is 1000s before the finalized head
+	const lastBlock, currentBlock = uint64(100), uint64(600)
+	blockTime := func(num uint64) uint64 { return 1000 + num*2 }
+	expectOutput := func(ep *mockRollupEndpointProvider, num uint64) {
+		ep.rollupClient.ExpectOutputAtBlock(num, &eth.OutputResponse{
+			Version:  supportedL2OutputVersion,
+			BlockRef: eth.L2BlockRef{Number: num, Time: blockTime(num)},
+		}, nil)
+	}
+	newSubmitter := func(maxProposals uint64, latest *contracts.GameProposal) (*L2OutputSubmitter, *mockRollupEndpointProvider) {
+		ep := newEndpointProvider()
+		txmgr := txmgrmocks.NewTxManager(t)
+		txmgr.On("From").Return(proposerAddr).Maybe()
+		return &L2OutputSubmitter{
+			DriverSetup: DriverSetup{
+				Log:  testlog.Logger(t, log.LevelDebug),
+				Metr: metrics.NoopMetrics,
+				Cfg: ProposerConfig{
+					ProposalInterval:    100 * time.Second,
+					CatchUpWindow:       time.Hour,
+					CatchUpMaxProposals: maxProposals,
+				},
+				Txmgr:          txmgr,
+				RollupProvider: ep,
+			},
+			dgfContract: &StubDGFContract{latest: latest},
+		}, ep
+	}
+	lastProposal := &contracts.GameProposal{
+		Proposal: contracts.Proposal{L2BlockNum: lastBlock},
+		Proposer: proposerAddr,
+	}
+	fetchAll := func(t *testing.T, ps *L2OutputSubmitter, ep *mockRollupEndpointProvider, expected []uint64) {
+		ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: currentBlock}}, nil)
+		expectOutput(ep, lastBlock)
+		expectOutput(ep, currentBlock)
+		for _, num := range expected {
+			expectOutput(ep, num)
+			output, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+			require.NoError(t, err)
+			require.True(t, shouldPropose)
+			require.Equal(t, num, output.BlockRef.Number)
+		}
+		require.Empty(t, ps.catchUp)
+		ep.rollupClient.AssertExpectations(t)
+	}
+
+	t.Run("ProposalPerInterval", func(t *testing.T) {
+		ps, ep := newSubmitter(0, lastProposal)
+		fetchAll(t, ps, ep, []uint64{150, 200, 250, 300, 350, 400, 450, 500, 550, 600})
+	})
+
+	t.Run("Coalesced", func(t *testing.T) {
+		ps, ep := newSubmitter(4, lastProposal)
+		fetchAll(t, ps, ep, []uint64{225, 350, 475, 600})
+	})
+
+	t.Run("NoGap", func(t *testing.T) {
+		ps, ep := newSubmitter(0, &contracts.GameProposal{Proposal: contracts.Proposal{L2BlockNum: 560}, Proposer: proposerAddr})
+		ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: currentBlock}}, nil)
+		expectOutput(ep, 560)
+		expectOutput(ep, currentBlock)
+		expectOutput(ep, currentBlock)
+		output, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+		require.NoError(t, err)
+		require.True(t, shouldPropose)
+		require.Equal(t, currentBlock, output.BlockRef.Number)
+		require.Empty(t, ps.catchUp)
+	})
+
+	t.Run("NoPreviousProposal", func(t *testing.T) {
+		ps, ep := newSubmitter(0, nil)
+		ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: currentBlock}}, nil)
+		expectOutput(ep, currentBlock)
+		output, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+		require.NoError(t, err)
+		require.True(t, shouldPropose)
+		require.Equal(t, currentBlock, output.BlockRef.Number)
+	})
+}
+
+func TestL2OutputSubmitter_SelectGameType(t *testing.T) {
+	dgf := &StubDGFContract{noImpls: make(map[uint32]bool)}
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  testlog.Logger(t, log.LevelDebug),
+			Metr: metrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				NetworkTimeout:    time.Second,
+				DisputeGameType:   1,
+				FallbackGameTypes: []uint32{2, 3},
+			},
+		},
+		dgfContract: dgf,
+	}
+
+	require.NoError(t, ps.selectGameType(context.Background()))
+	require.Equal(t, uint32(1), ps.gameType())
+
+	// Fall back to the first game type the factory has an implementation for
+	dgf.noImpls[1] = true
+	dgf.noImpls[2] = true
+	require.NoError(t, ps.selectGameType(context.Background()))
+	require.Equal(t, uint32(3), ps.gameType())
+
+	// Switch back to the preferred game type once it can be created again
+	dgf.noImpls[1] = false
+	require.NoError(t, ps.selectGameType(context.Background()))
+	require.Equal(t, uint32(1), ps.gameType())
+
+	dgf.noImpls[1] = true
+	dgf.noImpls[3] = true
+	require.ErrorContains(t, ps.selectGameType(context.Background()), "any configured game type")
+	require.Equal(t, uint32(1), ps.gameType(), "game type must not change if no game type can be created")
+}
+
+type dryRunMetrics struct {
+	metrics.Metricer
+	proposed []uint64
+	reverted []bool
+}
+
+func (m *dryRunMetrics) RecordDryRunProposal(l2ref eth.L2BlockRef, reverted bool) {
+	m.proposed = append(m.proposed, l2ref.Number)
+	m.reverted = append(m.reverted, reverted)
+}
+
+func TestL2OutputSubmitter_DryRun(t *testing.T) {
+	dgfAddr := common.Address{0xdd}
+	l1Client := &stubL1Client{reverts: make(map[uint32]bool)}
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab}).Maybe()
+	txmgr.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Maybe()
+	m := &dryRunMetrics{Metricer: metrics.NoopMetrics}
+	lgr, logs := testlog.CaptureLogger(t, log.LevelDebug)
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  lgr,
+			Metr: m,
+			Cfg: ProposerConfig{
+				PollInterval:           time.Millisecond,
+				NetworkTimeout:         time.Second,
+				ProposalInterval:       time.Hour,
+				DisputeGameFactoryAddr: &dgfAddr,
+				DisputeGameType:        1,
+				DryRun:                 true,
+			},
+			Txmgr:    txmgr,
+			L1Client: l1Client,
+		},
+		dgfContract: &StubDGFContract{},
+	}
+	output := &eth.OutputResponse{
+		BlockRef: eth.L2BlockRef{Number: 42},
+		Status:   &eth.SyncStatus{HeadL1: eth.L1BlockRef{Number: 90}},
+	}
+
+	// The proposal is simulated instead of sent, the tx manager mock fails the test if Send is called
+	require.NoError(t, ps.sendTransaction(context.Background(), output))
+	require.Equal(t, []uint64{42}, m.proposed)
+	require.Equal(t, []bool{false}, m.reverted)
+	require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Dry run: would propose output root")))
+
+	// The next proposal is scheduled after the dry-run proposal
+	_, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+	require.NoError(t, err)
+	require.False(t, shouldPropose)
+
+	l1Client.reverts[1] = true
+	output.BlockRef.Number = 43
+	require.NoError(t, ps.sendTransaction(context.Background(), output))
+	require.Equal(t, []uint64{42, 43}, m.proposed)
+	require.Equal(t, []bool{false, true}, m.reverted)
+	require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Dry run: proposal would revert")))
+	require.Equal(t, output, ps.lastDryRun)
+}
+
+func TestL2OutputSubmitter_DilatedClock(t *testing.T) {
+	dgfAddr := common.Address{0xdd}
+	ep := newEndpointProvider()
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab}).Maybe()
+	txmgr.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Maybe()
+	base := clock.NewDeterministicClock(time.Unix(10000, 0))
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  testlog.Logger(t, log.LevelDebug),
+			Metr: metrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				PollInterval:           time.Millisecond,
+				NetworkTimeout:         time.Second,
+				ProposalInterval:       time.Hour,
+				DisputeGameFactoryAddr: &dgfAddr,
+				DisputeGameType:        1,
+				DryRun:                 true,
+			},
+			Txmgr:          txmgr,
+			L1Client:       &stubL1Client{reverts: make(map[uint32]bool)},
+			RollupProvider: ep,
+			// An hour of proposal interval passes in a minute
+			Clock: clock.NewDilatedClock(base, 60),
+		},
+		dgfContract: &StubDGFContract{},
+	}
+	require.NoError(t, ps.sendTransaction(context.Background(), &eth.OutputResponse{
+		BlockRef: eth.L2BlockRef{Number: 42},
+		Status:   &eth.SyncStatus{HeadL1: eth.L1BlockRef{Number: 90}},
+	}))
+
+	base.AdvanceTime(59 * time.Second)
+	_, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+	require.NoError(t, err)
+	require.False(t, shouldPropose)
+
+	base.AdvanceTime(2 * time.Second)
+	ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: 100}}, nil)
+	ep.rollupClient.ExpectOutputAtBlock(100, &eth.OutputResponse{
+		Version:  supportedL2OutputVersion,
+		BlockRef: eth.L2BlockRef{Number: 100},
+	}, nil)
+	output, shouldPropose, err := ps.FetchDGFOutput(context.Background())
+	require.NoError(t, err)
+	require.True(t, shouldPropose)
+	require.Equal(t, uint64(100), output.BlockRef.Number)
+}
+
+func TestL2OutputSubmitter_DryRunL2OOSchedule(t *testing.T) {
+	ep := newEndpointProvider()
+	l2ooContract := new(MockL2OOContract)
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab}).Maybe()
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:            testlog.Logger(t, log.LevelDebug),
+			Metr:           metrics.NoopMetrics,
+			Cfg:            ProposerConfig{NetworkTimeout: time.Second, DryRun: true},
+			Txmgr:          txmgr,
+			RollupProvider: ep,
+		},
+		l2ooContract: l2ooContract,
+	}
+	status := &eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: 25}}
+	outputAt := func(num uint64) *eth.OutputResponse {
+		return &eth.OutputResponse{Version: supportedL2OutputVersion, BlockRef: eth.L2BlockRef{Number: num}, Status: status}
+	}
+	// The on-chain next block number never advances, since dry-run proposals are not sent
+	l2ooContract.On("NextBlockNumber", mock.Anything).Return(big.NewInt(10), nil)
+	l2ooContract.On("SubmissionInterval", mock.Anything).Return(big.NewInt(10), nil)
+	ep.rollupClient.On("SyncStatus").Return(status, nil)
+	ep.rollupClient.On("OutputAtBlock", uint64(10)).Return(outputAt(10), nil)
+	ep.rollupClient.On("OutputAtBlock", uint64(20)).Return(outputAt(20), nil)
+
+	output, shouldPropose, err := ps.FetchL2OOOutput(context.Background())
+	require.NoError(t, err)
+	require.True(t, shouldPropose)
+	require.Equal(t, uint64(10), output.BlockRef.Number)
+	ps.lastDryRun = output
+
+	// The next dry-run proposal is one submission interval after the last one
+	output, shouldPropose, err = ps.FetchL2OOOutput(context.Background())
+	require.NoError(t, err)
+	require.True(t, shouldPropose)
+	require.Equal(t, uint64(20), output.BlockRef.Number)
+	ps.lastDryRun = output
+
+	// Block 30 is not finalized yet
+	_, shouldPropose, err = ps.FetchL2OOOutput(context.Background())
+	require.NoError(t, err)
+	require.False(t, shouldPropose)
+}
+
+type verificationMetrics struct {
+	metrics.Metricer
+	results []bool
+}
+
+func (m *verificationMetrics) RecordOutputVerification(agree bool) {
+	m.results = append(m.results, agree)
+}
+
+func TestL2OutputSubmitter_VerifyOutput(t *testing.T) {
+	output := &eth.OutputResponse{
+		OutputRoot: eth.Bytes32{0xaa},
+		BlockRef:   eth.L2BlockRef{Number: 42},
+	}
+	newSubmitter := func(client RollupClient) (*L2OutputSubmitter, *verificationMetrics) {
+		m := &verificationMetrics{Metricer: metrics.NoopMetrics}
+		return &L2OutputSubmitter{
+			DriverSetup: DriverSetup{
+				Log:                testlog.Logger(t, log.LevelDebug),
+				Metr:               m,
+				Cfg:                ProposerConfig{NetworkTimeout: time.Second},
+				VerificationClient: client,
+			},
+		}, m
+	}
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		ps, m := newSubmitter(nil)
+		require.NoError(t, ps.verifyOutput(context.Background(), output))
+		require.Empty(t, m.results)
+	})
+
+	t.Run("Agree", func(t *testing.T) {
+		client := new(testutils.MockRollupClient)
+		client.ExpectOutputAtBlock(42, &eth.OutputResponse{OutputRoot: eth.Bytes32{0xaa}}, nil)
+		ps, m := newSubmitter(client)
+		require.NoError(t, ps.verifyOutput(context.Background(), output))
+		require.Equal(t, []bool{true}, m.results)
+	})
+
+	t.Run("Disagree", func(t *testing.T) {
+		client := new(testutils.MockRollupClient)
+		client.ExpectOutputAtBlock(42, &eth.OutputResponse{OutputRoot: eth.Bytes32{0xbb}}, nil)
+		ps, m := newSubmitter(client)
+		require.ErrorIs(t, ps.verifyOutput(context.Background(), output), ErrOutputMismatch)
+		require.Equal(t, []bool{false}, m.results)
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		client := new(testutils.MockRollupClient)
+		client.ExpectOutputAtBlock(42, (*eth.OutputResponse)(nil), errors.New("not found"))
+		ps, m := newSubmitter(client)
+		err := ps.verifyOutput(context.Background(), output)
+		require.ErrorContains(t, err, "not found")
+		require.NotErrorIs(t, err, ErrOutputMismatch)
+		require.Empty(t, m.results)
+	})
+}
+
+func TestL2OutputSubmitter_HaltOnOutputMismatch(t *testing.T) {
+	ep := newEndpointProvider()
+	l2ooContract := new(MockL2OOContract)
+	verification := new(testutils.MockRollupClient)
+	txmgr := txmgrmocks.NewTxManager(t)
+	lgr, logs := testlog.CaptureLogger(t, log.LevelDebug)
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:                lgr,
+			Metr:               metrics.NoopMetrics,
+			Cfg:                ProposerConfig{PollInterval: time.Microsecond, NetworkTimeout: time.Second},
+			Txmgr:              txmgr,
+			RollupProvider:     ep,
+			VerificationClient: verification,
+		},
+		done:         make(chan struct{}),
+		ctx:          context.Background(),
+		l2ooContract: l2ooContract,
+	}
+
+	output := eth.OutputResponse{
+		Version:    supportedL2OutputVersion,
+		OutputRoot: eth.Bytes32{0xaa},
+		BlockRef:   eth.L2BlockRef{Number: 10},
+		Status:     &eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: 10}},
+	}
+	txmgr.On("From").Return(common.Address{}).Maybe()
+	l2ooContract.On("NextBlockNumber", mock.Anything).Return(big.NewInt(10), nil)
+	ep.rollupClient.On("SyncStatus").Return(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: 10}}, nil)
+	ep.rollupClient.On("OutputAtBlock", uint64(10)).Return(&output, nil)
+	verification.ExpectOutputAtBlock(10, &eth.OutputResponse{OutputRoot: eth.Bytes32{0xbb}}, nil)
+
+	// The loop returns on the mismatch, and the tx manager mock fails the test if a proposal is sent
+	ps.wg.Add(1)
+	ps.loop()
+	verification.AssertExpectations(t)
+	require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageContainsFilter("Halting proposer")))
+}
+
+type resolutionMetrics struct {
+	metrics.Metricer
+	defenderWon []bool
+	bonds       []*big.Int
+	pending     int
+}
+
+func (m *resolutionMetrics) RecordGameResolution(defenderWon bool, bond *big.Int) {
+	m.defenderWon = append(m.defenderWon, defenderWon)
+	m.bonds = append(m.bonds, bond)
+}
+
+func (m *resolutionMetrics) RecordPendingGames(count int) {
+	m.pending = count
+}
+
+func TestL2OutputSubmitter_GameResolutions(t *testing.T) {
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab})
+	m := &resolutionMetrics{Metricer: metrics.NoopMetrics}
+	lgr, logs := testlog.CaptureLogger(t, log.LevelDebug)
+	gameA := contracts.ProposerGame{GameProposal: contracts.GameProposal{Address: common.Address{0xaa}}, Bond: big.NewInt(100)}
+	gameB := contracts.ProposerGame{GameProposal: contracts.GameProposal{Address: common.Address{0xbb}}, Bond: big.NewInt(200)}
+	gameC := contracts.ProposerGame{GameProposal: contracts.GameProposal{Address: common.Address{0xcc}}, Bond: big.NewInt(300)}
+	dgf := &StubDGFContract{
+		games:    []contracts.ProposerGame{gameA, gameB},
+		statuses: make(map[common.Address]contracts.GameStatus),
+	}
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:   lgr,
+			Metr:  m,
+			Cfg:   ProposerConfig{GameResolutionWindow: time.Hour},
+			Txmgr: txmgr,
+		},
+		dgfContract: dgf,
+	}
+
+	require.NoError(t, ps.checkGameResolutions(context.Background()))
+	require.Empty(t, m.defenderWon, "games are in progress")
+	require.Equal(t, 2, m.pending)
+
+	dgf.statuses[gameA.Address] = contracts.GameStatusChallengerWon
+	require.NoError(t, ps.checkGameResolutions(context.Background()))
+	require.Equal(t, []bool{false}, m.defenderWon)
+	require.Equal(t, []*big.Int{big.NewInt(100)}, m.bonds)
+	require.Equal(t, 1, m.pending)
+	require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Game of proposer resolved against the proposal, the proposal bond is lost")))
+
+	dgf.statuses[gameB.Address] = contracts.GameStatusDefenderWon
+	dgf.games = append(dgf.games, gameC)
+	require.NoError(t, ps.checkGameResolutions(context.Background()))
+	require.Equal(t, []bool{false, true}, m.defenderWon)
+	require.Equal(t, 1, m.pending, "new game is watched")
+	require.Contains(t, ps.pendingGames, gameC.Address)
+	require.Equal(t, uint64(3), ps.nextGameIndex)
+}
@@ -0,0 +1,73 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
+)
+
+// resolutionPollInterval is how often the games created by the proposer are checked for resolution.
+const resolutionPollInterval = time.Minute
+
+// resolutionLoop regularly checks whether the games created by the proposer have resolved.
+func (l *L2OutputSubmitter) resolutionLoop() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(resolutionPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := l.checkGameResolutions(l.ctx); err != nil {
+			l.Log.Warn("Failed to check game resolutions", "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// checkGameResolutions finds the games created by the proposer since the last check, and reports the games
+// that resolved since. A game that resolves against the proposer means that a proposal of the proposer was
+// successfully challenged, and the bond of the proposal is lost.
+func (l *L2OutputSubmitter) checkGameResolutions(ctx context.Context) error {
+	cutoff := l.now().Add(-l.Cfg.GameResolutionWindow)
+	games, next, err := l.dgfContract.ProposerGames(ctx, l.Txmgr.From(), l.nextGameIndex, cutoff)
+	if err != nil {
+		return fmt.Errorf("could not find games of proposer: %w", err)
+	}
+	if l.pendingGames == nil {
+		l.pendingGames = make(map[common.Address]contracts.ProposerGame)
+	}
+	for _, game := range games {
+		l.pendingGames[game.Address] = game
+	}
+	l.nextGameIndex = next
+
+	for addr, game := range l.pendingGames {
+		status, err := l.dgfContract.GameStatus(ctx, addr)
+		if err != nil {
+			l.Log.Warn("Failed to load game status", "game", addr, "err", err)
+			continue
+		}
+		switch status {
+		case contracts.GameStatusInProgress:
+			continue
+		case contracts.GameStatusDefenderWon:
+			l.Log.Info("Game of proposer resolved in favor of the proposal",
+				"game", addr, "l2_block", game.L2BlockNum, "output_root", game.OutputRoot)
+			l.Metr.RecordGameResolution(true, game.Bond)
+		default:
+			l.Log.Error("Game of proposer resolved against the proposal, the proposal bond is lost",
+				"game", addr, "status", status, "l2_block", game.L2BlockNum, "output_root", game.OutputRoot,
+				"game_type", game.GameType, "bond", game.Bond)
+			l.Metr.RecordGameResolution(false, game.Bond)
+		}
+		delete(l.pendingGames, addr)
+	}
+	l.Metr.RecordPendingGames(len(l.pendingGames))
+	return nil
+}
@@ -9,19 +9,23 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer/rpc"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -39,6 +43,9 @@ type ProposerConfig struct {
 	L2OutputOracleAddr     *common.Address
 	DisputeGameFactoryAddr *common.Address
 	DisputeGameType        uint32
+	// FallbackGameTypes are the dispute game types to fall back to, in order of preference,
+	// if creating a game of the DisputeGameType would revert.
+	FallbackGameTypes []uint32
 
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
@@ -47,6 +54,23 @@ type ProposerConfig struct {
 	AllowNonFinalized bool
 
 	WaitNodeSync bool
+
+	// SkipRedundantProposals skips proposals already covered by a valid game of another proposer.
+	SkipRedundantProposals bool
+
+	// CatchUpWindow is how far back to look for the last proposal when detecting missed proposals,
+	// e.g. after downtime. Catch-up proposals are disabled if zero.
+	CatchUpWindow time.Duration
+	// CatchUpMaxProposals limits the number of proposals made to catch up on a gap.
+	// Missed proposals beyond the limit are coalesced by spreading the proposals over the gap. Unlimited if zero.
+	CatchUpMaxProposals uint64
+
+	// GameResolutionWindow is how far back to look for games created by the proposer, to watch until they resolve.
+	// Games that resolve against the proposer are reported. Disabled if zero.
+	GameResolutionWindow time.Duration
+
+	// DryRun simulates proposals and logs the output roots that would be proposed, without sending transactions.
+	DryRun bool
 }
 
 type ProposerService struct {
@@ -58,6 +82,12 @@ type ProposerService struct {
 	TxManager      txmgr.TxManager
 	L1Client       *ethclient.Client
 	RollupProvider dial.RollupProvider
+	// VerificationClient is the client of the rollup node that output roots are verified against. Optional.
+	VerificationClient *sources.RollupClient
+	// ExtraDataEncoders creates the extra data of dispute games. Optional.
+	ExtraDataEncoders *contracts.ExtraDataEncoders
+	// Clock measures the proposal interval, catch-up and game resolution windows. Optional.
+	Clock clock.Clock
 
 	driver *L2OutputSubmitter
 
@@ -67,7 +97,7 @@ type ProposerService struct {
 	metricsSrv   *httputil.HTTPServer
 	rpcServer    *oprpc.Server
 
-	balanceMetricer io.Closer
+	accountMonitor io.Closer
 
 	stopped atomic.Bool
 }
@@ -93,9 +123,13 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.NetworkTimeout = cfg.TxMgrConfig.NetworkTimeout
 	ps.AllowNonFinalized = cfg.AllowNonFinalized
 	ps.WaitNodeSync = cfg.WaitNodeSync
+	ps.DryRun = cfg.DryRun
+	ps.Clock = cfg.ClockConfig.Clock()
 
 	ps.initL2ooAddress(cfg)
-	ps.initDGF(cfg)
+	if err := ps.initDGF(cfg); err != nil {
+		return fmt.Errorf("failed to init dispute game factory config: %w", err)
+	}
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
@@ -140,6 +174,14 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 		return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
 	}
 	ps.RollupProvider = rollupProvider
+
+	if cfg.VerificationRpc != "" {
+		verificationClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.VerificationRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial verification rollup node: %w", err)
+		}
+		ps.VerificationClient = verificationClient
+	}
 	return nil
 }
 
@@ -155,12 +197,14 @@ func (ps *ProposerService) initMetrics(cfg *CLIConfig) {
 // initBalanceMonitor depends on Metrics, L1Client and TxManager to start background-monitoring of the Proposer balance.
 func (ps *ProposerService) initBalanceMonitor(cfg *CLIConfig) {
 	if cfg.MetricsConfig.Enabled {
-		ps.balanceMetricer = ps.Metrics.StartBalanceMetrics(ps.Log, ps.L1Client, ps.TxManager.From())
+		ps.accountMonitor = ps.Metrics.StartAccountMonitor(ps.Log, ps.L1Client, ps.TxManager.From())
 	}
 }
 
 func (ps *ProposerService) initTxManager(cfg *CLIConfig) error {
-	txManager, err := txmgr.NewSimpleTxManager("proposer", ps.Log, ps.Metrics, cfg.TxMgrConfig)
+	txMgrCfg := cfg.TxMgrConfig
+	txMgrCfg.ErrorABIs = contracts.ErrorABIs()
+	txManager, err := txmgr.NewSimpleTxManager("proposer", ps.Log, ps.Metrics, txMgrCfg)
 	if err != nil {
 		return err
 	}
@@ -177,6 +221,7 @@ func (ps *ProposerService) initPProf(cfg *CLIConfig) error {
 		cfg.PprofConfig.ProfileDir,
 		cfg.PprofConfig.ProfileFilename,
 	)
+	ps.pprofService.EnableUpload(cfg.PprofConfig.Upload, "op-proposer", ps.Version)
 
 	if err := ps.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
@@ -213,27 +258,51 @@ func (ps *ProposerService) initL2ooAddress(cfg *CLIConfig) {
 	ps.L2OutputOracleAddr = &l2ooAddress
 }
 
-func (ps *ProposerService) initDGF(cfg *CLIConfig) {
+func (ps *ProposerService) initDGF(cfg *CLIConfig) error {
 	dgfAddress, err := opservice.ParseAddress(cfg.DGFAddress)
 	if err != nil {
 		// Return no error & set no DGF related configuration fields.
-		return
+		return nil
 	}
 	ps.DisputeGameFactoryAddr = &dgfAddress
 	ps.ProposalInterval = cfg.ProposalInterval
 	ps.DisputeGameType = cfg.DisputeGameType
+	ps.FallbackGameTypes = cfg.FallbackGameTypes
+	ps.SkipRedundantProposals = cfg.SkipRedundantProposals
+	ps.CatchUpWindow = cfg.CatchUpWindow
+	ps.CatchUpMaxProposals = cfg.CatchUpMaxProposals
+	ps.GameResolutionWindow = cfg.GameResolutionWindow
+
+	params, err := ParseGameExtraDataParams(cfg.GameExtraDataParams)
+	if err != nil {
+		return err
+	}
+	if len(params) > 0 {
+		ps.ExtraDataEncoders = contracts.NewExtraDataEncoders()
+		for gameType, p := range params {
+			ps.ExtraDataEncoders.Register(gameType, contracts.L2BlockNumberWithParamsExtraData(p))
+			ps.Log.Info("Appending params to the extra data of dispute games", "game_type", gameType, "params", hexutil.Bytes(p))
+		}
+	}
+	return nil
 }
 
 func (ps *ProposerService) initDriver() error {
-	driver, err := NewL2OutputSubmitter(DriverSetup{
-		Log:            ps.Log,
-		Metr:           ps.Metrics,
-		Cfg:            ps.ProposerConfig,
-		Txmgr:          ps.TxManager,
-		L1Client:       ps.L1Client,
-		Multicaller:    batching.NewMultiCaller(ps.L1Client.Client(), batching.DefaultBatchSize),
-		RollupProvider: ps.RollupProvider,
-	})
+	setup := DriverSetup{
+		Log:               ps.Log,
+		Metr:              ps.Metrics,
+		Cfg:               ps.ProposerConfig,
+		Txmgr:             ps.TxManager,
+		L1Client:          ps.L1Client,
+		Multicaller:       batching.NewMultiCaller(ps.L1Client.Client(), batching.DefaultBatchSize),
+		RollupProvider:    ps.RollupProvider,
+		ExtraDataEncoders: ps.ExtraDataEncoders,
+		Clock:             ps.Clock,
+	}
+	if ps.VerificationClient != nil {
+		setup.VerificationClient = ps.VerificationClient
+	}
+	driver, err := NewL2OutputSubmitter(setup)
 	if err != nil {
 		return err
 	}
@@ -248,6 +317,18 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 		ps.Version,
 		oprpc.WithLogger(ps.Log),
 	)
+	server.AddReadinessCheck("l1", func(ctx context.Context) error {
+		_, err := ps.L1Client.BlockNumber(ctx)
+		return err
+	})
+	server.AddReadinessCheck("rollup", func(ctx context.Context) error {
+		rollupClient, err := ps.RollupProvider.RollupClient(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = rollupClient.SyncStatus(ctx)
+		return err
+	})
 	if cfg.RPCConfig.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(ps.driver, ps.Metrics, ps.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
@@ -306,8 +387,8 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
 		}
 	}
-	if ps.balanceMetricer != nil {
-		if err := ps.balanceMetricer.Close(); err != nil {
+	if ps.accountMonitor != nil {
+		if err := ps.accountMonitor.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
 		}
 	}
@@ -330,6 +411,10 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		ps.RollupProvider.Close()
 	}
 
+	if ps.VerificationClient != nil {
+		ps.VerificationClient.Close()
+	}
+
 	if result == nil {
 		ps.stopped.Store(true)
 		ps.Log.Info("L2Output Submitter stopped")
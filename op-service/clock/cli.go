@@ -0,0 +1,65 @@
+package clock
+
+import (
+	"errors"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+)
+
+// TimeDilationFlagName is the flag of the services that measure time windows with the service clock:
+// op-challenger, op-dispute-mon and op-proposer. The other services do not take it: op-node and op-batcher
+// time their work off L1 and L2 block timestamps, and op-conductor measures the health of the sequencer
+// in real time, so a dilated clock would only desync them from the chain.
+const TimeDilationFlagName = "dev.time-dilation"
+
+var ErrInvalidTimeDilation = errors.New("time dilation factor must be positive")
+
+func CLIFlags(envPrefix string) []cli.Flag {
+	return CLIFlagsWithCategory(envPrefix, "")
+}
+
+func CLIFlagsWithCategory(envPrefix string, category string) []cli.Flag {
+	return []cli.Flag{
+		&cli.Float64Flag{
+			Name: TimeDilationFlagName,
+			Usage: "Development only: run the service clock this many times faster than real time, " +
+				"e.g. 10 to pass a 1 hour window in 6 minutes. Does not affect the on-chain clock.",
+			Value:    1,
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "DEV_TIME_DILATION"),
+			Category: category,
+		},
+	}
+}
+
+type CLIConfig struct {
+	// TimeDilation is the factor by which the service clock runs faster than real time.
+	// The zero value, like 1, runs the clock at real time.
+	TimeDilation float64
+}
+
+func DefaultCLIConfig() CLIConfig {
+	return CLIConfig{TimeDilation: 1}
+}
+
+func (c CLIConfig) Check() error {
+	if c.TimeDilation < 0 {
+		return ErrInvalidTimeDilation
+	}
+	return nil
+}
+
+// Clock returns the system clock, dilated by the configured factor if it is not 1.
+func (c CLIConfig) Clock() Clock {
+	if c.TimeDilation == 0 || c.TimeDilation == 1 {
+		return SystemClock
+	}
+	return NewDilatedClock(SystemClock, c.TimeDilation)
+}
+
+func ReadCLIConfig(ctx *cli.Context) CLIConfig {
+	return CLIConfig{
+		TimeDilation: ctx.Float64(TimeDilationFlagName),
+	}
+}
@@ -0,0 +1,138 @@
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DilatedClock is a Clock that runs faster (or slower) than its base clock by a constant factor.
+// Time progresses from the moment the clock is created: after the base clock advances by d,
+// Now has advanced by d*factor, and waiting for a duration d takes d/factor of base clock time.
+// It is intended for local development only, to exercise long time windows, like finalization delays
+// and challenge periods, in a fraction of the time.
+type DilatedClock struct {
+	base   Clock
+	factor float64
+	start  time.Time
+}
+
+var _ Clock = (*DilatedClock)(nil)
+
+// NewDilatedClock creates a clock that advances factor times as fast as the base clock.
+// The factor must be positive.
+func NewDilatedClock(base Clock, factor float64) *DilatedClock {
+	if factor <= 0 {
+		panic("time dilation factor must be positive")
+	}
+	return &DilatedClock{
+		base:   base,
+		factor: factor,
+		start:  base.Now(),
+	}
+}
+
+// dilate converts a duration of the base clock into a duration of the dilated clock.
+func (c *DilatedClock) dilate(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * c.factor)
+}
+
+// contract converts a duration of the dilated clock into a duration of the base clock.
+func (c *DilatedClock) contract(d time.Duration) time.Duration {
+	return time.Duration(float64(d) / c.factor)
+}
+
+func (c *DilatedClock) toDilated(t time.Time) time.Time {
+	return c.start.Add(c.dilate(t.Sub(c.start)))
+}
+
+func (c *DilatedClock) Now() time.Time {
+	return c.toDilated(c.base.Now())
+}
+
+func (c *DilatedClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *DilatedClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).Ch()
+}
+
+func (c *DilatedClock) AfterFunc(d time.Duration, f func()) Timer {
+	return c.base.AfterFunc(c.contract(d), f)
+}
+
+func (c *DilatedClock) NewTimer(d time.Duration) Timer {
+	ch := make(chan time.Time, 1)
+	// The timer sends the time it was due, rather than the time it fired at,
+	// as the base clock may run the function while holding its own lock.
+	due := c.Now().Add(d)
+	timer := c.base.AfterFunc(c.contract(d), func() {
+		ch <- due
+	})
+	return &dilatedTimer{Timer: timer, ch: ch}
+}
+
+func (c *DilatedClock) NewTicker(d time.Duration) Ticker {
+	t := &dilatedTicker{
+		clock:  c,
+		ticker: c.base.NewTicker(c.contract(d)),
+		ch:     make(chan time.Time, 1),
+		quit:   make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+func (c *DilatedClock) SleepCtx(ctx context.Context, d time.Duration) error {
+	return c.base.SleepCtx(ctx, c.contract(d))
+}
+
+type dilatedTimer struct {
+	Timer
+	ch chan time.Time
+}
+
+func (t *dilatedTimer) Ch() <-chan time.Time {
+	return t.ch
+}
+
+// dilatedTicker forwards the ticks of the base ticker, converted to the time of the dilated clock.
+type dilatedTicker struct {
+	clock  *DilatedClock
+	ticker Ticker
+	ch     chan time.Time
+
+	stopOnce sync.Once
+	quit     chan struct{}
+}
+
+func (t *dilatedTicker) run() {
+	for {
+		select {
+		case now := <-t.ticker.Ch():
+			select {
+			case t.ch <- t.clock.toDilated(now):
+			default:
+				// Drop the tick if the receiver is slow, like time.Ticker does
+			}
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+func (t *dilatedTicker) Ch() <-chan time.Time {
+	return t.ch
+}
+
+func (t *dilatedTicker) Stop() {
+	t.stopOnce.Do(func() {
+		t.ticker.Stop()
+		close(t.quit)
+	})
+}
+
+func (t *dilatedTicker) Reset(d time.Duration) {
+	t.ticker.Reset(t.clock.contract(d))
+}
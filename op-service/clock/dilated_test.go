@@ -0,0 +1,85 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDilatedClock(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	t.Run("Now", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 10)
+		require.Equal(t, start, clock.Now())
+		base.AdvanceTime(time.Second)
+		require.Equal(t, start.Add(10*time.Second), clock.Now())
+		require.Equal(t, 5*time.Second, clock.Since(start.Add(5*time.Second)))
+	})
+
+	t.Run("NewTimer", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 10)
+		timer := clock.NewTimer(time.Minute)
+		base.AdvanceTime(5 * time.Second)
+		require.Len(t, timer.Ch(), 0)
+		base.AdvanceTime(time.Second)
+		require.Equal(t, start.Add(time.Minute), <-timer.Ch())
+
+		timer = clock.NewTimer(time.Minute)
+		require.True(t, timer.Stop())
+		base.AdvanceTime(time.Minute)
+		require.Len(t, timer.Ch(), 0)
+	})
+
+	t.Run("AfterFunc", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 4)
+		called := make(chan struct{}, 1)
+		clock.AfterFunc(time.Minute, func() { called <- struct{}{} })
+		base.AdvanceTime(14 * time.Second)
+		require.Len(t, called, 0)
+		base.AdvanceTime(time.Second)
+		<-called
+	})
+
+	t.Run("NewTicker", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 10)
+		ticker := clock.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		base.AdvanceTime(time.Second)
+		require.Equal(t, start.Add(10*time.Second), <-ticker.Ch())
+		base.AdvanceTime(time.Second)
+		require.Equal(t, start.Add(20*time.Second), <-ticker.Ch())
+	})
+
+	t.Run("SleepCtx", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 10)
+		done := make(chan error)
+		go func() {
+			done <- clock.SleepCtx(context.Background(), time.Minute)
+		}()
+		require.True(t, base.WaitForNewPendingTaskWithTimeout(10*time.Second))
+		base.AdvanceTime(6 * time.Second)
+		require.NoError(t, <-done)
+	})
+
+	t.Run("Slower", func(t *testing.T) {
+		base := NewDeterministicClock(start)
+		clock := NewDilatedClock(base, 0.5)
+		base.AdvanceTime(time.Minute)
+		require.Equal(t, start.Add(30*time.Second), clock.Now())
+	})
+}
+
+func TestCLIConfigClock(t *testing.T) {
+	require.Equal(t, SystemClock, DefaultCLIConfig().Clock())
+	require.Equal(t, SystemClock, CLIConfig{}.Clock())
+	require.IsType(t, &DilatedClock{}, CLIConfig{TimeDilation: 10}.Clock())
+	require.ErrorIs(t, CLIConfig{TimeDilation: -1}.Check(), ErrInvalidTimeDilation)
+}
@@ -27,6 +27,14 @@ type HttpRPC interface {
 	HttpRPC() string
 }
 
+// IpcRPC is an RPC extension interface,
+// to explicitly provide the IPC RPC option.
+// An empty IPC path means the option is not available.
+type IpcRPC interface {
+	RPC
+	IpcRPC() string
+}
+
 // ClientRPC is an RPC extension interface,
 // providing the option to attach in-process to a client,
 // rather than dialing an endpoint.
@@ -58,11 +66,12 @@ func (url WsURL) WsRPC() string {
 }
 
 // WsOrHttpRPC provides optionality between
-// a websocket RPC endpoint and a HTTP RPC endpoint.
+// a websocket RPC endpoint, a HTTP RPC endpoint and an optional IPC endpoint.
 // The default is the websocket endpoint.
 type WsOrHttpRPC struct {
 	WsURL   string
 	HttpURL string
+	IpcPath string
 }
 
 func (r WsOrHttpRPC) RPC() string {
@@ -77,6 +86,10 @@ func (r WsOrHttpRPC) HttpRPC() string {
 	return r.HttpURL
 }
 
+func (r WsOrHttpRPC) IpcRPC() string {
+	return r.IpcPath
+}
+
 // ServerRPC is a very flexible RPC: it can attach in-process to a server,
 // or select one of the fallback RPC methods.
 type ServerRPC struct {
@@ -96,6 +109,10 @@ func (e *ServerRPC) HttpRPC() string {
 	return e.Fallback.HttpRPC()
 }
 
+func (e *ServerRPC) IpcRPC() string {
+	return e.Fallback.IpcRPC()
+}
+
 func (e *ServerRPC) ClientRPC() *rpc.Client {
 	return rpc.DialInProc(e.Server)
 }
@@ -108,6 +125,7 @@ const (
 	PreferAnyRPC RPCPreference = iota
 	PreferHttpRPC
 	PreferWSRPC
+	PreferIpcRPC
 )
 
 // DialRPC navigates the RPC interface,
@@ -119,6 +137,9 @@ func DialRPC(preference RPCPreference, rpc RPC, dialer Dialer) *rpc.Client {
 	if v, ok := rpc.(WsRPC); preference == PreferWSRPC && ok {
 		return dialer(v.WsRPC())
 	}
+	if v, ok := rpc.(IpcRPC); preference == PreferIpcRPC && ok && v.IpcRPC() != "" {
+		return dialer(v.IpcRPC())
+	}
 	if v, ok := rpc.(ClientRPC); ok {
 		return v.ClientRPC()
 	}
@@ -134,5 +155,8 @@ func SelectRPC(preference RPCPreference, rpc RPC) string {
 	if v, ok := rpc.(WsRPC); preference == PreferWSRPC && ok {
 		return v.WsRPC()
 	}
+	if v, ok := rpc.(IpcRPC); preference == PreferIpcRPC && ok && v.IpcRPC() != "" {
+		return v.IpcRPC()
+	}
 	return rpc.RPC()
 }
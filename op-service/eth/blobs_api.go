@@ -1,5 +1,7 @@
 package eth
 
+import "github.com/ethereum/go-ethereum/common"
+
 type BlobSidecar struct {
 	Blob          Blob         `json:"blob"`
 	Index         Uint64String `json:"index"`
@@ -39,6 +41,30 @@ type BeaconBlockHeader struct {
 	BodyRoot      Bytes32      `json:"body_root"`
 }
 
+// ReducedExecutionPayload is the subset of the execution payload of a beacon block
+// that identifies the execution-layer block.
+type ReducedExecutionPayload struct {
+	BlockNumber Uint64String `json:"block_number"`
+	BlockHash   common.Hash  `json:"block_hash"`
+}
+
+type ReducedBeaconBlockBody struct {
+	ExecutionPayload ReducedExecutionPayload `json:"execution_payload"`
+}
+
+type ReducedBeaconBlock struct {
+	Slot Uint64String           `json:"slot"`
+	Body ReducedBeaconBlockBody `json:"body"`
+}
+
+type SignedReducedBeaconBlock struct {
+	Message ReducedBeaconBlock `json:"message"`
+}
+
+type APIBeaconBlockResponse struct {
+	Data SignedReducedBeaconBlock `json:"data"`
+}
+
 type APIGetBlobSidecarsResponse struct {
 	Data []*APIBlobSidecar `json:"data"`
 }
@@ -0,0 +1,19 @@
+package eth
+
+// BlockBuildingLimits are soft limits on the transactions that the sequencer includes from the tx pool
+// in new blocks, to throttle the production of chain data, e.g. during DA fee spikes.
+// Limits are disabled when zero. Deposits and forced transactions are not limited.
+// The sequencer enforces the limits on the blocks built by the engine: a block that exceeds them is rebuilt
+// with only the tx-pool transactions that fit, the other transactions remain in the tx pool.
+// Rebuilding delays the publishing of the block by about the latency of building it,
+// since the engine API cannot apply the limits before the block is sealed.
+type BlockBuildingLimits struct {
+	// MaxTxs is the maximum number of tx-pool transactions per block.
+	MaxTxs Uint64Quantity `json:"max_txs"`
+	// MaxCalldataBytes is the maximum total calldata size of the tx-pool transactions per block.
+	MaxCalldataBytes Uint64Quantity `json:"max_calldata_bytes"`
+	// GasTarget is the maximum gas used by a block, including its deposits, above which tx-pool transactions are dropped.
+	// The gas used by each transaction is not known before the block is inserted, so trailing transactions are dropped
+	// until their intrinsic gas covers the excess, which may drop more gas than the excess.
+	GasTarget Uint64Quantity `json:"gas_target"`
+}
@@ -41,6 +41,16 @@ func InfoToL1BlockRef(info BlockInfo) L1BlockRef {
 	}
 }
 
+// HeaderToL1BlockRef converts a header into an L1BlockRef.
+func HeaderToL1BlockRef(h *types.Header) L1BlockRef {
+	return InfoToL1BlockRef(HeaderBlockInfo(h))
+}
+
+// BlockToL1BlockRef converts a block into an L1BlockRef.
+func BlockToL1BlockRef(b *types.Block) L1BlockRef {
+	return InfoToL1BlockRef(BlockToInfo(b))
+}
+
 type NumberAndHash interface {
 	Hash() common.Hash
 	NumberU64() uint64
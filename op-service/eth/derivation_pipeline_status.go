@@ -0,0 +1,25 @@
+package eth
+
+// DerivationPipelineStatus is a snapshot of the derivation pipeline of the op-node,
+// to diagnose why the safe head is not progressing.
+type DerivationPipelineStatus struct {
+	// Origin is the L1 block that the next derived attributes are derived from.
+	Origin L1BlockRef `json:"origin"`
+	// Ready is false while the pipeline is being reset.
+	Ready bool `json:"ready"`
+	// LastError is the last error that interrupted a derivation step, if any.
+	LastError string `json:"last_error,omitempty"`
+	// Stages are the buffering stages of the pipeline, in the order that data flows through them.
+	Stages []DerivationStageStatus `json:"stages"`
+}
+
+// DerivationStageStatus is a snapshot of a single stage of the derivation pipeline.
+type DerivationStageStatus struct {
+	Name string `json:"name"`
+	// Origin is the L1 block the stage is reading data from.
+	Origin L1BlockRef `json:"origin"`
+	// QueueDepth is the number of items buffered by the stage: frames, channels or batches.
+	QueueDepth uint64 `json:"queue_depth"`
+	// LastError is the last invalid data the stage skipped over, if any.
+	LastError string `json:"last_error,omitempty"`
+}
@@ -0,0 +1,79 @@
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BatcherFrameRef identifies a frame of batcher data, and the L1 transaction that included it.
+type BatcherFrameRef struct {
+	L1Block     BlockID     `json:"l1Block"`
+	TxHash      common.Hash `json:"txHash"`
+	FrameNumber uint16      `json:"frameNumber"`
+}
+
+// DepositRef identifies a deposit event emitted on L1.
+type DepositRef struct {
+	TxHash   common.Hash `json:"txHash"`
+	LogIndex uint        `json:"logIndex"`
+}
+
+// DerivationReceipt records the L1 data a safe L2 block was derived from.
+type DerivationReceipt struct {
+	L2Block  BlockID `json:"l2Block"`
+	L1Origin BlockID `json:"l1Origin"`
+	// DerivedFrom is the first L1 block that includes all data required to derive the L2 block.
+	DerivedFrom BlockID `json:"derivedFrom"`
+
+	// ChannelID is the channel the batch of the block was read from.
+	// It is empty if the block was not derived from batcher data, as the sequencing window expired.
+	ChannelID hexutil.Bytes `json:"channelID,omitempty"`
+	// Frames are the frames of the channel, in the order they were read.
+	Frames []BatcherFrameRef `json:"frames,omitempty"`
+	// BatchBlockIndex is the index of the block within its span batch, and BatchBlockCount the number
+	// of blocks of the batch. Singular batches always have a single block.
+	BatchBlockIndex uint64 `json:"batchBlockIndex"`
+	BatchBlockCount uint64 `json:"batchBlockCount"`
+
+	// Deposits are the user deposits of the block, only the first block of an epoch has deposits.
+	Deposits []DepositRef `json:"deposits,omitempty"`
+}
+
+// SafeBlockJustification is the batcher data that justified a safe L2 block,
+// for auditors to verify the data availability of the block.
+type SafeBlockJustification struct {
+	L2Block BlockID `json:"l2Block"`
+	// DerivedFrom is the first L1 block that includes all data required to derive the L2 block.
+	DerivedFrom BlockID `json:"derivedFrom"`
+
+	// ChannelID is the channel the batch of the block was read from.
+	// It is empty if the block was not derived from batcher data, as the sequencing window expired.
+	ChannelID hexutil.Bytes `json:"channelID,omitempty"`
+	// FrameNumbers are the numbers of the frames of the channel, in the order they were read.
+	FrameNumbers []uint16 `json:"frameNumbers,omitempty"`
+	// BatcherTxs are the hashes of the L1 transactions that included the frames, in the order they were read.
+	BatcherTxs []common.Hash `json:"batcherTxs,omitempty"`
+	// SpanBatchIndex is the index of the block within its span batch, and SpanBatchCount the number
+	// of blocks of the batch. Singular batches always have a single block.
+	SpanBatchIndex uint64 `json:"spanBatchIndex"`
+	SpanBatchCount uint64 `json:"spanBatchCount"`
+}
+
+// Justification returns the batcher data of the receipt that justified the safe L2 block.
+func (r *DerivationReceipt) Justification() *SafeBlockJustification {
+	j := &SafeBlockJustification{
+		L2Block:        r.L2Block,
+		DerivedFrom:    r.DerivedFrom,
+		ChannelID:      r.ChannelID,
+		SpanBatchIndex: r.BatchBlockIndex,
+		SpanBatchCount: r.BatchBlockCount,
+	}
+	for _, frame := range r.Frames {
+		j.FrameNumbers = append(j.FrameNumbers, frame.FrameNumber)
+		// A batcher tx may include multiple frames of the channel
+		if n := len(j.BatcherTxs); n == 0 || j.BatcherTxs[n-1] != frame.TxHash {
+			j.BatcherTxs = append(j.BatcherTxs, frame.TxHash)
+		}
+	}
+	return j
+}
@@ -0,0 +1,42 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivationReceiptJustification(t *testing.T) {
+	l1A := BlockID{Hash: common.Hash{0xa1}, Number: 100}
+	l1B := BlockID{Hash: common.Hash{0xb1}, Number: 101}
+	receipt := &DerivationReceipt{
+		L2Block:     BlockID{Hash: common.Hash{0xee}, Number: 223},
+		L1Origin:    BlockID{Hash: common.Hash{0xcc}, Number: 90},
+		DerivedFrom: l1B,
+		ChannelID:   hexutil.Bytes{0x01, 0x02},
+		Frames: []BatcherFrameRef{
+			{L1Block: l1A, TxHash: common.Hash{0x01}, FrameNumber: 0},
+			{L1Block: l1A, TxHash: common.Hash{0x01}, FrameNumber: 1},
+			{L1Block: l1B, TxHash: common.Hash{0x02}, FrameNumber: 2},
+		},
+		BatchBlockIndex: 2,
+		BatchBlockCount: 5,
+		Deposits:        []DepositRef{{TxHash: common.Hash{0xbb}, LogIndex: 3}},
+	}
+	require.Equal(t, &SafeBlockJustification{
+		L2Block:        receipt.L2Block,
+		DerivedFrom:    l1B,
+		ChannelID:      hexutil.Bytes{0x01, 0x02},
+		FrameNumbers:   []uint16{0, 1, 2},
+		BatcherTxs:     []common.Hash{{0x01}, {0x02}},
+		SpanBatchIndex: 2,
+		SpanBatchCount: 5,
+	}, receipt.Justification())
+
+	t.Run("NoBatcherData", func(t *testing.T) {
+		receipt := &DerivationReceipt{L2Block: BlockID{Number: 224}, DerivedFrom: l1B}
+		require.Equal(t, &SafeBlockJustification{L2Block: BlockID{Number: 224}, DerivedFrom: l1B}, receipt.Justification())
+	})
+}
@@ -0,0 +1,135 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BlockDataSource fetches the contents of a block by hash.
+// Implementations such as sources.EthClient cache the results,
+// so blocks referencing the same source share the cached data.
+type BlockDataSource interface {
+	InfoAndTxsByHash(ctx context.Context, hash common.Hash) (BlockInfo, types.Transactions, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (BlockInfo, types.Receipts, error)
+}
+
+// LazyBlock is a reference to a block which loads the block info, transactions and receipts
+// from an attached source when first requested. Loaded data is retained, so repeated calls
+// do not fetch from the source again. A LazyBlock is safe for concurrent use.
+// It is used where the data of an L1 block is read in several steps, e.g. by the derivation data sources
+// and the alt-DA challenge tracking. Clients that fetch whole blocks or headers, like the batcher,
+// convert them with BlockToL1BlockRef and HeaderToL1BlockRef instead.
+type LazyBlock struct {
+	id  BlockID
+	src BlockDataSource
+
+	mu       sync.Mutex
+	info     BlockInfo
+	txs      types.Transactions
+	receipts types.Receipts
+}
+
+// NewLazyBlock creates a LazyBlock for the block with the given ID.
+func NewLazyBlock(id BlockID, src BlockDataSource) *LazyBlock {
+	return &LazyBlock{id: id, src: src}
+}
+
+// LazyBlockFromInfo creates a LazyBlock for an already loaded block info.
+func LazyBlockFromInfo(info BlockInfo, src BlockDataSource) *LazyBlock {
+	return &LazyBlock{id: ToBlockID(info), src: src, info: info}
+}
+
+func (b *LazyBlock) ID() BlockID {
+	return b.id
+}
+
+// Info returns the block info, loading it if required.
+func (b *LazyBlock) Info(ctx context.Context) (BlockInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.info != nil {
+		return b.info, nil
+	}
+	if err := b.loadTxs(ctx); err != nil {
+		return nil, err
+	}
+	if b.info == nil {
+		return nil, fmt.Errorf("source returned no info for block %s", b.id)
+	}
+	return b.info, nil
+}
+
+// L1BlockRef returns the reference to the block, loading the block info if required.
+func (b *LazyBlock) L1BlockRef(ctx context.Context) (L1BlockRef, error) {
+	info, err := b.Info(ctx)
+	if err != nil {
+		return L1BlockRef{}, err
+	}
+	return InfoToL1BlockRef(info), nil
+}
+
+// Transactions returns the transactions of the block, loading them if required.
+func (b *LazyBlock) Transactions(ctx context.Context) (types.Transactions, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.txs != nil {
+		return b.txs, nil
+	}
+	if err := b.loadTxs(ctx); err != nil {
+		return nil, err
+	}
+	return b.txs, nil
+}
+
+// Receipts returns the receipts of the block, loading them if required.
+func (b *LazyBlock) Receipts(ctx context.Context) (types.Receipts, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+	info, receipts, err := b.src.FetchReceipts(ctx, b.id.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipts of block %s: %w", b.id, err)
+	}
+	if err := b.setInfo(info); err != nil {
+		return nil, err
+	}
+	if receipts == nil {
+		receipts = types.Receipts{}
+	}
+	b.receipts = receipts
+	return b.receipts, nil
+}
+
+func (b *LazyBlock) loadTxs(ctx context.Context) error {
+	info, txs, err := b.src.InfoAndTxsByHash(ctx, b.id.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch transactions of block %s: %w", b.id, err)
+	}
+	if err := b.setInfo(info); err != nil {
+		return err
+	}
+	if txs == nil {
+		txs = types.Transactions{}
+	}
+	b.txs = txs
+	return nil
+}
+
+func (b *LazyBlock) setInfo(info BlockInfo) error {
+	if info == nil {
+		return nil
+	}
+	if id := ToBlockID(info); id != b.id {
+		return fmt.Errorf("source returned block %s but expected %s", id, b.id)
+	}
+	if b.info == nil {
+		b.info = info
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBlockDataSource struct {
+	info     BlockInfo
+	txs      types.Transactions
+	receipts types.Receipts
+	err      error
+
+	txCalls      int
+	receiptCalls int
+}
+
+func (s *stubBlockDataSource) InfoAndTxsByHash(_ context.Context, _ common.Hash) (BlockInfo, types.Transactions, error) {
+	s.txCalls++
+	return s.info, s.txs, s.err
+}
+
+func (s *stubBlockDataSource) FetchReceipts(_ context.Context, _ common.Hash) (BlockInfo, types.Receipts, error) {
+	s.receiptCalls++
+	return s.info, s.receipts, s.err
+}
+
+func TestLazyBlock(t *testing.T) {
+	header := &types.Header{Number: big.NewInt(10), Time: 100, ParentHash: common.Hash{0x01}}
+	info := HeaderBlockInfo(header)
+	ctx := context.Background()
+
+	t.Run("LoadsOnce", func(t *testing.T) {
+		src := &stubBlockDataSource{
+			info:     info,
+			txs:      types.Transactions{types.NewTx(&types.LegacyTx{Nonce: 1})},
+			receipts: types.Receipts{{Status: types.ReceiptStatusSuccessful}},
+		}
+		block := NewLazyBlock(ToBlockID(info), src)
+		require.Equal(t, ToBlockID(info), block.ID())
+		require.Zero(t, src.txCalls)
+
+		ref, err := block.L1BlockRef(ctx)
+		require.NoError(t, err)
+		require.Equal(t, HeaderToL1BlockRef(header), ref)
+		txs, err := block.Transactions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, src.txs, txs)
+		require.Equal(t, 1, src.txCalls)
+
+		receipts, err := block.Receipts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, src.receipts, receipts)
+		_, err = block.Receipts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, src.receiptCalls)
+	})
+
+	t.Run("EmptyBlock", func(t *testing.T) {
+		src := &stubBlockDataSource{info: info}
+		block := LazyBlockFromInfo(info, src)
+		txs, err := block.Transactions(ctx)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+		_, err = block.Transactions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, src.txCalls)
+	})
+
+	t.Run("InfoFromConstructorNotFetched", func(t *testing.T) {
+		src := &stubBlockDataSource{}
+		block := LazyBlockFromInfo(info, src)
+		actual, err := block.Info(ctx)
+		require.NoError(t, err)
+		require.Equal(t, info, actual)
+		require.Zero(t, src.txCalls)
+	})
+
+	t.Run("SourceError", func(t *testing.T) {
+		src := &stubBlockDataSource{err: errors.New("boom")}
+		block := NewLazyBlock(ToBlockID(info), src)
+		_, err := block.Transactions(ctx)
+		require.ErrorIs(t, err, src.err)
+		_, err = block.Receipts(ctx)
+		require.ErrorIs(t, err, src.err)
+	})
+
+	t.Run("WrongBlock", func(t *testing.T) {
+		src := &stubBlockDataSource{info: info}
+		block := NewLazyBlock(BlockID{Hash: common.Hash{0xaa}, Number: 10}, src)
+		_, err := block.Info(ctx)
+		require.ErrorContains(t, err, "expected")
+	})
+}
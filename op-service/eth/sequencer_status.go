@@ -0,0 +1,25 @@
+package eth
+
+// SequencerStatus is a snapshot of the sequencing state of the op-node.
+type SequencerStatus struct {
+	// Active is true if the sequencer is running.
+	Active bool `json:"active"`
+	// UnsafeHead is the latest unsafe L2 block that the sequencer knows of, and builds on top of.
+	UnsafeHead L2BlockRef `json:"unsafe_head"`
+	// TimeSinceLastBlock is the number of milliseconds since the timestamp of the unsafe head.
+	TimeSinceLastBlock uint64 `json:"time_since_last_block"`
+	// BlockedOnL1Origin is true if the last attempt to build a block could not select an L1 origin,
+	// or was delayed to let the L1 origin catch up.
+	BlockedOnL1Origin bool `json:"blocked_on_l1_origin"`
+	// L1OriginError is the reason that the sequencer is blocked on the L1 origin selection, if any.
+	L1OriginError string `json:"l1_origin_error,omitempty"`
+	// ConductorLeader is true if the sequencer conductor reports this node as the leader.
+	// Without a conductor the node is always considered the leader.
+	ConductorLeader bool `json:"conductor_leader"`
+	// ConductorError is the error of the conductor leadership check, if any.
+	ConductorError string `json:"conductor_error,omitempty"`
+	// GossipPublishFailures is the number of sequenced blocks that failed to be published to the p2p network.
+	GossipPublishFailures uint64 `json:"gossip_publish_failures"`
+	// BuildingLimits are the soft limits that are applied to new blocks.
+	BuildingLimits BlockBuildingLimits `json:"building_limits"`
+}
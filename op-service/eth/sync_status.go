@@ -38,4 +38,29 @@ type SyncStatus struct {
 	CrossUnsafeL2 L2BlockRef `json:"cross_unsafe_l2"`
 	// LocalSafeL2 is an L2 block derived from L1, not yet verified to have valid cross-L2 dependencies.
 	LocalSafeL2 L2BlockRef `json:"local_safe_l2"`
+	// ConfigOverrides are the rollup config parameters overridden at startup with the --override.* flags.
+	// Nil if the node runs with the bundled or configured rollup config as-is.
+	ConfigOverrides *ConfigOverrides `json:"config_overrides,omitempty"`
+	// L1Health is the latest result of comparing the L1 RPC against the L1 beacon node and other L1 RPCs.
+	// Nil if L1 health monitoring is disabled.
+	L1Health *L1Health `json:"l1_health,omitempty"`
+}
+
+// L1Health reports whether the L1 view of the node is stale or forked,
+// in which case derivation is degraded: it may stall or follow a chain that is not canonical.
+type L1Health struct {
+	Degraded bool `json:"degraded"`
+	// Reasons describes each detected problem, empty if not degraded.
+	Reasons []string `json:"reasons,omitempty"`
+	// CheckedAt is the unix timestamp of the check.
+	CheckedAt uint64 `json:"checked_at"`
+}
+
+// ConfigOverrides are rollup config parameters that were overridden at startup.
+// Fields that were not overridden are nil.
+type ConfigOverrides struct {
+	SeqWindowSize  *uint64 `json:"seq_window_size,omitempty"`
+	ChannelTimeout *uint64 `json:"channel_timeout,omitempty"`
+	// ForkTimes are the overridden fork activation times, by fork name.
+	ForkTimes map[string]uint64 `json:"fork_times,omitempty"`
 }
@@ -7,67 +7,58 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 )
 
 const (
-	RollupConfigFlagName     = "rollup.config"
-	NetworkFlagName          = "network"
-	CanyonOverrideFlagName   = "override.canyon"
-	DeltaOverrideFlagName    = "override.delta"
-	EcotoneOverrideFlagName  = "override.ecotone"
-	FjordOverrideFlagName    = "override.fjord"
-	GraniteOverrideFlagName  = "override.granite"
-	HoloceneOverrideFlagName = "override.holocene"
+	RollupConfigFlagName = "rollup.config"
+	NetworkFlagName      = "network"
+
+	SeqWindowSizeOverrideFlagName  = "override.seq-window-size"
+	ChannelTimeoutOverrideFlagName = "override.channel-timeout"
 )
 
-func CLIFlags(envPrefix string, category string) []cli.Flag {
-	return []cli.Flag{
-		&cli.Uint64Flag{
-			Name:     CanyonOverrideFlagName,
-			Usage:    "Manually specify the Canyon fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_CANYON"),
-			Hidden:   false,
-			Category: category,
-		},
-		&cli.Uint64Flag{
-			Name:     DeltaOverrideFlagName,
-			Usage:    "Manually specify the Delta fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_DELTA"),
-			Hidden:   false,
-			Category: category,
-		},
-		&cli.Uint64Flag{
-			Name:     EcotoneOverrideFlagName,
-			Usage:    "Manually specify the Ecotone fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_ECOTONE"),
-			Hidden:   false,
-			Category: category,
-		},
-		&cli.Uint64Flag{
-			Name:     FjordOverrideFlagName,
-			Usage:    "Manually specify the Fjord fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_FJORD"),
+// ForkOverrideFlagName returns the name of the flag to override the activation time of the fork.
+func ForkOverrideFlagName(fork rollup.ForkName) string {
+	return "override." + string(fork)
+}
+
+// forkOverrideFlags returns a flag to override the activation time of each scheduled fork.
+func forkOverrideFlags(envPrefix string, category string) []cli.Flag {
+	var out []cli.Flag
+	for _, fork := range rollup.ScheduledForks {
+		name := string(fork.Name)
+		out = append(out, &cli.Uint64Flag{
+			Name:     ForkOverrideFlagName(fork.Name),
+			Usage:    fmt.Sprintf("Manually specify the %s fork timestamp, overriding the bundled setting", strings.ToUpper(name[:1])+name[1:]),
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_"+strings.ToUpper(name)),
 			Hidden:   false,
 			Category: category,
-		},
+		})
+	}
+	return out
+}
+
+func CLIFlags(envPrefix string, category string) []cli.Flag {
+	return append(forkOverrideFlags(envPrefix, category),
 		&cli.Uint64Flag{
-			Name:     GraniteOverrideFlagName,
-			Usage:    "Manually specify the Granite fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_GRANITE"),
+			Name:     SeqWindowSizeOverrideFlagName,
+			Usage:    "Manually specify the sequencing window size, overriding the bundled setting. Only for coordinated emergency responses",
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_SEQ_WINDOW_SIZE"),
 			Hidden:   false,
 			Category: category,
 		},
 		&cli.Uint64Flag{
-			Name:     HoloceneOverrideFlagName,
-			Usage:    "Manually specify the Holocene fork timestamp, overriding the bundled setting",
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_HOLOCENE"),
+			Name:     ChannelTimeoutOverrideFlagName,
+			Usage:    "Manually specify the pre-Granite channel timeout, overriding the bundled setting. Only for coordinated emergency responses",
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "OVERRIDE_CHANNEL_TIMEOUT"),
 			Hidden:   false,
 			Category: category,
 		},
 		CLINetworkFlag(envPrefix, category),
 		CLIRollupConfigFlag(envPrefix, category),
-	}
+	)
 }
 
 func CLINetworkFlag(envPrefix string, category string) cli.Flag {
@@ -82,7 +73,7 @@ func CLINetworkFlag(envPrefix string, category string) cli.Flag {
 func CLIRollupConfigFlag(envPrefix string, category string) cli.Flag {
 	return &cli.StringFlag{
 		Name:     RollupConfigFlagName,
-		Usage:    "Rollup chain parameters",
+		Usage:    "Rollup chain parameters, as JSON, TOML or YAML file detected by the file extension",
 		EnvVars:  opservice.PrefixEnvVar(envPrefix, "ROLLUP_CONFIG"),
 		Category: category,
 	}
@@ -98,3 +98,14 @@ func WithMaxHeaderBytes(max int) HTTPOption {
 		return nil
 	}
 }
+
+// WithRoute serves the given handler on the path, in addition to the server's existing handler.
+func WithRoute(path string, handler http.Handler) HTTPOption {
+	return func(srv *HTTPServer) error {
+		mux := http.NewServeMux()
+		mux.Handle("/", srv.srv.Handler)
+		mux.Handle(path, handler)
+		srv.srv.Handler = mux
+		return nil
+	}
+}
@@ -98,3 +98,27 @@ func TestStartHTTPServer(t *testing.T) {
 		require.True(t, srv.Closed())
 	})
 }
+
+func TestWithRoute(t *testing.T) {
+	base := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	route := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	srv, err := StartHTTPServer("localhost:0", base, WithRoute("/readyz", route))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, srv.Close())
+	}()
+
+	resp, err := http.Get("http://" + srv.Addr().String() + "/metrics")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusTeapot, resp.StatusCode)
+
+	resp, err = http.Get("http://" + srv.Addr().String() + "/readyz")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
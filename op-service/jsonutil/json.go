@@ -1,16 +1,90 @@
 package jsonutil
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 )
 
+// Format is the encoding of a config file.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatTOML Format = "toml"
+	FormatYAML Format = "yaml"
+)
+
+// FormatFromPath detects the format of a file by its extension, ignoring a .gz suffix.
+// Files without a known extension are assumed to be JSON.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(path, ".gz"))) {
+	case ".toml":
+		return FormatTOML
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatJSON
+	}
+}
+
+func (f Format) decoder() (DecoderFactory, error) {
+	switch f {
+	case FormatJSON:
+		return newJSONDecoder, nil
+	case FormatTOML:
+		return newTOMLDecoder, nil
+	case FormatYAML:
+		return newYAMLDecoder, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", f)
+	}
+}
+
+func (f Format) encoder() (EncoderFactory, error) {
+	switch f {
+	case FormatJSON:
+		return newJSONEncoder, nil
+	case FormatTOML:
+		return newTOMLEncoder, nil
+	case FormatYAML:
+		return newYAMLEncoder, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", f)
+	}
+}
+
+// ToJSON converts the contents of a config file in the given format to JSON.
+// This allows types that only define JSON field names, or that are processed as raw JSON, to be read from any format.
+func ToJSON(data []byte, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return data, nil
+	case FormatTOML:
+		var v map[string]any
+		if err := newTOMLDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	case FormatYAML:
+		var v json.RawMessage
+		if err := newYAMLDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
 type Decoder interface {
 	Decode(v interface{}) error
 }
@@ -104,6 +178,20 @@ func LoadTOML[X any](inputPath string) (*X, error) {
 	return load[X](inputPath, newTOMLDecoder)
 }
 
+func LoadYAML[X any](inputPath string) (*X, error) {
+	return load[X](inputPath, newYAMLDecoder)
+}
+
+// Load reads a file in the format detected by FormatFromPath.
+// TOML is decoded with the toml field names of X, YAML with the JSON field names.
+func Load[X any](inputPath string) (*X, error) {
+	dec, err := FormatFromPath(inputPath).decoder()
+	if err != nil {
+		return nil, err
+	}
+	return load[X](inputPath, dec)
+}
+
 func load[X any](inputPath string, dec DecoderFactory) (*X, error) {
 	if inputPath == "" {
 		return nil, errors.New("no path specified")
@@ -129,6 +217,19 @@ func WriteTOML[X any](value X, target ioutil.OutputTarget) error {
 	return write(value, target, newTOMLEncoder)
 }
 
+func WriteYAML[X any](value X, target ioutil.OutputTarget) error {
+	return write(value, target, newYAMLEncoder)
+}
+
+// Write writes the value to the target in the given format.
+func Write[X any](value X, format Format, target ioutil.OutputTarget) error {
+	enc, err := format.encoder()
+	if err != nil {
+		return err
+	}
+	return write(value, target, enc)
+}
+
 func write[X any](value X, target ioutil.OutputTarget, enc EncoderFactory) error {
 	out, closer, abort, err := target()
 	if err != nil {
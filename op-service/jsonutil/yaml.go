@@ -0,0 +1,232 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML is converted from and to JSON, rather than decoded into the target type directly,
+// so the JSON field names and custom JSON (un)marshalers of the config types apply to YAML too.
+
+type yamlDecoder struct {
+	d *yaml.Decoder
+}
+
+func newYAMLDecoder(r io.Reader) Decoder {
+	return &yamlDecoder{
+		d: yaml.NewDecoder(r),
+	}
+}
+
+func (d *yamlDecoder) Decode(v interface{}) error {
+	var doc yaml.Node
+	if err := d.d.Decode(&doc); err != nil {
+		return fmt.Errorf("failed to decode YAML: %w", err)
+	}
+	var extra yaml.Node
+	if err := d.d.Decode(&extra); err != io.EOF {
+		return errors.New("unexpected trailing data")
+	}
+	data, err := yamlNodeToJSON(&doc)
+	if err != nil {
+		return fmt.Errorf("failed to convert YAML to JSON: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode YAML: %w", err)
+	}
+	return nil
+}
+
+type yamlEncoder struct {
+	w io.Writer
+}
+
+func newYAMLEncoder(w io.Writer) Encoder {
+	return &yamlEncoder{
+		w: w,
+	}
+}
+
+func (e *yamlEncoder) Encode(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	node, err := jsonToYAMLNode(dec)
+	if err != nil {
+		return fmt.Errorf("failed to convert JSON to YAML: %w", err)
+	}
+	enc := yaml.NewEncoder(e.w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return enc.Close()
+}
+
+var yamlIntegerRegex = regexp.MustCompile(`^[-+]?[0-9_]+$`)
+
+func yamlNodeToJSON(node *yaml.Node) ([]byte, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return []byte("null"), nil
+		}
+		return yamlNodeToJSON(node.Content[0])
+	case yaml.AliasNode:
+		return yamlNodeToJSON(node.Alias)
+	case yaml.MappingNode:
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: unsupported non-scalar mapping key", key.Line)
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, err := json.Marshal(key.Value)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(k)
+			buf.WriteByte(':')
+			v, err := yamlNodeToJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(v)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	case yaml.SequenceNode:
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			v, err := yamlNodeToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(v)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	case yaml.ScalarNode:
+		return yamlScalarToJSON(node)
+	default:
+		return nil, fmt.Errorf("line %d: unsupported YAML node kind %d", node.Line, node.Kind)
+	}
+}
+
+func yamlScalarToJSON(node *yaml.Node) ([]byte, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return []byte("null"), nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		return json.Marshal(b)
+	case "!!int":
+		// Integers are kept as arbitrary precision numbers, YAML allows 0x and 0o prefixes and underscores.
+		n, ok := new(big.Int).SetString(strings.ReplaceAll(node.Value, "_", ""), 0)
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid integer %q", node.Line, node.Value)
+		}
+		return []byte(n.String()), nil
+	case "!!float":
+		// Integers that exceed 64 bits are resolved as floats by the YAML parser, but must not lose precision.
+		if yamlIntegerRegex.MatchString(node.Value) {
+			n, ok := new(big.Int).SetString(strings.ReplaceAll(node.Value, "_", ""), 10)
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid integer %q", node.Line, node.Value)
+			}
+			return []byte(n.String()), nil
+		}
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		return json.Marshal(f)
+	default:
+		return json.Marshal(node.Value)
+	}
+}
+
+func jsonToYAMLNode(dec *json.Decoder) (*yaml.Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := tok.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, ok := keyTok.(string)
+				if !ok {
+					return nil, fmt.Errorf("unexpected object key %v", keyTok)
+				}
+				value, err := jsonToYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+			}
+			if _, err := dec.Token(); err != nil { // consume '}'
+				return nil, err
+			}
+			return node, nil
+		case '[':
+			node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			for dec.More() {
+				value, err := jsonToYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, value)
+			}
+			if _, err := dec.Token(); err != nil { // consume ']'
+				return nil, err
+			}
+			return node, nil
+		default:
+			return nil, fmt.Errorf("unexpected delimiter %v", v)
+		}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}, nil
+	case json.Number:
+		tag := "!!int"
+		if _, err := v.Int64(); err != nil && !yamlIntegerRegex.MatchString(v.String()) {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}, nil
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON token %v", tok)
+	}
+}
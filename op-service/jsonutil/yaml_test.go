@@ -0,0 +1,140 @@
+package jsonutil
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+type yamlTestData struct {
+	Address  common.Address  `json:"address"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+	Balance  *big.Int        `json:"balance"`
+	Time     uint64          `json:"time"`
+	Ratio    float64         `json:"ratio"`
+	Enabled  bool            `json:"enabled"`
+	Optional *uint64         `json:"optional"`
+	Names    []string        `json:"names"`
+	Nested   *jsonTestData   `json:"nested"`
+	Data     hexutil.Bytes   `json:"data"`
+	Extra    map[string]uint `json:"extra"`
+}
+
+func newYAMLTestData() *yamlTestData {
+	balance, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	return &yamlTestData{
+		Address: common.Address{0xaa, 0xbb},
+		BaseFee: (*hexutil.Big)(big.NewInt(1_000_000_000)),
+		Balance: balance,
+		Time:    1700000000,
+		Ratio:   0.5,
+		Enabled: true,
+		Names:   []string{"true", "0x10", "null", "yes"},
+		Nested:  &jsonTestData{A: "yay", B: 3},
+		Data:    hexutil.Bytes{0x01, 0x02},
+		Extra:   map[string]uint{"a": 1},
+	}
+}
+
+func TestRoundTripYAML(t *testing.T) {
+	for _, name := range []string{"test.yaml", "test.yml", "test.yaml.gz"} {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), name)
+			data := newYAMLTestData()
+			require.NoError(t, WriteYAML(data, ioutil.ToAtomicFile(file, 0o755)))
+
+			result, err := LoadYAML[yamlTestData](file)
+			require.NoError(t, err)
+			require.EqualValues(t, data, result)
+
+			result, err = Load[yamlTestData](file)
+			require.NoError(t, err)
+			require.EqualValues(t, data, result)
+		})
+	}
+}
+
+func TestLoadYAML(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.yaml")
+	content := `
+address: "0xaabb000000000000000000000000000000000000"
+baseFee: "0x3b9aca00"
+balance: 123456789012345678901234567890
+time: 0x10
+ratio: 1.5
+enabled: true
+optional: null
+names: [a, b]
+nested: &nested
+  a: yay
+  b: 1_000
+`
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	result, err := Load[yamlTestData](file)
+	require.NoError(t, err)
+	balance, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.Equal(t, common.Address{0xaa, 0xbb}, result.Address)
+	require.Equal(t, uint64(1_000_000_000), result.BaseFee.ToInt().Uint64())
+	require.Equal(t, balance, result.Balance)
+	require.Equal(t, uint64(16), result.Time)
+	require.Equal(t, 1.5, result.Ratio)
+	require.True(t, result.Enabled)
+	require.Nil(t, result.Optional)
+	require.Equal(t, []string{"a", "b"}, result.Names)
+	require.Equal(t, &jsonTestData{A: "yay", B: 1000}, result.Nested)
+}
+
+func TestLoadYAMLWithTrailingDocument(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("a: yay\n---\na: nay\n"), 0o644))
+	_, err := Load[jsonTestData](file)
+	require.ErrorContains(t, err, "unexpected trailing data")
+}
+
+func TestFormatFromPath(t *testing.T) {
+	require.Equal(t, FormatJSON, FormatFromPath("config.json"))
+	require.Equal(t, FormatJSON, FormatFromPath("config.json.gz"))
+	require.Equal(t, FormatJSON, FormatFromPath("config"))
+	require.Equal(t, FormatJSON, FormatFromPath("-"))
+	require.Equal(t, FormatTOML, FormatFromPath("config.toml"))
+	require.Equal(t, FormatYAML, FormatFromPath("config.yaml"))
+	require.Equal(t, FormatYAML, FormatFromPath("CONFIG.YML.gz"))
+}
+
+func TestWriteFormats(t *testing.T) {
+	dir := t.TempDir()
+	data := &jsonTestData{A: "yay", B: 3}
+	for _, format := range []Format{FormatJSON, FormatTOML, FormatYAML} {
+		file := filepath.Join(dir, "test."+string(format))
+		require.NoError(t, Write(data, format, ioutil.ToAtomicFile(file, 0o755)))
+		result, err := Load[jsonTestData](file)
+		require.NoError(t, err)
+		require.EqualValues(t, data, result)
+	}
+	require.ErrorContains(t, Write(data, Format("xml"), ioutil.NoOutputStream()), "unsupported format")
+}
+
+func TestToJSON(t *testing.T) {
+	expected := `{"a":"yay","b":3}`
+	out, err := ToJSON([]byte(expected), FormatJSON)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(out))
+
+	out, err = ToJSON([]byte("a = \"yay\"\nb = 3\n"), FormatTOML)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(out))
+
+	out, err = ToJSON([]byte("a: yay\nb: 3\n"), FormatYAML)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(out))
+
+	_, err = ToJSON([]byte("a: [\n"), FormatYAML)
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package locks
+
+import "sync"
+
+// KeyedMutex is a set of mutexes, one per key.
+// The mutex of a key is created when first locked, and removed once it is no longer held or awaited,
+// so the set does not grow with every key ever used.
+// The zero value is ready to use.
+type KeyedMutex[K comparable] struct {
+	mu    sync.Mutex // only protects the map
+	locks map[K]*keyedLock
+}
+
+type keyedLock struct {
+	mu sync.Mutex
+	// refs is the number of routines holding or awaiting the lock
+	refs int
+}
+
+// Lock locks the mutex of the given key, and returns the function to unlock it again.
+// The unlock function must be called exactly once.
+func (m *KeyedMutex[K]) Lock(key K) (unlock func()) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[K]*keyedLock)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = new(keyedLock)
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
+
+// Len returns the number of keys that are currently locked or awaited.
+func (m *KeyedMutex[K]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.locks)
+}
@@ -0,0 +1,52 @@
+package locks
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	t.Run("exclusive per key", func(t *testing.T) {
+		var m KeyedMutex[string]
+		unlockA := m.Lock("a")
+		unlockB := m.Lock("b") // other keys are not blocked
+		require.Equal(t, 2, m.Len())
+
+		acquired := make(chan struct{})
+		go func() {
+			unlock := m.Lock("a")
+			close(acquired)
+			unlock()
+		}()
+		select {
+		case <-acquired:
+			t.Fatal("lock of key a acquired twice")
+		case <-time.After(50 * time.Millisecond):
+		}
+		unlockA()
+		<-acquired
+		unlockB()
+		require.Eventually(t, func() bool { return m.Len() == 0 }, time.Second, time.Millisecond)
+	})
+
+	t.Run("serializes same key", func(t *testing.T) {
+		var m KeyedMutex[int]
+		var wg sync.WaitGroup
+		count := 0
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				unlock := m.Lock(1)
+				defer unlock()
+				count++
+			}()
+		}
+		wg.Wait()
+		require.Equal(t, 100, count)
+		require.Equal(t, 0, m.Len())
+	})
+}
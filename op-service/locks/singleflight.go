@@ -0,0 +1,81 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// SingleFlight de-duplicates concurrent calls by key:
+// while a call for a key is in flight, other calls for the same key wait for, and share, its result.
+// Results are not retained once the call completes, and errors are shared like any other result.
+// The zero value is ready to use.
+type SingleFlight[K comparable, V any] struct {
+	mu    sync.Mutex // only protects the map
+	calls map[K]*flight[V]
+}
+
+type flight[V any] struct {
+	done chan struct{}
+	// dups is the number of other callers waiting for the result
+	dups int
+	val  V
+	err  error
+}
+
+// Do calls fn, unless a call for the same key is already in flight, in which case it waits for the result of that call.
+// Waiting for another call stops when ctx is done, without affecting the call itself.
+// The returned bool is true if the result was shared with, or by, another caller.
+func (s *SingleFlight[K, V]) Do(ctx context.Context, key K, fn func() (V, error)) (V, bool, error) {
+	s.mu.Lock()
+	if s.calls == nil {
+		s.calls = make(map[K]*flight[V])
+	}
+	if f, ok := s.calls[key]; ok {
+		f.dups++
+		s.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.val, true, f.err
+		case <-ctx.Done():
+			var v V
+			return v, false, ctx.Err()
+		}
+	}
+	f := &flight[V]{done: make(chan struct{})}
+	s.calls[key] = f
+	s.mu.Unlock()
+
+	s.run(key, f, fn)
+	return f.val, f.dups > 0, f.err
+}
+
+func (s *SingleFlight[K, V]) run(key K, f *flight[V], fn func() (V, error)) {
+	defer func() {
+		// waiters must never be stuck on a call that panicked
+		if r := recover(); r != nil {
+			f.err = fmt.Errorf("call panicked: %v", r)
+			s.complete(key, f)
+			panic(r)
+		}
+		s.complete(key, f)
+	}()
+	f.val, f.err = fn()
+}
+
+// waiters returns the number of callers waiting for the in-flight call of the key.
+func (s *SingleFlight[K, V]) waiters(key K) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f, ok := s.calls[key]; ok {
+		return f.dups
+	}
+	return 0
+}
+
+func (s *SingleFlight[K, V]) complete(key K, f *flight[V]) {
+	s.mu.Lock()
+	delete(s.calls, key)
+	s.mu.Unlock()
+	close(f.done)
+}
@@ -0,0 +1,105 @@
+package locks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleFlight(t *testing.T) {
+	t.Run("shares result of concurrent calls", func(t *testing.T) {
+		var s SingleFlight[string, int]
+		var calls atomic.Int32
+		release := make(chan struct{})
+		started := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, shared, err := s.Do(context.Background(), "a", func() (int, error) {
+				calls.Add(1)
+				close(started)
+				<-release
+				return 42, nil
+			})
+			require.NoError(t, err)
+			require.True(t, shared)
+			require.Equal(t, 42, v)
+		}()
+		<-started
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, shared, err := s.Do(context.Background(), "a", func() (int, error) {
+					calls.Add(1)
+					return 0, errors.New("should not be called")
+				})
+				require.NoError(t, err)
+				require.True(t, shared)
+				require.Equal(t, 42, v)
+			}()
+		}
+		require.Eventually(t, func() bool { return s.waiters("a") == 10 }, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+		require.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("does not retain results", func(t *testing.T) {
+		var s SingleFlight[string, int]
+		for i := 0; i < 3; i++ {
+			i := i
+			v, shared, err := s.Do(context.Background(), "a", func() (int, error) { return i, nil })
+			require.NoError(t, err)
+			require.False(t, shared)
+			require.Equal(t, i, v)
+		}
+	})
+
+	t.Run("shares errors", func(t *testing.T) {
+		var s SingleFlight[string, int]
+		expected := errors.New("boom")
+		_, _, err := s.Do(context.Background(), "a", func() (int, error) { return 0, expected })
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("waiting stops on context done", func(t *testing.T) {
+		var s SingleFlight[string, int]
+		release := make(chan struct{})
+		started := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _, _ = s.Do(context.Background(), "a", func() (int, error) {
+				close(started)
+				<-release
+				return 1, nil
+			})
+		}()
+		<-started
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := s.Do(ctx, "a", func() (int, error) { return 2, nil })
+		require.ErrorIs(t, err, context.Canceled)
+		close(release)
+		<-done
+	})
+
+	t.Run("panic completes call", func(t *testing.T) {
+		var s SingleFlight[string, int]
+		require.Panics(t, func() {
+			_, _, _ = s.Do(context.Background(), "a", func() (int, error) { panic("boom") })
+		})
+		v, shared, err := s.Do(context.Background(), "a", func() (int, error) { return 3, nil })
+		require.NoError(t, err)
+		require.False(t, shared)
+		require.Equal(t, 3, v)
+	})
+}
@@ -0,0 +1,67 @@
+package locks
+
+import (
+	"context"
+	"sync"
+)
+
+// WorkGroup runs functions concurrently, with at most a limited number running at once.
+// Like errgroup.Group, the first error is returned by Wait and cancels the context of the group.
+// Unlike errgroup.Group, waiting for a free slot stops when the context of the group is done,
+// so no more work is started after a failure or shutdown.
+type WorkGroup struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	slots  chan struct{}
+	wg     sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// NewWorkGroup creates a work group that runs at most limit functions at once, or any number if limit is not positive.
+// The returned context is derived from ctx, and is canceled when a function fails, or when Wait returns.
+func NewWorkGroup(ctx context.Context, limit int) (*WorkGroup, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &WorkGroup{ctx: ctx, cancel: cancel}
+	if limit > 0 {
+		g.slots = make(chan struct{}, limit)
+	}
+	return g, ctx
+}
+
+// Go waits for a free slot, and runs fn in a new goroutine.
+// If the context of the group is done before a slot frees up, fn is not run, and the context error is returned.
+func (g *WorkGroup) Go(fn func() error) error {
+	if err := g.ctx.Err(); err != nil {
+		return err
+	}
+	if g.slots != nil {
+		select {
+		case g.slots <- struct{}{}:
+		case <-g.ctx.Done():
+			return g.ctx.Err()
+		}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.slots != nil {
+			defer func() { <-g.slots }()
+		}
+		if err := fn(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel(err)
+			})
+		}
+	}()
+	return nil
+}
+
+// Wait waits for all started functions to complete, and returns the first error, if any.
+func (g *WorkGroup) Wait() error {
+	g.wg.Wait()
+	g.cancel(nil)
+	return g.err
+}
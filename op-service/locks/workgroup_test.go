@@ -0,0 +1,80 @@
+package locks
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkGroup(t *testing.T) {
+	t.Run("limits concurrency", func(t *testing.T) {
+		g, _ := NewWorkGroup(context.Background(), 3)
+		var running, maxRunning atomic.Int32
+		for i := 0; i < 50; i++ {
+			require.NoError(t, g.Go(func() error {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				return nil
+			}))
+		}
+		require.NoError(t, g.Wait())
+		require.LessOrEqual(t, maxRunning.Load(), int32(3))
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		g, _ := NewWorkGroup(context.Background(), 0)
+		release := make(chan struct{})
+		var started atomic.Int32
+		for i := 0; i < 10; i++ {
+			require.NoError(t, g.Go(func() error {
+				started.Add(1)
+				<-release
+				return nil
+			}))
+		}
+		require.Eventually(t, func() bool { return started.Load() == 10 }, time.Second, time.Millisecond)
+		close(release)
+		require.NoError(t, g.Wait())
+	})
+
+	t.Run("first error cancels", func(t *testing.T) {
+		g, ctx := NewWorkGroup(context.Background(), 1)
+		first := errors.New("first")
+		require.NoError(t, g.Go(func() error { return first }))
+		<-ctx.Done()
+		require.ErrorIs(t, g.Go(func() error { return nil }), context.Canceled)
+		require.ErrorIs(t, g.Wait(), first)
+		require.ErrorIs(t, context.Cause(ctx), first)
+	})
+
+	t.Run("parent context done", func(t *testing.T) {
+		parent, cancel := context.WithCancel(context.Background())
+		g, _ := NewWorkGroup(parent, 1)
+		release := make(chan struct{})
+		require.NoError(t, g.Go(func() error {
+			<-release
+			return nil
+		}))
+		cancel()
+		require.ErrorIs(t, g.Go(func() error { return nil }), context.Canceled)
+		close(release)
+		require.NoError(t, g.Wait())
+	})
+
+	t.Run("wait cancels context", func(t *testing.T) {
+		g, ctx := NewWorkGroup(context.Background(), 1)
+		require.NoError(t, g.Go(func() error { return nil }))
+		require.NoError(t, g.Wait())
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const accountMonitorInterval = 10 * time.Second
+
+// AccountClient is the client the AccountMonitor queries the account state with.
+type AccountClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// AccountMonitor records the balance, nonce, number of pending transactions and spending of the account
+// a service sends transactions from. The metrics are labeled by account, so they can be aggregated across services.
+type AccountMonitor struct {
+	log     log.Logger
+	client  AccountClient
+	account common.Address
+
+	balance       prometheus.Gauge
+	legacyBalance prometheus.Gauge
+	nonce         prometheus.Gauge
+	pendingTxs    prometheus.Gauge
+	spend         prometheus.Observer
+
+	lastBalance *big.Int
+}
+
+// NewAccountMonitor creates an AccountMonitor that records the metrics to the namespace.
+// The balance is also recorded to the unlabeled "balance" metric, for compatibility with existing dashboards.
+func NewAccountMonitor(log log.Logger, r *prometheus.Registry, ns string, client AccountClient, account common.Address) *AccountMonitor {
+	labels := prometheus.Labels{"account": account.Hex()}
+	return &AccountMonitor{
+		log:     log,
+		client:  client,
+		account: account,
+		balance: promauto.With(r).NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "account_balance",
+			Help:      "Balance (in ether) of the account",
+		}, []string{"account"}).With(labels),
+		legacyBalance: promauto.With(r).NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "balance",
+			Help:      "balance (in ether) of account " + account.String(),
+		}),
+		nonce: promauto.With(r).NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "account_nonce",
+			Help:      "Nonce of the account at the latest block",
+		}, []string{"account"}).With(labels),
+		pendingTxs: promauto.With(r).NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "account_pending_txs",
+			Help:      "Number of transactions of the account that are pending in the mempool",
+		}, []string{"account"}).With(labels),
+		spend: promauto.With(r).NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "account_spend",
+			Help:      "Decrease (in ether) of the balance of the account between two queries. The rate of the sum is the spend rate.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"account"}).With(labels),
+	}
+}
+
+// Update queries the state of the account and records it.
+// It is not safe for concurrent use, as it tracks the balance of the previous update to record the spending.
+func (m *AccountMonitor) Update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	if balance, err := m.client.BalanceAt(ctx, m.account, nil); err != nil {
+		m.log.Warn("Failed to get balance of account", "err", err, "address", m.account)
+	} else {
+		bal := eth.WeiToEther(balance)
+		m.balance.Set(bal)
+		m.legacyBalance.Set(bal)
+		// Top-ups increase the balance, and are not counted as spending.
+		if m.lastBalance != nil && balance.Cmp(m.lastBalance) < 0 {
+			m.spend.Observe(eth.WeiToEther(new(big.Int).Sub(m.lastBalance, balance)))
+		}
+		m.lastBalance = balance
+	}
+
+	nonce, err := m.client.NonceAt(ctx, m.account, nil)
+	if err != nil {
+		m.log.Warn("Failed to get nonce of account", "err", err, "address", m.account)
+		return
+	}
+	m.nonce.Set(float64(nonce))
+	pendingNonce, err := m.client.PendingNonceAt(ctx, m.account)
+	if err != nil {
+		m.log.Warn("Failed to get pending nonce of account", "err", err, "address", m.account)
+		return
+	}
+	var pending uint64
+	if pendingNonce > nonce {
+		pending = pendingNonce - nonce
+	}
+	m.pendingTxs.Set(float64(pending))
+}
+
+// LaunchAccountMonitor starts a periodic update of an AccountMonitor of the account.
+// Close the returned loop to shut it down.
+func LaunchAccountMonitor(log log.Logger, r *prometheus.Registry, ns string, client AccountClient, account common.Address) *clock.LoopFn {
+	m := NewAccountMonitor(log, r, ns, client, account)
+	return clock.NewLoopFn(clock.SystemClock, m.Update, func() error {
+		log.Info("Account monitor shutting down")
+		return nil
+	}, accountMonitorInterval)
+}
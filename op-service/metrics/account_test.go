@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubAccountClient struct {
+	balance      *big.Int
+	nonce        uint64
+	pendingNonce uint64
+	err          error
+}
+
+func (s *stubAccountClient) BalanceAt(context.Context, common.Address, *big.Int) (*big.Int, error) {
+	return s.balance, s.err
+}
+
+func (s *stubAccountClient) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return s.nonce, s.err
+}
+
+func (s *stubAccountClient) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return s.pendingNonce, s.err
+}
+
+func TestAccountMonitor(t *testing.T) {
+	account := common.Address{0xaa}
+	client := &stubAccountClient{balance: big.NewInt(3 * params.Ether), nonce: 5, pendingNonce: 7}
+	r := prometheus.NewRegistry()
+	m := NewAccountMonitor(testlog.Logger(t, log.LevelInfo), r, "test", client, account)
+
+	m.Update(context.Background())
+	require.Equal(t, 3.0, gatherMetric(t, r, "test_account_balance").GetGauge().GetValue())
+	require.Equal(t, 3.0, gatherMetric(t, r, "test_balance").GetGauge().GetValue())
+	require.Equal(t, 5.0, gatherMetric(t, r, "test_account_nonce").GetGauge().GetValue())
+	require.Equal(t, 2.0, gatherMetric(t, r, "test_account_pending_txs").GetGauge().GetValue())
+	require.Equal(t, account.Hex(), gatherMetric(t, r, "test_account_balance").GetLabel()[0].GetValue())
+
+	// Spending is recorded when the balance decreases.
+	client.balance = big.NewInt(2 * params.Ether)
+	client.nonce = 7
+	m.Update(context.Background())
+	require.Equal(t, 2.0, gatherMetric(t, r, "test_account_balance").GetGauge().GetValue())
+	require.Equal(t, 0.0, gatherMetric(t, r, "test_account_pending_txs").GetGauge().GetValue())
+	spend := gatherMetric(t, r, "test_account_spend").GetHistogram()
+	require.Equal(t, uint64(1), spend.GetSampleCount())
+	require.Equal(t, 1.0, spend.GetSampleSum())
+
+	// Top-ups are not spending.
+	client.balance = big.NewInt(5 * params.Ether)
+	m.Update(context.Background())
+	require.Equal(t, 5.0, gatherMetric(t, r, "test_account_balance").GetGauge().GetValue())
+	require.Equal(t, uint64(1), gatherMetric(t, r, "test_account_spend").GetHistogram().GetSampleCount())
+
+	// Failures leave the metrics unchanged.
+	client.err = errors.New("boom")
+	m.Update(context.Background())
+	require.Equal(t, 5.0, gatherMetric(t, r, "test_account_balance").GetGauge().GetValue())
+}
+
+func gatherMetric(t *testing.T, r *prometheus.Registry, name string) *dto.Metric {
+	families, err := r.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == name {
+			require.Len(t, family.GetMetric(), 1)
+			return family.GetMetric()[0]
+		}
+	}
+	t.Fatalf("metric %s not found", name)
+	return nil
+}
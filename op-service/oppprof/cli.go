@@ -18,8 +18,15 @@ const (
 	PortFlagName        = "pprof.port"
 	ProfileTypeFlagName = "pprof.type"
 	ProfilePathFlagName = "pprof.path"
-	defaultListenAddr   = "0.0.0.0"
-	defaultListenPort   = 6060
+
+	UploadURLFlagName          = "pprof.upload.url"
+	UploadIntervalFlagName     = "pprof.upload.interval"
+	UploadCPUDurationFlagName  = "pprof.upload.cpu-duration"
+	UploadProfileTypesFlagName = "pprof.upload.types"
+	UploadLabelsFlagName       = "pprof.upload.labels"
+
+	defaultListenAddr = "0.0.0.0"
+	defaultListenPort = 6060
 )
 
 var ErrInvalidPort = errors.New("invalid pprof port")
@@ -58,6 +65,11 @@ func DefaultCLIConfig() CLIConfig {
 		ListenEnabled: false,
 		ListenAddr:    defaultListenAddr,
 		ListenPort:    defaultListenPort,
+		Upload: UploadConfig{
+			Interval:     defaultUploadInterval,
+			CPUDuration:  defaultUploadCPUDuration,
+			ProfileTypes: defaultUploadProfileTypes,
+		},
 	}
 }
 
@@ -104,6 +116,39 @@ func CLIFlagsWithCategory(envPrefix string, category string) []cli.Flag {
 			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_TYPE"),
 			Category: category,
 		},
+		&cli.StringFlag{
+			Name:     UploadURLFlagName,
+			Usage:    "URL to periodically upload profiles to, e.g. of an object store or continuous profiling backend. Disabled if empty",
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_UPLOAD_URL"),
+			Category: category,
+		},
+		&cli.DurationFlag{
+			Name:     UploadIntervalFlagName,
+			Usage:    "Interval between profile uploads",
+			Value:    defaultUploadInterval,
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_UPLOAD_INTERVAL"),
+			Category: category,
+		},
+		&cli.DurationFlag{
+			Name:     UploadCPUDurationFlagName,
+			Usage:    "Duration of each uploaded CPU profile",
+			Value:    defaultUploadCPUDuration,
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_UPLOAD_CPU_DURATION"),
+			Category: category,
+		},
+		&cli.StringSliceFlag{
+			Name:     UploadProfileTypesFlagName,
+			Usage:    "Profile types to upload. Any of " + openum.EnumString(allowedProfileTypes),
+			Value:    cli.NewStringSlice(defaultUploadProfileTypes...),
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_UPLOAD_TYPES"),
+			Category: category,
+		},
+		&cli.StringSliceFlag{
+			Name:     UploadLabelsFlagName,
+			Usage:    "Additional labels of uploaded profiles, in the format key=value",
+			EnvVars:  opservice.PrefixEnvVar(envPrefix, "PPROF_UPLOAD_LABELS"),
+			Category: category,
+		},
 	}
 }
 
@@ -115,9 +160,18 @@ type CLIConfig struct {
 	ProfileType     profileType
 	ProfileDir      string
 	ProfileFilename string
+
+	Upload UploadConfig
 }
 
 func (m CLIConfig) Check() error {
+	if err := m.Upload.Check(); err != nil {
+		return err
+	}
+	if m.Upload.Enabled() && m.ProfileType == "cpu" && m.Upload.hasProfileType("cpu") {
+		return errors.New("cannot upload cpu profiles while writing a cpu profile to file")
+	}
+
 	if !m.ListenEnabled {
 		return nil
 	}
@@ -138,5 +192,25 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		ProfileType:     profileType(strings.ToLower(ctx.String(ProfileTypeFlagName))),
 		ProfileDir:      profilePathFlag.Dir(),
 		ProfileFilename: profilePathFlag.Filename(),
+		Upload: UploadConfig{
+			URL:          ctx.String(UploadURLFlagName),
+			Interval:     ctx.Duration(UploadIntervalFlagName),
+			CPUDuration:  ctx.Duration(UploadCPUDurationFlagName),
+			ProfileTypes: ctx.StringSlice(UploadProfileTypesFlagName),
+			Labels:       parseLabels(ctx.StringSlice(UploadLabelsFlagName)),
+		},
+	}
+}
+
+// parseLabels parses key=value labels. Labels without a value are kept with an empty value, to be rejected by the config check.
+func parseLabels(values []string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(values))
+	for _, v := range values {
+		key, value, _ := strings.Cut(v, "=")
+		labels[key] = value
 	}
+	return labels
 }
@@ -27,6 +27,7 @@ type Service struct {
 
 	cpuFile    io.Closer
 	httpServer *httputil.HTTPServer
+	uploader   *uploader
 }
 
 func New(listenEnabled bool, listenAddr string, listenPort int, profType profileType, profileDir, profileFilename string) *Service {
@@ -40,6 +41,15 @@ func New(listenEnabled bool, listenAddr string, listenPort int, profType profile
 	}
 }
 
+// EnableUpload configures the service to periodically capture profiles and upload them once started.
+// The profiles are labelled with the service name and version.
+func (s *Service) EnableUpload(cfg UploadConfig, service string, version string) {
+	if !cfg.Enabled() {
+		return
+	}
+	s.uploader = newUploader(log.Root().New("service", service), cfg, NewHTTPSink(cfg.URL), service, version)
+}
+
 func (s *Service) Start() error {
 	switch s.profileType {
 	case "cpu":
@@ -56,6 +66,16 @@ func (s *Service) Start() error {
 			return err
 		}
 	}
+	if s.uploader != nil {
+		if s.uploader.cfg.hasProfileType("block") {
+			runtime.SetBlockProfileRate(1)
+		}
+		if s.uploader.cfg.hasProfileType("mutex") {
+			runtime.SetMutexProfileFraction(1)
+		}
+		s.uploader.start()
+		log.Info("started pprof uploader", "url", s.uploader.cfg.RedactedURL(), "interval", s.uploader.cfg.Interval, "profile_types", s.uploader.cfg.ProfileTypes)
+	}
 	if s.profileType != "" {
 		log.Info("start profiling to file", "profile_type", s.profileType, "profile_filepath", s.buildTargetFilePath())
 	}
@@ -63,6 +83,9 @@ func (s *Service) Start() error {
 }
 
 func (s *Service) Stop(ctx context.Context) error {
+	if s.uploader != nil {
+		s.uploader.stop()
+	}
 	switch s.profileType {
 	case "cpu":
 		pprof.StopCPUProfile()
@@ -0,0 +1,260 @@
+package oppprof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime/pprof"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	defaultUploadInterval    = time.Minute
+	defaultUploadCPUDuration = 10 * time.Second
+)
+
+var defaultUploadProfileTypes = []string{"cpu", "heap"}
+
+type UploadConfig struct {
+	// URL to upload profiles to. Uploads are disabled if empty.
+	URL          string
+	Interval     time.Duration
+	CPUDuration  time.Duration
+	ProfileTypes []string
+	// Labels are added to every uploaded profile, in addition to the service and version labels.
+	Labels map[string]string
+}
+
+func (c UploadConfig) Enabled() bool {
+	return c.URL != ""
+}
+
+// RedactedURL returns the upload URL with the password of any basic auth credentials redacted, for logging.
+func (c UploadConfig) RedactedURL() string {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return "<invalid url>"
+	}
+	return u.Redacted()
+}
+
+func (c UploadConfig) Check() error {
+	if !c.Enabled() {
+		return nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid pprof upload url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported pprof upload url scheme: %q", u.Scheme)
+	}
+	if c.Interval <= 0 {
+		return errors.New("pprof upload interval must be positive")
+	}
+	if len(c.ProfileTypes) == 0 {
+		return errors.New("no pprof upload profile types")
+	}
+	for _, t := range c.ProfileTypes {
+		if !validProfileType(profileType(t)) {
+			return fmt.Errorf("unknown pprof upload profile type: %q", t)
+		}
+		if t == "cpu" && (c.CPUDuration <= 0 || c.CPUDuration > c.Interval) {
+			return fmt.Errorf("pprof upload cpu duration must be positive and at most the upload interval (%v)", c.Interval)
+		}
+	}
+	for k, v := range c.Labels {
+		if k == "" || v == "" {
+			return fmt.Errorf("invalid pprof upload label %q=%q", k, v)
+		}
+	}
+	return nil
+}
+
+func (c UploadConfig) hasProfileType(t string) bool {
+	for _, p := range c.ProfileTypes {
+		if p == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CapturedProfile is a profile of the running process, captured to be uploaded.
+type CapturedProfile struct {
+	Type   string
+	Start  time.Time
+	End    time.Time
+	Labels map[string]string
+	// Data is the gzip compressed protobuf encoding of the profile.
+	Data []byte
+}
+
+// ProfileSink stores captured profiles, e.g. in an object store or a continuous profiling backend.
+type ProfileSink interface {
+	Upload(ctx context.Context, p *CapturedProfile) error
+}
+
+// HTTPSink uploads profiles with a POST request to the URL. The profile type, capture window and labels
+// are added as query parameters. Credentials for basic auth may be included in the URL.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{url: url, client: &http.Client{Timeout: time.Minute}}
+}
+
+func (h *HTTPSink) Upload(ctx context.Context, p *CapturedProfile) error {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return err
+	}
+	q := u.Query()
+	for k, v := range p.Labels {
+		q.Set(k, v)
+	}
+	q.Set("type", p.Type)
+	q.Set("from", strconv.FormatInt(p.Start.Unix(), 10))
+	q.Set("until", strconv.FormatInt(p.End.Unix(), 10))
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(p.Data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// uploader periodically captures profiles and uploads them to the sink.
+type uploader struct {
+	log    log.Logger
+	cfg    UploadConfig
+	sink   ProfileSink
+	labels map[string]string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newUploader(logger log.Logger, cfg UploadConfig, sink ProfileSink, service string, version string) *uploader {
+	labels := make(map[string]string, len(cfg.Labels)+2)
+	for k, v := range cfg.Labels {
+		labels[k] = v
+	}
+	labels["service"] = service
+	labels["version"] = version
+	ctx, cancel := context.WithCancel(context.Background())
+	return &uploader{
+		log:    logger,
+		cfg:    cfg,
+		sink:   sink,
+		labels: labels,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (u *uploader) start() {
+	u.wg.Add(1)
+	go u.loop()
+}
+
+func (u *uploader) stop() {
+	u.cancel()
+	u.wg.Wait()
+}
+
+func (u *uploader) loop() {
+	defer u.wg.Done()
+	ticker := time.NewTicker(u.cfg.Interval)
+	defer ticker.Stop()
+	last := time.Now()
+	for {
+		select {
+		case <-u.ctx.Done():
+			return
+		case now := <-ticker.C:
+			u.captureAndUpload(last, now)
+			last = now
+		}
+	}
+}
+
+// captureAndUpload captures each of the configured profile types and uploads them.
+// Profiles other than the CPU profile cover the time since the previous upload.
+func (u *uploader) captureAndUpload(from time.Time, until time.Time) {
+	for _, t := range u.cfg.ProfileTypes {
+		var p *CapturedProfile
+		var err error
+		if t == "cpu" {
+			p, err = u.captureCPU()
+		} else {
+			p, err = u.capture(t, from, until)
+		}
+		if u.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			u.log.Warn("Failed to capture profile", "profile_type", t, "err", err)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(u.ctx, u.cfg.Interval)
+		err = u.sink.Upload(ctx, p)
+		cancel()
+		if err != nil {
+			u.log.Warn("Failed to upload profile", "profile_type", t, "err", err)
+			continue
+		}
+		u.log.Debug("Uploaded profile", "profile_type", t, "size", len(p.Data))
+	}
+}
+
+func (u *uploader) captureCPU() (*CapturedProfile, error) {
+	var buf bytes.Buffer
+	start := time.Now()
+	// Fails if a CPU profile is already being collected, e.g. through the pprof server
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+	timer := time.NewTimer(u.cfg.CPUDuration)
+	select {
+	case <-u.ctx.Done():
+	case <-timer.C:
+	}
+	timer.Stop()
+	pprof.StopCPUProfile()
+	return &CapturedProfile{Type: "cpu", Start: start, End: time.Now(), Labels: u.labels, Data: buf.Bytes()}, nil
+}
+
+func (u *uploader) capture(t string, from time.Time, until time.Time) (*CapturedProfile, error) {
+	profile := pprof.Lookup(t)
+	if profile == nil {
+		return nil, fmt.Errorf("unknown profile type: %q", t)
+	}
+	var buf bytes.Buffer
+	if err := profile.WriteTo(&buf, 0); err != nil {
+		return nil, err
+	}
+	return &CapturedProfile{Type: t, Start: from, End: until, Labels: u.labels, Data: buf.Bytes()}, nil
+}
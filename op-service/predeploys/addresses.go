@@ -83,87 +83,98 @@ var (
 )
 
 func init() {
-	Predeploys["L2ToL1MessagePasser"] = &Predeploy{Address: L2ToL1MessagePasserAddr}
-	Predeploys["DeployerWhitelist"] = &Predeploy{Address: DeployerWhitelistAddr}
-	Predeploys["WETH"] = &Predeploy{Address: WETHAddr, ProxyDisabled: true}
-	Predeploys["L2CrossDomainMessenger"] = &Predeploy{Address: L2CrossDomainMessengerAddr}
-	Predeploys["L2StandardBridge"] = &Predeploy{Address: L2StandardBridgeAddr}
-	Predeploys["SequencerFeeVault"] = &Predeploy{Address: SequencerFeeVaultAddr}
-	Predeploys["OptimismMintableERC20Factory"] = &Predeploy{Address: OptimismMintableERC20FactoryAddr}
-	Predeploys["L1BlockNumber"] = &Predeploy{Address: L1BlockNumberAddr}
-	Predeploys["GasPriceOracle"] = &Predeploy{Address: GasPriceOracleAddr}
-	Predeploys["L1Block"] = &Predeploy{Address: L1BlockAddr}
-	Predeploys["CrossL2Inbox"] = &Predeploy{Address: CrossL2InboxAddr}
-	Predeploys["L2toL2CrossDomainMessenger"] = &Predeploy{Address: L2toL2CrossDomainMessengerAddr}
-	Predeploys["GovernanceToken"] = &Predeploy{
+	register(&Predeploy{Name: "L2ToL1MessagePasser", Address: L2ToL1MessagePasserAddr})
+	register(&Predeploy{Name: "DeployerWhitelist", Address: DeployerWhitelistAddr})
+	register(&Predeploy{Name: "WETH", Address: WETHAddr, ProxyDisabled: true})
+	register(&Predeploy{Name: "L2CrossDomainMessenger", Address: L2CrossDomainMessengerAddr})
+	register(&Predeploy{Name: "L2StandardBridge", Address: L2StandardBridgeAddr})
+	register(&Predeploy{Name: "SequencerFeeVault", Address: SequencerFeeVaultAddr})
+	register(&Predeploy{Name: "OptimismMintableERC20Factory", Address: OptimismMintableERC20FactoryAddr})
+	register(&Predeploy{Name: "L1BlockNumber", Address: L1BlockNumberAddr})
+	register(&Predeploy{Name: "GasPriceOracle", Address: GasPriceOracleAddr})
+	register(&Predeploy{Name: "L1Block", Address: L1BlockAddr})
+	register(&Predeploy{Name: "CrossL2Inbox", Address: CrossL2InboxAddr, Fork: Interop})
+	register(&Predeploy{Name: "L2toL2CrossDomainMessenger", Address: L2toL2CrossDomainMessengerAddr, Fork: Interop})
+	register(&Predeploy{
+		Name:          "GovernanceToken",
 		Address:       GovernanceTokenAddr,
 		ProxyDisabled: true,
 		Enabled: func(config DeployConfig) bool {
 			return config.GovernanceEnabled()
 		},
-	}
-	Predeploys["LegacyMessagePasser"] = &Predeploy{Address: LegacyMessagePasserAddr}
-	Predeploys["L2ERC721Bridge"] = &Predeploy{Address: L2ERC721BridgeAddr}
-	Predeploys["OptimismMintableERC721Factory"] = &Predeploy{Address: OptimismMintableERC721FactoryAddr}
-	Predeploys["ProxyAdmin"] = &Predeploy{Address: ProxyAdminAddr}
-	Predeploys["BaseFeeVault"] = &Predeploy{Address: BaseFeeVaultAddr}
-	Predeploys["L1FeeVault"] = &Predeploy{Address: L1FeeVaultAddr}
-	Predeploys["SchemaRegistry"] = &Predeploy{Address: SchemaRegistryAddr}
-	Predeploys["EAS"] = &Predeploy{Address: EASAddr}
-	Predeploys["Create2Deployer"] = &Predeploy{
+	})
+	register(&Predeploy{Name: "LegacyMessagePasser", Address: LegacyMessagePasserAddr})
+	register(&Predeploy{Name: "L2ERC721Bridge", Address: L2ERC721BridgeAddr})
+	register(&Predeploy{Name: "OptimismMintableERC721Factory", Address: OptimismMintableERC721FactoryAddr})
+	register(&Predeploy{Name: "ProxyAdmin", Address: ProxyAdminAddr})
+	register(&Predeploy{Name: "BaseFeeVault", Address: BaseFeeVaultAddr})
+	register(&Predeploy{Name: "L1FeeVault", Address: L1FeeVaultAddr})
+	register(&Predeploy{Name: "SchemaRegistry", Address: SchemaRegistryAddr})
+	register(&Predeploy{Name: "EAS", Address: EASAddr})
+	register(&Predeploy{
+		Name:          "Create2Deployer",
 		Address:       Create2DeployerAddr,
+		Fork:          Canyon,
 		ProxyDisabled: true,
-	}
-	Predeploys["MultiCall3"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "MultiCall3",
 		Address:       MultiCall3Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["Safe_v130"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "Safe_v130",
 		Address:       Safe_v130Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["SafeL2_v130"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "SafeL2_v130",
 		Address:       SafeL2_v130Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["MultiSendCallOnly_v130"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "MultiSendCallOnly_v130",
 		Address:       MultiSendCallOnly_v130Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["SafeSingletonFactory"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "SafeSingletonFactory",
 		Address:       SafeSingletonFactoryAddr,
 		ProxyDisabled: true,
-	}
-	Predeploys["DeterministicDeploymentProxy"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "DeterministicDeploymentProxy",
 		Address:       DeterministicDeploymentProxyAddr,
 		ProxyDisabled: true,
-	}
-	Predeploys["MultiSend_v130"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "MultiSend_v130",
 		Address:       MultiSend_v130Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["Permit2"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "Permit2",
 		Address:       Permit2Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["SenderCreator_v060"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "SenderCreator_v060",
 		Address:       SenderCreator_v060Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["EntryPoint_v060"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "EntryPoint_v060",
 		Address:       EntryPoint_v060Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["SenderCreator_v070"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "SenderCreator_v070",
 		Address:       SenderCreator_v070Addr,
 		ProxyDisabled: true,
-	}
-	Predeploys["EntryPoint_v070"] = &Predeploy{
+	})
+	register(&Predeploy{
+		Name:          "EntryPoint_v070",
 		Address:       EntryPoint_v070Addr,
 		ProxyDisabled: true,
-	}
-
-	for _, predeploy := range Predeploys {
-		PredeploysByAddress[predeploy.Address] = predeploy
-	}
+	})
 }
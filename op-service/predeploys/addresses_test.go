@@ -12,3 +12,56 @@ func TestGethAddresses(t *testing.T) {
 	// we import geth in the monorepo, and do not want to import op monorepo into geth.
 	require.Equal(t, L1BlockAddr, types.L1BlockAddr)
 }
+
+func TestRegistry(t *testing.T) {
+	for name, p := range Predeploys {
+		require.Equal(t, name, p.Name)
+		require.NotEmpty(t, p.Fork, "predeploy %s has no fork", name)
+		byAddr, ok := ByAddress(p.Address)
+		require.True(t, ok)
+		require.Same(t, p, byAddr)
+	}
+	require.Len(t, PredeploysByAddress, len(Predeploys))
+
+	all := All()
+	require.Len(t, all, len(Predeploys))
+	for i := 1; i < len(all); i++ {
+		require.Negative(t, all[i-1].Address.Cmp(all[i].Address), "predeploys must be ordered by address")
+	}
+
+	p, ok := ByName("L1Block")
+	require.True(t, ok)
+	require.Equal(t, L1BlockAddr, p.Address)
+	require.Equal(t, Bedrock, p.Fork)
+	_, ok = ByName("NotAPredeploy")
+	require.False(t, ok)
+
+	require.Equal(t, []*Predeploy{Predeploys["Create2Deployer"]}, AddedIn(Canyon))
+	require.Equal(t, []*Predeploy{Predeploys["CrossL2Inbox"], Predeploys["L2toL2CrossDomainMessenger"]}, AddedIn(Interop))
+	require.Empty(t, AddedIn(Ecotone))
+}
+
+func TestNamespace(t *testing.T) {
+	require.Equal(t, LegacyMessagePasserAddr, NamespaceAddress(0))
+	require.Equal(t, L1BlockAddr, NamespaceAddress(0x15))
+	require.True(t, InNamespace(NamespaceAddress(0)))
+	require.True(t, InNamespace(NamespaceAddress(NamespaceSize-1)))
+	require.False(t, InNamespace(NamespaceAddress(NamespaceSize)))
+	require.False(t, InNamespace(Create2DeployerAddr))
+	require.True(t, IsPredeploy(L1BlockAddr))
+	require.False(t, IsPredeploy(NamespaceAddress(NamespaceSize-1)))
+}
+
+type stubDeployConfig struct {
+	governance bool
+}
+
+func (c stubDeployConfig) GovernanceEnabled() bool { return c.governance }
+
+func (c stubDeployConfig) CanyonTime(uint64) *uint64 { return nil }
+
+func TestIsEnabled(t *testing.T) {
+	require.True(t, Predeploys["L1Block"].IsEnabled(stubDeployConfig{}))
+	require.False(t, Predeploys["GovernanceToken"].IsEnabled(stubDeployConfig{}))
+	require.True(t, Predeploys["GovernanceToken"].IsEnabled(stubDeployConfig{governance: true}))
+}
@@ -1,16 +1,114 @@
 package predeploys
 
 import (
+	"fmt"
+	"math/big"
+	"slices"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Names of the network upgrades that introduced predeploys.
+// These match the fork names of the rollup package, which cannot be imported here.
+const (
+	Bedrock = "bedrock"
+	Canyon  = "canyon"
+	Ecotone = "ecotone"
+	Interop = "interop"
+)
+
+// NamespaceSize is the number of addresses in the predeploy namespace.
+// Every address in the namespace is allocated in the L2 genesis, whether a predeploy is registered for it or not.
+const NamespaceSize = 2048
+
+// Namespace is the first address of the L2 predeploy namespace.
+var Namespace = common.HexToAddress("0x4200000000000000000000000000000000000000")
+
 type DeployConfig interface {
 	GovernanceEnabled() bool
 	CanyonTime(genesisTime uint64) *uint64
 }
 
 type Predeploy struct {
-	Address       common.Address
+	// Name is the name of the contract, as used for its artifact and bindings.
+	Name    string
+	Address common.Address
+	// Fork is the network upgrade that introduced the predeploy, Bedrock for predeploys of the initial genesis.
+	// Other forks must be scheduled forks of the rollup package, which the rollup config check verifies.
+	Fork          string
 	ProxyDisabled bool
 	Enabled       func(config DeployConfig) bool
 }
+
+// IsEnabled returns true if the predeploy is part of the L2 genesis of the given deploy config.
+func (p *Predeploy) IsEnabled(config DeployConfig) bool {
+	return p.Enabled == nil || p.Enabled(config)
+}
+
+// register adds the predeploy to the registry. Panics on duplicate names or addresses,
+// since this indicates a significant programmer error.
+func register(p *Predeploy) {
+	if p.Fork == "" {
+		p.Fork = Bedrock
+	}
+	if _, ok := Predeploys[p.Name]; ok {
+		panic(fmt.Errorf("duplicate predeploy name %q", p.Name))
+	}
+	if existing, ok := PredeploysByAddress[p.Address]; ok {
+		panic(fmt.Errorf("predeploy %q has the same address as %q: %s", p.Name, existing.Name, p.Address))
+	}
+	Predeploys[p.Name] = p
+	PredeploysByAddress[p.Address] = p
+}
+
+// ByName returns the predeploy with the given contract name.
+func ByName(name string) (*Predeploy, bool) {
+	p, ok := Predeploys[name]
+	return p, ok
+}
+
+// ByAddress returns the predeploy at the given address.
+func ByAddress(addr common.Address) (*Predeploy, bool) {
+	p, ok := PredeploysByAddress[addr]
+	return p, ok
+}
+
+// IsPredeploy returns true if a predeploy is registered at the given address.
+func IsPredeploy(addr common.Address) bool {
+	_, ok := PredeploysByAddress[addr]
+	return ok
+}
+
+// All returns all predeploys, ordered by address.
+func All() []*Predeploy {
+	result := make([]*Predeploy, 0, len(Predeploys))
+	for _, p := range Predeploys {
+		result = append(result, p)
+	}
+	slices.SortFunc(result, func(a, b *Predeploy) int {
+		return a.Address.Cmp(b.Address)
+	})
+	return result
+}
+
+// AddedIn returns the predeploys that were introduced by the given network upgrade, ordered by address.
+func AddedIn(fork string) []*Predeploy {
+	var result []*Predeploy
+	for _, p := range All() {
+		if p.Fork == fork {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// NamespaceAddress returns the i-th address of the predeploy namespace.
+func NamespaceAddress(i uint64) common.Address {
+	return common.BigToAddress(new(big.Int).Or(Namespace.Big(), new(big.Int).SetUint64(i)))
+}
+
+// InNamespace returns true if the address is within the predeploy namespace.
+func InNamespace(addr common.Address) bool {
+	offset := new(big.Int).Sub(addr.Big(), Namespace.Big())
+	return offset.Sign() >= 0 && offset.Cmp(big.NewInt(NamespaceSize)) < 0
+}
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+
+	// DefaultHealthCheckTimeout is the maximum duration of a single dependency check.
+	DefaultHealthCheckTimeout = 5 * time.Second
+)
+
+// HealthCheck probes a single dependency of a service, e.g. that the L1 RPC is reachable.
+// A nil error indicates the dependency is healthy.
+type HealthCheck func(ctx context.Context) error
+
+type HealthCheckResult struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+type HealthCheckResponse struct {
+	Status  string              `json:"status"`
+	Version string              `json:"version"`
+	Checks  []HealthCheckResult `json:"checks"`
+}
+
+// HealthChecks is a registry of named dependency checks.
+// Checks may be registered at any time, including after the server has started.
+type HealthChecks struct {
+	appVersion string
+	timeout    time.Duration
+
+	mu     sync.RWMutex
+	checks map[string]HealthCheck
+}
+
+func NewHealthChecks(appVersion string) *HealthChecks {
+	return &HealthChecks{
+		appVersion: appVersion,
+		timeout:    DefaultHealthCheckTimeout,
+		checks:     make(map[string]HealthCheck),
+	}
+}
+
+// Register adds a named check, replacing any existing check with the same name.
+func (h *HealthChecks) Register(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks[name] = check
+}
+
+// Run executes all registered checks concurrently and returns the aggregated result.
+func (h *HealthChecks) Run(ctx context.Context) *HealthCheckResponse {
+	h.mu.RLock()
+	names := make([]string, 0, len(h.checks))
+	for name := range h.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checks := make([]HealthCheck, len(names))
+	for i, name := range names {
+		checks[i] = h.checks[name]
+	}
+	h.mu.RUnlock()
+
+	results := make([]HealthCheckResult, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+			start := time.Now()
+			err := checks[i](checkCtx)
+			results[i] = HealthCheckResult{
+				Name:     names[i],
+				Status:   HealthStatusOK,
+				Duration: time.Since(start).String(),
+			}
+			if err != nil {
+				results[i].Status = HealthStatusUnavailable
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	resp := &HealthCheckResponse{
+		Status:  HealthStatusOK,
+		Version: h.appVersion,
+		Checks:  results,
+	}
+	for _, result := range results {
+		if result.Status != HealthStatusOK {
+			resp.Status = HealthStatusUnavailable
+			break
+		}
+	}
+	return resp
+}
+
+// Handler returns an http.Handler suitable for k8s readiness probes.
+// It responds with 200 if all checks pass and 503 otherwise, with a JSON body describing each check.
+func (h *HealthChecks) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := h.Run(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if resp.Status != HealthStatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
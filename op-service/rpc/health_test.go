@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthChecks(t *testing.T) {
+	t.Run("NoChecks", func(t *testing.T) {
+		checks := NewHealthChecks("v1")
+		resp := checks.Run(context.Background())
+		require.Equal(t, HealthStatusOK, resp.Status)
+		require.Equal(t, "v1", resp.Version)
+		require.Empty(t, resp.Checks)
+	})
+
+	t.Run("AllHealthy", func(t *testing.T) {
+		checks := NewHealthChecks("v1")
+		checks.Register("l1", func(ctx context.Context) error { return nil })
+		checks.Register("engine", func(ctx context.Context) error { return nil })
+		resp := checks.Run(context.Background())
+		require.Equal(t, HealthStatusOK, resp.Status)
+		require.Len(t, resp.Checks, 2)
+		require.Equal(t, "engine", resp.Checks[0].Name)
+		require.Equal(t, "l1", resp.Checks[1].Name)
+	})
+
+	t.Run("Unhealthy", func(t *testing.T) {
+		checks := NewHealthChecks("v1")
+		checks.Register("l1", func(ctx context.Context) error { return nil })
+		checks.Register("db", func(ctx context.Context) error { return errors.New("not migrated") })
+		resp := checks.Run(context.Background())
+		require.Equal(t, HealthStatusUnavailable, resp.Status)
+		require.Equal(t, HealthCheckResult{Name: "db", Status: HealthStatusUnavailable, Error: "not migrated", Duration: resp.Checks[0].Duration}, resp.Checks[0])
+		require.Equal(t, HealthStatusOK, resp.Checks[1].Status)
+	})
+
+	t.Run("ReplaceCheck", func(t *testing.T) {
+		checks := NewHealthChecks("v1")
+		checks.Register("l1", func(ctx context.Context) error { return errors.New("boom") })
+		checks.Register("l1", func(ctx context.Context) error { return nil })
+		require.Equal(t, HealthStatusOK, checks.Run(context.Background()).Status)
+	})
+}
+
+func TestHealthChecksHandler(t *testing.T) {
+	checks := NewHealthChecks("v1")
+	healthy := true
+	checks.Register("l1", func(ctx context.Context) error {
+		if !healthy {
+			return errors.New("unreachable")
+		}
+		return nil
+	})
+
+	get := func() (int, HealthCheckResponse) {
+		rec := httptest.NewRecorder()
+		checks.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var resp HealthCheckResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		return rec.Code, resp
+	}
+
+	code, resp := get()
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, HealthStatusOK, resp.Status)
+
+	healthy = false
+	code, resp = get()
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, HealthStatusUnavailable, resp.Status)
+	require.Equal(t, "unreachable", resp.Checks[0].Error)
+}
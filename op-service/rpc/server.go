@@ -31,6 +31,8 @@ type Server struct {
 	jwtSecret      []byte
 	rpcPath        string
 	healthzPath    string
+	readyzPath     string
+	readiness      *HealthChecks
 	httpRecorder   opmetrics.HTTPRecorder
 	httpServer     *http.Server
 	listener       net.Listener
@@ -90,6 +92,19 @@ func WithHealthzPath(path string) ServerOption {
 	}
 }
 
+func WithReadyzPath(path string) ServerOption {
+	return func(b *Server) {
+		b.readyzPath = path
+	}
+}
+
+// WithReadinessCheck registers a named dependency check that is served on the readyz path.
+func WithReadinessCheck(name string, check HealthCheck) ServerOption {
+	return func(b *Server) {
+		b.readiness.Register(name, check)
+	}
+}
+
 func WithHTTPRecorder(recorder opmetrics.HTTPRecorder) ServerOption {
 	return func(b *Server) {
 		b.httpRecorder = recorder
@@ -128,6 +143,8 @@ func NewServer(host string, port int, appVersion string, opts ...ServerOption) *
 		vHosts:         wildcardHosts,
 		rpcPath:        "/",
 		healthzPath:    "/healthz",
+		readyzPath:     "/readyz",
+		readiness:      NewHealthChecks(appVersion),
 		httpRecorder:   opmetrics.NoopHTTPRecorder,
 		httpServer: &http.Server{
 			Addr: endpoint,
@@ -157,6 +174,12 @@ func (b *Server) AddAPI(api rpc.API) {
 	b.apis = append(b.apis, api)
 }
 
+// AddReadinessCheck registers a named dependency check that is served on the readyz path.
+// Checks may be added after the server has started.
+func (b *Server) AddReadinessCheck(name string, check HealthCheck) {
+	b.readiness.Register(name, check)
+}
+
 func (b *Server) Start() error {
 	srv := rpc.NewServer()
 	if err := node.RegisterApis(b.apis, nil, srv); err != nil {
@@ -173,6 +196,7 @@ func (b *Server) Start() error {
 	mux := http.NewServeMux()
 	mux.Handle(b.rpcPath, nodeHdlr)
 	mux.Handle(b.healthzPath, b.healthzHandler)
+	mux.Handle(b.readyzPath, b.readiness.Handler())
 
 	// http middleware
 	var handler http.Handler = mux
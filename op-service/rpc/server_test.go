@@ -1,6 +1,9 @@
 package rpc
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -30,6 +33,7 @@ func TestBaseServer(t *testing.T) {
 				Service:   new(testAPI),
 			},
 		}),
+		WithReadinessCheck("l1", func(ctx context.Context) error { return nil }),
 	)
 	require.NoError(t, server.Start())
 	defer func() {
@@ -48,6 +52,23 @@ func TestBaseServer(t *testing.T) {
 		require.EqualValues(t, fmt.Sprintf("{\"version\":\"%s\"}\n", appVersion), string(body))
 	})
 
+	t.Run("supports GET /readyz", func(t *testing.T) {
+		res, err := http.Get(fmt.Sprintf("http://%s/readyz", server.endpoint))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var resp HealthCheckResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+		require.Equal(t, HealthStatusOK, resp.Status)
+		require.Len(t, resp.Checks, 1)
+
+		server.AddReadinessCheck("engine", func(ctx context.Context) error { return errors.New("not connected") })
+		res2, err := http.Get(fmt.Sprintf("http://%s/readyz", server.endpoint))
+		require.NoError(t, err)
+		defer res2.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, res2.StatusCode)
+	})
+
 	t.Run("supports health_status", func(t *testing.T) {
 		var res string
 		require.NoError(t, rpcClient.Call(&res, "health_status"))
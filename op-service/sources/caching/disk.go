@@ -0,0 +1,168 @@
+package caching
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+)
+
+const (
+	// diskValuePrefix prefixes the key of an entry. The value is the sequence number of the entry, followed by its value.
+	diskValuePrefix byte = 'v'
+	// diskSeqPrefix prefixes the sequence number of an entry. The value is the size of the entry, followed by its key.
+	// Entries are evicted in order of their sequence number, i.e. first-in first-out.
+	diskSeqPrefix byte = 's'
+)
+
+// DiskCache is a persistent key-value cache, backed by a pebble database.
+// When the total size of the cached values exceeds the max size, the oldest entries are evicted.
+// Entries may be lost when the process crashes, the cache is never inconsistent.
+type DiskCache struct {
+	m     Metrics
+	label string
+	db    *pebble.DB
+
+	// mu protects the fields below, and serializes writes
+	mu       sync.Mutex
+	maxBytes uint64
+	bytes    uint64
+	count    int
+	nextSeq  uint64
+}
+
+// NewDiskCache opens or creates the persistent cache at the given path.
+// Metrics are optional: no metrics will be tracked if m == nil.
+func NewDiskCache(m Metrics, label string, path string, maxBytes uint64) (*DiskCache, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open disk cache at %s: %w", path, err)
+	}
+	c := &DiskCache{
+		m:        m,
+		label:    label,
+		db:       db,
+		maxBytes: maxBytes,
+	}
+	if err := c.load(); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return c, nil
+}
+
+// load recovers the size of the cache, and the next sequence number, from the sequence index.
+func (c *DiskCache) load() error {
+	iter, err := c.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{diskSeqPrefix},
+		UpperBound: []byte{diskSeqPrefix + 1},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to iterate disk cache: %w", err)
+	}
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		c.bytes += binary.BigEndian.Uint64(iter.Value()[:8])
+		c.count++
+		c.nextSeq = binary.BigEndian.Uint64(iter.Key()[1:]) + 1
+	}
+	return iter.Error()
+}
+
+func valueKey(key []byte) []byte {
+	return append([]byte{diskValuePrefix}, key...)
+}
+
+func seqKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{diskSeqPrefix}, seq)
+}
+
+// Get returns a copy of the cached value of the key.
+func (c *DiskCache) Get(key []byte) (value []byte, ok bool) {
+	val, closer, err := c.db.Get(valueKey(key))
+	if err == nil {
+		value = append([]byte(nil), val[8:]...)
+		ok = true
+		_ = closer.Close()
+	}
+	if c.m != nil {
+		c.m.CacheGet(c.label, ok)
+	}
+	return value, ok
+}
+
+// Add caches the value of the key, and evicts the oldest entries if the cache exceeds its max size.
+// Keys are expected to identify their value, like a block hash, so an already cached key is not updated.
+func (c *DiskCache) Add(key []byte, value []byte) (evicted bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vKey := valueKey(key)
+	if _, closer, err := c.db.Get(vKey); err == nil {
+		_ = closer.Close()
+		return false, nil
+	} else if !errors.Is(err, pebble.ErrNotFound) {
+		return false, fmt.Errorf("failed to read disk cache: %w", err)
+	}
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	seq := c.nextSeq
+	batch.Set(vKey, append(binary.BigEndian.AppendUint64(nil, seq), value...), nil)
+	batch.Set(seqKey(seq), append(binary.BigEndian.AppendUint64(nil, uint64(len(value))), key...), nil)
+	bytes := c.bytes + uint64(len(value))
+	count := c.count + 1
+	if bytes > c.maxBytes {
+		evictedBytes, evictedCount, err := c.evict(batch, bytes-c.maxBytes)
+		if err != nil {
+			return false, err
+		}
+		bytes -= evictedBytes
+		count -= evictedCount
+		evicted = evictedCount > 0
+	}
+	if err := batch.Commit(pebble.NoSync); err != nil {
+		return false, fmt.Errorf("failed to write to disk cache: %w", err)
+	}
+	c.nextSeq++
+	c.bytes = bytes
+	c.count = count
+	if c.m != nil {
+		c.m.CacheAdd(c.label, c.count, evicted)
+	}
+	return evicted, nil
+}
+
+// evict deletes the oldest entries, until at least the given number of bytes is freed up.
+// The batch is not committed yet, so the new entry of the batch is never evicted, even if it exceeds the max size by itself.
+func (c *DiskCache) evict(batch *pebble.Batch, excess uint64) (bytes uint64, count int, err error) {
+	iter, err := c.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{diskSeqPrefix},
+		UpperBound: []byte{diskSeqPrefix + 1},
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to iterate disk cache: %w", err)
+	}
+	defer iter.Close()
+	for iter.First(); iter.Valid() && bytes < excess; iter.Next() {
+		batch.Delete(valueKey(iter.Value()[8:]), nil)
+		batch.Delete(iter.Key(), nil)
+		bytes += binary.BigEndian.Uint64(iter.Value()[:8])
+		count++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, 0, fmt.Errorf("failed to iterate disk cache: %w", err)
+	}
+	return bytes, count, nil
+}
+
+// Len returns the number of cached entries.
+func (c *DiskCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.count
+}
+
+func (c *DiskCache) Close() error {
+	return c.db.Close()
+}
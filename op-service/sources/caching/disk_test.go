@@ -0,0 +1,94 @@
+package caching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCacheMetrics struct {
+	adds, hits, misses int
+	size               int
+	evictions          int
+}
+
+func (m *testCacheMetrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.adds++
+	m.size = cacheSize
+	if evicted {
+		m.evictions++
+	}
+}
+
+func (m *testCacheMetrics) CacheGet(label string, hit bool) {
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	m := new(testCacheMetrics)
+	c, err := NewDiskCache(m, "test", dir, 10)
+	require.NoError(t, err)
+
+	_, ok := c.Get([]byte("a"))
+	require.False(t, ok)
+
+	evicted, err := c.Add([]byte("a"), []byte("1234"))
+	require.NoError(t, err)
+	require.False(t, evicted)
+	evicted, err = c.Add([]byte("b"), []byte("5678"))
+	require.NoError(t, err)
+	require.False(t, evicted)
+	// already cached keys are not updated
+	evicted, err = c.Add([]byte("a"), []byte("xxxx"))
+	require.NoError(t, err)
+	require.False(t, evicted)
+
+	val, ok := c.Get([]byte("a"))
+	require.True(t, ok)
+	require.Equal(t, []byte("1234"), val)
+	require.Equal(t, 2, c.Len())
+
+	// exceeding the max size evicts the oldest entry
+	evicted, err = c.Add([]byte("c"), []byte("90ab"))
+	require.NoError(t, err)
+	require.True(t, evicted)
+	_, ok = c.Get([]byte("a"))
+	require.False(t, ok)
+	val, ok = c.Get([]byte("c"))
+	require.True(t, ok)
+	require.Equal(t, []byte("90ab"), val)
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, &testCacheMetrics{adds: 3, hits: 2, misses: 2, size: 2, evictions: 1}, m)
+
+	// the cache persists, including its size and eviction order
+	require.NoError(t, c.Close())
+	c, err = NewDiskCache(nil, "test", dir, 10)
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, 2, c.Len())
+	val, ok = c.Get([]byte("b"))
+	require.True(t, ok)
+	require.Equal(t, []byte("5678"), val)
+
+	// a value larger than the max size is kept, until the next entry is added
+	evicted, err = c.Add([]byte("d"), []byte("0123456789abcdef"))
+	require.NoError(t, err)
+	require.True(t, evicted)
+	require.Equal(t, 1, c.Len())
+	_, ok = c.Get([]byte("b"))
+	require.False(t, ok)
+	_, ok = c.Get([]byte("c"))
+	require.False(t, ok)
+	_, err = c.Add([]byte("e"), []byte("1"))
+	require.NoError(t, err)
+	_, ok = c.Get([]byte("d"))
+	require.False(t, ok)
+	val, ok = c.Get([]byte("e"))
+	require.True(t, ok)
+	require.Equal(t, []byte("1"), val)
+}
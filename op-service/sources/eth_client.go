@@ -63,6 +63,12 @@ type EthClientConfig struct {
 	// till we re-attempt the user-preferred methods.
 	// If this is 0 then the client does not fall back to less optimal but available methods.
 	MethodResetDuration time.Duration
+
+	// PersistentCacheDir is the directory to cache headers and receipts in, to keep them across restarts.
+	// The persistent cache is disabled if empty.
+	PersistentCacheDir string
+	// PersistentCacheSize is the max size in bytes of the persisted headers, and of the persisted receipts.
+	PersistentCacheSize uint64
 }
 
 func (c *EthClientConfig) Check() error {
@@ -87,6 +93,9 @@ func (c *EthClientConfig) Check() error {
 	if !ValidRPCProviderKind(c.RPCProviderKind) {
 		return fmt.Errorf("unknown rpc provider kind: %s", c.RPCProviderKind)
 	}
+	if c.PersistentCacheDir != "" && c.PersistentCacheSize == 0 {
+		return errors.New("persistent cache size must be set when the persistent cache is enabled")
+	}
 	return nil
 }
 
@@ -113,6 +122,9 @@ type EthClient struct {
 	// cache payloads by hash
 	// common.Hash -> *eth.ExecutionPayload
 	payloadsCache *caching.LRUCache[common.Hash, *eth.ExecutionPayloadEnvelope]
+
+	// cache headers and receipts on disk, nil if disabled
+	persistentCaches *persistentCaches
 }
 
 // NewEthClient returns an [EthClient], wrapping an RPC with bindings to fetch ethereum data with added error logging,
@@ -122,12 +134,24 @@ func NewEthClient(client client.RPC, log log.Logger, metrics caching.Metrics, co
 		return nil, fmt.Errorf("bad config, cannot create L1 source: %w", err)
 	}
 
+	var persistent *persistentCaches
+	var receiptsDisk *caching.DiskCache
+	if config.PersistentCacheDir != "" {
+		var err error
+		persistent, err = openPersistentCaches(metrics, config.PersistentCacheDir, config.PersistentCacheSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open persistent cache: %w", err)
+		}
+		receiptsDisk = persistent.receipts
+	}
+
 	client = LimitRPC(client, config.MaxConcurrentRequests)
-	recProvider := newRPCRecProviderFromConfig(client, log, metrics, config)
+	recProvider := newRPCRecProviderFromConfig(client, log, metrics, config, receiptsDisk)
 	if recProvider.isInnerNil() {
 		return nil, errors.New("failed to establish receipts provider")
 	}
 	return &EthClient{
+		persistentCaches:  persistent,
 		client:            client,
 		recProvider:       recProvider,
 		trustRPC:          config.TrustRPC,
@@ -192,7 +216,7 @@ func (s *EthClient) headerCall(ctx context.Context, method string, id rpcBlockID
 	if err := id.CheckID(eth.ToBlockID(info)); err != nil {
 		return nil, fmt.Errorf("fetched block header does not match requested ID: %w", err)
 	}
-	s.headersCache.Add(info.Hash(), info)
+	s.addHeader(info)
 	return info, nil
 }
 
@@ -212,11 +236,21 @@ func (s *EthClient) blockCall(ctx context.Context, method string, id rpcBlockID)
 	if err := id.CheckID(eth.ToBlockID(info)); err != nil {
 		return nil, nil, fmt.Errorf("fetched block data does not match requested ID: %w", err)
 	}
-	s.headersCache.Add(info.Hash(), info)
+	s.addHeader(info)
 	s.transactionsCache.Add(info.Hash(), txs)
 	return info, txs, nil
 }
 
+// addHeader caches the header in memory, and on disk if the persistent cache is enabled.
+func (s *EthClient) addHeader(info eth.BlockInfo) {
+	s.headersCache.Add(info.Hash(), info)
+	if s.persistentCaches != nil {
+		if err := s.persistentCaches.AddHeader(info); err != nil {
+			s.log.Warn("Failed to cache header on disk", "hash", info.Hash(), "err", err)
+		}
+	}
+}
+
 func (s *EthClient) payloadCall(ctx context.Context, method string, id rpcBlockID) (*eth.ExecutionPayloadEnvelope, error) {
 	var block *RPCBlock
 	err := s.client.CallContext(ctx, &block, method, id.Arg(), true)
@@ -251,6 +285,12 @@ func (s *EthClient) InfoByHash(ctx context.Context, hash common.Hash) (eth.Block
 	if header, ok := s.headersCache.Get(hash); ok {
 		return header, nil
 	}
+	if s.persistentCaches != nil {
+		if header, ok := s.persistentCaches.Header(hash); ok {
+			s.headersCache.Add(hash, header)
+			return header, nil
+		}
+	}
 	return s.headerCall(ctx, "eth_getBlockByHash", hashID(hash))
 }
 
@@ -372,4 +412,9 @@ func (s *EthClient) ReadStorageAt(ctx context.Context, address common.Address, s
 
 func (s *EthClient) Close() {
 	s.client.Close()
+	if s.persistentCaches != nil {
+		if err := s.persistentCaches.Close(); err != nil {
+			s.log.Error("Failed to close persistent cache", "err", err)
+		}
+	}
 }
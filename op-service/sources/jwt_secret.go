@@ -0,0 +1,222 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	gn "github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+)
+
+// ReadJWTSecret reads a JWT secret of 32 hex-encoded bytes from the file at the given path.
+func ReadJWTSecret(path string) ([32]byte, error) {
+	var secret [32]byte
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return secret, fmt.Errorf("failed to read jwt secret: %w", err)
+	}
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return secret, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", path)
+	}
+	copy(secret[:], jwtSecret)
+	return secret, nil
+}
+
+// JWTSecretWatcher authenticates engine API requests with the JWT secret of a file,
+// and reloads the secret when the file changes, so the secret can be rotated without a restart.
+// The previous secret remains acceptable for the rotation window after a change:
+// while the engine rejects the current secret, e.g. because it has not picked up the new secret yet,
+// requests fall back to the previous secret.
+type JWTSecretWatcher struct {
+	log            log.Logger
+	path           string
+	rotationWindow time.Duration
+	now            func() time.Time
+
+	mu          sync.RWMutex
+	current     [32]byte
+	previous    *[32]byte
+	rotatedAt   time.Time
+	usePrevious bool
+
+	watcher *fsnotify.Watcher
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewJWTSecretWatcher loads the JWT secret of the file at the given path.
+// Call Watch to start reloading the secret when the file changes.
+func NewJWTSecretWatcher(logger log.Logger, path string, rotationWindow time.Duration) (*JWTSecretWatcher, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ReadJWTSecret(path)
+	if err != nil {
+		return nil, err
+	}
+	return &JWTSecretWatcher{
+		log:            logger,
+		path:           path,
+		rotationWindow: rotationWindow,
+		now:            time.Now,
+		current:        secret,
+	}, nil
+}
+
+// Watch starts reloading the secret when the file changes, until Stop is called.
+// The directory of the file is watched, to follow files that are replaced, like kubernetes secret mounts.
+func (w *JWTSecretWatcher) Watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create jwt secret watcher: %w", err)
+	}
+	if err := watcher.Add(filepath.Dir(w.path)); err != nil {
+		return errors.Join(fmt.Errorf("failed to watch jwt secret %s: %w", w.path, err), watcher.Close())
+	}
+	w.watcher = watcher
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go w.run()
+	return nil
+}
+
+func (w *JWTSecretWatcher) run() {
+	defer close(w.done)
+	for {
+		select {
+		case <-w.stop:
+			return
+		case event := <-w.watcher.Events:
+			if event.Name == w.path || strings.HasSuffix(event.Name, "/..data") { // kubernetes secrets mount
+				if err := w.Reload(); err != nil {
+					// The file may not be fully written yet, the next write event reloads it again.
+					w.log.Warn("Failed to reload jwt secret, keeping the current secret", "path", w.path, "err", err)
+				}
+			}
+		case err := <-w.watcher.Errors:
+			w.log.Error("Error watching jwt secret", "path", w.path, "err", err)
+		}
+	}
+}
+
+// Stop stops watching the file for changes.
+func (w *JWTSecretWatcher) Stop() {
+	if w.watcher == nil {
+		return
+	}
+	close(w.stop)
+	<-w.done
+	_ = w.watcher.Close()
+	w.watcher = nil
+}
+
+// Reload reads the secret from the file. If it changed, the current secret becomes the previous secret.
+func (w *JWTSecretWatcher) Reload() error {
+	secret, err := ReadJWTSecret(w.path)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if secret == w.current {
+		return nil
+	}
+	previous := w.current
+	w.previous = &previous
+	w.current = secret
+	w.rotatedAt = w.now()
+	w.usePrevious = false
+	w.log.Info("Rotated jwt secret", "path", w.path, "rotationWindow", w.rotationWindow)
+	return nil
+}
+
+// secret returns the secret to authenticate with.
+func (w *JWTSecretWatcher) secret() [32]byte {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.usePrevious && w.inRotationWindow() {
+		return *w.previous
+	}
+	return w.current
+}
+
+// inRotationWindow returns true if the previous secret is still acceptable. The lock must be held.
+func (w *JWTSecretWatcher) inRotationWindow() bool {
+	return w.previous != nil && w.now().Sub(w.rotatedAt) < w.rotationWindow
+}
+
+// onUnauthorized switches to the other acceptable secret, after the engine rejected a request.
+// It returns false if there is no other acceptable secret to retry the request with.
+func (w *JWTSecretWatcher) onUnauthorized() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.inRotationWindow() {
+		return false
+	}
+	w.usePrevious = !w.usePrevious
+	w.log.Warn("Engine rejected jwt secret, switching secret within rotation window", "usePrevious", w.usePrevious)
+	return true
+}
+
+// Auth signs the Authorization header of a request with the currently acceptable secret.
+func (w *JWTSecretWatcher) Auth(h http.Header) error {
+	return gn.NewJWTAuth(w.secret())(h)
+}
+
+// JWTRotationRPC retries requests that are rejected by the engine as unauthorized with the other acceptable
+// secret of the JWTSecretWatcher, during a rotation window. The watcher is stopped when the RPC is closed.
+type JWTRotationRPC struct {
+	client.RPC
+	secrets *JWTSecretWatcher
+}
+
+var _ client.RPC = (*JWTRotationRPC)(nil)
+
+func NewJWTRotationRPC(inner client.RPC, secrets *JWTSecretWatcher) *JWTRotationRPC {
+	return &JWTRotationRPC{RPC: inner, secrets: secrets}
+}
+
+func (r *JWTRotationRPC) retry(err error) bool {
+	var httpErr rpc.HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusUnauthorized && r.secrets.onUnauthorized()
+}
+
+func (r *JWTRotationRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	err := r.RPC.CallContext(ctx, result, method, args...)
+	if r.retry(err) {
+		err = r.RPC.CallContext(ctx, result, method, args...)
+	}
+	return err
+}
+
+func (r *JWTRotationRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	err := r.RPC.BatchCallContext(ctx, b)
+	if r.retry(err) {
+		err = r.RPC.BatchCallContext(ctx, b)
+	}
+	return err
+}
+
+func (r *JWTRotationRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return r.RPC.EthSubscribe(ctx, channel, args...)
+}
+
+func (r *JWTRotationRPC) Close() {
+	r.secrets.Stop()
+	r.RPC.Close()
+}
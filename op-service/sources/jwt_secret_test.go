@@ -0,0 +1,131 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var (
+	jwtSecretA = [32]byte{0xaa}
+	jwtSecretB = [32]byte{0xbb}
+)
+
+func writeJWTSecret(t *testing.T, path string, secret [32]byte) {
+	require.NoError(t, os.WriteFile(path, []byte(hexutil.Encode(secret[:])), 0o600))
+}
+
+func newTestJWTSecretWatcher(t *testing.T, rotationWindow time.Duration) (*JWTSecretWatcher, string) {
+	path := filepath.Join(t.TempDir(), "jwt.txt")
+	writeJWTSecret(t, path, jwtSecretA)
+	w, err := NewJWTSecretWatcher(testlog.Logger(t, log.LevelInfo), path, rotationWindow)
+	require.NoError(t, err)
+	return w, path
+}
+
+func TestReadJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.txt")
+	require.NoError(t, os.WriteFile(path, []byte(hexutil.Encode(jwtSecretA[:])+"\n"), 0o600))
+	secret, err := ReadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, jwtSecretA, secret)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = ReadJWTSecret(path)
+	require.ErrorContains(t, err, "not 32 hex-formatted bytes")
+}
+
+func TestJWTSecretWatcherReloadsOnChange(t *testing.T) {
+	w, path := newTestJWTSecretWatcher(t, time.Minute)
+	require.NoError(t, w.Watch())
+	t.Cleanup(w.Stop)
+	require.Equal(t, jwtSecretA, w.secret())
+
+	writeJWTSecret(t, path, jwtSecretB)
+	require.Eventually(t, func() bool {
+		return w.secret() == jwtSecretB
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// An invalid secret is ignored, until the file is fixed.
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	require.Error(t, w.Reload())
+	require.Equal(t, jwtSecretB, w.secret())
+}
+
+func TestJWTRotationRPC(t *testing.T) {
+	setup := func(t *testing.T) (*JWTSecretWatcher, *stubAuthRPC, *JWTRotationRPC, *time.Time) {
+		w, path := newTestJWTSecretWatcher(t, time.Minute)
+		now := time.Unix(1000, 0)
+		w.now = func() time.Time { return now }
+		inner := &stubAuthRPC{secrets: w, accepted: jwtSecretA}
+		writeJWTSecret(t, path, jwtSecretB)
+		require.NoError(t, w.Reload())
+		return w, inner, NewJWTRotationRPC(inner, w), &now
+	}
+
+	t.Run("CurrentSecretAccepted", func(t *testing.T) {
+		_, inner, cl, _ := setup(t)
+		inner.accepted = jwtSecretB
+		require.NoError(t, cl.CallContext(context.Background(), nil, "engine_test"))
+		require.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("FallbackToPreviousSecret", func(t *testing.T) {
+		w, inner, cl, _ := setup(t)
+		require.NoError(t, cl.CallContext(context.Background(), nil, "engine_test"))
+		require.Equal(t, 2, inner.calls)
+		require.Equal(t, jwtSecretA, w.secret())
+
+		// Once the engine picks up the new secret, requests switch back to it.
+		inner.accepted = jwtSecretB
+		require.NoError(t, cl.BatchCallContext(context.Background(), nil))
+		require.Equal(t, 4, inner.calls)
+		require.Equal(t, jwtSecretB, w.secret())
+	})
+
+	t.Run("NoFallbackAfterRotationWindow", func(t *testing.T) {
+		w, inner, cl, now := setup(t)
+		*now = now.Add(time.Minute)
+		err := cl.CallContext(context.Background(), nil, "engine_test")
+		var httpErr rpc.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		require.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+		require.Equal(t, 1, inner.calls)
+		require.Equal(t, jwtSecretB, w.secret())
+	})
+}
+
+// stubAuthRPC rejects requests as unauthorized, unless the watcher currently authenticates with the accepted secret.
+type stubAuthRPC struct {
+	client.RPC
+	secrets  *JWTSecretWatcher
+	accepted [32]byte
+	calls    int
+}
+
+func (s *stubAuthRPC) check() error {
+	s.calls++
+	if s.secrets.secret() != s.accepted {
+		return rpc.HTTPError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}
+	}
+	return nil
+}
+
+func (s *stubAuthRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	return s.check()
+}
+
+func (s *stubAuthRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return s.check()
+}
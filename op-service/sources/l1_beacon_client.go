@@ -24,17 +24,50 @@ const (
 	specMethod           = "eth/v1/config/spec"
 	genesisMethod        = "eth/v1/beacon/genesis"
 	sidecarsMethodPrefix = "eth/v1/beacon/blob_sidecars/"
+	blocksMethodPrefix   = "eth/v2/beacon/blocks/"
+)
+
+// BeaconBlobSource is the name of the beacon node as blob source, in metrics and health checks.
+const BeaconBlobSource = "beacon"
+
+// Results of blob sidecar requests, as recorded in metrics.
+const (
+	BlobSourceSuccess  = "success"
+	BlobSourceNotFound = "not_found"
+	BlobSourceError    = "error"
 )
 
 type L1BeaconClientConfig struct {
 	FetchAllSidecars bool
+	// Metrics records the blob sidecar requests and health of each blob source. Optional.
+	Metrics BlobSourceMetrics
+}
+
+// BlobSourceMetrics records the results of the blob sidecar requests, and the health, of each blob source.
+// The beacon node is named BeaconBlobSource, and the fallbacks are named by FallbackBlobSource.
+type BlobSourceMetrics interface {
+	RecordBlobSourceRequest(source string, result string)
+	RecordBlobSourceHealth(source string, healthy bool)
+}
+
+type noopBlobSourceMetrics struct{}
+
+func (noopBlobSourceMetrics) RecordBlobSourceRequest(string, string) {}
+func (noopBlobSourceMetrics) RecordBlobSourceHealth(string, bool)    {}
+
+// FallbackBlobSource returns the name of the fallback blob source with the given index, starting at 0.
+// Fallbacks are named by position rather than address, since the address may contain credentials.
+func FallbackBlobSource(i int) string {
+	return "fallback_" + strconv.Itoa(i+1)
 }
 
 // L1BeaconClient is a high level golang client for the Beacon API.
 type L1BeaconClient struct {
-	cl   BeaconClient
-	pool *ClientPool[BlobSideCarsFetcher]
-	cfg  L1BeaconClientConfig
+	cl      BeaconClient
+	pool    *ClientPool[BlobSideCarsFetcher]
+	names   []string // names of the blob sources in the pool, in the same order
+	cfg     L1BeaconClientConfig
+	metrics BlobSourceMetrics
 
 	initLock     sync.Mutex
 	timeToSlotFn TimeToSlotFn
@@ -47,6 +80,7 @@ type BeaconClient interface {
 	NodeVersion(ctx context.Context) (string, error)
 	ConfigSpec(ctx context.Context) (eth.APIConfigResponse, error)
 	BeaconGenesis(ctx context.Context) (eth.APIGenesisResponse, error)
+	BeaconBlock(ctx context.Context, blockID string) (eth.APIBeaconBlockResponse, error)
 	BeaconBlobSideCars(ctx context.Context, fetchAllSidecars bool, slot uint64, hashes []eth.IndexedBlobHash) (eth.APIGetBlobSidecarsResponse, error)
 }
 
@@ -116,6 +150,16 @@ func (cl *BeaconHTTPClient) BeaconGenesis(ctx context.Context) (eth.APIGenesisRe
 	return genesisResp, nil
 }
 
+// BeaconBlock fetches the beacon block with the given block ID, e.g. "head", "finalized" or a slot number.
+// Only the fields identifying the execution-layer block are decoded.
+func (cl *BeaconHTTPClient) BeaconBlock(ctx context.Context, blockID string) (eth.APIBeaconBlockResponse, error) {
+	var blockResp eth.APIBeaconBlockResponse
+	if err := cl.apiReq(ctx, &blockResp, blocksMethodPrefix+blockID, nil); err != nil {
+		return eth.APIBeaconBlockResponse{}, err
+	}
+	return blockResp, nil
+}
+
 func (cl *BeaconHTTPClient) BeaconBlobSideCars(ctx context.Context, fetchAllSidecars bool, slot uint64, hashes []eth.IndexedBlobHash) (eth.APIGetBlobSidecarsResponse, error) {
 	reqPath := path.Join(sidecarsMethodPrefix, strconv.FormatUint(slot, 10))
 	var reqQuery url.Values
@@ -165,6 +209,11 @@ func (p *ClientPool[T]) Get() T {
 	return p.clients[p.index]
 }
 
+// Index returns the index of the current client.
+func (p *ClientPool[T]) Index() int {
+	return p.index
+}
+
 func (p *ClientPool[T]) MoveToNext() {
 	p.index += 1
 	if p.index == len(p.clients) {
@@ -174,13 +223,23 @@ func (p *ClientPool[T]) MoveToNext() {
 
 // NewL1BeaconClient returns a client for making requests to an L1 consensus layer node.
 // Fallbacks are optional clients that will be used for fetching blobs. L1BeaconClient will rotate between
-// the `cl` and the fallbacks whenever a client runs into an error while fetching blobs.
+// the `cl` and the fallbacks whenever a client runs into an error while fetching blobs, in the order they are given.
 func NewL1BeaconClient(cl BeaconClient, cfg L1BeaconClientConfig, fallbacks ...BlobSideCarsFetcher) *L1BeaconClient {
 	cs := append([]BlobSideCarsFetcher{cl}, fallbacks...)
+	names := []string{BeaconBlobSource}
+	for i := range fallbacks {
+		names = append(names, FallbackBlobSource(i))
+	}
+	m := cfg.Metrics
+	if m == nil {
+		m = noopBlobSourceMetrics{}
+	}
 	return &L1BeaconClient{
-		cl:   cl,
-		pool: NewClientPool(cs...),
-		cfg:  cfg,
+		cl:      cl,
+		pool:    NewClientPool(cs...),
+		names:   names,
+		cfg:     cfg,
+		metrics: m,
 	}
 }
 
@@ -218,21 +277,70 @@ func (cl *L1BeaconClient) GetTimeToSlotFn(ctx context.Context) (TimeToSlotFn, er
 	return cl.timeToSlotFn, nil
 }
 
+// ExecutionBlockID returns the execution-layer block of the beacon block with the given block ID,
+// e.g. "head" or "finalized", to compare the beacon node's view of L1 against the execution-layer RPC.
+func (cl *L1BeaconClient) ExecutionBlockID(ctx context.Context, blockID string) (eth.BlockID, error) {
+	resp, err := cl.cl.BeaconBlock(ctx, blockID)
+	if err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to fetch beacon block %s: %w", blockID, err)
+	}
+	payload := resp.Data.Message.Body.ExecutionPayload
+	return eth.BlockID{Hash: payload.BlockHash, Number: uint64(payload.BlockNumber)}, nil
+}
+
 func (cl *L1BeaconClient) fetchSidecars(ctx context.Context, slot uint64, hashes []eth.IndexedBlobHash) (eth.APIGetBlobSidecarsResponse, error) {
 	var errs []error
 	for i := 0; i < cl.pool.Len(); i++ {
 		f := cl.pool.Get()
+		name := cl.names[cl.pool.Index()]
 		resp, err := f.BeaconBlobSideCars(ctx, cl.cfg.FetchAllSidecars, slot, hashes)
 		if err != nil {
 			cl.pool.MoveToNext()
-			errs = append(errs, err)
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			if errors.Is(err, ethereum.NotFound) {
+				// The source is healthy, but does not have the blobs, e.g. because they expired.
+				cl.metrics.RecordBlobSourceRequest(name, BlobSourceNotFound)
+			} else {
+				cl.metrics.RecordBlobSourceRequest(name, BlobSourceError)
+				cl.metrics.RecordBlobSourceHealth(name, false)
+			}
 		} else {
+			cl.metrics.RecordBlobSourceRequest(name, BlobSourceSuccess)
+			cl.metrics.RecordBlobSourceHealth(name, true)
 			return resp, nil
 		}
 	}
 	return eth.APIGetBlobSidecarsResponse{}, errors.Join(errs...)
 }
 
+// BlobSourceHealth is the result of the health check of a blob source.
+type BlobSourceHealth struct {
+	Name    string
+	Version string
+	// Err is the error of the health check, nil if the source is healthy.
+	Err error
+}
+
+type nodeVersionSource interface {
+	NodeVersion(ctx context.Context) (string, error)
+}
+
+// CheckBlobSources checks the health of the beacon node and the fallbacks by requesting their node version.
+// Fallbacks that don't serve the node version endpoint are skipped.
+func (cl *L1BeaconClient) CheckBlobSources(ctx context.Context) []BlobSourceHealth {
+	var result []BlobSourceHealth
+	for i, f := range cl.pool.clients {
+		src, ok := f.(nodeVersionSource)
+		if !ok {
+			continue
+		}
+		version, err := src.NodeVersion(ctx)
+		cl.metrics.RecordBlobSourceHealth(cl.names[i], err == nil)
+		result = append(result, BlobSourceHealth{Name: cl.names[i], Version: version, Err: err})
+	}
+	return result
+}
+
 // GetBlobSidecars fetches blob sidecars that were confirmed in the specified
 // L1 block with the given indexed hashes.
 // Order of the returned sidecars is guaranteed to be that of the hashes.
@@ -16,6 +16,8 @@ import (
 	client_mocks "github.com/ethereum-optimism/optimism/op-service/client/mocks"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/mocks"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/stretchr/testify/require"
 )
@@ -183,6 +185,69 @@ func TestBeaconClientFallback(t *testing.T) {
 
 }
 
+type blobSourceMetrics struct {
+	requests map[string][]string
+	healthy  map[string]bool
+}
+
+func (m *blobSourceMetrics) RecordBlobSourceRequest(source string, result string) {
+	m.requests[source] = append(m.requests[source], result)
+}
+
+func (m *blobSourceMetrics) RecordBlobSourceHealth(source string, healthy bool) {
+	m.healthy[source] = healthy
+}
+
+func TestBeaconClientBlobSourceMetrics(t *testing.T) {
+	index0, sidecar0 := makeTestBlobSidecar(1)
+	hashes := []eth.IndexedBlobHash{index0}
+	apiSidecars := toAPISideCars([]*eth.BlobSidecar{sidecar0})
+
+	ctx := context.Background()
+	m := &blobSourceMetrics{requests: make(map[string][]string), healthy: make(map[string]bool)}
+	p := mocks.NewBeaconClient(t)
+	f1 := mocks.NewBlobSideCarsFetcher(t)
+	f2 := mocks.NewBlobSideCarsFetcher(t)
+	c := NewL1BeaconClient(p, L1BeaconClientConfig{Metrics: m}, f1, f2)
+	p.EXPECT().BeaconGenesis(ctx).Return(eth.APIGenesisResponse{Data: eth.ReducedGenesisData{GenesisTime: 10}}, nil)
+	p.EXPECT().ConfigSpec(ctx).Return(eth.APIConfigResponse{Data: eth.ReducedConfigData{SecondsPerSlot: 2}}, nil)
+	// The beacon node has expired the blobs, the first fallback is down, and the second fallback serves the blobs.
+	p.EXPECT().BeaconBlobSideCars(ctx, false, uint64(1), hashes).Return(eth.APIGetBlobSidecarsResponse{}, fmt.Errorf("expired: %w", ethereum.NotFound))
+	f1.EXPECT().BeaconBlobSideCars(ctx, false, uint64(1), hashes).Return(eth.APIGetBlobSidecarsResponse{}, errors.New("connection refused"))
+	f2.EXPECT().BeaconBlobSideCars(ctx, false, uint64(1), hashes).Return(eth.APIGetBlobSidecarsResponse{Data: apiSidecars}, nil)
+
+	resp, err := c.GetBlobSidecars(ctx, eth.L1BlockRef{Time: 12}, hashes)
+	require.NoError(t, err)
+	require.Equal(t, []*eth.BlobSidecar{sidecar0}, resp)
+	require.Equal(t, map[string][]string{
+		BeaconBlobSource:      {BlobSourceNotFound},
+		FallbackBlobSource(0): {BlobSourceError},
+		FallbackBlobSource(1): {BlobSourceSuccess},
+	}, m.requests)
+	require.Equal(t, map[string]bool{
+		FallbackBlobSource(0): false,
+		FallbackBlobSource(1): true,
+	}, m.healthy)
+}
+
+func TestBeaconClientCheckBlobSources(t *testing.T) {
+	ctx := context.Background()
+	m := &blobSourceMetrics{requests: make(map[string][]string), healthy: make(map[string]bool)}
+	p := mocks.NewBeaconClient(t)
+	archiver := mocks.NewBeaconClient(t)
+	sidecarsOnly := mocks.NewBlobSideCarsFetcher(t)
+	c := NewL1BeaconClient(p, L1BeaconClientConfig{Metrics: m}, sidecarsOnly, archiver)
+	p.EXPECT().NodeVersion(ctx).Return("beacon/v1", nil)
+	archiver.EXPECT().NodeVersion(ctx).Return("", errors.New("connection refused"))
+
+	result := c.CheckBlobSources(ctx)
+	require.Len(t, result, 2, "sources without node version endpoint are skipped")
+	require.Equal(t, BlobSourceHealth{Name: BeaconBlobSource, Version: "beacon/v1"}, result[0])
+	require.Equal(t, FallbackBlobSource(1), result[1].Name)
+	require.ErrorContains(t, result[1].Err, "connection refused")
+	require.Equal(t, map[string]bool{BeaconBlobSource: true, FallbackBlobSource(1): false}, m.healthy)
+}
+
 func TestBeaconHTTPClient(t *testing.T) {
 	c := client_mocks.NewHTTP(t)
 	b := NewBeaconHTTPClient(c)
@@ -228,3 +293,21 @@ func TestClientPoolSeveral(t *testing.T) {
 		p.MoveToNext()
 	}
 }
+
+func TestBeaconClientExecutionBlockID(t *testing.T) {
+	ctx := context.Background()
+	p := mocks.NewBeaconClient(t)
+	c := NewL1BeaconClient(p, L1BeaconClientConfig{})
+	hash := common.Hash{0xaa}
+	var resp eth.APIBeaconBlockResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"message":{"slot":"12","body":{"execution_payload":{"block_number":"100","block_hash":"`+hash.Hex()+`"}}}}}`), &resp))
+	p.EXPECT().BeaconBlock(ctx, "head").Return(resp, nil)
+
+	id, err := c.ExecutionBlockID(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, eth.BlockID{Hash: hash, Number: 100}, id)
+
+	p.EXPECT().BeaconBlock(ctx, "finalized").Return(eth.APIBeaconBlockResponse{}, errors.New("boom"))
+	_, err = c.ExecutionBlockID(ctx, "finalized")
+	require.ErrorContains(t, err, "boom")
+}
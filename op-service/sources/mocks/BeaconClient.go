@@ -22,6 +22,59 @@ func (_m *BeaconClient) EXPECT() *BeaconClient_Expecter {
 	return &BeaconClient_Expecter{mock: &_m.Mock}
 }
 
+// BeaconBlock provides a mock function with given fields: ctx, blockID
+func (_m *BeaconClient) BeaconBlock(ctx context.Context, blockID string) (eth.APIBeaconBlockResponse, error) {
+	ret := _m.Called(ctx, blockID)
+
+	var r0 eth.APIBeaconBlockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (eth.APIBeaconBlockResponse, error)); ok {
+		return rf(ctx, blockID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) eth.APIBeaconBlockResponse); ok {
+		r0 = rf(ctx, blockID)
+	} else {
+		r0 = ret.Get(0).(eth.APIBeaconBlockResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, blockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconClient_BeaconBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeaconBlock'
+type BeaconClient_BeaconBlock_Call struct {
+	*mock.Call
+}
+
+// BeaconBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockID string
+func (_e *BeaconClient_Expecter) BeaconBlock(ctx interface{}, blockID interface{}) *BeaconClient_BeaconBlock_Call {
+	return &BeaconClient_BeaconBlock_Call{Call: _e.mock.On("BeaconBlock", ctx, blockID)}
+}
+
+func (_c *BeaconClient_BeaconBlock_Call) Run(run func(ctx context.Context, blockID string)) *BeaconClient_BeaconBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BeaconClient_BeaconBlock_Call) Return(_a0 eth.APIBeaconBlockResponse, _a1 error) *BeaconClient_BeaconBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconClient_BeaconBlock_Call) RunAndReturn(run func(context.Context, string) (eth.APIBeaconBlockResponse, error)) *BeaconClient_BeaconBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeaconBlobSideCars provides a mock function with given fields: ctx, fetchAllSidecars, slot, hashes
 func (_m *BeaconClient) BeaconBlobSideCars(ctx context.Context, fetchAllSidecars bool, slot uint64, hashes []eth.IndexedBlobHash) (eth.APIGetBlobSidecarsResponse, error) {
 	ret := _m.Called(ctx, fetchAllSidecars, slot, hashes)
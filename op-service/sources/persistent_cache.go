@@ -0,0 +1,96 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
+)
+
+// persistentCaches are the caches of an EthClient that are kept on disk, across restarts.
+// Only data identified by block hash is cached, so the caches are not affected by reorgs.
+type persistentCaches struct {
+	headers  *caching.DiskCache
+	receipts *caching.DiskCache
+}
+
+func openPersistentCaches(m caching.Metrics, dir string, maxBytes uint64) (*persistentCaches, error) {
+	headers, err := caching.NewDiskCache(m, "disk_headers", filepath.Join(dir, "headers"), maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := caching.NewDiskCache(m, "disk_receipts", filepath.Join(dir, "receipts"), maxBytes)
+	if err != nil {
+		return nil, errors.Join(err, headers.Close())
+	}
+	return &persistentCaches{headers: headers, receipts: receipts}, nil
+}
+
+// Header returns the cached header of the block hash.
+func (c *persistentCaches) Header(hash common.Hash) (eth.BlockInfo, bool) {
+	data, ok := c.headers.Get(hash[:])
+	if !ok {
+		return nil, false
+	}
+	var header types.Header
+	if err := rlp.DecodeBytes(data, &header); err != nil || header.Hash() != hash {
+		return nil, false
+	}
+	return &headerInfo{hash: hash, Header: &header}, true
+}
+
+func (c *persistentCaches) AddHeader(info eth.BlockInfo) error {
+	data, err := info.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to encode header: %w", err)
+	}
+	hash := info.Hash()
+	_, err = c.headers.Add(hash[:], data)
+	return err
+}
+
+func (c *persistentCaches) Close() error {
+	return errors.Join(c.headers.Close(), c.receipts.Close())
+}
+
+// persistentReceiptsProvider caches the receipts of the inner ReceiptsProvider on disk.
+// Cached receipts are verified against the receipt hash of the block, like fetched receipts.
+type persistentReceiptsProvider struct {
+	inner ReceiptsProvider
+	cache *caching.DiskCache
+	log   log.Logger
+}
+
+func (p *persistentReceiptsProvider) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	block := eth.ToBlockID(blockInfo)
+	if data, ok := p.cache.Get(block.Hash[:]); ok {
+		var receipts types.Receipts
+		if err := json.Unmarshal(data, &receipts); err != nil {
+			p.log.Warn("Failed to decode cached receipts, refetching", "block", block, "err", err)
+		} else if err := validateReceipts(block, blockInfo.ReceiptHash(), txHashes, receipts); err != nil {
+			p.log.Warn("Invalid cached receipts, refetching", "block", block, "err", err)
+		} else {
+			return receipts, nil
+		}
+	}
+	receipts, err := p.inner.FetchReceipts(ctx, blockInfo, txHashes)
+	if err != nil {
+		return nil, err
+	}
+	// The JSON encoding of receipts includes the derived fields, unlike the consensus encoding.
+	if data, err := json.Marshal(receipts); err != nil {
+		p.log.Warn("Failed to encode receipts for disk cache", "block", block, "err", err)
+	} else if _, err := p.cache.Add(block.Hash[:], data); err != nil {
+		p.log.Warn("Failed to cache receipts on disk", "block", block, "err", err)
+	}
+	return receipts, nil
+}
@@ -0,0 +1,98 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestPersistentReceiptsProvider(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(42)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, err := block.Info(true, true)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	newProvider := func(t *testing.T, inner ReceiptsProvider) (*persistentReceiptsProvider, func()) {
+		caches, err := openPersistentCaches(nil, dir, 1<<20)
+		require.NoError(t, err)
+		p := &persistentReceiptsProvider{inner: inner, cache: caches.receipts, log: testlog.Logger(t, log.LevelDebug)}
+		return p, func() { require.NoError(t, caches.Close()) }
+	}
+
+	mrp := new(mockReceiptsProvider)
+	mrp.On("FetchReceipts", ctx, block.BlockID(), txHashes).
+		Return(types.Receipts(receipts), error(nil)).
+		Once()
+	p, closeCaches := newProvider(t, mrp)
+	got, err := p.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, got, len(receipts))
+	closeCaches()
+	mrp.AssertExpectations(t)
+
+	// after reopening the cache, the receipts are served from disk, including their derived fields
+	p, closeCaches = newProvider(t, new(mockReceiptsProvider))
+	defer closeCaches()
+	got, err = p.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	for i, rec := range got {
+		requireEqualReceipt(t, receipts[i], rec)
+	}
+
+	// cached receipts that do not match the block are refetched
+	otherBlock, otherReceipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(43)), 2)
+	otherInfo, _, err := otherBlock.Info(true, true)
+	require.NoError(t, err)
+	otherHashes := receiptTxHashes(otherReceipts)
+	_, err = p.cache.Add(otherBlock.Hash[:], []byte("[]"))
+	require.NoError(t, err)
+	fetchErr := errors.New("boom")
+	inner := new(mockReceiptsProvider)
+	inner.On("FetchReceipts", mock.Anything, otherBlock.BlockID(), otherHashes).
+		Return(types.Receipts(nil), fetchErr).
+		Once()
+	p.inner = inner
+	_, err = p.FetchReceipts(ctx, otherInfo, otherHashes)
+	require.ErrorIs(t, err, fetchErr)
+	inner.AssertExpectations(t)
+}
+
+func TestPersistentHeaders(t *testing.T) {
+	block, _ := randomRpcBlockAndReceipts(rand.New(rand.NewSource(42)), 1)
+	info, _, err := block.Info(false, false)
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	caches, err := openPersistentCaches(nil, dir, 1<<20)
+	require.NoError(t, err)
+	_, ok := caches.Header(info.Hash())
+	require.False(t, ok)
+	require.NoError(t, caches.AddHeader(info))
+	require.NoError(t, caches.Close())
+
+	caches, err = openPersistentCaches(nil, dir, 1<<20)
+	require.NoError(t, err)
+	defer caches.Close()
+	got, ok := caches.Header(info.Hash())
+	require.True(t, ok)
+	require.Equal(t, info.Hash(), got.Hash())
+	require.Equal(t, info.NumberU64(), got.NumberU64())
+	require.Equal(t, info.ReceiptHash(), got.ReceiptHash())
+
+	// headers that do not match their hash are not served
+	_, err = caches.headers.Add(common.Hash{0x01}.Bytes(), []byte{0xc0})
+	require.NoError(t, err)
+	_, ok = caches.Header(common.Hash{0x01})
+	require.False(t, ok)
+}
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -62,3 +63,30 @@ func TestCachingReceiptsProvider_Concurrency(t *testing.T) {
 
 	mrp.AssertExpectations(t)
 }
+
+func TestCachingReceiptsProvider_FetchError(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(69)), 4)
+	txHashes := receiptTxHashes(receipts)
+	blockid := block.BlockID()
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 1)
+	ctx := context.Background()
+
+	mrp.On("FetchReceipts", ctx, blockid, txHashes).
+		Return(types.Receipts(nil), errors.New("boom")).
+		Once()
+	mrp.On("FetchReceipts", ctx, blockid, txHashes).
+		Return(types.Receipts(receipts), error(nil)).
+		Once()
+
+	bInfo, _, _ := block.Info(true, true)
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorContains(t, err, "boom")
+	require.Zero(t, rp.fetching.Len(), "fetching lock must be released on error")
+
+	gotRecs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, gotRecs, len(receipts))
+	require.Zero(t, rp.fetching.Len())
+	mrp.AssertExpectations(t)
+}
@@ -16,13 +16,19 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
-func newRPCRecProviderFromConfig(client client.RPC, log log.Logger, metrics caching.Metrics, config *EthClientConfig) *CachingReceiptsProvider {
+// newRPCRecProviderFromConfig creates the receipts provider of the config.
+// The receipts are also cached on disk, if a disk cache is provided.
+func newRPCRecProviderFromConfig(client client.RPC, log log.Logger, metrics caching.Metrics, config *EthClientConfig, disk *caching.DiskCache) *CachingReceiptsProvider {
 	recCfg := RPCReceiptsConfig{
 		MaxBatchSize:        config.MaxRequestsPerBatch,
 		ProviderKind:        config.RPCProviderKind,
 		MethodResetDuration: config.MethodResetDuration,
 	}
-	return NewCachingRPCReceiptsProvider(client, log, recCfg, metrics, config.ReceiptsCacheSize)
+	if disk == nil {
+		return NewCachingRPCReceiptsProvider(client, log, recCfg, metrics, config.ReceiptsCacheSize)
+	}
+	inner := &persistentReceiptsProvider{inner: NewRPCReceiptsFetcher(client, log, recCfg), cache: disk, log: log}
+	return NewCachingReceiptsProvider(inner, metrics, config.ReceiptsCacheSize)
 }
 
 type rpcClient interface {
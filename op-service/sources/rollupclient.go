@@ -32,6 +32,18 @@ func (r *RollupClient) SafeHeadAtL1Block(ctx context.Context, blockNum uint64) (
 	return output, err
 }
 
+func (r *RollupClient) DerivationReceipt(ctx context.Context, blockNum uint64) (*eth.DerivationReceipt, error) {
+	var output *eth.DerivationReceipt
+	err := r.rpc.CallContext(ctx, &output, "optimism_derivationReceipt", hexutil.Uint64(blockNum))
+	return output, err
+}
+
+func (r *RollupClient) SafeBlockJustification(ctx context.Context, blockNum uint64) (*eth.SafeBlockJustification, error) {
+	var output *eth.SafeBlockJustification
+	err := r.rpc.CallContext(ctx, &output, "optimism_safeBlockJustification", hexutil.Uint64(blockNum))
+	return output, err
+}
+
 func (r *RollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	var output *eth.SyncStatus
 	err := r.rpc.CallContext(ctx, &output, "optimism_syncStatus")
@@ -50,6 +62,12 @@ func (r *RollupClient) Version(ctx context.Context) (string, error) {
 	return output, err
 }
 
+func (r *RollupClient) DerivationPipeline(ctx context.Context) (*eth.DerivationPipelineStatus, error) {
+	var output *eth.DerivationPipelineStatus
+	err := r.rpc.CallContext(ctx, &output, "debug_derivationPipeline")
+	return output, err
+}
+
 func (r *RollupClient) StartSequencer(ctx context.Context, unsafeHead common.Hash) error {
 	return r.rpc.CallContext(ctx, nil, "admin_startSequencer", unsafeHead)
 }
@@ -66,6 +84,16 @@ func (r *RollupClient) SequencerActive(ctx context.Context) (bool, error) {
 	return result, err
 }
 
+func (r *RollupClient) SequencerStatus(ctx context.Context) (*eth.SequencerStatus, error) {
+	var result *eth.SequencerStatus
+	err := r.rpc.CallContext(ctx, &result, "admin_sequencerStatus")
+	return result, err
+}
+
+func (r *RollupClient) SetBlockBuildingLimits(ctx context.Context, limits eth.BlockBuildingLimits) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setBlockBuildingLimits", limits)
+}
+
 func (r *RollupClient) PostUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error {
 	return r.rpc.CallContext(ctx, nil, "admin_postUnsafePayload", payload)
 }
@@ -74,6 +102,14 @@ func (r *RollupClient) OverrideLeader(ctx context.Context) error {
 	return r.rpc.CallContext(ctx, nil, "admin_overrideLeader")
 }
 
+func (r *RollupClient) OverrideProtocolVersionHalt(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_overrideProtocolVersionHalt")
+}
+
+func (r *RollupClient) AcknowledgeL1Reorg(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_acknowledgeL1Reorg")
+}
+
 func (r *RollupClient) SetLogLevel(ctx context.Context, lvl slog.Level) error {
 	return r.rpc.CallContext(ctx, nil, "admin_setLogLevel", lvl.String())
 }
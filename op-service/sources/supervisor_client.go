@@ -32,6 +32,18 @@ func (cl *SupervisorClient) CheckBlock(ctx context.Context,
 	return result, nil
 }
 
+// CheckMessageValidity checks if the message can be executed at the given timestamp.
+func (cl *SupervisorClient) CheckMessageValidity(ctx context.Context,
+	identifier types.Identifier, payloadHash common.Hash, executingTimestamp uint64) (types.MessageValidity, error) {
+	var result types.MessageValidity
+	err := cl.client.CallContext(ctx, &result, "supervisor_checkMessageValidity",
+		identifier, payloadHash, hexutil.Uint64(executingTimestamp))
+	if err != nil {
+		return types.MessageValidity{}, fmt.Errorf("failed to check validity of message %v: %w", identifier, err)
+	}
+	return result, nil
+}
+
 func (cl *SupervisorClient) Close() {
 	cl.client.Close()
 }
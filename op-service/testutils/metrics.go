@@ -9,11 +9,12 @@ import (
 // TestDerivationMetrics implements the metrics used in the derivation pipeline as no-op operations.
 // Optionally a test may hook into the metrics
 type TestDerivationMetrics struct {
-	FnRecordL1ReorgDepth      func(d uint64)
-	FnRecordL1Ref             func(name string, ref eth.L1BlockRef)
-	FnRecordL2Ref             func(name string, ref eth.L2BlockRef)
-	FnRecordUnsafePayloads    func(length uint64, memSize uint64, next eth.BlockID)
-	FnRecordChannelInputBytes func(inputCompressedBytes int)
+	FnRecordL1ReorgDepth             func(d uint64)
+	FnRecordL1Ref                    func(name string, ref eth.L1BlockRef)
+	FnRecordL2Ref                    func(name string, ref eth.L2BlockRef)
+	FnRecordUnsafePayloads           func(length uint64, memSize uint64, next eth.BlockID)
+	FnRecordUnsafePayloadsQuarantine func(length uint64)
+	FnRecordChannelInputBytes        func(inputCompressedBytes int)
 }
 
 func (t *TestDerivationMetrics) CountSequencedTxs(count int) {
@@ -49,6 +50,12 @@ func (t *TestDerivationMetrics) RecordUnsafePayloadsBuffer(length uint64, memSiz
 	}
 }
 
+func (t *TestDerivationMetrics) RecordUnsafePayloadsQuarantine(length uint64) {
+	if t.FnRecordUnsafePayloadsQuarantine != nil {
+		t.FnRecordUnsafePayloadsQuarantine(length)
+	}
+}
+
 func (t *TestDerivationMetrics) RecordChannelInputBytes(inputCompressedBytes int) {
 	if t.FnRecordChannelInputBytes != nil {
 		t.FnRecordChannelInputBytes(inputCompressedBytes)
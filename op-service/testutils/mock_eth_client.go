@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
@@ -153,3 +154,12 @@ func (m *MockEthClient) MaybeClose() {
 func (m *MockEthClient) Close() {
 	m.Mock.Called()
 }
+
+func (m *MockEthClient) Client() *rpc.Client {
+	out := m.Mock.Called()
+	return out.Get(0).(*rpc.Client)
+}
+
+func (m *MockEthClient) ExpectClient(cl *rpc.Client) {
+	m.Mock.On("Client").Return(cl)
+}
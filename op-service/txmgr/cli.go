@@ -12,6 +12,7 @@ import (
 	opcrypto "github.com/ethereum-optimism/optimism/op-service/crypto"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
@@ -38,6 +39,9 @@ const (
 	TxSendTimeoutFlagName             = "txmgr.send-timeout"
 	TxNotInMempoolTimeoutFlagName     = "txmgr.not-in-mempool-timeout"
 	ReceiptQueryIntervalFlagName      = "txmgr.receipt-query-interval"
+	SimulateTxFlagName                = "txmgr.simulate"
+	SpendCapFlagName                  = "txmgr.spend-cap"
+	SpendStateFileFlagName            = "txmgr.spend-cap.state-file"
 )
 
 var (
@@ -67,6 +71,7 @@ type DefaultFlagValues struct {
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
 	ReceiptQueryInterval      time.Duration
+	SimulateTx                bool
 }
 
 var (
@@ -191,6 +196,22 @@ func CLIFlagsWithDefaults(envPrefix string, defaults DefaultFlagValues) []cli.Fl
 			Value:   defaults.ReceiptQueryInterval,
 			EnvVars: prefixEnvVars("TXMGR_RECEIPT_QUERY_INTERVAL"),
 		},
+		&cli.BoolFlag{
+			Name:    SimulateTxFlagName,
+			Usage:   "Simulate transactions with eth_call at the pending block before sending, and abort if they revert. Adds an eth_call before every transaction",
+			Value:   defaults.SimulateTx,
+			EnvVars: prefixEnvVars("TXMGR_SIMULATE"),
+		},
+		&cli.Float64Flag{
+			Name:    SpendCapFlagName,
+			Usage:   "Maximum amount (in ETH) spent on fees and value by transactions within a rolling 24 hour window. New transactions exceeding the cap are refused. Disabled if 0.",
+			EnvVars: prefixEnvVars("TXMGR_SPEND_CAP"),
+		},
+		&cli.StringFlag{
+			Name:    SpendStateFileFlagName,
+			Usage:   "File to persist the spends counted against the spend cap to, so the cap holds across restarts. Spends are kept in memory only if empty.",
+			EnvVars: prefixEnvVars("TXMGR_SPEND_CAP_STATE_FILE"),
+		},
 	}, opsigner.CLIFlags(envPrefix)...)
 }
 
@@ -213,6 +234,12 @@ type CLIConfig struct {
 	NetworkTimeout            time.Duration
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
+	SimulateTx                bool
+	SpendCapEth               float64
+	SpendStateFile            string
+	// ErrorABIs are the contract ABIs used to decode the custom errors of reverting txs.
+	// They are set by the service, not by flags.
+	ErrorABIs []*abi.ABI
 }
 
 func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
@@ -229,6 +256,7 @@ func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
 		TxSendTimeout:             defaults.TxSendTimeout,
 		TxNotInMempoolTimeout:     defaults.TxNotInMempoolTimeout,
 		ReceiptQueryInterval:      defaults.ReceiptQueryInterval,
+		SimulateTx:                defaults.SimulateTx,
 		SignerCLIConfig:           opsigner.NewCLIConfig(),
 	}
 }
@@ -262,6 +290,9 @@ func (m CLIConfig) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	if m.SpendCapEth < 0 {
+		return errors.New("SpendCapEth must not be negative")
+	}
 	if err := m.SignerCLIConfig.Check(); err != nil {
 		return err
 	}
@@ -288,6 +319,9 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		NetworkTimeout:            ctx.Duration(NetworkTimeoutFlagName),
 		TxSendTimeout:             ctx.Duration(TxSendTimeoutFlagName),
 		TxNotInMempoolTimeout:     ctx.Duration(TxNotInMempoolTimeoutFlagName),
+		SimulateTx:                ctx.Bool(SimulateTxFlagName),
+		SpendCapEth:               ctx.Float64(SpendCapFlagName),
+		SpendStateFile:            ctx.String(SpendStateFileFlagName),
 	}
 }
 
@@ -338,6 +372,14 @@ func NewConfig(cfg CLIConfig, l log.Logger) (*Config, error) {
 		return nil, fmt.Errorf("invalid min tip cap: %w", err)
 	}
 
+	var spendCap *big.Int
+	if cfg.SpendCapEth > 0 {
+		spendCap, err = eth.GweiToWei(cfg.SpendCapEth * params.Ether / params.GWei)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spend cap: %w", err)
+		}
+	}
+
 	res := Config{
 		Backend:                   l1,
 		ChainID:                   chainID,
@@ -347,6 +389,10 @@ func NewConfig(cfg CLIConfig, l log.Logger) (*Config, error) {
 		ReceiptQueryInterval:      cfg.ReceiptQueryInterval,
 		NumConfirmations:          cfg.NumConfirmations,
 		SafeAbortNonceTooLowCount: cfg.SafeAbortNonceTooLowCount,
+		SimulateTx:                cfg.SimulateTx,
+		ErrorDecoder:              NewErrorDecoder(cfg.ErrorABIs...),
+		SpendCap:                  spendCap,
+		SpendStateFile:            cfg.SpendStateFile,
 		Signer:                    signerFactory(chainID),
 		From:                      from,
 	}
@@ -415,6 +461,19 @@ type Config struct {
 	// confirmation.
 	SafeAbortNonceTooLowCount uint64
 
+	// SimulateTx enables the simulation of txs with eth_call before sending them.
+	// Txs that revert in simulation are not sent, unless the candidate allows reverts.
+	SimulateTx bool
+
+	// ErrorDecoder decodes the revert reason of txs that revert in simulation. Optional.
+	ErrorDecoder *ErrorDecoder
+
+	// SpendCap is the maximum amount (in Wei) spent by txs within the rolling SpendWindow.
+	// New txs and fee bumps that may exceed the cap are refused. Optional.
+	SpendCap *big.Int
+	// SpendStateFile is the file the spends counted against the spend cap are persisted to. Optional.
+	SpendStateFile string
+
 	// Signer is used to sign transactions when the gas price is increased.
 	Signer opcrypto.SignerFn
 	From   common.Address
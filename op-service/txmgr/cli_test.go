@@ -22,6 +22,13 @@ func TestDefaultConfigIsValid(t *testing.T) {
 	require.NoError(t, cfg.Check())
 }
 
+func TestSimulateTxOptIn(t *testing.T) {
+	require.False(t, DefaultBatcherFlagValues.SimulateTx)
+	require.False(t, DefaultChallengerFlagValues.SimulateTx)
+	require.False(t, configForArgs().SimulateTx)
+	require.True(t, configForArgs("test", "--"+SimulateTxFlagName).SimulateTx)
+}
+
 func configForArgs(args ...string) CLIConfig {
 	app := cli.NewApp()
 	// txmgr expects the --l1-eth-rpc option to be declared externally
@@ -0,0 +1,93 @@
+package txmgr
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// inflightNonces tracks the transactions of all nonces that are not yet confirmed.
+// Transactions of which the send was abandoned, e.g. because the send timed out, are kept until
+// their nonce is confirmed, so they can be resubmitted when they block transactions with later nonces.
+// The zero value is ready to use.
+type inflightNonces struct {
+	mu  sync.Mutex
+	txs map[uint64]*inflightTx
+}
+
+type inflightTx struct {
+	tx        *types.Transaction
+	abandoned bool
+}
+
+// Sending records the latest published transaction of a nonce that is being sent.
+func (n *inflightNonces) Sending(tx *types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.txs == nil {
+		n.txs = make(map[uint64]*inflightTx)
+	}
+	n.txs[tx.Nonce()] = &inflightTx{tx: tx}
+}
+
+// Done removes the nonce once its transaction is confirmed.
+func (n *inflightNonces) Done(nonce uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.txs, nonce)
+}
+
+// Abandon marks the transaction as no longer being sent.
+// Nothing is changed if the nonce has since been taken over by another transaction.
+func (n *inflightNonces) Abandon(tx *types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if entry, ok := n.txs[tx.Nonce()]; ok && entry.tx.Hash() == tx.Hash() {
+		entry.abandoned = true
+	}
+}
+
+// Abandoned returns the abandoned transaction of the nonce, or nil if there is none.
+func (n *inflightNonces) Abandoned(nonce uint64) *types.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if entry, ok := n.txs[nonce]; ok && entry.abandoned {
+		return entry.tx
+	}
+	return nil
+}
+
+// Resubmitted replaces the abandoned transaction of the nonce with its resubmitted version.
+func (n *inflightNonces) Resubmitted(tx *types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if entry, ok := n.txs[tx.Nonce()]; ok && entry.abandoned {
+		entry.tx = tx
+	}
+}
+
+// LowestSending returns the lowest nonce that is still being sent.
+func (n *inflightNonces) LowestSending() (uint64, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var lowest uint64
+	found := false
+	for nonce, entry := range n.txs {
+		if !entry.abandoned && (!found || nonce < lowest) {
+			lowest = nonce
+			found = true
+		}
+	}
+	return lowest, found
+}
+
+// Confirmed removes the abandoned transactions of all nonces below the confirmed account nonce.
+func (n *inflightNonces) Confirmed(accountNonce uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for nonce, entry := range n.txs {
+		if entry.abandoned && nonce < accountNonce {
+			delete(n.txs, nonce)
+		}
+	}
+}
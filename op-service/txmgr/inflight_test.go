@@ -0,0 +1,52 @@
+package txmgr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInflightNonces(t *testing.T) {
+	newTx := func(nonce uint64, gasPrice int64) *types.Transaction {
+		return types.NewTx(&types.DynamicFeeTx{Nonce: nonce, GasFeeCap: big.NewInt(gasPrice)})
+	}
+	var n inflightNonces
+	_, ok := n.LowestSending()
+	require.False(t, ok)
+
+	tx1, tx2, tx3 := newTx(1, 1), newTx(2, 1), newTx(3, 1)
+	n.Sending(tx1)
+	n.Sending(tx2)
+	n.Sending(tx3)
+	lowest, ok := n.LowestSending()
+	require.True(t, ok)
+	require.Equal(t, uint64(1), lowest)
+
+	// Only the latest published tx of a nonce can be abandoned
+	n.Abandon(newTx(1, 2))
+	require.Nil(t, n.Abandoned(1))
+	n.Abandon(tx1)
+	require.Equal(t, tx1, n.Abandoned(1))
+	lowest, _ = n.LowestSending()
+	require.Equal(t, uint64(2), lowest)
+
+	// Resubmissions only replace abandoned txs
+	bumped := newTx(1, 2)
+	n.Resubmitted(bumped)
+	n.Resubmitted(newTx(2, 2))
+	require.Equal(t, bumped, n.Abandoned(1))
+	require.Nil(t, n.Abandoned(2))
+
+	// Sending the nonce again takes over the abandoned tx
+	n.Sending(tx1)
+	require.Nil(t, n.Abandoned(1))
+	n.Abandon(tx1)
+
+	n.Done(2)
+	n.Confirmed(2)
+	require.Nil(t, n.Abandoned(1))
+	lowest, _ = n.LowestSending()
+	require.Equal(t, uint64(3), lowest)
+}
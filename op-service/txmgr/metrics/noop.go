@@ -17,4 +17,8 @@ func (*NoopTxMetrics) TxPublished(string)                {}
 func (*NoopTxMetrics) RecordBaseFee(*big.Int)            {}
 func (*NoopTxMetrics) RecordBlobBaseFee(*big.Int)        {}
 func (*NoopTxMetrics) RecordTipCap(*big.Int)             {}
+func (*NoopTxMetrics) RecordNonceGap(uint64)             {}
+func (*NoopTxMetrics) RecordStuckTxResubmitted()         {}
+func (*NoopTxMetrics) RecordSpend(*big.Int)              {}
+func (*NoopTxMetrics) RecordSpendCapExceeded()           {}
 func (*NoopTxMetrics) RPCError()                         {}
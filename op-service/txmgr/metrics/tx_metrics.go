@@ -20,6 +20,10 @@ type TxMetricer interface {
 	RecordBaseFee(*big.Int)
 	RecordBlobBaseFee(*big.Int)
 	RecordTipCap(*big.Int)
+	RecordNonceGap(uint64)
+	RecordStuckTxResubmitted()
+	RecordSpend(*big.Int)
+	RecordSpendCapExceeded()
 	RPCError()
 }
 
@@ -39,6 +43,10 @@ type TxMetrics struct {
 	blobBaseFee        prometheus.Gauge
 	tipCap             prometheus.Gauge
 	rpcError           prometheus.Counter
+	nonceGap           prometheus.Gauge
+	stuckTxResubmitted prometheus.Counter
+	spend              prometheus.Gauge
+	spendCapExceeded   prometheus.Counter
 }
 
 func receiptStatusString(receipt *types.Receipt) string {
@@ -137,6 +145,30 @@ func MakeTxMetrics(ns string, factory metrics.Factory) TxMetrics {
 			Help:      "Temporary: Count of RPC errors (like timeouts) that have occurred",
 			Subsystem: "txmgr",
 		}),
+		nonceGap: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "nonce_gap",
+			Help:      "Number of nonces blocking the lowest pending transaction from being mined",
+			Subsystem: "txmgr",
+		}),
+		stuckTxResubmitted: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "stuck_tx_resubmitted_count",
+			Help:      "Count of abandoned transactions resubmitted to fill a nonce gap",
+			Subsystem: "txmgr",
+		}),
+		spend: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "spend_wei",
+			Help:      "Amount spent by transactions within the spend cap window, including the maximum cost of pending transactions",
+			Subsystem: "txmgr",
+		}),
+		spendCapExceeded: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "spend_cap_exceeded_count",
+			Help:      "Count of transactions refused because they may exceed the spend cap",
+			Subsystem: "txmgr",
+		}),
 	}
 }
 
@@ -192,3 +224,20 @@ func (t *TxMetrics) RecordTipCap(tipcap *big.Int) {
 func (t *TxMetrics) RPCError() {
 	t.rpcError.Inc()
 }
+
+func (t *TxMetrics) RecordNonceGap(gap uint64) {
+	t.nonceGap.Set(float64(gap))
+}
+
+func (t *TxMetrics) RecordStuckTxResubmitted() {
+	t.stuckTxResubmitted.Inc()
+}
+
+func (t *TxMetrics) RecordSpend(spent *big.Int) {
+	sf, _ := spent.Float64()
+	t.spend.Set(sf)
+}
+
+func (t *TxMetrics) RecordSpendCapExceeded() {
+	t.spendCapExceeded.Inc()
+}
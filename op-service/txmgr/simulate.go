@@ -0,0 +1,120 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertError is returned when a transaction candidate reverts in simulation, instead of sending a doomed tx.
+type RevertError struct {
+	// Data is the raw revert data. Empty if the call reverted without data.
+	Data []byte
+	// Reason is the decoded revert reason, or empty if the revert data could not be decoded.
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("tx simulation reverted: %s", e.Reason)
+	}
+	return fmt.Sprintf("tx simulation reverted with data %v", hexutil.Bytes(e.Data))
+}
+
+// ErrorDecoder decodes revert data into a human-readable reason,
+// using the custom errors of a set of contract ABIs, in addition to the standard Error(string) and Panic(uint256).
+type ErrorDecoder struct {
+	errors map[[4]byte]abi.Error
+}
+
+// NewErrorDecoder creates a decoder of the custom errors defined by the given ABIs.
+func NewErrorDecoder(abis ...*abi.ABI) *ErrorDecoder {
+	d := &ErrorDecoder{errors: make(map[[4]byte]abi.Error)}
+	for _, a := range abis {
+		for _, e := range a.Errors {
+			d.errors[[4]byte(e.ID[:4])] = e
+		}
+	}
+	return d
+}
+
+// Decode returns the reason of the revert data, or an empty string if it cannot be decoded.
+// A nil decoder only decodes the standard errors.
+func (d *ErrorDecoder) Decode(data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if d == nil || len(data) < 4 {
+		return ""
+	}
+	e, ok := d.errors[[4]byte(data[:4])]
+	if !ok {
+		return ""
+	}
+	args, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(e.Name)
+	sb.WriteString("(")
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprint(arg))
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// simulate executes the candidate with eth_call at the pending block, and returns a [RevertError] if it reverts.
+// Blob txs are not simulated, since the call cannot carry the blobs.
+// Other failures of the call are logged but not returned, since they do not indicate the tx would revert.
+func (m *SimpleTxManager) simulate(ctx context.Context, candidate TxCandidate) error {
+	if len(candidate.Blobs) > 0 {
+		return nil
+	}
+	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	_, err := m.backend.CallContract(cCtx, ethereum.CallMsg{
+		From:  m.cfg.From,
+		To:    candidate.To,
+		Gas:   candidate.GasLimit,
+		Value: candidate.Value,
+		Data:  candidate.TxData,
+	}, big.NewInt(int64(rpc.PendingBlockNumber)))
+	if err == nil {
+		return nil
+	}
+	revertErr, ok := m.toRevertError(err)
+	if !ok {
+		m.l.Warn("Failed to simulate tx, sending without simulation", "err", err)
+		return nil
+	}
+	return revertErr
+}
+
+// toRevertError converts the error of an eth_call to a [RevertError], if the call reverted.
+func (m *SimpleTxManager) toRevertError(err error) (*RevertError, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if s, ok := dataErr.ErrorData().(string); ok {
+			data, decErr := hexutil.Decode(s)
+			if decErr == nil {
+				return &RevertError{Data: data, Reason: m.cfg.ErrorDecoder.Decode(data)}, true
+			}
+		}
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return &RevertError{}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,122 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const testErrorsABI = `[
+	{"type": "error", "name": "ClaimAlreadyExists", "inputs": []},
+	{"type": "error", "name": "InsufficientBond", "inputs": [{"name": "required", "type": "uint256"}]}
+]`
+
+// revertRPCError mimics the error returned by geth for a reverting eth_call.
+type revertRPCError struct {
+	data []byte
+}
+
+func (e *revertRPCError) Error() string {
+	return "execution reverted"
+}
+
+func (e *revertRPCError) ErrorData() interface{} {
+	return hexutil.Encode(e.data)
+}
+
+var _ rpc.DataError = (*revertRPCError)(nil)
+
+// selector returns the selector of the named custom error.
+func selector(parsed abi.ABI, name string) []byte {
+	return parsed.Errors[name].ID.Bytes()[:4]
+}
+
+func testErrorDecoder(t *testing.T) (*ErrorDecoder, abi.ABI) {
+	parsed, err := abi.JSON(strings.NewReader(testErrorsABI))
+	require.NoError(t, err)
+	return NewErrorDecoder(&parsed), parsed
+}
+
+func TestErrorDecoder(t *testing.T) {
+	decoder, parsed := testErrorDecoder(t)
+
+	reason, err := (abi.Arguments{{Type: abi.Type{T: abi.StringTy}}}).Pack("not allowed")
+	require.NoError(t, err)
+	require.Equal(t, "not allowed", decoder.Decode(append(common.FromHex("0x08c379a0"), reason...)))
+
+	require.Equal(t, "ClaimAlreadyExists()", decoder.Decode(selector(parsed, "ClaimAlreadyExists")))
+
+	args, err := parsed.Errors["InsufficientBond"].Inputs.Pack(big.NewInt(42))
+	require.NoError(t, err)
+	require.Equal(t, "InsufficientBond(42)", decoder.Decode(append(selector(parsed, "InsufficientBond"), args...)))
+
+	require.Empty(t, decoder.Decode([]byte{0xde, 0xad, 0xbe, 0xef}))
+	require.Empty(t, decoder.Decode(nil))
+	require.Empty(t, (*ErrorDecoder)(nil).Decode(selector(parsed, "ClaimAlreadyExists")))
+}
+
+func TestSendSimulation(t *testing.T) {
+	decoder, parsed := testErrorDecoder(t)
+	revertData := selector(parsed, "ClaimAlreadyExists")
+
+	setup := func(t *testing.T, callErr error) (*testHarness, *int) {
+		cfg := configWithNumConfs(1)
+		cfg.SimulateTx = true
+		cfg.ErrorDecoder = decoder
+		h := newTestHarnessWithConfig(t, cfg)
+		sent := new(int)
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			*sent++
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap(), nil)
+			return nil
+		})
+		h.backend.call = func(call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			require.EqualValues(t, rpc.PendingBlockNumber, blockNumber.Int64())
+			return nil, callErr
+		}
+		return h, sent
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		h, sent := setup(t, nil)
+		_, err := h.mgr.Send(context.Background(), h.createTxCandidate())
+		require.NoError(t, err)
+		require.Equal(t, 1, *sent)
+	})
+
+	t.Run("Revert", func(t *testing.T) {
+		h, sent := setup(t, &revertRPCError{data: revertData})
+		_, err := h.mgr.Send(context.Background(), h.createTxCandidate())
+		var revertErr *RevertError
+		require.ErrorAs(t, err, &revertErr)
+		require.Equal(t, revertData, revertErr.Data)
+		require.Equal(t, "ClaimAlreadyExists()", revertErr.Reason)
+		require.Zero(t, *sent)
+	})
+
+	t.Run("AllowRevert", func(t *testing.T) {
+		h, sent := setup(t, &revertRPCError{data: revertData})
+		candidate := h.createTxCandidate()
+		candidate.AllowRevert = true
+		_, err := h.mgr.Send(context.Background(), candidate)
+		require.NoError(t, err)
+		require.Equal(t, 1, *sent)
+	})
+
+	t.Run("CallFailure", func(t *testing.T) {
+		h, sent := setup(t, context.DeadlineExceeded)
+		_, err := h.mgr.Send(context.Background(), h.createTxCandidate())
+		require.NoError(t, err)
+		require.Equal(t, 1, *sent)
+	})
+}
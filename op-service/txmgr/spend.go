@@ -0,0 +1,192 @@
+package txmgr
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// SpendWindow is the rolling window over which the spend cap applies.
+const SpendWindow = 24 * time.Hour
+
+var ErrSpendCapExceeded = errors.New("spend cap exceeded")
+
+type spend struct {
+	Time   time.Time    `json:"time"`
+	Amount *hexutil.Big `json:"amount"`
+}
+
+// spendState is the state of the spend tracker that is persisted.
+type spendState struct {
+	Spends []spend `json:"spends"`
+	// Reserved is the maximum cost of the unconfirmed txs of every nonce.
+	Reserved map[uint64]*hexutil.Big `json:"reserved"`
+}
+
+// spendTracker accounts the amount spent by confirmed txs within the rolling SpendWindow,
+// and the maximum amount that can be spent by the unconfirmed tx of every nonce.
+// The reservation of a nonce is kept until the nonce is confirmed, also if the send of its tx is abandoned,
+// as the tx may still be included. It only grows when the tx is replaced, e.g. by a fee bump,
+// as any of the txs of the nonce may be included.
+// A nonce that is confirmed without a known receipt, is accounted at the cost reserved for it.
+// The zero value is ready to use, and keeps its state in memory only.
+type spendTracker struct {
+	mu sync.Mutex
+	// now returns the current time, time.Now if nil.
+	now   func() time.Time
+	state spendState
+	// log and path are set if the state is persisted to a file.
+	log  log.Logger
+	path string
+}
+
+// persistTo persists the state to the file at the given path from now on,
+// and restores the state of a previous run from it, if it exists.
+func (s *spendTracker) persistTo(log log.Logger, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.log = log
+	s.path = path
+	state, err := jsonutil.LoadJSON[spendState](path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to load spend state: %w", err)
+	}
+	s.state = *state
+	return nil
+}
+
+func (s *spendTracker) time() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// persist writes the state to the file, if there is one. It must be called with the lock held.
+func (s *spendTracker) persist() {
+	if s.path == "" {
+		return
+	}
+	if err := jsonutil.WriteJSON(s.state, ioutil.ToAtomicFile(s.path, 0o644)); err != nil {
+		s.log.Error("Failed to persist spend state", "path", s.path, "err", err)
+	}
+}
+
+// spent returns the amount spent within the window, including the reserved amount.
+// It must be called with the lock held.
+func (s *spendTracker) spent() *big.Int {
+	cutoff := s.time().Add(-SpendWindow)
+	for len(s.state.Spends) > 0 && !s.state.Spends[0].Time.After(cutoff) {
+		s.state.Spends = s.state.Spends[1:]
+	}
+	total := new(big.Int)
+	for _, amount := range s.state.Reserved {
+		total.Add(total, amount.ToInt())
+	}
+	for _, sp := range s.state.Spends {
+		total.Add(total, sp.Amount.ToInt())
+	}
+	return total
+}
+
+// Spent returns the amount spent within the window, including the amount reserved for unconfirmed nonces.
+func (s *spendTracker) Spent() *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.spent()
+}
+
+// Reserve reserves the maximum amount the tx of the nonce can spend, if it fits within the spend cap.
+// If the nonce already has a reservation, the tx replaces a previous tx of the nonce,
+// and the reservation is raised to the amount, if it is larger.
+func (s *spendTracker) Reserve(limit *big.Int, nonce uint64, amount *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev := new(big.Int)
+	if r, ok := s.state.Reserved[nonce]; ok {
+		prev = r.ToInt()
+	}
+	if amount.Cmp(prev) <= 0 {
+		return nil
+	}
+	spent := s.spent()
+	if new(big.Int).Add(spent, new(big.Int).Sub(amount, prev)).Cmp(limit) > 0 {
+		return fmt.Errorf("%w: spent %v wei in the last %v, tx may spend up to %v wei, cap is %v wei",
+			ErrSpendCapExceeded, spent, SpendWindow, amount, limit)
+	}
+	if s.state.Reserved == nil {
+		s.state.Reserved = make(map[uint64]*hexutil.Big)
+	}
+	s.state.Reserved[nonce] = (*hexutil.Big)(new(big.Int).Set(amount))
+	s.persist()
+	return nil
+}
+
+// Mined replaces the reservation of the nonce with the actual amount spent by its included tx.
+// All lower nonces are confirmed as well.
+func (s *spendTracker) Mined(nonce uint64, actual *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.state.Reserved, nonce)
+	s.state.Spends = append(s.state.Spends, spend{Time: s.time(), Amount: (*hexutil.Big)(new(big.Int).Set(actual))})
+	s.confirmed(nonce)
+	s.persist()
+}
+
+// Confirmed accounts the reservations of all nonces below the confirmed account nonce as spent.
+func (s *spendTracker) Confirmed(accountNonce uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.confirmed(accountNonce) {
+		s.persist()
+	}
+}
+
+// confirmed must be called with the lock held. It returns true if any reservation was confirmed.
+func (s *spendTracker) confirmed(accountNonce uint64) bool {
+	changed := false
+	for nonce, amount := range s.state.Reserved {
+		if nonce < accountNonce {
+			s.state.Spends = append(s.state.Spends, spend{Time: s.time(), Amount: amount})
+			delete(s.state.Reserved, nonce)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// maxTxCost returns the maximum amount the tx can spend, at its current fee caps.
+func maxTxCost(tx *types.Transaction) *big.Int {
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+	if tx.Type() == types.BlobTxType {
+		cost.Add(cost, new(big.Int).Mul(new(big.Int).SetUint64(tx.BlobGas()), tx.BlobGasFeeCap()))
+	}
+	return cost.Add(cost, tx.Value())
+}
+
+// receiptCost returns the amount spent by the confirmed tx with the given value.
+func receiptCost(receipt *types.Receipt, value *big.Int) *big.Int {
+	cost := new(big.Int)
+	if receipt.EffectiveGasPrice != nil {
+		cost.Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	}
+	if receipt.BlobGasPrice != nil {
+		cost.Add(cost, new(big.Int).Mul(new(big.Int).SetUint64(receipt.BlobGasUsed), receipt.BlobGasPrice))
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful && value != nil {
+		cost.Add(cost, value)
+	}
+	return cost
+}
@@ -0,0 +1,161 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+func TestSpendTracker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	s := spendTracker{now: func() time.Time { return now }}
+	limit := big.NewInt(100)
+
+	require.NoError(t, s.Reserve(limit, 1, big.NewInt(60)))
+	require.Equal(t, big.NewInt(60), s.Spent())
+
+	// The reservation of the first tx counts against the cap
+	require.ErrorIs(t, s.Reserve(limit, 2, big.NewInt(50)), ErrSpendCapExceeded)
+
+	// A replacement of the tx only counts the increase of its cost
+	require.NoError(t, s.Reserve(limit, 1, big.NewInt(40)))
+	require.Equal(t, big.NewInt(60), s.Spent())
+	require.NoError(t, s.Reserve(limit, 1, big.NewInt(90)))
+	require.Equal(t, big.NewInt(90), s.Spent())
+	require.ErrorIs(t, s.Reserve(limit, 1, big.NewInt(101)), ErrSpendCapExceeded)
+
+	// Only the actual amount spent remains once the tx is mined
+	s.Mined(1, big.NewInt(30))
+	require.Equal(t, big.NewInt(30), s.Spent())
+
+	now = now.Add(time.Hour)
+	require.NoError(t, s.Reserve(limit, 2, big.NewInt(40)))
+	require.NoError(t, s.Reserve(limit, 3, big.NewInt(20)))
+	require.ErrorIs(t, s.Reserve(limit, 4, big.NewInt(11)), ErrSpendCapExceeded)
+
+	// The reservation of a lower nonce is spent once a later nonce is mined
+	s.Mined(3, big.NewInt(5))
+	require.Equal(t, big.NewInt(30+40+5), s.Spent())
+
+	// Reservations of nonces below the account nonce are spent
+	require.NoError(t, s.Reserve(limit, 4, big.NewInt(10)))
+	s.Confirmed(4)
+	require.Equal(t, big.NewInt(85), s.Spent())
+	s.Confirmed(5)
+	require.Equal(t, big.NewInt(85), s.Spent())
+
+	// Spends leave the window after SpendWindow
+	now = now.Add(SpendWindow - time.Hour)
+	require.Equal(t, big.NewInt(55), s.Spent())
+	now = now.Add(time.Hour)
+	require.Equal(t, big.NewInt(0), s.Spent())
+	require.NoError(t, s.Reserve(limit, 5, big.NewInt(100)))
+}
+
+func TestSpendTrackerPersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spends.json")
+	logger := testlog.Logger(t, log.LevelInfo)
+	limit := big.NewInt(100)
+	var s spendTracker
+	require.NoError(t, s.persistTo(logger, path))
+	require.NoError(t, s.Reserve(limit, 1, big.NewInt(60)))
+	s.Mined(1, big.NewInt(30))
+	require.NoError(t, s.Reserve(limit, 2, big.NewInt(50)))
+
+	// Both the spends and the reservations survive a restart
+	var restarted spendTracker
+	require.NoError(t, restarted.persistTo(logger, path))
+	require.Equal(t, big.NewInt(80), restarted.Spent())
+	require.ErrorIs(t, restarted.Reserve(limit, 3, big.NewInt(21)), ErrSpendCapExceeded)
+	restarted.Confirmed(3)
+	require.Equal(t, big.NewInt(80), restarted.Spent())
+}
+
+func TestTxCost(t *testing.T) {
+	tx := types.NewTx(&types.DynamicFeeTx{Gas: 10, GasFeeCap: big.NewInt(3), Value: big.NewInt(5)})
+	require.Equal(t, big.NewInt(35), maxTxCost(tx))
+
+	blobTx := types.NewTx(&types.BlobTx{
+		Gas:        10,
+		GasFeeCap:  uint256.NewInt(3),
+		BlobFeeCap: uint256.NewInt(2),
+		BlobHashes: []common.Hash{{}},
+	})
+	require.Equal(t, new(big.Int).SetUint64(30+2*blobTx.BlobGas()), maxTxCost(blobTx))
+
+	receipt := &types.Receipt{
+		Status:            types.ReceiptStatusSuccessful,
+		GasUsed:           7,
+		EffectiveGasPrice: big.NewInt(2),
+		BlobGasUsed:       4,
+		BlobGasPrice:      big.NewInt(3),
+	}
+	require.Equal(t, big.NewInt(14+12+5), receiptCost(receipt, big.NewInt(5)))
+
+	// The value is not transferred by failed txs
+	receipt.Status = types.ReceiptStatusFailed
+	require.Equal(t, big.NewInt(14+12), receiptCost(receipt, big.NewInt(5)))
+}
+
+func TestTxMgr_SpendCapExceeded(t *testing.T) {
+	cfg := configWithNumConfs(1)
+	cfg.SpendCap = big.NewInt(1)
+	h := newTestHarnessWithConfig(t, cfg)
+	sent := false
+	h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+		sent = true
+		return nil
+	})
+
+	_, err := h.mgr.Send(context.Background(), h.createTxCandidate())
+	require.ErrorIs(t, err, ErrSpendCapExceeded)
+	require.False(t, sent)
+}
+
+func TestTxMgr_SpendCapFeeBump(t *testing.T) {
+	cfg := Config{
+		Signer: func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+		SpendCap: big.NewInt(10_000),
+	}
+	cfg.FeeLimitMultiplier.Store(5)
+	mgr := &SimpleTxManager{
+		cfg:     &cfg,
+		name:    "TEST",
+		backend: &failingBackend{gasTip: big.NewInt(10), baseFee: big.NewInt(100), returnSuccessHeader: true},
+		l:       testlog.Logger(t, log.LevelCrit),
+		metr:    &metrics.NoopTxMetrics{},
+	}
+	tx := types.NewTx(&types.DynamicFeeTx{Nonce: 1, Gas: 100, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(100)})
+	require.NoError(t, mgr.reserveSpend(tx))
+	require.Equal(t, big.NewInt(10_000), mgr.spending.Spent())
+
+	// The bumped tx may cost more than the original, which no longer fits within the cap
+	_, err := mgr.increaseGasPrice(context.Background(), tx)
+	require.ErrorIs(t, err, ErrSpendCapExceeded)
+	require.Equal(t, big.NewInt(10_000), mgr.spending.Spent())
+
+	cfg.SpendCap = big.NewInt(100_000)
+	bumped, err := mgr.increaseGasPrice(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, maxTxCost(bumped), mgr.spending.Spent())
+	require.Greater(t, maxTxCost(bumped).Uint64(), uint64(10_000))
+
+	// The reservation is kept if the send is abandoned, until the nonce is confirmed
+	mgr.spending.Confirmed(1)
+	require.Equal(t, maxTxCost(bumped), mgr.spending.Spent())
+	mgr.spending.Confirmed(2)
+	require.Equal(t, maxTxCost(bumped), mgr.spending.Spent(), "confirmed reservation is spent")
+}
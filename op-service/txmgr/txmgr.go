@@ -140,6 +140,10 @@ type SimpleTxManager struct {
 	nonce     *uint64
 	nonceLock sync.RWMutex
 
+	inflight inflightNonces
+
+	spending spendTracker
+
 	pending atomic.Int64
 
 	closed atomic.Bool
@@ -159,14 +163,20 @@ func NewSimpleTxManagerFromConfig(name string, l log.Logger, m metrics.TxMetrice
 	if err := conf.Check(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
-	return &SimpleTxManager{
+	mgr := &SimpleTxManager{
 		chainID: conf.ChainID,
 		name:    name,
 		cfg:     conf,
 		backend: conf.Backend,
 		l:       l.New("service", name),
 		metr:    m,
-	}, nil
+	}
+	if conf.SpendCap != nil && conf.SpendStateFile != "" {
+		if err := mgr.spending.persistTo(mgr.l, conf.SpendStateFile); err != nil {
+			return nil, err
+		}
+	}
+	return mgr, nil
 }
 
 func (m *SimpleTxManager) From() common.Address {
@@ -220,6 +230,8 @@ type TxCandidate struct {
 	GasLimit uint64
 	// Value is the value to be used in the constructed tx.
 	Value *big.Int
+	// AllowRevert sends the tx even if it reverts in simulation.
+	AllowRevert bool
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -253,6 +265,10 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 		m.resetNonce()
 		return nil, err
 	}
+	if err := m.reserveSpend(tx); err != nil {
+		m.resetNonce()
+		return nil, err
+	}
 	receipt, err := m.sendTx(ctx, tx)
 	if err != nil {
 		m.resetNonce()
@@ -285,6 +301,9 @@ func (m *SimpleTxManager) SendAsync(ctx context.Context, candidate TxCandidate,
 	}
 
 	tx, err := m.prepare(ctx, candidate)
+	if err == nil {
+		err = m.reserveSpend(tx)
+	}
 	if err != nil {
 		m.resetNonce()
 		cancel()
@@ -313,6 +332,11 @@ func (m *SimpleTxManager) SendAsync(ctx context.Context, candidate TxCandidate,
 
 // prepare prepares the transaction for sending.
 func (m *SimpleTxManager) prepare(ctx context.Context, candidate TxCandidate) (*types.Transaction, error) {
+	if m.cfg.SimulateTx && !candidate.AllowRevert {
+		if err := m.simulate(ctx, candidate); err != nil {
+			return nil, err
+		}
+	}
 	tx, err := retry.Do(ctx, 30, retry.Fixed(2*time.Second), func() (*types.Transaction, error) {
 		if m.closed.Load() {
 			return nil, ErrClosed
@@ -329,6 +353,30 @@ func (m *SimpleTxManager) prepare(ctx context.Context, candidate TxCandidate) (*
 	return tx, nil
 }
 
+// reserveSpend reserves the maximum cost of the tx against the spend cap, if one is configured.
+// The reservation is kept until the nonce of the tx is confirmed, and raised if the tx is replaced with a higher cost.
+func (m *SimpleTxManager) reserveSpend(tx *types.Transaction) error {
+	if m.cfg.SpendCap == nil {
+		return nil
+	}
+	if err := m.spending.Reserve(m.cfg.SpendCap, tx.Nonce(), maxTxCost(tx)); err != nil {
+		m.metr.RecordSpendCapExceeded()
+		m.txLogger(tx, true).Error("Refusing to send transaction", "err", err)
+		return err
+	}
+	m.metr.RecordSpend(m.spending.Spent())
+	return nil
+}
+
+// recordMined replaces the spend reservation of the nonce of the tx with the cost of its receipt.
+func (m *SimpleTxManager) recordMined(tx *types.Transaction, receipt *types.Receipt) {
+	if m.cfg.SpendCap == nil {
+		return
+	}
+	m.spending.Mined(tx.Nonce(), receiptCost(receipt, tx.Value()))
+	m.metr.RecordSpend(m.spending.Spent())
+}
+
 // craftTx creates the signed transaction
 // It queries L1 for the current fee market conditions as well as for the nonce.
 // NOTE: This method SHOULD NOT publish the resulting transaction.
@@ -547,6 +595,17 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 	ticker := time.NewTicker(resubmissionTimeout)
 	defer ticker.Stop()
 
+	m.inflight.Sending(tx)
+	confirmed := false
+	defer func() {
+		if confirmed {
+			m.inflight.Done(tx.Nonce())
+		} else {
+			// Keep the abandoned tx around, to resubmit it if it blocks later nonces
+			m.inflight.Abandon(tx)
+		}
+	}()
+
 	for {
 		if !sendState.IsWaitingForConfirmation() {
 			if m.closed.Load() {
@@ -556,6 +615,7 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 			}
 			var published bool
 			if tx, published = m.publishTx(ctx, tx, sendState); published {
+				m.inflight.Sending(tx)
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
@@ -570,11 +630,16 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 
 		select {
 		case <-ticker.C:
+			if !sendState.IsWaitingForConfirmation() {
+				m.resubmitStuckAncestors(ctx, tx.Nonce())
+			}
 
 		case <-ctx.Done():
 			return nil, ctx.Err()
 
 		case receipt := <-receiptChan:
+			confirmed = true
+			m.recordMined(tx, receipt)
 			m.metr.RecordGasBumpCount(sendState.bumpCount)
 			m.metr.TxConfirmed(receipt)
 			return receipt, nil
@@ -671,6 +736,60 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 	}
 }
 
+// resubmitStuckAncestors detects a gap between the confirmed account nonce and the nonce of a transaction
+// that is not getting mined. Any transaction filling the gap of which the send was abandoned,
+// e.g. because it was dropped or underpriced and the send timed out, is resubmitted with bumped fees.
+// Only the send of the lowest nonce checks for a gap, as all later nonces are blocked by the same gap.
+func (m *SimpleTxManager) resubmitStuckAncestors(ctx context.Context, nonce uint64) {
+	if lowest, ok := m.inflight.LowestSending(); !ok || lowest != nonce {
+		return
+	}
+	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	accountNonce, err := m.backend.NonceAt(cCtx, m.cfg.From, nil)
+	cancel()
+	if err != nil {
+		m.metr.RPCError()
+		m.l.Warn("Failed to get nonce to check for nonce gap", "err", err)
+		return
+	}
+	m.inflight.Confirmed(accountNonce)
+	if m.cfg.SpendCap != nil {
+		m.spending.Confirmed(accountNonce)
+	}
+	if accountNonce >= nonce {
+		m.metr.RecordNonceGap(0)
+		return
+	}
+	m.metr.RecordNonceGap(nonce - accountNonce)
+	m.l.Warn("Detected nonce gap", "accountNonce", accountNonce, "blockedNonce", nonce)
+
+	for n := accountNonce; n < nonce; n++ {
+		stuck := m.inflight.Abandoned(n)
+		if stuck == nil {
+			// This can happen if the tx was sent by another process or before a restart.
+			m.l.Warn("Unable to resubmit unknown transaction of nonce gap", "nonce", n)
+			continue
+		}
+		l := m.txLogger(stuck, true)
+		tx, err := m.increaseGasPrice(ctx, stuck)
+		if err != nil {
+			// Resubmit as is, the tx may have been dropped from the mempool
+			l.Warn("Unable to increase gas of stuck transaction, will try to re-publish it", "err", err)
+			tx = stuck
+		}
+		cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+		err = m.backend.SendTransaction(cCtx, tx)
+		cancel()
+		if err != nil && !errStringMatch(err, txpool.ErrAlreadyKnown) {
+			l.Warn("Failed to resubmit stuck transaction", "err", err)
+			continue
+		}
+		m.inflight.Resubmitted(tx)
+		m.metr.RecordStuckTxResubmitted()
+		m.txLogger(tx, true).Info("Resubmitted stuck transaction of nonce gap")
+	}
+}
+
 // waitForTx calls waitMined, and then sends the receipt to receiptChan in a non-blocking way if a receipt is found
 // for the transaction. It should be called in a separate goroutine.
 func (m *SimpleTxManager) waitForTx(ctx context.Context, tx *types.Transaction, sendState *SendState, receiptChan chan *types.Receipt) {
@@ -853,6 +972,10 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 		m.l.Warn("failed to sign new transaction", "err", err, "tx", tx.Hash())
 		return tx, nil
 	}
+	// The bumped tx may be included instead of the original, so its cost must fit within the spend cap too.
+	if err := m.reserveSpend(signedTx); err != nil {
+		return nil, err
+	}
 	return signedTx, nil
 }
 
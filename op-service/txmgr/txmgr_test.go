@@ -207,6 +207,7 @@ type mockBackend struct {
 
 	g    *gasPricer
 	send sendTransactionFunc
+	call func(call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
 
 	// blockHeight tracks the current height of the chain.
 	blockHeight uint64
@@ -255,6 +256,9 @@ func (b *mockBackend) BlockNumber(ctx context.Context) (uint64, error) {
 
 // Call mocks a call to the EVM.
 func (b *mockBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.call != nil {
+		return b.call(call, blockNumber)
+	}
 	return nil, nil
 }
 
@@ -1597,3 +1601,40 @@ func TestSendAsyncUnbufferedChan(t *testing.T) {
 		h.mgr.SendAsync(context.Background(), TxCandidate{}, make(chan SendResponse))
 	})
 }
+
+// TestResubmitStuckAncestors asserts that an abandoned tx blocking a later nonce is resubmitted with bumped fees.
+func TestResubmitStuckAncestors(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+
+	var sent []*types.Transaction
+	h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+		sent = append(sent, tx)
+		return nil
+	})
+
+	stuck, err := h.mgr.craftTx(ctx, h.createTxCandidate())
+	require.NoError(t, err)
+	require.Equal(t, uint64(startingNonce), stuck.Nonce())
+	blocked, err := h.mgr.craftTx(ctx, h.createTxCandidate())
+	require.NoError(t, err)
+
+	h.mgr.inflight.Sending(stuck)
+	h.mgr.inflight.Sending(blocked)
+
+	// The stuck tx is still being sent, so its own send is responsible for it
+	h.mgr.resubmitStuckAncestors(ctx, blocked.Nonce())
+	require.Empty(t, sent)
+
+	h.mgr.inflight.Abandon(stuck)
+	h.mgr.resubmitStuckAncestors(ctx, blocked.Nonce())
+	require.Len(t, sent, 1)
+	require.Equal(t, stuck.Nonce(), sent[0].Nonce())
+	require.Equal(t, 1, sent[0].GasFeeCap().Cmp(stuck.GasFeeCap()), "fees must be bumped")
+	require.Equal(t, sent[0], h.mgr.inflight.Abandoned(stuck.Nonce()))
+
+	// Nothing is resubmitted once no send is blocked anymore
+	h.mgr.inflight.Done(blocked.Nonce())
+	h.mgr.resubmitStuckAncestors(ctx, stuck.Nonce())
+	require.Len(t, sent, 1)
+}
@@ -74,6 +74,20 @@ func TestMockRun(t *testing.T) {
 	})
 }
 
+func TestBackfill(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.Backfill)
+		require.Zero(t, cfg.BackfillStartBlock)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--backfill", "--backfill-start-block=1234"))
+		require.True(t, cfg.Backfill)
+		require.Equal(t, uint64(1234), cfg.BackfillStartBlock)
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := dryRunWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
@@ -27,6 +27,11 @@ type Config struct {
 
 	L2RPCs  []string
 	Datadir string
+
+	// Backfill indexes the logs of each chain up to its current head, before queries are served
+	Backfill bool
+	// BackfillStartBlock is the first block to index of chains without any indexed blocks
+	BackfillStartBlock uint64
 }
 
 func (c *Config) Check() error {
@@ -30,6 +30,16 @@ var (
 		Usage:   "Directory to store data generated as part of responding to games",
 		EnvVars: prefixEnvVars("DATADIR"),
 	}
+	BackfillFlag = &cli.BoolFlag{
+		Name:    "backfill",
+		Usage:   "Index the logs of each chain up to its current head before serving queries. Resumes from the indexed data after a restart.",
+		EnvVars: prefixEnvVars("BACKFILL"),
+	}
+	BackfillStartBlockFlag = &cli.Uint64Flag{
+		Name:    "backfill-start-block",
+		Usage:   "First block to index of chains without any indexed data.",
+		EnvVars: prefixEnvVars("BACKFILL_START_BLOCK"),
+	}
 	MockRunFlag = &cli.BoolFlag{
 		Name:    "mock-run",
 		Usage:   "Mock run, no actual backend used, just presenting the service",
@@ -44,6 +54,8 @@ var requiredFlags = []cli.Flag{
 }
 
 var optionalFlags = []cli.Flag{
+	BackfillFlag,
+	BackfillStartBlockFlag,
 	MockRunFlag,
 }
 
@@ -79,5 +91,8 @@ func ConfigFromCLI(ctx *cli.Context, version string) *config.Config {
 		MockRun:       ctx.Bool(MockRunFlag.Name),
 		L2RPCs:        ctx.StringSlice(L2RPCsFlag.Name),
 		Datadir:       ctx.Path(DataDirFlag.Name),
+
+		Backfill:           ctx.Bool(BackfillFlag.Name),
+		BackfillStartBlock: ctx.Uint64(BackfillStartBlockFlag.Name),
 	}
 }
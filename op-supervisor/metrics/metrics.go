@@ -21,6 +21,8 @@ type Metricer interface {
 	RecordDBEntryCount(chainID types.ChainID, count int64)
 	RecordDBSearchEntriesRead(chainID types.ChainID, count int64)
 
+	RecordBackfillProgress(chainID types.ChainID, current uint64, target uint64)
+
 	Document() []opmetrics.DocumentedMetric
 }
 
@@ -38,6 +40,9 @@ type Metrics struct {
 	DBEntryCountVec        *prometheus.GaugeVec
 	DBSearchEntriesReadVec *prometheus.HistogramVec
 
+	BackfillCurrentVec *prometheus.GaugeVec
+	BackfillTargetVec  *prometheus.GaugeVec
+
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
 }
@@ -118,6 +123,21 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"chain",
 		}),
+
+		BackfillCurrentVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "backfill_block_current",
+			Help:      "Number of the latest block processed by the backfill, by chain ID",
+		}, []string{
+			"chain",
+		}),
+		BackfillTargetVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "backfill_block_target",
+			Help:      "Number of the block the backfill processes up to, by chain ID",
+		}, []string{
+			"chain",
+		}),
 	}
 }
 
@@ -167,6 +187,12 @@ func (m *Metrics) RecordDBSearchEntriesRead(chainID types.ChainID, count int64)
 	m.DBSearchEntriesReadVec.WithLabelValues(chainIDLabel(chainID)).Observe(float64(count))
 }
 
+func (m *Metrics) RecordBackfillProgress(chainID types.ChainID, current uint64, target uint64) {
+	chain := chainIDLabel(chainID)
+	m.BackfillCurrentVec.WithLabelValues(chain).Set(float64(current))
+	m.BackfillTargetVec.WithLabelValues(chain).Set(float64(target))
+}
+
 func chainIDLabel(chainID types.ChainID) string {
 	return chainID.String()
 }
@@ -24,6 +24,9 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// backfillRetryDelay is the delay before retrying to backfill a chain after an error
+const backfillRetryDelay = 5 * time.Second
+
 type SupervisorBackend struct {
 	ctx     context.Context
 	started atomic.Bool
@@ -34,9 +37,20 @@ type SupervisorBackend struct {
 	chainMonitors map[types.ChainID]*source.ChainMonitor
 	db            *db.ChainsDB
 
+	backfill           bool
+	backfillStartBlock uint64
+	// backfilling is true while the chains are backfilled, and queries are not served yet
+	backfilling atomic.Bool
+	// backfillDone is closed when the backfill ended, and the chain monitors are started if it completed
+	backfillDone    chan struct{}
+	monitorsStarted bool
+
 	maintenanceCancel context.CancelFunc
 }
 
+// ErrBackfilling is returned by queries while the chains are backfilled.
+var ErrBackfilling = errors.New("backfilling chains, not serving queries yet")
+
 var _ frontend.Backend = (*SupervisorBackend)(nil)
 
 var _ io.Closer = (*SupervisorBackend)(nil)
@@ -66,6 +80,9 @@ func NewSupervisorBackend(ctx context.Context, logger log.Logger, m Metrics, cfg
 		dataDir:       cfg.Datadir,
 		chainMonitors: chainMonitors,
 		db:            db,
+
+		backfill:           cfg.Backfill,
+		backfillStartBlock: cfg.BackfillStartBlock,
 	}
 
 	// from the RPC strings, have the supervisor backend create a chain monitor
@@ -96,7 +113,7 @@ func (su *SupervisorBackend) addFromRPC(ctx context.Context, logger log.Logger,
 	if err != nil {
 		return fmt.Errorf("failed to create logdb for chain %v at %v: %w", chainID, path, err)
 	}
-	monitor, err := source.NewChainMonitor(ctx, logger, cm, chainID, rpc, rpcClient, su.db)
+	monitor, err := source.NewChainMonitor(ctx, logger, cm, chainID, rpc, rpcClient, su.db, su.backfillStartBlock)
 	if err != nil {
 		return fmt.Errorf("failed to create monitor for rpc %v: %w", rpc, err)
 	}
@@ -129,16 +146,67 @@ func (su *SupervisorBackend) Start(ctx context.Context) error {
 	if err := su.db.Resume(); err != nil {
 		return fmt.Errorf("failed to resume chains db: %w", err)
 	}
+	maintinenceCtx, cancel := context.WithCancel(ctx)
+	su.maintenanceCancel = cancel
+	su.monitorsStarted = false
+	su.backfillDone = make(chan struct{})
+	if su.backfill {
+		su.backfilling.Store(true)
+		go func() {
+			defer close(su.backfillDone)
+			if err := su.backfillChains(maintinenceCtx); err != nil {
+				su.logger.Error("Backfill stopped", "err", err)
+				return
+			}
+			if err := su.startMonitoring(maintinenceCtx); err != nil {
+				su.logger.Error("Failed to start monitoring after backfill", "err", err)
+				return
+			}
+			su.backfilling.Store(false)
+		}()
+		return nil
+	}
+	defer close(su.backfillDone)
+	return su.startMonitoring(maintinenceCtx)
+}
+
+// backfillChains backfills all chains, retrying until completed or the context is done.
+// The cross-heads are updated once all chains are backfilled.
+func (su *SupervisorBackend) backfillChains(ctx context.Context) error {
+	for chainID, monitor := range su.chainMonitors {
+		for {
+			err := monitor.Backfill(ctx)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			su.logger.Warn("Failed to backfill chain, retrying", "chain", chainID, "err", err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backfillRetryDelay):
+			}
+		}
+	}
+	if err := su.db.UpdateAllHeads(); err != nil {
+		return fmt.Errorf("failed to update cross-heads after backfill: %w", err)
+	}
+	return nil
+}
+
+// startMonitoring starts the chain monitors and the db maintenance loop
+func (su *SupervisorBackend) startMonitoring(ctx context.Context) error {
 	// start chain monitors
 	for _, monitor := range su.chainMonitors {
 		if err := monitor.Start(); err != nil {
 			return fmt.Errorf("failed to start chain monitor: %w", err)
 		}
 	}
+	su.monitorsStarted = true
 	// start db maintenance loop
-	maintinenceCtx, cancel := context.WithCancel(ctx)
-	su.db.StartCrossHeadMaintenance(maintinenceCtx)
-	su.maintenanceCancel = cancel
+	su.db.StartCrossHeadMaintenance(ctx)
 	return nil
 }
 
@@ -146,13 +214,17 @@ func (su *SupervisorBackend) Stop(ctx context.Context) error {
 	if !su.started.CompareAndSwap(true, false) {
 		return errors.New("already stopped")
 	}
-	// signal the maintenance loop to stop
+	// signal the maintenance loop and any backfill to stop
 	su.maintenanceCancel()
+	<-su.backfillDone
+	su.backfilling.Store(false)
 	// collect errors from stopping chain monitors
 	var errs error
-	for _, monitor := range su.chainMonitors {
-		if err := monitor.Stop(); err != nil {
-			errs = errors.Join(errs, fmt.Errorf("failed to stop chain monitor: %w", err))
+	if su.monitorsStarted {
+		for _, monitor := range su.chainMonitors {
+			if err := monitor.Stop(); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to stop chain monitor: %w", err))
+			}
 		}
 	}
 	// close the database
@@ -180,6 +252,9 @@ func (su *SupervisorBackend) AddL2RPC(ctx context.Context, rpc string) error {
 }
 
 func (su *SupervisorBackend) CheckMessage(identifier types.Identifier, payloadHash common.Hash) (types.SafetyLevel, error) {
+	if su.backfilling.Load() {
+		return types.Invalid, ErrBackfilling
+	}
 	chainID := identifier.ChainID
 	blockNum := identifier.BlockNumber
 	logIdx := identifier.LogIndex
@@ -204,6 +279,32 @@ func (su *SupervisorBackend) CheckMessage(identifier types.Identifier, payloadHa
 	return safest, nil
 }
 
+// CheckMessageValidity checks if the message can be executed at the given timestamp:
+// the initiating message must be known, and not be newer than the executing message, or expired.
+func (su *SupervisorBackend) CheckMessageValidity(identifier types.Identifier, payloadHash common.Hash, executingTimestamp hexutil.Uint64) (types.MessageValidity, error) {
+	safety, err := su.CheckMessage(identifier, payloadHash)
+	if err != nil {
+		return types.MessageValidity{}, err
+	}
+	executions, err := su.db.Executions(identifier.ChainID, identifier.BlockNumber, uint32(identifier.LogIndex), backendTypes.TruncateHash(payloadHash))
+	if err != nil {
+		return types.MessageValidity{}, fmt.Errorf("failed to get executions of message: %w", err)
+	}
+	validity := types.MessageValidity{
+		Safety:     safety,
+		Expiry:     hexutil.Uint64(identifier.Expiry()),
+		Executions: executions,
+	}
+	if safety == types.Invalid {
+		validity.Reason = "unknown initiating message"
+	} else if err := identifier.CheckValidityWindow(uint64(executingTimestamp)); err != nil {
+		validity.Reason = err.Error()
+	} else {
+		validity.Executable = true
+	}
+	return validity, nil
+}
+
 func (su *SupervisorBackend) CheckMessages(
 	messages []types.Message,
 	minSafety types.SafetyLevel) error {
@@ -228,6 +329,9 @@ func (su *SupervisorBackend) CheckMessages(
 func (su *SupervisorBackend) CheckBlock(chainID *hexutil.U256, blockHash common.Hash, blockNumber hexutil.Uint64) (types.SafetyLevel, error) {
 	// TODO(#11612): this function ignores blockHash and assumes that the block in the db is the one we are looking for
 	// In order to check block hash, the database must *always* insert a block hash checkpoint, which is not currently done
+	if su.backfilling.Load() {
+		return types.Invalid, ErrBackfilling
+	}
 	safest := types.CrossUnsafe
 	// find the last log index in the block
 	i, err := su.db.LastLogInBlock(types.ChainID(*chainID), uint64(blockNumber))
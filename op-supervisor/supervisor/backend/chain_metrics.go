@@ -3,6 +3,7 @@ package backend
 import (
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/logs"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/source"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
 )
 
@@ -12,6 +13,8 @@ type Metrics interface {
 
 	RecordDBEntryCount(chainID types.ChainID, count int64)
 	RecordDBSearchEntriesRead(chainID types.ChainID, count int64)
+
+	RecordBackfillProgress(chainID types.ChainID, current uint64, target uint64)
 }
 
 // chainMetrics is an adapter between the metrics API expected by clients that assume there's only a single chain
@@ -44,5 +47,10 @@ func (c *chainMetrics) RecordDBSearchEntriesRead(count int64) {
 	c.delegate.RecordDBSearchEntriesRead(c.chainID, count)
 }
 
+func (c *chainMetrics) RecordBackfillProgress(current uint64, target uint64) {
+	c.delegate.RecordBackfillProgress(c.chainID, current, target)
+}
+
 var _ caching.Metrics = (*chainMetrics)(nil)
 var _ logs.Metrics = (*chainMetrics)(nil)
+var _ source.Metrics = (*chainMetrics)(nil)
@@ -25,6 +25,7 @@ type LogStorage interface {
 	AddLog(logHash backendTypes.TruncatedHash, block eth.BlockID, timestamp uint64, logIdx uint32, execMsg *backendTypes.ExecutingMessage) error
 	Rewind(newHeadBlockNum uint64) error
 	LatestBlockNum() uint64
+	IsEmpty() bool
 	ClosestBlockInfo(blockNum uint64) (uint64, backendTypes.TruncatedHash, error)
 	ClosestBlockIterator(blockNum uint64) (logs.Iterator, error)
 	Contains(blockNum uint64, logIdx uint32, loghash backendTypes.TruncatedHash) (bool, entrydb.EntryIdx, error)
@@ -43,12 +44,14 @@ type ChainsDB struct {
 	logDBs           map[types.ChainID]LogStorage
 	heads            HeadsStorage
 	maintenanceReady chan struct{}
+	executions       *executionsIndex
 }
 
 func NewChainsDB(logDBs map[types.ChainID]LogStorage, heads HeadsStorage) *ChainsDB {
 	return &ChainsDB{
-		logDBs: logDBs,
-		heads:  heads,
+		logDBs:     logDBs,
+		heads:      heads,
+		executions: newExecutionsIndex(),
 	}
 }
 
@@ -68,6 +71,9 @@ func (db *ChainsDB) Resume() error {
 		if err := Resume(logStore); err != nil {
 			return fmt.Errorf("failed to resume chain %v: %w", chain, err)
 		}
+		if err := db.executions.load(chain, logStore); err != nil {
+			return fmt.Errorf("failed to load executing messages of chain %v: %w", chain, err)
+		}
 	}
 	return nil
 }
@@ -85,7 +91,7 @@ func (db *ChainsDB) StartCrossHeadMaintenance(ctx context.Context) {
 			case <-ticker.C:
 				db.RequestMaintenance()
 			case <-db.maintenanceReady:
-				if err := db.updateAllHeads(); err != nil {
+				if err := db.UpdateAllHeads(); err != nil {
 					log.Error("failed to update cross-heads", "err", err)
 				}
 			}
@@ -113,9 +119,9 @@ func (db *ChainsDB) RequestMaintenance() {
 	}
 }
 
-// updateAllHeads updates the cross-heads of all safety levels
+// UpdateAllHeads updates the cross-heads of all safety levels
 // it is called by the maintenance loop
-func (db *ChainsDB) updateAllHeads() error {
+func (db *ChainsDB) UpdateAllHeads() error {
 	// create three safety checkers, one for each safety level
 	unsafeChecker := NewSafetyChecker(Unsafe, db)
 	safeChecker := NewSafetyChecker(Safe, db)
@@ -253,12 +259,27 @@ func (db *ChainsDB) LatestBlockNum(chain types.ChainID) uint64 {
 	return logDB.LatestBlockNum()
 }
 
+// IsEmpty returns true if no blocks have been recorded to the logs db of the given chain yet.
+func (db *ChainsDB) IsEmpty(chain types.ChainID) bool {
+	logDB, ok := db.logDBs[chain]
+	if !ok {
+		return true
+	}
+	return logDB.IsEmpty()
+}
+
 func (db *ChainsDB) AddLog(chain types.ChainID, logHash backendTypes.TruncatedHash, block eth.BlockID, timestamp uint64, logIdx uint32, execMsg *backendTypes.ExecutingMessage) error {
 	logDB, ok := db.logDBs[chain]
 	if !ok {
 		return fmt.Errorf("%w: %v", ErrUnknownChain, chain)
 	}
-	return logDB.AddLog(logHash, block, timestamp, logIdx, execMsg)
+	if err := logDB.AddLog(logHash, block, timestamp, logIdx, execMsg); err != nil {
+		return err
+	}
+	if execMsg != nil {
+		db.executions.add(*execMsg, types.MessageExecution{ChainID: chain, BlockNumber: block.Number, LogIndex: logIdx}, timestamp)
+	}
+	return nil
 }
 
 func (db *ChainsDB) Rewind(chain types.ChainID, headBlockNum uint64) error {
@@ -266,7 +287,16 @@ func (db *ChainsDB) Rewind(chain types.ChainID, headBlockNum uint64) error {
 	if !ok {
 		return fmt.Errorf("%w: %v", ErrUnknownChain, chain)
 	}
-	return logDB.Rewind(headBlockNum)
+	if err := logDB.Rewind(headBlockNum); err != nil {
+		return err
+	}
+	db.executions.rewind(chain, headBlockNum)
+	return nil
+}
+
+// Executions returns the known logs that execute the given initiating message.
+func (db *ChainsDB) Executions(chain types.ChainID, blockNum uint64, logIdx uint32, logHash backendTypes.TruncatedHash) ([]types.MessageExecution, error) {
+	return db.executions.get(chain, blockNum, logIdx, logHash)
 }
 
 func (db *ChainsDB) Close() error {
@@ -464,6 +464,10 @@ func (s *stubLogDB) LatestBlockNum() uint64 {
 	return s.headBlockNum
 }
 
+func (s *stubLogDB) IsEmpty() bool {
+	return s.headBlockNum == 0
+}
+
 func (s *stubLogDB) Close() error {
 	return nil
 }
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	backendTypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/types"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+// executionsPruneInterval is the minimum time in seconds between two prunes of expired messages.
+const executionsPruneInterval = 60 * 60
+
+// messageKey identifies an initiating message.
+type messageKey struct {
+	chain    uint32
+	blockNum uint64
+	logIdx   uint32
+	hash     backendTypes.TruncatedHash
+}
+
+type messageExecutions struct {
+	// timestamp of the initiating message, to forget the executions once the message expired
+	timestamp  uint64
+	executions []types.MessageExecution
+}
+
+// executionsIndex keeps track of the logs that execute each initiating message, so replays of messages can be reported.
+// Executions are forgotten once the initiating message expired, as it cannot be executed anymore.
+type executionsIndex struct {
+	mu        sync.Mutex
+	byMessage map[messageKey]*messageExecutions
+	// latest timestamp of an executing log, and the timestamp expired messages were last pruned at
+	latest     uint64
+	lastPruned uint64
+}
+
+func newExecutionsIndex() *executionsIndex {
+	return &executionsIndex{byMessage: make(map[messageKey]*messageExecutions)}
+}
+
+func (x *executionsIndex) add(msg backendTypes.ExecutingMessage, execution types.MessageExecution, timestamp uint64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	key := messageKey{chain: msg.Chain, blockNum: msg.BlockNum, logIdx: msg.LogIdx, hash: msg.Hash}
+	entry, ok := x.byMessage[key]
+	if !ok {
+		entry = &messageExecutions{timestamp: msg.Timestamp}
+		x.byMessage[key] = entry
+	}
+	entry.executions = append(entry.executions, execution)
+	if timestamp > x.latest {
+		x.latest = timestamp
+	}
+	if x.latest-x.lastPruned >= executionsPruneInterval {
+		x.pruneExpired()
+	}
+}
+
+func (x *executionsIndex) pruneExpired() {
+	for key, entry := range x.byMessage {
+		if entry.timestamp+types.MessageExpiryWindow < x.latest {
+			delete(x.byMessage, key)
+		}
+	}
+	x.lastPruned = x.latest
+}
+
+// rewind forgets the executions of the chain after the given block.
+func (x *executionsIndex) rewind(chain types.ChainID, headBlockNum uint64) {
+	x.filter(func(e types.MessageExecution) bool {
+		return e.ChainID != chain || e.BlockNumber <= headBlockNum
+	})
+}
+
+// filter forgets the executions that are not kept.
+func (x *executionsIndex) filter(keep func(e types.MessageExecution) bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for key, entry := range x.byMessage {
+		kept := entry.executions[:0]
+		for _, e := range entry.executions {
+			if keep(e) {
+				kept = append(kept, e)
+			}
+		}
+		if len(kept) == 0 {
+			delete(x.byMessage, key)
+		} else {
+			entry.executions = kept
+		}
+	}
+}
+
+// get returns the known executions of the initiating message.
+func (x *executionsIndex) get(chain types.ChainID, blockNum uint64, logIdx uint32, hash backendTypes.TruncatedHash) ([]types.MessageExecution, error) {
+	chainID, err := chain.ToUInt32()
+	if err != nil {
+		return nil, err
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	entry, ok := x.byMessage[messageKey{chain: chainID, blockNum: blockNum, logIdx: logIdx, hash: hash}]
+	if !ok {
+		return nil, nil
+	}
+	return append([]types.MessageExecution(nil), entry.executions...), nil
+}
+
+// load replaces the executions of the chain with the executing messages recorded in its log storage.
+func (x *executionsIndex) load(chain types.ChainID, logDB LogStorage) error {
+	x.filter(func(e types.MessageExecution) bool {
+		return e.ChainID != chain
+	})
+	iter, err := logDB.LastCheckpointBehind(0)
+	if errors.Is(err, io.EOF) {
+		// empty database
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to iterate logs: %w", err)
+	}
+	for {
+		blockNum, logIdx, _, err := iter.NextLog()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read log: %w", err)
+		}
+		msg, err := iter.ExecMessage()
+		if err != nil {
+			return fmt.Errorf("failed to read executing message of log %d in block %d: %w", logIdx, blockNum, err)
+		}
+		if msg == (backendTypes.ExecutingMessage{}) {
+			continue
+		}
+		// The timestamp of the executing log is not recorded, the timestamp of the initiating message
+		// is a lower bound of it, and good enough to prune the expired messages.
+		x.add(msg, types.MessageExecution{ChainID: chain, BlockNumber: blockNum, LogIndex: logIdx}, msg.Timestamp)
+	}
+}
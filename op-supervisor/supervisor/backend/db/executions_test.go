@@ -0,0 +1,84 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/logs"
+	backendTypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/types"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+type stubLogsMetrics struct{}
+
+func (s *stubLogsMetrics) RecordDBEntryCount(count int64)        {}
+func (s *stubLogsMetrics) RecordDBSearchEntriesRead(count int64) {}
+
+func TestChainsDB_Executions(t *testing.T) {
+	chainA := types.ChainIDFromUInt64(1)
+	chainB := types.ChainIDFromUInt64(2)
+	logDB, err := logs.NewFromFile(testlog.Logger(t, log.LvlInfo), &stubLogsMetrics{}, filepath.Join(t.TempDir(), "b.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = logDB.Close() })
+	db := NewChainsDB(map[types.ChainID]LogStorage{chainB: logDB}, &stubHeadStorage{})
+
+	// an initiating message on chain A
+	initHash := backendTypes.TruncateHash(common.Hash{0xaa})
+	execMsg := &backendTypes.ExecutingMessage{Chain: 1, BlockNum: 10, LogIdx: 2, Timestamp: 1000, Hash: initHash}
+
+	// executed twice on chain B
+	block1 := eth.BlockID{Hash: common.Hash{0x01}, Number: 1}
+	block2 := eth.BlockID{Hash: common.Hash{0x02}, Number: 2}
+	require.NoError(t, db.AddLog(chainB, backendTypes.TruncateHash(common.Hash{0x10}), block1, 1002, 0, nil))
+	require.NoError(t, db.AddLog(chainB, backendTypes.TruncateHash(common.Hash{0x11}), block1, 1002, 1, execMsg))
+	require.NoError(t, db.AddLog(chainB, backendTypes.TruncateHash(common.Hash{0x12}), block2, 1004, 0, execMsg))
+
+	expected := []types.MessageExecution{
+		{ChainID: chainB, BlockNumber: 1, LogIndex: 1},
+		{ChainID: chainB, BlockNumber: 2, LogIndex: 0},
+	}
+	executions, err := db.Executions(chainA, 10, 2, initHash)
+	require.NoError(t, err)
+	require.Equal(t, expected, executions)
+
+	executions, err = db.Executions(chainA, 10, 2, backendTypes.TruncateHash(common.Hash{0xbb}))
+	require.NoError(t, err)
+	require.Empty(t, executions, "different message")
+
+	// The executions are loaded from the logs database on restart
+	reloaded := NewChainsDB(map[types.ChainID]LogStorage{chainB: logDB}, &stubHeadStorage{})
+	require.NoError(t, reloaded.executions.load(chainB, logDB))
+	executions, err = reloaded.Executions(chainA, 10, 2, initHash)
+	require.NoError(t, err)
+	require.Equal(t, expected, executions)
+
+	// Executions in blocks that are rewound are forgotten
+	require.NoError(t, db.Rewind(chainB, 1))
+	executions, err = db.Executions(chainA, 10, 2, initHash)
+	require.NoError(t, err)
+	require.Equal(t, expected[:1], executions)
+}
+
+func TestExecutionsIndex_PruneExpired(t *testing.T) {
+	x := newExecutionsIndex()
+	chain := types.ChainIDFromUInt64(2)
+	oldMsg := backendTypes.ExecutingMessage{Chain: 1, BlockNum: 10, Timestamp: 1000}
+	newMsg := backendTypes.ExecutingMessage{Chain: 1, BlockNum: 20, Timestamp: 2000}
+	x.add(oldMsg, types.MessageExecution{ChainID: chain, BlockNumber: 1}, 1001)
+	x.add(newMsg, types.MessageExecution{ChainID: chain, BlockNumber: 2}, 2001)
+
+	// The old message expires, and is pruned when a later executing message is added
+	x.add(newMsg, types.MessageExecution{ChainID: chain, BlockNumber: 3}, 1000+types.MessageExpiryWindow+1)
+	executions, err := x.get(types.ChainIDFromUInt64(1), 10, 0, backendTypes.TruncatedHash{})
+	require.NoError(t, err)
+	require.Empty(t, executions)
+	executions, err = x.get(types.ChainIDFromUInt64(1), 20, 0, backendTypes.TruncatedHash{})
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+}
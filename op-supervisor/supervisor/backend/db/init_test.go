@@ -86,6 +86,10 @@ func (s *stubLogStore) LatestBlockNum() uint64 {
 	panic("not supported")
 }
 
+func (s *stubLogStore) IsEmpty() bool {
+	panic("not supported")
+}
+
 func (s *stubLogStore) Close() error {
 	return nil
 }
@@ -181,6 +181,13 @@ func (db *DB) LatestBlockNum() uint64 {
 	return db.lastEntryContext.blockNum
 }
 
+// IsEmpty returns true if no logs have been recorded to the db yet.
+func (db *DB) IsEmpty() bool {
+	db.rwLock.RLock()
+	defer db.rwLock.RUnlock()
+	return db.lastEntryIdx() < 0
+}
+
 // ClosestBlockInfo returns the block number and hash of the highest recorded block at or before blockNum.
 // Since block data is only recorded in search checkpoints, this may return an earlier block even if log data is
 // recorded for the requested block.
@@ -75,6 +75,19 @@ func TestEmptyDbDoesNotFindEntry(t *testing.T) {
 		func(t *testing.T, db *DB, m *stubMetrics) {
 			requireNotContains(t, db, 0, 0, createHash(1))
 			requireNotContains(t, db, 0, 0, common.Hash{})
+			require.True(t, db.IsEmpty())
+		})
+}
+
+func TestIsEmpty(t *testing.T) {
+	runDBTest(t,
+		func(t *testing.T, db *DB, m *stubMetrics) {
+			err := db.AddLog(createTruncatedHash(1), eth.BlockID{Hash: createHash(15), Number: 15}, 5000, 0, nil)
+			require.NoError(t, err)
+		},
+		func(t *testing.T, db *DB, m *stubMetrics) {
+			require.False(t, db.IsEmpty())
+			require.Equal(t, uint64(15), db.LatestBlockNum())
 		})
 }
 
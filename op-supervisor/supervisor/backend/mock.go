@@ -51,6 +51,14 @@ func (m *MockBackend) CheckMessages(messages []types.Message, minSafety types.Sa
 	return nil
 }
 
+func (m *MockBackend) CheckMessageValidity(identifier types.Identifier, payloadHash common.Hash, executingTimestamp hexutil.Uint64) (types.MessageValidity, error) {
+	return types.MessageValidity{
+		Safety:     types.CrossUnsafe,
+		Expiry:     hexutil.Uint64(identifier.Expiry()),
+		Executable: identifier.CheckValidityWindow(uint64(executingTimestamp)) == nil,
+	}, nil
+}
+
 func (m *MockBackend) CheckBlock(chainID *hexutil.U256, blockHash common.Hash, blockNumber hexutil.Uint64) (types.SafetyLevel, error) {
 	return types.CrossUnsafe, nil
 }
@@ -18,25 +18,37 @@ const epochPollInterval = 30 * time.Second
 const pollInterval = 2 * time.Second
 const trustRpc = false
 const rpcKind = sources.RPCKindStandard
+const backfillLogInterval = 10 * time.Second
 
 type Metrics interface {
 	caching.Metrics
+
+	RecordBackfillProgress(current uint64, target uint64)
 }
 
 type Storage interface {
 	LogStorage
 	DatabaseRewinder
 	LatestBlockNum(chainID types.ChainID) uint64
+	IsEmpty(chainID types.ChainID) bool
 }
 
 // ChainMonitor monitors a source L2 chain, retrieving the data required to populate the database and perform
 // interop consolidation. It detects and notifies when reorgs occur.
 type ChainMonitor struct {
 	log         log.Logger
+	metrics     Metrics
+	chainID     types.ChainID
+	client      *sources.L1Client
+	store       Storage
+	startBlock  uint64
+	processor   *ChainProcessor
 	headMonitor *HeadMonitor
 }
 
-func NewChainMonitor(ctx context.Context, logger log.Logger, m Metrics, chainID types.ChainID, rpc string, client client.RPC, store Storage) (*ChainMonitor, error) {
+// NewChainMonitor creates a chain monitor, which starts processing the chain after the latest block in the store.
+// The first block to process of a chain without any processed blocks is the given start block.
+func NewChainMonitor(ctx context.Context, logger log.Logger, m Metrics, chainID types.ChainID, rpc string, client client.RPC, store Storage, startBlock uint64) (*ChainMonitor, error) {
 	logger = logger.New("chainID", chainID)
 	cl, err := newClient(ctx, logger, m, rpc, client, pollInterval, trustRpc, rpcKind)
 	if err != nil {
@@ -44,7 +56,7 @@ func NewChainMonitor(ctx context.Context, logger log.Logger, m Metrics, chainID
 	}
 
 	startingHead := eth.L1BlockRef{
-		Number: store.LatestBlockNum(chainID),
+		Number: startingHeadNum(store, chainID, startBlock),
 	}
 
 	processLogs := newLogProcessor(chainID, store)
@@ -57,10 +69,53 @@ func NewChainMonitor(ctx context.Context, logger log.Logger, m Metrics, chainID
 
 	return &ChainMonitor{
 		log:         logger,
+		metrics:     m,
+		chainID:     chainID,
+		client:      cl,
+		store:       store,
+		startBlock:  startBlock,
+		processor:   unsafeBlockProcessor,
 		headMonitor: headMonitor,
 	}, nil
 }
 
+// startingHeadNum returns the number of the block to process blocks after.
+// Processing resumes after the latest block in the store, and only starts at the start block
+// if the store has no blocks of the chain yet.
+func startingHeadNum(store Storage, chainID types.ChainID, startBlock uint64) uint64 {
+	if store.IsEmpty(chainID) && startBlock > 0 {
+		return startBlock - 1
+	}
+	return store.LatestBlockNum(chainID)
+}
+
+// Backfill processes the blocks of the chain up to its current unsafe head. It must be called before the
+// chain monitor is started. The processed blocks are persisted to the store, so after a restart backfilling
+// resumes after the latest block in the store.
+func (c *ChainMonitor) Backfill(ctx context.Context) error {
+	// The store may have been rewound since the chain monitor was created, when resuming after a restart.
+	c.processor.lastBlock = eth.L1BlockRef{Number: startingHeadNum(c.store, c.chainID, c.startBlock)}
+	head, err := c.client.L1BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		return fmt.Errorf("failed to fetch unsafe head: %w", err)
+	}
+	c.log.Info("Backfilling chain", "from", c.processor.lastBlock.Number+1, "to", head.Number)
+	c.metrics.RecordBackfillProgress(c.processor.lastBlock.Number, head.Number)
+	lastLog := time.Now()
+	err = c.processor.Backfill(ctx, head.Number, func(block eth.L1BlockRef) {
+		c.metrics.RecordBackfillProgress(block.Number, head.Number)
+		if time.Since(lastLog) > backfillLogInterval {
+			c.log.Info("Backfilling chain", "block", block.Number, "head", head.Number)
+			lastLog = time.Now()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	c.log.Info("Backfilled chain", "head", head.Number)
+	return nil
+}
+
 func (c *ChainMonitor) Start() error {
 	c.log.Info("Started monitoring chain")
 	return c.headMonitor.Start()
@@ -2,6 +2,7 @@ package source
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
@@ -67,6 +68,27 @@ func (s *ChainProcessor) OnNewHead(ctx context.Context, head eth.L1BlockRef) {
 	s.processBlock(ctx, head)
 }
 
+// Backfill processes all blocks after the last processed block up to the given head, before head updates are
+// processed. Unlike OnNewHead it returns on the first error, and it reports the progress after every block.
+// The last processed block is retained on error, so a following Backfill call resumes where it stopped.
+func (s *ChainProcessor) Backfill(ctx context.Context, head uint64, onProgress func(block eth.L1BlockRef)) error {
+	for s.lastBlock.Number < head {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		blockNum := s.lastBlock.Number + 1
+		block, err := s.client.L1BlockRefByNumber(ctx, blockNum)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block %d: %w", blockNum, err)
+		}
+		if ok := s.processBlock(ctx, block); !ok {
+			return fmt.Errorf("failed to process block %v", block)
+		}
+		onProgress(block)
+	}
+	return nil
+}
+
 func (s *ChainProcessor) processBlock(ctx context.Context, block eth.L1BlockRef) bool {
 	if err := s.processor.ProcessBlock(ctx, block); err != nil {
 		s.log.Error("Failed to process block", "block", block, "err", err)
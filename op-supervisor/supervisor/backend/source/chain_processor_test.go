@@ -140,6 +140,83 @@ func TestUnsafeBlocksStage(t *testing.T) {
 	})
 }
 
+func TestBackfill(t *testing.T) {
+	t.Run("ProcessUpToHead", func(t *testing.T) {
+		ctx := context.Background()
+		logger := testlog.Logger(t, log.LvlInfo)
+		client := &stubBlockByNumberSource{}
+		processor := &stubBlockProcessor{}
+		stage := NewChainProcessor(logger, client, processorChainID, eth.L1BlockRef{Number: 100}, processor, &stubRewinder{})
+		var progress []uint64
+		err := stage.Backfill(ctx, 103, func(block eth.L1BlockRef) {
+			progress = append(progress, block.Number)
+		})
+		require.NoError(t, err)
+		require.Equal(t, []eth.L1BlockRef{makeBlockRef(101), makeBlockRef(102), makeBlockRef(103)}, processor.processed)
+		require.Equal(t, []uint64{101, 102, 103}, progress)
+
+		// Head updates continue after the backfilled blocks
+		stage.OnNewHead(ctx, makeBlockRef(104))
+		require.Equal(t, makeBlockRef(104), processor.processed[3])
+		require.Len(t, processor.processed, 4)
+	})
+
+	t.Run("ResumeAfterError", func(t *testing.T) {
+		ctx := context.Background()
+		logger := testlog.Logger(t, log.LvlInfo)
+		client := &stubBlockByNumberSource{}
+		processor := &stubBlockProcessor{err: errors.New("boom")}
+		rewinder := &stubRewinder{}
+		stage := NewChainProcessor(logger, client, processorChainID, eth.L1BlockRef{Number: 100}, processor, rewinder)
+		err := stage.Backfill(ctx, 102, func(block eth.L1BlockRef) {})
+		require.ErrorContains(t, err, "failed to process block")
+		require.Equal(t, uint64(100), rewinder.rewoundTo, "should rewind to block before error")
+
+		processor.err = nil
+		require.NoError(t, stage.Backfill(ctx, 102, func(block eth.L1BlockRef) {}))
+		require.Equal(t, []eth.L1BlockRef{makeBlockRef(101), makeBlockRef(101), makeBlockRef(102)}, processor.processed)
+	})
+
+	t.Run("StopWhenContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		logger := testlog.Logger(t, log.LvlInfo)
+		processor := &stubBlockProcessor{}
+		stage := NewChainProcessor(logger, &stubBlockByNumberSource{}, processorChainID, eth.L1BlockRef{Number: 100}, processor, &stubRewinder{})
+		err := stage.Backfill(ctx, 102, func(block eth.L1BlockRef) {})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, processor.processed)
+	})
+}
+
+func TestStartingHeadNum(t *testing.T) {
+	empty := &stubStorage{empty: true}
+	require.Equal(t, uint64(0), startingHeadNum(empty, processorChainID, 0))
+	require.Equal(t, uint64(0), startingHeadNum(empty, processorChainID, 1))
+	require.Equal(t, uint64(99), startingHeadNum(empty, processorChainID, 100))
+
+	indexed := &stubStorage{latest: 50}
+	require.Equal(t, uint64(50), startingHeadNum(indexed, processorChainID, 0))
+	require.Equal(t, uint64(50), startingHeadNum(indexed, processorChainID, 100), "should not skip blocks after the latest block")
+	indexed.latest = 150
+	require.Equal(t, uint64(150), startingHeadNum(indexed, processorChainID, 100), "should resume after the latest block")
+}
+
+type stubStorage struct {
+	stubLogStorage
+	stubRewinder
+	latest uint64
+	empty  bool
+}
+
+func (s *stubStorage) LatestBlockNum(types.ChainID) uint64 {
+	return s.latest
+}
+
+func (s *stubStorage) IsEmpty(types.ChainID) bool {
+	return s.empty
+}
+
 type stubBlockByNumberSource struct {
 	calls int
 	err   error
@@ -18,6 +18,7 @@ type AdminBackend interface {
 type QueryBackend interface {
 	CheckMessage(identifier types.Identifier, payloadHash common.Hash) (types.SafetyLevel, error)
 	CheckMessages(messages []types.Message, minSafety types.SafetyLevel) error
+	CheckMessageValidity(identifier types.Identifier, payloadHash common.Hash, executingTimestamp hexutil.Uint64) (types.MessageValidity, error)
 	CheckBlock(chainID *hexutil.U256, blockHash common.Hash, blockNumber hexutil.Uint64) (types.SafetyLevel, error)
 }
 
@@ -44,6 +45,13 @@ func (q *QueryFrontend) CheckMessages(
 	return q.Supervisor.CheckMessages(messages, minSafety)
 }
 
+// CheckMessageValidity checks if a message can be executed at the given timestamp,
+// and returns the safety-level and expiry of the message, and the logs that already executed it.
+// Sequencers can use it to filter out transactions with executing messages that would be invalid.
+func (q *QueryFrontend) CheckMessageValidity(identifier types.Identifier, payloadHash common.Hash, executingTimestamp hexutil.Uint64) (types.MessageValidity, error) {
+	return q.Supervisor.CheckMessageValidity(identifier, payloadHash, executingTimestamp)
+}
+
 // CheckBlock checks the safety-level of an L2 block as a whole.
 func (q *QueryFrontend) CheckBlock(chainID *hexutil.U256, blockHash common.Hash, blockNumber hexutil.Uint64) (types.SafetyLevel, error) {
 	return q.Supervisor.CheckBlock(chainID, blockHash, blockNumber)
@@ -101,6 +101,7 @@ func (su *SupervisorService) initPProf(cfg *config.Config) error {
 		cfg.PprofConfig.ProfileDir,
 		cfg.PprofConfig.ProfileFilename,
 	)
+	su.pprofService.EnableUpload(cfg.PprofConfig.Upload, "op-supervisor", cfg.Version)
 
 	if err := su.pprofService.Start(); err != nil {
 		return fmt.Errorf("failed to start pprof service: %w", err)
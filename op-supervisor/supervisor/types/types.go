@@ -65,7 +65,7 @@ func (lvl SafetyLevel) String() string {
 
 func (lvl SafetyLevel) Valid() bool {
 	switch lvl {
-	case Finalized, Safe, CrossUnsafe, Unsafe:
+	case CrossFinalized, Finalized, CrossSafe, Safe, CrossUnsafe, Unsafe, Invalid:
 		return true
 	default:
 		return false
@@ -139,3 +139,80 @@ func (id ChainID) ToUInt32() (uint32, error) {
 	}
 	return uint32(v64), nil
 }
+
+// MessageExpiryWindow is the time in seconds after which an initiating message can no longer be executed.
+const MessageExpiryWindow = 180 * 24 * 60 * 60
+
+var (
+	ErrMessageExpired    = errors.New("initiating message expired")
+	ErrMessageFromFuture = errors.New("initiating message is newer than the executing message")
+)
+
+// Expiry returns the last timestamp at which the identified initiating message can be executed.
+func (id Identifier) Expiry() uint64 {
+	if id.Timestamp > math.MaxUint64-MessageExpiryWindow {
+		return math.MaxUint64
+	}
+	return id.Timestamp + MessageExpiryWindow
+}
+
+// CheckValidityWindow checks that the identified initiating message can be executed at the given timestamp.
+func (id Identifier) CheckValidityWindow(executingTimestamp uint64) error {
+	if id.Timestamp > executingTimestamp {
+		return fmt.Errorf("%w: initiated at %d, executed at %d", ErrMessageFromFuture, id.Timestamp, executingTimestamp)
+	}
+	if expiry := id.Expiry(); executingTimestamp > expiry {
+		return fmt.Errorf("%w: expired at %d, executed at %d", ErrMessageExpired, expiry, executingTimestamp)
+	}
+	return nil
+}
+
+// MessageExecution identifies a log that executes a message.
+type MessageExecution struct {
+	ChainID     ChainID
+	BlockNumber uint64
+	LogIndex    uint32
+}
+
+type messageExecutionMarshaling struct {
+	ChainID     hexutil.U256   `json:"chainID"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	LogIndex    hexutil.Uint64 `json:"logIndex"`
+}
+
+func (e MessageExecution) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&messageExecutionMarshaling{
+		ChainID:     (hexutil.U256)(e.ChainID),
+		BlockNumber: hexutil.Uint64(e.BlockNumber),
+		LogIndex:    hexutil.Uint64(e.LogIndex),
+	})
+}
+
+func (e *MessageExecution) UnmarshalJSON(input []byte) error {
+	var dec messageExecutionMarshaling
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.LogIndex > math.MaxUint32 {
+		return fmt.Errorf("log index %d out of range", dec.LogIndex)
+	}
+	e.ChainID = (ChainID)(dec.ChainID)
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.LogIndex = uint32(dec.LogIndex)
+	return nil
+}
+
+// MessageValidity describes whether a message can be executed at a given timestamp.
+type MessageValidity struct {
+	// Safety is the safety level of the initiating message, Invalid if it is unknown.
+	Safety SafetyLevel `json:"safety"`
+	// Expiry is the last timestamp at which the message can be executed.
+	Expiry hexutil.Uint64 `json:"expiry"`
+	// Executable is true if the initiating message is known, and the message can be executed at the given timestamp.
+	Executable bool `json:"executable"`
+	// Reason explains why the message cannot be executed.
+	Reason string `json:"reason,omitempty"`
+	// Executions are the known logs that already executed the message.
+	// Messages may be executed more than once, replay protection is left to the applications.
+	Executions []MessageExecution `json:"executions"`
+}
@@ -59,3 +59,30 @@ func TestChainID_String(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentifier_CheckValidityWindow(t *testing.T) {
+	id := Identifier{Timestamp: 1000}
+	require.Equal(t, uint64(1000+MessageExpiryWindow), id.Expiry())
+	require.NoError(t, id.CheckValidityWindow(1000))
+	require.NoError(t, id.CheckValidityWindow(id.Expiry()))
+	require.ErrorIs(t, id.CheckValidityWindow(999), ErrMessageFromFuture)
+	require.ErrorIs(t, id.CheckValidityWindow(id.Expiry()+1), ErrMessageExpired)
+
+	id.Timestamp = math.MaxUint64 - 1
+	require.Equal(t, uint64(math.MaxUint64), id.Expiry())
+}
+
+func TestMessageValidity_JSON(t *testing.T) {
+	validity := MessageValidity{
+		Safety:     Invalid,
+		Expiry:     1234,
+		Executable: false,
+		Reason:     "unknown initiating message",
+		Executions: []MessageExecution{{ChainID: ChainIDFromUInt64(10), BlockNumber: 5, LogIndex: 3}},
+	}
+	raw, err := json.Marshal(&validity)
+	require.NoError(t, err)
+	var dec MessageValidity
+	require.NoError(t, json.Unmarshal(raw, &dec))
+	require.Equal(t, validity, dec)
+}
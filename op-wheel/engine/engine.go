@@ -242,7 +242,7 @@ func Auto(
 							log.Error("failed to find block for new safe block progress", "err", err)
 							continue
 						}
-						status.Safe = eth.L1BlockRef{Hash: safe.Hash(), Number: safe.Number.Uint64(), Time: safe.Time, ParentHash: safe.ParentHash}
+						status.Safe = eth.HeaderToL1BlockRef(safe)
 					}
 					if status.Finalized.Number+32 <= status.Safe.Number {
 						finalized, err := getHeader(ctx, client.RPC, methodEthGetBlockByNumber, hexutil.Uint64(status.Safe.Number-32).String())
@@ -251,7 +251,7 @@ func Auto(
 							log.Error("failed to find block for new finalized block progress", "err", err)
 							continue
 						}
-						status.Finalized = eth.L1BlockRef{Hash: finalized.Hash(), Number: finalized.Number.Uint64(), Time: finalized.Time, ParentHash: finalized.ParentHash}
+						status.Finalized = eth.HeaderToL1BlockRef(finalized)
 					}
 				}
 
@@ -299,9 +299,9 @@ func Status(ctx context.Context, client client.RPC) (*StatusData, error) {
 		return nil, err
 	}
 	return &StatusData{
-		Head:      eth.L1BlockRef{Hash: head.Hash(), Number: head.NumberU64(), Time: head.Time(), ParentHash: head.ParentHash()},
-		Safe:      eth.L1BlockRef{Hash: safe.Hash(), Number: safe.Number.Uint64(), Time: safe.Time, ParentHash: safe.ParentHash},
-		Finalized: eth.L1BlockRef{Hash: finalized.Hash(), Number: finalized.Number.Uint64(), Time: finalized.Time, ParentHash: finalized.ParentHash},
+		Head:      eth.BlockToL1BlockRef(head),
+		Safe:      eth.HeaderToL1BlockRef(safe),
+		Finalized: eth.HeaderToL1BlockRef(finalized),
 		Txs:       uint64(len(head.Transactions())),
 		Gas:       head.GasUsed(),
 		StateRoot: head.Root(),
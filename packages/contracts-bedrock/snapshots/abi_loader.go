@@ -28,6 +28,15 @@ var systemConfig []byte
 //go:embed abi/CrossL2Inbox.json
 var crossL2Inbox []byte
 
+//go:embed abi/ProxyAdmin.json
+var proxyAdmin []byte
+
+//go:embed abi/OptimismPortal.json
+var optimismPortal []byte
+
+//go:embed abi/L2OutputOracle.json
+var l2OutputOracle []byte
+
 func LoadDisputeGameFactoryABI() *abi.ABI {
 	return loadABI(disputeGameFactory)
 }
@@ -52,6 +61,18 @@ func LoadCrossL2InboxABI() *abi.ABI {
 	return loadABI(crossL2Inbox)
 }
 
+func LoadProxyAdminABI() *abi.ABI {
+	return loadABI(proxyAdmin)
+}
+
+func LoadOptimismPortalABI() *abi.ABI {
+	return loadABI(optimismPortal)
+}
+
+func LoadL2OutputOracleABI() *abi.ABI {
+	return loadABI(l2OutputOracle)
+}
+
 func loadABI(json []byte) *abi.ABI {
 	if parsed, err := abi.JSON(bytes.NewReader(json)); err != nil {
 		panic(err)